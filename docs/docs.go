@@ -0,0 +1,6945 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "contact": {},
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/v1/admin/migrations/down": {
+            "post": {
+                "description": "Roll back ` + "`" + `steps` + "`" + ` migrations. A backup of the database is taken before anything is rolled back. Only served if ` + "`" + `enable_destructive_migration_endpoints` + "`" + ` is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Roll back migrations",
+                "parameters": [
+                    {
+                        "description": "Migrate Down request",
+                        "name": "migration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MigrateDownRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MigrationResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/admin/migrations/force": {
+            "post": {
+                "description": "Set the schema ` + "`" + `version` + "`" + ` without running any migrations, and clear the dirty flag. Version ` + "`" + `-1` + "`" + ` marks the database as having no migrations applied. Only served if ` + "`" + `enable_destructive_migration_endpoints` + "`" + ` is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Force a migration version",
+                "parameters": [
+                    {
+                        "description": "Force Migration Version request",
+                        "name": "migration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.ForceMigrationVersionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MigrationStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/admin/migrations/goto": {
+            "post": {
+                "description": "Migrate up or down to ` + "`" + `version` + "`" + `. Version ` + "`" + `0` + "`" + ` rolls back every migration. A backup of the database is taken if the migration goes down. Only served if ` + "`" + `enable_destructive_migration_endpoints` + "`" + ` is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Migrate to a version",
+                "parameters": [
+                    {
+                        "description": "Migrate To Version request",
+                        "name": "migration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MigrateToVersionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MigrationResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/admin/migrations/status": {
+            "get": {
+                "description": "Get the current schema ` + "`" + `version` + "`" + `, whether the database is ` + "`" + `dirty` + "`" + `, and which migrations are applied or pending",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get the migration status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MigrationStatusResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/admin/migrations/up": {
+            "post": {
+                "description": "Apply all pending up migrations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Apply pending migrations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.MigrationStatusResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/agenda": {
+            "get": {
+                "description": "Get the booked ` + "`" + `event` + "`" + `s (` + "`" + `callBooked` + "`" + `, ` + "`" + `interviewBooked` + "`" + ` and ` + "`" + `recruiterInterviewBooked` + "`" + `) from ` + "`" + `from` + "`" + ` up to ` + "`" + `to` + "`" + ` as ` + "`" + `upcoming` + "`" + `, soonest first. ` + "`" + `from` + "`" + ` defaults to now, and without ` + "`" + `to` + "`" + ` every later booked event is returned. ` + "`" + `overdue` + "`" + ` lists the booked events whose date has passed without a matching completed event (` + "`" + `callCompleted` + "`" + `, ` + "`" + `interviewCompleted` + "`" + ` or ` + "`" + `recruiterInterviewCompleted` + "`" + `) dated at or after them and linked to one of the same ` + "`" + `application` + "`" + `s or ` + "`" + `company` + "`" + `s, oldest first. Events include their ` + "`" + `application` + "`" + `s, ` + "`" + `company` + "`" + `s and ` + "`" + `person` + "`" + `s with all fields. Only these built-in types are booked or completed. Event types added through ` + "`" + `/api/v1/type/event/` + "`" + ` are never included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get the agenda",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2025-12-01T00:00:00Z",
+                        "description": "Start of the period, as RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2025-12-31T00:00:00Z",
+                        "description": "End of the period, exclusive, as RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. upcoming. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.AgendaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/associate": {
+            "post": {
+                "description": "associate an ` + "`" + `application` + "`" + ` with a ` + "`" + `event` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationEvent"
+                ],
+                "summary": "associate an application with an event",
+                "parameters": [
+                    {
+                        "description": "Associate Application Event request",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.AssociateApplicationEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ApplicationEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/associate/bulk": {
+            "post": {
+                "description": "associate up to 100 application event pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status ` + "`" + `conflict` + "`" + ` or ` + "`" + `invalid` + "`" + `, and the other pairs are still associated. Returns 400 without associating anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "bulk associate application event pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk associate ApplicationEvent request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkAssociateApplicationEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/delete": {
+            "delete": {
+                "description": "Delete a ` + "`" + `applicationEvent` + "`" + ` by application UUID and event UUID",
+                "tags": [
+                    "applicationEvent"
+                ],
+                "summary": "Delete an applicationEvent by application UUID and event UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/delete/bulk": {
+            "delete": {
+                "description": "delete up to 100 application event pairs in one transaction. A pair that does not exist is reported in its result with the status ` + "`" + `notFound` + "`" + `, and the other pairs are still deleted. Returns 400 without deleting anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "bulk delete application event pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk delete ApplicationEvent request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkDeleteApplicationEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/get/": {
+            "get": {
+                "description": "Get ` + "`" + `applicationEvent` + "`" + `s by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationEvent"
+                ],
+                "summary": "Get applicationEvents by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. application_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `applicationEvent` + "`" + `s",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationEvent"
+                ],
+                "summary": "Get all applicationEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. application_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-event/update": {
+            "post": {
+                "description": "Update the ` + "`" + `role` + "`" + `, ` + "`" + `notes` + "`" + `, ` + "`" + `start_date` + "`" + ` and ` + "`" + `end_date` + "`" + ` of a ` + "`" + `applicationEvent` + "`" + `. Details that are not in the request are left unchanged. The ` + "`" + `end_date` + "`" + ` cannot be before the ` + "`" + `start_date` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationEvent"
+                ],
+                "summary": "Update the details of a applicationEvent",
+                "parameters": [
+                    {
+                        "description": "Update applicationEvent request",
+                        "name": "applicationEvent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateApplicationEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/associate": {
+            "post": {
+                "description": "associate an ` + "`" + `application` + "`" + ` with a ` + "`" + `person` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationPerson"
+                ],
+                "summary": "associate an application with a person",
+                "parameters": [
+                    {
+                        "description": "Associate Application Person request",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.AssociateApplicationPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ApplicationPersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/associate/bulk": {
+            "post": {
+                "description": "associate up to 100 application person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status ` + "`" + `conflict` + "`" + ` or ` + "`" + `invalid` + "`" + `, and the other pairs are still associated. Returns 400 without associating anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "bulk associate application person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk associate ApplicationPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkAssociateApplicationPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/delete": {
+            "delete": {
+                "description": "Delete a ` + "`" + `applicationPerson` + "`" + ` by application UUID and person UUID",
+                "tags": [
+                    "applicationPerson"
+                ],
+                "summary": "Delete an applicationPerson by application UUID and person UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/delete/bulk": {
+            "delete": {
+                "description": "delete up to 100 application person pairs in one transaction. A pair that does not exist is reported in its result with the status ` + "`" + `notFound` + "`" + `, and the other pairs are still deleted. Returns 400 without deleting anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "bulk delete application person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk delete ApplicationPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkDeleteApplicationPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/get/": {
+            "get": {
+                "description": "Get ` + "`" + `applicationPerson` + "`" + `s by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationPerson"
+                ],
+                "summary": "Get applicationPersons by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. application_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `applicationPerson` + "`" + `s",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationPerson"
+                ],
+                "summary": "Get all applicationPersons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. application_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application-person/update": {
+            "post": {
+                "description": "Update the ` + "`" + `role` + "`" + `, ` + "`" + `notes` + "`" + `, ` + "`" + `start_date` + "`" + ` and ` + "`" + `end_date` + "`" + ` of a ` + "`" + `applicationPerson` + "`" + `. Details that are not in the request are left unchanged. The ` + "`" + `end_date` + "`" + ` cannot be before the ` + "`" + `start_date` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "applicationPerson"
+                ],
+                "summary": "Update the details of a applicationPerson",
+                "parameters": [
+                    {
+                        "description": "Update applicationPerson request",
+                        "name": "applicationPerson",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateApplicationPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/delete/{id}": {
+            "delete": {
+                "description": "Delete an ` + "`" + `application` + "`" + ` by ID",
+                "tags": [
+                    "application"
+                ],
+                "summary": "Delete an application by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the delete is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `application` + "`" + `s\n- include_company=all: Returns associated ` + "`" + `company` + "`" + ` with all fields\n- include_company=ids: Returns associated ` + "`" + `company` + "`" + ` with only ` + "`" + `id` + "`" + `. Note: this will also return the ` + "`" + `company_id` + "`" + ` in the main response, resulting in duplicated data.\n- include_company=none: No associated ` + "`" + `company` + "`" + ` included (default). Note: this will still return the ` + "`" + `company_id` + "`" + ` in the main response.\n- include_recruiter=all: Returns associated ` + "`" + `recruiter` + "`" + ` (` + "`" + `company` + "`" + `) with all fields\n- include_recruiter=ids: Returns associated ` + "`" + `recruiter` + "`" + ` with only ` + "`" + `id` + "`" + `. Note: this will also return the ` + "`" + `recruiter_id` + "`" + ` in the main response, resulting in duplicated data.\n- include_recruiter=none: No associated ` + "`" + `recruiter` + "`" + ` included (default). Note: this will still return the ` + "`" + `recruiter_id` + "`" + ` in the main response.\n- include_persons=all: Returns ` + "`" + `person` + "`" + `s with all fields\n- include_persons=ids: Returns ` + "`" + `person` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_persons=none: No ` + "`" + `person` + "`" + ` data included (default)\n- include_events=all: Returns ` + "`" + `event` + "`" + `s with all fields\n- include_events=ids: Returns ` + "`" + `event` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_events=none: No ` + "`" + `event` + "`" + ` data included (default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "Get all applications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,job_title. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/application/get/id/{id}": {
+            "get": {
+                "description": "Get an ` + "`" + `application` + "`" + ` by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "Get an application by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,job_title. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ApplicationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the application"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/get/ids": {
+            "post": {
+                "description": "Get up to 100 ` + "`" + `application` + "`" + `s by ID, in the order of ` + "`" + `ids` + "`" + `. IDs that do not exist are skipped. Duplicate IDs are returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "Get applications by IDs",
+                "parameters": [
+                    {
+                        "description": "IDs of the applications",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.GetByIDsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,job_title. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/get/title/{title}": {
+            "get": {
+                "description": "Get ` + "`" + `application` + "`" + `s which fully, or partially, match the input job title",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "Get applications by job title",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job title",
+                        "name": "job_title",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,job_title. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ApplicationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/log": {
+            "post": {
+                "description": "create an ` + "`" + `application` + "`" + `, an optional inline ` + "`" + `company` + "`" + ` and ` + "`" + `recruiter` + "`" + `, new ` + "`" + `persons` + "`" + ` and the initial ` + "`" + `event` + "`" + `, and associate the persons in ` + "`" + `persons` + "`" + ` and ` + "`" + `person_ids` + "`" + ` and the event with the application. Everything is created in one transaction: if any part fails, nothing is created. ` + "`" + `application.company_id` + "`" + ` and ` + "`" + `application.recruiter_id` + "`" + ` may be omitted when ` + "`" + `company` + "`" + ` and ` + "`" + `recruiter` + "`" + ` are given. The response includes the company, recruiter, persons and events. If an application with the same normalized ` + "`" + `job_ad_url` + "`" + `, or with the same company and ` + "`" + `job_title` + "`" + `, already exists, 409 is returned and nothing is created, unless ` + "`" + `force=true` + "`" + ` is passed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "log a new application",
+                "parameters": [
+                    {
+                        "description": "Log Application request",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.LogApplicationRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create the application even if it looks like a duplicate",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ApplicationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/new": {
+            "post": {
+                "description": "create an ` + "`" + `application` + "`" + ` and return it. ` + "`" + `company_id` + "`" + ` AND/OR ` + "`" + `recruiter_id` + "`" + ` must be provided. ` + "`" + `job_title` + "`" + ` AND/OR ` + "`" + `job_ad_url` + "`" + ` must be provided.\n` + "`" + `job_ad_url` + "`" + ` is normalized before it is stored: the scheme becomes https, the host is lower-cased without \"www.\", and tracking parameters such as ` + "`" + `utm_*` + "`" + `, trailing slashes and the fragment are removed. A fragment that starts with \"#/\" or \"#!\" is kept. URLs stored before they were normalized are normalized when the server starts.\nIf an application with the same normalized ` + "`" + `job_ad_url` + "`" + `, or with the same ` + "`" + `company_id` + "`" + ` and ` + "`" + `job_title` + "`" + `, already exists, 409 is returned with the ID of that application. Pass ` + "`" + `force=true` + "`" + ` to create the application anyway.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "create an application",
+                "parameters": [
+                    {
+                        "description": "Create Application request",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateApplicationRequest"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Create the application even if it looks like a duplicate",
+                        "name": "force",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ApplicationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/update": {
+            "post": {
+                "description": "update an ` + "`" + `application` + "`" + `\n` + "`" + `job_ad_url` + "`" + ` is normalized in the same way as when the application is created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "update an application",
+                "parameters": [
+                    {
+                        "description": "Update Application Request",
+                        "name": "application",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateApplicationRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/application/{id}/timeline": {
+            "get": {
+                "description": "Get the history of an ` + "`" + `application` + "`" + `, oldest first: when it was created and last updated, its ` + "`" + `event` + "`" + `s with their ` + "`" + `company` + "`" + `s and ` + "`" + `person` + "`" + `s, and when ` + "`" + `person` + "`" + `s were linked to it or their links were changed. Links that were deleted are not shown. With ` + "`" + `format=markdown` + "`" + ` the timeline is returned as a Markdown list instead.",
+                "produces": [
+                    "application/json",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "application"
+                ],
+                "summary": "Get the timeline of an application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Application ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "json (default) or markdown",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return. Default all. Not with format=markdown",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TimelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/changes/stream": {
+            "get": {
+                "description": "Stream the creates, updates and deletes of ` + "`" + `application` + "`" + `s, ` + "`" + `company` + "`" + `s, ` + "`" + `event` + "`" + `s and ` + "`" + `person` + "`" + `s, and the associates, updates and deletes of the links between them, as server-sent events. Each event has the ` + "`" + `sequence` + "`" + ` of the change as its ` + "`" + `id` + "`" + ` and a ` + "`" + `ChangeResponse` + "`" + ` as its ` + "`" + `data` + "`" + `. Without ` + "`" + `Last-Event-ID` + "`" + ` the stream starts with the next change. A client that reconnects with ` + "`" + `Last-Event-ID` + "`" + `, as ` + "`" + `EventSource` + "`" + ` does, receives every change after that sequence. ` + "`" + `last_event_id` + "`" + ` can be used instead of the header for the first connection. Comments are sent when the stream is idle, to keep the connection open.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "change"
+                ],
+                "summary": "Stream changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated entity types, e.g. application,company-person. Default all",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sequence of the last change received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sequence of the last change received, if Last-Event-ID is not set",
+                        "name": "last_event_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/associate": {
+            "post": {
+                "description": "associate a ` + "`" + `company` + "`" + ` with a ` + "`" + `event` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyEvent"
+                ],
+                "summary": "associate a company with an event",
+                "parameters": [
+                    {
+                        "description": "Associate Company Event request",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.AssociateCompanyEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CompanyEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/associate/bulk": {
+            "post": {
+                "description": "associate up to 100 company event pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status ` + "`" + `conflict` + "`" + ` or ` + "`" + `invalid` + "`" + `, and the other pairs are still associated. Returns 400 without associating anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "bulk associate company event pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk associate CompanyEvent request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkAssociateCompanyEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/delete": {
+            "delete": {
+                "description": "Delete a ` + "`" + `companyEvent` + "`" + ` by company UUID and event UUID",
+                "tags": [
+                    "companyEvent"
+                ],
+                "summary": "Delete a companyEvent by company UUID and event UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "company ID",
+                        "name": "company-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/delete/bulk": {
+            "delete": {
+                "description": "delete up to 100 company event pairs in one transaction. A pair that does not exist is reported in its result with the status ` + "`" + `notFound` + "`" + `, and the other pairs are still deleted. Returns 400 without deleting anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "bulk delete company event pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk delete CompanyEvent request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkDeleteCompanyEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/get/": {
+            "get": {
+                "description": "Get ` + "`" + `companyEvent` + "`" + `s by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyEvent"
+                ],
+                "summary": "Get companyEvents by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "company ID",
+                        "name": "company-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. company_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `companyEvent` + "`" + `s",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyEvent"
+                ],
+                "summary": "Get all companyEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. company_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-event/update": {
+            "post": {
+                "description": "Update the ` + "`" + `role` + "`" + `, ` + "`" + `notes` + "`" + `, ` + "`" + `start_date` + "`" + ` and ` + "`" + `end_date` + "`" + ` of a ` + "`" + `companyEvent` + "`" + `. Details that are not in the request are left unchanged. The ` + "`" + `end_date` + "`" + ` cannot be before the ` + "`" + `start_date` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyEvent"
+                ],
+                "summary": "Update the details of a companyEvent",
+                "parameters": [
+                    {
+                        "description": "Update companyEvent request",
+                        "name": "companyEvent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateCompanyEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/associate": {
+            "post": {
+                "description": "associate a ` + "`" + `company` + "`" + ` with a ` + "`" + `person` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyPerson"
+                ],
+                "summary": "associate a company with a person",
+                "parameters": [
+                    {
+                        "description": "Associate Company Person request",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.AssociateCompanyPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CompanyPersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/associate/bulk": {
+            "post": {
+                "description": "associate up to 100 company person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status ` + "`" + `conflict` + "`" + ` or ` + "`" + `invalid` + "`" + `, and the other pairs are still associated. Returns 400 without associating anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "bulk associate company person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk associate CompanyPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkAssociateCompanyPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/delete": {
+            "delete": {
+                "description": "Delete a ` + "`" + `companyPerson` + "`" + ` by company UUID and person UUID",
+                "tags": [
+                    "companyPerson"
+                ],
+                "summary": "Delete a companyPerson by company UUID and person UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "company ID",
+                        "name": "company-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/delete/bulk": {
+            "delete": {
+                "description": "delete up to 100 company person pairs in one transaction. A pair that does not exist is reported in its result with the status ` + "`" + `notFound` + "`" + `, and the other pairs are still deleted. Returns 400 without deleting anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "bulk delete company person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk delete CompanyPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkDeleteCompanyPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/get/": {
+            "get": {
+                "description": "Get ` + "`" + `companyPerson` + "`" + `s by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyPerson"
+                ],
+                "summary": "Get companyPersons by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "company ID",
+                        "name": "company-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. company_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `companyPerson` + "`" + `s",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyPerson"
+                ],
+                "summary": "Get all companyPersons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. company_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company-person/update": {
+            "post": {
+                "description": "Update the ` + "`" + `role` + "`" + `, ` + "`" + `notes` + "`" + `, ` + "`" + `start_date` + "`" + ` and ` + "`" + `end_date` + "`" + ` of a ` + "`" + `companyPerson` + "`" + `. Details that are not in the request are left unchanged. The ` + "`" + `end_date` + "`" + ` cannot be before the ` + "`" + `start_date` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "companyPerson"
+                ],
+                "summary": "Update the details of a companyPerson",
+                "parameters": [
+                    {
+                        "description": "Update companyPerson request",
+                        "name": "companyPerson",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateCompanyPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/delete/{id}": {
+            "delete": {
+                "description": "Delete a ` + "`" + `company` + "`" + ` by ID",
+                "tags": [
+                    "company"
+                ],
+                "summary": "Delete a company by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the delete is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/duplicates": {
+            "get": {
+                "description": "Get pairs of ` + "`" + `company` + "`" + `s whose names are similar once case, punctuation and legal forms such as \"AB\" or \"Inc\" are ignored. Pairs are ordered by ` + "`" + `score` + "`" + `, from 1 for identical normalized names down to 0.85. ` + "`" + `company` + "`" + ` is the older of the two, and the suggested survivor for ` + "`" + `/v1/company/merge` + "`" + `.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get duplicate companies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. duplicate,score. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyDuplicateResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `company` + "`" + `s\n- include_applications=all: Returns ` + "`" + `application` + "`" + `s with all fields\n- include_applications=ids: Returns ` + "`" + `application` + "`" + `s with only ` + "`" + `id` + "`" + `, ` + "`" + `application_id` + "`" + `, and ` + "`" + `recruiter_id` + "`" + `\n- include_applications=none: No ` + "`" + `application` + "`" + ` data included (default)\n- include_persons=all: Returns ` + "`" + `person` + "`" + `s with all fields\n- include_persons=ids: Returns ` + "`" + `person` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_persons=none: No ` + "`" + `person` + "`" + ` data included (default)\n- include_events=all: Returns ` + "`" + `event` + "`" + `s with all fields\n- include_events=ids: Returns ` + "`" + `event` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_events=none: No ` + "`" + `event` + "`" + ` data included (default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get all companies",
+                "parameters": [
+                    {
+                        "enum": [
+                            "all",
+                            "ids",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "string enums",
+                        "name": "include_applications",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "ids",
+                            "none"
+                        ],
+                        "type": "string",
+                        "description": "string enums",
+                        "name": "include_persons",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/get/id/{id}": {
+            "get": {
+                "description": "Get a ` + "`" + `company` + "`" + ` by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get a company by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CompanyResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the company"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/get/ids": {
+            "post": {
+                "description": "Get up to 100 ` + "`" + `company` + "`" + `s by ID, in the order of ` + "`" + `ids` + "`" + `. IDs that do not exist are skipped. Duplicate IDs are returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get companies by IDs",
+                "parameters": [
+                    {
+                        "description": "IDs of the companies",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.GetByIDsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/get/name/{name}": {
+            "get": {
+                "description": "Get ` + "`" + `company` + "`" + `s which fully, or partially, match the input name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get companies by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Company Name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.CompanyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/merge": {
+            "post": {
+                "description": "Move every ` + "`" + `application` + "`" + ` (as company or recruiter), ` + "`" + `company-person` + "`" + ` and ` + "`" + `company-event` + "`" + ` of the ` + "`" + `duplicate_id` + "`" + ` company onto the ` + "`" + `survivor_id` + "`" + ` company, then delete the duplicate. Everything happens in one transaction. The survivor keeps its own name, type and notes. Returns the surviving company.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Merge two companies",
+                "parameters": [
+                    {
+                        "description": "Merge request",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/new": {
+            "post": {
+                "description": "create a ` + "`" + `company` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "create a company",
+                "parameters": [
+                    {
+                        "description": "Create Company request",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateCompanyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CompanyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/update": {
+            "post": {
+                "description": "update a ` + "`" + `company` + "`" + `",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "update a company",
+                "parameters": [
+                    {
+                        "description": "Update Company Request",
+                        "name": "company",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateCompanyRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/company/{id}/timeline": {
+            "get": {
+                "description": "Get the history of a ` + "`" + `company` + "`" + `, oldest first: when it was created and last updated, when ` + "`" + `application` + "`" + `s with it as company or recruiter were created, its ` + "`" + `event` + "`" + `s with their ` + "`" + `application` + "`" + `s and ` + "`" + `person` + "`" + `s, and when ` + "`" + `person` + "`" + `s were linked to it or their links were changed. Links that were deleted are not shown. With ` + "`" + `format=markdown` + "`" + ` the timeline is returned as a Markdown list instead.",
+                "produces": [
+                    "application/json",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "company"
+                ],
+                "summary": "Get the timeline of a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Company ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "json (default) or markdown",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return. Default all. Not with format=markdown",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TimelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/associate": {
+            "post": {
+                "description": "associate an ` + "`" + `event` + "`" + ` with a ` + "`" + `person` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "eventPerson"
+                ],
+                "summary": "associate an event with a person",
+                "parameters": [
+                    {
+                        "description": "Associate Event Person request",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.AssociateEventPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.EventPersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/associate/bulk": {
+            "post": {
+                "description": "associate up to 100 event person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status ` + "`" + `conflict` + "`" + ` or ` + "`" + `invalid` + "`" + `, and the other pairs are still associated. Returns 400 without associating anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "bulk associate event person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk associate EventPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkAssociateEventPersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/delete": {
+            "delete": {
+                "description": "Delete a ` + "`" + `eventPerson` + "`" + ` by event UUID and person UUID",
+                "tags": [
+                    "eventPerson"
+                ],
+                "summary": "Delete an eventPerson by event UUID and person UUID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/delete/bulk": {
+            "delete": {
+                "description": "delete up to 100 event person pairs in one transaction. A pair that does not exist is reported in its result with the status ` + "`" + `notFound` + "`" + `, and the other pairs are still deleted. Returns 400 without deleting anything if ` + "`" + `links` + "`" + ` is empty, too long or contains a malformed pair.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "bulk delete event person pairs",
+                "parameters": [
+                    {
+                        "description": "Bulk delete EventPerson request",
+                        "name": "links",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.BulkDeleteEventPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.BulkLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/get/": {
+            "get": {
+                "description": "Get ` + "`" + `eventPerson` + "`" + `s by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "eventPerson"
+                ],
+                "summary": "Get eventPersons by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "event ID",
+                        "name": "event-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "person ID",
+                        "name": "person-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. event_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.EventPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `eventPerson` + "`" + `s",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "eventPerson"
+                ],
+                "summary": "Get all eventPersons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. event_id,role. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.EventPersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event-person/update": {
+            "post": {
+                "description": "Update the ` + "`" + `role` + "`" + `, ` + "`" + `notes` + "`" + `, ` + "`" + `start_date` + "`" + ` and ` + "`" + `end_date` + "`" + ` of a ` + "`" + `eventPerson` + "`" + `. Details that are not in the request are left unchanged. The ` + "`" + `end_date` + "`" + ` cannot be before the ` + "`" + `start_date` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "eventPerson"
+                ],
+                "summary": "Update the details of a eventPerson",
+                "parameters": [
+                    {
+                        "description": "Update eventPerson request",
+                        "name": "eventPerson",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateEventPersonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/delete/{id}": {
+            "delete": {
+                "description": "Delete an ` + "`" + `event` + "`" + ` by ID",
+                "tags": [
+                    "event"
+                ],
+                "summary": "Delete an event by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the delete is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `event` + "`" + `s\n- include_applications=all: Returns ` + "`" + `application` + "`" + `s with all fields\n- include_applications=ids: Returns ` + "`" + `application` + "`" + `s with only ` + "`" + `id` + "`" + `, ` + "`" + `application_id` + "`" + `, and ` + "`" + `recruiter_id` + "`" + `\n- include_applications=none: No ` + "`" + `application` + "`" + ` data included (default)\n- include_companies=all: Returns ` + "`" + `company` + "`" + `s with all fields\n- include_companies=ids: Returns ` + "`" + `company` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_companies=none: No ` + "`" + `company` + "`" + ` data included (default)\n- include_persons=all: Returns ` + "`" + `person` + "`" + `s with all fields\n- include_persons=ids: Returns ` + "`" + `person` + "`" + `s with only ` + "`" + `id` + "`" + `\n- include_persons=none: No ` + "`" + `person` + "`" + ` data included (default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get all events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,event_date. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.EventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/get/id/{id}": {
+            "get": {
+                "description": "Get an ` + "`" + `event` + "`" + ` by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get an event by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Event ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,event_date. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.EventResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the event"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/get/ids": {
+            "post": {
+                "description": "Get up to 100 ` + "`" + `event` + "`" + `s by ID, in the order of ` + "`" + `ids` + "`" + `. IDs that do not exist are skipped. Duplicate IDs are returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "Get events by IDs",
+                "parameters": [
+                    {
+                        "description": "IDs of the events",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.GetByIDsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,event_date. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.EventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/new": {
+            "post": {
+                "description": "create an ` + "`" + `event` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "create an event",
+                "parameters": [
+                    {
+                        "description": "Create Event request",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/event/update": {
+            "post": {
+                "description": "update an ` + "`" + `event` + "`" + ` and return it. ` + "`" + `overlapping_events` + "`" + ` lists the booked events that it now clashes with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "event"
+                ],
+                "summary": "update an event",
+                "parameters": [
+                    {
+                        "description": "Update Event Request",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-prep/delete/{id}": {
+            "delete": {
+                "description": "Delete the interview prep of an ` + "`" + `event` + "`" + `, and all of its questions. The event itself is kept.",
+                "tags": [
+                    "interviewPrep"
+                ],
+                "summary": "Delete interview prep",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the interviewCompleted or codeTestCompleted event",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the delete is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-prep/get/id/{id}": {
+            "get": {
+                "description": "Get the interview prep of an ` + "`" + `event` + "`" + `, with its questions, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewPrep"
+                ],
+                "summary": "Get interview prep by event ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the interviewCompleted or codeTestCompleted event",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. event_id,notes. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.InterviewPrepResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the interview prep and its questions"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-prep/new": {
+            "post": {
+                "description": "Add structured prep to an ` + "`" + `event` + "`" + ` of type ` + "`" + `interviewCompleted` + "`" + ` or ` + "`" + `codeTestCompleted` + "`" + `: technical topics, a self-rating from 1 to 5 and notes. The prep uses the ID of the event as its own. Questions are added with ` + "`" + `/v1/interview-question/new` + "`" + `.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewPrep"
+                ],
+                "summary": "Create interview prep",
+                "parameters": [
+                    {
+                        "description": "Create interview prep request",
+                        "name": "interviewPrep",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateInterviewPrepRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.InterviewPrepResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-prep/update": {
+            "post": {
+                "description": "Update the technical topics, self-rating or notes of an interview prep",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewPrep"
+                ],
+                "summary": "Update interview prep",
+                "parameters": [
+                    {
+                        "description": "Update interview prep request",
+                        "name": "interviewPrep",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateInterviewPrepRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-question/delete/{id}": {
+            "delete": {
+                "description": "Delete an interview question by ID",
+                "tags": [
+                    "interviewQuestion"
+                ],
+                "summary": "Delete an interview question",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the interview question",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-question/get": {
+            "get": {
+                "description": "Get every question from the interviews and code tests of an ` + "`" + `application` + "`" + `, or of a ` + "`" + `company` + "`" + `, newest event first. The events of a company are those associated with the company, and those associated with an application where it is the employer. Exactly one of ` + "`" + `application-id` + "`" + ` and ` + "`" + `company-id` + "`" + ` must be provided.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewQuestion"
+                ],
+                "summary": "Get interview questions of an application or a company",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "company ID",
+                        "name": "company-id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. question,answer. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.InterviewQuestionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-question/new": {
+            "post": {
+                "description": "Add a question to the interview prep of an ` + "`" + `event` + "`" + `. ` + "`" + `askedToUs` + "`" + ` questions were asked by the interviewer and ` + "`" + `answer` + "`" + ` is our answer. ` + "`" + `askedByUs` + "`" + ` questions were asked by us and ` + "`" + `answer` + "`" + ` is the interviewer's answer. The interview prep must exist.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewQuestion"
+                ],
+                "summary": "Create an interview question",
+                "parameters": [
+                    {
+                        "description": "Create interview question request",
+                        "name": "interviewQuestion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateInterviewQuestionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.InterviewQuestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/interview-question/update": {
+            "post": {
+                "description": "Update the type, question, answer or topic of an interview question",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "interviewQuestion"
+                ],
+                "summary": "Update an interview question",
+                "parameters": [
+                    {
+                        "description": "Update interview question request",
+                        "name": "interviewQuestion",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateInterviewQuestionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/job-ad-snapshot/get": {
+            "get": {
+                "description": "Get the ` + "`" + `jobAdSnapshot` + "`" + `s of the ` + "`" + `application-id` + "`" + ` application, newest first. ` + "`" + `content` + "`" + ` is not included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobAdSnapshot"
+                ],
+                "summary": "Get the job ad snapshots of an application",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. job_title,company_name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.JobAdSnapshotResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/job-ad-snapshot/get/id/{id}": {
+            "get": {
+                "description": "Get a ` + "`" + `jobAdSnapshot` + "`" + ` by ID, including the saved job ad in ` + "`" + `content` + "`" + `",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobAdSnapshot"
+                ],
+                "summary": "Get a job ad snapshot by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "ID of the job ad snapshot",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the job ad snapshot the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. job_title,company_name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.JobAdSnapshotResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the job ad snapshot, which never changes"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/job-ad-snapshot/new": {
+            "post": {
+                "description": "Store a saved job ad for the ` + "`" + `application-id` + "`" + ` application, together with what could be read from it. The job ad is sent the same way as for ` + "`" + `/v1/job-ad-snapshot/parse` + "`" + `. The response does not include ` + "`" + `content` + "`" + `.",
+                "consumes": [
+                    "text/html",
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobAdSnapshot"
+                ],
+                "summary": "Create a job ad snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "application ID",
+                        "name": "application-id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "The saved job ad",
+                        "name": "jobAd",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.JobAdSnapshotResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/job-ad-snapshot/parse": {
+            "post": {
+                "description": "Read the job title, company, location, remote hints and salary from a saved job ad, without storing it. The job ad is either the HTML page of the ad or a schema.org ` + "`" + `JobPosting` + "`" + ` in JSON-LD, sent as the request body or as the ` + "`" + `file` + "`" + ` field of a multipart form. A ` + "`" + `JobPosting` + "`" + ` embedded in an HTML page is preferred over the rest of the page. Nothing is fetched from the network. ` + "`" + `application` + "`" + ` can be used as a starting point for ` + "`" + `/v1/application/new` + "`" + ` once a company or recruiter has been chosen. At most 5 MiB is accepted.",
+                "consumes": [
+                    "text/html",
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "jobAdSnapshot"
+                ],
+                "summary": "Parse a saved job ad",
+                "parameters": [
+                    {
+                        "description": "The saved job ad",
+                        "name": "jobAd",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.JobAdPostingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/delete/{id}": {
+            "delete": {
+                "description": "Delete a ` + "`" + `person` + "`" + ` by ID",
+                "tags": [
+                    "person"
+                ],
+                "summary": "Delete a person by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the delete is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/duplicates": {
+            "get": {
+                "description": "Get pairs of ` + "`" + `person` + "`" + `s whose names are similar once case, punctuation and word order are ignored (reason ` + "`" + `name` + "`" + `), or whose email addresses have the same domain and similar parts before the \"@\", ignoring case, dots and \"+tags\" (reason ` + "`" + `email` + "`" + `). A pair with similar names and the same email domain also has reason ` + "`" + `domain` + "`" + `. Shared mailboxes such as \"info@\" or \"recruiting@\" are not compared. ` + "`" + `score` + "`" + ` is the similarity of the names or of the email addresses, whichever is higher. Pairs are ordered by ` + "`" + `score` + "`" + `, and pairs with more reasons come first among equal scores. ` + "`" + `person` + "`" + ` is the older of the two, and the suggested survivor for ` + "`" + `/v1/person/merge` + "`" + `.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get duplicate persons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. duplicate,score. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PersonDuplicateResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/get/all": {
+            "get": {
+                "description": "Get all ` + "`" + `person` + "`" + `s\n- include_companies=all: Returns ` + "`" + `company` + "`" + `s with all fields\n- include_companies=ids: Returns ` + "`" + `company` + "`" + `s with only ` + "`" + `id` + "`" + ` field\n- include_companies=none: No ` + "`" + `company` + "`" + ` data included (default)\n- include_events=all: Returns ` + "`" + `event` + "`" + `s with all fields\n- include_events=ids: Returns ` + "`" + `event` + "`" + `s with only ` + "`" + `id` + "`" + ` field\n- include_events=none: No ` + "`" + `event` + "`" + ` data included (default)\n- include_applications=all: Returns ` + "`" + `application` + "`" + `s with all fields\n- include_applications=ids: Returns ` + "`" + `application` + "`" + `s with only ` + "`" + `id` + "`" + `, ` + "`" + `application_id` + "`" + `, and ` + "`" + `recruiter_id` + "`" + `\n- include_applications=none: No ` + "`" + `application` + "`" + ` data included (default)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get all persons",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/get/id/{id}": {
+            "get": {
+                "description": "Get a ` + "`" + `person` + "`" + ` by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get a person by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the client already has",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PersonResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the person"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/get/ids": {
+            "post": {
+                "description": "Get up to 100 ` + "`" + `person` + "`" + `s by ID, in the order of ` + "`" + `ids` + "`" + `. IDs that do not exist are skipped. Duplicate IDs are returned once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get persons by IDs",
+                "parameters": [
+                    {
+                        "description": "IDs of the persons",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.GetByIDsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/get/name/{name}": {
+            "get": {
+                "description": "Get ` + "`" + `person` + "`" + `s which fully, or partially, match the input name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get persons by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Person Name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. id,name. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.PersonResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/merge": {
+            "post": {
+                "description": "Move every ` + "`" + `company-person` + "`" + `, ` + "`" + `application-person` + "`" + ` and ` + "`" + `event-person` + "`" + ` of the ` + "`" + `duplicate_id` + "`" + ` person onto the ` + "`" + `survivor_id` + "`" + ` person, then delete the duplicate. Everything happens in one transaction. The survivor keeps its own name, type, email, phone and notes. Returns the surviving person.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Merge two persons",
+                "parameters": [
+                    {
+                        "description": "Merge request",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/new": {
+            "post": {
+                "description": "create a ` + "`" + `person` + "`" + ` and return it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "create a person",
+                "parameters": [
+                    {
+                        "description": "Create Person request",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreatePersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.PersonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/update": {
+            "post": {
+                "description": "update a ` + "`" + `person` + "`" + `",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "update a person",
+                "parameters": [
+                    {
+                        "description": "Update Person Request",
+                        "name": "person",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdatePersonRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "412": {
+                        "description": "Precondition Failed"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/person/{id}/timeline": {
+            "get": {
+                "description": "Get the history of a ` + "`" + `person` + "`" + `, oldest first: when they were created and last updated, the ` + "`" + `event` + "`" + `s they took part in with their ` + "`" + `application` + "`" + `s, ` + "`" + `company` + "`" + `s and other ` + "`" + `person` + "`" + `s, and when they were linked to ` + "`" + `company` + "`" + `s and ` + "`" + `application` + "`" + `s or those links were changed. Links that were deleted are not shown. With ` + "`" + `format=markdown` + "`" + ` the timeline is returned as a Markdown list instead.",
+                "produces": [
+                    "application/json",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "person"
+                ],
+                "summary": "Get the timeline of a person",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Person ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "markdown"
+                        ],
+                        "type": "string",
+                        "description": "json (default) or markdown",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return. Default all. Not with format=markdown",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TimelineResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/type/{kind}/delete/{name}": {
+            "delete": {
+                "description": "Delete a type of ` + "`" + `event` + "`" + `, ` + "`" + `person` + "`" + ` or ` + "`" + `company` + "`" + `. Built-in types cannot be deleted, and neither can types that are still used by an event, person or company.",
+                "tags": [
+                    "type"
+                ],
+                "summary": "Delete a type",
+                "parameters": [
+                    {
+                        "enum": [
+                            "event",
+                            "person",
+                            "company"
+                        ],
+                        "type": "string",
+                        "description": "Kind of type",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/type/{kind}/get/all": {
+            "get": {
+                "description": "Get all types of ` + "`" + `event` + "`" + `, ` + "`" + `person` + "`" + ` or ` + "`" + `company` + "`" + `, ordered by ` + "`" + `sort_order` + "`" + `",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "type"
+                ],
+                "summary": "Get all types of a kind",
+                "parameters": [
+                    {
+                        "enum": [
+                            "event",
+                            "person",
+                            "company"
+                        ],
+                        "type": "string",
+                        "description": "Kind of type",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated attributes to return, e.g. name,description. Default all",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.TypeDefinitionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/type/{kind}/new": {
+            "post": {
+                "description": "Add a type of ` + "`" + `event` + "`" + `, ` + "`" + `person` + "`" + ` or ` + "`" + `company` + "`" + `. The ` + "`" + `name` + "`" + ` is what events, persons or companies of this type store in their type field. Types are listed by ` + "`" + `sort_order` + "`" + `, and an event type can be mapped to a ` + "`" + `pipeline_stage` + "`" + `. An added event type is never a booked or completed event type, so the agenda and the overlap checks ignore it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "type"
+                ],
+                "summary": "Create a type",
+                "parameters": [
+                    {
+                        "enum": [
+                            "event",
+                            "person",
+                            "company"
+                        ],
+                        "type": "string",
+                        "description": "Kind of type",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create type request",
+                        "name": "type",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CreateTypeDefinitionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Retries with the same key and body get the first response",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.TypeDefinitionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "409": {
+                        "description": "Conflict"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        },
+        "/v1/type/{kind}/update": {
+            "post": {
+                "description": "Update the display name, sort order or pipeline stage of a type of ` + "`" + `event` + "`" + `, ` + "`" + `person` + "`" + ` or ` + "`" + `company` + "`" + `. The name of a type cannot be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "type"
+                ],
+                "summary": "Update a type",
+                "parameters": [
+                    {
+                        "enum": [
+                            "event",
+                            "person",
+                            "company"
+                        ],
+                        "type": "string",
+                        "description": "Kind of type",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update type request",
+                        "name": "type",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UpdateTypeDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    },
+                    "500": {
+                        "description": "Internal Server Error"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "requests.AssociateApplicationEventRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.AssociateApplicationPersonRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.AssociateCompanyEventRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.AssociateCompanyPersonRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.AssociateEventPersonRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.BulkAssociateApplicationEventRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.AssociateApplicationEventRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkAssociateApplicationPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.AssociateApplicationPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkAssociateCompanyEventRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.AssociateCompanyEventRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkAssociateCompanyPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.AssociateCompanyPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkAssociateEventPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.AssociateEventPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkDeleteApplicationEventRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.DeleteApplicationEventRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkDeleteApplicationPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.DeleteApplicationPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkDeleteCompanyEventRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.DeleteCompanyEventRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkDeleteCompanyPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.DeleteCompanyPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.BulkDeleteEventPersonRequest": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.DeleteEventPersonRequest"
+                    },
+                    "x-order": "0"
+                }
+            }
+        },
+        "requests.CreateApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "00",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "01",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "recruiter_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "02",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "job_title": {
+                    "type": "string",
+                    "x-order": "03",
+                    "example": "Job Title"
+                },
+                "job_ad_url": {
+                    "type": "string",
+                    "x-order": "04",
+                    "example": "https://job.ad.url"
+                },
+                "country": {
+                    "type": "string",
+                    "x-order": "05",
+                    "example": "Sweden"
+                },
+                "area": {
+                    "type": "string",
+                    "x-order": "06",
+                    "example": "Stockholm"
+                },
+                "remote_status_type": {
+                    "type": "string",
+                    "x-order": "07",
+                    "example": "hybrid"
+                },
+                "weekdays_in_office": {
+                    "type": "integer",
+                    "x-order": "08",
+                    "example": 2
+                },
+                "estimated_cycle_time": {
+                    "type": "integer",
+                    "x-order": "09",
+                    "example": 25
+                },
+                "estimated_commute_time": {
+                    "type": "integer",
+                    "x-order": "10",
+                    "example": 35
+                },
+                "application_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "requests.CreateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "company_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "employer"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "last_contact": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "requests.CreateEventRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "interviewCompleted"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Event Description"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "event_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "5",
+                    "example": 45
+                },
+                "location": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "Drottninggatan 1, Stockholm"
+                },
+                "meeting_url": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "https://meet.example.com/abc-defg-hij"
+                },
+                "interview_format": {
+                    "type": "string",
+                    "x-order": "8",
+                    "example": "video"
+                },
+                "timezone": {
+                    "type": "string",
+                    "x-order": "9",
+                    "example": "Europe/Stockholm"
+                }
+            }
+        },
+        "requests.CreateInterviewPrepRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "technical_topics": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Go concurrency, SQL indexes"
+                },
+                "self_rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "x-order": "2",
+                    "example": 4
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                }
+            }
+        },
+        "requests.CreateInterviewQuestionRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "question_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "askedToUs"
+                },
+                "question": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "How would you design a rate limiter?"
+                },
+                "answer": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "A token bucket per client"
+                },
+                "topic": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "System design"
+                }
+            }
+        },
+        "requests.CreatePersonRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Person Name"
+                },
+                "person_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "internalRecruiter"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email",
+                    "x-order": "3",
+                    "example": "name@domain.com"
+                },
+                "phone": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "+46123456789"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "Notes go here"
+                }
+            }
+        },
+        "requests.CreateTypeDefinitionRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "technicalScreen"
+                },
+                "display_name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Technical screen"
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "x-order": "2",
+                    "example": 65
+                },
+                "pipeline_stage": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "interviewing"
+                }
+            }
+        },
+        "requests.DeleteApplicationEventRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "requests.DeleteApplicationPersonRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "requests.DeleteCompanyEventRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "requests.DeleteCompanyPersonRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "requests.DeleteEventPersonRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "requests.ForceMigrationVersionRequest": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 8
+                }
+            }
+        },
+        "requests.GetByIDsRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    },
+                    "x-order": "0",
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174000"
+                    ]
+                }
+            }
+        },
+        "requests.LogApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/requests.CreateApplicationRequest"
+                        }
+                    ],
+                    "x-order": "0"
+                },
+                "company": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/requests.CreateCompanyRequest"
+                        }
+                    ],
+                    "x-order": "1"
+                },
+                "recruiter": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/requests.CreateCompanyRequest"
+                        }
+                    ],
+                    "x-order": "2"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/requests.CreatePersonRequest"
+                    },
+                    "x-order": "3"
+                },
+                "person_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    },
+                    "x-order": "4",
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174000"
+                    ]
+                },
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/requests.CreateEventRequest"
+                        }
+                    ],
+                    "x-order": "5"
+                }
+            }
+        },
+        "requests.MergeRequest": {
+            "type": "object",
+            "properties": {
+                "survivor_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "duplicate_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "2c1e9a4b-7d3f-4e1a-9b0c-5f6d7e8a9b0c"
+                }
+            }
+        },
+        "requests.MigrateDownRequest": {
+            "type": "object",
+            "properties": {
+                "steps": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 1
+                }
+            }
+        },
+        "requests.MigrateToVersionRequest": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 8
+                }
+            }
+        },
+        "requests.UpdateApplicationEventRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.UpdateApplicationPersonRequest": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.UpdateApplicationRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "00",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "01",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "recruiter_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "02",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "job_title": {
+                    "type": "string",
+                    "x-order": "03",
+                    "example": "Job Title"
+                },
+                "job_ad_url": {
+                    "type": "string",
+                    "x-order": "04",
+                    "example": "https://job.ad.url"
+                },
+                "country": {
+                    "type": "string",
+                    "x-order": "05",
+                    "example": "Sweden"
+                },
+                "area": {
+                    "type": "string",
+                    "x-order": "06",
+                    "example": "Stockholm"
+                },
+                "remote_status_type": {
+                    "type": "string",
+                    "x-order": "07",
+                    "example": "hybrid"
+                },
+                "weekdays_in_office": {
+                    "type": "integer",
+                    "x-order": "08",
+                    "example": 2
+                },
+                "estimated_cycle_time": {
+                    "type": "integer",
+                    "x-order": "09",
+                    "example": 25
+                },
+                "estimated_commute_time": {
+                    "type": "integer",
+                    "x-order": "10",
+                    "example": 35
+                },
+                "application_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "requests.UpdateCompanyEventRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.UpdateCompanyPersonRequest": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.UpdateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "company_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "employer"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "last_contact": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "requests.UpdateEventPersonRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "requests.UpdateEventRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "codeTestCompleted"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Event Description"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "event_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "x-order": "5",
+                    "example": 45
+                },
+                "location": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "Drottninggatan 1, Stockholm"
+                },
+                "meeting_url": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "https://meet.example.com/abc-defg-hij"
+                },
+                "interview_format": {
+                    "type": "string",
+                    "x-order": "8",
+                    "example": "video"
+                },
+                "timezone": {
+                    "type": "string",
+                    "x-order": "9",
+                    "example": "Europe/Stockholm"
+                }
+            }
+        },
+        "requests.UpdateInterviewPrepRequest": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "technical_topics": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Go concurrency, SQL indexes"
+                },
+                "self_rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "x-order": "2",
+                    "example": 4
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                }
+            }
+        },
+        "requests.UpdateInterviewQuestionRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "question_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "askedByUs"
+                },
+                "question": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "What does a typical week look like?"
+                },
+                "answer": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Two planning meetings, the rest is focus time"
+                },
+                "topic": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "Team"
+                }
+            }
+        },
+        "requests.UpdatePersonRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Person Name"
+                },
+                "person_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "internalRecruiter"
+                },
+                "email": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "name@domain.com"
+                },
+                "phone": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "+46123456789"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "Notes go here"
+                }
+            }
+        },
+        "requests.UpdateTypeDefinitionRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "technicalScreen"
+                },
+                "display_name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Technical screen"
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "x-order": "2",
+                    "example": 65
+                },
+                "pipeline_stage": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "interviewing"
+                }
+            }
+        },
+        "responses.AgendaResponse": {
+            "type": "object",
+            "properties": {
+                "upcoming": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventResponse"
+                    },
+                    "x-order": "0"
+                },
+                "overdue": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventResponse"
+                    },
+                    "x-order": "1"
+                }
+            }
+        },
+        "responses.ApplicationDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "00",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "01",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "recruiter_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "02",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "job_title": {
+                    "type": "string",
+                    "x-order": "03",
+                    "example": "Job Title"
+                },
+                "job_ad_url": {
+                    "type": "string",
+                    "x-order": "04",
+                    "example": "https://job.ad.url"
+                },
+                "country": {
+                    "type": "string",
+                    "x-order": "05",
+                    "example": "Sweden"
+                },
+                "area": {
+                    "type": "string",
+                    "x-order": "06",
+                    "example": "Stockholm"
+                },
+                "remote_status_type": {
+                    "type": "string",
+                    "x-order": "07",
+                    "example": "hybrid"
+                },
+                "weekdays_in_office": {
+                    "type": "integer",
+                    "x-order": "08",
+                    "example": 2
+                },
+                "estimated_cycle_time": {
+                    "type": "integer",
+                    "x-order": "09",
+                    "example": 25
+                },
+                "estimated_commute_time": {
+                    "type": "integer",
+                    "x-order": "10",
+                    "example": 35
+                },
+                "application_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "12",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "13",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.ApplicationEventResponse": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.ApplicationPersonResponse": {
+            "type": "object",
+            "properties": {
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.ApplicationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "00",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "01",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "recruiter_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "02",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "job_title": {
+                    "type": "string",
+                    "x-order": "03",
+                    "example": "Job Title"
+                },
+                "job_ad_url": {
+                    "type": "string",
+                    "x-order": "04",
+                    "example": "https://job.ad.url"
+                },
+                "country": {
+                    "type": "string",
+                    "x-order": "05",
+                    "example": "Sweden"
+                },
+                "area": {
+                    "type": "string",
+                    "x-order": "06",
+                    "example": "Stockholm"
+                },
+                "remote_status_type": {
+                    "type": "string",
+                    "x-order": "07",
+                    "example": "hybrid"
+                },
+                "weekdays_in_office": {
+                    "type": "integer",
+                    "x-order": "08",
+                    "example": 2
+                },
+                "estimated_cycle_time": {
+                    "type": "integer",
+                    "x-order": "09",
+                    "example": 25
+                },
+                "estimated_commute_time": {
+                    "type": "integer",
+                    "x-order": "10",
+                    "example": 35
+                },
+                "application_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "12",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "13",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "company": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.CompanyDTO"
+                        }
+                    ],
+                    "x-order": "14"
+                },
+                "recruiter": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.CompanyDTO"
+                        }
+                    ],
+                    "x-order": "15"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PersonDTO"
+                    },
+                    "x-order": "16"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventDTO"
+                    },
+                    "x-order": "17"
+                }
+            }
+        },
+        "responses.BulkLinkResponse": {
+            "type": "object",
+            "properties": {
+                "succeeded": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 4
+                },
+                "failed": {
+                    "type": "integer",
+                    "x-order": "1",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.BulkLinkResultResponse"
+                    },
+                    "x-order": "2"
+                }
+            }
+        },
+        "responses.BulkLinkResultResponse": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 0
+                },
+                "status": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "conflict"
+                },
+                "message": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "conflict error on insert: ..."
+                }
+            }
+        },
+        "responses.ChangeResponse": {
+            "type": "object",
+            "properties": {
+                "sequence": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 42
+                },
+                "entity_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "application"
+                },
+                "change_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "updated"
+                },
+                "entity_id": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "8abb6f3a-2d5e-4ee1-a4a6-2c7d1f0e5b11"
+                },
+                "related_entity_id": {
+                    "type": "string",
+                    "x-order": "4"
+                },
+                "change_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T23:59:00Z"
+                }
+            }
+        },
+        "responses.CompanyDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "company_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "employer"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "last_contact": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.CompanyDuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "company": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.CompanyDTO"
+                        }
+                    ],
+                    "x-order": "0"
+                },
+                "duplicate": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.CompanyDTO"
+                        }
+                    ],
+                    "x-order": "1"
+                },
+                "score": {
+                    "type": "number",
+                    "x-order": "2",
+                    "example": 0.92
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "3",
+                    "example": [
+                        "name"
+                    ]
+                }
+            }
+        },
+        "responses.CompanyEventResponse": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.CompanyPersonResponse": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.CompanyResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "company_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "employer"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "last_contact": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "applications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.ApplicationDTO"
+                    },
+                    "x-order": "7"
+                },
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PersonDTO"
+                    },
+                    "x-order": "8"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventDTO"
+                    },
+                    "x-order": "9"
+                }
+            }
+        },
+        "responses.EventDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "interviewCompleted"
+                },
+                "timezone": {
+                    "type": "string",
+                    "x-order": "10",
+                    "example": "Europe/Stockholm"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "12",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Event Description"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "event_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "x-order": "5",
+                    "example": 45
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2026-01-01T00:44Z"
+                },
+                "location": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "Drottninggatan 1, Stockholm"
+                },
+                "meeting_url": {
+                    "type": "string",
+                    "x-order": "8",
+                    "example": "https://meet.example.com/abc-defg-hij"
+                },
+                "interview_format": {
+                    "type": "string",
+                    "x-order": "9",
+                    "example": "video"
+                }
+            }
+        },
+        "responses.EventPersonResponse": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "person_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.EventResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "interviewCompleted"
+                },
+                "timezone": {
+                    "type": "string",
+                    "x-order": "10",
+                    "example": "Europe/Stockholm"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "12",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "applications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.ApplicationDTO"
+                    },
+                    "x-order": "13"
+                },
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CompanyDTO"
+                    },
+                    "x-order": "14"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.PersonDTO"
+                    },
+                    "x-order": "15"
+                },
+                "overlapping_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventDTO"
+                    },
+                    "x-order": "16"
+                },
+                "description": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Event Description"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "event_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "duration_minutes": {
+                    "type": "integer",
+                    "x-order": "5",
+                    "example": 45
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2026-01-01T00:44Z"
+                },
+                "location": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "Drottninggatan 1, Stockholm"
+                },
+                "meeting_url": {
+                    "type": "string",
+                    "x-order": "8",
+                    "example": "https://meet.example.com/abc-defg-hij"
+                },
+                "interview_format": {
+                    "type": "string",
+                    "x-order": "9",
+                    "example": "video"
+                }
+            }
+        },
+        "responses.InterviewPrepResponse": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "technical_topics": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Go concurrency, SQL indexes"
+                },
+                "self_rating": {
+                    "type": "integer",
+                    "x-order": "2",
+                    "example": 4
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Notes go here"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.InterviewQuestionResponse"
+                    },
+                    "x-order": "4"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.InterviewQuestionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "event_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "1",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "question_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "askedToUs"
+                },
+                "question": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "How would you design a rate limiter?"
+                },
+                "answer": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "A token bucket per client"
+                },
+                "topic": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "System design"
+                },
+                "event_type": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "interviewCompleted"
+                },
+                "event_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "8",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "9",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.JobAdPostingResponse": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/requests.CreateApplicationRequest"
+                        }
+                    ],
+                    "x-order": "0"
+                },
+                "company_name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "Company Name"
+                },
+                "salary": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "45000-55000 SEK per month"
+                }
+            }
+        },
+        "responses.JobAdSnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "00",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "application_id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "01",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "source_type": {
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "jsonLd"
+                    ],
+                    "x-order": "02",
+                    "example": "html"
+                },
+                "job_title": {
+                    "type": "string",
+                    "x-order": "03",
+                    "example": "Job Title"
+                },
+                "company_name": {
+                    "type": "string",
+                    "x-order": "04",
+                    "example": "Company Name"
+                },
+                "job_ad_url": {
+                    "type": "string",
+                    "x-order": "05",
+                    "example": "https://job.ad.url"
+                },
+                "country": {
+                    "type": "string",
+                    "x-order": "06",
+                    "example": "Sweden"
+                },
+                "area": {
+                    "type": "string",
+                    "x-order": "07",
+                    "example": "Stockholm"
+                },
+                "remote_status_type": {
+                    "type": "string",
+                    "x-order": "08",
+                    "example": "hybrid"
+                },
+                "salary": {
+                    "type": "string",
+                    "x-order": "09",
+                    "example": "45000-55000 SEK per month"
+                },
+                "content": {
+                    "type": "string",
+                    "x-order": "10",
+                    "example": "\u003chtml\u003e...\u003c/html\u003e"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "11",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.LinkDetailsDTO": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Hiring manager"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "Introduced by a former colleague"
+                },
+                "start_date": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "end_date": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "2025-12-31T00:00:00Z"
+                }
+            }
+        },
+        "responses.MigrationResultResponse": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 9
+                },
+                "dirty": {
+                    "type": "boolean",
+                    "x-order": "1",
+                    "example": false
+                },
+                "latest_version": {
+                    "type": "integer",
+                    "x-order": "2",
+                    "example": 9
+                },
+                "applied_versions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-order": "3"
+                },
+                "pending_versions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-order": "4"
+                },
+                "backup_file": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "resources/database/backups/20251231T235900.000Z_jobSearchDatabase.sqlite"
+                }
+            }
+        },
+        "responses.MigrationStatusResponse": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "x-order": "0",
+                    "example": 9
+                },
+                "dirty": {
+                    "type": "boolean",
+                    "x-order": "1",
+                    "example": false
+                },
+                "latest_version": {
+                    "type": "integer",
+                    "x-order": "2",
+                    "example": 9
+                },
+                "applied_versions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-order": "3"
+                },
+                "pending_versions": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "x-order": "4"
+                }
+            }
+        },
+        "responses.PersonDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "person_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "internalRecruiter"
+                },
+                "email": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "name@domain.com"
+                },
+                "phone": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "+46123456789"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "Notes go here"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        },
+        "responses.PersonDuplicateResponse": {
+            "type": "object",
+            "properties": {
+                "person": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.PersonDTO"
+                        }
+                    ],
+                    "x-order": "0"
+                },
+                "duplicate": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.PersonDTO"
+                        }
+                    ],
+                    "x-order": "1"
+                },
+                "score": {
+                    "type": "number",
+                    "x-order": "2",
+                    "example": 1
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "x-order": "3",
+                    "example": [
+                        "name",
+                        "email",
+                        "domain"
+                    ]
+                }
+            }
+        },
+        "responses.PersonResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid",
+                    "x-order": "0",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "CompanyName AB"
+                },
+                "applications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.ApplicationDTO"
+                    },
+                    "x-order": "10"
+                },
+                "person_type": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "internalRecruiter"
+                },
+                "email": {
+                    "type": "string",
+                    "x-order": "3",
+                    "example": "name@domain.com"
+                },
+                "phone": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "+46123456789"
+                },
+                "notes": {
+                    "type": "string",
+                    "x-order": "5",
+                    "example": "Notes go here"
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CompanyDTO"
+                    },
+                    "x-order": "8"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.EventDTO"
+                    },
+                    "x-order": "9"
+                }
+            }
+        },
+        "responses.TimelineEntryResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "entry_type": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "event"
+                },
+                "summary": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "interviewBooked: Technical interview, with Jane Doe"
+                },
+                "application": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.ApplicationDTO"
+                        }
+                    ],
+                    "x-order": "3"
+                },
+                "company": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.CompanyDTO"
+                        }
+                    ],
+                    "x-order": "4"
+                },
+                "event": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.EventResponse"
+                        }
+                    ],
+                    "x-order": "5"
+                },
+                "person": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.PersonDTO"
+                        }
+                    ],
+                    "x-order": "6"
+                },
+                "link_details": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/responses.LinkDetailsDTO"
+                        }
+                    ],
+                    "x-order": "7"
+                }
+            }
+        },
+        "responses.TimelineResponse": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "Backend developer"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.TimelineEntryResponse"
+                    },
+                    "x-order": "1"
+                }
+            }
+        },
+        "responses.TypeDefinitionResponse": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string",
+                    "x-order": "0",
+                    "example": "event"
+                },
+                "name": {
+                    "type": "string",
+                    "x-order": "1",
+                    "example": "technicalScreen"
+                },
+                "display_name": {
+                    "type": "string",
+                    "x-order": "2",
+                    "example": "Technical screen"
+                },
+                "sort_order": {
+                    "type": "integer",
+                    "x-order": "3",
+                    "example": 65
+                },
+                "pipeline_stage": {
+                    "type": "string",
+                    "x-order": "4",
+                    "example": "interviewing"
+                },
+                "is_built_in": {
+                    "type": "boolean",
+                    "x-order": "5",
+                    "example": false
+                },
+                "created_date": {
+                    "type": "string",
+                    "x-order": "6",
+                    "example": "2025-12-31T23:59Z"
+                },
+                "updated_date": {
+                    "type": "string",
+                    "x-order": "7",
+                    "example": "2025-12-31T23:59Z"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "",
+	BasePath:         "/api",
+	Schemes:          []string{},
+	Title:            "JobSearchTracker API",
+	Description:      "Job application tracking API",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
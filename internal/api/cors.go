@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+var corsAllowedMethods = strings.Join(
+	[]string{http.MethodGet, http.MethodPost, http.MethodDelete, http.MethodOptions}, ", ")
+
+// newCORSHandler adds CORS headers to responses for requests from allowedOrigins, and answers preflight requests.
+// Requests from other origins are passed on unchanged, leaving the browser to block them.
+func newCORSHandler(allowedOrigins []string, next http.Handler) http.Handler {
+	if len(allowedOrigins) == 0 {
+		return next
+	}
+
+	allowAnyOrigin := slices.Contains(allowedOrigins, "*")
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		origin := request.Header.Get("Origin")
+		if origin == "" || (!allowAnyOrigin && !slices.Contains(allowedOrigins, origin)) {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		header := writer.Header()
+		header.Add("Vary", "Origin")
+		if allowAnyOrigin {
+			header.Set("Access-Control-Allow-Origin", "*")
+		} else {
+			header.Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if request.Method == http.MethodOptions && request.Header.Get("Access-Control-Request-Method") != "" {
+			header.Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			if requestHeaders := request.Header.Get("Access-Control-Request-Headers"); requestHeaders != "" {
+				header.Set("Access-Control-Allow-Headers", requestHeaders)
+			}
+			header.Set("Access-Control-Max-Age", "600")
+			writer.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		// The ETag is needed to send If-Match when updating or deleting. Idempotent-Replayed tells a retry that was
+		// answered with the saved response from one that was handled.
+		header.Set("Access-Control-Expose-Headers", "ETag, "+idempotentReplayedHeader)
+		next.ServeHTTP(writer, request)
+	})
+}
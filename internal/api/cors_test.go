@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var okHandler = http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+	writer.WriteHeader(http.StatusOK)
+})
+
+func TestNewCORSHandler_ShouldAddHeadersForAllowedOrigin(t *testing.T) {
+	handler := newCORSHandler([]string{"https://allowed.example"}, okHandler)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/all", nil)
+	request.Header.Set("Origin", "https://allowed.example")
+	responseRecorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "https://allowed.example", responseRecorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(
+		t, "ETag, Idempotent-Replayed", responseRecorder.Header().Get("Access-Control-Expose-Headers"))
+}
+
+func TestNewCORSHandler_ShouldNotAddHeadersForOtherOrigin(t *testing.T) {
+	handler := newCORSHandler([]string{"https://allowed.example"}, okHandler)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/all", nil)
+	request.Header.Set("Origin", "https://other.example")
+	responseRecorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Empty(t, responseRecorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestNewCORSHandler_ShouldAnswerPreflightRequest(t *testing.T) {
+	handler := newCORSHandler([]string{"*"}, okHandler)
+
+	request := httptest.NewRequest(http.MethodOptions, "/api/v1/company/new", nil)
+	request.Header.Set("Origin", "https://any.example")
+	request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	request.Header.Set("Access-Control-Request-Headers", "Content-Type")
+	responseRecorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(responseRecorder, request)
+	assert.Equal(t, http.StatusNoContent, responseRecorder.Code)
+	assert.Equal(t, "*", responseRecorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Content-Type", responseRecorder.Header().Get("Access-Control-Allow-Headers"))
+	assert.Contains(t, responseRecorder.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+}
@@ -0,0 +1,122 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"net/http"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotentRequestSize is the largest body that is read to check an Idempotency-Key. It is the size of the
+	// largest job ad snapshot, which no other request body comes near.
+	maxIdempotentRequestSize = 5 << 20
+)
+
+// newIdempotencyHandler handles a request with an Idempotency-Key header only once per key and path, and answers a
+// retry with the response to the first request, marked with an Idempotent-Replayed header. Requests without the
+// header are passed on unchanged.
+func newIdempotencyHandler(idempotencyService *services.IdempotencyService, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		key, hasKey := request.Header[idempotencyKeyHeader]
+		if !hasKey {
+			next.ServeHTTP(writer, request)
+			return
+		}
+
+		logger := logging.FromContext(request.Context())
+
+		requestBody, err := io.ReadAll(http.MaxBytesReader(writer, request.Body, maxIdempotentRequestSize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				logger.Info("api.newIdempotencyHandler: Request body is too large", "limit", maxBytesErr.Limit)
+				http.Error(writer, "Request body is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			logger.Info("api.newIdempotencyHandler: Unable to read request body", "error", err)
+			http.Error(writer, "Unable to read request body", http.StatusBadRequest)
+			return
+		}
+		request.Body = io.NopCloser(bytes.NewReader(requestBody))
+
+		// can return ConflictError, InternalServiceError, ValidationError
+		idempotencyKey, isReplay, err := idempotencyService.Begin(
+			request.Context(), key[0], request.Method, request.URL.Path, request.URL.RawQuery, requestBody)
+		if err != nil {
+			var validationErr *internalErrors.ValidationError
+			var conflictErr *internalErrors.ConflictError
+			if errors.As(err, &validationErr) {
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+			} else if errors.As(err, &conflictErr) {
+				http.Error(writer, err.Error(), http.StatusConflict)
+			} else {
+				errorMessage := "Internal service error while checking Idempotency-Key"
+				logger.Error("api.newIdempotencyHandler: "+errorMessage, "error", err)
+				http.Error(writer, errorMessage, http.StatusInternalServerError)
+			}
+			return
+		}
+
+		if isReplay {
+			if idempotencyKey.ContentType != nil {
+				writer.Header().Set("Content-Type", *idempotencyKey.ContentType)
+			}
+			writer.Header().Set(idempotentReplayedHeader, "true")
+			writer.WriteHeader(*idempotencyKey.StatusCode)
+			if _, err := writer.Write(idempotencyKey.ResponseBody); err != nil {
+				logger.Info("api.newIdempotencyHandler: Unable to write replayed response", "error", err)
+			}
+			return
+		}
+
+		responseRecorder := &responseRecordingResponseWriter{
+			statusRecordingResponseWriter: statusRecordingResponseWriter{ResponseWriter: writer, status: http.StatusOK},
+		}
+
+		// A key is released if next panics, so that the request can be retried at once instead of after the lease.
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				// can return InternalServiceError, NotFoundError
+				err := idempotencyService.Release(context.WithoutCancel(request.Context()), idempotencyKey)
+				if err != nil {
+					logger.Error("api.newIdempotencyHandler: Unable to release Idempotency-Key", "error", err)
+				}
+				panic(recovered)
+			}
+		}()
+
+		next.ServeHTTP(responseRecorder, request)
+
+		idempotencyKey.StatusCode = &responseRecorder.status
+		if contentType := writer.Header().Get("Content-Type"); contentType != "" {
+			idempotencyKey.ContentType = &contentType
+		}
+		idempotencyKey.ResponseBody = responseRecorder.body.Bytes()
+
+		// The response is saved even if the client has gone, as the client is the one most likely to retry.
+		// can return InternalServiceError, NotFoundError, ValidationError
+		err = idempotencyService.SaveResponse(context.WithoutCancel(request.Context()), idempotencyKey)
+		if err != nil {
+			logger.Error("api.newIdempotencyHandler: Unable to save response", "error", err)
+		}
+	})
+}
+
+// responseRecordingResponseWriter keeps a copy of the status code and body written by a handler.
+type responseRecordingResponseWriter struct {
+	statusRecordingResponseWriter
+	body bytes.Buffer
+}
+
+func (writer *responseRecordingResponseWriter) Write(data []byte) (int, error) {
+	writer.body.Write(data)
+	return writer.statusRecordingResponseWriter.Write(data)
+}
@@ -0,0 +1,190 @@
+package api
+
+import (
+	"io"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIdempotencyService(t *testing.T) *services.IdempotencyService {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		IdempotencyKeyTTLHours:               1,
+		IdempotencyKeyLeaseSeconds:           60,
+	}
+	container := dependencyinjection.SetupIdempotencyServiceTestContainer(t, config)
+
+	var idempotencyService *services.IdempotencyService
+	err := container.Invoke(func(service *services.IdempotencyService) {
+		idempotencyService = service
+	})
+	assert.NoError(t, err)
+
+	return idempotencyService
+}
+
+// setupIdempotencyHandler wraps a handler that answers with the number of times it has been called and the request
+// body, and with the given status codes, in turn.
+func setupIdempotencyHandler(t *testing.T, statusCodes ...int) (http.Handler, *int) {
+	idempotencyService := setupIdempotencyService(t)
+
+	calls := 0
+	next := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestBody, err := io.ReadAll(request.Body)
+		assert.NoError(t, err)
+
+		statusCode := http.StatusCreated
+		if calls < len(statusCodes) {
+			statusCode = statusCodes[calls]
+		}
+		calls++
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(statusCode)
+		_, err = writer.Write([]byte(`{"call":` + strconv.Itoa(calls) + `,"request":` + string(requestBody) + `}`))
+		assert.NoError(t, err)
+	})
+
+	return newIdempotencyHandler(idempotencyService, next), &calls
+}
+
+func sendIdempotentRequest(handler http.Handler, key string, body string) *httptest.ResponseRecorder {
+	return sendIdempotentRequestTo(handler, "/api/v1/company/new", key, body)
+}
+
+func sendIdempotentRequestTo(
+	handler http.Handler, target string, key string, body string) *httptest.ResponseRecorder {
+
+	request := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	if key != "" {
+		request.Header.Set("Idempotency-Key", key)
+	}
+	responseRecorder := httptest.NewRecorder()
+	handler.ServeHTTP(responseRecorder, request)
+	return responseRecorder
+}
+
+func TestNewIdempotencyHandler_ShouldReplayResponseForSameKey(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	firstResponse := sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusCreated, firstResponse.Code)
+	assert.Equal(t, `{"call":1,"request":{"name":"Company"}}`, firstResponse.Body.String())
+	assert.Empty(t, firstResponse.Header().Get("Idempotent-Replayed"))
+
+	retryResponse := sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusCreated, retryResponse.Code)
+	assert.Equal(t, `{"call":1,"request":{"name":"Company"}}`, retryResponse.Body.String())
+	assert.Equal(t, "application/json", retryResponse.Header().Get("Content-Type"))
+	assert.Equal(t, "true", retryResponse.Header().Get("Idempotent-Replayed"))
+
+	assert.Equal(t, 1, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldHandleRequestsWithoutKeyEveryTime(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	sendIdempotentRequest(handler, "", `{"name":"Company"}`)
+	response := sendIdempotentRequest(handler, "", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusCreated, response.Code)
+	assert.Equal(t, `{"call":2,"request":{"name":"Company"}}`, response.Body.String())
+
+	assert.Equal(t, 2, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldHandleRetryAfterServerError(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t, http.StatusInternalServerError)
+
+	firstResponse := sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusInternalServerError, firstResponse.Code)
+
+	retryResponse := sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusCreated, retryResponse.Code)
+	assert.Equal(t, `{"call":2,"request":{"name":"Company"}}`, retryResponse.Body.String())
+	assert.Empty(t, retryResponse.Header().Get("Idempotent-Replayed"))
+
+	assert.Equal(t, 2, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldReleaseKeyIfHandlerPanics(t *testing.T) {
+	idempotencyService := setupIdempotencyService(t)
+
+	calls := 0
+	handler := newIdempotencyHandler(idempotencyService, http.HandlerFunc(
+		func(writer http.ResponseWriter, request *http.Request) {
+			calls++
+			if calls == 1 {
+				panic("handler failed")
+			}
+			writer.WriteHeader(http.StatusCreated)
+		}))
+
+	assert.PanicsWithValue(t, "handler failed", func() {
+		sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	})
+
+	retryResponse := sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusCreated, retryResponse.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestNewIdempotencyHandler_ShouldReplayClientError(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t, http.StatusBadRequest)
+
+	sendIdempotentRequest(handler, "key-1", `{"name":""}`)
+	retryResponse := sendIdempotentRequest(handler, "key-1", `{"name":""}`)
+	assert.Equal(t, http.StatusBadRequest, retryResponse.Code)
+	assert.Equal(t, "true", retryResponse.Header().Get("Idempotent-Replayed"))
+
+	assert.Equal(t, 1, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldRejectKeyReusedForDifferentRequest(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	sendIdempotentRequest(handler, "key-1", `{"name":"Company"}`)
+	response := sendIdempotentRequest(handler, "key-1", `{"name":"Other company"}`)
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Contains(t, response.Body.String(), "Idempotency-Key has already been used for a different request")
+
+	assert.Equal(t, 1, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldRejectKeyReusedWithDifferentQuery(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	sendIdempotentRequestTo(handler, "/api/v1/company/new", "key-1", `{"name":"Company"}`)
+	response := sendIdempotentRequestTo(handler, "/api/v1/company/new?force=true", "key-1", `{"name":"Company"}`)
+	assert.Equal(t, http.StatusConflict, response.Code)
+	assert.Contains(t, response.Body.String(), "Idempotency-Key has already been used for a different request")
+
+	assert.Equal(t, 1, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldReturnRequestEntityTooLargeForLargeBody(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	response := sendIdempotentRequest(handler, "key-1", strings.Repeat("a", maxIdempotentRequestSize+1))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+
+	assert.Equal(t, 0, *calls)
+}
+
+func TestNewIdempotencyHandler_ShouldReturnBadRequestForInvalidKey(t *testing.T) {
+	handler, calls := setupIdempotencyHandler(t)
+
+	response := sendIdempotentRequest(handler, strings.Repeat("a", 256), `{"name":"Company"}`)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.Contains(t, response.Body.String(), "Idempotency-Key cannot be longer than 255 characters")
+
+	assert.Equal(t, 0, *calls)
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/metrics"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+
+	// unmatchedRoute labels the metrics of requests that no route matched, so that arbitrary paths do not each
+	// create a new series.
+	unmatchedRoute = "unmatched"
+)
+
+// newRequestMiddleware assigns each request an ID, taken from the X-Request-ID header if the client sent a usable
+// one, and returns it in the response. It stores a logger carrying the ID in the request context, logs the method,
+// path, status and latency of every request, and records them in metrics.
+func newRequestMiddleware(
+	router *mux.Router, metrics *metrics.Metrics, logger *slog.Logger, next http.Handler) http.Handler {
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		start := time.Now()
+
+		requestID := request.Header.Get(requestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		writer.Header().Set(requestIDHeader, requestID)
+
+		requestLogger := logger.With("requestId", requestID)
+		ctx := logging.WithLogger(logging.WithRequestID(request.Context(), requestID), requestLogger)
+		request = request.WithContext(ctx)
+
+		route := unmatchedRoute
+		var routeMatch mux.RouteMatch
+		if router.Match(request, &routeMatch) && routeMatch.Route != nil {
+			if pathTemplate, err := routeMatch.Route.GetPathTemplate(); err == nil {
+				route = pathTemplate
+			}
+		}
+
+		statusRecorder := &statusRecordingResponseWriter{ResponseWriter: writer, status: http.StatusOK}
+		next.ServeHTTP(statusRecorder, request)
+
+		duration := time.Since(start)
+		metrics.ObserveHTTPRequest(request.Method, route, statusRecorder.status, duration)
+
+		level := slog.LevelInfo
+		if statusRecorder.status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		requestLogger.Log(ctx, level, "Handled request",
+			"method", request.Method,
+			"path", request.URL.Path,
+			"route", route,
+			"status", statusRecorder.status,
+			"durationMs", float64(duration.Microseconds())/1000)
+	})
+}
+
+// isValidRequestID accepts non-empty IDs of printable ASCII characters, so that a client cannot inject arbitrary
+// content into logs and response headers.
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for index := 0; index < len(requestID); index++ {
+		if requestID[index] < '!' || requestID[index] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// statusRecordingResponseWriter remembers the status code written by a handler. Handlers that never call
+// WriteHeader respond with 200.
+type statusRecordingResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (writer *statusRecordingResponseWriter) WriteHeader(status int) {
+	if !writer.wroteHeader {
+		writer.status = status
+		writer.wroteHeader = true
+	}
+	writer.ResponseWriter.WriteHeader(status)
+}
+
+func (writer *statusRecordingResponseWriter) Write(bytes []byte) (int, error) {
+	writer.wroteHeader = true
+	return writer.ResponseWriter.Write(bytes)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, for example to flush it.
+func (writer *statusRecordingResponseWriter) Unwrap() http.ResponseWriter {
+	return writer.ResponseWriter
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/metrics"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRequestMiddleware(t *testing.T, handlerFunc http.HandlerFunc) (http.Handler, *metrics.Metrics, *bytes.Buffer) {
+	t.Helper()
+
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/company/get/id/{id}", handlerFunc).Methods(http.MethodGet)
+
+	logOutput := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(logOutput, nil))
+	requestMetrics := metrics.NewMetrics()
+
+	return newRequestMiddleware(router, requestMetrics, logger, router), requestMetrics, logOutput
+}
+
+func TestNewRequestMiddleware_ShouldPropagateRequestID(t *testing.T) {
+	var requestIDInContext string
+	handler, _, logOutput := setupRequestMiddleware(t, func(writer http.ResponseWriter, request *http.Request) {
+		requestIDInContext = logging.RequestIDFromContext(request.Context())
+		logging.FromContext(request.Context()).Info("inside handler")
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/"+uuid.NewString(), nil)
+	request.Header.Set("X-Request-ID", "client-request-1")
+	responseRecorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(responseRecorder, request)
+	assert.Equal(t, "client-request-1", responseRecorder.Header().Get("X-Request-ID"))
+	assert.Equal(t, "client-request-1", requestIDInContext)
+
+	logLines := strings.Split(strings.TrimSpace(logOutput.String()), "\n")
+	assert.Len(t, logLines, 2)
+	for _, logLine := range logLines {
+		assert.Contains(t, logLine, `"requestId":"client-request-1"`)
+	}
+	assert.Contains(t, logLines[1], `"route":"/api/v1/company/get/id/{id}"`)
+	assert.Contains(t, logLines[1], `"status":200`)
+}
+
+func TestNewRequestMiddleware_ShouldGenerateRequestIDIfMissingOrInvalid(t *testing.T) {
+	handler, _, _ := setupRequestMiddleware(t, func(http.ResponseWriter, *http.Request) {})
+
+	tests := []struct {
+		testName  string
+		requestID string
+	}{
+		{"missing", ""},
+		{"contains a newline", "abc\ndef"},
+		{"too long", strings.Repeat("a", 129)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/"+uuid.NewString(), nil)
+			if test.requestID != "" {
+				request.Header.Set("X-Request-ID", test.requestID)
+			}
+			responseRecorder := httptest.NewRecorder()
+
+			handler.ServeHTTP(responseRecorder, request)
+
+			_, err := uuid.Parse(responseRecorder.Header().Get("X-Request-ID"))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewRequestMiddleware_ShouldRecordMetricsByRouteTemplate(t *testing.T) {
+	handler, requestMetrics, _ := setupRequestMiddleware(t, func(writer http.ResponseWriter, _ *http.Request) {
+		http.Error(writer, "not found", http.StatusNotFound)
+	})
+
+	for range 2 {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/"+uuid.NewString(), nil)
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	}
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/no/such/path", nil))
+
+	var output bytes.Buffer
+	_, err := requestMetrics.WriteTo(&output)
+	assert.NoError(t, err)
+
+	assert.Contains(t, output.String(),
+		`http_requests_total{method="GET",route="/api/v1/company/get/id/{id}",status="404"} 2`)
+	assert.Contains(t, output.String(), `http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, output.String(),
+		`http_request_duration_seconds_count{method="GET",route="/api/v1/company/get/id/{id}"} 2`)
+}
@@ -1,8 +1,12 @@
 package api
 
 import (
+	"context"
 	"database/sql"
 	apiV1 "jobsearchtracker/internal/api/v1/handlers"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/metrics"
+	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/repositories"
 	"jobsearchtracker/internal/services"
 	"log/slog"
@@ -16,109 +20,266 @@ import (
 )
 
 type Server struct {
-	router *mux.Router
-	logger *slog.Logger
+	router        *mux.Router
+	handler       http.Handler
+	logger        *slog.Logger
+	changeService *services.ChangeService
 }
 
-func NewServer(database *sql.DB, logger *slog.Logger) *Server {
+func NewServer(
+	database *sql.DB, config *configPackage.Config, logger *slog.Logger, metrics *metrics.Metrics) *Server {
+
 	slog.SetDefault(logger)
 
-	applicationRepository := repositories.NewApplicationRepository(database)
+	applicationRepository := repositories.NewApplicationRepository(database, config.DatabaseQueryTimeout())
 	applicationService := services.NewApplicationService(applicationRepository)
+	if _, err := applicationService.NormalizeJobAdURLs(context.Background()); err != nil {
+		slog.Error("Unable to normalize the job ad URLs of existing applications", "error", err)
+	}
 	applicationHandler := apiV1.NewApplicationHandler(applicationService)
 
-	applicationEventRepository := repositories.NewApplicationEventRepository(database)
-	applicationEventService := services.NewApplicationEventService(applicationEventRepository)
+	applicationEventRepository := repositories.NewApplicationEventRepository(database, config.DatabaseQueryTimeout())
+	eventRepository := repositories.NewEventRepository(database, config.DatabaseQueryTimeout())
+	eventTransitionValidator := services.NewEventTransitionValidator(
+		eventRepository,
+		applicationEventRepository,
+		&models.DefaultEventTransitionRules,
+		models.EventTransitionMode(config.EventTransitionMode))
+
+	applicationEventService := services.NewApplicationEventService(applicationEventRepository, eventTransitionValidator)
 	applicationEventHandler := apiV1.NewApplicationEventHandler(applicationEventService)
 
-	applicationPersonRepository := repositories.NewApplicationPersonRepository(database)
+	applicationPersonRepository := repositories.NewApplicationPersonRepository(database, config.DatabaseQueryTimeout())
 	applicationPersonService := services.NewApplicationPersonService(applicationPersonRepository)
 	applicationPersonHandler := apiV1.NewApplicationPersonHandler(applicationPersonService)
 
-	companyRepository := repositories.NewCompanyRepository(database)
+	companyRepository := repositories.NewCompanyRepository(database, config.DatabaseQueryTimeout())
 	companyService := services.NewCompanyService(companyRepository)
 	companyHandler := apiV1.NewCompanyHandler(companyService)
 
-	companyEventRepository := repositories.NewCompanyEventRepository(database)
+	companyEventRepository := repositories.NewCompanyEventRepository(database, config.DatabaseQueryTimeout())
 	companyEventService := services.NewCompanyEventService(companyEventRepository)
 	companyEventHandler := apiV1.NewCompanyEventHandler(companyEventService)
 
-	companyPersonRepository := repositories.NewCompanyPersonRepository(database)
+	companyPersonRepository := repositories.NewCompanyPersonRepository(database, config.DatabaseQueryTimeout())
 	companyPersonService := services.NewCompanyPersonService(companyPersonRepository)
 	companyPersonHandler := apiV1.NewCompanyPersonHandler(companyPersonService)
 
-	eventRepository := repositories.NewEventRepository(database)
-	eventService := services.NewEventService(eventRepository)
+	eventService := services.NewEventService(eventRepository, eventTransitionValidator)
 	eventHandler := apiV1.NewEventHandler(eventService)
 
-	eventPersonRepository := repositories.NewEventPersonRepository(database)
+	agendaService := services.NewAgendaService(eventRepository)
+	agendaHandler := apiV1.NewAgendaHandler(agendaService)
+
+	eventPersonRepository := repositories.NewEventPersonRepository(database, config.DatabaseQueryTimeout())
 	eventPersonService := services.NewEventPersonService(eventPersonRepository)
 	eventPersonHandler := apiV1.NewEventPersonHandler(eventPersonService)
 
-	personRepository := repositories.NewPersonRepository(database)
+	interviewPrepRepository := repositories.NewInterviewPrepRepository(database, config.DatabaseQueryTimeout())
+	interviewQuestionRepository := repositories.NewInterviewQuestionRepository(database, config.DatabaseQueryTimeout())
+	interviewPrepService := services.NewInterviewPrepService(
+		eventRepository, interviewPrepRepository, interviewQuestionRepository)
+	interviewPrepHandler := apiV1.NewInterviewPrepHandler(interviewPrepService)
+
+	jobAdSnapshotRepository := repositories.NewJobAdSnapshotRepository(database, config.DatabaseQueryTimeout())
+	jobAdSnapshotService := services.NewJobAdSnapshotService(jobAdSnapshotRepository)
+	jobAdSnapshotHandler := apiV1.NewJobAdSnapshotHandler(jobAdSnapshotService)
+
+	personRepository := repositories.NewPersonRepository(database, config.DatabaseQueryTimeout())
 	personService := services.NewPersonService(personRepository)
 	personHandler := apiV1.NewPersonHandler(personService)
+
+	typeDefinitionRepository := repositories.NewTypeDefinitionRepository(database, config.DatabaseQueryTimeout())
+	typeDefinitionService := services.NewTypeDefinitionService(typeDefinitionRepository)
+	typeDefinitionHandler := apiV1.NewTypeDefinitionHandler(typeDefinitionService)
+	if err := typeDefinitionService.LoadKnownTypes(context.Background()); err != nil {
+		slog.Error("Unable to load the event, person and company types. Using the built-in types.", "error", err)
+	}
+
+	unitOfWork := services.NewUnitOfWork(database, config)
+	logApplicationService := services.NewLogApplicationService(unitOfWork, eventTransitionValidator)
+	logApplicationHandler := apiV1.NewLogApplicationHandler(logApplicationService)
+
+	duplicateService := services.NewDuplicateService(companyRepository, personRepository, unitOfWork)
+	duplicateHandler := apiV1.NewDuplicateHandler(duplicateService)
+
+	bulkLinkService := services.NewBulkLinkService(unitOfWork, eventTransitionValidator)
+	bulkLinkHandler := apiV1.NewBulkLinkHandler(bulkLinkService)
+
+	timelineService := services.NewTimelineService(
+		applicationRepository,
+		companyRepository,
+		eventRepository,
+		personRepository,
+		applicationPersonRepository,
+		companyPersonRepository)
+	timelineHandler := apiV1.NewTimelineHandler(timelineService)
+
+	changeRepository := repositories.NewChangeRepository(database, config.DatabaseQueryTimeout())
+	changeService := services.NewChangeService(
+		changeRepository, config.ChangeStreamPollInterval(), config.ChangeLogRetention())
+	changeService.StartPruning()
+	changeHandler := apiV1.NewChangeHandler(changeService)
+
+	idempotencyKeyRepository := repositories.NewIdempotencyKeyRepository(database, config.DatabaseQueryTimeout())
+	idempotencyService := services.NewIdempotencyService(
+		idempotencyKeyRepository, config.IdempotencyKeyTTL(), config.IdempotencyKeyLease())
+
+	// idempotent lets a client retry a create or associate request with the same Idempotency-Key without creating a
+	// second record.
+	idempotent := func(handlerFunc http.HandlerFunc) http.Handler {
+		return newIdempotencyHandler(idempotencyService, handlerFunc)
+	}
+
+	migrationService := services.NewMigrationService(database, config, typeDefinitionService)
+	migrationHandler := apiV1.NewMigrationHandler(migrationService)
+
+	healthService := services.NewHealthService(database, migrationService)
+	healthHandler := apiV1.NewHealthHandler(healthService)
+
 	router := mux.NewRouter()
 
-	router.HandleFunc("/api/v1/application/new", applicationHandler.CreateApplication).Methods(http.MethodPost)
+	router.HandleFunc("/healthz", healthHandler.Liveness).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", healthHandler.Readiness).Methods(http.MethodGet)
+	router.Handle("/metrics", metrics.Handler()).Methods(http.MethodGet)
+
+	router.Handle("/api/v1/application/new", idempotent(applicationHandler.CreateApplication)).Methods(http.MethodPost)
+	router.Handle("/api/v1/application/log", idempotent(logApplicationHandler.LogApplication)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application/get/id/{id}", applicationHandler.GetApplicationByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/application/get/title/{title}", applicationHandler.GetApplicationsByJobTitle).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/application/get/all", applicationHandler.GetAllApplications).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/application/get/ids", applicationHandler.GetApplicationsByIDs).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/application/{id}/timeline", timelineHandler.GetApplicationTimeline).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/application/update", applicationHandler.UpdateApplication).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application/delete/{id}", applicationHandler.DeleteApplication).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/application-event/associate", applicationEventHandler.AssociateApplicationEvent).Methods(http.MethodPost)
+	router.Handle("/api/v1/application-event/associate", idempotent(applicationEventHandler.AssociateApplicationEvent)).Methods(http.MethodPost)
+	router.Handle("/api/v1/application-event/associate/bulk", idempotent(bulkLinkHandler.BulkAssociateApplicationEvents)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application-event/get", applicationEventHandler.GetApplicationEventsByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/application-event/get/all", applicationEventHandler.GetAllApplicationEvents).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/application-event/update", applicationEventHandler.UpdateApplicationEvent).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application-event/delete", applicationEventHandler.DeleteApplicationEvent).Methods(http.MethodDelete)
+	router.HandleFunc("/api/v1/application-event/delete/bulk", bulkLinkHandler.BulkDeleteApplicationEvents).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/application-person/associate", applicationPersonHandler.AssociateApplicationPerson).Methods(http.MethodPost)
+	router.Handle("/api/v1/application-person/associate", idempotent(applicationPersonHandler.AssociateApplicationPerson)).Methods(http.MethodPost)
+	router.Handle("/api/v1/application-person/associate/bulk", idempotent(bulkLinkHandler.BulkAssociateApplicationPersons)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application-person/get", applicationPersonHandler.GetApplicationPersonsByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/application-person/get/all", applicationPersonHandler.GetAllApplicationPersons).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/application-person/update", applicationPersonHandler.UpdateApplicationPerson).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/application-person/delete", applicationPersonHandler.DeleteApplicationPerson).Methods(http.MethodDelete)
+	router.HandleFunc("/api/v1/application-person/delete/bulk", bulkLinkHandler.BulkDeleteApplicationPersons).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/company/new", companyHandler.CreateCompany).Methods(http.MethodPost)
+	router.Handle("/api/v1/company/new", idempotent(companyHandler.CreateCompany)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company/get/id/{id}", companyHandler.GetCompanyById).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/company/get/name/{name}", companyHandler.GetCompaniesByName).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/company/get/all", companyHandler.GetAllCompanies).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/company/get/ids", companyHandler.GetCompaniesByIDs).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/company/{id}/timeline", timelineHandler.GetCompanyTimeline).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/company/duplicates", duplicateHandler.GetDuplicateCompanies).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/company/merge", duplicateHandler.MergeCompanies).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company/update", companyHandler.UpdateCompany).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company/delete/{id}", companyHandler.DeleteCompany).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/company-event/associate", companyEventHandler.AssociateCompanyEvent).Methods(http.MethodPost)
+	router.Handle("/api/v1/company-event/associate", idempotent(companyEventHandler.AssociateCompanyEvent)).Methods(http.MethodPost)
+	router.Handle("/api/v1/company-event/associate/bulk", idempotent(bulkLinkHandler.BulkAssociateCompanyEvents)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company-event/get/id", companyEventHandler.GetCompanyEventsByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/company-event/get/all", companyEventHandler.GetAllCompanyEvents).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/company-event/update", companyEventHandler.UpdateCompanyEvent).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company-event/delete", companyEventHandler.DeleteCompanyEvent).Methods(http.MethodDelete)
+	router.HandleFunc("/api/v1/company-event/delete/bulk", bulkLinkHandler.BulkDeleteCompanyEvents).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/company-person/associate", companyPersonHandler.AssociateCompanyPerson).Methods(http.MethodPost)
+	router.Handle("/api/v1/company-person/associate", idempotent(companyPersonHandler.AssociateCompanyPerson)).Methods(http.MethodPost)
+	router.Handle("/api/v1/company-person/associate/bulk", idempotent(bulkLinkHandler.BulkAssociateCompanyPersons)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company-person/get/id", companyPersonHandler.GetCompanyPersonsByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/company-person/get/all", companyPersonHandler.GetAllCompanyPersons).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/company-person/update", companyPersonHandler.UpdateCompanyPerson).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/company-person/delete", companyPersonHandler.DeleteCompanyPerson).Methods(http.MethodDelete)
+	router.HandleFunc("/api/v1/company-person/delete/bulk", bulkLinkHandler.BulkDeleteCompanyPersons).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/event/new", eventHandler.CreateEvent).Methods(http.MethodPost)
+	router.Handle("/api/v1/event/new", idempotent(eventHandler.CreateEvent)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/event/get/id/{id}", eventHandler.GetEventByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/event/get/all", eventHandler.GetAllEvents).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/event/get/ids", eventHandler.GetEventsByIDs).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/event/update", eventHandler.UpdateEvent).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/event/delete/{id}", eventHandler.DeleteEvent).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/event-person/associate", eventPersonHandler.AssociateEventPerson).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/agenda", agendaHandler.GetAgenda).Methods(http.MethodGet)
+
+	router.Handle("/api/v1/event-person/associate", idempotent(eventPersonHandler.AssociateEventPerson)).Methods(http.MethodPost)
+	router.Handle("/api/v1/event-person/associate/bulk", idempotent(bulkLinkHandler.BulkAssociateEventPersons)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/event-person/get", eventPersonHandler.GetEventPersonsByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/event-person/get/all", eventPersonHandler.GetAllEventPersons).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/event-person/update", eventPersonHandler.UpdateEventPerson).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/event-person/delete", eventPersonHandler.DeleteEventPerson).Methods(http.MethodDelete)
+	router.HandleFunc("/api/v1/event-person/delete/bulk", bulkLinkHandler.BulkDeleteEventPersons).Methods(http.MethodDelete)
+
+	router.Handle("/api/v1/interview-prep/new", idempotent(interviewPrepHandler.CreateInterviewPrep)).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/interview-prep/get/id/{id}", interviewPrepHandler.GetInterviewPrepByID).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/interview-prep/update", interviewPrepHandler.UpdateInterviewPrep).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/interview-prep/delete/{id}", interviewPrepHandler.DeleteInterviewPrep).Methods(http.MethodDelete)
 
-	router.HandleFunc("/api/v1/person/new", personHandler.CreatePerson).Methods(http.MethodPost)
+	router.Handle("/api/v1/interview-question/new", idempotent(interviewPrepHandler.CreateInterviewQuestion)).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/interview-question/get", interviewPrepHandler.GetInterviewQuestions).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/interview-question/update", interviewPrepHandler.UpdateInterviewQuestion).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/interview-question/delete/{id}", interviewPrepHandler.DeleteInterviewQuestion).Methods(http.MethodDelete)
+
+	router.HandleFunc("/api/v1/job-ad-snapshot/parse", jobAdSnapshotHandler.ParseJobAd).Methods(http.MethodPost)
+	router.Handle("/api/v1/job-ad-snapshot/new", idempotent(jobAdSnapshotHandler.CreateJobAdSnapshot)).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/job-ad-snapshot/get/id/{id}", jobAdSnapshotHandler.GetJobAdSnapshotByID).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/job-ad-snapshot/get", jobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID).Methods(http.MethodGet)
+
+	router.Handle("/api/v1/person/new", idempotent(personHandler.CreatePerson)).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/person/get/id/{id}", personHandler.GetPersonByID).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/person/get/name/{name}", personHandler.GetPersonsByName).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/person/get/all", personHandler.GetAllPersons).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/person/get/ids", personHandler.GetPersonsByIDs).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/person/{id}/timeline", timelineHandler.GetPersonTimeline).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/person/duplicates", duplicateHandler.GetDuplicatePersons).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/person/merge", duplicateHandler.MergePersons).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/person/update", personHandler.UpdatePerson).Methods(http.MethodPost)
 	router.HandleFunc("/api/v1/person/delete/{id}", personHandler.DeletePerson).Methods(http.MethodDelete)
 
+	router.Handle("/api/v1/type/{kind}/new", idempotent(typeDefinitionHandler.CreateTypeDefinition)).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/type/{kind}/get/all", typeDefinitionHandler.GetAllTypeDefinitions).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/type/{kind}/update", typeDefinitionHandler.UpdateTypeDefinition).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/type/{kind}/delete/{name}", typeDefinitionHandler.DeleteTypeDefinition).Methods(http.MethodDelete)
+
+	router.HandleFunc("/api/v1/changes/stream", changeHandler.StreamChanges).Methods(http.MethodGet)
+
+	addMigrationRoutes(router, migrationHandler, config.EnableDestructiveMigrationEndpoints)
+
 	// Swagger documentation
 	router.PathPrefix("/swagger/").Handler(httpSwagger.WrapHandler)
 
 	slog.Info("Server created. Returning Server.")
-	return &Server{router: router, logger: logger}
+	handler := newRequestMiddleware(router, metrics, logger, newCORSHandler(config.CORSOrigins, router))
+	return &Server{router: router, handler: handler, logger: logger, changeService: changeService}
+}
+
+// addMigrationRoutes adds the endpoints that manage the database migrations. The API has no authentication, so the
+// endpoints that roll back or force the schema version, and can drop data, are only added if
+// enableDestructiveEndpoints is set.
+func addMigrationRoutes(
+	router *mux.Router, migrationHandler *apiV1.MigrationHandler, enableDestructiveEndpoints bool) {
+
+	router.HandleFunc("/api/v1/admin/migrations/status", migrationHandler.GetMigrationStatus).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/admin/migrations/up", migrationHandler.MigrateUp).Methods(http.MethodPost)
+
+	if !enableDestructiveEndpoints {
+		slog.Info("Destructive migration endpoints are disabled. Use the migrate command to roll back migrations.")
+		return
+	}
+	router.HandleFunc("/api/v1/admin/migrations/down", migrationHandler.MigrateDown).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/admin/migrations/goto", migrationHandler.MigrateToVersion).Methods(http.MethodPost)
+	router.HandleFunc("/api/v1/admin/migrations/force", migrationHandler.ForceMigrationVersion).Methods(http.MethodPost)
 }
 
 func (server *Server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	server.router.ServeHTTP(writer, request)
+	server.handler.ServeHTTP(writer, request)
+}
+
+// Close ends the change streams, which would otherwise keep the server from shutting down until its shutdown timeout,
+// and stops the pruning of the change log.
+func (server *Server) Close() {
+	server.changeService.Close()
 }
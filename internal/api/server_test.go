@@ -0,0 +1,42 @@
+package api
+
+import (
+	apiV1 "jobsearchtracker/internal/api/v1/handlers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- addMigrationRoutes tests: --------
+
+func TestAddMigrationRoutes_ShouldOnlyAddDestructiveEndpointsIfEnabled(t *testing.T) {
+	tests := []struct {
+		path                  string
+		method                string
+		isDestructiveEndpoint bool
+	}{
+		{path: "/api/v1/admin/migrations/status", method: http.MethodGet, isDestructiveEndpoint: false},
+		{path: "/api/v1/admin/migrations/up", method: http.MethodPost, isDestructiveEndpoint: false},
+		{path: "/api/v1/admin/migrations/down", method: http.MethodPost, isDestructiveEndpoint: true},
+		{path: "/api/v1/admin/migrations/goto", method: http.MethodPost, isDestructiveEndpoint: true},
+		{path: "/api/v1/admin/migrations/force", method: http.MethodPost, isDestructiveEndpoint: true},
+	}
+
+	for _, enableDestructiveEndpoints := range []bool{false, true} {
+		router := mux.NewRouter()
+		addMigrationRoutes(router, apiV1.NewMigrationHandler(nil), enableDestructiveEndpoints)
+
+		for _, test := range tests {
+			var match mux.RouteMatch
+			isRouted := router.Match(httptest.NewRequest(test.method, test.path, nil), &match)
+			assert.Equal(
+				t,
+				enableDestructiveEndpoints || !test.isDestructiveEndpoint,
+				isRouted,
+				"%s %s with enableDestructiveEndpoints %t", test.method, test.path, enableDestructiveEndpoints)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"net/http"
+	"time"
+)
+
+type AgendaHandler struct {
+	agendaService *services.AgendaService
+}
+
+func NewAgendaHandler(agendaService *services.AgendaService) *AgendaHandler {
+	return &AgendaHandler{agendaService: agendaService}
+}
+
+// GetAgenda retrieves the upcoming booked events, and the booked events that are overdue
+//
+// @Summary Get the agenda
+// @Description Get the booked `event`s (`callBooked`, `interviewBooked` and `recruiterInterviewBooked`) from `from` up to `to` as `upcoming`, soonest first. `from` defaults to now, and without `to` every later booked event is returned. `overdue` lists the booked events whose date has passed without a matching completed event (`callCompleted`, `interviewCompleted` or `recruiterInterviewCompleted`) dated at or after them and linked to one of the same `application`s or `company`s, oldest first. Events include their `application`s, `company`s and `person`s with all fields. Only these built-in types are booked or completed. Event types added through `/api/v1/type/event/` are never included.
+// @Tags event
+// @Produce json
+// @Param from query string false "Start of the period, as RFC 3339" example(2025-12-01T00:00:00Z)
+// @Param to query string false "End of the period, exclusive, as RFC 3339" example(2025-12-31T00:00:00Z)
+// @Param fields query string false "Comma-separated attributes to return, e.g. upcoming. Default all"
+// @Success 200 {object} responses.AgendaResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/agenda [get]
+func (agendaHandler *AgendaHandler) GetAgenda(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	query := request.URL.Query()
+
+	fromDate, err := parseOptionalTimeParam(query.Get("from"))
+	if err != nil {
+		logger.Info("v1.AgendaHandler.GetAgenda: Unable to parse from", "error", err)
+		http.Error(writer, "Unable to parse from. It should be an RFC 3339 date-time", http.StatusBadRequest)
+		return
+	}
+
+	toDate, err := parseOptionalTimeParam(query.Get("to"))
+	if err != nil {
+		logger.Info("v1.AgendaHandler.GetAgenda: Unable to parse to", "error", err)
+		http.Error(writer, "Unable to parse to. It should be an RFC 3339 date-time", http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	agenda, err := agendaHandler.agendaService.GetAgenda(request.Context(), fromDate, toDate)
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info("v1.AgendaHandler.GetAgenda: ValidationError while getting agenda", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		errorMessage := "Internal service error while getting agenda"
+		logger.Error("v1.AgendaHandler.GetAgenda: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	agendaResponse, err := responses.NewAgendaResponse(agenda)
+	if err != nil {
+		logger.Error("v1.AgendaHandler.GetAgenda: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(writer, request, agendaResponse, "v1.AgendaHandler.GetAgenda")
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(writer).Encode(selectedResponse); err != nil {
+		logger.Error("v1.AgendaHandler.GetAgenda: Unable to write response", "error", err)
+	}
+}
+
+// parseOptionalTimeParam returns nil if value is empty.
+func parseOptionalTimeParam(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
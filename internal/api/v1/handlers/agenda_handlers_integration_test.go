@@ -0,0 +1,90 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAgendaHandler(t *testing.T) (*handlers.AgendaHandler, *repositories.EventRepository) {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupAgendaHandlerTestContainer(t, config)
+
+	var agendaHandler *handlers.AgendaHandler
+	var eventRepository *repositories.EventRepository
+	err := container.Invoke(func(handler *handlers.AgendaHandler, event *repositories.EventRepository) {
+		agendaHandler = handler
+		eventRepository = event
+	})
+	assert.NoError(t, err)
+
+	return agendaHandler, eventRepository
+}
+
+func getAgenda(t *testing.T, agendaHandler *handlers.AgendaHandler, query string) *httptest.ResponseRecorder {
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/agenda?"+query, nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	agendaHandler.GetAgenda(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- GetAgenda tests: --------
+
+func TestGetAgenda_ShouldReturnBookedEventsInPeriodAndOverdueEvents(t *testing.T) {
+	agendaHandler, eventRepository := setupAgendaHandler(t)
+
+	var interviewBooked models.EventType = models.EventTypeInterviewBooked
+	var callBooked models.EventType = models.EventTypeCallBooked
+	from := time.Now().AddDate(0, 0, 1).Truncate(time.Second)
+
+	afterPeriod := from.AddDate(0, 0, 10)
+	yesterday := time.Now().AddDate(0, 0, -1)
+
+	inPeriod := repositoryhelpers.CreateEvent(t, eventRepository, nil, &interviewBooked, &from)
+	repositoryhelpers.CreateEvent(t, eventRepository, nil, &interviewBooked, &afterPeriod)
+	overdue := repositoryhelpers.CreateEvent(t, eventRepository, nil, &callBooked, &yesterday)
+
+	query := url.Values{}
+	query.Set("from", from.Format(time.RFC3339))
+	query.Set("to", from.AddDate(0, 0, 7).Format(time.RFC3339))
+
+	responseRecorder := getAgenda(t, agendaHandler, query.Encode())
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var agendaResponse responses.AgendaResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&agendaResponse))
+	assert.Len(t, agendaResponse.Upcoming, 1)
+	assert.Equal(t, inPeriod.ID, agendaResponse.Upcoming[0].ID)
+	assert.Len(t, agendaResponse.Overdue, 1)
+	assert.Equal(t, overdue.ID, agendaResponse.Overdue[0].ID)
+}
+
+func TestGetAgenda_ShouldReturnBadRequestOnInvalidDates(t *testing.T) {
+	agendaHandler, _ := setupAgendaHandler(t)
+
+	responseRecorder := getAgenda(t, agendaHandler, "from=tomorrow")
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "Unable to parse from. It should be an RFC 3339 date-time\n", responseRecorder.Body.String())
+
+	responseRecorder = getAgenda(t, agendaHandler, "from=2026-01-02T00:00:00Z&to=2026-01-01T00:00:00Z")
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error on field 'to': to must be after from\n", responseRecorder.Body.String())
+}
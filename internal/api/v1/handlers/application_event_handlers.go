@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,15 +29,18 @@ func NewApplicationEventHandler(applicationEventService *services.ApplicationEve
 // @Accept json
 // @Produce json
 // @Param application body requests.AssociateApplicationEventRequest true "Associate Application Event request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.ApplicationEventResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/application-event/associate [post]
 func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createApplicationEventRequest requests.AssociateApplicationEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&createApplicationEventRequest); err != nil {
-		slog.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: invalid request body", "error", err)
+		logger.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -45,7 +48,7 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 	// can return ValidationError
 	createApplicationEventModel, err := createApplicationEventRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationEventHandler.AssociateApplicationEvent: Unable to convert CreateApplicationEventRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -53,7 +56,7 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 	}
 
 	if createApplicationEventModel == nil {
-		slog.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: CreateApplicationEvent model is nil")
+		logger.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: CreateApplicationEvent model is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,8 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	applicationEventModel, err := handler.applicationEventService.AssociateApplicationEvent(createApplicationEventModel)
+	applicationEventModel, err := handler.applicationEventService.AssociateApplicationEvent(
+		request.Context(), createApplicationEventModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -74,23 +78,23 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationEventHandler.AssociateApplicationEvent: ConflictError creating record",
 				"error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while associating event to application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationEventHandler.AssociateApplicationEvent: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationEventHandler.AssociateApplicationEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationEventHandler.AssociateApplicationEvent: ValidationError while associating event to application",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while associating event to application"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.ApplicationEventHandler.AssociateApplicationEvent: Error while associating event to application",
 				"error", err)
 		}
@@ -107,7 +111,7 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 	err = json.NewEncoder(writer).Encode(response)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.EventHandler.AssociateApplicationEvent: Unable to write response", "error", err)
+		logger.Error("v1.EventHandler.AssociateApplicationEvent: Unable to write response", "error", err)
 		http.Error(writer, "Event created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -122,19 +126,22 @@ func (handler *ApplicationEventHandler) AssociateApplicationEvent(writer http.Re
 // @Produce json
 // @Param application-id query string false "application ID" format(uuid)
 // @Param event-id query string false "event ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. application_id,role. Default all"
 // @Success 200 {array} responses.ApplicationEventResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/application-event/get/ [get]
 func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 	applicationIDString := query.Get("application-id")
 	eventIDString := query.Get("event-id")
 
 	if applicationIDString == "" && eventIDString == "" {
 		errorMessage := "ApplicationID and/or EventID are required"
-		slog.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
+		logger.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -148,7 +155,7 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 		applicationIDValue, err := uuid.Parse(applicationIDString)
 		if err != nil || applicationIDValue == uuid.Nil {
 			errorMessage := "Unable to parse ApplicationID"
-			slog.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
+			logger.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -163,7 +170,7 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 		eventIDValue, err := uuid.Parse(eventIDString)
 		if err != nil || eventIDValue == uuid.Nil {
 			errorMessage := "Unable to parse EventID"
-			slog.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
+			logger.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -173,10 +180,10 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 		eventID = &eventIDValue
 	}
 
-	applicationEvents, err := handler.applicationEventService.GetByID(applicationID, eventID)
+	applicationEvents, err := handler.applicationEventService.GetByID(request.Context(), applicationID, eventID)
 	if err != nil {
 		errorMessage := "Internal service error while getting applicationEvents by ID"
-		slog.Error("v1.ApplicationEventHandler.GetApplicationEventsByID: "+errorMessage, "error", err)
+		logger.Error("v1.ApplicationEventHandler.GetApplicationEventsByID: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -186,10 +193,16 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 
 	response := responses.NewApplicationEventsResponse(applicationEvents)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, response, "v1.ApplicationEventHandler.GetApplicationEventsByID")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(response)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.ApplicationEventHandler.GetApplicationEventsByID: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationEventHandler.GetApplicationEventsByID: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -198,7 +211,7 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 		return
 	}
 
-	slog.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: retrieved all applications successfully")
+	logger.Info("v1.ApplicationEventHandler.GetApplicationEventsByID: retrieved all applications successfully")
 }
 
 // GetAllApplicationEvents retrieves all applicationEvents.
@@ -207,15 +220,18 @@ func (handler *ApplicationEventHandler) GetApplicationEventsByID(writer http.Res
 // @Description Get all `applicationEvent`s
 // @Tags applicationEvent
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. application_id,role. Default all"
 // @Success 200 {array} responses.ApplicationEventResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/application-event/get/all [get]
 func (handler *ApplicationEventHandler) GetAllApplicationEvents(writer http.ResponseWriter, request *http.Request) {
-	applicationEvents, err := handler.applicationEventService.GetAll()
+	logger := logging.FromContext(request.Context())
+
+	applicationEvents, err := handler.applicationEventService.GetAll(request.Context())
 	if err != nil {
 		errorMessage := "Internal service error while getting all applicationEvents"
-		slog.Error("v1.ApplicationHandler.GetAllApplicationEvents: "+errorMessage, "error", err)
+		logger.Error("v1.ApplicationHandler.GetAllApplicationEvents: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -225,10 +241,16 @@ func (handler *ApplicationEventHandler) GetAllApplicationEvents(writer http.Resp
 
 	applicationEventsResponse := responses.NewApplicationEventsResponse(applicationEvents)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationEventsResponse, "v1.ApplicationEventHandler.GetAllApplicationEvents")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(applicationEventsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.ApplicationEventHandler.GetAllApplicationEvents: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationEventHandler.GetAllApplicationEvents: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -237,7 +259,78 @@ func (handler *ApplicationEventHandler) GetAllApplicationEvents(writer http.Resp
 		return
 	}
 
-	slog.Info("v1.ApplicationEventHandler.GetAllApplicationEvents: retrieved all ApplicationEvents successfully")
+	logger.Info("v1.ApplicationEventHandler.GetAllApplicationEvents: retrieved all ApplicationEvents successfully")
+}
+
+// UpdateApplicationEvent updates the role, notes, start date and end date of a `applicationEvent`
+//
+// @Summary Update the details of a applicationEvent
+// @Description Update the `role`, `notes`, `start_date` and `end_date` of a `applicationEvent`. Details that are not in the request are left unchanged. The `end_date` cannot be before the `start_date`.
+// @Tags applicationEvent
+// @Accept json
+// @Param applicationEvent body requests.UpdateApplicationEventRequest true "Update applicationEvent request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/application-event/update [post]
+func (handler *ApplicationEventHandler) UpdateApplicationEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateApplicationEventRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.ApplicationEventHandler.UpdateApplicationEvent: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	updateModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.ApplicationEventHandler.UpdateApplicationEvent: Unable to convert request to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.applicationEventService.Update(request.Context(), updateModel)
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while updating ApplicationEvent"
+			status = http.StatusInternalServerError
+			logger.Error("v1.ApplicationEventHandler.UpdateApplicationEvent: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info(
+				"v1.ApplicationEventHandler.UpdateApplicationEvent: NotFoundErr while updating ApplicationEvent",
+				"error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info(
+				"v1.ApplicationEventHandler.UpdateApplicationEvent: ValidationError while updating ApplicationEvent",
+				"error", err)
+		} else {
+			errorMessage = "Unknown internal error while updating ApplicationEvent"
+			status = http.StatusInternalServerError
+			logger.Error("v1.ApplicationEventHandler.UpdateApplicationEvent: "+errorMessage, "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
 }
 
 // DeleteApplicationEvent deletes a `applicationEvent` matching input application UUID and event UUID
@@ -253,9 +346,11 @@ func (handler *ApplicationEventHandler) GetAllApplicationEvents(writer http.Resp
 // @Failure 500
 // @Router /v1/application-event/delete [delete]
 func (handler *ApplicationEventHandler) DeleteApplicationEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var deleteRequest requests.DeleteApplicationEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&deleteRequest); err != nil {
-		slog.Info("v1.ApplicationEventHandler.DeleteApplicationEvent: invalid request body", "error", err)
+		logger.Info("v1.ApplicationEventHandler.DeleteApplicationEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -263,7 +358,7 @@ func (handler *ApplicationEventHandler) DeleteApplicationEvent(writer http.Respo
 	// can return ValidationError
 	deleteModel, err := deleteRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationEventHandler.DeleteApplicationEvent: Unable to convert DeleteApplicationEventRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -271,7 +366,7 @@ func (handler *ApplicationEventHandler) DeleteApplicationEvent(writer http.Respo
 	}
 
 	if deleteModel == nil {
-		slog.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: DeleteApplicationEvent is nil")
+		logger.Info("v1.ApplicationEventHandler.AssociateApplicationEvent: DeleteApplicationEvent is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -279,7 +374,7 @@ func (handler *ApplicationEventHandler) DeleteApplicationEvent(writer http.Respo
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	err = handler.applicationEventService.Delete(deleteModel)
+	err = handler.applicationEventService.Delete(request.Context(), deleteModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundErr *internalErrors.NotFoundError
@@ -291,23 +386,23 @@ func (handler *ApplicationEventHandler) DeleteApplicationEvent(writer http.Respo
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting ApplicationEvent"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationEventHandler.DeleteApplicationEvent: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationEventHandler.DeleteApplicationEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundErr) {
 			errorMessage = err.Error()
 			status = http.StatusNotFound
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationEventHandler.DeleteApplicationEvent: NotFoundErr while deleting ApplicationEvent", "error",
 				err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationEventHandler.DeleteApplicationEvent: ValidationError while deleting ApplicationEvent", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating application"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.ApplicationEventHandler.DeleteApplicationEvent: Error while deleting ApplicationEvent",
 				"error", err)
 		}
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"jobsearchtracker/internal/api/v1/handlers"
 	"jobsearchtracker/internal/api/v1/requests"
@@ -324,6 +325,42 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 	assert.NotNil(t, response[2].CreatedDate)
 }
 
+func TestGetAllApplicationEvents_ShouldOnlyReturnRequestedFields(t *testing.T) {
+	applicationEventHandler,
+		applicationRepository,
+		eventRepository,
+		companyRepository,
+		applicationEventRepository := setupApplicationEventHandler(t)
+
+	setupApplicationEventTestData(
+		t,
+		applicationRepository,
+		eventRepository,
+		companyRepository,
+		applicationEventRepository,
+		true)
+
+	getRequest, err := http.NewRequest(
+		http.MethodGet, "/api/v1/application/application-event/get/all?fields=application_id,event_id", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	applicationEventHandler.GetAllApplicationEvents(responseRecorder, getRequest)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response []map[string]any
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Len(t, response, 3)
+	for _, applicationEvent := range response {
+		assert.Len(t, applicationEvent, 2)
+		assert.Contains(t, applicationEvent, "application_id")
+		assert.Contains(t, applicationEvent, "event_id")
+	}
+}
+
 func TestGetAllApplicationEvents_ShouldReturnNothingIfNothingInDatabase(t *testing.T) {
 	applicationEventHandler, _, _, _, _ := setupApplicationEventHandler(t)
 
@@ -346,6 +383,96 @@ func TestGetAllApplicationEvents_ShouldReturnNothingIfNothingInDatabase(t *testi
 	assert.Len(t, response, 0)
 }
 
+// -------- UpdateApplicationEvent tests: --------
+
+func TestUpdateApplicationEvent_ShouldUpdateLinkDetails(t *testing.T) {
+	applicationEventHandler, applicationRepository, eventRepository, companyRepository, _ :=
+		setupApplicationEventHandler(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	role := "Hiring manager"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateBytes, err := json.Marshal(requests.AssociateApplicationEventRequest{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails: requests.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/application-event/associate", bytes.NewReader(associateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationEventHandler.AssociateApplicationEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var applicationEventResponse responses.ApplicationEventResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&applicationEventResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, role, *applicationEventResponse.Role)
+	assert.Equal(t, startDate, *applicationEventResponse.StartDate)
+	assert.Nil(t, applicationEventResponse.UpdatedDate)
+
+	notes := "Prefers to be contacted by email"
+	updateBytes, err := json.Marshal(requests.UpdateApplicationEventRequest{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails:   requests.LinkDetails{Notes: &notes},
+	})
+	assert.NoError(t, err)
+
+	request, err = http.NewRequest(http.MethodPost, "/api/v1/application-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder = httptest.NewRecorder()
+	applicationEventHandler.UpdateApplicationEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
+
+func TestUpdateApplicationEvent_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T) {
+	applicationEventHandler, _, _, _, _ := setupApplicationEventHandler(t)
+
+	updateBytes, err := json.Marshal(requests.UpdateApplicationEventRequest{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/application-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationEventHandler.UpdateApplicationEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: nothing to update\n", responseRecorder.Body.String())
+}
+
+func TestUpdateApplicationEvent_ShouldReturnNotFoundIfLinkDoesNotExist(t *testing.T) {
+	applicationEventHandler, _, _, _, _ := setupApplicationEventHandler(t)
+
+	role := "Recruiter"
+	updateBytes, err := json.Marshal(requests.UpdateApplicationEventRequest{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+		LinkDetails:   requests.LinkDetails{Role: &role},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/application-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationEventHandler.UpdateApplicationEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
 // -------- DeleteApplicationEvent tests: --------
 
 func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
@@ -440,7 +567,7 @@ func setupApplicationEventTestData(
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	if sleep {
@@ -453,7 +580,7 @@ func setupApplicationEventTestData(
 		ApplicationID: application2.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	if sleep {
@@ -466,7 +593,7 @@ func setupApplicationEventTestData(
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
 	return application1.ID, application2.ID, event1.ID, event2.ID
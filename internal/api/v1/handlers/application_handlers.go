@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -26,19 +26,26 @@ func NewApplicationHandler(applicationService *services.ApplicationService) *App
 //
 // @Summary create an application
 // @Description create an `application` and return it. `company_id` AND/OR `recruiter_id` must be provided. `job_title` AND/OR `job_ad_url` must be provided.
+// @Description `job_ad_url` is normalized before it is stored: the scheme becomes https, the host is lower-cased without "www.", and tracking parameters such as `utm_*`, trailing slashes and the fragment are removed. A fragment that starts with "#/" or "#!" is kept. URLs stored before they were normalized are normalized when the server starts.
+// @Description If an application with the same normalized `job_ad_url`, or with the same `company_id` and `job_title`, already exists, 409 is returned with the ID of that application. Pass `force=true` to create the application anyway.
 // @Tags application
 // @Accept json
 // @Produce json
 // @Param application body requests.CreateApplicationRequest true "Create Application request"
+// @Param force query bool false "Create the application even if it looks like a duplicate"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.ApplicationResponse
 // @Failure 400
 // @Failure 404
+// @Failure 409
 // @Failure 500
 // @Router /v1/application/new [post]
 func (applicationHandler *ApplicationHandler) CreateApplication(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createApplicationRequest requests.CreateApplicationRequest
 	if err := json.NewDecoder(request.Body).Decode(&createApplicationRequest); err != nil {
-		slog.Info("v1.ApplicationHandler.CreateApplication: invalid request body", "error", err)
+		logger.Info("v1.ApplicationHandler.CreateApplication: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -46,7 +53,7 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 	// can return ValidationError
 	createApplicationModel, err := createApplicationRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationHandler.CreateApplication: Unable to convert CreateApplicationRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -54,15 +61,48 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 	}
 
 	if createApplicationModel == nil {
-		slog.Info("v1.ApplicationHandler.CreateApplication: CreateApplicationModel is nil", "error", err)
+		logger.Info("v1.ApplicationHandler.CreateApplication: CreateApplicationModel is nil", "error", err)
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
 		return
 	}
 
+	// can return ValidationError
+	force, err := GetForceParam(request.URL.Query().Get("force"))
+	if err != nil {
+		logger.Info("v1.ApplicationHandler.CreateApplication: Could not parse force param", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !force {
+		// can return ConflictError, InternalServiceError, ValidationError
+		err = applicationHandler.applicationService.CheckForDuplicateApplication(
+			request.Context(), createApplicationModel)
+		if err != nil {
+			var conflictErr *internalErrors.ConflictError
+			var validationErr *internalErrors.ValidationError
+
+			if errors.As(err, &conflictErr) {
+				logger.Info("v1.ApplicationHandler.CreateApplication: Application is a duplicate", "error", err)
+				http.Error(writer, err.Error()+". Use force=true to create it anyway", http.StatusConflict)
+			} else if errors.As(err, &validationErr) {
+				logger.Info("v1.ApplicationHandler.CreateApplication: ValidationError checking duplicates", "error", err)
+				http.Error(writer, err.Error(), http.StatusBadRequest)
+			} else {
+				errorMessage := "Internal service error while checking for duplicate applications"
+				logger.Error("v1.ApplicationHandler.CreateApplication: "+errorMessage, "error", err)
+				http.Error(writer, errorMessage, http.StatusInternalServerError)
+			}
+
+			return
+		}
+	}
+
 	// can return ConflictError, InternalServiceError, ValidationError
-	createdApplication, err := applicationHandler.applicationService.CreateApplication(createApplicationModel)
+	createdApplication, err := applicationHandler.applicationService.CreateApplication(
+		request.Context(), createApplicationModel)
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
 		var internalServiceErr *internalErrors.InternalServiceError
@@ -74,21 +114,21 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.ApplicationHandler.CreateApplication: ConflictError creating application", "error", err)
+			logger.Info("v1.ApplicationHandler.CreateApplication: ConflictError creating application", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while creating application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.CreateApplication: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationHandler.CreateApplication: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationHandler.CreateApplication: ValidationError while creating application", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.CreateApplication: Error while creating application", "error", err)
+			logger.Error("v1.ApplicationHandler.CreateApplication: Error while creating application", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -98,7 +138,7 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 	// can return InternalServiceError
 	applicationResponse, err := responses.NewApplicationResponse(createdApplication)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"v1.ApplicationHandler.CreateApplication: Unable to convert internal model to response", "error",
 			err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
@@ -109,7 +149,7 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 	err = json.NewEncoder(writer).Encode(applicationResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.ApplicationHandler.CreateApplication: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationHandler.CreateApplication: Unable to write response", "error", err)
 		http.Error(writer, "Application created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -123,24 +163,30 @@ func (applicationHandler *ApplicationHandler) CreateApplication(writer http.Resp
 // @Tags application
 // @Produce json
 // @Param id path string true "application ID" format(uuid)
+// @Param If-None-Match header string false "ETag of the version the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,job_title. Default all"
 // @Success 200 {object} responses.ApplicationResponse
+// @Header 200 {string} ETag "Version of the application"
+// @Failure 304
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/application/get/id/{id} [get]
 func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	applicationIDStr := vars["id"]
 
 	if applicationIDStr == "" {
-		slog.Info("v1.ApplicationHandler.GetApplicationById: application ID is empty")
+		logger.Info("v1.ApplicationHandler.GetApplicationById: application ID is empty")
 		http.Error(writer, "application ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	applicationID, err := uuid.Parse(applicationIDStr)
 	if err != nil {
-		slog.Info("v1.ApplicationHandler.GetApplicationById: application ID is not a valid UUID")
+		logger.Info("v1.ApplicationHandler.GetApplicationById: application ID is not a valid UUID")
 		http.Error(writer, "application ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
@@ -150,7 +196,7 @@ func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.Res
 	var validationErr *internalErrors.ValidationError
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	application, err := applicationHandler.applicationService.GetApplicationById(&applicationID)
+	application, err := applicationHandler.applicationService.GetApplicationById(request.Context(), &applicationID)
 	if err != nil {
 		var errorMessage string
 		var status int
@@ -158,15 +204,15 @@ func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.Res
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.GetApplicationByID: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationHandler.GetApplicationByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "application not found"
 			status = http.StatusNotFound
-			slog.Info("v1.ApplicationHandler.GetApplicationByID: "+errorMessage, "error", err)
+			logger.Info("v1.ApplicationHandler.GetApplicationByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.ApplicationHandler.GetApplicationByID: Validation error", "error", err)
+			logger.Info("v1.ApplicationHandler.GetApplicationByID: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -176,23 +222,36 @@ func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.Res
 	// can return InternalServiceError
 	applicationResponse, err := responses.NewApplicationResponse(application)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"v1.ApplicationHandler.GetApplicationByID: Unable to convert internal model to response",
 			"error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationResponse, "v1.ApplicationHandler.GetApplicationByID")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, application.Version)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(applicationResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.ApplicationHandler.GetApplicationByID: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationHandler.GetApplicationByID: Unable to write response", "error", err)
 		http.Error(writer, "Application found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info(
+	logger.Info(
 		"v1.ApplicationHandler.GetApplicationByID: retrieved application successfully",
 		"application.ID", application.ID.String())
 }
@@ -204,6 +263,7 @@ func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.Res
 // @Tags application
 // @Produce json
 // @Param job_title path string true "job title"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,job_title. Default all"
 // @Success 200 {array} responses.ApplicationResponse
 // @Failure 400
 // @Failure 404
@@ -212,11 +272,13 @@ func (applicationHandler *ApplicationHandler) GetApplicationByID(writer http.Res
 func (applicationHandler *ApplicationHandler) GetApplicationsByJobTitle(
 	writer http.ResponseWriter, request *http.Request) {
 
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	jobTitle := vars["title"]
 
 	if jobTitle == "" {
-		slog.Info("v1.ApplicationHandler.GetApplicationByJobTitle: job title is empty")
+		logger.Info("v1.ApplicationHandler.GetApplicationByJobTitle: job title is empty")
 		http.Error(writer, "job title is empty", http.StatusBadRequest)
 		return
 	}
@@ -225,7 +287,7 @@ func (applicationHandler *ApplicationHandler) GetApplicationsByJobTitle(
 	var notFoundError *internalErrors.NotFoundError
 	var validationErr *internalErrors.ValidationError
 
-	applications, err := applicationHandler.applicationService.GetApplicationsByJobTitle(&jobTitle)
+	applications, err := applicationHandler.applicationService.GetApplicationsByJobTitle(request.Context(), &jobTitle)
 	if err != nil {
 		var errorMessage string
 		var status int
@@ -233,15 +295,15 @@ func (applicationHandler *ApplicationHandler) GetApplicationsByJobTitle(
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving applications"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.GetApplicationsByJobTitle: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationHandler.GetApplicationsByJobTitle: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "No applications [partially] matching this job title found"
 			status = http.StatusNotFound
-			slog.Info("v1.ApplicationHandler.GetApplicationsByJobTitle: "+errorMessage, "error", err)
+			logger.Info("v1.ApplicationHandler.GetApplicationsByJobTitle: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.ApplicationHandler.GetApplicationsByJobTitle: Validation error", "error", err)
+			logger.Info("v1.ApplicationHandler.GetApplicationsByJobTitle: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -251,23 +313,29 @@ func (applicationHandler *ApplicationHandler) GetApplicationsByJobTitle(
 	// can return InternalServiceError
 	applicationsResponse, err := responses.NewApplicationsResponse(applications)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"v1.ApplicationHandler.GetApplicationsByJobTitle: Unable to convert internal model to response",
 			"error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationsResponse, "v1.ApplicationHandler.GetApplicationsByJobTitle")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(applicationsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.ApplicationHandler.GetApplicationsByJobTitle: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationHandler.GetApplicationsByJobTitle: Unable to write response", "error", err)
 		http.Error(writer, "Application found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info(
+	logger.Info(
 		"v1.ApplicationHandler.GetApplicationsByJobTitle: retrieved applications successfully",
 		"jobTitle", jobTitle)
 }
@@ -290,14 +358,17 @@ func (applicationHandler *ApplicationHandler) GetApplicationsByJobTitle(
 // @Description - include_events=none: No `event` data included (default)
 // @Tags application
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,job_title. Default all"
 // @Success 200 {array} responses.ApplicationResponse
 // @Router /v1/application/get/all [get]
 func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 
 	includeCompany, err := GetExtraDataTypeParam(query.Get("include_company"))
 	if err != nil {
-		slog.Error("v1.applicationHandler.GetAllApplications: Could not parse include_company param", "error", err)
+		logger.Error("v1.applicationHandler.GetAllApplications: Could not parse include_company param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -310,7 +381,7 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 
 	includeRecruiter, err := GetExtraDataTypeParam(query.Get("include_recruiter"))
 	if err != nil {
-		slog.Error("v1.applicationHandler.GetAllApplications: Could not parse include_recruiter param", "error", err)
+		logger.Error("v1.applicationHandler.GetAllApplications: Could not parse include_recruiter param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -323,7 +394,7 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 
 	includePersons, err := GetExtraDataTypeParam(query.Get("include_persons"))
 	if err != nil {
-		slog.Error("v1.applicationHandler.GetAllApplications: Could not parse include_persons param", "error", err)
+		logger.Error("v1.applicationHandler.GetAllApplications: Could not parse include_persons param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -336,7 +407,7 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 
 	includeEvents, err := GetExtraDataTypeParam(query.Get("include_events"))
 	if err != nil {
-		slog.Error("v1.applicationHandler.GetAllApplications: Could not parse include_events param", "error", err)
+		logger.Error("v1.applicationHandler.GetAllApplications: Could not parse include_events param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -349,6 +420,7 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 
 	// can return InternalServiceError
 	applications, err := applicationHandler.applicationService.GetAllApplications(
+		request.Context(),
 		*includeCompany,
 		*includeRecruiter,
 		*includePersons,
@@ -357,7 +429,7 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 	if err != nil {
 		errorMessage := "Internal service error while getting all applications"
 		status := http.StatusInternalServerError
-		slog.Error("v1.ApplicationHandler.getAllApplications: "+errorMessage, "error", err)
+		logger.Error("v1.ApplicationHandler.getAllApplications: "+errorMessage, "error", err)
 
 		http.Error(writer, errorMessage, status)
 		return
@@ -366,41 +438,129 @@ func (applicationHandler *ApplicationHandler) GetAllApplications(writer http.Res
 	// can return InternalServiceError
 	applicationsResponse, err := responses.NewApplicationsResponse(applications)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"v1.ApplicationHandler.GetAllApplications: Unable to convert internal model to response",
 			"error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationsResponse, "v1.ApplicationHandler.GetAllApplications")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(applicationsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.ApplicationHandler.GetAllApplications: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationHandler.GetAllApplications: Unable to write response", "error", err)
 		http.Error(writer, "Applications retrieved but unable to create response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.ApplicationHandler.GetAllApplications: retrieved all applications successfully")
+	logger.Info("v1.ApplicationHandler.GetAllApplications: retrieved all applications successfully")
+}
+
+// GetApplicationsByIDs retrieves the `application`s with the given IDs
+//
+// @Summary Get applications by IDs
+// @Description Get up to 100 `application`s by ID, in the order of `ids`. IDs that do not exist are skipped. Duplicate IDs are returned once.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param ids body requests.GetByIDsRequest true "IDs of the applications"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,job_title. Default all"
+// @Success 200 {array} responses.ApplicationResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/application/get/ids [post]
+func (applicationHandler *ApplicationHandler) GetApplicationsByIDs(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var getByIDsRequest requests.GetByIDsRequest
+	if err := json.NewDecoder(request.Body).Decode(&getByIDsRequest); err != nil {
+		logger.Info("v1.ApplicationHandler.GetApplicationsByIDs: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	ids, err := getByIDsRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.ApplicationHandler.GetApplicationsByIDs: Unable to convert GetByIDsRequest to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	applications, err := applicationHandler.applicationService.GetApplicationsByIDs(request.Context(), ids)
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info("v1.ApplicationHandler.GetApplicationsByIDs: Validation error", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		errorMessage := "Internal service error while retrieving applications"
+		logger.Error("v1.ApplicationHandler.GetApplicationsByIDs: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	applicationsResponse, err := responses.NewApplicationsResponse(applications)
+	if err != nil {
+		logger.Error(
+			"v1.ApplicationHandler.GetApplicationsByIDs: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationsResponse, "v1.ApplicationHandler.GetApplicationsByIDs")
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(writer).Encode(selectedResponse)
+	if err != nil {
+		logger.Error("v1.ApplicationHandler.GetApplicationsByIDs: Unable to write response", "error", err)
+		http.Error(writer, "Applications retrieved but unable to create response", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info(
+		"v1.ApplicationHandler.GetApplicationsByIDs: retrieved applications successfully",
+		"requested", len(ids),
+		"found", len(applications))
 }
 
 // UpdateApplication updates an application
 //
 // @Summary update an application
 // @Description update an `application`
+// @Description `job_ad_url` is normalized in the same way as when the application is created.
 // @Tags application
 // @Accept json
 // @Param application body requests.UpdateApplicationRequest true "Update Application Request"
+// @Param If-Match header string false "ETag of the version the update is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/application/update [post]
 func (applicationHandler *ApplicationHandler) UpdateApplication(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var updateApplicationRequest requests.UpdateApplicationRequest
 	if err := json.NewDecoder(request.Body).Decode(&updateApplicationRequest); err != nil {
-		slog.Info("v1.ApplicationHandler.UpdateApplication: invalid request body", "error", err)
+		logger.Info("v1.ApplicationHandler.UpdateApplication: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -408,7 +568,7 @@ func (applicationHandler *ApplicationHandler) UpdateApplication(writer http.Resp
 	// can return ValidationError
 	updateApplicationModel, err := updateApplicationRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationHandler.UpdateApplication: Unable to convert UpdateApplicationRequest to model",
 			"error", err)
 		http.Error(writer, "Unable to convert request to internal model: "+err.Error(), http.StatusBadRequest)
@@ -417,16 +577,26 @@ func (applicationHandler *ApplicationHandler) UpdateApplication(writer http.Resp
 	}
 
 	if updateApplicationModel == nil {
-		slog.Error(
+		logger.Error(
 			"v1.ApplicationHandler.UpdateApplication: updateApplicationModel is nil after attempting to convert request to internal model")
 		http.Error(writer, "Unable to convert request to model: Model is nil ", http.StatusInternalServerError)
 		return
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = applicationHandler.applicationService.UpdateApplication(updateApplicationModel)
+	// can return ValidationError
+	updateApplicationModel.ExpectedVersion, err = GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.ApplicationHandler.UpdateApplication: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = applicationHandler.applicationService.UpdateApplication(request.Context(), updateApplicationModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -435,17 +605,27 @@ func (applicationHandler *ApplicationHandler) UpdateApplication(writer http.Resp
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while updating application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.UpdateApplication: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationHandler.UpdateApplication: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundError) {
+			errorMessage = "Application not found"
+			status = http.StatusNotFound
+			logger.Info("v1.ApplicationHandler.UpdateApplication: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info(
+				"v1.ApplicationHandler.UpdateApplication: Application has been changed by another request",
+				"error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationHandler.UpdateApplication: ValidationError while updating application",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while updating application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.UpdateApplication: Error while updating application", "error", err)
+			logger.Error("v1.ApplicationHandler.UpdateApplication: Error while updating application", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -461,33 +641,46 @@ func (applicationHandler *ApplicationHandler) UpdateApplication(writer http.Resp
 // @Description Delete an `application` by ID
 // @Tags application
 // @Param id path string true "Application ID" format(uuid)
+// @Param If-Match header string false "ETag of the version the delete is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/application/delete/{id} [delete]
 func (applicationHandler *ApplicationHandler) DeleteApplication(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	applicationIDStr := vars["id"]
 
 	if applicationIDStr == "" {
-		slog.Info("v1.ApplicationHandler.DeleteApplication: application ID is empty")
+		logger.Info("v1.ApplicationHandler.DeleteApplication: application ID is empty")
 		http.Error(writer, "application ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	applicationID, err := uuid.Parse(applicationIDStr)
 	if err != nil {
-		slog.Info("v1.ApplicationHandler.DeleteApplication: application ID is not a valid UUID")
+		logger.Info("v1.ApplicationHandler.DeleteApplication: application ID is not a valid UUID")
 		http.Error(writer, "application ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, NotFoundError, ValidationError
-	err = applicationHandler.applicationService.DeleteApplication(&applicationID)
+	// can return ValidationError
+	expectedVersion, err := GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.ApplicationHandler.DeleteApplication: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = applicationHandler.applicationService.DeleteApplication(request.Context(), &applicationID, expectedVersion)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -496,21 +689,27 @@ func (applicationHandler *ApplicationHandler) DeleteApplication(writer http.Resp
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while deleting application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.DeleteApplication: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationHandler.DeleteApplication: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "Application not found"
 			status = http.StatusNotFound
-			slog.Info("v1.ApplicationHandler.DeleteApplication: "+errorMessage, "error", err)
+			logger.Info("v1.ApplicationHandler.DeleteApplication: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info(
+				"v1.ApplicationHandler.DeleteApplication: Application has been changed by another request",
+				"error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationHandler.DeleteApplication: ValidationError while deleting application",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while deleting application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationHandler.DeleteApplication: Error while deleting application", "error", err)
+			logger.Error("v1.ApplicationHandler.DeleteApplication: Error while deleting application", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
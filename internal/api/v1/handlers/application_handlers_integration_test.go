@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"jobsearchtracker/internal/api/v1/handlers"
 	"jobsearchtracker/internal/api/v1/requests"
@@ -14,6 +15,7 @@ import (
 	"jobsearchtracker/internal/testutil/repositoryhelpers"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -189,6 +191,77 @@ func TestCreateApplication_ShouldReturnStatusConflictIfApplicationIDIsDuplicate(
 	assert.Equal(t, expectedError, secondResponseRecorder.Body.String())
 }
 
+func TestCreateApplication_ShouldReturnStatusConflictIfJobAdURLIsDuplicateUnlessForced(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	recruiter := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+
+	postApplication := func(url string, requestBody requests.CreateApplicationRequest) *httptest.ResponseRecorder {
+		requestBytes, err := json.Marshal(requestBody)
+		assert.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBytes))
+		assert.NoError(t, err)
+
+		responseRecorder := httptest.NewRecorder()
+		applicationHandler.CreateApplication(responseRecorder, request)
+		return responseRecorder
+	}
+
+	firstResponseRecorder := postApplication("/api/v1/application/new", requests.CreateApplicationRequest{
+		CompanyID:        &company.ID,
+		JobAdURL:         testutil.ToPtr("https://www.acme.se/jobs/123?utm_source=linkedin"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+	assert.Equal(t, http.StatusCreated, firstResponseRecorder.Code)
+
+	var firstApplicationResponse responses.ApplicationResponse
+	err := json.NewDecoder(firstResponseRecorder.Body).Decode(&firstApplicationResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.se/jobs/123", *firstApplicationResponse.JobAdURL)
+
+	duplicateRequestBody := requests.CreateApplicationRequest{
+		RecruiterID:      &recruiter.ID,
+		JobAdURL:         testutil.ToPtr("http://acme.se/jobs/123/"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	}
+
+	secondResponseRecorder := postApplication("/api/v1/application/new", duplicateRequestBody)
+	assert.Equal(t, http.StatusConflict, secondResponseRecorder.Code)
+	assert.Equal(
+		t,
+		"conflict error on insert: an application with the same job ad URL, or the same company and job title, "+
+			"already exists: '"+firstApplicationResponse.ID.String()+"'. Use force=true to create it anyway\n",
+		secondResponseRecorder.Body.String())
+
+	thirdResponseRecorder := postApplication("/api/v1/application/new?force=true", duplicateRequestBody)
+	assert.Equal(t, http.StatusCreated, thirdResponseRecorder.Code)
+}
+
+func TestCreateApplication_ShouldReturnStatusBadRequestIfForceIsInvalid(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+
+	requestBytes, err := json.Marshal(requests.CreateApplicationRequest{
+		CompanyID:        &company.ID,
+		JobTitle:         testutil.ToPtr("Job Title"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/application/new?force=maybe", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationHandler.CreateApplication(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t, "validation error on field 'force': force must be 'true' or 'false'\n", responseRecorder.Body.String())
+}
+
 func TestCreateApplication_ShouldReturnErrorIfCompanyIDDoesNotExistInCompany(t *testing.T) {
 	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
 
@@ -330,6 +403,92 @@ func TestGetApplicationById_ShouldReturnNotFoundIfApplicationDoesNotExist(t *tes
 	assert.NotEmpty(t, firstResponseBodyString, "Application not found\n")
 }
 
+func TestGetApplicationById_ShouldReturnETagAndNotModifiedIfIfNoneMatchMatches(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	id := uuid.New()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	insertApplication(t, applicationHandler, requests.CreateApplicationRequest{
+		ID:               &id,
+		CompanyID:        testutil.ToPtr(company.ID),
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+
+	vars := map[string]string{
+		"id": id.String(),
+	}
+
+	tests := []struct {
+		testName       string
+		ifNoneMatch    string
+		expectedStatus int
+	}{
+		{"no If-None-Match", "", http.StatusOK},
+		{"current version", `"1"`, http.StatusNotModified},
+		{"weak current version", `W/"1"`, http.StatusNotModified},
+		{"list with current version", `"7", "1"`, http.StatusNotModified},
+		{"other version", `"2"`, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			getRequest, err := http.NewRequest(http.MethodGet, "/api/v1/application/get/id", nil)
+			assert.NoError(t, err)
+			getRequest = mux.SetURLVars(getRequest, vars)
+			if test.ifNoneMatch != "" {
+				getRequest.Header.Set("If-None-Match", test.ifNoneMatch)
+			}
+
+			responseRecorder := httptest.NewRecorder()
+			applicationHandler.GetApplicationByID(responseRecorder, getRequest)
+
+			assert.Equal(t, test.expectedStatus, responseRecorder.Code)
+			assert.Equal(t, `"1"`, responseRecorder.Header().Get("ETag"))
+			if test.expectedStatus == http.StatusNotModified {
+				assert.Empty(t, responseRecorder.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetApplicationById_ShouldCheckFieldsBeforeIfNoneMatch(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	id := uuid.New()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	insertApplication(t, applicationHandler, requests.CreateApplicationRequest{
+		ID:               &id,
+		CompanyID:        testutil.ToPtr(company.ID),
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+
+	getApplication := func(target string, ifNoneMatch string) *httptest.ResponseRecorder {
+		getRequest, err := http.NewRequest(http.MethodGet, target, nil)
+		assert.NoError(t, err)
+		getRequest = mux.SetURLVars(getRequest, map[string]string{"id": id.String()})
+		getRequest.Header.Set("If-None-Match", ifNoneMatch)
+
+		responseRecorder := httptest.NewRecorder()
+		applicationHandler.GetApplicationByID(responseRecorder, getRequest)
+		return responseRecorder
+	}
+
+	responseRecorder := getApplication("/api/v1/application/get/id?fields=not_a_field", "*")
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+
+	responseRecorder = getApplication("/api/v1/application/get/id?fields=job_title", `"1"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	jobTitleETag := responseRecorder.Header().Get("ETag")
+
+	responseRecorder = getApplication("/api/v1/application/get/id?fields=job_title,country", jobTitleETag)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseRecorder = getApplication("/api/v1/application/get/id?fields=job_title", jobTitleETag)
+	assert.Equal(t, http.StatusNotModified, responseRecorder.Code)
+}
+
 // -------- GetApplicationByJobTitle tests: --------
 
 func TestGetApplicationsByJobTitle_ShouldReturnApplication(t *testing.T) {
@@ -473,6 +632,114 @@ func TestGetApplicationsByJobTitle_ShouldReturnNotFoundIfNoApplicationsMatchingJ
 	assert.Equal(t, "No applications [partially] matching this job title found\n", responseBodyString)
 }
 
+// -------- GetApplicationsByIDs tests: --------
+
+func TestGetApplicationsByIDs_ShouldReturnApplicationsInOrderOfIDs(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	recruiter := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+
+	firstRequestBody := requests.CreateApplicationRequest{
+		ID:               testutil.ToPtr(uuid.New()),
+		RecruiterID:      testutil.ToPtr(recruiter.ID),
+		JobTitle:         testutil.ToPtr("Software Engineer 1"),
+		RemoteStatusType: requests.RemoteStatusTypeOffice,
+	}
+	insertApplication(t, applicationHandler, firstRequestBody)
+
+	secondRequestBody := requests.CreateApplicationRequest{
+		ID:               testutil.ToPtr(uuid.New()),
+		RecruiterID:      testutil.ToPtr(recruiter.ID),
+		JobTitle:         testutil.ToPtr("Software Engineer 2"),
+		RemoteStatusType: requests.RemoteStatusTypeRemote,
+	}
+	insertApplication(t, applicationHandler, secondRequestBody)
+
+	requestBody := requests.GetByIDsRequest{
+		IDs: []uuid.UUID{*secondRequestBody.ID, uuid.New(), *firstRequestBody.ID},
+	}
+	requestBytes, err := json.Marshal(requestBody)
+	assert.NoError(t, err)
+
+	getRequest, err := http.NewRequest(http.MethodPost, "/api/v1/application/get/ids", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	applicationHandler.GetApplicationsByIDs(responseRecorder, getRequest)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response []responses.ApplicationResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Len(t, response, 2)
+	assert.Equal(t, *secondRequestBody.ID, response[0].ID)
+	assert.Equal(t, *secondRequestBody.JobTitle, *response[0].JobTitle)
+	assert.Equal(t, *firstRequestBody.ID, response[1].ID)
+	assert.Equal(t, *firstRequestBody.JobTitle, *response[1].JobTitle)
+}
+
+func TestGetApplicationsByIDs_ShouldReturnBadRequestIfIDsAreEmpty(t *testing.T) {
+	applicationHandler, _, _, _, _, _ := setupApplicationHandler(t)
+
+	getRequest, err := http.NewRequest(
+		http.MethodPost, "/api/v1/application/get/ids", bytes.NewBufferString(`{"ids":[]}`))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	applicationHandler.GetApplicationsByIDs(responseRecorder, getRequest)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), "IDs is empty")
+}
+
+// -------- Fields tests: --------
+
+func TestGetApplicationByID_ShouldOnlyReturnRequestedFields(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	id := uuid.New()
+	recruiter := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	requestBody := requests.CreateApplicationRequest{
+		ID:               &id,
+		RecruiterID:      testutil.ToPtr(recruiter.ID),
+		JobTitle:         testutil.ToPtr("Job Title"),
+		Country:          testutil.ToPtr("country"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	}
+	insertApplication(t, applicationHandler, requestBody)
+
+	getRequest, err := http.NewRequest(http.MethodGet, "/api/v1/application/get/id?fields=job_title,country", nil)
+	assert.NoError(t, err)
+	getRequest = mux.SetURLVars(getRequest, map[string]string{"id": id.String()})
+
+	responseRecorder := httptest.NewRecorder()
+
+	applicationHandler.GetApplicationByID(responseRecorder, getRequest)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.True(t, strings.HasPrefix(responseRecorder.Header().Get("ETag"), `W/"1-`))
+
+	var response map[string]any
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"id": id.String(), "job_title": "Job Title", "country": "country"}, response)
+}
+
+func TestGetAllApplications_ShouldReturnBadRequestIfFieldIsUnknown(t *testing.T) {
+	applicationHandler, _, _, _, _, _ := setupApplicationHandler(t)
+
+	getRequest, err := http.NewRequest(http.MethodGet, "/api/v1/application/get/all?fields=salary", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	applicationHandler.GetAllApplications(responseRecorder, getRequest)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), "'salary' is not a valid field")
+}
+
 // -------- GetAllApplications - Base tests: --------
 
 func TestGetAllApplications_ShouldReturnAllApplications(t *testing.T) {
@@ -578,7 +845,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithCompanyIfIncludeCompanyI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -674,7 +941,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithCompanyIfIncludeCompanyI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -731,7 +998,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithNoCompanyIfIncludeCompan
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -780,7 +1047,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithCompanyIfIncludeCompanyI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -831,7 +1098,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithRecruiterIfIncludeRecrui
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -888,7 +1155,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithNoRecruiterIfIncludeRecr
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -937,7 +1204,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithRecruiterIfIncludeRecrui
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -994,7 +1261,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithNoCompanyIfIncludeRecrui
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -1043,7 +1310,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithNoRecruiterIfIncludeRecr
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationRequest := requests.CreateApplicationRequest{
@@ -1110,7 +1377,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithEventsIfIncludeEventsIsA
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -1237,7 +1504,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithEventIDsIfIncludeEventsI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -1419,7 +1686,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithPersonsIfIncludePersonsI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := personRepository.Create(&person1ToInsert)
+	_, err := personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1546,7 +1813,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithPersonIDsIfIncludePerson
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := personRepository.Create(&person1ToInsert)
+	_, err := personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1673,7 +1940,7 @@ func TestGetAllApplications_ShouldReturnApplicationsWithNoPersonsIfIncludePerson
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := personRepository.Create(&person1ToInsert)
+	_, err := personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1854,6 +2121,67 @@ func TestUpdateApplication_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T)
 		responseBodyString)
 }
 
+func TestUpdateApplication_ShouldReturnPreconditionFailedIfIfMatchIsStale(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	id := uuid.New()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	insertApplication(t, applicationHandler, requests.CreateApplicationRequest{
+		ID:               &id,
+		CompanyID:        testutil.ToPtr(company.ID),
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+
+	update := func(jobTitle string, ifMatch string) *httptest.ResponseRecorder {
+		requestBytes, err := json.Marshal(requests.UpdateApplicationRequest{ID: id, JobTitle: &jobTitle})
+		assert.NoError(t, err)
+
+		updateRequest, err := http.NewRequest(
+			http.MethodPost, "/api/v1/application/update", bytes.NewBuffer(requestBytes))
+		assert.NoError(t, err)
+		updateRequest.Header.Set("If-Match", ifMatch)
+
+		responseRecorder := httptest.NewRecorder()
+		applicationHandler.UpdateApplication(responseRecorder, updateRequest)
+		return responseRecorder
+	}
+
+	// two tabs read version 1, the first one to save wins
+	responseRecorder := update("First tab", `"1"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseRecorder = update("Second tab", `"1"`)
+	assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"precondition failed: Application has been changed since it was read. Expected version 1, current version "+
+			"is 2\n",
+		responseRecorder.Body.String())
+
+	responseRecorder = update("Second tab", `W/"2"`)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"validation error on field 'If-Match': If-Match must be a single strong entity tag, as returned in the "+
+			"ETag header\n",
+		responseRecorder.Body.String())
+
+	getRequest, err := http.NewRequest(http.MethodGet, "/api/v1/application/get/id", nil)
+	assert.NoError(t, err)
+	getRequest = mux.SetURLVars(getRequest, map[string]string{"id": id.String()})
+
+	getResponseRecorder := httptest.NewRecorder()
+	applicationHandler.GetApplicationByID(getResponseRecorder, getRequest)
+	assert.Equal(t, http.StatusOK, getResponseRecorder.Code)
+	assert.Equal(t, `"2"`, getResponseRecorder.Header().Get("ETag"))
+
+	var applicationResponse responses.ApplicationResponse
+	err = json.NewDecoder(getResponseRecorder.Body).Decode(&applicationResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, "First tab", *applicationResponse.JobTitle)
+}
+
 // -------- DeleteApplication tests: --------
 
 func TestDeleteApplication_ShouldDeleteApplication(t *testing.T) {
@@ -1917,6 +2245,38 @@ func TestDeleteApplication_ShouldReturnStatusNotFoundIfApplicationDoesNotExist(t
 	assert.Equal(t, http.StatusNotFound, deleteResponseRecorder.Code)
 }
 
+func TestDeleteApplication_ShouldReturnPreconditionFailedIfIfMatchIsStale(t *testing.T) {
+	applicationHandler, companyRepository, _, _, _, _ := setupApplicationHandler(t)
+
+	id := uuid.New()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	insertApplication(t, applicationHandler, requests.CreateApplicationRequest{
+		ID:               &id,
+		CompanyID:        testutil.ToPtr(company.ID),
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: requests.RemoteStatusTypeHybrid,
+	})
+
+	vars := map[string]string{
+		"id": id.String(),
+	}
+
+	deleteRequest, err := http.NewRequest(http.MethodDelete, "/api/v1/application/delete/", nil)
+	assert.NoError(t, err)
+	deleteRequest = mux.SetURLVars(deleteRequest, vars)
+	deleteRequest.Header.Set("If-Match", `"2"`)
+
+	deleteResponseRecorder := httptest.NewRecorder()
+	applicationHandler.DeleteApplication(deleteResponseRecorder, deleteRequest)
+	assert.Equal(t, http.StatusPreconditionFailed, deleteResponseRecorder.Code)
+
+	deleteRequest.Header.Set("If-Match", `"1"`)
+
+	deleteResponseRecorder = httptest.NewRecorder()
+	applicationHandler.DeleteApplication(deleteResponseRecorder, deleteRequest)
+	assert.Equal(t, http.StatusOK, deleteResponseRecorder.Code)
+}
+
 // -------- Test helpers: --------
 
 func insertApplication(
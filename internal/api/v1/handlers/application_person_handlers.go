@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,15 +29,18 @@ func NewApplicationPersonHandler(applicationPersonService *services.ApplicationP
 // @Accept json
 // @Produce json
 // @Param application body requests.AssociateApplicationPersonRequest true "Associate Application Person request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.ApplicationPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/application-person/associate [post]
 func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createApplicationPersonRequest requests.AssociateApplicationPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&createApplicationPersonRequest); err != nil {
-		slog.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: invalid request body", "error", err)
+		logger.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -45,7 +48,7 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 	// can return ValidationError
 	createApplicationPersonModel, err := createApplicationPersonRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationPersonHandler.AssociateApplicationPerson: Unable to convert CreateApplicationPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -53,7 +56,7 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 	}
 
 	if createApplicationPersonModel == nil {
-		slog.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: CreateApplicationPerson model is nil")
+		logger.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: CreateApplicationPerson model is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,8 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	applicationPersonModel, err := handler.applicationPersonService.AssociateApplicationPerson(createApplicationPersonModel)
+	applicationPersonModel, err := handler.applicationPersonService.AssociateApplicationPerson(
+		request.Context(), createApplicationPersonModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -74,21 +78,21 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: ConflictError creating record", "error", err)
+			logger.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: ConflictError creating record", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while associating person to application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationPersonHandler.AssociateApplicationPerson: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationPersonHandler.AssociateApplicationPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationPersonHandler.AssociateApplicationPerson: ValidationError while associating person to application",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while associating person to application"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.ApplicationPersonHandler.AssociateApplicationPerson: Error while associating person to application",
 				"error", err)
 		}
@@ -105,7 +109,7 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 	err = json.NewEncoder(writer).Encode(response)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.AssociateApplicationPerson: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.AssociateApplicationPerson: Unable to write response", "error", err)
 		http.Error(writer, "Person created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -120,19 +124,22 @@ func (handler *ApplicationPersonHandler) AssociateApplicationPerson(writer http.
 // @Produce json
 // @Param application-id query string false "application ID" format(uuid)
 // @Param person-id query string false "person ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. application_id,role. Default all"
 // @Success 200 {array} responses.ApplicationPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/application-person/get/ [get]
 func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 	applicationIDString := query.Get("application-id")
 	personIDString := query.Get("person-id")
 
 	if applicationIDString == "" && personIDString == "" {
 		errorMessage := "ApplicationID and/or PersonID are required"
-		slog.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
+		logger.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -146,7 +153,7 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 		applicationIDValue, err := uuid.Parse(applicationIDString)
 		if err != nil || applicationIDValue == uuid.Nil {
 			errorMessage := "Unable to parse ApplicationID"
-			slog.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
+			logger.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -161,7 +168,7 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 		personIDValue, err := uuid.Parse(personIDString)
 		if err != nil || personIDValue == uuid.Nil {
 			errorMessage := "Unable to parse PersonID"
-			slog.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
+			logger.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -171,10 +178,10 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 		personID = &personIDValue
 	}
 
-	applicationPersons, err := handler.applicationPersonService.GetByID(applicationID, personID)
+	applicationPersons, err := handler.applicationPersonService.GetByID(request.Context(), applicationID, personID)
 	if err != nil {
 		errorMessage := "Internal service error while getting applicationPersons by ID"
-		slog.Error("v1.ApplicationPersonHandler.GetApplicationPersonsByID: "+errorMessage, "error", err)
+		logger.Error("v1.ApplicationPersonHandler.GetApplicationPersonsByID: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -184,10 +191,16 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 
 	response := responses.NewApplicationPersonsResponse(applicationPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, response, "v1.ApplicationPersonHandler.GetApplicationPersonsByID")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(response)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.ApplicationPersonHandler.GetApplicationPersonsByID: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationPersonHandler.GetApplicationPersonsByID: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -196,7 +209,7 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 		return
 	}
 
-	slog.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: retrieved all applications successfully")
+	logger.Info("v1.ApplicationPersonHandler.GetApplicationPersonsByID: retrieved all applications successfully")
 }
 
 // GetAllApplicationPersons retrieves all applicationPersons.
@@ -205,15 +218,18 @@ func (handler *ApplicationPersonHandler) GetApplicationPersonsByID(writer http.R
 // @Description Get all `applicationPerson`s
 // @Tags applicationPerson
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. application_id,role. Default all"
 // @Success 200 {array} responses.ApplicationPersonResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/application-person/get/all [get]
 func (handler *ApplicationPersonHandler) GetAllApplicationPersons(writer http.ResponseWriter, request *http.Request) {
-	applicationPersons, err := handler.applicationPersonService.GetAll()
+	logger := logging.FromContext(request.Context())
+
+	applicationPersons, err := handler.applicationPersonService.GetAll(request.Context())
 	if err != nil {
 		errorMessage := "Internal service error while getting all applicationPersons"
-		slog.Error("v1.ApplicationHandler.GetAllApplicationPersons: "+errorMessage, "error", err)
+		logger.Error("v1.ApplicationHandler.GetAllApplicationPersons: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -223,10 +239,16 @@ func (handler *ApplicationPersonHandler) GetAllApplicationPersons(writer http.Re
 
 	applicationPersonsResponse := responses.NewApplicationPersonsResponse(applicationPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, applicationPersonsResponse, "v1.ApplicationPersonHandler.GetAllApplicationPersons")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(applicationPersonsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.ApplicationPersonHandler.GetAllApplicationPersons: Unable to write response", "error", err)
+		logger.Error("v1.ApplicationPersonHandler.GetAllApplicationPersons: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -235,7 +257,78 @@ func (handler *ApplicationPersonHandler) GetAllApplicationPersons(writer http.Re
 		return
 	}
 
-	slog.Info("v1.ApplicationPersonHandler.GetAllApplicationPersons: retrieved all ApplicationPersons successfully")
+	logger.Info("v1.ApplicationPersonHandler.GetAllApplicationPersons: retrieved all ApplicationPersons successfully")
+}
+
+// UpdateApplicationPerson updates the role, notes, start date and end date of a `applicationPerson`
+//
+// @Summary Update the details of a applicationPerson
+// @Description Update the `role`, `notes`, `start_date` and `end_date` of a `applicationPerson`. Details that are not in the request are left unchanged. The `end_date` cannot be before the `start_date`.
+// @Tags applicationPerson
+// @Accept json
+// @Param applicationPerson body requests.UpdateApplicationPersonRequest true "Update applicationPerson request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/application-person/update [post]
+func (handler *ApplicationPersonHandler) UpdateApplicationPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateApplicationPersonRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.ApplicationPersonHandler.UpdateApplicationPerson: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	updateModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.ApplicationPersonHandler.UpdateApplicationPerson: Unable to convert request to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.applicationPersonService.Update(request.Context(), updateModel)
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while updating ApplicationPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.ApplicationPersonHandler.UpdateApplicationPerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info(
+				"v1.ApplicationPersonHandler.UpdateApplicationPerson: NotFoundErr while updating ApplicationPerson",
+				"error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info(
+				"v1.ApplicationPersonHandler.UpdateApplicationPerson: ValidationError while updating ApplicationPerson",
+				"error", err)
+		} else {
+			errorMessage = "Unknown internal error while updating ApplicationPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.ApplicationPersonHandler.UpdateApplicationPerson: "+errorMessage, "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
 }
 
 // DeleteApplicationPerson deletes a `applicationPerson` matching input application UUID and person UUID
@@ -251,9 +344,11 @@ func (handler *ApplicationPersonHandler) GetAllApplicationPersons(writer http.Re
 // @Failure 500
 // @Router /v1/application-person/delete [delete]
 func (handler *ApplicationPersonHandler) DeleteApplicationPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var deleteRequest requests.DeleteApplicationPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&deleteRequest); err != nil {
-		slog.Info("v1.ApplicationPersonHandler.DeleteApplicationPerson: invalid request body", "error", err)
+		logger.Info("v1.ApplicationPersonHandler.DeleteApplicationPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -261,7 +356,7 @@ func (handler *ApplicationPersonHandler) DeleteApplicationPerson(writer http.Res
 	// can return ValidationError
 	deleteModel, err := deleteRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.ApplicationPersonHandler.DeleteApplicationPerson: Unable to convert DeleteApplicationPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -269,7 +364,7 @@ func (handler *ApplicationPersonHandler) DeleteApplicationPerson(writer http.Res
 	}
 
 	if deleteModel == nil {
-		slog.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: DeleteApplicationPerson is nil")
+		logger.Info("v1.ApplicationPersonHandler.AssociateApplicationPerson: DeleteApplicationPerson is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -277,7 +372,7 @@ func (handler *ApplicationPersonHandler) DeleteApplicationPerson(writer http.Res
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	err = handler.applicationPersonService.Delete(deleteModel)
+	err = handler.applicationPersonService.Delete(request.Context(), deleteModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundErr *internalErrors.NotFoundError
@@ -289,23 +384,23 @@ func (handler *ApplicationPersonHandler) DeleteApplicationPerson(writer http.Res
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting ApplicationPerson"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationPersonHandler.DeleteApplicationPerson: "+errorMessage, "error", err)
+			logger.Error("v1.ApplicationPersonHandler.DeleteApplicationPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundErr) {
 			errorMessage = err.Error()
 			status = http.StatusNotFound
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationPersonHandler.DeleteApplicationPerson: NotFoundErr while deleting ApplicationPerson", "error",
 				err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.ApplicationPersonHandler.DeleteApplicationPerson: ValidationError while deleting ApplicationPerson", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating application"
 			status = http.StatusInternalServerError
-			slog.Error("v1.ApplicationPersonHandler.DeleteApplicationPerson: Error while deleting ApplicationPerson", "error", err)
+			logger.Error("v1.ApplicationPersonHandler.DeleteApplicationPerson: Error while deleting ApplicationPerson", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -284,6 +284,96 @@ func TestGetAllApplicationPersons_ShouldReturnNothingIfNothingInDatabase(t *test
 	assert.Len(t, response, 0)
 }
 
+// -------- UpdateApplicationPerson tests: --------
+
+func TestUpdateApplicationPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	applicationPersonHandler, applicationRepository, personRepository, companyRepository :=
+		setupApplicationPersonHandler(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	role := "Hiring manager"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateBytes, err := json.Marshal(requests.AssociateApplicationPersonRequest{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails: requests.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/application-person/associate", bytes.NewReader(associateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationPersonHandler.AssociateApplicationPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var applicationPersonResponse responses.ApplicationPersonResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&applicationPersonResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, role, *applicationPersonResponse.Role)
+	assert.Equal(t, startDate, *applicationPersonResponse.StartDate)
+	assert.Nil(t, applicationPersonResponse.UpdatedDate)
+
+	notes := "Prefers to be contacted by email"
+	updateBytes, err := json.Marshal(requests.UpdateApplicationPersonRequest{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails:   requests.LinkDetails{Notes: &notes},
+	})
+	assert.NoError(t, err)
+
+	request, err = http.NewRequest(http.MethodPost, "/api/v1/application-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder = httptest.NewRecorder()
+	applicationPersonHandler.UpdateApplicationPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
+
+func TestUpdateApplicationPerson_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T) {
+	applicationPersonHandler, _, _, _ := setupApplicationPersonHandler(t)
+
+	updateBytes, err := json.Marshal(requests.UpdateApplicationPersonRequest{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/application-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationPersonHandler.UpdateApplicationPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: nothing to update\n", responseRecorder.Body.String())
+}
+
+func TestUpdateApplicationPerson_ShouldReturnNotFoundIfLinkDoesNotExist(t *testing.T) {
+	applicationPersonHandler, _, _, _ := setupApplicationPersonHandler(t)
+
+	role := "Recruiter"
+	updateBytes, err := json.Marshal(requests.UpdateApplicationPersonRequest{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+		LinkDetails:   requests.LinkDetails{Role: &role},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/application-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	applicationPersonHandler.UpdateApplicationPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
 // -------- DeleteApplicationPerson tests: --------
 
 func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"log/slog"
+	"net/http"
+)
+
+type BulkLinkHandler struct {
+	bulkLinkService *services.BulkLinkService
+}
+
+func NewBulkLinkHandler(bulkLinkService *services.BulkLinkService) *BulkLinkHandler {
+	return &BulkLinkHandler{bulkLinkService: bulkLinkService}
+}
+
+// BulkAssociateApplicationEvents associates up to 100 application event pairs in one transaction
+//
+// @Summary bulk associate application event pairs
+// @Description associate up to 100 application event pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status `conflict` or `invalid`, and the other pairs are still associated. Returns 400 without associating anything if `links` is empty, too long or contains a malformed pair.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkAssociateApplicationEventRequest true "Bulk associate ApplicationEvent request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/application-event/associate/bulk [post]
+func (bulkLinkHandler *BulkLinkHandler) BulkAssociateApplicationEvents(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkAssociateApplicationEvents", (*requests.BulkAssociateApplicationEventRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.AssociateApplicationEvents)
+}
+
+// BulkDeleteApplicationEvents deletes up to 100 application event pairs in one transaction
+//
+// @Summary bulk delete application event pairs
+// @Description delete up to 100 application event pairs in one transaction. A pair that does not exist is reported in its result with the status `notFound`, and the other pairs are still deleted. Returns 400 without deleting anything if `links` is empty, too long or contains a malformed pair.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkDeleteApplicationEventRequest true "Bulk delete ApplicationEvent request"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/application-event/delete/bulk [delete]
+func (bulkLinkHandler *BulkLinkHandler) BulkDeleteApplicationEvents(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkDeleteApplicationEvents", (*requests.BulkDeleteApplicationEventRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.DeleteApplicationEvents)
+}
+
+// BulkAssociateApplicationPersons associates up to 100 application person pairs in one transaction
+//
+// @Summary bulk associate application person pairs
+// @Description associate up to 100 application person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status `conflict` or `invalid`, and the other pairs are still associated. Returns 400 without associating anything if `links` is empty, too long or contains a malformed pair.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkAssociateApplicationPersonRequest true "Bulk associate ApplicationPerson request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/application-person/associate/bulk [post]
+func (bulkLinkHandler *BulkLinkHandler) BulkAssociateApplicationPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkAssociateApplicationPersons", (*requests.BulkAssociateApplicationPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.AssociateApplicationPersons)
+}
+
+// BulkDeleteApplicationPersons deletes up to 100 application person pairs in one transaction
+//
+// @Summary bulk delete application person pairs
+// @Description delete up to 100 application person pairs in one transaction. A pair that does not exist is reported in its result with the status `notFound`, and the other pairs are still deleted. Returns 400 without deleting anything if `links` is empty, too long or contains a malformed pair.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkDeleteApplicationPersonRequest true "Bulk delete ApplicationPerson request"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/application-person/delete/bulk [delete]
+func (bulkLinkHandler *BulkLinkHandler) BulkDeleteApplicationPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkDeleteApplicationPersons", (*requests.BulkDeleteApplicationPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.DeleteApplicationPersons)
+}
+
+// BulkAssociateCompanyEvents associates up to 100 company event pairs in one transaction
+//
+// @Summary bulk associate company event pairs
+// @Description associate up to 100 company event pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status `conflict` or `invalid`, and the other pairs are still associated. Returns 400 without associating anything if `links` is empty, too long or contains a malformed pair.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkAssociateCompanyEventRequest true "Bulk associate CompanyEvent request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/company-event/associate/bulk [post]
+func (bulkLinkHandler *BulkLinkHandler) BulkAssociateCompanyEvents(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkAssociateCompanyEvents", (*requests.BulkAssociateCompanyEventRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.AssociateCompanyEvents)
+}
+
+// BulkDeleteCompanyEvents deletes up to 100 company event pairs in one transaction
+//
+// @Summary bulk delete company event pairs
+// @Description delete up to 100 company event pairs in one transaction. A pair that does not exist is reported in its result with the status `notFound`, and the other pairs are still deleted. Returns 400 without deleting anything if `links` is empty, too long or contains a malformed pair.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkDeleteCompanyEventRequest true "Bulk delete CompanyEvent request"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/company-event/delete/bulk [delete]
+func (bulkLinkHandler *BulkLinkHandler) BulkDeleteCompanyEvents(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkDeleteCompanyEvents", (*requests.BulkDeleteCompanyEventRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.DeleteCompanyEvents)
+}
+
+// BulkAssociateCompanyPersons associates up to 100 company person pairs in one transaction
+//
+// @Summary bulk associate company person pairs
+// @Description associate up to 100 company person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status `conflict` or `invalid`, and the other pairs are still associated. Returns 400 without associating anything if `links` is empty, too long or contains a malformed pair.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkAssociateCompanyPersonRequest true "Bulk associate CompanyPerson request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/company-person/associate/bulk [post]
+func (bulkLinkHandler *BulkLinkHandler) BulkAssociateCompanyPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkAssociateCompanyPersons", (*requests.BulkAssociateCompanyPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.AssociateCompanyPersons)
+}
+
+// BulkDeleteCompanyPersons deletes up to 100 company person pairs in one transaction
+//
+// @Summary bulk delete company person pairs
+// @Description delete up to 100 company person pairs in one transaction. A pair that does not exist is reported in its result with the status `notFound`, and the other pairs are still deleted. Returns 400 without deleting anything if `links` is empty, too long or contains a malformed pair.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkDeleteCompanyPersonRequest true "Bulk delete CompanyPerson request"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/company-person/delete/bulk [delete]
+func (bulkLinkHandler *BulkLinkHandler) BulkDeleteCompanyPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkDeleteCompanyPersons", (*requests.BulkDeleteCompanyPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.DeleteCompanyPersons)
+}
+
+// BulkAssociateEventPersons associates up to 100 event person pairs in one transaction
+//
+// @Summary bulk associate event person pairs
+// @Description associate up to 100 event person pairs in one transaction. A pair that already exists, refers to something that does not exist, or is otherwise invalid is reported in its result with the status `conflict` or `invalid`, and the other pairs are still associated. Returns 400 without associating anything if `links` is empty, too long or contains a malformed pair.
+// @Tags event
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkAssociateEventPersonRequest true "Bulk associate EventPerson request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/event-person/associate/bulk [post]
+func (bulkLinkHandler *BulkLinkHandler) BulkAssociateEventPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkAssociateEventPersons", (*requests.BulkAssociateEventPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.AssociateEventPersons)
+}
+
+// BulkDeleteEventPersons deletes up to 100 event person pairs in one transaction
+//
+// @Summary bulk delete event person pairs
+// @Description delete up to 100 event person pairs in one transaction. A pair that does not exist is reported in its result with the status `notFound`, and the other pairs are still deleted. Returns 400 without deleting anything if `links` is empty, too long or contains a malformed pair.
+// @Tags event
+// @Accept json
+// @Produce json
+// @Param links body requests.BulkDeleteEventPersonRequest true "Bulk delete EventPerson request"
+// @Success 200 {object} responses.BulkLinkResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/event-person/delete/bulk [delete]
+func (bulkLinkHandler *BulkLinkHandler) BulkDeleteEventPersons(
+	writer http.ResponseWriter, request *http.Request) {
+
+	handleBulkLinks(
+		writer, request, "BulkDeleteEventPersons", (*requests.BulkDeleteEventPersonRequest).ToModels,
+		bulkLinkHandler.bulkLinkService.DeleteEventPersons)
+}
+
+// handleBulkLinks decodes a bulk request, converts it with toModels, applies the links with apply and writes the
+// result of each link.
+func handleBulkLinks[Request any, Model any](
+	writer http.ResponseWriter,
+	request *http.Request,
+	method string,
+	toModels func(bulkRequest *Request) ([]*Model, error),
+	apply func(ctx context.Context, links []*Model) ([]*models.BulkLinkResult, error)) {
+
+	logger := logging.FromContext(request.Context())
+
+	var bulkRequest Request
+	if err := json.NewDecoder(request.Body).Decode(&bulkRequest); err != nil {
+		logger.Info("v1.BulkLinkHandler."+method+": invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	links, err := toModels(&bulkRequest)
+	if err != nil {
+		logger.Info("v1.BulkLinkHandler."+method+": Unable to convert request to models", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	results, err := apply(request.Context(), links)
+	if err != nil {
+		writeBulkLinkError(writer, logger, method, err)
+		return
+	}
+
+	// can return InternalServiceError
+	bulkLinkResponse, err := responses.NewBulkLinkResponse(results)
+	if err != nil {
+		logger.Error("v1.BulkLinkHandler."+method+": Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(writer).Encode(bulkLinkResponse); err != nil {
+		logger.Error("v1.BulkLinkHandler."+method+": Unable to write response", "error", err)
+	}
+}
+
+func writeBulkLinkError(writer http.ResponseWriter, logger *slog.Logger, method string, err error) {
+	var internalServiceErr *internalErrors.InternalServiceError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &validationErr) {
+		logger.Info("v1.BulkLinkHandler."+method+": ValidationError while applying links", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage := "Internal service error while applying links"
+		logger.Error("v1.BulkLinkHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	} else {
+		errorMessage := "Unknown internal error while applying links"
+		logger.Error("v1.BulkLinkHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	}
+}
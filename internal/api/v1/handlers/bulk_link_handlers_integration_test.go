@@ -0,0 +1,167 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBulkLinkHandler(t *testing.T) (
+	*handlers.BulkLinkHandler,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository,
+	*repositories.CompanyPersonRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupBulkLinkHandlerTestContainer(t, config)
+
+	var bulkLinkHandler *handlers.BulkLinkHandler
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	var companyPersonRepository *repositories.CompanyPersonRepository
+	err := container.Invoke(func(
+		handler *handlers.BulkLinkHandler,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository,
+		companyPerson *repositories.CompanyPersonRepository) {
+
+		bulkLinkHandler = handler
+		companyRepository = company
+		personRepository = person
+		companyPersonRepository = companyPerson
+	})
+	assert.NoError(t, err)
+
+	return bulkLinkHandler, companyRepository, personRepository, companyPersonRepository
+}
+
+func sendBulkLinkRequest(
+	t *testing.T, handlerFunc http.HandlerFunc, method string, url string, body any) *httptest.ResponseRecorder {
+
+	var requestBody bytes.Buffer
+	assert.NoError(t, json.NewEncoder(&requestBody).Encode(body))
+
+	request, err := http.NewRequest(method, url, &requestBody)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- BulkAssociateCompanyPersons tests: --------
+
+func TestBulkAssociateCompanyPersons_ShouldReturnResultForEachLink(t *testing.T) {
+	bulkLinkHandler, companyRepository, personRepository, companyPersonRepository := setupBulkLinkHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	existingPerson := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	newPerson := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, existingPerson.ID, nil)
+
+	responseRecorder := sendBulkLinkRequest(
+		t, bulkLinkHandler.BulkAssociateCompanyPersons, http.MethodPost, "/api/v1/company-person/associate/bulk",
+		requests.BulkAssociateCompanyPersonRequest{
+			Links: []*requests.AssociateCompanyPersonRequest{
+				{CompanyID: company.ID, PersonID: newPerson.ID},
+				{CompanyID: company.ID, PersonID: existingPerson.ID},
+				{CompanyID: uuid.New(), PersonID: newPerson.ID},
+			},
+		})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var bulkLinkResponse responses.BulkLinkResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&bulkLinkResponse))
+	assert.Equal(t, 1, bulkLinkResponse.Succeeded)
+	assert.Equal(t, 2, bulkLinkResponse.Failed)
+	assert.Len(t, bulkLinkResponse.Results, 3)
+	assert.Equal(t, "associated", bulkLinkResponse.Results[0].Status)
+	assert.Equal(t, "conflict", bulkLinkResponse.Results[1].Status)
+	assert.Equal(t, 2, bulkLinkResponse.Results[2].Index)
+	assert.Equal(t, "invalid", bulkLinkResponse.Results[2].Status)
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or PersonID': CompanyID or PersonID does not exist",
+		*bulkLinkResponse.Results[2].Message)
+
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, companyPersons, 2)
+}
+
+func TestBulkAssociateCompanyPersons_ShouldReturnBadRequestForMalformedLink(t *testing.T) {
+	bulkLinkHandler, companyRepository, personRepository, companyPersonRepository := setupBulkLinkHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	responseRecorder := sendBulkLinkRequest(
+		t, bulkLinkHandler.BulkAssociateCompanyPersons, http.MethodPost, "/api/v1/company-person/associate/bulk",
+		requests.BulkAssociateCompanyPersonRequest{
+			Links: []*requests.AssociateCompanyPersonRequest{
+				{CompanyID: company.ID, PersonID: person.ID},
+				{CompanyID: company.ID},
+			},
+		})
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t, "validation error on field 'links[1]': PersonID is invalid\n", responseRecorder.Body.String())
+
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, companyPersons, 0)
+}
+
+// -------- BulkDeleteCompanyPersons tests: --------
+
+func TestBulkDeleteCompanyPersons_ShouldReturnResultForEachLink(t *testing.T) {
+	bulkLinkHandler, companyRepository, personRepository, companyPersonRepository := setupBulkLinkHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, person.ID, nil)
+
+	responseRecorder := sendBulkLinkRequest(
+		t, bulkLinkHandler.BulkDeleteCompanyPersons, http.MethodDelete, "/api/v1/company-person/delete/bulk",
+		requests.BulkDeleteCompanyPersonRequest{
+			Links: []*requests.DeleteCompanyPersonRequest{
+				{CompanyID: company.ID, PersonID: person.ID},
+				{CompanyID: company.ID, PersonID: person.ID},
+			},
+		})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var bulkLinkResponse responses.BulkLinkResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&bulkLinkResponse))
+	assert.Equal(t, 1, bulkLinkResponse.Succeeded)
+	assert.Equal(t, 1, bulkLinkResponse.Failed)
+	assert.Equal(t, "deleted", bulkLinkResponse.Results[0].Status)
+	assert.Equal(t, "notFound", bulkLinkResponse.Results[1].Status)
+}
+
+func TestBulkDeleteCompanyPersons_ShouldReturnBadRequestForEmptyLinks(t *testing.T) {
+	bulkLinkHandler, _, _, _ := setupBulkLinkHandler(t)
+
+	responseRecorder := sendBulkLinkRequest(
+		t, bulkLinkHandler.BulkDeleteCompanyPersons, http.MethodDelete, "/api/v1/company-person/delete/bulk",
+		requests.BulkDeleteCompanyPersonRequest{})
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error on field 'Links': Links is empty\n", responseRecorder.Body.String())
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// changeStreamKeepAliveInterval is how long the change stream may be silent before a comment is sent, so that proxies
+// do not close an idle connection.
+const changeStreamKeepAliveInterval = 15 * time.Second
+
+type ChangeHandler struct {
+	changeService *services.ChangeService
+}
+
+func NewChangeHandler(changeService *services.ChangeService) *ChangeHandler {
+	return &ChangeHandler{changeService: changeService}
+}
+
+// StreamChanges pushes changes to records and links as server-sent events
+//
+// @Summary Stream changes
+// @Description Stream the creates, updates and deletes of `application`s, `company`s, `event`s and `person`s, and the associates, updates and deletes of the links between them, as server-sent events. Each event has the `sequence` of the change as its `id` and a `ChangeResponse` as its `data`. Without `Last-Event-ID` the stream starts with the next change. A client that reconnects with `Last-Event-ID`, as `EventSource` does, receives every change after that sequence. `last_event_id` can be used instead of the header for the first connection. Comments are sent when the stream is idle, to keep the connection open.
+// @Tags change
+// @Produce text/event-stream
+// @Param entity_type query string false "Comma-separated entity types, e.g. application,company-person. Default all"
+// @Param Last-Event-ID header integer false "Sequence of the last change received"
+// @Param last_event_id query integer false "Sequence of the last change received, if Last-Event-ID is not set"
+// @Success 200 {object} responses.ChangeResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/changes/stream [get]
+func (changeHandler *ChangeHandler) StreamChanges(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	filter := models.ChangeFilter{EntityTypes: parseEntityTypesParam(request.URL.Query()["entity_type"])}
+
+	lastEventID := request.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = request.URL.Query().Get("last_event_id")
+	}
+
+	var lastSequence *int64
+	if lastEventID != "" {
+		sequence, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			logger.Info("v1.ChangeHandler.StreamChanges: Last-Event-ID is not an integer", "error", err)
+			http.Error(writer, "Last-Event-ID must be an integer", http.StatusBadRequest)
+			return
+		}
+		lastSequence = &sequence
+	}
+
+	responseController := http.NewResponseController(writer)
+
+	// The stream stays open for longer than the write timeout of the server.
+	if err := responseController.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Debug("v1.ChangeHandler.StreamChanges: Unable to clear the write deadline", "error", err)
+	}
+
+	isStreaming := false
+	lastWrite := time.Now()
+
+	// can return InternalServiceError, ValidationError
+	err := changeHandler.changeService.Watch(
+		request.Context(), &filter, lastSequence, func(changes []*models.Change) error {
+			if !isStreaming {
+				writer.Header().Set("Content-Type", "text/event-stream")
+				writer.Header().Set("Cache-Control", "no-cache")
+				writer.Header().Set("Connection", "keep-alive")
+				writer.WriteHeader(http.StatusOK)
+				isStreaming = true
+			} else if len(changes) == 0 && time.Since(lastWrite) < changeStreamKeepAliveInterval {
+				return nil
+			}
+
+			if len(changes) == 0 {
+				if _, err := fmt.Fprint(writer, ": keep-alive\n\n"); err != nil {
+					return err
+				}
+			}
+
+			for _, change := range changes {
+				// can return InternalServiceError
+				changeResponse, err := responses.NewChangeResponse(change)
+				if err != nil {
+					return err
+				}
+
+				data, err := json.Marshal(changeResponse)
+				if err != nil {
+					return internalErrors.NewInternalServiceError("Error encoding change: " + err.Error())
+				}
+
+				if _, err = fmt.Fprintf(writer, "id: %d\ndata: %s\n\n", change.Sequence, data); err != nil {
+					return err
+				}
+			}
+
+			lastWrite = time.Now()
+			return responseController.Flush()
+		})
+
+	if err == nil {
+		logger.Info("v1.ChangeHandler.StreamChanges: Stream closed")
+		return
+	}
+
+	if isStreaming {
+		// The status has been sent, so the error can only end the stream. The client reconnects and resumes.
+		logger.Info("v1.ChangeHandler.StreamChanges: Stream ended by error", "error", err)
+		return
+	}
+
+	var validationErr *internalErrors.ValidationError
+	if errors.As(err, &validationErr) {
+		logger.Info("v1.ChangeHandler.StreamChanges: ValidationError while streaming changes", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	errorMessage := "Internal service error while streaming changes"
+	logger.Error("v1.ChangeHandler.StreamChanges: "+errorMessage, "error", err)
+	http.Error(writer, errorMessage, http.StatusInternalServerError)
+}
+
+// parseEntityTypesParam accepts both repeated and comma-separated values.
+func parseEntityTypesParam(values []string) []models.ChangeEntityType {
+	var entityTypes []models.ChangeEntityType
+	for _, value := range values {
+		for _, entityType := range strings.Split(value, ",") {
+			entityType = strings.TrimSpace(entityType)
+			if entityType != "" {
+				entityTypes = append(entityTypes, models.ChangeEntityType(entityType))
+			}
+		}
+	}
+	return entityTypes
+}
@@ -0,0 +1,187 @@
+package handlers_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupChangeHandler(t *testing.T) (
+	*handlers.ChangeHandler,
+	*services.ChangeService,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository,
+	*repositories.CompanyPersonRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		ChangeStreamPollIntervalMilliseconds: 10,
+	}
+	container := dependencyinjection.SetupChangeHandlerTestContainer(t, config)
+
+	var changeHandler *handlers.ChangeHandler
+	var changeService *services.ChangeService
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	var companyPersonRepository *repositories.CompanyPersonRepository
+	err := container.Invoke(func(
+		handler *handlers.ChangeHandler,
+		service *services.ChangeService,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository,
+		companyPerson *repositories.CompanyPersonRepository) {
+
+		changeHandler = handler
+		changeService = service
+		companyRepository = company
+		personRepository = person
+		companyPersonRepository = companyPerson
+	})
+	assert.NoError(t, err)
+
+	return changeHandler, changeService, companyRepository, personRepository, companyPersonRepository
+}
+
+// readChangeEvents reads server-sent events from body until count changes have been received.
+func readChangeEvents(t *testing.T, body io.Reader, count int) ([]string, []*responses.ChangeResponse) {
+	var ids []string
+	var changes []*responses.ChangeResponse
+
+	scanner := bufio.NewScanner(body)
+	for len(changes) < count && scanner.Scan() {
+		line := scanner.Text()
+		if id, isID := strings.CutPrefix(line, "id: "); isID {
+			ids = append(ids, id)
+		} else if data, isData := strings.CutPrefix(line, "data: "); isData {
+			var change responses.ChangeResponse
+			assert.NoError(t, json.Unmarshal([]byte(data), &change))
+			changes = append(changes, &change)
+		}
+	}
+	assert.NoError(t, scanner.Err())
+
+	return ids, changes
+}
+
+// -------- StreamChanges tests: --------
+
+func TestStreamChanges_ShouldStreamChangesAfterLastEventID(t *testing.T) {
+	changeHandler, changeService, companyRepository, personRepository, companyPersonRepository :=
+		setupChangeHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	server := httptest.NewServer(http.HandlerFunc(changeHandler.StreamChanges))
+	defer server.Close()
+	defer changeService.Close()
+
+	request, err := http.NewRequest(
+		http.MethodGet, server.URL+"/api/v1/changes/stream?entity_type=company,company-person", nil)
+	assert.NoError(t, err)
+	request.Header.Set("Last-Event-ID", "0")
+
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+	// made after the stream has started, so it is pushed by a later poll
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, person.ID, nil)
+
+	ids, changes := readChangeEvents(t, response.Body, 2)
+	assert.Equal(t, []string{"1", "3"}, ids)
+	assert.Len(t, changes, 2)
+
+	assert.Equal(t, int64(1), changes[0].Sequence)
+	assert.Equal(t, models.ChangeEntityTypeCompany, changes[0].EntityType)
+	assert.Equal(t, models.ChangeTypeCreated, changes[0].ChangeType)
+	assert.Equal(t, company.ID, changes[0].EntityID)
+	assert.Nil(t, changes[0].RelatedEntityID)
+
+	assert.Equal(t, models.ChangeEntityTypeCompanyPerson, changes[1].EntityType)
+	assert.Equal(t, models.ChangeTypeAssociated, changes[1].ChangeType)
+	assert.Equal(t, company.ID, changes[1].EntityID)
+	assert.Equal(t, person.ID, *changes[1].RelatedEntityID)
+}
+
+func TestStreamChanges_ShouldEndStreamWhenServiceIsClosed(t *testing.T) {
+	changeHandler, changeService, _, _, _ := setupChangeHandler(t)
+
+	server := httptest.NewServer(http.HandlerFunc(changeHandler.StreamChanges))
+	defer server.Close()
+
+	response, err := http.Get(server.URL + "/api/v1/changes/stream")
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	changeService.Close()
+
+	// the body ends instead of blocking until the client gives up
+	_, err = io.ReadAll(response.Body)
+	assert.NoError(t, err)
+}
+
+func TestStreamChanges_ShouldReturnBadRequest(t *testing.T) {
+	changeHandler, _, _, _, _ := setupChangeHandler(t)
+
+	tests := []struct {
+		testName        string
+		url             string
+		lastEventID     string
+		expectedMessage string
+	}{
+		{
+			"invalid entity type",
+			"/api/v1/changes/stream?entity_type=company,job-ad",
+			"",
+			"validation error on field 'entity_type': 'job-ad' is not a valid entity type",
+		},
+		{
+			"Last-Event-ID is not an integer",
+			"/api/v1/changes/stream",
+			"abc",
+			"Last-Event-ID must be an integer",
+		},
+		{
+			"negative last_event_id",
+			"/api/v1/changes/stream?last_event_id=-5",
+			"",
+			"validation error on field 'Last-Event-ID': Last-Event-ID cannot be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodGet, test.url, nil)
+			assert.NoError(t, err)
+			if test.lastEventID != "" {
+				request.Header.Set("Last-Event-ID", test.lastEventID)
+			}
+
+			responseRecorder := httptest.NewRecorder()
+			changeHandler.StreamChanges(responseRecorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Contains(t, responseRecorder.Body.String(), test.expectedMessage)
+		})
+	}
+}
@@ -1,8 +1,17 @@
 package handlers
 
 import (
+	"errors"
+	"hash/fnv"
 	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -24,3 +33,127 @@ func GetExtraDataTypeParam(urlParamValue string) (*models.IncludeExtraDataType,
 
 	return &includeApplicationsTypeModel, err
 }
+
+// GetForceParam returns false if urlParamValue is empty.
+//
+// GetForceParam can return ValidationError
+func GetForceParam(urlParamValue string) (bool, error) {
+	if urlParamValue == "" {
+		return false, nil
+	}
+
+	force, err := strconv.ParseBool(urlParamValue)
+	if err != nil {
+		forceString := "force"
+		return false, internalErrors.NewValidationError(&forceString, "force must be 'true' or 'false'")
+	}
+
+	return force, nil
+}
+
+// FormatETag returns the strong entity tag of a record with the given version.
+func FormatETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// FormatResponseETag returns the entity tag of the response to request with a record of the given version. A response
+// with only the attributes in the fields query parameter is not the whole record, so it gets a weak entity tag, which
+// If-Match does not accept. The weak entity tag includes a hash of the sorted fields, so that a cached response with
+// other fields does not match.
+// The entity tag only changes with version, so it must not be used for a response that includes related records
+// whose changes do not increment version.
+func FormatResponseETag(request *http.Request, version int) string {
+	fields := GetFieldsParam(request.URL.Query())
+	if len(fields) == 0 {
+		return FormatETag(version)
+	}
+
+	slices.Sort(fields)
+	fieldsHash := fnv.New32a()
+	_, _ = fieldsHash.Write([]byte(strings.Join(slices.Compact(fields), ",")))
+
+	return `W/"` + strconv.Itoa(version) + "-" + strconv.FormatUint(uint64(fieldsHash.Sum32()), 16) + `"`
+}
+
+// GetIfMatchVersion returns the version in an If-Match header, or nil if the header is empty or "*". Only a single
+// strong entity tag, as set by FormatETag, is accepted.
+//
+// GetIfMatchVersion can return ValidationError
+func GetIfMatchVersion(headerValue string) (*int, error) {
+	headerValue = strings.TrimSpace(headerValue)
+	if headerValue == "" || headerValue == "*" {
+		return nil, nil
+	}
+
+	ifMatchString := "If-Match"
+	unquoted, isQuoted := strings.CutPrefix(headerValue, `"`)
+	unquoted, hasClosingQuote := strings.CutSuffix(unquoted, `"`)
+	if !isQuoted || !hasClosingQuote {
+		return nil, internalErrors.NewValidationError(
+			&ifMatchString, "If-Match must be a single strong entity tag, as returned in the ETag header")
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version < 1 {
+		return nil, internalErrors.NewValidationError(&ifMatchString, "If-Match is not an entity tag of this server")
+	}
+
+	return &version, nil
+}
+
+// IsNotModified reports whether an If-None-Match header matches etag. Weak and strong entity tags are compared in the
+// same way, as the header only guards reads.
+func IsNotModified(request *http.Request, etag string) bool {
+	headerValue := request.Header.Get("If-None-Match")
+	if headerValue == "" {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(headerValue, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFieldsParam returns the attributes named in the fields query parameter, which can be repeated or
+// comma-separated. nil means every attribute.
+func GetFieldsParam(query url.Values) []string {
+	var fields []string
+	for _, value := range query["fields"] {
+		for _, field := range strings.Split(value, ",") {
+			field = strings.TrimSpace(field)
+			if field != "" {
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
+
+// SelectRequestedFields applies the fields query parameter of request to response, as described in
+// responses.SelectFields. If the parameter is invalid, the error response is written and false is returned.
+func SelectRequestedFields(
+	writer http.ResponseWriter, request *http.Request, response any, caller string) (any, bool) {
+
+	logger := logging.FromContext(request.Context())
+
+	// can return InternalServiceError, ValidationError
+	selectedResponse, err := responses.SelectFields(response, GetFieldsParam(request.URL.Query()))
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info(caller+": Invalid fields param", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+		} else {
+			logger.Error(caller+": Unable to select fields", "error", err)
+			http.Error(writer, "Error: Unable to select fields of response", http.StatusInternalServerError)
+		}
+		return nil, false
+	}
+
+	return selectedResponse, true
+}
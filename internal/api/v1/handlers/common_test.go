@@ -3,6 +3,9 @@ package handlers
 import (
 	"errors"
 	"jobsearchtracker/internal/models"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	internalErrors "jobsearchtracker/internal/errors"
@@ -54,3 +57,111 @@ func TestGetIncludeExtraDataParam_ShouldReturnValidationErrIfUrlParamValueIsNotM
 	assert.True(t, errors.As(err, &validationError))
 	assert.Equal(t, "validation error: invalid Type 'names'", validationError.Error())
 }
+
+func TestGetForceParam_ShouldReturnFalseIfEmpty(t *testing.T) {
+	force, err := GetForceParam("")
+	assert.NoError(t, err)
+	assert.False(t, force)
+}
+
+func TestGetForceParam_ShouldParseBooleans(t *testing.T) {
+	force, err := GetForceParam("true")
+	assert.NoError(t, err)
+	assert.True(t, force)
+
+	force, err = GetForceParam("FALSE")
+	assert.NoError(t, err)
+	assert.False(t, force)
+}
+
+func TestGetForceParam_ShouldReturnValidationErrorOnInvalidValue(t *testing.T) {
+	force, err := GetForceParam("yes")
+	assert.False(t, force)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'force': force must be 'true' or 'false'", validationError.Error())
+}
+
+func TestFormatETag_ShouldQuoteVersion(t *testing.T) {
+	assert.Equal(t, `"12"`, FormatETag(12))
+}
+
+func TestFormatResponseETag_ShouldBeWeakIfFieldsAreSelected(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/1", nil)
+	assert.Equal(t, `"12"`, FormatResponseETag(request, 12))
+
+	request = httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/1?fields=name", nil)
+	assert.True(t, strings.HasPrefix(FormatResponseETag(request, 12), `W/"12-`))
+}
+
+func TestFormatResponseETag_ShouldDependOnSelectedFieldsButNotOnTheirOrder(t *testing.T) {
+	formatETag := func(target string) string {
+		return FormatResponseETag(httptest.NewRequest(http.MethodGet, target, nil), 12)
+	}
+
+	nameAndNotes := formatETag("/api/v1/company/get/id/1?fields=name,notes")
+	assert.Equal(t, nameAndNotes, formatETag("/api/v1/company/get/id/1?fields=notes&fields=name"))
+	assert.NotEqual(t, nameAndNotes, formatETag("/api/v1/company/get/id/1?fields=name"))
+	assert.NotEqual(t, nameAndNotes, FormatResponseETag(
+		httptest.NewRequest(http.MethodGet, "/api/v1/company/get/id/1?fields=name,notes", nil), 13))
+}
+
+func TestGetIfMatchVersion_ShouldReturnNilIfEmptyOrWildcard(t *testing.T) {
+	version, err := GetIfMatchVersion("")
+	assert.NoError(t, err)
+	assert.Nil(t, version)
+
+	version, err = GetIfMatchVersion("*")
+	assert.NoError(t, err)
+	assert.Nil(t, version)
+}
+
+func TestGetIfMatchVersion_ShouldReturnVersionOfStrongETag(t *testing.T) {
+	version, err := GetIfMatchVersion(` "3" `)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *version)
+}
+
+func TestGetIfMatchVersion_ShouldReturnValidationErrorIfHeaderIsInvalid(t *testing.T) {
+	for _, headerValue := range []string{`W/"3"`, "3", `"3", "4"`, `"abc"`, `"0"`} {
+		version, err := GetIfMatchVersion(headerValue)
+		assert.Nil(t, version, headerValue)
+
+		var validationError *internalErrors.ValidationError
+		assert.True(t, errors.As(err, &validationError), headerValue)
+	}
+}
+
+func TestIsNotModified_ShouldCompareIfNoneMatchWithETag(t *testing.T) {
+	tests := []struct {
+		ifNoneMatch string
+		expected    bool
+	}{
+		{"", false},
+		{`"2"`, true},
+		{`W/"2"`, true},
+		{`"1", "2"`, true},
+		{"*", true},
+		{`"1"`, false},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		if test.ifNoneMatch != "" {
+			request.Header.Set("If-None-Match", test.ifNoneMatch)
+		}
+		assert.Equal(t, test.expected, IsNotModified(request, `"2"`), test.ifNoneMatch)
+		assert.Equal(t, test.expected, IsNotModified(request, `W/"2"`), test.ifNoneMatch)
+	}
+}
+
+func TestGetFieldsParam_ShouldSplitRepeatedAndCommaSeparatedValues(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/?fields=name,%20notes,&fields=email", nil)
+	assert.Equal(t, []string{"name", "notes", "email"}, GetFieldsParam(request.URL.Query()))
+}
+
+func TestGetFieldsParam_ShouldReturnNilIfNotSet(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	assert.Nil(t, GetFieldsParam(request.URL.Query()))
+}
@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,15 +29,18 @@ func NewCompanyEventHandler(companyEventService *services.CompanyEventService) *
 // @Accept json
 // @Produce json
 // @Param company body requests.AssociateCompanyEventRequest true "Associate Company Event request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.CompanyEventResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company-event/associate [post]
 func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createCompanyEventRequest requests.AssociateCompanyEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&createCompanyEventRequest); err != nil {
-		slog.Info("v1.CompanyEventHandler.AssociateCompanyEvent: invalid request body", "error", err)
+		logger.Info("v1.CompanyEventHandler.AssociateCompanyEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -45,7 +48,7 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 	// can return ValidationError
 	createCompanyEventModel, err := createCompanyEventRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.CompanyEventHandler.AssociateCompanyEvent: Unable to convert CreateCompanyEventRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -53,7 +56,7 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 	}
 
 	if createCompanyEventModel == nil {
-		slog.Info("v1.CompanyEventHandler.AssociateCompanyEvent: CreateCompanyEvent model is nil")
+		logger.Info("v1.CompanyEventHandler.AssociateCompanyEvent: CreateCompanyEvent model is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,8 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	companyEventModel, err := handler.companyEventService.AssociateCompanyEvent(createCompanyEventModel)
+	companyEventModel, err := handler.companyEventService.AssociateCompanyEvent(
+		request.Context(), createCompanyEventModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -74,21 +78,21 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.CompanyEventHandler.AssociateCompanyEvent: ConflictError creating record", "error", err)
+			logger.Info("v1.CompanyEventHandler.AssociateCompanyEvent: ConflictError creating record", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while associating event to company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyEventHandler.AssociateCompanyEvent: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyEventHandler.AssociateCompanyEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.CompanyEventHandler.AssociateCompanyEvent: ValidationError while associating event to company",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while associating event to company"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.CompanyEventHandler.AssociateCompanyEvent: Error while associating event to company",
 				"error", err)
 		}
@@ -105,7 +109,7 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 	err = json.NewEncoder(writer).Encode(response)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.EventHandler.AssociateCompanyEvent: Unable to write response", "error", err)
+		logger.Error("v1.EventHandler.AssociateCompanyEvent: Unable to write response", "error", err)
 		http.Error(writer, "Event created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -120,19 +124,22 @@ func (handler *CompanyEventHandler) AssociateCompanyEvent(writer http.ResponseWr
 // @Produce json
 // @Param company-id query string false "company ID" format(uuid)
 // @Param event-id query string false "event ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. company_id,role. Default all"
 // @Success 200 {array} responses.CompanyEventResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company-event/get/ [get]
 func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 	companyIDString := query.Get("company-id")
 	eventIDString := query.Get("event-id")
 
 	if companyIDString == "" && eventIDString == "" {
 		errorMessage := "CompanyID and/or EventID are required"
-		slog.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
+		logger.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -146,7 +153,7 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 		companyIDValue, err := uuid.Parse(companyIDString)
 		if err != nil || companyIDValue == uuid.Nil {
 			errorMessage := "Unable to parse CompanyID"
-			slog.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
+			logger.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -161,7 +168,7 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 		eventIDValue, err := uuid.Parse(eventIDString)
 		if err != nil || eventIDValue == uuid.Nil {
 			errorMessage := "Unable to parse EventID"
-			slog.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
+			logger.Info("v1.CompanyEventHandler.GetCompanyEventsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -171,10 +178,10 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 		eventID = &eventIDValue
 	}
 
-	companyEvents, err := handler.companyEventService.GetByID(companyID, eventID)
+	companyEvents, err := handler.companyEventService.GetByID(request.Context(), companyID, eventID)
 	if err != nil {
 		errorMessage := "Internal service error while getting companyEvents by ID"
-		slog.Error("v1.CompanyEventHandler.GetCompanyEventsByID: "+errorMessage, "error", err)
+		logger.Error("v1.CompanyEventHandler.GetCompanyEventsByID: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -184,10 +191,16 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 
 	response := responses.NewCompanyEventsResponse(companyEvents)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, response, "v1.CompanyEventHandler.GetCompanyEventsByID")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(response)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.CompanyEventHandler.GetCompanyEventsByID: Unable to write response", "error", err)
+		logger.Error("v1.CompanyEventHandler.GetCompanyEventsByID: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -196,7 +209,7 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 		return
 	}
 
-	slog.Info("v1.CompanyEventHandler.GetCompanyEventsByID: retrieved all companies successfully")
+	logger.Info("v1.CompanyEventHandler.GetCompanyEventsByID: retrieved all companies successfully")
 }
 
 // GetAllCompanyEvents retrieves all companyEvents.
@@ -205,15 +218,18 @@ func (handler *CompanyEventHandler) GetCompanyEventsByID(writer http.ResponseWri
 // @Description Get all `companyEvent`s
 // @Tags companyEvent
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. company_id,role. Default all"
 // @Success 200 {array} responses.CompanyEventResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/company-event/get/all [get]
 func (handler *CompanyEventHandler) GetAllCompanyEvents(writer http.ResponseWriter, request *http.Request) {
-	companyEvents, err := handler.companyEventService.GetAll()
+	logger := logging.FromContext(request.Context())
+
+	companyEvents, err := handler.companyEventService.GetAll(request.Context())
 	if err != nil {
 		errorMessage := "Internal service error while getting all companyEvents"
-		slog.Error("v1.CompanyHandler.GetAllCompanyEvents: "+errorMessage, "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanyEvents: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -223,10 +239,16 @@ func (handler *CompanyEventHandler) GetAllCompanyEvents(writer http.ResponseWrit
 
 	companyEventsResponse := responses.NewCompanyEventsResponse(companyEvents)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, companyEventsResponse, "v1.CompanyEventHandler.GetAllCompanyEvents")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(companyEventsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.CompanyEventHandler.GetAllCompanyEvents: Unable to write response", "error", err)
+		logger.Error("v1.CompanyEventHandler.GetAllCompanyEvents: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -235,7 +257,78 @@ func (handler *CompanyEventHandler) GetAllCompanyEvents(writer http.ResponseWrit
 		return
 	}
 
-	slog.Info("v1.CompanyEventHandler.GetAllCompanyEvents: retrieved all CompanyEvents successfully")
+	logger.Info("v1.CompanyEventHandler.GetAllCompanyEvents: retrieved all CompanyEvents successfully")
+}
+
+// UpdateCompanyEvent updates the role, notes, start date and end date of a `companyEvent`
+//
+// @Summary Update the details of a companyEvent
+// @Description Update the `role`, `notes`, `start_date` and `end_date` of a `companyEvent`. Details that are not in the request are left unchanged. The `end_date` cannot be before the `start_date`.
+// @Tags companyEvent
+// @Accept json
+// @Param companyEvent body requests.UpdateCompanyEventRequest true "Update companyEvent request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/company-event/update [post]
+func (handler *CompanyEventHandler) UpdateCompanyEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateCompanyEventRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.CompanyEventHandler.UpdateCompanyEvent: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	updateModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.CompanyEventHandler.UpdateCompanyEvent: Unable to convert request to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.companyEventService.Update(request.Context(), updateModel)
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while updating CompanyEvent"
+			status = http.StatusInternalServerError
+			logger.Error("v1.CompanyEventHandler.UpdateCompanyEvent: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info(
+				"v1.CompanyEventHandler.UpdateCompanyEvent: NotFoundErr while updating CompanyEvent",
+				"error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info(
+				"v1.CompanyEventHandler.UpdateCompanyEvent: ValidationError while updating CompanyEvent",
+				"error", err)
+		} else {
+			errorMessage = "Unknown internal error while updating CompanyEvent"
+			status = http.StatusInternalServerError
+			logger.Error("v1.CompanyEventHandler.UpdateCompanyEvent: "+errorMessage, "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
 }
 
 // DeleteCompanyEvent deletes a `companyEvent` matching input company UUID and event UUID
@@ -251,9 +344,11 @@ func (handler *CompanyEventHandler) GetAllCompanyEvents(writer http.ResponseWrit
 // @Failure 500
 // @Router /v1/company-event/delete [delete]
 func (handler *CompanyEventHandler) DeleteCompanyEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var deleteRequest requests.DeleteCompanyEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&deleteRequest); err != nil {
-		slog.Info("v1.CompanyEventHandler.DeleteCompanyEvent: invalid request body", "error", err)
+		logger.Info("v1.CompanyEventHandler.DeleteCompanyEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -261,7 +356,7 @@ func (handler *CompanyEventHandler) DeleteCompanyEvent(writer http.ResponseWrite
 	// can return ValidationError
 	deleteModel, err := deleteRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.CompanyEventHandler.DeleteCompanyEvent: Unable to convert DeleteCompanyEventRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -269,7 +364,7 @@ func (handler *CompanyEventHandler) DeleteCompanyEvent(writer http.ResponseWrite
 	}
 
 	if deleteModel == nil {
-		slog.Info("v1.CompanyEventHandler.AssociateCompanyEvent: DeleteCompanyEvent is nil")
+		logger.Info("v1.CompanyEventHandler.AssociateCompanyEvent: DeleteCompanyEvent is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -277,7 +372,7 @@ func (handler *CompanyEventHandler) DeleteCompanyEvent(writer http.ResponseWrite
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	err = handler.companyEventService.Delete(deleteModel)
+	err = handler.companyEventService.Delete(request.Context(), deleteModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundErr *internalErrors.NotFoundError
@@ -289,23 +384,23 @@ func (handler *CompanyEventHandler) DeleteCompanyEvent(writer http.ResponseWrite
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting CompanyEvent"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyEventHandler.DeleteCompanyEvent: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyEventHandler.DeleteCompanyEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundErr) {
 			errorMessage = err.Error()
 			status = http.StatusNotFound
-			slog.Info(
+			logger.Info(
 				"v1.CompanyEventHandler.DeleteCompanyEvent: NotFoundErr while deleting CompanyEvent", "error",
 				err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.CompanyEventHandler.DeleteCompanyEvent: ValidationError while deleting CompanyEvent", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyEventHandler.DeleteCompanyEvent: Error while deleting CompanyEvent", "error", err)
+			logger.Error("v1.CompanyEventHandler.DeleteCompanyEvent: Error while deleting CompanyEvent", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
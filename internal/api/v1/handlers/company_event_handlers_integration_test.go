@@ -274,6 +274,94 @@ func TestGetAllCompanyEvents_ShouldReturnNothingIfNothingInDatabase(t *testing.T
 	assert.Len(t, response, 0)
 }
 
+// -------- UpdateCompanyEvent tests: --------
+
+func TestUpdateCompanyEvent_ShouldUpdateLinkDetails(t *testing.T) {
+	companyEventHandler, companyRepository, eventRepository := setupCompanyEventHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	role := "Hiring manager"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateBytes, err := json.Marshal(requests.AssociateCompanyEventRequest{
+		CompanyID: company.ID,
+		EventID:   event.ID,
+		LinkDetails: requests.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/company-event/associate", bytes.NewReader(associateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyEventHandler.AssociateCompanyEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var companyEventResponse responses.CompanyEventResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&companyEventResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, role, *companyEventResponse.Role)
+	assert.Equal(t, startDate, *companyEventResponse.StartDate)
+	assert.Nil(t, companyEventResponse.UpdatedDate)
+
+	notes := "Prefers to be contacted by email"
+	updateBytes, err := json.Marshal(requests.UpdateCompanyEventRequest{
+		CompanyID:   company.ID,
+		EventID:     event.ID,
+		LinkDetails: requests.LinkDetails{Notes: &notes},
+	})
+	assert.NoError(t, err)
+
+	request, err = http.NewRequest(http.MethodPost, "/api/v1/company-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder = httptest.NewRecorder()
+	companyEventHandler.UpdateCompanyEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
+
+func TestUpdateCompanyEvent_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T) {
+	companyEventHandler, _, _ := setupCompanyEventHandler(t)
+
+	updateBytes, err := json.Marshal(requests.UpdateCompanyEventRequest{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/company-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyEventHandler.UpdateCompanyEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: nothing to update\n", responseRecorder.Body.String())
+}
+
+func TestUpdateCompanyEvent_ShouldReturnNotFoundIfLinkDoesNotExist(t *testing.T) {
+	companyEventHandler, _, _ := setupCompanyEventHandler(t)
+
+	role := "Recruiter"
+	updateBytes, err := json.Marshal(requests.UpdateCompanyEventRequest{
+		CompanyID:   uuid.New(),
+		EventID:     uuid.New(),
+		LinkDetails: requests.LinkDetails{Role: &role},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/company-event/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyEventHandler.UpdateCompanyEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
 // -------- DeleteCompanyEvent tests: --------
 
 func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -30,15 +30,18 @@ func NewCompanyHandler(companyService *services.CompanyService) *CompanyHandler
 // @Accept json
 // @Produce json
 // @Param company body requests.CreateCompanyRequest true "Create Company request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.CompanyResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company/new [post]
 func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createCompanyRequest requests.CreateCompanyRequest
 	if err := json.NewDecoder(request.Body).Decode(&createCompanyRequest); err != nil {
-		slog.Info("v1.CompanyHandler.CreateCompany: invalid request body", "error", err)
+		logger.Info("v1.CompanyHandler.CreateCompany: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -46,14 +49,14 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 	// can return ValidationError
 	createCompanyModel, err := createCompanyRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.CompanyHandler.CreateCompany: Unable to convert CreateCompanyRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
 		return
 	}
 	if createCompanyModel == nil {
-		slog.Error("v1.CompanyHandler.CreateCompany: createCompanyModel is nil", "error", err)
+		logger.Error("v1.CompanyHandler.CreateCompany: createCompanyModel is nil", "error", err)
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,7 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	createdCompany, err := companyHandler.companyService.CreateCompany(createCompanyModel)
+	createdCompany, err := companyHandler.companyService.CreateCompany(request.Context(), createCompanyModel)
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
 		var internalServiceErr *internalErrors.InternalServiceError
@@ -73,19 +76,19 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.CompanyHandler.CreateCompany: ConflictError creating company", "error", err)
+			logger.Info("v1.CompanyHandler.CreateCompany: ConflictError creating company", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while creating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.CreateCompany: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyHandler.CreateCompany: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.CompanyHandler.CreateCompany: ValidationError while creating company", "error", err)
+			logger.Info("v1.CompanyHandler.CreateCompany: ValidationError while creating company", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.CreateCompany: Error while creating company", "error", err)
+			logger.Error("v1.CompanyHandler.CreateCompany: Error while creating company", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 		return
@@ -94,7 +97,7 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 	// can return InternalServiceError
 	companyResponse, err := responses.NewCompanyResponse(createdCompany)
 	if err != nil {
-		slog.Error("v1.CompanyHandler.CreateCompany: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.CompanyHandler.CreateCompany: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
@@ -103,7 +106,7 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 	err = json.NewEncoder(writer).Encode(companyResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.CompanyHandler.CreateCompany: Unable to write response", "error", err)
+		logger.Error("v1.CompanyHandler.CreateCompany: Unable to write response", "error", err)
 		http.Error(writer, "Company created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -117,24 +120,30 @@ func (companyHandler *CompanyHandler) CreateCompany(writer http.ResponseWriter,
 // @Tags company
 // @Produce json
 // @Param id path string true "Company ID" format(uuid)
+// @Param If-None-Match header string false "ETag of the version the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {object} responses.CompanyResponse
+// @Header 200 {string} ETag "Version of the company"
+// @Failure 304
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company/get/id/{id} [get]
 func (companyHandler *CompanyHandler) GetCompanyById(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	companyIDStr := vars["id"]
 
 	if companyIDStr == "" {
-		slog.Info("v1.CompanyHandler.GetCompanyById: company ID is empty")
+		logger.Info("v1.CompanyHandler.GetCompanyById: company ID is empty")
 		http.Error(writer, "company ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	companyID, err := uuid.Parse(companyIDStr)
 	if err != nil {
-		slog.Info("v1.CompanyHandler.GetCompanyById: Company ID is not a valid UUID")
+		logger.Info("v1.CompanyHandler.GetCompanyById: Company ID is not a valid UUID")
 		http.Error(writer, "company ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
@@ -144,7 +153,7 @@ func (companyHandler *CompanyHandler) GetCompanyById(writer http.ResponseWriter,
 	var validationErr *internalErrors.ValidationError
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	company, err := companyHandler.companyService.GetCompanyById(&companyID)
+	company, err := companyHandler.companyService.GetCompanyById(request.Context(), &companyID)
 	if err != nil {
 		var errorMessage string
 		var status int
@@ -152,15 +161,15 @@ func (companyHandler *CompanyHandler) GetCompanyById(writer http.ResponseWriter,
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.GetCompanyById: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyHandler.GetCompanyById: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "Company not found"
 			status = http.StatusNotFound
-			slog.Info("v1.CompanyHandler.GetCompanyById: "+errorMessage, "error", err)
+			logger.Info("v1.CompanyHandler.GetCompanyById: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.CompanyHandler.GetCompanyById: Validation error", "error", err)
+			logger.Info("v1.CompanyHandler.GetCompanyById: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -170,21 +179,33 @@ func (companyHandler *CompanyHandler) GetCompanyById(writer http.ResponseWriter,
 	// can return InternalServiceError
 	companyResponse, err := responses.NewCompanyResponse(company)
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetCompanyById: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.CompanyHandler.GetCompanyById: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, companyResponse, "v1.CompanyHandler.GetCompanyById")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, company.Version)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(companyResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.CompanyHandler.GetCompanyById: Unable to write response", "error", err)
+		logger.Error("v1.CompanyHandler.GetCompanyById: Unable to write response", "error", err)
 		http.Error(writer, "Company found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.CompanyHandler.GetCompanyById: retrieved company successfully", "company.ID", company.ID.String())
+	logger.Info("v1.CompanyHandler.GetCompanyById: retrieved company successfully", "company.ID", company.ID.String())
 }
 
 // GetCompaniesByName retrieves `company`s which fully, or partially, match the input name
@@ -194,22 +215,25 @@ func (companyHandler *CompanyHandler) GetCompanyById(writer http.ResponseWriter,
 // @Tags company
 // @Produce json
 // @Param name path string true "Company Name"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {array} responses.CompanyResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company/get/name/{name} [get]
 func (companyHandler *CompanyHandler) GetCompaniesByName(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	companyName := vars["name"]
 
 	if companyName == "" {
-		slog.Info("v1.CompanyHandler.GetCompanyByName: company Name is empty")
+		logger.Info("v1.CompanyHandler.GetCompanyByName: company Name is empty")
 		http.Error(writer, "company Name is empty", http.StatusBadRequest)
 		return
 	}
 
-	companies, err := companyHandler.companyService.GetCompaniesByName(&companyName)
+	companies, err := companyHandler.companyService.GetCompaniesByName(request.Context(), &companyName)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
@@ -221,15 +245,15 @@ func (companyHandler *CompanyHandler) GetCompaniesByName(writer http.ResponseWri
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving companies"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.GetCompaniesByName: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyHandler.GetCompaniesByName: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "No companies [partially] matching this name found"
 			status = http.StatusNotFound
-			slog.Info("v1.CompanyHandler.GetCompaniesByName: "+errorMessage, "error", err)
+			logger.Info("v1.CompanyHandler.GetCompaniesByName: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.CompanyHandler.GetCompaniesByName: Validation error", "error", err)
+			logger.Info("v1.CompanyHandler.GetCompaniesByName: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -239,21 +263,27 @@ func (companyHandler *CompanyHandler) GetCompaniesByName(writer http.ResponseWri
 	// can return InternalServiceError
 	companiesResponse, err := responses.NewCompaniesResponse(companies)
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetCompaniesByName: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.CompanyHandler.GetCompaniesByName: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, companiesResponse, "v1.CompanyHandler.GetCompaniesByName")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(companiesResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.CompanyHandler.GetCompaniesByName: Unable to write response", "error", err)
+		logger.Error("v1.CompanyHandler.GetCompaniesByName: Unable to write response", "error", err)
 		http.Error(writer, "Company found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.CompanyHandler.GetCompaniesByName: retrieved companies successfully", "name", companyName)
+	logger.Info("v1.CompanyHandler.GetCompaniesByName: retrieved companies successfully", "name", companyName)
 }
 
 // GetAllCompanies retrieves all companies.
@@ -273,17 +303,19 @@ func (companyHandler *CompanyHandler) GetCompaniesByName(writer http.ResponseWri
 // @Produce json
 // @Param include_applications query string false "string enums" Enums(all, ids, none)
 // @Param include_persons query string false "string enums" Enums(all, ids, none)
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {array} responses.CompanyResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/company/get/all [get]
 func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
 
 	query := request.URL.Query()
 
 	includeApplications, err := GetExtraDataTypeParam(query.Get("include_applications"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_applications param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_applications param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -296,7 +328,7 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 
 	includePersons, err := GetExtraDataTypeParam(query.Get("include_persons"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_persons param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_persons param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -309,7 +341,7 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 
 	includeEvents, err := GetExtraDataTypeParam(query.Get("include_events"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_events param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_events param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -322,13 +354,14 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 
 	// can return InternalServiceError
 	companies, err := companyHandler.companyService.GetAllCompanies(
+		request.Context(),
 		*includeApplications,
 		*includePersons,
 		*includeEvents)
 
 	if err != nil {
 		errorMessage := "Internal service error while getting all companies"
-		slog.Error("v1.CompanyHandler.GetAllCompanies: "+errorMessage, "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -339,17 +372,23 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 	// can return InternalServiceError
 	companiesResponse, err := responses.NewCompaniesResponse(companies)
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Unable to convert internal model to response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(http.StatusInternalServerError)
 		http.Error(writer, "Error: Unable to convert internal model to response", status)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, companiesResponse, "v1.CompanyHandler.GetAllCompanies")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(companiesResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Unable to write response", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -358,7 +397,83 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 		return
 	}
 
-	slog.Info("v1.CompanyHandler.GetAllCompanies: retrieved all companies successfully")
+	logger.Info("v1.CompanyHandler.GetAllCompanies: retrieved all companies successfully")
+}
+
+// GetCompaniesByIDs retrieves the `company`s with the given IDs
+//
+// @Summary Get companies by IDs
+// @Description Get up to 100 `company`s by ID, in the order of `ids`. IDs that do not exist are skipped. Duplicate IDs are returned once.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param ids body requests.GetByIDsRequest true "IDs of the companies"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
+// @Success 200 {array} responses.CompanyResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/company/get/ids [post]
+func (companyHandler *CompanyHandler) GetCompaniesByIDs(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var getByIDsRequest requests.GetByIDsRequest
+	if err := json.NewDecoder(request.Body).Decode(&getByIDsRequest); err != nil {
+		logger.Info("v1.CompanyHandler.GetCompaniesByIDs: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	ids, err := getByIDsRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.CompanyHandler.GetCompaniesByIDs: Unable to convert GetByIDsRequest to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	companies, err := companyHandler.companyService.GetCompaniesByIDs(request.Context(), ids)
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info("v1.CompanyHandler.GetCompaniesByIDs: Validation error", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		errorMessage := "Internal service error while retrieving companies"
+		logger.Error("v1.CompanyHandler.GetCompaniesByIDs: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	companiesResponse, err := responses.NewCompaniesResponse(companies)
+	if err != nil {
+		logger.Error(
+			"v1.CompanyHandler.GetCompaniesByIDs: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, companiesResponse, "v1.CompanyHandler.GetCompaniesByIDs")
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(writer).Encode(selectedResponse)
+	if err != nil {
+		logger.Error("v1.CompanyHandler.GetCompaniesByIDs: Unable to write response", "error", err)
+		http.Error(writer, "Companies retrieved but unable to create response", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info(
+		"v1.CompanyHandler.GetCompaniesByIDs: retrieved companies successfully",
+		"requested", len(ids),
+		"found", len(companies))
 }
 
 // UpdateCompany updates a company
@@ -369,15 +484,19 @@ func (companyHandler *CompanyHandler) GetAllCompanies(writer http.ResponseWriter
 // @Accept json
 // @Produce json
 // @Param company body requests.UpdateCompanyRequest true "Update Company Request"
+// @Param If-Match header string false "ETag of the version the update is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/company/update [post]
 func (companyHandler *CompanyHandler) UpdateCompany(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var updateCompanyRequest requests.UpdateCompanyRequest
 	if err := json.NewDecoder(request.Body).Decode(&updateCompanyRequest); err != nil {
-		slog.Info("v1.CompanyHandler.UpdateCompany: invalid request body", "error", err)
+		logger.Info("v1.CompanyHandler.UpdateCompany: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -385,22 +504,32 @@ func (companyHandler *CompanyHandler) UpdateCompany(writer http.ResponseWriter,
 	// can return ValidationError
 	updateCompanyModel, err := updateCompanyRequest.ToModel()
 	if err != nil {
-		slog.Info("v1.CompanyHandler.UpdateCompany: Unable to convert UpdateCompanyRequest to model", "error", err)
+		logger.Info("v1.CompanyHandler.UpdateCompany: Unable to convert UpdateCompanyRequest to model", "error", err)
 		http.Error(writer, "Unable to convert request to internal model: "+err.Error(), http.StatusBadRequest)
 
 		return
 	}
 	if updateCompanyModel == nil {
-		slog.Error(
+		logger.Error(
 			"v1.CompanyHandler.UpdateCompany: updateCompanyModel is nil after attempting to convert request to internal model")
 		http.Error(writer, "Unable to convert request to model", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = companyHandler.companyService.UpdateCompany(updateCompanyModel)
+	// can return ValidationError
+	updateCompanyModel.ExpectedVersion, err = GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.CompanyHandler.UpdateCompany: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = companyHandler.companyService.UpdateCompany(request.Context(), updateCompanyModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -409,15 +538,23 @@ func (companyHandler *CompanyHandler) UpdateCompany(writer http.ResponseWriter,
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while updating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.UpdateCompany: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyHandler.UpdateCompany: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundError) {
+			errorMessage = "Company not found"
+			status = http.StatusNotFound
+			logger.Info("v1.CompanyHandler.UpdateCompany: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.CompanyHandler.UpdateCompany: Company has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.CompanyHandler.UpdateCompany: ValidationError while updating company", "error", err)
+			logger.Info("v1.CompanyHandler.UpdateCompany: ValidationError while updating company", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while updating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.UpdateCompany: Error while updating company", "error", err)
+			logger.Error("v1.CompanyHandler.UpdateCompany: Error while updating company", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -433,18 +570,22 @@ func (companyHandler *CompanyHandler) UpdateCompany(writer http.ResponseWriter,
 // @Description Delete a `company` by ID
 // @Tags company
 // @Param id path string true "Company ID" format(uuid)
+// @Param If-Match header string false "ETag of the version the delete is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/company/delete/{id} [delete]
 func (companyHandler *CompanyHandler) DeleteCompany(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	companyIDStr := vars["id"]
 
 	if companyIDStr == "" {
 		errorMessage := "company ID is empty"
-		slog.Info(errorMessage)
+		logger.Info(errorMessage)
 		http.Error(writer, errorMessage, http.StatusBadRequest)
 		return
 	}
@@ -452,16 +593,25 @@ func (companyHandler *CompanyHandler) DeleteCompany(writer http.ResponseWriter,
 	companyID, err := uuid.Parse(companyIDStr)
 	if err != nil {
 		errorMessage := "company ID is not a valid UUID"
-		slog.Info(errorMessage)
+		logger.Info(errorMessage)
 		http.Error(writer, errorMessage, http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, NotFoundError, ValidationError
-	err = companyHandler.companyService.DeleteCompany(&companyID)
+	// can return ValidationError
+	expectedVersion, err := GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.CompanyHandler.DeleteCompany: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = companyHandler.companyService.DeleteCompany(request.Context(), &companyID, expectedVersion)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -470,19 +620,23 @@ func (companyHandler *CompanyHandler) DeleteCompany(writer http.ResponseWriter,
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.DeleteCompany: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyHandler.DeleteCompany: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "Company not found"
 			status = http.StatusNotFound
-			slog.Info("v1.CompanyHandler.DeleteCompany: "+errorMessage, "error", err)
+			logger.Info("v1.CompanyHandler.DeleteCompany: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.CompanyHandler.DeleteCompany: Company has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.CompanyHandler.DeleteCompany: ValidationError while deleting company", "error", err)
+			logger.Info("v1.CompanyHandler.DeleteCompany: ValidationError while deleting company", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyHandler.DeleteCompany: Error while deleting company", "error", err)
+			logger.Error("v1.CompanyHandler.DeleteCompany: Error while deleting company", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
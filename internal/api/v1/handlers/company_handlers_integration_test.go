@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"jobsearchtracker/internal/api/v1/handlers"
 	"jobsearchtracker/internal/api/v1/requests"
@@ -527,7 +528,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationRepository.Create(&createApplication2)
+	_, err := applicationRepository.Create(context.Background(), &createApplication2)
 	assert.NoError(t, err)
 
 	// get all companies
@@ -643,7 +644,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationRepository.Create(&application2)
+	_, err := applicationRepository.Create(context.Background(), &application2)
 	assert.NoError(t, err)
 
 	// get all companies
@@ -791,7 +792,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithEventsIfIncludeEventsIsAll(t *
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := eventRepository.Create(&createEvent2)
+	_, err := eventRepository.Create(context.Background(), &createEvent2)
 	assert.NoError(t, err)
 
 	// associate company to events
@@ -896,7 +897,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithEventIDsIfIncludeEventsIsIDs(t
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
 
-	_, err := eventRepository.Create(&createEvent2)
+	_, err := eventRepository.Create(context.Background(), &createEvent2)
 	assert.NoError(t, err)
 
 	// setup companyEvents
@@ -1044,7 +1045,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsAll(t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := personRepository.Create(&createPerson2)
+	_, err := personRepository.Create(context.Background(), &createPerson2)
 	assert.NoError(t, err)
 
 	// associate company to persons
@@ -1149,7 +1150,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := personRepository.Create(&person2)
+	_, err := personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	// setup companyPersons
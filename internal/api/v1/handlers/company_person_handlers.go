@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,15 +29,18 @@ func NewCompanyPersonHandler(companyPersonService *services.CompanyPersonService
 // @Accept json
 // @Produce json
 // @Param company body requests.AssociateCompanyPersonRequest true "Associate Company Person request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.CompanyPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company-person/associate [post]
 func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createCompanyPersonRequest requests.AssociateCompanyPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&createCompanyPersonRequest); err != nil {
-		slog.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: invalid request body", "error", err)
+		logger.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -45,7 +48,7 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 	// can return ValidationError
 	createCompanyPersonModel, err := createCompanyPersonRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.CompanyPersonHandler.AssociateCompanyPerson: Unable to convert CreateCompanyPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -53,7 +56,7 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 	}
 
 	if createCompanyPersonModel == nil {
-		slog.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: CreateCompanyPerson model is nil")
+		logger.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: CreateCompanyPerson model is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,8 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	companyPersonModel, err := handler.companyPersonService.AssociateCompanyPerson(createCompanyPersonModel)
+	companyPersonModel, err := handler.companyPersonService.AssociateCompanyPerson(
+		request.Context(), createCompanyPersonModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -74,21 +78,21 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: ConflictError creating record", "error", err)
+			logger.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: ConflictError creating record", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while associating person to company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyPersonHandler.AssociateCompanyPerson: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyPersonHandler.AssociateCompanyPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.CompanyPersonHandler.AssociateCompanyPerson: ValidationError while associating person to company",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while associating person to company"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.CompanyPersonHandler.AssociateCompanyPerson: Error while associating person to company",
 				"error", err)
 		}
@@ -105,7 +109,7 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 	err = json.NewEncoder(writer).Encode(response)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.AssociateCompanyPerson: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.AssociateCompanyPerson: Unable to write response", "error", err)
 		http.Error(writer, "Person created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -120,19 +124,22 @@ func (handler *CompanyPersonHandler) AssociateCompanyPerson(writer http.Response
 // @Produce json
 // @Param company-id query string false "company ID" format(uuid)
 // @Param person-id query string false "person ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. company_id,role. Default all"
 // @Success 200 {array} responses.CompanyPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/company-person/get/ [get]
 func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 	companyIDString := query.Get("company-id")
 	personIDString := query.Get("person-id")
 
 	if companyIDString == "" && personIDString == "" {
 		errorMessage := "CompanyID and/or PersonID are required"
-		slog.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
+		logger.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -146,7 +153,7 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 		companyIDValue, err := uuid.Parse(companyIDString)
 		if err != nil || companyIDValue == uuid.Nil {
 			errorMessage := "Unable to parse CompanyID"
-			slog.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
+			logger.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -161,7 +168,7 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 		personIDValue, err := uuid.Parse(personIDString)
 		if err != nil || personIDValue == uuid.Nil {
 			errorMessage := "Unable to parse PersonID"
-			slog.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
+			logger.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -171,10 +178,10 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 		personID = &personIDValue
 	}
 
-	companyPersons, err := handler.companyPersonService.GetByID(companyID, personID)
+	companyPersons, err := handler.companyPersonService.GetByID(request.Context(), companyID, personID)
 	if err != nil {
 		errorMessage := "Internal service error while getting companyPersons by ID"
-		slog.Error("v1.CompanyPersonHandler.GetCompanyPersonsByID: "+errorMessage, "error", err)
+		logger.Error("v1.CompanyPersonHandler.GetCompanyPersonsByID: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -184,10 +191,16 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 
 	response := responses.NewCompanyPersonsResponse(companyPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, response, "v1.CompanyPersonHandler.GetCompanyPersonsByID")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(response)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.CompanyPersonHandler.GetCompanyPersonsByID: Unable to write response", "error", err)
+		logger.Error("v1.CompanyPersonHandler.GetCompanyPersonsByID: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -196,7 +209,7 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 		return
 	}
 
-	slog.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: retrieved all companies successfully")
+	logger.Info("v1.CompanyPersonHandler.GetCompanyPersonsByID: retrieved all companies successfully")
 }
 
 // GetAllCompanyPersons retrieves all companyPersons.
@@ -205,15 +218,18 @@ func (handler *CompanyPersonHandler) GetCompanyPersonsByID(writer http.ResponseW
 // @Description Get all `companyPerson`s
 // @Tags companyPerson
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. company_id,role. Default all"
 // @Success 200 {array} responses.CompanyPersonResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/company-person/get/all [get]
 func (handler *CompanyPersonHandler) GetAllCompanyPersons(writer http.ResponseWriter, request *http.Request) {
-	companyPersons, err := handler.companyPersonService.GetAll()
+	logger := logging.FromContext(request.Context())
+
+	companyPersons, err := handler.companyPersonService.GetAll(request.Context())
 	if err != nil {
 		errorMessage := "Internal service error while getting all companyPersons"
-		slog.Error("v1.CompanyHandler.GetAllCompanyPersons: "+errorMessage, "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanyPersons: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -223,10 +239,16 @@ func (handler *CompanyPersonHandler) GetAllCompanyPersons(writer http.ResponseWr
 
 	companyPersonsResponse := responses.NewCompanyPersonsResponse(companyPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, companyPersonsResponse, "v1.CompanyPersonHandler.GetAllCompanyPersons")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(companyPersonsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.CompanyPersonHandler.GetAllCompanyPersons: Unable to write response", "error", err)
+		logger.Error("v1.CompanyPersonHandler.GetAllCompanyPersons: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -235,7 +257,78 @@ func (handler *CompanyPersonHandler) GetAllCompanyPersons(writer http.ResponseWr
 		return
 	}
 
-	slog.Info("v1.CompanyPersonHandler.GetAllCompanyPersons: retrieved all CompanyPersons successfully")
+	logger.Info("v1.CompanyPersonHandler.GetAllCompanyPersons: retrieved all CompanyPersons successfully")
+}
+
+// UpdateCompanyPerson updates the role, notes, start date and end date of a `companyPerson`
+//
+// @Summary Update the details of a companyPerson
+// @Description Update the `role`, `notes`, `start_date` and `end_date` of a `companyPerson`. Details that are not in the request are left unchanged. The `end_date` cannot be before the `start_date`.
+// @Tags companyPerson
+// @Accept json
+// @Param companyPerson body requests.UpdateCompanyPersonRequest true "Update companyPerson request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/company-person/update [post]
+func (handler *CompanyPersonHandler) UpdateCompanyPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateCompanyPersonRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.CompanyPersonHandler.UpdateCompanyPerson: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	updateModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.CompanyPersonHandler.UpdateCompanyPerson: Unable to convert request to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.companyPersonService.Update(request.Context(), updateModel)
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while updating CompanyPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.CompanyPersonHandler.UpdateCompanyPerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info(
+				"v1.CompanyPersonHandler.UpdateCompanyPerson: NotFoundErr while updating CompanyPerson",
+				"error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info(
+				"v1.CompanyPersonHandler.UpdateCompanyPerson: ValidationError while updating CompanyPerson",
+				"error", err)
+		} else {
+			errorMessage = "Unknown internal error while updating CompanyPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.CompanyPersonHandler.UpdateCompanyPerson: "+errorMessage, "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
 }
 
 // DeleteCompanyPerson deletes a `companyPerson` matching input company UUID and person UUID
@@ -251,9 +344,11 @@ func (handler *CompanyPersonHandler) GetAllCompanyPersons(writer http.ResponseWr
 // @Failure 500
 // @Router /v1/company-person/delete [delete]
 func (handler *CompanyPersonHandler) DeleteCompanyPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var deleteRequest requests.DeleteCompanyPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&deleteRequest); err != nil {
-		slog.Info("v1.CompanyPersonHandler.DeleteCompanyPerson: invalid request body", "error", err)
+		logger.Info("v1.CompanyPersonHandler.DeleteCompanyPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -261,7 +356,7 @@ func (handler *CompanyPersonHandler) DeleteCompanyPerson(writer http.ResponseWri
 	// can return ValidationError
 	deleteModel, err := deleteRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.CompanyPersonHandler.DeleteCompanyPerson: Unable to convert DeleteCompanyPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -269,7 +364,7 @@ func (handler *CompanyPersonHandler) DeleteCompanyPerson(writer http.ResponseWri
 	}
 
 	if deleteModel == nil {
-		slog.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: DeleteCompanyPerson is nil")
+		logger.Info("v1.CompanyPersonHandler.AssociateCompanyPerson: DeleteCompanyPerson is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -277,7 +372,7 @@ func (handler *CompanyPersonHandler) DeleteCompanyPerson(writer http.ResponseWri
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	err = handler.companyPersonService.Delete(deleteModel)
+	err = handler.companyPersonService.Delete(request.Context(), deleteModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundErr *internalErrors.NotFoundError
@@ -289,23 +384,23 @@ func (handler *CompanyPersonHandler) DeleteCompanyPerson(writer http.ResponseWri
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting CompanyPerson"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyPersonHandler.DeleteCompanyPerson: "+errorMessage, "error", err)
+			logger.Error("v1.CompanyPersonHandler.DeleteCompanyPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundErr) {
 			errorMessage = err.Error()
 			status = http.StatusNotFound
-			slog.Info(
+			logger.Info(
 				"v1.CompanyPersonHandler.DeleteCompanyPerson: NotFoundErr while deleting CompanyPerson", "error",
 				err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.CompanyPersonHandler.DeleteCompanyPerson: ValidationError while deleting CompanyPerson", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating company"
 			status = http.StatusInternalServerError
-			slog.Error("v1.CompanyPersonHandler.DeleteCompanyPerson: Error while deleting CompanyPerson", "error", err)
+			logger.Error("v1.CompanyPersonHandler.DeleteCompanyPerson: Error while deleting CompanyPerson", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
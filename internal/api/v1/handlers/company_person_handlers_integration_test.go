@@ -274,6 +274,94 @@ func TestGetAllCompanyPersons_ShouldReturnNothingIfNothingInDatabase(t *testing.
 	assert.Len(t, response, 0)
 }
 
+// -------- UpdateCompanyPerson tests: --------
+
+func TestUpdateCompanyPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	companyPersonHandler, companyRepository, personRepository := setupCompanyPersonHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	role := "Hiring manager"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateBytes, err := json.Marshal(requests.AssociateCompanyPersonRequest{
+		CompanyID: company.ID,
+		PersonID:  person.ID,
+		LinkDetails: requests.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/company-person/associate", bytes.NewReader(associateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyPersonHandler.AssociateCompanyPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var companyPersonResponse responses.CompanyPersonResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&companyPersonResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, role, *companyPersonResponse.Role)
+	assert.Equal(t, startDate, *companyPersonResponse.StartDate)
+	assert.Nil(t, companyPersonResponse.UpdatedDate)
+
+	notes := "Prefers to be contacted by email"
+	updateBytes, err := json.Marshal(requests.UpdateCompanyPersonRequest{
+		CompanyID:   company.ID,
+		PersonID:    person.ID,
+		LinkDetails: requests.LinkDetails{Notes: &notes},
+	})
+	assert.NoError(t, err)
+
+	request, err = http.NewRequest(http.MethodPost, "/api/v1/company-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder = httptest.NewRecorder()
+	companyPersonHandler.UpdateCompanyPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
+
+func TestUpdateCompanyPerson_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T) {
+	companyPersonHandler, _, _ := setupCompanyPersonHandler(t)
+
+	updateBytes, err := json.Marshal(requests.UpdateCompanyPersonRequest{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/company-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyPersonHandler.UpdateCompanyPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: nothing to update\n", responseRecorder.Body.String())
+}
+
+func TestUpdateCompanyPerson_ShouldReturnNotFoundIfLinkDoesNotExist(t *testing.T) {
+	companyPersonHandler, _, _ := setupCompanyPersonHandler(t)
+
+	role := "Recruiter"
+	updateBytes, err := json.Marshal(requests.UpdateCompanyPersonRequest{
+		CompanyID:   uuid.New(),
+		PersonID:    uuid.New(),
+		LinkDetails: requests.LinkDetails{Role: &role},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/company-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	companyPersonHandler.UpdateCompanyPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
 // -------- DeleteCompanyPerson tests: --------
 
 func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"log/slog"
+	"net/http"
+)
+
+type DuplicateHandler struct {
+	duplicateService *services.DuplicateService
+}
+
+func NewDuplicateHandler(duplicateService *services.DuplicateService) *DuplicateHandler {
+	return &DuplicateHandler{duplicateService: duplicateService}
+}
+
+// GetDuplicateCompanies retrieves pairs of companies that are probably the same
+//
+// @Summary Get duplicate companies
+// @Description Get pairs of `company`s whose names are similar once case, punctuation and legal forms such as "AB" or "Inc" are ignored. Pairs are ordered by `score`, from 1 for identical normalized names down to 0.85. `company` is the older of the two, and the suggested survivor for `/v1/company/merge`.
+// @Tags company
+// @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. duplicate,score. Default all"
+// @Success 200 {array} responses.CompanyDuplicateResponse
+// @Failure 500
+// @Router /v1/company/duplicates [get]
+func (duplicateHandler *DuplicateHandler) GetDuplicateCompanies(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	// can return InternalServiceError
+	duplicates, err := duplicateHandler.duplicateService.FindDuplicateCompanies(request.Context())
+	if err != nil {
+		errorMessage := "Internal service error while finding duplicate companies"
+		logger.Error("v1.DuplicateHandler.GetDuplicateCompanies: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	duplicatesResponse, err := responses.NewCompanyDuplicatesResponse(duplicates)
+	if err != nil {
+		logger.Error(
+			"v1.DuplicateHandler.GetDuplicateCompanies: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, duplicatesResponse, "v1.DuplicateHandler.GetDuplicateCompanies")
+	if !ok {
+		return
+	}
+
+	writeDuplicateResponse(writer, logger, "GetDuplicateCompanies", http.StatusOK, selectedResponse)
+}
+
+// GetDuplicatePersons retrieves pairs of persons that are probably the same
+//
+// @Summary Get duplicate persons
+// @Description Get pairs of `person`s whose names are similar once case, punctuation and word order are ignored (reason `name`), or whose email addresses have the same domain and similar parts before the "@", ignoring case, dots and "+tags" (reason `email`). A pair with similar names and the same email domain also has reason `domain`. Shared mailboxes such as "info@" or "recruiting@" are not compared. `score` is the similarity of the names or of the email addresses, whichever is higher. Pairs are ordered by `score`, and pairs with more reasons come first among equal scores. `person` is the older of the two, and the suggested survivor for `/v1/person/merge`.
+// @Tags person
+// @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. duplicate,score. Default all"
+// @Success 200 {array} responses.PersonDuplicateResponse
+// @Failure 500
+// @Router /v1/person/duplicates [get]
+func (duplicateHandler *DuplicateHandler) GetDuplicatePersons(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	// can return InternalServiceError
+	duplicates, err := duplicateHandler.duplicateService.FindDuplicatePersons(request.Context())
+	if err != nil {
+		errorMessage := "Internal service error while finding duplicate persons"
+		logger.Error("v1.DuplicateHandler.GetDuplicatePersons: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	duplicatesResponse, err := responses.NewPersonDuplicatesResponse(duplicates)
+	if err != nil {
+		logger.Error(
+			"v1.DuplicateHandler.GetDuplicatePersons: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, duplicatesResponse, "v1.DuplicateHandler.GetDuplicatePersons")
+	if !ok {
+		return
+	}
+
+	writeDuplicateResponse(writer, logger, "GetDuplicatePersons", http.StatusOK, selectedResponse)
+}
+
+// MergeCompanies merges a duplicate company into the surviving company
+//
+// @Summary Merge two companies
+// @Description Move every `application` (as company or recruiter), `company-person` and `company-event` of the `duplicate_id` company onto the `survivor_id` company, then delete the duplicate. Everything happens in one transaction. The survivor keeps its own name, type and notes. Returns the surviving company.
+// @Tags company
+// @Accept json
+// @Produce json
+// @Param merge body requests.MergeRequest true "Merge request"
+// @Success 200 {object} responses.CompanyResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/company/merge [post]
+func (duplicateHandler *DuplicateHandler) MergeCompanies(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	// can return ValidationError
+	mergeModel, ok := decodeMergeRequest(writer, request, logger, "MergeCompanies")
+	if !ok {
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	company, err := duplicateHandler.duplicateService.MergeCompanies(request.Context(), mergeModel)
+	if err != nil {
+		writeMergeError(writer, logger, "MergeCompanies", err)
+		return
+	}
+
+	// can return InternalServiceError
+	companyResponse, err := responses.NewCompanyResponse(company)
+	if err != nil {
+		logger.Error("v1.DuplicateHandler.MergeCompanies: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeDuplicateResponse(writer, logger, "MergeCompanies", http.StatusOK, companyResponse)
+}
+
+// MergePersons merges a duplicate person into the surviving person
+//
+// @Summary Merge two persons
+// @Description Move every `company-person`, `application-person` and `event-person` of the `duplicate_id` person onto the `survivor_id` person, then delete the duplicate. Everything happens in one transaction. The survivor keeps its own name, type, email, phone and notes. Returns the surviving person.
+// @Tags person
+// @Accept json
+// @Produce json
+// @Param merge body requests.MergeRequest true "Merge request"
+// @Success 200 {object} responses.PersonResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/person/merge [post]
+func (duplicateHandler *DuplicateHandler) MergePersons(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	// can return ValidationError
+	mergeModel, ok := decodeMergeRequest(writer, request, logger, "MergePersons")
+	if !ok {
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	person, err := duplicateHandler.duplicateService.MergePersons(request.Context(), mergeModel)
+	if err != nil {
+		writeMergeError(writer, logger, "MergePersons", err)
+		return
+	}
+
+	// can return InternalServiceError
+	personResponse, err := responses.NewPersonResponse(person)
+	if err != nil {
+		logger.Error("v1.DuplicateHandler.MergePersons: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeDuplicateResponse(writer, logger, "MergePersons", http.StatusOK, personResponse)
+}
+
+// decodeMergeRequest writes a 400 response and returns false if the body is not a valid MergeRequest.
+func decodeMergeRequest(
+	writer http.ResponseWriter, request *http.Request, logger *slog.Logger, method string) (*models.Merge, bool) {
+
+	var mergeRequest requests.MergeRequest
+	if err := json.NewDecoder(request.Body).Decode(&mergeRequest); err != nil {
+		logger.Info("v1.DuplicateHandler."+method+": invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return nil, false
+	}
+
+	// can return ValidationError
+	mergeModel, err := mergeRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.DuplicateHandler."+method+": Unable to convert MergeRequest to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return mergeModel, true
+}
+
+func writeMergeError(writer http.ResponseWriter, logger *slog.Logger, method string, err error) {
+	var internalServiceErr *internalErrors.InternalServiceError
+	var notFoundErr *internalErrors.NotFoundError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &notFoundErr) {
+		logger.Info("v1.DuplicateHandler."+method+": NotFoundError while merging", "error", err)
+		http.Error(writer, err.Error(), http.StatusNotFound)
+	} else if errors.As(err, &validationErr) {
+		logger.Info("v1.DuplicateHandler."+method+": ValidationError while merging", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage := "Internal service error while merging"
+		logger.Error("v1.DuplicateHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	} else {
+		errorMessage := "Unknown internal error while merging"
+		logger.Error("v1.DuplicateHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	}
+}
+
+func writeDuplicateResponse(
+	writer http.ResponseWriter, logger *slog.Logger, method string, status int, response any) {
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Error("v1.DuplicateHandler."+method+": Unable to write response", "error", err)
+	}
+}
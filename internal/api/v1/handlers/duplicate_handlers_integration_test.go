@@ -0,0 +1,283 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupDuplicateHandler(t *testing.T) (
+	*handlers.DuplicateHandler,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupDuplicateHandlerTestContainer(t, config)
+
+	var duplicateHandler *handlers.DuplicateHandler
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	err := container.Invoke(func(
+		handler *handlers.DuplicateHandler,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository) {
+
+		duplicateHandler = handler
+		applicationRepository = application
+		companyRepository = company
+		personRepository = person
+	})
+	assert.NoError(t, err)
+
+	return duplicateHandler, applicationRepository, companyRepository, personRepository
+}
+
+func postMerge(
+	t *testing.T, handlerFunc http.HandlerFunc, url string, requestBody any) *httptest.ResponseRecorder {
+
+	requestBytes, err := json.Marshal(requestBody)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+func createCompanyNamed(
+	t *testing.T, companyRepository *repositories.CompanyRepository, name string, createdDate time.Time) *models.Company {
+
+	company, err := companyRepository.Create(context.Background(), &models.CreateCompany{
+		Name:        name,
+		CompanyType: models.CompanyTypeEmployer,
+		CreatedDate: &createdDate,
+	})
+	assert.NoError(t, err)
+	return company
+}
+
+func createPersonWithEmail(
+	t *testing.T, personRepository *repositories.PersonRepository, name string, email string) *models.Person {
+
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	err := personRepository.Update(context.Background(), &models.UpdatePerson{
+		ID: person.ID, Name: testutil.ToPtr(name), Email: testutil.ToPtr(email)})
+	assert.NoError(t, err)
+
+	return person
+}
+
+func getDuplicatePersons(
+	t *testing.T, duplicateHandler *handlers.DuplicateHandler) []responses.PersonDuplicateResponse {
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/person/duplicates", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	duplicateHandler.GetDuplicatePersons(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var duplicatesResponse []responses.PersonDuplicateResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&duplicatesResponse)
+	assert.NoError(t, err)
+
+	return duplicatesResponse
+}
+
+// -------- GetDuplicateCompanies tests: --------
+
+func TestGetDuplicateCompanies_ShouldReturnSimilarCompanies(t *testing.T) {
+	duplicateHandler, _, companyRepository, _ := setupDuplicateHandler(t)
+
+	older := createCompanyNamed(t, companyRepository, "Acme Inc.", time.Now().AddDate(0, 0, -1))
+	newer := createCompanyNamed(t, companyRepository, "acme", time.Now())
+	createCompanyNamed(t, companyRepository, "Globex", time.Now())
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/company/duplicates", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	duplicateHandler.GetDuplicateCompanies(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var duplicatesResponse []responses.CompanyDuplicateResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&duplicatesResponse)
+	assert.NoError(t, err)
+
+	assert.Len(t, duplicatesResponse, 1)
+	assert.Equal(t, older.ID, duplicatesResponse[0].Company.ID)
+	assert.Equal(t, newer.ID, duplicatesResponse[0].Duplicate.ID)
+	assert.Equal(t, 1.0, duplicatesResponse[0].Score)
+	assert.Equal(t, []string{"name"}, duplicatesResponse[0].Reasons)
+}
+
+// -------- GetDuplicatePersons tests: --------
+
+func TestGetDuplicatePersons_ShouldReturnPersonsWithSameEmail(t *testing.T) {
+	duplicateHandler, _, _, personRepository := setupDuplicateHandler(t)
+
+	first := createPersonWithEmail(t, personRepository, "Jane Doe", "jane.doe@acme.se")
+	second := createPersonWithEmail(t, personRepository, "Bob Smith", "JaneDoe+jobs@acme.se")
+
+	duplicatesResponse := getDuplicatePersons(t, duplicateHandler)
+
+	assert.Len(t, duplicatesResponse, 1)
+	assert.ElementsMatch(
+		t,
+		[]uuid.UUID{first.ID, second.ID},
+		[]uuid.UUID{duplicatesResponse[0].Person.ID, duplicatesResponse[0].Duplicate.ID})
+	assert.Equal(t, 1.0, duplicatesResponse[0].Score)
+	assert.Equal(t, []string{"email"}, duplicatesResponse[0].Reasons)
+}
+
+func TestGetDuplicatePersons_ShouldReturnPersonsWithSimilarEmailsAtSameDomain(t *testing.T) {
+	duplicateHandler, _, _, personRepository := setupDuplicateHandler(t)
+
+	first := createPersonWithEmail(t, personRepository, "Anna Berg", "anna.berg@recruitco.se")
+	second := createPersonWithEmail(t, personRepository, "Recruiter", "anna.bergh@recruitco.se")
+
+	duplicatesResponse := getDuplicatePersons(t, duplicateHandler)
+
+	assert.Len(t, duplicatesResponse, 1)
+	assert.ElementsMatch(
+		t,
+		[]uuid.UUID{first.ID, second.ID},
+		[]uuid.UUID{duplicatesResponse[0].Person.ID, duplicatesResponse[0].Duplicate.ID})
+	assert.Equal(t, []string{"email"}, duplicatesResponse[0].Reasons)
+}
+
+func TestGetDuplicatePersons_ShouldNotReturnPersonsWithSameEmailLocalPartAtDifferentDomains(t *testing.T) {
+	duplicateHandler, _, _, personRepository := setupDuplicateHandler(t)
+
+	createPersonWithEmail(t, personRepository, "Jane Doe", "jane.doe@acme.se")
+	createPersonWithEmail(t, personRepository, "Bob Smith", "janedoe@gmail.com")
+
+	duplicatesResponse := getDuplicatePersons(t, duplicateHandler)
+	assert.Empty(t, duplicatesResponse)
+}
+
+func TestGetDuplicatePersons_ShouldNotReturnPersonsWithSameRoleMailbox(t *testing.T) {
+	duplicateHandler, _, _, personRepository := setupDuplicateHandler(t)
+
+	createPersonWithEmail(t, personRepository, "Jane Doe", "recruiting@acme.se")
+	createPersonWithEmail(t, personRepository, "Bob Smith", "Recruiting+backend@acme.se")
+
+	duplicatesResponse := getDuplicatePersons(t, duplicateHandler)
+	assert.Empty(t, duplicatesResponse)
+}
+
+// -------- MergeCompanies tests: --------
+
+func TestMergeCompanies_ShouldReturnSurvivorAndMoveApplications(t *testing.T) {
+	duplicateHandler, applicationRepository, companyRepository, _ := setupDuplicateHandler(t)
+
+	survivor := createCompanyNamed(t, companyRepository, "Acme AB", time.Now().AddDate(0, 0, -1))
+	duplicate := createCompanyNamed(t, companyRepository, "ACME", time.Now())
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &duplicate.ID, nil, nil)
+
+	responseRecorder := postMerge(
+		t,
+		duplicateHandler.MergeCompanies,
+		"/api/v1/company/merge",
+		requests.MergeRequest{SurvivorID: survivor.ID, DuplicateID: duplicate.ID})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var companyResponse responses.CompanyResponse
+	err := json.NewDecoder(responseRecorder.Body).Decode(&companyResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, companyResponse.ID)
+
+	movedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, *movedApplication.CompanyID)
+}
+
+func TestMergeCompanies_ShouldReturnNotFoundIfDuplicateDoesNotExist(t *testing.T) {
+	duplicateHandler, _, companyRepository, _ := setupDuplicateHandler(t)
+
+	survivor := createCompanyNamed(t, companyRepository, "Acme AB", time.Now())
+
+	responseRecorder := postMerge(
+		t,
+		duplicateHandler.MergeCompanies,
+		"/api/v1/company/merge",
+		requests.MergeRequest{SurvivorID: survivor.ID, DuplicateID: uuid.New()})
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestMergeCompanies_ShouldReturnBadRequestIfIDsAreTheSame(t *testing.T) {
+	duplicateHandler, _, _, _ := setupDuplicateHandler(t)
+
+	id := uuid.New()
+	responseRecorder := postMerge(
+		t,
+		duplicateHandler.MergeCompanies,
+		"/api/v1/company/merge",
+		requests.MergeRequest{SurvivorID: id, DuplicateID: id})
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"validation error: survivor_id and duplicate_id cannot be the same\n",
+		responseRecorder.Body.String())
+}
+
+// -------- MergePersons tests: --------
+
+func TestMergePersons_ShouldReturnSurvivorAndDeleteDuplicate(t *testing.T) {
+	duplicateHandler, _, _, personRepository := setupDuplicateHandler(t)
+
+	survivor := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	duplicate := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	responseRecorder := postMerge(
+		t,
+		duplicateHandler.MergePersons,
+		"/api/v1/person/merge",
+		requests.MergeRequest{SurvivorID: survivor.ID, DuplicateID: duplicate.ID})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var personResponse responses.PersonResponse
+	err := json.NewDecoder(responseRecorder.Body).Decode(&personResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, personResponse.ID)
+
+	_, err = personRepository.GetById(context.Background(), &duplicate.ID)
+	assert.Error(t, err)
+}
+
+func TestMergePersons_ShouldReturnBadRequestOnInvalidJSON(t *testing.T) {
+	duplicateHandler, _, _, _ := setupDuplicateHandler(t)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/person/merge", bytes.NewBufferString("{"))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	duplicateHandler.MergePersons(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -30,15 +30,18 @@ func NewEventHandler(eventService *services.EventService) *EventHandler {
 // @Accept json
 // @Produce json
 // @Param event body requests.CreateEventRequest true "Create Event request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.EventResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/event/new [post]
 func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createEventRequest requests.CreateEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&createEventRequest); err != nil {
-		slog.Info("v1.EventHandler.CreateEvent: invalid request body", "error", err)
+		logger.Info("v1.EventHandler.CreateEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -46,13 +49,13 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 	// can return ValidationError
 	createEventModel, err := createEventRequest.ToModel()
 	if err != nil {
-		slog.Info("v1.EventHandler.CreateEvent: Unable to convert CreateEventRequest to model", "error", err)
+		logger.Info("v1.EventHandler.CreateEvent: Unable to convert CreateEventRequest to model", "error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	if createEventModel == nil {
-		slog.Info("v1.EventHandler.CreateEvent: CreateEventModel is nil", "error", err)
+		logger.Info("v1.EventHandler.CreateEvent: CreateEventModel is nil", "error", err)
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -60,7 +63,7 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	createdEvent, err := eventHandler.eventService.CreateEvent(createEventModel)
+	createdEvent, err := eventHandler.eventService.CreateEvent(request.Context(), createEventModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -73,19 +76,19 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.EventHandler.CreateEvent: ConflictError creating event", "error", err)
+			logger.Info("v1.EventHandler.CreateEvent: ConflictError creating event", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while creating event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.CreateEvent: "+errorMessage, "error", err)
+			logger.Error("v1.EventHandler.CreateEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.EventHandler.CreateEvent: ValidationError while creating event", "error", err)
+			logger.Info("v1.EventHandler.CreateEvent: ValidationError while creating event", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.CreateEvent: Error while creating event", "error", err)
+			logger.Error("v1.EventHandler.CreateEvent: Error while creating event", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -95,7 +98,7 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 	// can return InternalServiceError
 	eventResponse, err := responses.NewEventResponse(createdEvent)
 	if err != nil {
-		slog.Error("v1.EventHandler.CreateEvent: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.EventHandler.CreateEvent: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
@@ -104,7 +107,7 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 	err = json.NewEncoder(writer).Encode(eventResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.EventHandler.CreateEvent: Unable to write response", "error", err)
+		logger.Error("v1.EventHandler.CreateEvent: Unable to write response", "error", err)
 		http.Error(writer, "Event created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -118,30 +121,36 @@ func (eventHandler *EventHandler) CreateEvent(writer http.ResponseWriter, reques
 // @Tags event
 // @Produce json
 // @Param id path string true "Event ID" format(uuid)
+// @Param If-None-Match header string false "ETag of the version the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,event_date. Default all"
 // @Success 200 {object} responses.EventResponse
+// @Header 200 {string} ETag "Version of the event"
+// @Failure 304
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/event/get/id/{id} [get]
 func (eventHandler *EventHandler) GetEventByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	eventIDStr := vars["id"]
 
 	if eventIDStr == "" {
-		slog.Info("v1.EventHandler.GetEventById: event ID is empty")
+		logger.Info("v1.EventHandler.GetEventById: event ID is empty")
 		http.Error(writer, "event ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	eventID, err := uuid.Parse(eventIDStr)
 	if err != nil {
-		slog.Info("v1.EventHandler.GetEventById: event ID is not a valid UUID")
+		logger.Info("v1.EventHandler.GetEventById: event ID is not a valid UUID")
 		http.Error(writer, "event ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	event, err := eventHandler.eventService.GetEventByID(&eventID)
+	event, err := eventHandler.eventService.GetEventByID(request.Context(), &eventID)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
@@ -153,15 +162,15 @@ func (eventHandler *EventHandler) GetEventByID(writer http.ResponseWriter, reque
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.GetEventByID: "+errorMessage, "error", err)
+			logger.Error("v1.EventHandler.GetEventByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "event not found"
 			status = http.StatusNotFound
-			slog.Info("v1.EventHandler.GetEventByID: "+errorMessage, "error", err)
+			logger.Info("v1.EventHandler.GetEventByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.EventHandler.GetEventByID: Validation error", "error", err)
+			logger.Info("v1.EventHandler.GetEventByID: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -171,21 +180,33 @@ func (eventHandler *EventHandler) GetEventByID(writer http.ResponseWriter, reque
 	// can return InternalServiceError
 	eventResponse, err := responses.NewEventResponse(event)
 	if err != nil {
-		slog.Error("v1.EventHandler.GetEventByID: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.EventHandler.GetEventByID: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, eventResponse, "v1.EventHandler.GetEventByID")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, event.Version)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(eventResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.EventHandler.GetEventByID: Unable to write response", "error", err)
+		logger.Error("v1.EventHandler.GetEventByID: Unable to write response", "error", err)
 		http.Error(writer, "Event found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.EventHandler.GetEventByID: retrieved event successfully", "event.ID", event.ID.String())
+	logger.Info("v1.EventHandler.GetEventByID: retrieved event successfully", "event.ID", event.ID.String())
 }
 
 // GetAllEvents retrieves all events.
@@ -203,17 +224,19 @@ func (eventHandler *EventHandler) GetEventByID(writer http.ResponseWriter, reque
 // @Description - include_persons=none: No `person` data included (default)
 // @Tags event
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,event_date. Default all"
 // @Success 200 {array} responses.EventResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/event/get/all [get]
 func (eventHandler *EventHandler) GetAllEvents(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
 
 	query := request.URL.Query()
 
 	includeApplications, err := GetExtraDataTypeParam(query.Get("include_applications"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_applications param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_applications param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -226,7 +249,7 @@ func (eventHandler *EventHandler) GetAllEvents(writer http.ResponseWriter, reque
 
 	includeCompanies, err := GetExtraDataTypeParam(query.Get("include_companies"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_companies param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_companies param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -239,7 +262,7 @@ func (eventHandler *EventHandler) GetAllEvents(writer http.ResponseWriter, reque
 
 	includePersons, err := GetExtraDataTypeParam(query.Get("include_persons"))
 	if err != nil {
-		slog.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_persons param", "error", err)
+		logger.Error("v1.CompanyHandler.GetAllCompanies: Could not parse include_persons param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -252,13 +275,14 @@ func (eventHandler *EventHandler) GetAllEvents(writer http.ResponseWriter, reque
 
 	// can return InternalServiceError
 	events, err := eventHandler.eventService.GetAllEvents(
+		request.Context(),
 		*includeApplications,
 		*includeCompanies,
 		*includePersons)
 	if err != nil {
 		errorMessage := "Internal service error while getting all events"
 		status := http.StatusInternalServerError
-		slog.Error("v1.EventHandler.getAllEvents: "+errorMessage, "error", err)
+		logger.Error("v1.EventHandler.getAllEvents: "+errorMessage, "error", err)
 
 		http.Error(writer, errorMessage, status)
 		return
@@ -267,40 +291,125 @@ func (eventHandler *EventHandler) GetAllEvents(writer http.ResponseWriter, reque
 	//  can return InternalServiceError
 	eventsResponse, err := responses.NewEventsResponse(events)
 	if err != nil {
-		slog.Error("v1.EventHandler.GetAllEvents: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.EventHandler.GetAllEvents: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, eventsResponse, "v1.EventHandler.GetAllEvents")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(eventsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.EventHandler.GetAllEvents: Unable to write response", "error", err)
+		logger.Error("v1.EventHandler.GetAllEvents: Unable to write response", "error", err)
 		http.Error(writer, "Events retrieved but unable to create response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.EventHandler.GetAllEvents: retrieved all events successfully")
+	logger.Info("v1.EventHandler.GetAllEvents: retrieved all events successfully")
+}
+
+// GetEventsByIDs retrieves the `event`s with the given IDs
+//
+// @Summary Get events by IDs
+// @Description Get up to 100 `event`s by ID, in the order of `ids`. IDs that do not exist are skipped. Duplicate IDs are returned once.
+// @Tags event
+// @Accept json
+// @Produce json
+// @Param ids body requests.GetByIDsRequest true "IDs of the events"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,event_date. Default all"
+// @Success 200 {array} responses.EventResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/event/get/ids [post]
+func (eventHandler *EventHandler) GetEventsByIDs(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var getByIDsRequest requests.GetByIDsRequest
+	if err := json.NewDecoder(request.Body).Decode(&getByIDsRequest); err != nil {
+		logger.Info("v1.EventHandler.GetEventsByIDs: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	ids, err := getByIDsRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.EventHandler.GetEventsByIDs: Unable to convert GetByIDsRequest to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	events, err := eventHandler.eventService.GetEventsByIDs(request.Context(), ids)
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info("v1.EventHandler.GetEventsByIDs: Validation error", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		errorMessage := "Internal service error while retrieving events"
+		logger.Error("v1.EventHandler.GetEventsByIDs: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	eventsResponse, err := responses.NewEventsResponse(events)
+	if err != nil {
+		logger.Error(
+			"v1.EventHandler.GetEventsByIDs: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, eventsResponse, "v1.EventHandler.GetEventsByIDs")
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(writer).Encode(selectedResponse)
+	if err != nil {
+		logger.Error("v1.EventHandler.GetEventsByIDs: Unable to write response", "error", err)
+		http.Error(writer, "Events retrieved but unable to create response", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info(
+		"v1.EventHandler.GetEventsByIDs: retrieved events successfully",
+		"requested", len(ids),
+		"found", len(events))
 }
 
-// UpdateEvent updates an event
+// UpdateEvent updates an event and returns it
 //
 // @Summary update an event
-// @Description update an `event`
+// @Description update an `event` and return it. `overlapping_events` lists the booked events that it now clashes with.
 // @Tags event
 // @Accept json
 // @Produce json
 // @Param event body requests.UpdateEventRequest true "Update Event Request"
-// @Success 200
+// @Param If-Match header string false "ETag of the version the update is based on"
+// @Success 200 {object} responses.EventResponse
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/event/update [post]
 func (eventHandler *EventHandler) UpdateEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var updateEventRequest requests.UpdateEventRequest
 	if err := json.NewDecoder(request.Body).Decode(&updateEventRequest); err != nil {
-		slog.Info("v1.EventHandler.UpdateEvent: invalid request body", "error", err)
+		logger.Info("v1.EventHandler.UpdateEvent: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -308,23 +417,33 @@ func (eventHandler *EventHandler) UpdateEvent(writer http.ResponseWriter, reques
 	// can return ValidationError
 	updateEventModel, err := updateEventRequest.ToModel()
 	if err != nil {
-		slog.Info("v1.EventHandler.UpdateEvent: Unable to convert UpdateEventRequest to model", "error", err)
+		logger.Info("v1.EventHandler.UpdateEvent: Unable to convert UpdateEventRequest to model", "error", err)
 		http.Error(writer, "Unable to convert request to internal model: "+err.Error(), http.StatusBadRequest)
 
 		return
 	}
 
 	if updateEventModel == nil {
-		slog.Error(
+		logger.Error(
 			"v1.EventHandler.UpdateEvent: updateEventModel is nil after attempting to convert request to internal model")
 		http.Error(writer, "Unable to convert request to model: Internal model is nil.", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = eventHandler.eventService.UpdateEvent(updateEventModel)
+	// can return ValidationError
+	updateEventModel.ExpectedVersion, err = GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.EventHandler.UpdateEvent: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	updatedEvent, err := eventHandler.eventService.UpdateEvent(request.Context(), updateEventModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -333,22 +452,45 @@ func (eventHandler *EventHandler) UpdateEvent(writer http.ResponseWriter, reques
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while updating event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.UpdateEvent: "+errorMessage, "error", err)
+			logger.Error("v1.EventHandler.UpdateEvent: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundError) {
+			errorMessage = "Event not found"
+			status = http.StatusNotFound
+			logger.Info("v1.EventHandler.UpdateEvent: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.EventHandler.UpdateEvent: Event has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.EventHandler.UpdateEvent: ValidationError while updating event", "error", err)
+			logger.Info("v1.EventHandler.UpdateEvent: ValidationError while updating event", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while updating event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.UpdateEvent: Error while updating event", "error", err)
+			logger.Error("v1.EventHandler.UpdateEvent: Error while updating event", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
 		return
 	}
 
+	eventResponse, err := responses.NewEventResponse(updatedEvent)
+	if err != nil {
+		logger.Error("v1.EventHandler.UpdateEvent: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("ETag", FormatETag(updatedEvent.Version))
 	writer.WriteHeader(http.StatusOK)
+	err = json.NewEncoder(writer).Encode(eventResponse)
+	if err != nil {
+		logger.Error("v1.EventHandler.UpdateEvent: Unable to write response", "error", err)
+		http.Error(writer, "Event updated but unable to create response", http.StatusInternalServerError)
+		return
+	}
 }
 
 // DeleteEvent deletes an `event` matching input UUID
@@ -357,33 +499,46 @@ func (eventHandler *EventHandler) UpdateEvent(writer http.ResponseWriter, reques
 // @Description Delete an `event` by ID
 // @Tags event
 // @Param id path string true "Event ID" format(uuid)
+// @Param If-Match header string false "ETag of the version the delete is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/event/delete/{id} [delete]
 func (eventHandler *EventHandler) DeleteEvent(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	eventIDStr := vars["id"]
 
 	if eventIDStr == "" {
-		slog.Info("v1.EventHandler.DeleteEvent: event ID is empty")
+		logger.Info("v1.EventHandler.DeleteEvent: event ID is empty")
 		http.Error(writer, "event ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	eventID, err := uuid.Parse(eventIDStr)
 	if err != nil {
-		slog.Info("v1.EventHandler.DeleteEvent: event ID is not a valid UUID")
+		logger.Info("v1.EventHandler.DeleteEvent: event ID is not a valid UUID")
 		http.Error(writer, "event ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, NotFoundError, ValidationError
-	err = eventHandler.eventService.DeleteEvent(&eventID)
+	// can return ValidationError
+	expectedVersion, err := GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.EventHandler.DeleteEvent: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = eventHandler.eventService.DeleteEvent(request.Context(), &eventID, expectedVersion)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -392,19 +547,23 @@ func (eventHandler *EventHandler) DeleteEvent(writer http.ResponseWriter, reques
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while deleting event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.DeleteEvent: "+errorMessage, "error", err)
+			logger.Error("v1.EventHandler.DeleteEvent: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "Event not found"
 			status = http.StatusNotFound
-			slog.Info("v1.EventHandler.DeleteEvent: "+errorMessage, "error", err)
+			logger.Info("v1.EventHandler.DeleteEvent: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.EventHandler.DeleteEvent: Event has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.EventHandler.DeleteEvent: ValidationError while deleting event", "error", err)
+			logger.Info("v1.EventHandler.DeleteEvent: ValidationError while deleting event", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventHandler.DeleteEvent: Error while deleting event", "error", err)
+			logger.Error("v1.EventHandler.DeleteEvent: Error while deleting event", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"jobsearchtracker/internal/api/v1/handlers"
@@ -217,7 +218,7 @@ func TestGetAllEvents_ShouldReturnAllEvents(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	event1, err := eventRepository.Create(&createEvent1)
+	event1, err := eventRepository.Create(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 	assert.NotNil(t, event1)
 
@@ -332,7 +333,7 @@ func TestGetAllEvents_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -468,7 +469,7 @@ func TestGetAllEvents_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -666,7 +667,7 @@ func TestGetAllEvents_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *testi
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -681,21 +682,21 @@ func TestGetAllEvents_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *testi
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
@@ -818,7 +819,7 @@ func TestGetAllEvents_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *test
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -833,21 +834,21 @@ func TestGetAllEvents_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *test
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
@@ -1014,7 +1015,7 @@ func TestGetAllEvents_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *testing.T
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1129,7 +1130,7 @@ func TestGetAllEvents_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1286,7 +1287,7 @@ func TestUpdateEvent_ShouldUpdateEvent(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	event, err := eventRepository.Create(&createEvent)
+	event, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -1315,7 +1316,7 @@ func TestUpdateEvent_ShouldUpdateEvent(t *testing.T) {
 
 	// get the event by ID
 
-	updatedEvent, err := eventRepository.GetByID(createEvent.ID)
+	updatedEvent, err := eventRepository.GetByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 
 	assert.Equal(t, updateBody.ID, updatedEvent.ID)
@@ -1385,7 +1386,7 @@ func TestDeleteEvent_ShouldDeleteEvent(t *testing.T) {
 
 	// try to get the event
 
-	nilEvent, err := eventRepository.GetByID(&event.ID)
+	nilEvent, err := eventRepository.GetByID(context.Background(), &event.ID)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -1410,3 +1411,110 @@ func TestDeleteEvent_ShouldReturnStatusNotFoundIfEventDoesNotExist(t *testing.T)
 	eventHandler.DeleteEvent(deleteResponseRecorder, deleteRequest)
 	assert.Equal(t, http.StatusNotFound, deleteResponseRecorder.Code)
 }
+
+// -------- Scheduling tests: --------
+
+func TestCreateEvent_ShouldReturnSchedulingFieldsAndOverlappingEvents(t *testing.T) {
+	eventHandler, _, _, _, _, _, _, _ := setupEventHandler(t)
+
+	var interviewFormat requests.InterviewFormat = requests.InterviewFormatVideo
+	eventDate := time.Date(2026, 6, 10, 8, 0, 0, 0, time.UTC)
+
+	createEvent := func(requestBody requests.CreateEventRequest) responses.EventResponse {
+		requestBytes, err := json.Marshal(requestBody)
+		assert.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodPost, "/api/v1/event/new", bytes.NewBuffer(requestBytes))
+		assert.NoError(t, err)
+
+		responseRecorder := httptest.NewRecorder()
+		eventHandler.CreateEvent(responseRecorder, request)
+		assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+		var eventResponse responses.EventResponse
+		err = json.NewDecoder(responseRecorder.Body).Decode(&eventResponse)
+		assert.NoError(t, err)
+		return eventResponse
+	}
+
+	firstResponse := createEvent(requests.CreateEventRequest{
+		EventType:       requests.EventTypeInterviewBooked,
+		EventDate:       eventDate,
+		DurationMinutes: testutil.ToPtr(30),
+		MeetingURL:      testutil.ToPtr("https://meet.example.com/abc"),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("Europe/Stockholm"),
+	})
+	assert.Equal(t, 30, *firstResponse.DurationMinutes)
+	assert.Equal(t, "https://meet.example.com/abc", *firstResponse.MeetingURL)
+	assert.Equal(t, interviewFormat, *firstResponse.InterviewFormat)
+	assert.Equal(t, "Europe/Stockholm", *firstResponse.Timezone)
+	assert.Equal(t, "2026-06-10T10:00:00+02:00", firstResponse.EventDate.Format(time.RFC3339))
+	assert.Equal(t, "2026-06-10T10:30:00+02:00", firstResponse.EndDate.Format(time.RFC3339))
+	assert.Empty(t, firstResponse.OverlappingEvents)
+
+	secondResponse := createEvent(requests.CreateEventRequest{
+		EventType: requests.EventTypeRecruiterInterviewBooked,
+		EventDate: eventDate.Add(15 * time.Minute),
+	})
+	assert.Len(t, secondResponse.OverlappingEvents, 1)
+	assert.Equal(t, firstResponse.ID, secondResponse.OverlappingEvents[0].ID)
+}
+
+func TestUpdateEvent_ShouldReturnUpdatedEventWithOverlappingEvents(t *testing.T) {
+	eventHandler, _, _, eventRepository, _, _, _, _ := setupEventHandler(t)
+
+	eventDate := time.Now().AddDate(0, 0, 7).Truncate(time.Minute)
+	existingEvent, err := eventRepository.Create(context.Background(), &models.CreateEvent{
+		EventType:       models.EventTypeInterviewBooked,
+		EventDate:       eventDate,
+		DurationMinutes: testutil.ToPtr(60),
+	})
+	assert.NoError(t, err)
+
+	movedEvent, err := eventRepository.Create(context.Background(), &models.CreateEvent{
+		EventType: models.EventTypeCallBooked,
+		EventDate: eventDate.AddDate(0, 0, 1),
+	})
+	assert.NoError(t, err)
+
+	requestBytes, err := json.Marshal(requests.UpdateEventRequest{
+		ID:        movedEvent.ID,
+		EventDate: testutil.ToPtr(eventDate.Add(30 * time.Minute)),
+	})
+	assert.NoError(t, err)
+
+	updateRequest, err := http.NewRequest(http.MethodPost, "/api/v1/event/update", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	eventHandler.UpdateEvent(responseRecorder, updateRequest)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+
+	var eventResponse responses.EventResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&eventResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, movedEvent.ID, eventResponse.ID)
+	assert.Len(t, eventResponse.OverlappingEvents, 1)
+	assert.Equal(t, existingEvent.ID, eventResponse.OverlappingEvents[0].ID)
+}
+
+func TestCreateEvent_ShouldReturnBadRequestOnInvalidTimezone(t *testing.T) {
+	eventHandler, _, _, _, _, _, _, _ := setupEventHandler(t)
+
+	requestBytes, err := json.Marshal(requests.CreateEventRequest{
+		EventType: requests.EventTypeInterviewBooked,
+		EventDate: time.Now(),
+		Timezone:  testutil.ToPtr("CEST"),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/event/new", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	eventHandler.CreateEvent(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,15 +29,18 @@ func NewEventPersonHandler(eventPersonService *services.EventPersonService) *Eve
 // @Accept json
 // @Produce json
 // @Param event body requests.AssociateEventPersonRequest true "Associate Event Person request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.EventPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/event-person/associate [post]
 func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createEventPersonRequest requests.AssociateEventPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&createEventPersonRequest); err != nil {
-		slog.Info("v1.EventPersonHandler.AssociateEventPerson: invalid request body", "error", err)
+		logger.Info("v1.EventPersonHandler.AssociateEventPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -45,7 +48,7 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 	// can return ValidationError
 	createEventPersonModel, err := createEventPersonRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.EventPersonHandler.AssociateEventPerson: Unable to convert CreateEventPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -53,7 +56,7 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 	}
 
 	if createEventPersonModel == nil {
-		slog.Info("v1.EventPersonHandler.AssociateEventPerson: CreateEventPerson model is nil")
+		logger.Info("v1.EventPersonHandler.AssociateEventPerson: CreateEventPerson model is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -61,7 +64,7 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	eventPersonModel, err := handler.eventPersonService.AssociateEventPerson(createEventPersonModel)
+	eventPersonModel, err := handler.eventPersonService.AssociateEventPerson(request.Context(), createEventPersonModel)
 
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
@@ -74,23 +77,23 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info(
+			logger.Info(
 				"v1.EventPersonHandler.AssociateEventPerson: ConflictError creating record",
 				"error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while associating person to event"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventPersonHandler.AssociateEventPerson: "+errorMessage, "error", err)
+			logger.Error("v1.EventPersonHandler.AssociateEventPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.EventPersonHandler.AssociateEventPerson: ValidationError while associating person to event",
 				"error", err)
 		} else {
 			errorMessage = "Unknown internal error while associating person to event"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.EventPersonHandler.AssociateEventPerson: Error while associating person to event",
 				"error", err)
 		}
@@ -107,7 +110,7 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 	err = json.NewEncoder(writer).Encode(response)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.AssociateEventPerson: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.AssociateEventPerson: Unable to write response", "error", err)
 		http.Error(writer, "Person created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -122,19 +125,22 @@ func (handler *EventPersonHandler) AssociateEventPerson(writer http.ResponseWrit
 // @Produce json
 // @Param event-id query string false "event ID" format(uuid)
 // @Param person-id query string false "person ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. event_id,role. Default all"
 // @Success 200 {array} responses.EventPersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/event-person/get/ [get]
 func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 	eventIDString := query.Get("event-id")
 	personIDString := query.Get("person-id")
 
 	if eventIDString == "" && personIDString == "" {
 		errorMessage := "EventID and/or PersonID are required"
-		slog.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
+		logger.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -148,7 +154,7 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 		eventIDValue, err := uuid.Parse(eventIDString)
 		if err != nil || eventIDValue == uuid.Nil {
 			errorMessage := "Unable to parse EventID"
-			slog.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
+			logger.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -163,7 +169,7 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 		personIDValue, err := uuid.Parse(personIDString)
 		if err != nil || personIDValue == uuid.Nil {
 			errorMessage := "Unable to parse PersonID"
-			slog.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
+			logger.Info("v1.EventPersonHandler.GetEventPersonsByID: " + errorMessage)
 
 			status := http.StatusBadRequest
 			writer.WriteHeader(status)
@@ -173,10 +179,10 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 		personID = &personIDValue
 	}
 
-	eventPersons, err := handler.eventPersonService.GetByID(eventID, personID)
+	eventPersons, err := handler.eventPersonService.GetByID(request.Context(), eventID, personID)
 	if err != nil {
 		errorMessage := "Internal service error while getting eventPersons by ID"
-		slog.Error("v1.EventPersonHandler.GetEventPersonsByID: "+errorMessage, "error", err)
+		logger.Error("v1.EventPersonHandler.GetEventPersonsByID: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -186,10 +192,16 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 
 	response := responses.NewEventPersonsResponse(eventPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, response, "v1.EventPersonHandler.GetEventPersonsByID")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "event/json")
-	err = json.NewEncoder(writer).Encode(response)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.EventPersonHandler.GetEventPersonsByID: Unable to write response", "error", err)
+		logger.Error("v1.EventPersonHandler.GetEventPersonsByID: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -198,7 +210,7 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 		return
 	}
 
-	slog.Info("v1.EventPersonHandler.GetEventPersonsByID: retrieved all events successfully")
+	logger.Info("v1.EventPersonHandler.GetEventPersonsByID: retrieved all events successfully")
 }
 
 // GetAllEventPersons retrieves all eventPersons.
@@ -207,15 +219,18 @@ func (handler *EventPersonHandler) GetEventPersonsByID(writer http.ResponseWrite
 // @Description Get all `eventPerson`s
 // @Tags eventPerson
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. event_id,role. Default all"
 // @Success 200 {array} responses.EventPersonResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/event-person/get/all [get]
 func (handler *EventPersonHandler) GetAllEventPersons(writer http.ResponseWriter, request *http.Request) {
-	eventPersons, err := handler.eventPersonService.GetAll()
+	logger := logging.FromContext(request.Context())
+
+	eventPersons, err := handler.eventPersonService.GetAll(request.Context())
 	if err != nil {
 		errorMessage := "Internal service error while getting all eventPersons"
-		slog.Error("v1.EventHandler.GetAllEventPersons: "+errorMessage, "error", err)
+		logger.Error("v1.EventHandler.GetAllEventPersons: "+errorMessage, "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -225,10 +240,16 @@ func (handler *EventPersonHandler) GetAllEventPersons(writer http.ResponseWriter
 
 	eventPersonsResponse := responses.NewEventPersonsResponse(eventPersons)
 
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, eventPersonsResponse, "v1.EventPersonHandler.GetAllEventPersons")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "event/json")
-	err = json.NewEncoder(writer).Encode(eventPersonsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
-		slog.Error("v1.EventPersonHandler.GetAllEventPersons: Unable to write response", "error", err)
+		logger.Error("v1.EventPersonHandler.GetAllEventPersons: Unable to write response", "error", err)
 
 		status := http.StatusInternalServerError
 		writer.WriteHeader(status)
@@ -237,7 +258,78 @@ func (handler *EventPersonHandler) GetAllEventPersons(writer http.ResponseWriter
 		return
 	}
 
-	slog.Info("v1.EventPersonHandler.GetAllEventPersons: retrieved all EventPersons successfully")
+	logger.Info("v1.EventPersonHandler.GetAllEventPersons: retrieved all EventPersons successfully")
+}
+
+// UpdateEventPerson updates the role, notes, start date and end date of a `eventPerson`
+//
+// @Summary Update the details of a eventPerson
+// @Description Update the `role`, `notes`, `start_date` and `end_date` of a `eventPerson`. Details that are not in the request are left unchanged. The `end_date` cannot be before the `start_date`.
+// @Tags eventPerson
+// @Accept json
+// @Param eventPerson body requests.UpdateEventPersonRequest true "Update eventPerson request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/event-person/update [post]
+func (handler *EventPersonHandler) UpdateEventPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateEventPersonRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.EventPersonHandler.UpdateEventPerson: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	updateModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.EventPersonHandler.UpdateEventPerson: Unable to convert request to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.eventPersonService.Update(request.Context(), updateModel)
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while updating EventPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.EventPersonHandler.UpdateEventPerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info(
+				"v1.EventPersonHandler.UpdateEventPerson: NotFoundErr while updating EventPerson",
+				"error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info(
+				"v1.EventPersonHandler.UpdateEventPerson: ValidationError while updating EventPerson",
+				"error", err)
+		} else {
+			errorMessage = "Unknown internal error while updating EventPerson"
+			status = http.StatusInternalServerError
+			logger.Error("v1.EventPersonHandler.UpdateEventPerson: "+errorMessage, "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
 }
 
 // DeleteEventPerson deletes a `eventPerson` matching input event UUID and person UUID
@@ -253,9 +345,11 @@ func (handler *EventPersonHandler) GetAllEventPersons(writer http.ResponseWriter
 // @Failure 500
 // @Router /v1/event-person/delete [delete]
 func (handler *EventPersonHandler) DeleteEventPerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var deleteRequest requests.DeleteEventPersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&deleteRequest); err != nil {
-		slog.Info("v1.EventPersonHandler.DeleteEventPerson: invalid request body", "error", err)
+		logger.Info("v1.EventPersonHandler.DeleteEventPerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -263,7 +357,7 @@ func (handler *EventPersonHandler) DeleteEventPerson(writer http.ResponseWriter,
 	// can return ValidationError
 	deleteModel, err := deleteRequest.ToModel()
 	if err != nil {
-		slog.Info(
+		logger.Info(
 			"v1.EventPersonHandler.DeleteEventPerson: Unable to convert DeleteEventPersonRequest to model",
 			"error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
@@ -271,7 +365,7 @@ func (handler *EventPersonHandler) DeleteEventPerson(writer http.ResponseWriter,
 	}
 
 	if deleteModel == nil {
-		slog.Info("v1.EventPersonHandler.AssociateEventPerson: DeleteEventPerson is nil")
+		logger.Info("v1.EventPersonHandler.AssociateEventPerson: DeleteEventPerson is nil")
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -279,7 +373,7 @@ func (handler *EventPersonHandler) DeleteEventPerson(writer http.ResponseWriter,
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	err = handler.eventPersonService.Delete(deleteModel)
+	err = handler.eventPersonService.Delete(request.Context(), deleteModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
 		var notFoundErr *internalErrors.NotFoundError
@@ -291,23 +385,23 @@ func (handler *EventPersonHandler) DeleteEventPerson(writer http.ResponseWriter,
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while deleting EventPerson"
 			status = http.StatusInternalServerError
-			slog.Error("v1.EventPersonHandler.DeleteEventPerson: "+errorMessage, "error", err)
+			logger.Error("v1.EventPersonHandler.DeleteEventPerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundErr) {
 			errorMessage = err.Error()
 			status = http.StatusNotFound
-			slog.Info(
+			logger.Info(
 				"v1.EventPersonHandler.DeleteEventPerson: NotFoundErr while deleting EventPerson", "error",
 				err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info(
+			logger.Info(
 				"v1.EventPersonHandler.DeleteEventPerson: ValidationError while deleting EventPerson", "error",
 				err)
 		} else {
 			errorMessage = "Unknown internal error while creating event"
 			status = http.StatusInternalServerError
-			slog.Error(
+			logger.Error(
 				"v1.EventPersonHandler.DeleteEventPerson: Error while deleting EventPerson",
 				"error", err)
 		}
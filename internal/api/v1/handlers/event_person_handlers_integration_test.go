@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"jobsearchtracker/internal/api/v1/handlers"
 	"jobsearchtracker/internal/api/v1/requests"
@@ -326,6 +327,94 @@ func TestGetAllEventPersons_ShouldReturnNothingIfNothingInDatabase(t *testing.T)
 	assert.Len(t, response, 0)
 }
 
+// -------- UpdateEventPerson tests: --------
+
+func TestUpdateEventPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	eventPersonHandler, eventRepository, personRepository, _ := setupEventPersonHandler(t)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	role := "Hiring manager"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateBytes, err := json.Marshal(requests.AssociateEventPersonRequest{
+		EventID:  event.ID,
+		PersonID: person.ID,
+		LinkDetails: requests.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/event-person/associate", bytes.NewReader(associateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	eventPersonHandler.AssociateEventPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var eventPersonResponse responses.EventPersonResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&eventPersonResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, role, *eventPersonResponse.Role)
+	assert.Equal(t, startDate, *eventPersonResponse.StartDate)
+	assert.Nil(t, eventPersonResponse.UpdatedDate)
+
+	notes := "Prefers to be contacted by email"
+	updateBytes, err := json.Marshal(requests.UpdateEventPersonRequest{
+		EventID:     event.ID,
+		PersonID:    person.ID,
+		LinkDetails: requests.LinkDetails{Notes: &notes},
+	})
+	assert.NoError(t, err)
+
+	request, err = http.NewRequest(http.MethodPost, "/api/v1/event-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder = httptest.NewRecorder()
+	eventPersonHandler.UpdateEventPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+}
+
+func TestUpdateEventPerson_ShouldReturnBadRequestIfNothingToUpdate(t *testing.T) {
+	eventPersonHandler, _, _, _ := setupEventPersonHandler(t)
+
+	updateBytes, err := json.Marshal(requests.UpdateEventPersonRequest{
+		EventID:  uuid.New(),
+		PersonID: uuid.New(),
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/event-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	eventPersonHandler.UpdateEventPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: nothing to update\n", responseRecorder.Body.String())
+}
+
+func TestUpdateEventPerson_ShouldReturnNotFoundIfLinkDoesNotExist(t *testing.T) {
+	eventPersonHandler, _, _, _ := setupEventPersonHandler(t)
+
+	role := "Recruiter"
+	updateBytes, err := json.Marshal(requests.UpdateEventPersonRequest{
+		EventID:     uuid.New(),
+		PersonID:    uuid.New(),
+		LinkDetails: requests.LinkDetails{Role: &role},
+	})
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/event-person/update", bytes.NewReader(updateBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	eventPersonHandler.UpdateEventPerson(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
 // -------- DeleteEventPerson tests: --------
 
 func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
@@ -417,7 +506,7 @@ func setupEventPersonTestData(
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	if sleep {
@@ -430,7 +519,7 @@ func setupEventPersonTestData(
 		EventID:  event2.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	if sleep {
@@ -443,7 +532,7 @@ func setupEventPersonTestData(
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
 	return event1.ID, event2.ID, person1.ID, person2.ID
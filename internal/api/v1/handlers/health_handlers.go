@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/responses"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"net/http"
+)
+
+type HealthHandler struct {
+	healthService *services.HealthService
+}
+
+func NewHealthHandler(healthService *services.HealthService) *HealthHandler {
+	return &HealthHandler{healthService: healthService}
+}
+
+// Liveness reports that the process is running and able to serve requests. It does not check any dependencies, so
+// that a supervisor does not restart the service because the database is briefly unavailable.
+//
+// It is served at /healthz, outside the /api base path, so it is not part of the Swagger documentation.
+func (handler *HealthHandler) Liveness(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	writer.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(writer).Encode(responses.LivenessResponse{Status: responses.HealthStatusOK})
+	if err != nil {
+		logger.Error("v1.HealthHandler.Liveness: Unable to write response", "error", err)
+	}
+}
+
+// Readiness reports whether the service can handle traffic: the database must answer a ping, and every migration must
+// be applied and not dirty. It returns 503 otherwise. It is served at /readyz.
+func (handler *HealthHandler) Readiness(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	readiness := handler.healthService.CheckReadiness(request.Context())
+	response := responses.NewReadinessResponse(readiness)
+
+	writer.Header().Set("Content-Type", "application/json")
+	if !readiness.IsReady() {
+		logger.Warn("v1.HealthHandler.Readiness: Service is not ready", "response", response)
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	err := json.NewEncoder(writer).Encode(response)
+	if err != nil {
+		logger.Error("v1.HealthHandler.Readiness: Unable to write response", "error", err)
+	}
+}
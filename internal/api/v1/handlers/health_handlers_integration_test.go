@@ -0,0 +1,124 @@
+package handlers_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupHealthHandler(t *testing.T) (*handlers.HealthHandler, *sql.DB) {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		DatabaseFileName:                     "test.sqlite",
+	}
+	container := dependencyinjection.SetupHealthHandlerTestContainer(t, config)
+
+	var healthHandler *handlers.HealthHandler
+	var database *sql.DB
+	err := container.Invoke(func(handler *handlers.HealthHandler, db *sql.DB) {
+		healthHandler = handler
+		database = db
+	})
+	assert.NoError(t, err)
+
+	return healthHandler, database
+}
+
+// -------- Liveness tests: --------
+
+func TestLiveness_ShouldReturnOK(t *testing.T) {
+	healthHandler, _ := setupHealthHandler(t)
+
+	request, err := http.NewRequest(http.MethodGet, "/healthz", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	healthHandler.Liveness(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.LivenessResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+	assert.Equal(t, responses.HealthStatusOK, response.Status)
+}
+
+// -------- Readiness tests: --------
+
+func TestReadiness_ShouldReturnOKIfDatabaseIsMigrated(t *testing.T) {
+	healthHandler, _ := setupHealthHandler(t)
+
+	request, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	healthHandler.Readiness(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.ReadinessResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, responses.HealthStatusOK, response.Status)
+	assert.Equal(t, responses.HealthStatusOK, response.Database)
+	assert.Equal(t, uint(19), *response.MigrationVersion)
+	assert.False(t, *response.MigrationDirty)
+	assert.Empty(t, response.PendingVersions)
+}
+
+func TestReadiness_ShouldReturnServiceUnavailableIfDatabaseIsDirty(t *testing.T) {
+	healthHandler, database := setupHealthHandler(t)
+
+	_, err := database.Exec("UPDATE schema_migrations SET dirty = 1")
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	healthHandler.Readiness(responseRecorder, request)
+	assert.Equal(t, http.StatusServiceUnavailable, responseRecorder.Code)
+
+	var response responses.ReadinessResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, responses.HealthStatusUnavailable, response.Status)
+	assert.Equal(t, responses.HealthStatusOK, response.Database)
+	assert.True(t, *response.MigrationDirty)
+}
+
+func TestReadiness_ShouldReturnServiceUnavailableIfDatabaseIsClosed(t *testing.T) {
+	healthHandler, database := setupHealthHandler(t)
+
+	err := database.Close()
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "/readyz", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	healthHandler.Readiness(responseRecorder, request)
+	assert.Equal(t, http.StatusServiceUnavailable, responseRecorder.Code)
+
+	var response responses.ReadinessResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, responses.HealthStatusUnavailable, response.Status)
+	assert.Equal(t, responses.HealthStatusUnavailable, response.Database)
+	assert.NotNil(t, response.DatabaseError)
+	assert.Nil(t, response.MigrationVersion)
+}
@@ -0,0 +1,464 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type InterviewPrepHandler struct {
+	interviewPrepService *services.InterviewPrepService
+}
+
+func NewInterviewPrepHandler(interviewPrepService *services.InterviewPrepService) *InterviewPrepHandler {
+	return &InterviewPrepHandler{interviewPrepService: interviewPrepService}
+}
+
+// CreateInterviewPrep adds interview prep to an interview or code test
+//
+// @Summary Create interview prep
+// @Description Add structured prep to an `event` of type `interviewCompleted` or `codeTestCompleted`: technical topics, a self-rating from 1 to 5 and notes. The prep uses the ID of the event as its own. Questions are added with `/v1/interview-question/new`.
+// @Tags interviewPrep
+// @Accept json
+// @Produce json
+// @Param interviewPrep body requests.CreateInterviewPrepRequest true "Create interview prep request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 201 {object} responses.InterviewPrepResponse
+// @Failure 400
+// @Failure 409
+// @Failure 500
+// @Router /v1/interview-prep/new [post]
+func (handler *InterviewPrepHandler) CreateInterviewPrep(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var createRequest requests.CreateInterviewPrepRequest
+	if err := json.NewDecoder(request.Body).Decode(&createRequest); err != nil {
+		logger.Info("v1.InterviewPrepHandler.CreateInterviewPrep: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	prepModel, err := createRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.CreateInterviewPrep: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	prep, err := handler.interviewPrepService.CreateInterviewPrep(request.Context(), prepModel)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "CreateInterviewPrep", "creating interview prep", err)
+		return
+	}
+
+	// can return InternalServiceError
+	prepResponse, err := responses.NewInterviewPrepResponse(prep)
+	if err != nil {
+		logger.Error(
+			"v1.InterviewPrepHandler.CreateInterviewPrep: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeInterviewPrepResponse(writer, logger, "CreateInterviewPrep", http.StatusCreated, prepResponse)
+}
+
+// GetInterviewPrepByID retrieves the interview prep of an interview or code test
+//
+// @Summary Get interview prep by event ID
+// @Description Get the interview prep of an `event`, with its questions, oldest first
+// @Tags interviewPrep
+// @Produce json
+// @Param id path string true "ID of the interviewCompleted or codeTestCompleted event" format(uuid)
+// @Param If-None-Match header string false "ETag of the version the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. event_id,notes. Default all"
+// @Success 200 {object} responses.InterviewPrepResponse
+// @Header 200 {string} ETag "Version of the interview prep and its questions"
+// @Failure 304
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/interview-prep/get/id/{id} [get]
+func (handler *InterviewPrepHandler) GetInterviewPrepByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	eventID, err := uuid.Parse(mux.Vars(request)["id"])
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.GetInterviewPrepByID: event ID is not a valid UUID")
+		http.Error(writer, "event ID is not a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	prep, err := handler.interviewPrepService.GetInterviewPrepByEventID(request.Context(), &eventID)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "GetInterviewPrepByID", "retrieving interview prep", err)
+		return
+	}
+
+	// can return InternalServiceError
+	prepResponse, err := responses.NewInterviewPrepResponse(prep)
+	if err != nil {
+		logger.Error(
+			"v1.InterviewPrepHandler.GetInterviewPrepByID: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, prepResponse, "v1.InterviewPrepHandler.GetInterviewPrepByID")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, prep.Version)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writeInterviewPrepResponse(writer, logger, "GetInterviewPrepByID", http.StatusOK, selectedResponse)
+}
+
+// UpdateInterviewPrep updates the interview prep of an interview or code test
+//
+// @Summary Update interview prep
+// @Description Update the technical topics, self-rating or notes of an interview prep
+// @Tags interviewPrep
+// @Accept json
+// @Param interviewPrep body requests.UpdateInterviewPrepRequest true "Update interview prep request"
+// @Param If-Match header string false "ETag of the version the update is based on"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 412
+// @Failure 500
+// @Router /v1/interview-prep/update [post]
+func (handler *InterviewPrepHandler) UpdateInterviewPrep(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateInterviewPrepRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.InterviewPrepHandler.UpdateInterviewPrep: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	prepModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.UpdateInterviewPrep: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	prepModel.ExpectedVersion, err = GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.UpdateInterviewPrep: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = handler.interviewPrepService.UpdateInterviewPrep(request.Context(), prepModel)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "UpdateInterviewPrep", "updating interview prep", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// DeleteInterviewPrep deletes the interview prep of an interview or code test
+//
+// @Summary Delete interview prep
+// @Description Delete the interview prep of an `event`, and all of its questions. The event itself is kept.
+// @Tags interviewPrep
+// @Param id path string true "ID of the interviewCompleted or codeTestCompleted event" format(uuid)
+// @Param If-Match header string false "ETag of the version the delete is based on"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 412
+// @Failure 500
+// @Router /v1/interview-prep/delete/{id} [delete]
+func (handler *InterviewPrepHandler) DeleteInterviewPrep(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	eventID, err := uuid.Parse(mux.Vars(request)["id"])
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.DeleteInterviewPrep: event ID is not a valid UUID")
+		http.Error(writer, "event ID is not a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	expectedVersion, err := GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.DeleteInterviewPrep: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = handler.interviewPrepService.DeleteInterviewPrep(request.Context(), &eventID, expectedVersion)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "DeleteInterviewPrep", "deleting interview prep", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// CreateInterviewQuestion adds a question to the interview prep of an interview or code test
+//
+// @Summary Create an interview question
+// @Description Add a question to the interview prep of an `event`. `askedToUs` questions were asked by the interviewer and `answer` is our answer. `askedByUs` questions were asked by us and `answer` is the interviewer's answer. The interview prep must exist.
+// @Tags interviewQuestion
+// @Accept json
+// @Produce json
+// @Param interviewQuestion body requests.CreateInterviewQuestionRequest true "Create interview question request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 201 {object} responses.InterviewQuestionResponse
+// @Failure 400
+// @Failure 409
+// @Failure 500
+// @Router /v1/interview-question/new [post]
+func (handler *InterviewPrepHandler) CreateInterviewQuestion(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var createRequest requests.CreateInterviewQuestionRequest
+	if err := json.NewDecoder(request.Body).Decode(&createRequest); err != nil {
+		logger.Info("v1.InterviewPrepHandler.CreateInterviewQuestion: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	questionModel, err := createRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.InterviewPrepHandler.CreateInterviewQuestion: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	question, err := handler.interviewPrepService.CreateInterviewQuestion(request.Context(), questionModel)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "CreateInterviewQuestion", "creating interview question", err)
+		return
+	}
+
+	// can return InternalServiceError
+	questionResponse, err := responses.NewInterviewQuestionResponse(question)
+	if err != nil {
+		logger.Error(
+			"v1.InterviewPrepHandler.CreateInterviewQuestion: Unable to convert internal model to response",
+			"error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeInterviewPrepResponse(writer, logger, "CreateInterviewQuestion", http.StatusCreated, questionResponse)
+}
+
+// GetInterviewQuestions retrieves the question bank of an application or a company
+//
+// @Summary Get interview questions of an application or a company
+// @Description Get every question from the interviews and code tests of an `application`, or of a `company`, newest event first. The events of a company are those associated with the company, and those associated with an application where it is the employer. Exactly one of `application-id` and `company-id` must be provided.
+// @Tags interviewQuestion
+// @Produce json
+// @Param application-id query string false "application ID" format(uuid)
+// @Param company-id query string false "company ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. question,answer. Default all"
+// @Success 200 {array} responses.InterviewQuestionResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/interview-question/get [get]
+func (handler *InterviewPrepHandler) GetInterviewQuestions(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	query := request.URL.Query()
+	applicationID, ok := parseOptionalUUIDParam(
+		writer, logger, "GetInterviewQuestions", "ApplicationID", query.Get("application-id"))
+	if !ok {
+		return
+	}
+	companyID, ok := parseOptionalUUIDParam(
+		writer, logger, "GetInterviewQuestions", "CompanyID", query.Get("company-id"))
+	if !ok {
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	questions, err := handler.interviewPrepService.GetInterviewQuestions(request.Context(), applicationID, companyID)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "GetInterviewQuestions", "retrieving interview questions", err)
+		return
+	}
+
+	// can return InternalServiceError
+	questionsResponse, err := responses.NewInterviewQuestionsResponse(questions)
+	if err != nil {
+		logger.Error(
+			"v1.InterviewPrepHandler.GetInterviewQuestions: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, questionsResponse, "v1.InterviewPrepHandler.GetInterviewQuestions")
+	if !ok {
+		return
+	}
+
+	writeInterviewPrepResponse(writer, logger, "GetInterviewQuestions", http.StatusOK, selectedResponse)
+}
+
+// UpdateInterviewQuestion updates an interview question
+//
+// @Summary Update an interview question
+// @Description Update the type, question, answer or topic of an interview question
+// @Tags interviewQuestion
+// @Accept json
+// @Param interviewQuestion body requests.UpdateInterviewQuestionRequest true "Update interview question request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/interview-question/update [post]
+func (handler *InterviewPrepHandler) UpdateInterviewQuestion(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateInterviewQuestionRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.InterviewPrepHandler.UpdateInterviewQuestion: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	questionModel, err := updateRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.InterviewPrepHandler.UpdateInterviewQuestion: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.interviewPrepService.UpdateInterviewQuestion(request.Context(), questionModel)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "UpdateInterviewQuestion", "updating interview question", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// DeleteInterviewQuestion deletes an interview question
+//
+// @Summary Delete an interview question
+// @Description Delete an interview question by ID
+// @Tags interviewQuestion
+// @Param id path string true "ID of the interview question" format(uuid)
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/interview-question/delete/{id} [delete]
+func (handler *InterviewPrepHandler) DeleteInterviewQuestion(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	questionID, err := uuid.Parse(mux.Vars(request)["id"])
+	if err != nil {
+		logger.Info("v1.InterviewPrepHandler.DeleteInterviewQuestion: question ID is not a valid UUID")
+		http.Error(writer, "question ID is not a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.interviewPrepService.DeleteInterviewQuestion(request.Context(), &questionID)
+	if err != nil {
+		writeInterviewPrepError(writer, logger, "DeleteInterviewQuestion", "deleting interview question", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// parseOptionalUUIDParam returns nil if value is empty. It writes a 400 response and returns false if value is not a
+// valid UUID.
+func parseOptionalUUIDParam(
+	writer http.ResponseWriter, logger *slog.Logger, method string, name string, value string) (*uuid.UUID, bool) {
+
+	if value == "" {
+		return nil, true
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil || id == uuid.Nil {
+		errorMessage := "Unable to parse " + name
+		logger.Info("v1.InterviewPrepHandler." + method + ": " + errorMessage)
+		http.Error(writer, errorMessage, http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &id, true
+}
+
+func writeInterviewPrepError(
+	writer http.ResponseWriter, logger *slog.Logger, method string, action string, err error) {
+
+	var conflictErr *internalErrors.ConflictError
+	var internalServiceErr *internalErrors.InternalServiceError
+	var notFoundErr *internalErrors.NotFoundError
+	var preconditionFailedErr *internalErrors.PreconditionFailedError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &conflictErr) {
+		logger.Info("v1.InterviewPrepHandler."+method+": ConflictError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusConflict)
+	} else if errors.As(err, &notFoundErr) {
+		logger.Info("v1.InterviewPrepHandler."+method+": NotFoundError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusNotFound)
+	} else if errors.As(err, &preconditionFailedErr) {
+		logger.Info("v1.InterviewPrepHandler."+method+": PreconditionFailedError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusPreconditionFailed)
+	} else if errors.As(err, &validationErr) {
+		logger.Info("v1.InterviewPrepHandler."+method+": ValidationError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage := "Internal service error while " + action
+		logger.Error("v1.InterviewPrepHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	} else {
+		errorMessage := "Unknown internal error while " + action
+		logger.Error("v1.InterviewPrepHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	}
+}
+
+func writeInterviewPrepResponse(
+	writer http.ResponseWriter, logger *slog.Logger, method string, status int, response any) {
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Error("v1.InterviewPrepHandler."+method+": Unable to write response", "error", err)
+	}
+}
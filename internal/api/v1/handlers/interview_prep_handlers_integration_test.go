@@ -0,0 +1,344 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupInterviewPrepHandler(t *testing.T) (
+	*handlers.InterviewPrepHandler,
+	*repositories.EventRepository,
+	*repositories.CompanyRepository,
+	*repositories.CompanyEventRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupInterviewPrepHandlerTestContainer(t, config)
+
+	var interviewPrepHandler *handlers.InterviewPrepHandler
+	var eventRepository *repositories.EventRepository
+	var companyRepository *repositories.CompanyRepository
+	var companyEventRepository *repositories.CompanyEventRepository
+	err := container.Invoke(func(
+		handler *handlers.InterviewPrepHandler,
+		event *repositories.EventRepository,
+		company *repositories.CompanyRepository,
+		companyEvent *repositories.CompanyEventRepository) {
+
+		interviewPrepHandler = handler
+		eventRepository = event
+		companyRepository = company
+		companyEventRepository = companyEvent
+	})
+	assert.NoError(t, err)
+
+	return interviewPrepHandler, eventRepository, companyRepository, companyEventRepository
+}
+
+func sendInterviewPrepRequest(
+	t *testing.T, handlerFunc http.HandlerFunc, method string, url string, body any,
+	urlVars map[string]string) *httptest.ResponseRecorder {
+
+	var requestBody bytes.Buffer
+	if body != nil {
+		assert.NoError(t, json.NewEncoder(&requestBody).Encode(body))
+	}
+
+	request, err := http.NewRequest(method, url, &requestBody)
+	assert.NoError(t, err)
+	if urlVars != nil {
+		request = mux.SetURLVars(request, urlVars)
+	}
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- CreateInterviewPrep tests: --------
+
+func TestCreateInterviewPrep_ShouldCreateAndReturnPrepWithQuestions(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{
+			EventID:         event.ID,
+			TechnicalTopics: testutil.ToPtr("System design"),
+			SelfRating:      testutil.ToPtr(4),
+		},
+		nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var createdResponse responses.InterviewPrepResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&createdResponse))
+	assert.Equal(t, event.ID, createdResponse.EventID)
+	assert.Equal(t, "System design", *createdResponse.TechnicalTopics)
+	assert.Equal(t, 4, *createdResponse.SelfRating)
+	assert.Empty(t, createdResponse.Questions)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewQuestion, http.MethodPost, "/api/v1/interview-question/new",
+		requests.CreateInterviewQuestionRequest{
+			EventID:      event.ID,
+			QuestionType: requests.InterviewQuestionTypeAskedToUs,
+			Question:     "How would you scale a chat service?",
+		},
+		nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var questionResponse responses.InterviewQuestionResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&questionResponse))
+	assert.Equal(t, requests.EventType(requests.EventTypeInterviewCompleted), questionResponse.EventType)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.GetInterviewPrepByID, http.MethodGet,
+		"/api/v1/interview-prep/get/id/"+event.ID.String(), nil, map[string]string{"id": event.ID.String()})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var retrievedResponse responses.InterviewPrepResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&retrievedResponse))
+	assert.Len(t, retrievedResponse.Questions, 1)
+	assert.Equal(t, questionResponse.ID, retrievedResponse.Questions[0].ID)
+	assert.Equal(t, "How would you scale a chat service?", retrievedResponse.Questions[0].Question)
+}
+
+func TestCreateInterviewPrep_ShouldReturnStatusBadRequestForWrongEventType(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeApplied
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestCreateInterviewPrep_ShouldReturnStatusConflictIfPrepExists(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeCodeTestCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+}
+
+// -------- GetInterviewPrepByID tests: --------
+
+func TestGetInterviewPrepByID_ShouldReturnStatusNotFoundForUnknownEvent(t *testing.T) {
+	interviewPrepHandler, _, _, _ := setupInterviewPrepHandler(t)
+
+	eventID := uuid.New().String()
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.GetInterviewPrepByID, http.MethodGet, "/api/v1/interview-prep/get/id/"+eventID,
+		nil, map[string]string{"id": eventID})
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestGetInterviewPrepByID_ShouldReturnETagThatChangesWithQuestions(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		request, err := http.NewRequest(
+			http.MethodGet, "/api/v1/interview-prep/get/id/"+event.ID.String(), nil)
+		assert.NoError(t, err)
+		request = mux.SetURLVars(request, map[string]string{"id": event.ID.String()})
+		request.Header.Set("If-None-Match", ifNoneMatch)
+
+		responseRecorder := httptest.NewRecorder()
+		interviewPrepHandler.GetInterviewPrepByID(responseRecorder, request)
+		return responseRecorder
+	}
+
+	responseRecorder = get("")
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"1"`, responseRecorder.Header().Get("ETag"))
+
+	responseRecorder = get(`"1"`)
+	assert.Equal(t, http.StatusNotModified, responseRecorder.Code)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewQuestion, http.MethodPost, "/api/v1/interview-question/new",
+		requests.CreateInterviewQuestionRequest{
+			EventID:      event.ID,
+			QuestionType: requests.InterviewQuestionTypeAskedByUs,
+			Question:     "What does a normal week look like?",
+		},
+		nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	// the prep is returned with its questions, so a new question is a new version
+	responseRecorder = get(`"1"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, `"2"`, responseRecorder.Header().Get("ETag"))
+}
+
+// -------- UpdateInterviewPrep tests: --------
+
+func TestUpdateInterviewPrep_ShouldReturnPreconditionFailedIfIfMatchIsStale(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	update := func(notes string, ifMatch string) *httptest.ResponseRecorder {
+		requestBytes, err := json.Marshal(requests.UpdateInterviewPrepRequest{EventID: event.ID, Notes: &notes})
+		assert.NoError(t, err)
+
+		request, err := http.NewRequest(
+			http.MethodPost, "/api/v1/interview-prep/update", bytes.NewBuffer(requestBytes))
+		assert.NoError(t, err)
+		request.Header.Set("If-Match", ifMatch)
+
+		responseRecorder := httptest.NewRecorder()
+		interviewPrepHandler.UpdateInterviewPrep(responseRecorder, request)
+		return responseRecorder
+	}
+
+	responseRecorder = update("First tab", `"1"`)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseRecorder = update("Second tab", `"1"`)
+	assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"precondition failed: Interview prep has been changed since it was read. Expected version 1, current "+
+			"version is 2\n",
+		responseRecorder.Body.String())
+}
+
+// -------- GetInterviewQuestions tests: --------
+
+func TestGetInterviewQuestions_ShouldReturnQuestionsOfCompany(t *testing.T) {
+	interviewPrepHandler, eventRepository, companyRepository, companyEventRepository := setupInterviewPrepHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	var eventType models.EventType = models.EventTypeCodeTestCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+	repositoryhelpers.AssociateCompanyEvent(t, companyEventRepository, company.ID, event.ID, nil)
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewQuestion, http.MethodPost, "/api/v1/interview-question/new",
+		requests.CreateInterviewQuestionRequest{
+			EventID:      event.ID,
+			QuestionType: requests.InterviewQuestionTypeAskedByUs,
+			Question:     "What does the team work on?",
+			Topic:        testutil.ToPtr("Team"),
+		},
+		nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.GetInterviewQuestions, http.MethodGet,
+		"/api/v1/interview-question/get?company-id="+company.ID.String(), nil, nil)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var questionsResponse []*responses.InterviewQuestionResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&questionsResponse))
+	assert.Len(t, questionsResponse, 1)
+	assert.Equal(t, "What does the team work on?", questionsResponse[0].Question)
+	assert.Equal(t, "Team", *questionsResponse[0].Topic)
+}
+
+func TestGetInterviewQuestions_ShouldReturnStatusBadRequestWithoutIDs(t *testing.T) {
+	interviewPrepHandler, _, _, _ := setupInterviewPrepHandler(t)
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.GetInterviewQuestions, http.MethodGet, "/api/v1/interview-question/get", nil, nil)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+// -------- DeleteInterviewPrep tests: --------
+
+func TestDeleteInterviewPrep_ShouldDeletePrepButKeepEvent(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	responseRecorder = sendInterviewPrepRequest(
+		t, interviewPrepHandler.DeleteInterviewPrep, http.MethodDelete,
+		"/api/v1/interview-prep/delete/"+event.ID.String(), nil, map[string]string{"id": event.ID.String()})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err := eventRepository.GetByID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+}
+
+func TestDeleteInterviewPrep_ShouldReturnPreconditionFailedIfIfMatchIsStale(t *testing.T) {
+	interviewPrepHandler, eventRepository, _, _ := setupInterviewPrepHandler(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	responseRecorder := sendInterviewPrepRequest(
+		t, interviewPrepHandler.CreateInterviewPrep, http.MethodPost, "/api/v1/interview-prep/new",
+		requests.CreateInterviewPrepRequest{EventID: event.ID}, nil)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	request, err := http.NewRequest(
+		http.MethodDelete, "/api/v1/interview-prep/delete/"+event.ID.String(), nil)
+	assert.NoError(t, err)
+	request = mux.SetURLVars(request, map[string]string{"id": event.ID.String()})
+	request.Header.Set("If-Match", `"2"`)
+
+	responseRecorder = httptest.NewRecorder()
+	interviewPrepHandler.DeleteInterviewPrep(responseRecorder, request)
+	assert.Equal(t, http.StatusPreconditionFailed, responseRecorder.Code)
+}
@@ -0,0 +1,310 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+// maxJobAdSize is the largest saved job ad, in bytes, that is accepted.
+const maxJobAdSize = 5 << 20
+
+type JobAdSnapshotHandler struct {
+	jobAdSnapshotService *services.JobAdSnapshotService
+}
+
+func NewJobAdSnapshotHandler(jobAdSnapshotService *services.JobAdSnapshotService) *JobAdSnapshotHandler {
+	return &JobAdSnapshotHandler{jobAdSnapshotService: jobAdSnapshotService}
+}
+
+// ParseJobAd reads a saved job ad without storing it
+//
+// @Summary Parse a saved job ad
+// @Description Read the job title, company, location, remote hints and salary from a saved job ad, without storing it. The job ad is either the HTML page of the ad or a schema.org `JobPosting` in JSON-LD, sent as the request body or as the `file` field of a multipart form. A `JobPosting` embedded in an HTML page is preferred over the rest of the page. Nothing is fetched from the network. `application` can be used as a starting point for `/v1/application/new` once a company or recruiter has been chosen. At most 5 MiB is accepted.
+// @Tags jobAdSnapshot
+// @Accept html
+// @Accept json
+// @Accept mpfd
+// @Produce json
+// @Param jobAd body string true "The saved job ad"
+// @Success 200 {object} responses.JobAdPostingResponse
+// @Failure 400
+// @Failure 413
+// @Failure 500
+// @Router /v1/job-ad-snapshot/parse [post]
+func (handler *JobAdSnapshotHandler) ParseJobAd(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	content, ok := readJobAd(writer, request, logger, "ParseJobAd")
+	if !ok {
+		return
+	}
+
+	// can return ValidationError
+	posting, err := handler.jobAdSnapshotService.ParseJobAd(request.Context(), content)
+	if err != nil {
+		writeJobAdSnapshotError(writer, logger, "ParseJobAd", "parsing job ad", err)
+		return
+	}
+
+	// can return InternalServiceError
+	postingResponse, err := responses.NewJobAdPostingResponse(posting)
+	if err != nil {
+		logger.Error("v1.JobAdSnapshotHandler.ParseJobAd: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeJobAdSnapshotResponse(writer, logger, "ParseJobAd", http.StatusOK, postingResponse)
+}
+
+// CreateJobAdSnapshot stores a saved job ad for an application
+//
+// @Summary Create a job ad snapshot
+// @Description Store a saved job ad for the `application-id` application, together with what could be read from it. The job ad is sent the same way as for `/v1/job-ad-snapshot/parse`. The response does not include `content`.
+// @Tags jobAdSnapshot
+// @Accept html
+// @Accept json
+// @Accept mpfd
+// @Produce json
+// @Param application-id query string true "application ID" format(uuid)
+// @Param jobAd body string true "The saved job ad"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 201 {object} responses.JobAdSnapshotResponse
+// @Failure 400
+// @Failure 409
+// @Failure 413
+// @Failure 500
+// @Router /v1/job-ad-snapshot/new [post]
+func (handler *JobAdSnapshotHandler) CreateJobAdSnapshot(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	applicationID, err := uuid.Parse(request.URL.Query().Get("application-id"))
+	if err != nil || applicationID == uuid.Nil {
+		errorMessage := "Unable to parse ApplicationID"
+		logger.Info("v1.JobAdSnapshotHandler.CreateJobAdSnapshot: " + errorMessage)
+		http.Error(writer, errorMessage, http.StatusBadRequest)
+		return
+	}
+
+	content, ok := readJobAd(writer, request, logger, "CreateJobAdSnapshot")
+	if !ok {
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	snapshot, err := handler.jobAdSnapshotService.CreateJobAdSnapshot(request.Context(), &applicationID, content)
+	if err != nil {
+		writeJobAdSnapshotError(writer, logger, "CreateJobAdSnapshot", "creating job ad snapshot", err)
+		return
+	}
+
+	// can return InternalServiceError
+	snapshotResponse, err := responses.NewJobAdSnapshotResponse(snapshot)
+	if err != nil {
+		logger.Error(
+			"v1.JobAdSnapshotHandler.CreateJobAdSnapshot: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeJobAdSnapshotResponse(writer, logger, "CreateJobAdSnapshot", http.StatusCreated, snapshotResponse)
+}
+
+// GetJobAdSnapshotByID retrieves a job ad snapshot, including the saved job ad
+//
+// @Summary Get a job ad snapshot by ID
+// @Description Get a `jobAdSnapshot` by ID, including the saved job ad in `content`
+// @Tags jobAdSnapshot
+// @Produce json
+// @Param id path string true "ID of the job ad snapshot" format(uuid)
+// @Param If-None-Match header string false "ETag of the job ad snapshot the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. job_title,company_name. Default all"
+// @Success 200 {object} responses.JobAdSnapshotResponse
+// @Header 200 {string} ETag "Version of the job ad snapshot, which never changes"
+// @Failure 304
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/job-ad-snapshot/get/id/{id} [get]
+func (handler *JobAdSnapshotHandler) GetJobAdSnapshotByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	snapshotID, err := uuid.Parse(mux.Vars(request)["id"])
+	if err != nil {
+		logger.Info("v1.JobAdSnapshotHandler.GetJobAdSnapshotByID: job ad snapshot ID is not a valid UUID")
+		http.Error(writer, "job ad snapshot ID is not a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	snapshot, err := handler.jobAdSnapshotService.GetJobAdSnapshotById(request.Context(), &snapshotID)
+	if err != nil {
+		writeJobAdSnapshotError(writer, logger, "GetJobAdSnapshotByID", "retrieving job ad snapshot", err)
+		return
+	}
+
+	// A snapshot is never changed once it is saved, so it has no version column and is always at its first version.
+	// can return InternalServiceError
+	snapshotResponse, err := responses.NewJobAdSnapshotResponse(snapshot)
+	if err != nil {
+		logger.Error(
+			"v1.JobAdSnapshotHandler.GetJobAdSnapshotByID: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, snapshotResponse, "v1.JobAdSnapshotHandler.GetJobAdSnapshotByID")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, 1)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writeJobAdSnapshotResponse(writer, logger, "GetJobAdSnapshotByID", http.StatusOK, selectedResponse)
+}
+
+// GetJobAdSnapshotsByApplicationID retrieves the job ad snapshots of an application
+//
+// @Summary Get the job ad snapshots of an application
+// @Description Get the `jobAdSnapshot`s of the `application-id` application, newest first. `content` is not included.
+// @Tags jobAdSnapshot
+// @Produce json
+// @Param application-id query string true "application ID" format(uuid)
+// @Param fields query string false "Comma-separated attributes to return, e.g. job_title,company_name. Default all"
+// @Success 200 {array} responses.JobAdSnapshotResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/job-ad-snapshot/get [get]
+func (handler *JobAdSnapshotHandler) GetJobAdSnapshotsByApplicationID(
+	writer http.ResponseWriter, request *http.Request) {
+
+	logger := logging.FromContext(request.Context())
+
+	applicationID, err := uuid.Parse(request.URL.Query().Get("application-id"))
+	if err != nil || applicationID == uuid.Nil {
+		errorMessage := "Unable to parse ApplicationID"
+		logger.Info("v1.JobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID: " + errorMessage)
+		http.Error(writer, errorMessage, http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	snapshots, err := handler.jobAdSnapshotService.GetJobAdSnapshotsByApplicationID(request.Context(), &applicationID)
+	if err != nil {
+		writeJobAdSnapshotError(
+			writer, logger, "GetJobAdSnapshotsByApplicationID", "retrieving job ad snapshots", err)
+		return
+	}
+
+	// can return InternalServiceError
+	snapshotsResponse, err := responses.NewJobAdSnapshotsResponse(snapshots)
+	if err != nil {
+		logger.Error(
+			"v1.JobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID: Unable to convert internal model to response",
+			"error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, snapshotsResponse, "v1.JobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID")
+	if !ok {
+		return
+	}
+
+	writeJobAdSnapshotResponse(writer, logger, "GetJobAdSnapshotsByApplicationID", http.StatusOK, selectedResponse)
+}
+
+// readJobAd returns the request body, or the `file` field of a multipart form. It writes an error response and
+// returns false if the job ad cannot be read or is larger than maxJobAdSize.
+func readJobAd(
+	writer http.ResponseWriter, request *http.Request, logger *slog.Logger, method string) ([]byte, bool) {
+
+	request.Body = http.MaxBytesReader(writer, request.Body, maxJobAdSize)
+
+	var reader io.Reader = request.Body
+	mediaType, _, _ := mime.ParseMediaType(request.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		file, _, err := request.FormFile("file")
+		if err != nil {
+			return nil, writeReadJobAdError(writer, logger, method, err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, writeReadJobAdError(writer, logger, method, err)
+	}
+
+	return content, true
+}
+
+func writeReadJobAdError(writer http.ResponseWriter, logger *slog.Logger, method string, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		logger.Info("v1.JobAdSnapshotHandler."+method+": job ad is too large", "limit", maxBytesErr.Limit)
+		http.Error(writer, "job ad is larger than 5 MiB", http.StatusRequestEntityTooLarge)
+		return false
+	}
+
+	logger.Info("v1.JobAdSnapshotHandler."+method+": Unable to read job ad", "error", err)
+	http.Error(writer, "Unable to read job ad: "+err.Error(), http.StatusBadRequest)
+	return false
+}
+
+func writeJobAdSnapshotError(
+	writer http.ResponseWriter, logger *slog.Logger, method string, action string, err error) {
+
+	var conflictErr *internalErrors.ConflictError
+	var internalServiceErr *internalErrors.InternalServiceError
+	var notFoundErr *internalErrors.NotFoundError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &conflictErr) {
+		logger.Info("v1.JobAdSnapshotHandler."+method+": ConflictError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusConflict)
+	} else if errors.As(err, &notFoundErr) {
+		logger.Info("v1.JobAdSnapshotHandler."+method+": NotFoundError while "+action, "error", err)
+		http.Error(writer, "job ad snapshot not found", http.StatusNotFound)
+	} else if errors.As(err, &validationErr) {
+		logger.Info("v1.JobAdSnapshotHandler."+method+": ValidationError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage := "Internal service error while " + action
+		logger.Error("v1.JobAdSnapshotHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	} else {
+		errorMessage := "Unknown internal error while " + action
+		logger.Error("v1.JobAdSnapshotHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	}
+}
+
+func writeJobAdSnapshotResponse(
+	writer http.ResponseWriter, logger *slog.Logger, method string, status int, response any) {
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Error("v1.JobAdSnapshotHandler."+method+": Unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,269 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupJobAdSnapshotHandler(t *testing.T) (
+	*handlers.JobAdSnapshotHandler, *repositories.ApplicationRepository, *repositories.CompanyRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupJobAdSnapshotHandlerTestContainer(t, config)
+
+	var jobAdSnapshotHandler *handlers.JobAdSnapshotHandler
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		handler *handlers.JobAdSnapshotHandler,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository) {
+
+		jobAdSnapshotHandler = handler
+		applicationRepository = application
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	return jobAdSnapshotHandler, applicationRepository, companyRepository
+}
+
+func readJobAdTestdata(t *testing.T, name string) []byte {
+	content, err := os.ReadFile("../../../jobad/testdata/" + name)
+	assert.NoError(t, err)
+	return content
+}
+
+func postJobAd(
+	t *testing.T, handlerFunc http.HandlerFunc, url string, contentType string,
+	content []byte) *httptest.ResponseRecorder {
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(content))
+	assert.NoError(t, err)
+	request.Header.Set("Content-Type", contentType)
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- ParseJobAd tests: --------
+
+func TestParseJobAd_ShouldPrefillApplicationFromJSONLD(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.ParseJobAd, "/api/v1/job-ad-snapshot/parse", "application/ld+json",
+		readJobAdTestdata(t, "job_posting.jsonld"))
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var postingResponse responses.JobAdPostingResponse
+	err := json.NewDecoder(responseRecorder.Body).Decode(&postingResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Senior Backend Developer", *postingResponse.Application.JobTitle)
+	assert.Equal(
+		t, "https://careers.acme.se/jobs/1234-senior-backend-developer", *postingResponse.Application.JobAdURL)
+	assert.Equal(t, "SE", *postingResponse.Application.Country)
+	assert.Equal(t, "Stockholm", *postingResponse.Application.Area)
+	assert.Equal(
+		t, requests.RemoteStatusType(requests.RemoteStatusTypeHybrid), postingResponse.Application.RemoteStatusType)
+	assert.Nil(t, postingResponse.Application.CompanyID)
+	assert.Equal(t, "Acme AB", *postingResponse.CompanyName)
+	assert.Equal(t, "55000-70000 SEK per month", *postingResponse.Salary)
+}
+
+func TestParseJobAd_ShouldAcceptMultipartFile(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	var body bytes.Buffer
+	multipartWriter := multipart.NewWriter(&body)
+	fileWriter, err := multipartWriter.CreateFormFile("file", "job_ad_without_json_ld.html")
+	assert.NoError(t, err)
+	_, err = fileWriter.Write(readJobAdTestdata(t, "job_ad_without_json_ld.html"))
+	assert.NoError(t, err)
+	assert.NoError(t, multipartWriter.Close())
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.ParseJobAd, "/api/v1/job-ad-snapshot/parse", multipartWriter.FormDataContentType(),
+		body.Bytes())
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var postingResponse responses.JobAdPostingResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&postingResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Data Engineer", *postingResponse.Application.JobTitle)
+	assert.Equal(t, "https://hooli.xyz/jobs/data-engineer", *postingResponse.Application.JobAdURL)
+	assert.Equal(
+		t, requests.RemoteStatusType(requests.RemoteStatusTypeRemote), postingResponse.Application.RemoteStatusType)
+	assert.Equal(t, "Hooli", *postingResponse.CompanyName)
+}
+
+func TestParseJobAd_ShouldReturnBadRequestForMultipartWithoutFile(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	var body bytes.Buffer
+	multipartWriter := multipart.NewWriter(&body)
+	assert.NoError(t, multipartWriter.WriteField("notes", "no file"))
+	assert.NoError(t, multipartWriter.Close())
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.ParseJobAd, "/api/v1/job-ad-snapshot/parse", multipartWriter.FormDataContentType(),
+		body.Bytes())
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+func TestParseJobAd_ShouldReturnBadRequestIfThereIsNoJobPosting(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.ParseJobAd, "/api/v1/job-ad-snapshot/parse", "application/json",
+		readJobAdTestdata(t, "not_a_job_posting.json"))
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error: job ad does not contain a JobPosting\n", responseRecorder.Body.String())
+}
+
+func TestParseJobAd_ShouldReturnRequestEntityTooLargeForHugeJobAd(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	content := []byte("<html><body>" + strings.Repeat("a", 5<<20) + "</body></html>")
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.ParseJobAd, "/api/v1/job-ad-snapshot/parse", "text/html", content)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, responseRecorder.Code)
+}
+
+// -------- CreateJobAdSnapshot tests: --------
+
+func TestCreateJobAdSnapshot_ShouldCreateAndRetrieveSnapshot(t *testing.T) {
+	jobAdSnapshotHandler, applicationRepository, companyRepository := setupJobAdSnapshotHandler(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	content := readJobAdTestdata(t, "job_ad_with_json_ld.html")
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.CreateJobAdSnapshot,
+		"/api/v1/job-ad-snapshot/new?application-id="+application.ID.String(), "text/html", content)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var createdResponse responses.JobAdSnapshotResponse
+	err := json.NewDecoder(responseRecorder.Body).Decode(&createdResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, application.ID, createdResponse.ApplicationID)
+	assert.Equal(t, "html", createdResponse.SourceType)
+	assert.Equal(t, "https://jobs.globex.com/frontend-developer-4711", *createdResponse.JobAdURL)
+	assert.Equal(t, "48000 SEK", *createdResponse.Salary)
+	assert.Nil(t, createdResponse.Content)
+
+	// get by ID includes the content
+	getRequest, err := http.NewRequest(
+		http.MethodGet, "/api/v1/job-ad-snapshot/get/id/"+createdResponse.ID.String(), nil)
+	assert.NoError(t, err)
+	getRequest = mux.SetURLVars(getRequest, map[string]string{"id": createdResponse.ID.String()})
+
+	getResponseRecorder := httptest.NewRecorder()
+	jobAdSnapshotHandler.GetJobAdSnapshotByID(getResponseRecorder, getRequest)
+	assert.Equal(t, http.StatusOK, getResponseRecorder.Code)
+
+	var retrievedResponse responses.JobAdSnapshotResponse
+	err = json.NewDecoder(getResponseRecorder.Body).Decode(&retrievedResponse)
+	assert.NoError(t, err)
+	assert.Equal(t, createdResponse.ID, retrievedResponse.ID)
+	assert.Equal(t, string(content), *retrievedResponse.Content)
+
+	// a snapshot never changes, so a client that has it can keep it
+	assert.Equal(t, `"1"`, getResponseRecorder.Header().Get("ETag"))
+	getRequest.Header.Set("If-None-Match", `"1"`)
+	getResponseRecorder = httptest.NewRecorder()
+	jobAdSnapshotHandler.GetJobAdSnapshotByID(getResponseRecorder, getRequest)
+	assert.Equal(t, http.StatusNotModified, getResponseRecorder.Code)
+
+	// get by application ID does not include the content
+	listRequest, err := http.NewRequest(
+		http.MethodGet, "/api/v1/job-ad-snapshot/get?application-id="+application.ID.String(), nil)
+	assert.NoError(t, err)
+
+	listResponseRecorder := httptest.NewRecorder()
+	jobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID(listResponseRecorder, listRequest)
+	assert.Equal(t, http.StatusOK, listResponseRecorder.Code)
+
+	var listResponse []*responses.JobAdSnapshotResponse
+	err = json.NewDecoder(listResponseRecorder.Body).Decode(&listResponse)
+	assert.NoError(t, err)
+	assert.Len(t, listResponse, 1)
+	assert.Equal(t, createdResponse.ID, listResponse[0].ID)
+	assert.Nil(t, listResponse[0].Content)
+}
+
+func TestCreateJobAdSnapshot_ShouldReturnBadRequestWithoutApplicationID(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.CreateJobAdSnapshot, "/api/v1/job-ad-snapshot/new", "application/json",
+		readJobAdTestdata(t, "job_posting.jsonld"))
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "Unable to parse ApplicationID\n", responseRecorder.Body.String())
+}
+
+func TestCreateJobAdSnapshot_ShouldReturnBadRequestIfApplicationDoesNotExist(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	responseRecorder := postJobAd(
+		t, jobAdSnapshotHandler.CreateJobAdSnapshot,
+		"/api/v1/job-ad-snapshot/new?application-id="+uuid.New().String(), "application/json",
+		readJobAdTestdata(t, "job_posting.jsonld"))
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+}
+
+// -------- GetJobAdSnapshotByID tests: --------
+
+func TestGetJobAdSnapshotByID_ShouldReturnNotFoundForUnknownID(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	id := uuid.New().String()
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/job-ad-snapshot/get/id/"+id, nil)
+	assert.NoError(t, err)
+	request = mux.SetURLVars(request, map[string]string{"id": id})
+
+	responseRecorder := httptest.NewRecorder()
+	jobAdSnapshotHandler.GetJobAdSnapshotByID(responseRecorder, request)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+// -------- GetJobAdSnapshotsByApplicationID tests: --------
+
+func TestGetJobAdSnapshotsByApplicationID_ShouldReturnEmptyArrayForApplicationWithoutSnapshots(t *testing.T) {
+	jobAdSnapshotHandler, _, _ := setupJobAdSnapshotHandler(t)
+
+	request, err := http.NewRequest(
+		http.MethodGet, "/api/v1/job-ad-snapshot/get?application-id="+uuid.New().String(), nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	jobAdSnapshotHandler.GetJobAdSnapshotsByApplicationID(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "[]\n", responseRecorder.Body.String())
+}
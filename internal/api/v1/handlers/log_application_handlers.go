@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"net/http"
+)
+
+type LogApplicationHandler struct {
+	logApplicationService *services.LogApplicationService
+}
+
+func NewLogApplicationHandler(logApplicationService *services.LogApplicationService) *LogApplicationHandler {
+	return &LogApplicationHandler{logApplicationService: logApplicationService}
+}
+
+// LogApplication creates an application together with its company, recruiter, persons and initial event
+//
+// @Summary log a new application
+// @Description create an `application`, an optional inline `company` and `recruiter`, new `persons` and the initial `event`, and associate the persons in `persons` and `person_ids` and the event with the application. Everything is created in one transaction: if any part fails, nothing is created. `application.company_id` and `application.recruiter_id` may be omitted when `company` and `recruiter` are given. The response includes the company, recruiter, persons and events. If an application with the same normalized `job_ad_url`, or with the same company and `job_title`, already exists, 409 is returned and nothing is created, unless `force=true` is passed.
+// @Tags application
+// @Accept json
+// @Produce json
+// @Param application body requests.LogApplicationRequest true "Log Application request"
+// @Param force query bool false "Create the application even if it looks like a duplicate"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 201 {object} responses.ApplicationResponse
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /v1/application/log [post]
+func (logApplicationHandler *LogApplicationHandler) LogApplication(
+	writer http.ResponseWriter, request *http.Request) {
+
+	logger := logging.FromContext(request.Context())
+
+	var logApplicationRequest requests.LogApplicationRequest
+	if err := json.NewDecoder(request.Body).Decode(&logApplicationRequest); err != nil {
+		logger.Info("v1.LogApplicationHandler.LogApplication: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	logApplicationModel, err := logApplicationRequest.ToModel()
+	if err != nil {
+		logger.Info(
+			"v1.LogApplicationHandler.LogApplication: Unable to convert LogApplicationRequest to model",
+			"error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	logApplicationModel.Force, err = GetForceParam(request.URL.Query().Get("force"))
+	if err != nil {
+		logger.Info("v1.LogApplicationHandler.LogApplication: Could not parse force param", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+	application, err := logApplicationHandler.logApplicationService.LogApplication(
+		request.Context(), logApplicationModel)
+	if err != nil {
+		var conflictErr *internalErrors.ConflictError
+		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		var errorMessage string
+		var status int
+
+		if errors.As(err, &conflictErr) {
+			errorMessage = err.Error()
+			status = http.StatusConflict
+			logger.Info("v1.LogApplicationHandler.LogApplication: ConflictError logging application", "error", err)
+		} else if errors.As(err, &internalServiceErr) {
+			errorMessage = "Internal service error while logging application"
+			status = http.StatusInternalServerError
+			logger.Error("v1.LogApplicationHandler.LogApplication: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundErr) {
+			errorMessage = err.Error()
+			status = http.StatusNotFound
+			logger.Info("v1.LogApplicationHandler.LogApplication: NotFoundError logging application", "error", err)
+		} else if errors.As(err, &validationErr) {
+			errorMessage = err.Error()
+			status = http.StatusBadRequest
+			logger.Info("v1.LogApplicationHandler.LogApplication: ValidationError logging application", "error", err)
+		} else {
+			errorMessage = "Unknown internal error while logging application"
+			status = http.StatusInternalServerError
+			logger.Error("v1.LogApplicationHandler.LogApplication: Error while logging application", "error", err)
+		}
+		http.Error(writer, errorMessage, status)
+
+		return
+	}
+
+	// can return InternalServiceError
+	applicationResponse, err := responses.NewApplicationResponse(application)
+	if err != nil {
+		logger.Error(
+			"v1.LogApplicationHandler.LogApplication: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(writer).Encode(applicationResponse)
+	if err != nil {
+		logger.Error("v1.LogApplicationHandler.LogApplication: Unable to write response", "error", err)
+		return
+	}
+}
@@ -0,0 +1,214 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupLogApplicationHandler(t *testing.T) (
+	*handlers.LogApplicationHandler,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupLogApplicationHandlerTestContainer(t, config)
+
+	var logApplicationHandler *handlers.LogApplicationHandler
+	err := container.Invoke(func(handler *handlers.LogApplicationHandler) {
+		logApplicationHandler = handler
+	})
+	assert.NoError(t, err)
+
+	var applicationRepository *repositories.ApplicationRepository
+	err = container.Invoke(func(repository *repositories.ApplicationRepository) {
+		applicationRepository = repository
+	})
+	assert.NoError(t, err)
+
+	var companyRepository *repositories.CompanyRepository
+	err = container.Invoke(func(repository *repositories.CompanyRepository) {
+		companyRepository = repository
+	})
+	assert.NoError(t, err)
+
+	var personRepository *repositories.PersonRepository
+	err = container.Invoke(func(repository *repositories.PersonRepository) {
+		personRepository = repository
+	})
+	assert.NoError(t, err)
+
+	return logApplicationHandler, applicationRepository, companyRepository, personRepository
+}
+
+func postLogApplication(
+	t *testing.T,
+	logApplicationHandler *handlers.LogApplicationHandler,
+	requestBody requests.LogApplicationRequest) *httptest.ResponseRecorder {
+
+	requestBytes, err := json.Marshal(requestBody)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, "/api/v1/application/log", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+	logApplicationHandler.LogApplication(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- LogApplication tests: --------
+
+func TestLogApplication_ShouldCreateAndReturnPopulatedApplication(t *testing.T) {
+	logApplicationHandler, applicationRepository, _, personRepository := setupLogApplicationHandler(t)
+
+	existingPerson := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+
+	requestBody := requests.LogApplicationRequest{
+		Application: requests.CreateApplicationRequest{
+			ID:               testutil.ToPtr(uuid.New()),
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: requests.RemoteStatusTypeRemote,
+		},
+		Company:   &requests.CreateCompanyRequest{Name: "Employer", CompanyType: requests.CompanyTypeEmployer},
+		Recruiter: &requests.CreateCompanyRequest{Name: "Recruiter", CompanyType: requests.CompanyTypeRecruiter},
+		Persons: []*requests.CreatePersonRequest{
+			{Name: "Hiring Manager", PersonType: requests.PersonTypeCTO},
+		},
+		PersonIDs: []uuid.UUID{existingPerson.ID},
+		Event:     &requests.CreateEventRequest{EventType: requests.EventTypeApplied, EventDate: time.Now()},
+	}
+
+	responseRecorder := postLogApplication(t, logApplicationHandler, requestBody)
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var applicationResponse responses.ApplicationResponse
+	err := json.NewDecoder(responseRecorder.Body).Decode(&applicationResponse)
+	assert.NoError(t, err)
+
+	assert.Equal(t, *requestBody.Application.ID, applicationResponse.ID)
+	assert.Equal(t, "Employer", *applicationResponse.Company.Name)
+	assert.Equal(t, applicationResponse.Company.ID, *applicationResponse.CompanyID)
+	assert.Equal(t, "Recruiter", *applicationResponse.Recruiter.Name)
+	assert.Equal(t, applicationResponse.Recruiter.ID, *applicationResponse.RecruiterID)
+
+	assert.Len(t, *applicationResponse.Persons, 2)
+	assert.Equal(t, "Hiring Manager", *(*applicationResponse.Persons)[0].Name)
+	assert.Equal(t, existingPerson.ID, (*applicationResponse.Persons)[1].ID)
+
+	assert.Len(t, *applicationResponse.Events, 1)
+	assert.Equal(t, requests.EventTypeApplied, (*applicationResponse.Events)[0].EventType.String())
+
+	storedApplication, err := applicationRepository.GetById(context.Background(), requestBody.Application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, applicationResponse.CompanyID, storedApplication.CompanyID)
+}
+
+func TestLogApplication_ShouldReturnStatusConflictAndCreateNothingIfApplicationIDIsDuplicate(t *testing.T) {
+	logApplicationHandler, applicationRepository, companyRepository, _ := setupLogApplicationHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	existingApplication := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &company.ID, nil, nil)
+
+	recruiterID := uuid.New()
+	requestBody := requests.LogApplicationRequest{
+		Application: requests.CreateApplicationRequest{
+			ID:               &existingApplication.ID,
+			CompanyID:        &company.ID,
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: requests.RemoteStatusTypeRemote,
+		},
+		Recruiter: &requests.CreateCompanyRequest{
+			ID:          &recruiterID,
+			Name:        "Recruiter",
+			CompanyType: requests.CompanyTypeRecruiter,
+		},
+	}
+
+	responseRecorder := postLogApplication(t, logApplicationHandler, requestBody)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+
+	recruiter, err := companyRepository.GetById(context.Background(), &recruiterID)
+	assert.Nil(t, recruiter)
+	assert.Error(t, err)
+}
+
+func TestLogApplication_ShouldReturnStatusNotFoundIfCompanyDoesNotExist(t *testing.T) {
+	logApplicationHandler, _, _, _ := setupLogApplicationHandler(t)
+
+	requestBody := requests.LogApplicationRequest{
+		Application: requests.CreateApplicationRequest{
+			CompanyID:        testutil.ToPtr(uuid.New()),
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: requests.RemoteStatusTypeRemote,
+		},
+	}
+
+	responseRecorder := postLogApplication(t, logApplicationHandler, requestBody)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
+
+func TestLogApplication_ShouldReturnStatusConflictIfJobAdURLIsDuplicateUnlessForced(t *testing.T) {
+	logApplicationHandler, applicationRepository, companyRepository, _ := setupLogApplicationHandler(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	existingApplication, err := applicationRepository.Create(context.Background(), &models.CreateApplication{
+		CompanyID:        &company.ID,
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/123"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	requestBody := requests.LogApplicationRequest{
+		Application: requests.CreateApplicationRequest{
+			JobAdURL:         testutil.ToPtr("https://www.acme.se/jobs/123/?utm_source=newsletter"),
+			RemoteStatusType: requests.RemoteStatusTypeRemote,
+		},
+		Recruiter: &requests.CreateCompanyRequest{Name: "Recruiter", CompanyType: requests.CompanyTypeRecruiter},
+	}
+
+	responseRecorder := postLogApplication(t, logApplicationHandler, requestBody)
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+	assert.Contains(t, responseRecorder.Body.String(), existingApplication.ID.String())
+
+	companies, err := companyRepository.GetAll(
+		context.Background(),
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, companies, 1, "the recruiter should not have been created")
+
+	requestBytes, err := json.Marshal(requestBody)
+	assert.NoError(t, err)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/application/log?force=true", bytes.NewBuffer(requestBytes))
+	assert.NoError(t, err)
+
+	forcedResponseRecorder := httptest.NewRecorder()
+	logApplicationHandler.LogApplication(forcedResponseRecorder, request)
+	assert.Equal(t, http.StatusCreated, forcedResponseRecorder.Code)
+}
@@ -0,0 +1,71 @@
+package handlers_test
+
+import (
+	"bytes"
+	v1 "jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/testutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- LogApplication tests: --------
+
+func TestLogApplication_ShouldRespondWithBadRequestStatus(t *testing.T) {
+	tests := []struct {
+		testName             string
+		inputRequest         *string
+		expectedErrorMessage string
+	}{
+		{
+			testName:             "body is nil",
+			inputRequest:         nil,
+			expectedErrorMessage: "invalid request body: Unable to parse JSON\n",
+		},
+		{
+			testName:             "malformed json",
+			inputRequest:         testutil.ToPtr(`"application":{}`),
+			expectedErrorMessage: "invalid request body: Unable to parse JSON\n",
+		},
+		{
+			testName:             "application is missing",
+			inputRequest:         testutil.ToPtr(`{"event":{"event_type":"applied"}}`),
+			expectedErrorMessage: "validation error: CompanyID and RecruiterID cannot both be empty\n",
+		},
+		{
+			testName: "company_id does not match company",
+			inputRequest: testutil.ToPtr(`{
+				"application":{"company_id":"8abb5944-761b-447c-8a77-11ba1108ff68","job_title":"Job","remote_status_type":"hybrid"},
+				"company":{"id":"1b6f5b5e-0c39-4e43-9f3b-3f7dc0b8c7b1","name":"Company","company_type":"employer"}}`),
+			expectedErrorMessage: "validation error on field 'company': application.company_id does not match company.id\n",
+		},
+		{
+			testName: "event type is invalid",
+			inputRequest: testutil.ToPtr(`{
+				"application":{"company_id":"8abb5944-761b-447c-8a77-11ba1108ff68","job_title":"Job","remote_status_type":"hybrid"},
+				"event":{"event_type":"Blah"}}`),
+			expectedErrorMessage: "validation error on field 'eventType': event type is invalid\n",
+		},
+	}
+
+	for _, test := range tests {
+		logApplicationHandler := v1.NewLogApplicationHandler(nil)
+		t.Run(test.testName, func(t *testing.T) {
+			var requestBody []byte
+			if test.inputRequest != nil {
+				requestBody = []byte(*test.inputRequest)
+			}
+
+			request, err := http.NewRequest(http.MethodPost, "/api/v1/application/log", bytes.NewBuffer(requestBody))
+			assert.NoError(t, err)
+
+			responseRecorder := httptest.NewRecorder()
+
+			logApplicationHandler.LogApplication(responseRecorder, request)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Equal(t, test.expectedErrorMessage, responseRecorder.Body.String())
+		})
+	}
+}
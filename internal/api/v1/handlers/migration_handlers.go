@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/services"
+	"net/http"
+)
+
+type MigrationHandler struct {
+	migrationService *services.MigrationService
+}
+
+func NewMigrationHandler(migrationService *services.MigrationService) *MigrationHandler {
+	return &MigrationHandler{migrationService: migrationService}
+}
+
+// GetMigrationStatus returns the current schema version of the database
+//
+// @Summary Get the migration status
+// @Description Get the current schema `version`, whether the database is `dirty`, and which migrations are applied or pending
+// @Tags admin
+// @Produce json
+// @Success 200 {object} responses.MigrationStatusResponse
+// @Failure 500
+// @Router /v1/admin/migrations/status [get]
+func (handler *MigrationHandler) GetMigrationStatus(writer http.ResponseWriter, request *http.Request) {
+	// can return InternalServiceError
+	status, err := handler.migrationService.GetStatus()
+	if err != nil {
+		handler.writeError(writer, request, "GetMigrationStatus", err)
+		return
+	}
+
+	handler.writeResponse(writer, request, "GetMigrationStatus", responses.NewMigrationStatusResponse(status))
+}
+
+// MigrateUp applies all pending migrations
+//
+// @Summary Apply pending migrations
+// @Description Apply all pending up migrations
+// @Tags admin
+// @Produce json
+// @Success 200 {object} responses.MigrationStatusResponse
+// @Failure 409
+// @Failure 500
+// @Router /v1/admin/migrations/up [post]
+func (handler *MigrationHandler) MigrateUp(writer http.ResponseWriter, request *http.Request) {
+	// can return ConflictError, InternalServiceError
+	status, err := handler.migrationService.MigrateUp()
+	if err != nil {
+		handler.writeError(writer, request, "MigrateUp", err)
+		return
+	}
+
+	handler.writeResponse(writer, request, "MigrateUp", responses.NewMigrationStatusResponse(status))
+}
+
+// MigrateDown rolls back a number of migrations
+//
+// @Summary Roll back migrations
+// @Description Roll back `steps` migrations. A backup of the database is taken before anything is rolled back. Only served if `enable_destructive_migration_endpoints` is set.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param migration body requests.MigrateDownRequest true "Migrate Down request"
+// @Success 200 {object} responses.MigrationResultResponse
+// @Failure 400
+// @Failure 409
+// @Failure 500
+// @Router /v1/admin/migrations/down [post]
+func (handler *MigrationHandler) MigrateDown(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var migrateDownRequest requests.MigrateDownRequest
+	if err := json.NewDecoder(request.Body).Decode(&migrateDownRequest); err != nil {
+		logger.Info("v1.MigrationHandler.MigrateDown: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	if err := migrateDownRequest.Validate(); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	result, err := handler.migrationService.MigrateDown(migrateDownRequest.Steps)
+	if err != nil {
+		handler.writeError(writer, request, "MigrateDown", err)
+		return
+	}
+
+	handler.writeResponse(writer, request, "MigrateDown", responses.NewMigrationResultResponse(result))
+}
+
+// MigrateToVersion migrates the database up or down to a version
+//
+// @Summary Migrate to a version
+// @Description Migrate up or down to `version`. Version `0` rolls back every migration. A backup of the database is taken if the migration goes down. Only served if `enable_destructive_migration_endpoints` is set.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param migration body requests.MigrateToVersionRequest true "Migrate To Version request"
+// @Success 200 {object} responses.MigrationResultResponse
+// @Failure 400
+// @Failure 409
+// @Failure 500
+// @Router /v1/admin/migrations/goto [post]
+func (handler *MigrationHandler) MigrateToVersion(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var migrateToVersionRequest requests.MigrateToVersionRequest
+	if err := json.NewDecoder(request.Body).Decode(&migrateToVersionRequest); err != nil {
+		logger.Info("v1.MigrationHandler.MigrateToVersion: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	if err := migrateToVersionRequest.Validate(); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	result, err := handler.migrationService.MigrateToVersion(*migrateToVersionRequest.Version)
+	if err != nil {
+		handler.writeError(writer, request, "MigrateToVersion", err)
+		return
+	}
+
+	handler.writeResponse(writer, request, "MigrateToVersion", responses.NewMigrationResultResponse(result))
+}
+
+// ForceMigrationVersion sets the migration version and clears the dirty flag
+//
+// @Summary Force a migration version
+// @Description Set the schema `version` without running any migrations, and clear the dirty flag. Version `-1` marks the database as having no migrations applied. Only served if `enable_destructive_migration_endpoints` is set.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param migration body requests.ForceMigrationVersionRequest true "Force Migration Version request"
+// @Success 200 {object} responses.MigrationStatusResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/admin/migrations/force [post]
+func (handler *MigrationHandler) ForceMigrationVersion(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var forceMigrationVersionRequest requests.ForceMigrationVersionRequest
+	if err := json.NewDecoder(request.Body).Decode(&forceMigrationVersionRequest); err != nil {
+		logger.Info("v1.MigrationHandler.ForceMigrationVersion: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	if err := forceMigrationVersionRequest.Validate(); err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	status, err := handler.migrationService.ForceVersion(*forceMigrationVersionRequest.Version)
+	if err != nil {
+		handler.writeError(writer, request, "ForceMigrationVersion", err)
+		return
+	}
+
+	handler.writeResponse(writer, request, "ForceMigrationVersion", responses.NewMigrationStatusResponse(status))
+}
+
+func (handler *MigrationHandler) writeError(
+	writer http.ResponseWriter, request *http.Request, methodName string, err error) {
+
+	logger := logging.FromContext(request.Context())
+
+	var conflictErr *internalErrors.ConflictError
+	var internalServiceErr *internalErrors.InternalServiceError
+	var validationErr *internalErrors.ValidationError
+
+	var errorMessage string
+	var status int
+
+	if errors.As(err, &conflictErr) {
+		errorMessage = conflictErr.Message
+		status = http.StatusConflict
+		logger.Info("v1.MigrationHandler."+methodName+": ConflictError while migrating", "error", err)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage = "Internal service error while migrating database"
+		status = http.StatusInternalServerError
+		logger.Error("v1.MigrationHandler."+methodName+": "+errorMessage, "error", err)
+	} else if errors.As(err, &validationErr) {
+		errorMessage = err.Error()
+		status = http.StatusBadRequest
+		logger.Info("v1.MigrationHandler."+methodName+": ValidationError while migrating", "error", err)
+	} else {
+		errorMessage = "Unknown internal error while migrating database"
+		status = http.StatusInternalServerError
+		logger.Error("v1.MigrationHandler."+methodName+": Error while migrating", "error", err)
+	}
+
+	http.Error(writer, errorMessage, status)
+}
+
+func (handler *MigrationHandler) writeResponse(
+	writer http.ResponseWriter, request *http.Request, methodName string, response any) {
+
+	logger := logging.FromContext(request.Context())
+
+	writer.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(writer).Encode(response)
+	if err != nil {
+		logger.Error("v1.MigrationHandler."+methodName+": Unable to write response", "error", err)
+		http.Error(writer, "Migration completed but unable to create response", http.StatusInternalServerError)
+	}
+}
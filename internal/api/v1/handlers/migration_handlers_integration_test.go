@@ -0,0 +1,118 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMigrationHandler(t *testing.T) *handlers.MigrationHandler {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		DatabaseFileName:                     "test.sqlite",
+		DatabaseBackupPath:                   t.TempDir(),
+	}
+	container := dependencyinjection.SetupMigrationHandlerTestContainer(t, config)
+
+	var migrationHandler *handlers.MigrationHandler
+	err := container.Invoke(func(handler *handlers.MigrationHandler) {
+		migrationHandler = handler
+	})
+	assert.NoError(t, err)
+
+	return migrationHandler
+}
+
+// -------- GetMigrationStatus tests: --------
+
+func TestGetMigrationStatus_ShouldReturnStatus(t *testing.T) {
+	migrationHandler := setupMigrationHandler(t)
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/admin/migrations/status", nil)
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	migrationHandler.GetMigrationStatus(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.MigrationStatusResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint(19), response.Version)
+	assert.False(t, response.Dirty)
+	assert.Equal(t, uint(19), response.LatestVersion)
+	assert.Empty(t, response.PendingVersions)
+}
+
+// -------- MigrateDown tests: --------
+
+func TestMigrateDown_ShouldRollBackAndReturnBackupFile(t *testing.T) {
+	migrationHandler := setupMigrationHandler(t)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/admin/migrations/down", bytes.NewBufferString(`{"steps": 1}`))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	migrationHandler.MigrateDown(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.MigrationResultResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint(18), response.Version)
+	assert.Equal(t, []uint{19}, response.PendingVersions)
+	assert.NotNil(t, response.BackupFile)
+}
+
+func TestMigrateDown_ShouldRespondWithBadRequestIfStepsExceedAppliedMigrations(t *testing.T) {
+	migrationHandler := setupMigrationHandler(t)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/admin/migrations/down", bytes.NewBufferString(`{"steps": 20}`))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	migrationHandler.MigrateDown(responseRecorder, request)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"validation error on field 'steps': cannot roll back 20 migrations: only 19 are applied\n",
+		responseRecorder.Body.String())
+}
+
+// -------- ForceMigrationVersion tests: --------
+
+func TestForceMigrationVersion_ShouldSetVersion(t *testing.T) {
+	migrationHandler := setupMigrationHandler(t)
+
+	request, err := http.NewRequest(
+		http.MethodPost, "/api/v1/admin/migrations/force", bytes.NewBufferString(`{"version": 3}`))
+	assert.NoError(t, err)
+
+	responseRecorder := httptest.NewRecorder()
+
+	migrationHandler.ForceMigrationVersion(responseRecorder, request)
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.MigrationStatusResponse
+	err = json.NewDecoder(responseRecorder.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint(3), response.Version)
+	assert.Equal(t, []uint{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, response.PendingVersions)
+}
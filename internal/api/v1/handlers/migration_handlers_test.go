@@ -0,0 +1,130 @@
+package handlers_test
+
+import (
+	"bytes"
+	v1 "jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/testutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- MigrateDown tests: --------
+
+func TestMigrateDown_ShouldRespondWithBadRequestStatus(t *testing.T) {
+	tests := []struct {
+		testName             string
+		inputRequest         *string
+		expectedErrorMessage string
+	}{
+		{"body is nil", nil, "invalid request body: Unable to parse JSON\n"},
+		{"body is malformed", testutil.ToPtr(`"steps": 1`), "invalid request body: Unable to parse JSON\n"},
+		{
+			"steps is missing",
+			testutil.ToPtr(`{}`),
+			"validation error on field 'steps': steps must be greater than 0\n"},
+		{
+			"steps is negative",
+			testutil.ToPtr(`{"steps": -3}`),
+			"validation error on field 'steps': steps must be greater than 0\n"},
+	}
+
+	for _, test := range tests {
+		migrationHandler := v1.NewMigrationHandler(nil)
+		t.Run(test.testName, func(t *testing.T) {
+			var requestBody []byte
+			if test.inputRequest != nil {
+				requestBody = []byte(*test.inputRequest)
+			}
+
+			request, err := http.NewRequest(
+				http.MethodPost, "/api/v1/admin/migrations/down", bytes.NewBuffer(requestBody))
+			assert.NoError(t, err)
+
+			responseRecorder := httptest.NewRecorder()
+
+			migrationHandler.MigrateDown(responseRecorder, request)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Equal(t, test.expectedErrorMessage, responseRecorder.Body.String())
+		})
+	}
+}
+
+// -------- MigrateToVersion tests: --------
+
+func TestMigrateToVersion_ShouldRespondWithBadRequestStatus(t *testing.T) {
+	tests := []struct {
+		testName             string
+		inputRequest         *string
+		expectedErrorMessage string
+	}{
+		{"body is nil", nil, "invalid request body: Unable to parse JSON\n"},
+		{"version is negative", testutil.ToPtr(`{"version": -1}`), "invalid request body: Unable to parse JSON\n"},
+		{
+			"version is missing",
+			testutil.ToPtr(`{}`),
+			"validation error on field 'version': version is required\n"},
+	}
+
+	for _, test := range tests {
+		migrationHandler := v1.NewMigrationHandler(nil)
+		t.Run(test.testName, func(t *testing.T) {
+			var requestBody []byte
+			if test.inputRequest != nil {
+				requestBody = []byte(*test.inputRequest)
+			}
+
+			request, err := http.NewRequest(
+				http.MethodPost, "/api/v1/admin/migrations/goto", bytes.NewBuffer(requestBody))
+			assert.NoError(t, err)
+
+			responseRecorder := httptest.NewRecorder()
+
+			migrationHandler.MigrateToVersion(responseRecorder, request)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Equal(t, test.expectedErrorMessage, responseRecorder.Body.String())
+		})
+	}
+}
+
+// -------- ForceMigrationVersion tests: --------
+
+func TestForceMigrationVersion_ShouldRespondWithBadRequestStatus(t *testing.T) {
+	tests := []struct {
+		testName             string
+		inputRequest         *string
+		expectedErrorMessage string
+	}{
+		{"body is nil", nil, "invalid request body: Unable to parse JSON\n"},
+		{
+			"version is missing",
+			testutil.ToPtr(`{}`),
+			"validation error on field 'version': version is required\n"},
+		{
+			"version is too low",
+			testutil.ToPtr(`{"version": -2}`),
+			"validation error on field 'version': version must be -1 or greater\n"},
+	}
+
+	for _, test := range tests {
+		migrationHandler := v1.NewMigrationHandler(nil)
+		t.Run(test.testName, func(t *testing.T) {
+			var requestBody []byte
+			if test.inputRequest != nil {
+				requestBody = []byte(*test.inputRequest)
+			}
+
+			request, err := http.NewRequest(
+				http.MethodPost, "/api/v1/admin/migrations/force", bytes.NewBuffer(requestBody))
+			assert.NoError(t, err)
+
+			responseRecorder := httptest.NewRecorder()
+
+			migrationHandler.ForceMigrationVersion(responseRecorder, request)
+			assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+			assert.Equal(t, test.expectedErrorMessage, responseRecorder.Body.String())
+		})
+	}
+}
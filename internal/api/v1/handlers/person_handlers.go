@@ -6,8 +6,8 @@ import (
 	"jobsearchtracker/internal/api/v1/requests"
 	"jobsearchtracker/internal/api/v1/responses"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/services"
-	"log/slog"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -30,15 +30,18 @@ func NewPersonHandler(personService *services.PersonService) *PersonHandler {
 // @Accept json
 // @Produce json
 // @Param person body requests.CreatePersonRequest true "Create Person request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
 // @Success 201 {object} responses.PersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/person/new [post]
 func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var createPersonRequest requests.CreatePersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&createPersonRequest); err != nil {
-		slog.Info("v1.PersonHandler.CreatePerson: invalid request body", "error", err)
+		logger.Info("v1.PersonHandler.CreatePerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -46,13 +49,13 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 	// can return ValidationError
 	createPersonModel, err := createPersonRequest.ToModel()
 	if err != nil {
-		slog.Info("v1.PersonHandler.CreatePerson: Unable to convert CreatePersonRequest to model", "error", err)
+		logger.Info("v1.PersonHandler.CreatePerson: Unable to convert CreatePersonRequest to model", "error", err)
 		http.Error(writer, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	if createPersonModel == nil {
-		slog.Info("v1.PersonHandler.CreatePerson: CreatePersonModel is nil", "error", err)
+		logger.Info("v1.PersonHandler.CreatePerson: CreatePersonModel is nil", "error", err)
 		http.Error(writer,
 			"Unable to convert request to internal model: Internal model is nil",
 			http.StatusInternalServerError)
@@ -60,7 +63,7 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	createdPerson, err := personHandler.personService.CreatePerson(createPersonModel)
+	createdPerson, err := personHandler.personService.CreatePerson(request.Context(), createPersonModel)
 	if err != nil {
 		var conflictErr *internalErrors.ConflictError
 		var internalServiceErr *internalErrors.InternalServiceError
@@ -72,19 +75,19 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 		if errors.As(err, &conflictErr) {
 			errorMessage = "Conflict error on insert: ID already exists"
 			status = http.StatusConflict
-			slog.Info("v1.PersonHandler.CreatePerson: ConflictError creating person", "error", err)
+			logger.Info("v1.PersonHandler.CreatePerson: ConflictError creating person", "error", err)
 		} else if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while creating person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.CreatePerson: "+errorMessage, "error", err)
+			logger.Error("v1.PersonHandler.CreatePerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.PersonHandler.CreatePerson: ValidationError while creating person", "error", err)
+			logger.Info("v1.PersonHandler.CreatePerson: ValidationError while creating person", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.CreatePerson: Error while creating person", "error", err)
+			logger.Error("v1.PersonHandler.CreatePerson: Error while creating person", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -94,7 +97,7 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 	// can return InternalServiceError
 	personResponse, err := responses.NewPersonResponse(createdPerson)
 	if err != nil {
-		slog.Error("v1.PersonHandler.CreatePerson: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.PersonHandler.CreatePerson: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
@@ -103,7 +106,7 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 	err = json.NewEncoder(writer).Encode(personResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.CreatePerson: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.CreatePerson: Unable to write response", "error", err)
 		http.Error(writer, "Person created but unable to create response", http.StatusInternalServerError)
 
 		return
@@ -117,30 +120,36 @@ func (personHandler *PersonHandler) CreatePerson(writer http.ResponseWriter, req
 // @Tags person
 // @Produce json
 // @Param id path string true "Person ID" format(uuid)
+// @Param If-None-Match header string false "ETag of the version the client already has"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {object} responses.PersonResponse
+// @Header 200 {string} ETag "Version of the person"
+// @Failure 304
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/person/get/id/{id} [get]
 func (personHandler *PersonHandler) GetPersonByID(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	personIDStr := vars["id"]
 
 	if personIDStr == "" {
-		slog.Info("v1.PersonHandler.GetPersonById: person ID is empty")
+		logger.Info("v1.PersonHandler.GetPersonById: person ID is empty")
 		http.Error(writer, "person ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	personID, err := uuid.Parse(personIDStr)
 	if err != nil {
-		slog.Info("v1.PersonHandler.GetPersonById: person ID is not a valid UUID")
+		logger.Info("v1.PersonHandler.GetPersonById: person ID is not a valid UUID")
 		http.Error(writer, "person ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	person, err := personHandler.personService.GetPersonById(&personID)
+	person, err := personHandler.personService.GetPersonById(request.Context(), &personID)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
@@ -152,15 +161,15 @@ func (personHandler *PersonHandler) GetPersonByID(writer http.ResponseWriter, re
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.GetPersonByID: "+errorMessage, "error", err)
+			logger.Error("v1.PersonHandler.GetPersonByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "person not found"
 			status = http.StatusNotFound
-			slog.Info("v1.PersonHandler.GetPersonByID: "+errorMessage, "error", err)
+			logger.Info("v1.PersonHandler.GetPersonByID: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.PersonHandler.GetPersonByID: Validation error", "error", err)
+			logger.Info("v1.PersonHandler.GetPersonByID: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -170,21 +179,33 @@ func (personHandler *PersonHandler) GetPersonByID(writer http.ResponseWriter, re
 	// can return InternalServiceError
 	personResponse, err := responses.NewPersonResponse(person)
 	if err != nil {
-		slog.Error("v1.PersonHandler.GetPersonByID: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.PersonHandler.GetPersonByID: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, personResponse, "v1.PersonHandler.GetPersonByID")
+	if !ok {
+		return
+	}
+
+	etag := FormatResponseETag(request, person.Version)
+	writer.Header().Set("ETag", etag)
+	if IsNotModified(request, etag) {
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(personResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.GetPersonByID: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.GetPersonByID: Unable to write response", "error", err)
 		http.Error(writer, "Person found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.PersonHandler.GetPersonByID: retrieved person successfully", "person.ID", person.ID.String())
+	logger.Info("v1.PersonHandler.GetPersonByID: retrieved person successfully", "person.ID", person.ID.String())
 }
 
 // GetPersonsByName retrieves `person`s which fully, or partially, match the input name
@@ -194,21 +215,24 @@ func (personHandler *PersonHandler) GetPersonByID(writer http.ResponseWriter, re
 // @Tags person
 // @Produce json
 // @Param name path string true "Person Name"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {array} responses.PersonResponse
 // @Failure 400
 // @Failure 404
 // @Failure 500
 // @Router /v1/person/get/name/{name} [get]
 func (personHandler *PersonHandler) GetPersonsByName(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	personName := vars["name"]
 
 	if personName == "" {
-		slog.Info("v1.PersonHandler.GetPersonByName: person Name is empty")
+		logger.Info("v1.PersonHandler.GetPersonByName: person Name is empty")
 		http.Error(writer, "person Name is empty", http.StatusBadRequest)
 		return
 	}
-	persons, err := personHandler.personService.GetPersonsByName(&personName)
+	persons, err := personHandler.personService.GetPersonsByName(request.Context(), &personName)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
@@ -220,15 +244,15 @@ func (personHandler *PersonHandler) GetPersonsByName(writer http.ResponseWriter,
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while retrieving persons"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.GetPersonsByName: "+errorMessage, "error", err)
+			logger.Error("v1.PersonHandler.GetPersonsByName: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "No people [partially] matching this name found"
 			status = http.StatusNotFound
-			slog.Info("v1.PersonHandler.GetPersonsByName: "+errorMessage, "error", err)
+			logger.Info("v1.PersonHandler.GetPersonsByName: "+errorMessage, "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.PersonHandler.GetPersonsByName: Validation error", "error", err)
+			logger.Info("v1.PersonHandler.GetPersonsByName: Validation error", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -238,21 +262,26 @@ func (personHandler *PersonHandler) GetPersonsByName(writer http.ResponseWriter,
 	// can return InternalServiceError
 	personsResponse, err := responses.NewPersonsResponse(persons)
 	if err != nil {
-		slog.Error("v1.PersonHandler.GetPersonsByName: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.PersonHandler.GetPersonsByName: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, personsResponse, "v1.PersonHandler.GetPersonsByName")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(personsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.GetPersonsByName: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.GetPersonsByName: Unable to write response", "error", err)
 		http.Error(writer, "Person found but unable to build response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.PersonHandler.GetPersonsByName: retrieved persons successfully", "name", personName)
+	logger.Info("v1.PersonHandler.GetPersonsByName: retrieved persons successfully", "name", personName)
 }
 
 // GetAllPersons retrieves all persons.
@@ -270,16 +299,19 @@ func (personHandler *PersonHandler) GetPersonsByName(writer http.ResponseWriter,
 // @Description - include_applications=none: No `application` data included (default)
 // @Tags person
 // @Produce json
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
 // @Success 200 {array} responses.PersonResponse
 // @Failure 400
 // @Failure 500
 // @Router /v1/person/get/all [get]
 func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	query := request.URL.Query()
 
 	includeCompanies, err := GetExtraDataTypeParam(query.Get("include_companies"))
 	if err != nil {
-		slog.Error("v1.personHandler.GetAllPersons: Could not parse include_companies param", "error", err)
+		logger.Error("v1.personHandler.GetAllPersons: Could not parse include_companies param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -292,7 +324,7 @@ func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, re
 
 	includeEvents, err := GetExtraDataTypeParam(query.Get("include_events"))
 	if err != nil {
-		slog.Error("v1.personHandler.GetAllPersons: Could not parse include_events param", "error", err)
+		logger.Error("v1.personHandler.GetAllPersons: Could not parse include_events param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -305,7 +337,7 @@ func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, re
 
 	includeApplications, err := GetExtraDataTypeParam(query.Get("include_applications"))
 	if err != nil {
-		slog.Error("v1.personHandler.GetAllPersons: Could not parse include_applications param", "error", err)
+		logger.Error("v1.personHandler.GetAllPersons: Could not parse include_applications param", "error", err)
 
 		status := http.StatusBadRequest
 		writer.WriteHeader(status)
@@ -317,11 +349,12 @@ func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, re
 	}
 
 	// can return InternalServiceError
-	persons, err := personHandler.personService.GetAllPersons(*includeCompanies, *includeEvents, *includeApplications)
+	persons, err := personHandler.personService.GetAllPersons(
+		request.Context(), *includeCompanies, *includeEvents, *includeApplications)
 	if err != nil {
 		errorMessage := "Internal service error while getting all persons"
 		status := http.StatusInternalServerError
-		slog.Error("v1.PersonHandler.getAllPersons: "+errorMessage, "error", err)
+		logger.Error("v1.PersonHandler.getAllPersons: "+errorMessage, "error", err)
 
 		http.Error(writer, errorMessage, status)
 		return
@@ -330,21 +363,102 @@ func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, re
 	//  can return InternalServiceError
 	personsResponse, err := responses.NewPersonsResponse(persons)
 	if err != nil {
-		slog.Error("v1.PersonHandler.GetAllPersons: Unable to convert internal model to response", "error", err)
+		logger.Error("v1.PersonHandler.GetAllPersons: Unable to convert internal model to response", "error", err)
 		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
 	}
 
+	selectedResponse, ok := SelectRequestedFields(writer, request, personsResponse, "v1.PersonHandler.GetAllPersons")
+	if !ok {
+		return
+	}
+
 	writer.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(writer).Encode(personsResponse)
+	err = json.NewEncoder(writer).Encode(selectedResponse)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
-		slog.Error("v1.PersonHandler.GetAllPersons: Unable to write response", "error", err)
+		logger.Error("v1.PersonHandler.GetAllPersons: Unable to write response", "error", err)
 		http.Error(writer, "Persons retrieved but unable to create response", http.StatusInternalServerError)
 
 		return
 	}
 
-	slog.Info("v1.PersonHandler.GetAllPersons: retrieved all persons successfully")
+	logger.Info("v1.PersonHandler.GetAllPersons: retrieved all persons successfully")
+}
+
+// GetPersonsByIDs retrieves the `person`s with the given IDs
+//
+// @Summary Get persons by IDs
+// @Description Get up to 100 `person`s by ID, in the order of `ids`. IDs that do not exist are skipped. Duplicate IDs are returned once.
+// @Tags person
+// @Accept json
+// @Produce json
+// @Param ids body requests.GetByIDsRequest true "IDs of the persons"
+// @Param fields query string false "Comma-separated attributes to return, e.g. id,name. Default all"
+// @Success 200 {array} responses.PersonResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/person/get/ids [post]
+func (personHandler *PersonHandler) GetPersonsByIDs(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var getByIDsRequest requests.GetByIDsRequest
+	if err := json.NewDecoder(request.Body).Decode(&getByIDsRequest); err != nil {
+		logger.Info("v1.PersonHandler.GetPersonsByIDs: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	ids, err := getByIDsRequest.ToModel()
+	if err != nil {
+		logger.Info("v1.PersonHandler.GetPersonsByIDs: Unable to convert GetByIDsRequest to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, ValidationError
+	persons, err := personHandler.personService.GetPersonsByIDs(request.Context(), ids)
+	if err != nil {
+		var validationErr *internalErrors.ValidationError
+		if errors.As(err, &validationErr) {
+			logger.Info("v1.PersonHandler.GetPersonsByIDs: Validation error", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		errorMessage := "Internal service error while retrieving persons"
+		logger.Error("v1.PersonHandler.GetPersonsByIDs: "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	// can return InternalServiceError
+	personsResponse, err := responses.NewPersonsResponse(persons)
+	if err != nil {
+		logger.Error(
+			"v1.PersonHandler.GetPersonsByIDs: Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, personsResponse, "v1.PersonHandler.GetPersonsByIDs")
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(writer).Encode(selectedResponse)
+	if err != nil {
+		logger.Error("v1.PersonHandler.GetPersonsByIDs: Unable to write response", "error", err)
+		http.Error(writer, "Persons retrieved but unable to create response", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info(
+		"v1.PersonHandler.GetPersonsByIDs: retrieved persons successfully",
+		"requested", len(ids),
+		"found", len(persons))
 }
 
 // UpdatePerson updates a person
@@ -355,15 +469,19 @@ func (personHandler *PersonHandler) GetAllPersons(writer http.ResponseWriter, re
 // @Accept json
 // @Produce json
 // @Param person body requests.UpdatePersonRequest true "Update Person Request"
+// @Param If-Match header string false "ETag of the version the update is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/person/update [post]
 func (personHandler *PersonHandler) UpdatePerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	var updatePersonRequest requests.UpdatePersonRequest
 	if err := json.NewDecoder(request.Body).Decode(&updatePersonRequest); err != nil {
-		slog.Info("v1.PersonHandler.UpdatePerson: invalid request body", "error", err)
+		logger.Info("v1.PersonHandler.UpdatePerson: invalid request body", "error", err)
 		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
 		return
 	}
@@ -371,23 +489,33 @@ func (personHandler *PersonHandler) UpdatePerson(writer http.ResponseWriter, req
 	// can return ValidationError
 	updatePersonModel, err := updatePersonRequest.ToModel()
 	if err != nil {
-		slog.Info("v1.PersonHandler.UpdatePerson: Unable to convert UpdatePersonRequest to model", "error", err)
+		logger.Info("v1.PersonHandler.UpdatePerson: Unable to convert UpdatePersonRequest to model", "error", err)
 		http.Error(writer, "Unable to convert request to internal model: "+err.Error(), http.StatusBadRequest)
 
 		return
 	}
 
 	if updatePersonModel == nil {
-		slog.Error(
+		logger.Error(
 			"v1.PersonHandler.UpdatePerson: updatePersonModel is nil after attempting to convert request to internal model")
 		http.Error(writer, "Unable to convert request to model: Internal model is nil.", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = personHandler.personService.UpdatePerson(updatePersonModel)
+	// can return ValidationError
+	updatePersonModel.ExpectedVersion, err = GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.PersonHandler.UpdatePerson: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = personHandler.personService.UpdatePerson(request.Context(), updatePersonModel)
 	if err != nil {
 		var internalServiceErr *internalErrors.InternalServiceError
+		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -396,15 +524,23 @@ func (personHandler *PersonHandler) UpdatePerson(writer http.ResponseWriter, req
 		if errors.As(err, &internalServiceErr) {
 			errorMessage = "Internal service error while updating person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.UpdatePerson: "+errorMessage, "error", err)
+			logger.Error("v1.PersonHandler.UpdatePerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &notFoundError) {
+			errorMessage = "Person not found"
+			status = http.StatusNotFound
+			logger.Info("v1.PersonHandler.UpdatePerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.PersonHandler.UpdatePerson: Person has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.PersonHandler.UpdatePerson: ValidationError while updating person", "error", err)
+			logger.Info("v1.PersonHandler.UpdatePerson: ValidationError while updating person", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while updating person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.UpdatePerson: Error while updating person", "error", err)
+			logger.Error("v1.PersonHandler.UpdatePerson: Error while updating person", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -420,33 +556,46 @@ func (personHandler *PersonHandler) UpdatePerson(writer http.ResponseWriter, req
 // @Description Delete a `person` by ID
 // @Tags person
 // @Param id path string true "Person ID" format(uuid)
+// @Param If-Match header string false "ETag of the version the delete is based on"
 // @Success 200
 // @Failure 400
 // @Failure 404
+// @Failure 412
 // @Failure 500
 // @Router /v1/person/delete/{id} [delete]
 func (personHandler *PersonHandler) DeletePerson(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
 	vars := mux.Vars(request)
 	personIDStr := vars["id"]
 
 	if personIDStr == "" {
-		slog.Info("v1.PersonHandler.DeletePerson: person ID is empty")
+		logger.Info("v1.PersonHandler.DeletePerson: person ID is empty")
 		http.Error(writer, "person ID is empty", http.StatusBadRequest)
 		return
 	}
 
 	personID, err := uuid.Parse(personIDStr)
 	if err != nil {
-		slog.Info("v1.PersonHandler.DeletePerson: person ID is not a valid UUID")
+		logger.Info("v1.PersonHandler.DeletePerson: person ID is not a valid UUID")
 		http.Error(writer, "person ID is not a valid UUID", http.StatusBadRequest)
 		return
 	}
 
-	// can return InternalServiceError, NotFoundError, ValidationError
-	err = personHandler.personService.DeletePerson(&personID)
+	// can return ValidationError
+	expectedVersion, err := GetIfMatchVersion(request.Header.Get("If-Match"))
+	if err != nil {
+		logger.Info("v1.PersonHandler.DeletePerson: invalid If-Match header", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = personHandler.personService.DeletePerson(request.Context(), &personID, expectedVersion)
 	if err != nil {
 		var internalServiceError *internalErrors.InternalServiceError
 		var notFoundError *internalErrors.NotFoundError
+		var preconditionFailedErr *internalErrors.PreconditionFailedError
 		var validationErr *internalErrors.ValidationError
 
 		var errorMessage string
@@ -455,19 +604,23 @@ func (personHandler *PersonHandler) DeletePerson(writer http.ResponseWriter, req
 		if errors.As(err, &internalServiceError) {
 			errorMessage = "Internal service error while deleting person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.DeletePerson: "+errorMessage, "error", err)
+			logger.Error("v1.PersonHandler.DeletePerson: "+errorMessage, "error", err)
 		} else if errors.As(err, &notFoundError) {
 			errorMessage = "Person not found"
 			status = http.StatusNotFound
-			slog.Info("v1.PersonHandler.DeletePerson: "+errorMessage, "error", err)
+			logger.Info("v1.PersonHandler.DeletePerson: "+errorMessage, "error", err)
+		} else if errors.As(err, &preconditionFailedErr) {
+			errorMessage = err.Error()
+			status = http.StatusPreconditionFailed
+			logger.Info("v1.PersonHandler.DeletePerson: Person has been changed by another request", "error", err)
 		} else if errors.As(err, &validationErr) {
 			errorMessage = err.Error()
 			status = http.StatusBadRequest
-			slog.Info("v1.PersonHandler.DeletePerson: ValidationError while deleting person", "error", err)
+			logger.Info("v1.PersonHandler.DeletePerson: ValidationError while deleting person", "error", err)
 		} else {
 			errorMessage = "Unknown internal error while creating person"
 			status = http.StatusInternalServerError
-			slog.Error("v1.PersonHandler.DeletePerson: Error while deleting person", "error", err)
+			logger.Error("v1.PersonHandler.DeletePerson: Error while deleting person", "error", err)
 		}
 		http.Error(writer, errorMessage, status)
 
@@ -2,6 +2,7 @@ package handlers_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"jobsearchtracker/internal/api/v1/handlers"
 	"jobsearchtracker/internal/api/v1/requests"
@@ -519,7 +520,7 @@ func TestGetAllPersons_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -655,7 +656,7 @@ func TestGetAllPersons_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -859,7 +860,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&company1)
+	_, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -867,7 +868,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -876,21 +877,21 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
@@ -979,7 +980,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&company1)
+	_, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -987,7 +988,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
@@ -1067,7 +1068,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&company1)
+	_, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -1075,7 +1076,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -1084,21 +1085,21 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
@@ -1188,7 +1189,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&company1)
+	_, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -1196,7 +1197,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
@@ -1276,7 +1277,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := companyRepository.Create(&company1)
+	_, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -1284,7 +1285,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -1293,21 +1294,21 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
@@ -1360,7 +1361,7 @@ func TestGetAllPersons_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testing.T)
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -1462,7 +1463,7 @@ func TestGetAllPersons_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *testing.
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const (
+	timelineFormatJSON     = "json"
+	timelineFormatMarkdown = "markdown"
+)
+
+type TimelineHandler struct {
+	timelineService *services.TimelineService
+}
+
+func NewTimelineHandler(timelineService *services.TimelineService) *TimelineHandler {
+	return &TimelineHandler{timelineService: timelineService}
+}
+
+// GetApplicationTimeline retrieves the history of an application
+//
+// @Summary Get the timeline of an application
+// @Description Get the history of an `application`, oldest first: when it was created and last updated, its `event`s with their `company`s and `person`s, and when `person`s were linked to it or their links were changed. Links that were deleted are not shown. With `format=markdown` the timeline is returned as a Markdown list instead.
+// @Tags application
+// @Produce json
+// @Produce text/markdown
+// @Param id path string true "Application ID" format(uuid)
+// @Param format query string false "json (default) or markdown" Enums(json, markdown)
+// @Param fields query string false "Comma-separated attributes to return. Default all. Not with format=markdown"
+// @Success 200 {object} responses.TimelineResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/application/{id}/timeline [get]
+func (timelineHandler *TimelineHandler) GetApplicationTimeline(writer http.ResponseWriter, request *http.Request) {
+	writeTimeline(writer, request, "GetApplicationTimeline", "application",
+		timelineHandler.timelineService.GetApplicationTimeline)
+}
+
+// GetCompanyTimeline retrieves the history of a company
+//
+// @Summary Get the timeline of a company
+// @Description Get the history of a `company`, oldest first: when it was created and last updated, when `application`s with it as company or recruiter were created, its `event`s with their `application`s and `person`s, and when `person`s were linked to it or their links were changed. Links that were deleted are not shown. With `format=markdown` the timeline is returned as a Markdown list instead.
+// @Tags company
+// @Produce json
+// @Produce text/markdown
+// @Param id path string true "Company ID" format(uuid)
+// @Param format query string false "json (default) or markdown" Enums(json, markdown)
+// @Param fields query string false "Comma-separated attributes to return. Default all. Not with format=markdown"
+// @Success 200 {object} responses.TimelineResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/company/{id}/timeline [get]
+func (timelineHandler *TimelineHandler) GetCompanyTimeline(writer http.ResponseWriter, request *http.Request) {
+	writeTimeline(writer, request, "GetCompanyTimeline", "company",
+		timelineHandler.timelineService.GetCompanyTimeline)
+}
+
+// GetPersonTimeline retrieves the history of a person
+//
+// @Summary Get the timeline of a person
+// @Description Get the history of a `person`, oldest first: when they were created and last updated, the `event`s they took part in with their `application`s, `company`s and other `person`s, and when they were linked to `company`s and `application`s or those links were changed. Links that were deleted are not shown. With `format=markdown` the timeline is returned as a Markdown list instead.
+// @Tags person
+// @Produce json
+// @Produce text/markdown
+// @Param id path string true "Person ID" format(uuid)
+// @Param format query string false "json (default) or markdown" Enums(json, markdown)
+// @Param fields query string false "Comma-separated attributes to return. Default all. Not with format=markdown"
+// @Success 200 {object} responses.TimelineResponse
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/person/{id}/timeline [get]
+func (timelineHandler *TimelineHandler) GetPersonTimeline(writer http.ResponseWriter, request *http.Request) {
+	writeTimeline(writer, request, "GetPersonTimeline", "person",
+		timelineHandler.timelineService.GetPersonTimeline)
+}
+
+// writeTimeline gets the timeline of the record with the id in the path, and writes it as JSON or Markdown.
+func writeTimeline(
+	writer http.ResponseWriter,
+	request *http.Request,
+	method string,
+	kind string,
+	getTimeline func(ctx context.Context, id *uuid.UUID) (*models.Timeline, error)) {
+
+	logger := logging.FromContext(request.Context())
+
+	id, err := uuid.Parse(mux.Vars(request)["id"])
+	if err != nil {
+		logger.Info("v1.TimelineHandler."+method+": "+kind+" ID is not a valid UUID", "error", err)
+		http.Error(writer, kind+" ID is not a valid UUID", http.StatusBadRequest)
+		return
+	}
+
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format = timelineFormatJSON
+	}
+	if format != timelineFormatJSON && format != timelineFormatMarkdown {
+		logger.Info("v1.TimelineHandler."+method+": invalid format", "format", format)
+		http.Error(writer, "format must be 'json' or 'markdown'", http.StatusBadRequest)
+		return
+	}
+	if format == timelineFormatMarkdown && len(GetFieldsParam(request.URL.Query())) > 0 {
+		logger.Info("v1.TimelineHandler." + method + ": fields cannot be used with format=markdown")
+		http.Error(writer, "fields cannot be used with format=markdown", http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	timeline, err := getTimeline(request.Context(), &id)
+	if err != nil {
+		var notFoundErr *internalErrors.NotFoundError
+		var validationErr *internalErrors.ValidationError
+
+		if errors.As(err, &notFoundErr) {
+			logger.Info("v1.TimelineHandler."+method+": "+kind+" not found", "error", err)
+			http.Error(writer, kind+" not found", http.StatusNotFound)
+		} else if errors.As(err, &validationErr) {
+			logger.Info("v1.TimelineHandler."+method+": ValidationError while getting timeline", "error", err)
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+		} else {
+			errorMessage := "Internal service error while getting timeline"
+			logger.Error("v1.TimelineHandler."+method+": "+errorMessage, "error", err)
+			http.Error(writer, errorMessage, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if format == timelineFormatMarkdown {
+		// can return InternalServiceError
+		markdown, err := responses.NewTimelineMarkdown(timeline)
+		if err != nil {
+			logger.Error("v1.TimelineHandler."+method+": Unable to render timeline as Markdown", "error", err)
+			http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+			return
+		}
+
+		writer.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		writer.WriteHeader(http.StatusOK)
+		if _, err = writer.Write([]byte(markdown)); err != nil {
+			logger.Error("v1.TimelineHandler."+method+": Unable to write response", "error", err)
+		}
+		return
+	}
+
+	// can return InternalServiceError
+	timelineResponse, err := responses.NewTimelineResponse(timeline)
+	if err != nil {
+		logger.Error("v1.TimelineHandler."+method+": Unable to convert internal model to response", "error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(writer, request, timelineResponse, "v1.TimelineHandler."+method)
+	if !ok {
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(http.StatusOK)
+	if err = json.NewEncoder(writer).Encode(selectedResponse); err != nil {
+		logger.Error("v1.TimelineHandler."+method+": Unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,206 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTimelineHandler(t *testing.T) (
+	*handlers.TimelineHandler,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository,
+	*repositories.ApplicationPersonRepository) {
+
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupTimelineHandlerTestContainer(t, config)
+
+	var timelineHandler *handlers.TimelineHandler
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	var applicationPersonRepository *repositories.ApplicationPersonRepository
+	err := container.Invoke(func(
+		handler *handlers.TimelineHandler,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository,
+		applicationPerson *repositories.ApplicationPersonRepository) {
+
+		timelineHandler = handler
+		applicationRepository = application
+		companyRepository = company
+		personRepository = person
+		applicationPersonRepository = applicationPerson
+	})
+	assert.NoError(t, err)
+
+	return timelineHandler, applicationRepository, companyRepository, personRepository, applicationPersonRepository
+}
+
+func sendTimelineRequest(
+	t *testing.T, handlerFunc http.HandlerFunc, url string, id string) *httptest.ResponseRecorder {
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	request = mux.SetURLVars(request, map[string]string{"id": id})
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- GetApplicationTimeline tests: --------
+
+func TestGetApplicationTimeline_ShouldReturnTimelineAsJSON(t *testing.T) {
+	timelineHandler, applicationRepository, companyRepository, personRepository, applicationPersonRepository :=
+		setupTimelineHandler(t)
+
+	start := time.Now().AddDate(0, 0, -5).UTC()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, nil, &company.ID, nil, testutil.ToPtr(start))
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateApplicationPerson(
+		t, applicationPersonRepository, application.ID, person.ID, testutil.ToPtr(start.AddDate(0, 0, 1)))
+
+	responseRecorder := sendTimelineRequest(
+		t,
+		timelineHandler.GetApplicationTimeline,
+		"/api/v1/application/"+application.ID.String()+"/timeline",
+		application.ID.String())
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "application/json", responseRecorder.Header().Get("Content-Type"))
+
+	var response responses.TimelineResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&response))
+	assert.Equal(t, "JobTitle", response.Title)
+	assert.Len(t, response.Entries, 2)
+
+	assert.Equal(t, models.TimelineEntryTypeCreated, response.Entries[0].EntryType)
+	assert.Equal(t, "Created application 'JobTitle'", response.Entries[0].Summary)
+	assert.Equal(t, application.ID, response.Entries[0].Application.ID)
+
+	assert.Equal(t, models.TimelineEntryTypeLinked, response.Entries[1].EntryType)
+	assert.Equal(t, "Linked person 'PersonName'", response.Entries[1].Summary)
+	assert.Equal(t, person.ID, response.Entries[1].Person.ID)
+	assert.NotNil(t, response.Entries[1].LinkDetails)
+}
+
+func TestGetApplicationTimeline_ShouldReturnTimelineAsMarkdown(t *testing.T) {
+	timelineHandler, applicationRepository, companyRepository, _, _ := setupTimelineHandler(t)
+
+	createdDate := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, nil, &company.ID, nil, &createdDate)
+
+	responseRecorder := sendTimelineRequest(
+		t,
+		timelineHandler.GetApplicationTimeline,
+		"/api/v1/application/"+application.ID.String()+"/timeline?format=markdown",
+		application.ID.String())
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "text/markdown; charset=utf-8", responseRecorder.Header().Get("Content-Type"))
+	assert.Equal(
+		t,
+		"# Timeline: JobTitle\n\n- **2025-03-14 09:30** Created application 'JobTitle'\n",
+		responseRecorder.Body.String())
+}
+
+func TestGetApplicationTimeline_ShouldReturnBadRequestForInvalidFormat(t *testing.T) {
+	timelineHandler, _, _, _, _ := setupTimelineHandler(t)
+
+	id := uuid.New().String()
+	responseRecorder := sendTimelineRequest(
+		t, timelineHandler.GetApplicationTimeline, "/api/v1/application/"+id+"/timeline?format=html", id)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "format must be 'json' or 'markdown'\n", responseRecorder.Body.String())
+}
+
+func TestGetApplicationTimeline_ShouldReturnBadRequestForFieldsWithMarkdown(t *testing.T) {
+	timelineHandler, _, _, _, _ := setupTimelineHandler(t)
+
+	id := uuid.New().String()
+	responseRecorder := sendTimelineRequest(
+		t,
+		timelineHandler.GetApplicationTimeline,
+		"/api/v1/application/"+id+"/timeline?format=markdown&fields=title",
+		id)
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "fields cannot be used with format=markdown\n", responseRecorder.Body.String())
+}
+
+func TestGetApplicationTimeline_ShouldReturnBadRequestForInvalidID(t *testing.T) {
+	timelineHandler, _, _, _, _ := setupTimelineHandler(t)
+
+	responseRecorder := sendTimelineRequest(
+		t, timelineHandler.GetApplicationTimeline, "/api/v1/application/not-a-uuid/timeline", "not-a-uuid")
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "application ID is not a valid UUID\n", responseRecorder.Body.String())
+}
+
+func TestGetApplicationTimeline_ShouldReturnNotFoundForUnknownApplication(t *testing.T) {
+	timelineHandler, _, _, _, _ := setupTimelineHandler(t)
+
+	id := uuid.New().String()
+	responseRecorder := sendTimelineRequest(
+		t, timelineHandler.GetApplicationTimeline, "/api/v1/application/"+id+"/timeline", id)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	assert.Equal(t, "application not found\n", responseRecorder.Body.String())
+}
+
+// -------- GetCompanyTimeline tests: --------
+
+func TestGetCompanyTimeline_ShouldReturnApplicationsOfCompany(t *testing.T) {
+	timelineHandler, applicationRepository, companyRepository, _, _ := setupTimelineHandler(t)
+
+	start := time.Now().AddDate(0, 0, -5).UTC()
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, testutil.ToPtr(start))
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, nil, &company.ID, nil, testutil.ToPtr(start.AddDate(0, 0, 1)))
+
+	responseRecorder := sendTimelineRequest(
+		t,
+		timelineHandler.GetCompanyTimeline,
+		"/api/v1/company/"+company.ID.String()+"/timeline",
+		company.ID.String())
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var response responses.TimelineResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&response))
+	assert.Len(t, response.Entries, 2)
+	assert.Equal(t, "Created company 'CompanyName'", response.Entries[0].Summary)
+	assert.Equal(t, application.ID, response.Entries[1].Application.ID)
+}
+
+// -------- GetPersonTimeline tests: --------
+
+func TestGetPersonTimeline_ShouldReturnNotFoundForUnknownPerson(t *testing.T) {
+	timelineHandler, _, _, _, _ := setupTimelineHandler(t)
+
+	id := uuid.New().String()
+	responseRecorder := sendTimelineRequest(t, timelineHandler.GetPersonTimeline, "/api/v1/person/"+id+"/timeline", id)
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+	assert.True(t, strings.HasPrefix(responseRecorder.Body.String(), "person not found"))
+}
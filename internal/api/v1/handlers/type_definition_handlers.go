@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type TypeDefinitionHandler struct {
+	typeDefinitionService *services.TypeDefinitionService
+}
+
+func NewTypeDefinitionHandler(typeDefinitionService *services.TypeDefinitionService) *TypeDefinitionHandler {
+	return &TypeDefinitionHandler{typeDefinitionService: typeDefinitionService}
+}
+
+// CreateTypeDefinition adds an event, person or company type
+//
+// @Summary Create a type
+// @Description Add a type of `event`, `person` or `company`. The `name` is what events, persons or companies of this type store in their type field. Types are listed by `sort_order`, and an event type can be mapped to a `pipeline_stage`. An added event type is never a booked or completed event type, so the agenda and the overlap checks ignore it.
+// @Tags type
+// @Accept json
+// @Produce json
+// @Param kind path string true "Kind of type" Enums(event, person, company)
+// @Param type body requests.CreateTypeDefinitionRequest true "Create type request"
+// @Param Idempotency-Key header string false "Retries with the same key and body get the first response"
+// @Success 201 {object} responses.TypeDefinitionResponse
+// @Failure 400
+// @Failure 409
+// @Failure 500
+// @Router /v1/type/{kind}/new [post]
+func (handler *TypeDefinitionHandler) CreateTypeDefinition(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var createRequest requests.CreateTypeDefinitionRequest
+	if err := json.NewDecoder(request.Body).Decode(&createRequest); err != nil {
+		logger.Info("v1.TypeDefinitionHandler.CreateTypeDefinition: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	typeDefinitionModel, err := createRequest.ToModel(mux.Vars(request)["kind"])
+	if err != nil {
+		logger.Info(
+			"v1.TypeDefinitionHandler.CreateTypeDefinition: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	typeDefinition, err := handler.typeDefinitionService.CreateTypeDefinition(request.Context(), typeDefinitionModel)
+	if err != nil {
+		writeTypeDefinitionError(writer, logger, "CreateTypeDefinition", "creating type", err)
+		return
+	}
+
+	// can return InternalServiceError
+	typeDefinitionResponse, err := responses.NewTypeDefinitionResponse(typeDefinition)
+	if err != nil {
+		logger.Error(
+			"v1.TypeDefinitionHandler.CreateTypeDefinition: Unable to convert internal model to response",
+			"error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	writeTypeDefinitionResponse(writer, logger, "CreateTypeDefinition", http.StatusCreated, typeDefinitionResponse)
+}
+
+// GetAllTypeDefinitions retrieves all event, person or company types
+//
+// @Summary Get all types of a kind
+// @Description Get all types of `event`, `person` or `company`, ordered by `sort_order`
+// @Tags type
+// @Produce json
+// @Param kind path string true "Kind of type" Enums(event, person, company)
+// @Param fields query string false "Comma-separated attributes to return, e.g. name,description. Default all"
+// @Success 200 {array} responses.TypeDefinitionResponse
+// @Failure 400
+// @Failure 500
+// @Router /v1/type/{kind}/get/all [get]
+func (handler *TypeDefinitionHandler) GetAllTypeDefinitions(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	kind := models.TypeKind(mux.Vars(request)["kind"])
+
+	// can return InternalServiceError, ValidationError
+	typeDefinitions, err := handler.typeDefinitionService.GetAllTypeDefinitions(request.Context(), kind)
+	if err != nil {
+		writeTypeDefinitionError(writer, logger, "GetAllTypeDefinitions", "retrieving types", err)
+		return
+	}
+
+	// can return InternalServiceError
+	typeDefinitionsResponse, err := responses.NewTypeDefinitionsResponse(typeDefinitions)
+	if err != nil {
+		logger.Error(
+			"v1.TypeDefinitionHandler.GetAllTypeDefinitions: Unable to convert internal model to response",
+			"error", err)
+		http.Error(writer, "Error: Unable to convert internal model to response", http.StatusInternalServerError)
+		return
+	}
+
+	selectedResponse, ok := SelectRequestedFields(
+		writer, request, typeDefinitionsResponse, "v1.TypeDefinitionHandler.GetAllTypeDefinitions")
+	if !ok {
+		return
+	}
+
+	writeTypeDefinitionResponse(writer, logger, "GetAllTypeDefinitions", http.StatusOK, selectedResponse)
+}
+
+// UpdateTypeDefinition updates an event, person or company type
+//
+// @Summary Update a type
+// @Description Update the display name, sort order or pipeline stage of a type of `event`, `person` or `company`. The name of a type cannot be changed.
+// @Tags type
+// @Accept json
+// @Param kind path string true "Kind of type" Enums(event, person, company)
+// @Param type body requests.UpdateTypeDefinitionRequest true "Update type request"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /v1/type/{kind}/update [post]
+func (handler *TypeDefinitionHandler) UpdateTypeDefinition(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	var updateRequest requests.UpdateTypeDefinitionRequest
+	if err := json.NewDecoder(request.Body).Decode(&updateRequest); err != nil {
+		logger.Info("v1.TypeDefinitionHandler.UpdateTypeDefinition: invalid request body", "error", err)
+		http.Error(writer, "invalid request body: Unable to parse JSON", http.StatusBadRequest)
+		return
+	}
+
+	// can return ValidationError
+	typeDefinitionModel, err := updateRequest.ToModel(mux.Vars(request)["kind"])
+	if err != nil {
+		logger.Info(
+			"v1.TypeDefinitionHandler.UpdateTypeDefinition: Unable to convert request to model", "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	err = handler.typeDefinitionService.UpdateTypeDefinition(request.Context(), typeDefinitionModel)
+	if err != nil {
+		writeTypeDefinitionError(writer, logger, "UpdateTypeDefinition", "updating type", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// DeleteTypeDefinition deletes an event, person or company type
+//
+// @Summary Delete a type
+// @Description Delete a type of `event`, `person` or `company`. Built-in types cannot be deleted, and neither can types that are still used by an event, person or company.
+// @Tags type
+// @Param kind path string true "Kind of type" Enums(event, person, company)
+// @Param name path string true "Name of the type"
+// @Success 200
+// @Failure 400
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /v1/type/{kind}/delete/{name} [delete]
+func (handler *TypeDefinitionHandler) DeleteTypeDefinition(writer http.ResponseWriter, request *http.Request) {
+	logger := logging.FromContext(request.Context())
+
+	vars := mux.Vars(request)
+	kind := models.TypeKind(vars["kind"])
+
+	// can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+	err := handler.typeDefinitionService.DeleteTypeDefinition(request.Context(), kind, vars["name"])
+	if err != nil {
+		writeTypeDefinitionError(writer, logger, "DeleteTypeDefinition", "deleting type", err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+func writeTypeDefinitionError(
+	writer http.ResponseWriter, logger *slog.Logger, method string, action string, err error) {
+
+	var conflictErr *internalErrors.ConflictError
+	var internalServiceErr *internalErrors.InternalServiceError
+	var notFoundErr *internalErrors.NotFoundError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &conflictErr) {
+		logger.Info("v1.TypeDefinitionHandler."+method+": ConflictError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusConflict)
+	} else if errors.As(err, &notFoundErr) {
+		logger.Info("v1.TypeDefinitionHandler."+method+": NotFoundError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusNotFound)
+	} else if errors.As(err, &validationErr) {
+		logger.Info("v1.TypeDefinitionHandler."+method+": ValidationError while "+action, "error", err)
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+	} else if errors.As(err, &internalServiceErr) {
+		errorMessage := "Internal service error while " + action
+		logger.Error("v1.TypeDefinitionHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	} else {
+		errorMessage := "Unknown internal error while " + action
+		logger.Error("v1.TypeDefinitionHandler."+method+": "+errorMessage, "error", err)
+		http.Error(writer, errorMessage, http.StatusInternalServerError)
+	}
+}
+
+func writeTypeDefinitionResponse(
+	writer http.ResponseWriter, logger *slog.Logger, method string, status int, response any) {
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		logger.Error("v1.TypeDefinitionHandler."+method+": Unable to write response", "error", err)
+	}
+}
@@ -0,0 +1,185 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"jobsearchtracker/internal/api/v1/handlers"
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/api/v1/responses"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTypeDefinitionHandler(t *testing.T) *handlers.TypeDefinitionHandler {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupTypeDefinitionHandlerTestContainer(t, config)
+
+	var typeDefinitionHandler *handlers.TypeDefinitionHandler
+	err := container.Invoke(func(handler *handlers.TypeDefinitionHandler) {
+		typeDefinitionHandler = handler
+	})
+	assert.NoError(t, err)
+
+	return typeDefinitionHandler
+}
+
+func sendTypeDefinitionRequest(
+	t *testing.T, handlerFunc http.HandlerFunc, method string, url string, body any,
+	urlVars map[string]string) *httptest.ResponseRecorder {
+
+	var requestBody bytes.Buffer
+	if body != nil {
+		assert.NoError(t, json.NewEncoder(&requestBody).Encode(body))
+	}
+
+	request, err := http.NewRequest(method, url, &requestBody)
+	assert.NoError(t, err)
+	request = mux.SetURLVars(request, urlVars)
+
+	responseRecorder := httptest.NewRecorder()
+	handlerFunc(responseRecorder, request)
+
+	return responseRecorder
+}
+
+// -------- CreateTypeDefinition tests: --------
+
+func TestCreateTypeDefinition_ShouldMakeTypeUsableUntilItIsDeleted(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	interviewing := requests.PipelineStage(requests.PipelineStageInterviewing)
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.CreateTypeDefinition, http.MethodPost, "/api/v1/type/event/new",
+		requests.CreateTypeDefinitionRequest{
+			Name:          "technicalScreen",
+			DisplayName:   "Technical screen",
+			SortOrder:     65,
+			PipelineStage: &interviewing,
+		},
+		map[string]string{"kind": models.TypeKindEvent})
+	assert.Equal(t, http.StatusCreated, responseRecorder.Code)
+
+	var createdResponse responses.TypeDefinitionResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&createdResponse))
+	assert.Equal(t, "event", createdResponse.Kind)
+	assert.Equal(t, "technicalScreen", createdResponse.Name)
+	assert.Equal(t, "Technical screen", createdResponse.DisplayName)
+	assert.Equal(t, 65, createdResponse.SortOrder)
+	assert.Equal(t, interviewing, *createdResponse.PipelineStage)
+	assert.False(t, createdResponse.IsBuiltIn)
+
+	eventType, err := requests.EventType("technicalScreen").ToModel()
+	assert.NoError(t, err)
+	assert.Equal(t, models.EventType("technicalScreen"), eventType)
+
+	responseRecorder = sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.DeleteTypeDefinition, http.MethodDelete, "/api/v1/type/event/delete/technicalScreen",
+		nil,
+		map[string]string{"kind": models.TypeKindEvent, "name": "technicalScreen"})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	_, err = requests.EventType("technicalScreen").ToModel()
+	assert.Error(t, err)
+}
+
+func TestCreateTypeDefinition_ShouldReturnBadRequestForInvalidKind(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.CreateTypeDefinition, http.MethodPost, "/api/v1/type/application/new",
+		requests.CreateTypeDefinitionRequest{Name: "remote", DisplayName: "Remote"},
+		map[string]string{"kind": "application"})
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(t, "validation error on field 'Kind': Kind is invalid\n", responseRecorder.Body.String())
+}
+
+func TestCreateTypeDefinition_ShouldReturnConflictForExistingType(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.CreateTypeDefinition, http.MethodPost, "/api/v1/type/company/new",
+		requests.CreateTypeDefinitionRequest{Name: models.CompanyTypeEmployer, DisplayName: "Employer"},
+		map[string]string{"kind": models.TypeKindCompany})
+	assert.Equal(t, http.StatusConflict, responseRecorder.Code)
+}
+
+// -------- GetAllTypeDefinitions tests: --------
+
+func TestGetAllTypeDefinitions_ShouldReturnBuiltInTypes(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.GetAllTypeDefinitions, http.MethodGet, "/api/v1/type/company/get/all", nil,
+		map[string]string{"kind": models.TypeKindCompany})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	var typeDefinitionsResponse []*responses.TypeDefinitionResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&typeDefinitionsResponse))
+	assert.Len(t, typeDefinitionsResponse, 3)
+	assert.Equal(t, models.CompanyTypeEmployer, typeDefinitionsResponse[0].Name)
+	assert.Equal(t, models.CompanyTypeRecruiter, typeDefinitionsResponse[1].Name)
+	assert.Equal(t, models.CompanyTypeConsultancy, typeDefinitionsResponse[2].Name)
+	for _, typeDefinitionResponse := range typeDefinitionsResponse {
+		assert.True(t, typeDefinitionResponse.IsBuiltIn)
+	}
+}
+
+// -------- UpdateTypeDefinition tests: --------
+
+func TestUpdateTypeDefinition_ShouldUpdateDisplayName(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.UpdateTypeDefinition, http.MethodPost, "/api/v1/type/person/update",
+		requests.UpdateTypeDefinitionRequest{Name: models.PersonTypeCTO, DisplayName: testutil.ToPtr("Chief tech")},
+		map[string]string{"kind": models.TypeKindPerson})
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+
+	responseRecorder = sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.GetAllTypeDefinitions, http.MethodGet, "/api/v1/type/person/get/all", nil,
+		map[string]string{"kind": models.TypeKindPerson})
+
+	var typeDefinitionsResponse []*responses.TypeDefinitionResponse
+	assert.NoError(t, json.NewDecoder(responseRecorder.Body).Decode(&typeDefinitionsResponse))
+	for _, typeDefinitionResponse := range typeDefinitionsResponse {
+		if typeDefinitionResponse.Name == models.PersonTypeCTO {
+			assert.Equal(t, "Chief tech", typeDefinitionResponse.DisplayName)
+			assert.NotNil(t, typeDefinitionResponse.UpdatedDate)
+		}
+	}
+}
+
+// -------- DeleteTypeDefinition tests: --------
+
+func TestDeleteTypeDefinition_ShouldReturnBadRequestForBuiltInType(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.DeleteTypeDefinition, http.MethodDelete, "/api/v1/type/event/delete/applied", nil,
+		map[string]string{"kind": models.TypeKindEvent, "name": models.EventTypeApplied})
+	assert.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	assert.Equal(
+		t,
+		"validation error on field 'Name': built-in event type cannot be deleted: 'applied'\n",
+		responseRecorder.Body.String())
+}
+
+func TestDeleteTypeDefinition_ShouldReturnNotFoundForUnknownType(t *testing.T) {
+	typeDefinitionHandler := setupTypeDefinitionHandler(t)
+
+	responseRecorder := sendTypeDefinitionRequest(
+		t, typeDefinitionHandler.DeleteTypeDefinition, http.MethodDelete, "/api/v1/type/person/delete/designer", nil,
+		map[string]string{"kind": models.TypeKindPerson, "name": "designer"})
+	assert.Equal(t, http.StatusNotFound, responseRecorder.Code)
+}
@@ -11,6 +11,7 @@ import (
 type AssociateApplicationEventRequest struct {
 	ApplicationID uuid.UUID `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	EventID       uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
 }
 
 // validate can return ValidationError
@@ -46,6 +47,31 @@ func (request *AssociateApplicationEventRequest) ToModel() (*models.AssociateApp
 	model := models.AssociateApplicationEvent{
 		ApplicationID: request.ApplicationID,
 		EventID:       request.EventID,
+		LinkDetails:   request.LinkDetails.toModel(),
+	}
+
+	return &model, nil
+}
+
+type UpdateApplicationEventRequest struct {
+	ApplicationID uuid.UUID `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventID       uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
+}
+
+// ToModel can return ValidationError
+func (request *UpdateApplicationEventRequest) ToModel() (*models.UpdateApplicationEvent, error) {
+	model := models.UpdateApplicationEvent{
+		ApplicationID: request.ApplicationID,
+		EventID:       request.EventID,
+		LinkDetails:   request.LinkDetails.toModel(),
+	}
+
+	// can return ValidationError
+	err := model.Validate()
+	if err != nil {
+		slog.Info("UpdateApplicationEventRequest.ToModel: request is invalid", "error", err)
+		return nil, err
 	}
 
 	return &model, nil
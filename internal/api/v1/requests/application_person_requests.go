@@ -11,6 +11,7 @@ import (
 type AssociateApplicationPersonRequest struct {
 	ApplicationID uuid.UUID `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	PersonID      uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
 }
 
 // validate can return ValidationError
@@ -46,6 +47,31 @@ func (request *AssociateApplicationPersonRequest) ToModel() (*models.AssociateAp
 	model := models.AssociateApplicationPerson{
 		ApplicationID: request.ApplicationID,
 		PersonID:      request.PersonID,
+		LinkDetails:   request.LinkDetails.toModel(),
+	}
+
+	return &model, nil
+}
+
+type UpdateApplicationPersonRequest struct {
+	ApplicationID uuid.UUID `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	PersonID      uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
+}
+
+// ToModel can return ValidationError
+func (request *UpdateApplicationPersonRequest) ToModel() (*models.UpdateApplicationPerson, error) {
+	model := models.UpdateApplicationPerson{
+		ApplicationID: request.ApplicationID,
+		PersonID:      request.PersonID,
+		LinkDetails:   request.LinkDetails.toModel(),
+	}
+
+	// can return ValidationError
+	err := model.Validate()
+	if err != nil {
+		slog.Info("UpdateApplicationPersonRequest.ToModel: request is invalid", "error", err)
+		return nil, err
 	}
 
 	return &model, nil
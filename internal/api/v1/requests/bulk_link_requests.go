@@ -0,0 +1,136 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"strconv"
+)
+
+type BulkAssociateApplicationEventRequest struct {
+	Links []*AssociateApplicationEventRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkAssociateApplicationEventRequest) ToModels() ([]*models.AssociateApplicationEvent, error) {
+	return bulkLinksToModels(request.Links, (*AssociateApplicationEventRequest).ToModel)
+}
+
+type BulkDeleteApplicationEventRequest struct {
+	Links []*DeleteApplicationEventRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkDeleteApplicationEventRequest) ToModels() ([]*models.DeleteApplicationEvent, error) {
+	return bulkLinksToModels(request.Links, (*DeleteApplicationEventRequest).ToModel)
+}
+
+type BulkAssociateApplicationPersonRequest struct {
+	Links []*AssociateApplicationPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkAssociateApplicationPersonRequest) ToModels() ([]*models.AssociateApplicationPerson, error) {
+	return bulkLinksToModels(request.Links, (*AssociateApplicationPersonRequest).ToModel)
+}
+
+type BulkDeleteApplicationPersonRequest struct {
+	Links []*DeleteApplicationPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkDeleteApplicationPersonRequest) ToModels() ([]*models.DeleteApplicationPerson, error) {
+	return bulkLinksToModels(request.Links, (*DeleteApplicationPersonRequest).ToModel)
+}
+
+type BulkAssociateCompanyEventRequest struct {
+	Links []*AssociateCompanyEventRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkAssociateCompanyEventRequest) ToModels() ([]*models.AssociateCompanyEvent, error) {
+	return bulkLinksToModels(request.Links, (*AssociateCompanyEventRequest).ToModel)
+}
+
+type BulkDeleteCompanyEventRequest struct {
+	Links []*DeleteCompanyEventRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkDeleteCompanyEventRequest) ToModels() ([]*models.DeleteCompanyEvent, error) {
+	return bulkLinksToModels(request.Links, (*DeleteCompanyEventRequest).ToModel)
+}
+
+type BulkAssociateCompanyPersonRequest struct {
+	Links []*AssociateCompanyPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkAssociateCompanyPersonRequest) ToModels() ([]*models.AssociateCompanyPerson, error) {
+	return bulkLinksToModels(request.Links, (*AssociateCompanyPersonRequest).ToModel)
+}
+
+type BulkDeleteCompanyPersonRequest struct {
+	Links []*DeleteCompanyPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkDeleteCompanyPersonRequest) ToModels() ([]*models.DeleteCompanyPerson, error) {
+	return bulkLinksToModels(request.Links, (*DeleteCompanyPersonRequest).ToModel)
+}
+
+type BulkAssociateEventPersonRequest struct {
+	Links []*AssociateEventPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkAssociateEventPersonRequest) ToModels() ([]*models.AssociateEventPerson, error) {
+	return bulkLinksToModels(request.Links, (*AssociateEventPersonRequest).ToModel)
+}
+
+type BulkDeleteEventPersonRequest struct {
+	Links []*DeleteEventPersonRequest `json:"links" extensions:"x-order=0"`
+}
+
+// ToModels can return ValidationError
+func (request *BulkDeleteEventPersonRequest) ToModels() ([]*models.DeleteEventPerson, error) {
+	return bulkLinksToModels(request.Links, (*DeleteEventPersonRequest).ToModel)
+}
+
+// bulkLinksToModels converts each link with toModel. The field of a returned ValidationError is the position of the
+// invalid link, such as 'links[2]'.
+//
+// bulkLinksToModels can return ValidationError
+func bulkLinksToModels[Request any, Model any](
+	links []*Request, toModel func(request *Request) (*Model, error)) ([]*Model, error) {
+
+	if err := models.ValidateBulkLinkCount(len(links)); err != nil {
+		slog.Info("requests.bulkLinksToModels: links are invalid", "error", err)
+		return nil, err
+	}
+
+	linkModels := make([]*Model, len(links))
+	for index, link := range links {
+		field := "links[" + strconv.Itoa(index) + "]"
+
+		if link == nil {
+			return nil, internalErrors.NewValidationError(&field, "link is null")
+		}
+
+		// can return ValidationError
+		model, err := toModel(link)
+		if err != nil {
+			message := err.Error()
+			var validationErr *internalErrors.ValidationError
+			if errors.As(err, &validationErr) {
+				message = validationErr.Message
+			}
+			return nil, internalErrors.NewValidationError(&field, message)
+		}
+
+		linkModels[index] = model
+	}
+
+	return linkModels, nil
+}
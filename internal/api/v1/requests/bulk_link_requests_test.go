@@ -0,0 +1,85 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- BulkAssociateCompanyPersonRequest tests: --------
+
+func TestBulkAssociateCompanyPersonRequestToModels_ShouldConvertToModels(t *testing.T) {
+	role := "Engineering manager"
+	request := BulkAssociateCompanyPersonRequest{
+		Links: []*AssociateCompanyPersonRequest{
+			{CompanyID: uuid.New(), PersonID: uuid.New(), LinkDetails: LinkDetails{Role: &role}},
+			{CompanyID: uuid.New(), PersonID: uuid.New()},
+		},
+	}
+
+	models, err := request.ToModels()
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+	assert.Equal(t, request.Links[0].CompanyID, models[0].CompanyID)
+	assert.Equal(t, request.Links[0].PersonID, models[0].PersonID)
+	assert.Equal(t, role, *models[0].Role)
+	assert.Equal(t, request.Links[1].CompanyID, models[1].CompanyID)
+	assert.Equal(t, request.Links[1].PersonID, models[1].PersonID)
+}
+
+func TestBulkAssociateCompanyPersonRequestToModels_ShouldReturnValidationErrors(t *testing.T) {
+	tests := []struct {
+		testName             string
+		links                []*AssociateCompanyPersonRequest
+		expectedErrorMessage string
+	}{
+		{
+			testName:             "no links",
+			links:                nil,
+			expectedErrorMessage: "validation error on field 'Links': Links is empty",
+		},
+		{
+			testName:             "null link",
+			links:                []*AssociateCompanyPersonRequest{{CompanyID: uuid.New(), PersonID: uuid.New()}, nil},
+			expectedErrorMessage: "validation error on field 'links[1]': link is null",
+		},
+		{
+			testName:             "empty company_id",
+			links:                []*AssociateCompanyPersonRequest{{PersonID: uuid.New()}},
+			expectedErrorMessage: "validation error on field 'links[0]': CompanyID is invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			request := BulkAssociateCompanyPersonRequest{Links: test.links}
+
+			models, err := request.ToModels()
+			assert.Nil(t, models)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, test.expectedErrorMessage, err.Error())
+		})
+	}
+}
+
+// -------- BulkDeleteEventPersonRequest tests: --------
+
+func TestBulkDeleteEventPersonRequestToModels_ShouldReturnValidationErrorForTooManyLinks(t *testing.T) {
+	links := make([]*DeleteEventPersonRequest, 101)
+	for index := range links {
+		links[index] = &DeleteEventPersonRequest{EventID: uuid.New(), PersonID: uuid.New()}
+	}
+	request := BulkDeleteEventPersonRequest{Links: links}
+
+	models, err := request.ToModels()
+	assert.Nil(t, models)
+	assert.Equal(
+		t,
+		"validation error on field 'Links': Links cannot contain more than 100 links. Got 101",
+		err.Error())
+}
@@ -11,6 +11,7 @@ import (
 type AssociateCompanyEventRequest struct {
 	CompanyID uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	EventID   uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
 }
 
 // validate can return ValidationError
@@ -44,8 +45,33 @@ func (request *AssociateCompanyEventRequest) ToModel() (*models.AssociateCompany
 	}
 
 	model := models.AssociateCompanyEvent{
-		CompanyID: request.CompanyID,
-		EventID:   request.EventID,
+		CompanyID:   request.CompanyID,
+		EventID:     request.EventID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	return &model, nil
+}
+
+type UpdateCompanyEventRequest struct {
+	CompanyID uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventID   uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
+}
+
+// ToModel can return ValidationError
+func (request *UpdateCompanyEventRequest) ToModel() (*models.UpdateCompanyEvent, error) {
+	model := models.UpdateCompanyEvent{
+		CompanyID:   request.CompanyID,
+		EventID:     request.EventID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	// can return ValidationError
+	err := model.Validate()
+	if err != nil {
+		slog.Info("UpdateCompanyEventRequest.ToModel: request is invalid", "error", err)
+		return nil, err
 	}
 
 	return &model, nil
@@ -11,6 +11,7 @@ import (
 type AssociateCompanyPersonRequest struct {
 	CompanyID uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	PersonID  uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
 }
 
 // validate can return ValidationError
@@ -44,8 +45,33 @@ func (request *AssociateCompanyPersonRequest) ToModel() (*models.AssociateCompan
 	}
 
 	model := models.AssociateCompanyPerson{
-		CompanyID: request.CompanyID,
-		PersonID:  request.PersonID,
+		CompanyID:   request.CompanyID,
+		PersonID:    request.PersonID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	return &model, nil
+}
+
+type UpdateCompanyPersonRequest struct {
+	CompanyID uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	PersonID  uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
+}
+
+// ToModel can return ValidationError
+func (request *UpdateCompanyPersonRequest) ToModel() (*models.UpdateCompanyPerson, error) {
+	model := models.UpdateCompanyPerson{
+		CompanyID:   request.CompanyID,
+		PersonID:    request.PersonID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	// can return ValidationError
+	err := model.Validate()
+	if err != nil {
+		slog.Info("UpdateCompanyPersonRequest.ToModel: request is invalid", "error", err)
+		return nil, err
 	}
 
 	return &model, nil
@@ -4,6 +4,7 @@ import (
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -74,6 +75,69 @@ func TestAssociateCompanyPersonRequestToModel_ShouldConvertToModel(t *testing.T)
 	assert.Nil(t, model.CreatedDate)
 }
 
+// -------- UpdateCompanyPersonRequest.ToModel tests: --------
+
+func TestUpdateCompanyPersonRequestToModel_ShouldConvertToModel(t *testing.T) {
+	role := "Recruiter"
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	request := UpdateCompanyPersonRequest{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+		LinkDetails: LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.NotNil(t, model)
+
+	assert.Equal(t, request.CompanyID, model.CompanyID)
+	assert.Equal(t, request.PersonID, model.PersonID)
+	assert.Equal(t, role, *model.Role)
+	assert.Nil(t, model.Notes)
+	assert.Equal(t, startDate, *model.StartDate)
+	assert.Nil(t, model.EndDate)
+}
+
+func TestUpdateCompanyPersonRequestToModel_ShouldReturnValidationErrors(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, -1)
+
+	tests := []struct {
+		testName             string
+		linkDetails          LinkDetails
+		expectedErrorMessage string
+	}{
+		{
+			"nothing to update",
+			LinkDetails{},
+			"validation error: nothing to update"},
+		{
+			"EndDate before StartDate",
+			LinkDetails{StartDate: &startDate, EndDate: &endDate},
+			"validation error on field 'EndDate': EndDate cannot be before StartDate"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			request := UpdateCompanyPersonRequest{
+				CompanyID:   uuid.New(),
+				PersonID:    uuid.New(),
+				LinkDetails: test.linkDetails,
+			}
+
+			model, err := request.ToModel()
+			assert.Nil(t, model)
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedErrorMessage, validationError.Error())
+		})
+	}
+}
+
 // -------- DeleteCompanyPersonRequest.validate tests: --------
 
 func TestDeleteCompanyPersonRequestValidate_ShouldValidateRequest(t *testing.T) {
@@ -148,8 +148,6 @@ func (request *UpdateCompanyRequest) ToModel() (*models.UpdateCompany, error) {
 }
 
 // CompanyType represents the type of company
-//
-// @enum employer,recruiter,consultancy
 type CompanyType string
 
 const (
@@ -158,12 +156,9 @@ const (
 	CompanyTypeConsultancy = "consultancy"
 )
 
+// IsValid reports whether companyType is one of the company types, which can be managed through the type endpoints.
 func (companyType CompanyType) IsValid() bool {
-	switch companyType {
-	case CompanyTypeEmployer, CompanyTypeRecruiter, CompanyTypeConsultancy:
-		return true
-	}
-	return false
+	return models.IsKnownType(models.TypeKindCompany, string(companyType))
 }
 
 func (companyType CompanyType) String() string {
@@ -172,20 +167,15 @@ func (companyType CompanyType) String() string {
 
 // ToModel can return ValidationError
 func (companyType CompanyType) ToModel() (models.CompanyType, error) {
-	switch companyType {
-	case CompanyTypeEmployer:
-		return models.CompanyTypeEmployer, nil
-	case CompanyTypeRecruiter:
-		return models.CompanyTypeRecruiter, nil
-	case CompanyTypeConsultancy:
-		return models.CompanyTypeConsultancy, nil
-	default:
+	if !companyType.IsValid() {
 		slog.Info("v1.types.toModel: Invalid CompanyType: '" + companyType.String() + "'")
 		companyTypeString := "CompanyType"
 		return "", internalErrors.NewValidationError(
 			&companyTypeString,
 			"invalid CompanyType: '"+companyType.String()+"'")
 	}
+
+	return models.CompanyType(companyType), nil
 }
 
 // NewCompanyType can return InternalServerError
@@ -197,19 +187,14 @@ func NewCompanyType(modelCompanyType *models.CompanyType) (CompanyType, error) {
 				"Error trying to convert internal companyType to external CompanyType.")
 	}
 
-	switch *modelCompanyType {
-	case models.CompanyTypeEmployer:
-		return CompanyTypeEmployer, nil
-	case models.CompanyTypeRecruiter:
-		return CompanyTypeRecruiter, nil
-	case models.CompanyTypeConsultancy:
-		return CompanyTypeConsultancy, nil
-	default:
+	if !models.IsKnownType(models.TypeKindCompany, string(*modelCompanyType)) {
 		slog.Error("v1.types.NewCompanyType: Invalid modelCompanyType: '" + modelCompanyType.String() + "'")
 		return "",
 			internalErrors.NewInternalServiceError(
 				"Error converting internal CompanyType to external CompanyType: '" + modelCompanyType.String() + "'")
 	}
+
+	return CompanyType(*modelCompanyType), nil
 }
 
 func (companyType CompanyType) ToPointer() *CompanyType {
@@ -0,0 +1,50 @@
+package requests
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// MergeRequest represents a request to merge a duplicate into the surviving company or person.
+type MergeRequest struct {
+	SurvivorID  uuid.UUID `json:"survivor_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	DuplicateID uuid.UUID `json:"duplicate_id" swaggertype:"string" format:"uuid" example:"2c1e9a4b-7d3f-4e1a-9b0c-5f6d7e8a9b0c" extensions:"x-order=1"`
+}
+
+// validate can return ValidationError
+func (request *MergeRequest) validate() error {
+	if request.SurvivorID == uuid.Nil {
+		message := "survivor_id is empty"
+		slog.Info("MergeRequest.validate failed: " + message)
+		survivorID := "survivor_id"
+		return internalErrors.NewValidationError(&survivorID, message)
+	}
+
+	if request.DuplicateID == uuid.Nil {
+		message := "duplicate_id is empty"
+		slog.Info("MergeRequest.validate failed: " + message)
+		duplicateID := "duplicate_id"
+		return internalErrors.NewValidationError(&duplicateID, message)
+	}
+
+	if request.SurvivorID == request.DuplicateID {
+		message := "survivor_id and duplicate_id cannot be the same"
+		slog.Info("MergeRequest.validate failed: " + message)
+		return internalErrors.NewValidationError(nil, message)
+	}
+
+	return nil
+}
+
+// ToModel can return ValidationError
+func (request *MergeRequest) ToModel() (*models.Merge, error) {
+	err := request.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Merge{SurvivorID: request.SurvivorID, DuplicateID: request.DuplicateID}, nil
+}
@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- MergeRequest tests: --------
+
+func TestMergeRequestToModel_ShouldConvertToModel(t *testing.T) {
+	request := MergeRequest{SurvivorID: uuid.New(), DuplicateID: uuid.New()}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.Equal(t, request.SurvivorID, model.SurvivorID)
+	assert.Equal(t, request.DuplicateID, model.DuplicateID)
+}
+
+func TestMergeRequestToModel_ShouldReturnValidationErrors(t *testing.T) {
+	sameID := uuid.New()
+
+	tests := []struct {
+		testName             string
+		request              MergeRequest
+		expectedErrorMessage string
+	}{
+		{
+			testName:             "empty survivor_id",
+			request:              MergeRequest{DuplicateID: uuid.New()},
+			expectedErrorMessage: "validation error on field 'survivor_id': survivor_id is empty",
+		},
+		{
+			testName:             "empty duplicate_id",
+			request:              MergeRequest{SurvivorID: uuid.New()},
+			expectedErrorMessage: "validation error on field 'duplicate_id': duplicate_id is empty",
+		},
+		{
+			testName:             "same IDs",
+			request:              MergeRequest{SurvivorID: sameID, DuplicateID: sameID},
+			expectedErrorMessage: "validation error: survivor_id and duplicate_id cannot be the same",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			model, err := test.request.ToModel()
+			assert.Nil(t, model)
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedErrorMessage, validationError.Error())
+		})
+	}
+}
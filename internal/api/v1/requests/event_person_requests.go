@@ -11,6 +11,7 @@ import (
 type AssociateEventPersonRequest struct {
 	EventID  uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	PersonID uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
 }
 
 // validate can return ValidationError
@@ -44,8 +45,33 @@ func (request *AssociateEventPersonRequest) ToModel() (*models.AssociateEventPer
 	}
 
 	model := models.AssociateEventPerson{
-		EventID:  request.EventID,
-		PersonID: request.PersonID,
+		EventID:     request.EventID,
+		PersonID:    request.PersonID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	return &model, nil
+}
+
+type UpdateEventPersonRequest struct {
+	EventID  uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	PersonID uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetails
+}
+
+// ToModel can return ValidationError
+func (request *UpdateEventPersonRequest) ToModel() (*models.UpdateEventPerson, error) {
+	model := models.UpdateEventPerson{
+		EventID:     request.EventID,
+		PersonID:    request.PersonID,
+		LinkDetails: request.LinkDetails.toModel(),
+	}
+
+	// can return ValidationError
+	err := model.Validate()
+	if err != nil {
+		slog.Info("UpdateEventPersonRequest.ToModel: request is invalid", "error", err)
+		return nil, err
 	}
 
 	return &model, nil
@@ -9,12 +9,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// CreateEventRequest represents a request to create an event. `timezone` is the IANA timezone the event was scheduled
+// in. If it is set, `event_date` is stored and returned with the UTC offset of that timezone.
 type CreateEventRequest struct {
-	ID          *uuid.UUID `json:"id,omitempty" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
-	EventType   EventType  `json:"event_type" example:"interviewCompleted" extensions:"x-order=2"`
-	Description *string    `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
-	Notes       *string    `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
-	EventDate   time.Time  `json:"event_date" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
+	ID              *uuid.UUID       `json:"id,omitempty" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventType       EventType        `json:"event_type" example:"interviewCompleted" extensions:"x-order=1"`
+	Description     *string          `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
+	Notes           *string          `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+	EventDate       time.Time        `json:"event_date" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
+	DurationMinutes *int             `json:"duration_minutes,omitempty" minimum:"1" example:"45" extensions:"x-order=5"`
+	Location        *string          `json:"location,omitempty" example:"Drottninggatan 1, Stockholm" extensions:"x-order=6"`
+	MeetingURL      *string          `json:"meeting_url,omitempty" example:"https://meet.example.com/abc-defg-hij" extensions:"x-order=7"`
+	InterviewFormat *InterviewFormat `json:"interview_format,omitempty" example:"video" extensions:"x-order=8"`
+	Timezone        *string          `json:"timezone,omitempty" example:"Europe/Stockholm" extensions:"x-order=9"`
 }
 
 func (request *CreateEventRequest) validate() error {
@@ -50,23 +57,39 @@ func (request *CreateEventRequest) ToModel() (*models.CreateEvent, error) {
 
 	eventType, _ := request.EventType.ToModel()
 
+	// can return ValidationError
+	interviewFormat, err := request.InterviewFormat.toModel()
+	if err != nil {
+		return nil, err
+	}
+
 	eventModel := models.CreateEvent{
-		ID:          request.ID,
-		EventType:   eventType,
-		Description: request.Description,
-		Notes:       request.Notes,
-		EventDate:   request.EventDate,
+		ID:              request.ID,
+		EventType:       eventType,
+		Description:     request.Description,
+		Notes:           request.Notes,
+		EventDate:       request.EventDate,
+		DurationMinutes: request.DurationMinutes,
+		Location:        request.Location,
+		MeetingURL:      request.MeetingURL,
+		InterviewFormat: interviewFormat,
+		Timezone:        request.Timezone,
 	}
 
 	return &eventModel, nil
 }
 
 type UpdateEventRequest struct {
-	ID          uuid.UUID  `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
-	EventType   *EventType `json:"event_type,omitempty" example:"codeTestCompleted" extensions:"x-order=2"`
-	Description *string    `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
-	Notes       *string    `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
-	EventDate   *time.Time `json:"event_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
+	ID              uuid.UUID        `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventType       *EventType       `json:"event_type,omitempty" example:"codeTestCompleted" extensions:"x-order=1"`
+	Description     *string          `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
+	Notes           *string          `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+	EventDate       *time.Time       `json:"event_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
+	DurationMinutes *int             `json:"duration_minutes,omitempty" minimum:"1" example:"45" extensions:"x-order=5"`
+	Location        *string          `json:"location,omitempty" example:"Drottninggatan 1, Stockholm" extensions:"x-order=6"`
+	MeetingURL      *string          `json:"meeting_url,omitempty" example:"https://meet.example.com/abc-defg-hij" extensions:"x-order=7"`
+	InterviewFormat *InterviewFormat `json:"interview_format,omitempty" example:"video" extensions:"x-order=8"`
+	Timezone        *string          `json:"timezone,omitempty" example:"Europe/Stockholm" extensions:"x-order=9"`
 }
 
 func (request *UpdateEventRequest) validate() error {
@@ -82,7 +105,9 @@ func (request *UpdateEventRequest) validate() error {
 		return internalErrors.NewValidationError(nil, message)
 	}
 
-	if request.EventType == nil && request.Description == nil && request.Notes == nil && request.EventDate == nil {
+	if request.EventType == nil && request.Description == nil && request.Notes == nil && request.EventDate == nil &&
+		request.DurationMinutes == nil && request.Location == nil && request.MeetingURL == nil &&
+		request.InterviewFormat == nil && request.Timezone == nil {
 		message := "nothing to update"
 		slog.Info("UpdateEventRequest.Validate: "+message, "ID", request.ID)
 		return internalErrors.NewValidationError(nil, message)
@@ -129,22 +154,35 @@ func (request *UpdateEventRequest) ToModel() (*models.UpdateEvent, error) {
 		return nil, err
 	}
 
-	eventType, _ := request.EventType.ToModel()
+	var eventType *models.EventType
+	if request.EventType != nil {
+		modelEventType, _ := request.EventType.ToModel()
+		eventType = &modelEventType
+	}
+
+	// can return ValidationError
+	interviewFormat, err := request.InterviewFormat.toModel()
+	if err != nil {
+		return nil, err
+	}
 
 	eventModel := models.UpdateEvent{
-		ID:          request.ID,
-		EventType:   &eventType,
-		Description: request.Description,
-		Notes:       request.Notes,
-		EventDate:   request.EventDate,
+		ID:              request.ID,
+		EventType:       eventType,
+		Description:     request.Description,
+		Notes:           request.Notes,
+		EventDate:       request.EventDate,
+		DurationMinutes: request.DurationMinutes,
+		Location:        request.Location,
+		MeetingURL:      request.MeetingURL,
+		InterviewFormat: interviewFormat,
+		Timezone:        request.Timezone,
 	}
 
 	return &eventModel, nil
 }
 
 // EventType represents the type of event.
-//
-// @enum applied,callBooked,callCompleted,codeTestCompleted,codeTestReceived,interviewBooked,interviewCompleted,paused,offer,other,recruiterInterviewBooked,recruiterInterviewCompleted,rejected,signed,withdrew
 type EventType string
 
 const (
@@ -165,58 +203,23 @@ const (
 	EventTypeWithdrew                    = "withdrew"
 )
 
+// isValid reports whether eventType is one of the event types, which can be managed through the type endpoints.
 func (eventType EventType) isValid() bool {
-	switch eventType {
-	case EventTypeApplied, EventTypeCallBooked, EventTypeCallCompleted, EventTypeCodeTestCompleted,
-		EventTypeCodeTestReceived, EventTypeInterviewBooked, EventTypeInterviewCompleted, EventTypePaused,
-		EventTypeOffer, EventTypeOther, EventTypeRecruiterInterviewBooked, EventTypeRecruiterInterviewCompleted,
-		EventTypeRejected, EventTypeSigned, EventTypeWithdrew:
-		return true
-	}
-	return false
+	return models.IsKnownType(models.TypeKindEvent, string(eventType))
 }
 
 func (eventType EventType) String() string { return string(eventType) }
 
 func (eventType EventType) ToModel() (models.EventType, error) {
-	switch eventType {
-	case EventTypeApplied:
-		return models.EventTypeApplied, nil
-	case EventTypeCallBooked:
-		return models.EventTypeCallBooked, nil
-	case EventTypeCallCompleted:
-		return models.EventTypeCallCompleted, nil
-	case EventTypeCodeTestCompleted:
-		return models.EventTypeCodeTestCompleted, nil
-	case EventTypeCodeTestReceived:
-		return models.EventTypeCodeTestReceived, nil
-	case EventTypeInterviewBooked:
-		return models.EventTypeInterviewBooked, nil
-	case EventTypeInterviewCompleted:
-		return models.EventTypeInterviewCompleted, nil
-	case EventTypePaused:
-		return models.EventTypePaused, nil
-	case EventTypeOffer:
-		return models.EventTypeOffer, nil
-	case EventTypeOther:
-		return models.EventTypeOther, nil
-	case EventTypeRecruiterInterviewBooked:
-		return models.EventTypeRecruiterInterviewBooked, nil
-	case EventTypeRecruiterInterviewCompleted:
-		return models.EventTypeRecruiterInterviewCompleted, nil
-	case EventTypeRejected:
-		return models.EventTypeRejected, nil
-	case EventTypeSigned:
-		return models.EventTypeSigned, nil
-	case EventTypeWithdrew:
-		return models.EventTypeWithdrew, nil
-	default:
+	if !eventType.isValid() {
 		slog.Info("v1.types.toModel: Invalid EventType: '" + eventType.String() + "'")
-		personTypeString := "EventType"
+		eventTypeString := "EventType"
 		return "", internalErrors.NewValidationError(
-			&personTypeString,
+			&eventTypeString,
 			"invalid EventType: '"+eventType.String()+"'")
 	}
+
+	return models.EventType(eventType), nil
 }
 
 func NewEventType(modelEventType *models.EventType) (EventType, error) {
@@ -226,40 +229,77 @@ func NewEventType(modelEventType *models.EventType) (EventType, error) {
 			"Error trying to convert internal eventType to external EventType.")
 	}
 
-	switch *modelEventType {
-	case models.EventTypeApplied:
-		return EventTypeApplied, nil
-	case models.EventTypeCallBooked:
-		return EventTypeCallBooked, nil
-	case models.EventTypeCallCompleted:
-		return EventTypeCallCompleted, nil
-	case models.EventTypeCodeTestCompleted:
-		return EventTypeCodeTestCompleted, nil
-	case models.EventTypeCodeTestReceived:
-		return EventTypeCodeTestReceived, nil
-	case models.EventTypeInterviewBooked:
-		return EventTypeInterviewBooked, nil
-	case models.EventTypeInterviewCompleted:
-		return EventTypeInterviewCompleted, nil
-	case models.EventTypePaused:
-		return EventTypePaused, nil
-	case models.EventTypeOffer:
-		return EventTypeOffer, nil
-	case models.EventTypeOther:
-		return EventTypeOther, nil
-	case models.EventTypeRecruiterInterviewBooked:
-		return EventTypeRecruiterInterviewBooked, nil
-	case models.EventTypeRecruiterInterviewCompleted:
-		return EventTypeRecruiterInterviewCompleted, nil
-	case models.EventTypeRejected:
-		return EventTypeRejected, nil
-	case models.EventTypeSigned:
-		return EventTypeSigned, nil
-	case models.EventTypeWithdrew:
-		return EventTypeWithdrew, nil
-	default:
+	if !models.IsKnownType(models.TypeKindEvent, string(*modelEventType)) {
 		slog.Info("v1.types.NewEventType: Invalid modelEventType: '" + modelEventType.String() + "'")
 		return "", internalErrors.NewInternalServiceError(
 			"Error converting internal EventType to external EventType: '" + modelEventType.String() + "'")
 	}
+
+	return EventType(*modelEventType), nil
+}
+
+// InterviewFormat tells how an interview or call takes place.
+//
+// @enum phone,video,onsite
+type InterviewFormat string
+
+const (
+	InterviewFormatPhone  = "phone"
+	InterviewFormatVideo  = "video"
+	InterviewFormatOnsite = "onsite"
+)
+
+func (interviewFormat InterviewFormat) String() string { return string(interviewFormat) }
+
+// toModel returns nil if interviewFormat is nil.
+//
+// toModel can return ValidationError
+func (interviewFormat *InterviewFormat) toModel() (*models.InterviewFormat, error) {
+	if interviewFormat == nil {
+		return nil, nil
+	}
+
+	var modelInterviewFormat models.InterviewFormat
+	switch *interviewFormat {
+	case InterviewFormatPhone:
+		modelInterviewFormat = models.InterviewFormatPhone
+	case InterviewFormatVideo:
+		modelInterviewFormat = models.InterviewFormatVideo
+	case InterviewFormatOnsite:
+		modelInterviewFormat = models.InterviewFormatOnsite
+	default:
+		slog.Info("v1.types.toModel: Invalid InterviewFormat: '" + interviewFormat.String() + "'")
+		interviewFormatString := "InterviewFormat"
+		return nil, internalErrors.NewValidationError(
+			&interviewFormatString,
+			"invalid InterviewFormat: '"+interviewFormat.String()+"'")
+	}
+
+	return &modelInterviewFormat, nil
+}
+
+// NewInterviewFormat returns nil if modelInterviewFormat is nil.
+//
+// NewInterviewFormat can return InternalServiceError
+func NewInterviewFormat(modelInterviewFormat *models.InterviewFormat) (*InterviewFormat, error) {
+	if modelInterviewFormat == nil {
+		return nil, nil
+	}
+
+	var interviewFormat InterviewFormat
+	switch *modelInterviewFormat {
+	case models.InterviewFormatPhone:
+		interviewFormat = InterviewFormatPhone
+	case models.InterviewFormatVideo:
+		interviewFormat = InterviewFormatVideo
+	case models.InterviewFormatOnsite:
+		interviewFormat = InterviewFormatOnsite
+	default:
+		slog.Info("v1.types.NewInterviewFormat: Invalid modelInterviewFormat: '" + modelInterviewFormat.String() + "'")
+		return nil, internalErrors.NewInternalServiceError(
+			"Error converting internal InterviewFormat to external InterviewFormat: '" +
+				modelInterviewFormat.String() + "'")
+	}
+
+	return &interviewFormat, nil
 }
@@ -441,3 +441,72 @@ func TestNewEventType_ShouldReturnInternalServiceErrorOnInvalidEventType(t *test
 		"internal service error: Error converting internal EventType to external EventType: 'Broken'",
 		internalServiceError.Error())
 }
+
+// -------- Event scheduling tests: --------
+
+func TestCreateEventRequestToModel_ShouldConvertSchedulingFields(t *testing.T) {
+	var interviewFormat InterviewFormat = InterviewFormatOnsite
+	request := CreateEventRequest{
+		EventType:       EventTypeInterviewBooked,
+		EventDate:       time.Now().AddDate(0, 0, 7),
+		DurationMinutes: testutil.ToPtr(60),
+		Location:        testutil.ToPtr("Main office"),
+		MeetingURL:      testutil.ToPtr("https://meet.example.com/abc"),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("Europe/Stockholm"),
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 60, *model.DurationMinutes)
+	assert.Equal(t, "Main office", *model.Location)
+	assert.Equal(t, "https://meet.example.com/abc", *model.MeetingURL)
+	assert.Equal(t, models.InterviewFormat(models.InterviewFormatOnsite), *model.InterviewFormat)
+	assert.Equal(t, "Europe/Stockholm", *model.Timezone)
+}
+
+func TestCreateEventRequestToModel_ShouldReturnValidationErrorOnInvalidInterviewFormat(t *testing.T) {
+	var interviewFormat InterviewFormat = "telepathy"
+	request := CreateEventRequest{
+		EventType:       EventTypeInterviewBooked,
+		EventDate:       time.Now(),
+		InterviewFormat: &interviewFormat,
+	}
+
+	model, err := request.ToModel()
+	assert.Nil(t, model)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'InterviewFormat': invalid InterviewFormat: 'telepathy'", err.Error())
+}
+
+func TestUpdateEventRequestToModel_ShouldLeaveEventTypeNilIfNotGiven(t *testing.T) {
+	request := UpdateEventRequest{
+		ID:       uuid.New(),
+		Location: testutil.ToPtr("Main office"),
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.Nil(t, model.EventType)
+	assert.Equal(t, "Main office", *model.Location)
+}
+
+func TestNewInterviewFormat_ShouldConvertEveryModelInterviewFormat(t *testing.T) {
+	for _, modelInterviewFormat := range []models.InterviewFormat{
+		models.InterviewFormatPhone, models.InterviewFormatVideo, models.InterviewFormatOnsite} {
+
+		interviewFormat, err := NewInterviewFormat(&modelInterviewFormat)
+		assert.NoError(t, err)
+
+		convertedInterviewFormat, err := interviewFormat.toModel()
+		assert.NoError(t, err)
+		assert.Equal(t, modelInterviewFormat, *convertedInterviewFormat)
+	}
+
+	interviewFormat, err := NewInterviewFormat(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, interviewFormat)
+}
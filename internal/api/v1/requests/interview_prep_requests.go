@@ -0,0 +1,178 @@
+package requests
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// CreateInterviewPrepRequest represents a request to add interview prep to an interviewCompleted or
+// codeTestCompleted event.
+type CreateInterviewPrepRequest struct {
+	EventID         uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	TechnicalTopics *string   `json:"technical_topics,omitempty" example:"Go concurrency, SQL indexes" extensions:"x-order=1"`
+	SelfRating      *int      `json:"self_rating,omitempty" minimum:"1" maximum:"5" example:"4" extensions:"x-order=2"`
+	Notes           *string   `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+}
+
+func (request *CreateInterviewPrepRequest) ToModel() (*models.CreateInterviewPrep, error) {
+	prepModel := models.CreateInterviewPrep{
+		EventID:         request.EventID,
+		TechnicalTopics: request.TechnicalTopics,
+		SelfRating:      request.SelfRating,
+		Notes:           request.Notes,
+	}
+
+	// can return ValidationError
+	err := prepModel.Validate()
+	if err != nil {
+		slog.Info("CreateInterviewPrepRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &prepModel, nil
+}
+
+type UpdateInterviewPrepRequest struct {
+	EventID         uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	TechnicalTopics *string   `json:"technical_topics,omitempty" example:"Go concurrency, SQL indexes" extensions:"x-order=1"`
+	SelfRating      *int      `json:"self_rating,omitempty" minimum:"1" maximum:"5" example:"4" extensions:"x-order=2"`
+	Notes           *string   `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+}
+
+func (request *UpdateInterviewPrepRequest) ToModel() (*models.UpdateInterviewPrep, error) {
+	prepModel := models.UpdateInterviewPrep{
+		EventID:         request.EventID,
+		TechnicalTopics: request.TechnicalTopics,
+		SelfRating:      request.SelfRating,
+		Notes:           request.Notes,
+	}
+
+	// can return ValidationError
+	err := prepModel.Validate()
+	if err != nil {
+		slog.Info("UpdateInterviewPrepRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &prepModel, nil
+}
+
+// CreateInterviewQuestionRequest represents a question from an interview or code test. `answer` is our answer to a
+// question asked to us, or the interviewer's answer to a question asked by us.
+type CreateInterviewQuestionRequest struct {
+	ID           *uuid.UUID            `json:"id,omitempty" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventID      uuid.UUID             `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	QuestionType InterviewQuestionType `json:"question_type" example:"askedToUs" extensions:"x-order=2"`
+	Question     string                `json:"question" example:"How would you design a rate limiter?" extensions:"x-order=3"`
+	Answer       *string               `json:"answer,omitempty" example:"A token bucket per client" extensions:"x-order=4"`
+	Topic        *string               `json:"topic,omitempty" example:"System design" extensions:"x-order=5"`
+}
+
+func (request *CreateInterviewQuestionRequest) ToModel() (*models.CreateInterviewQuestion, error) {
+	// can return ValidationError
+	questionType, err := request.QuestionType.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	questionModel := models.CreateInterviewQuestion{
+		ID:           request.ID,
+		EventID:      request.EventID,
+		QuestionType: questionType,
+		Question:     request.Question,
+		Answer:       request.Answer,
+		Topic:        request.Topic,
+	}
+
+	// can return ValidationError
+	err = questionModel.Validate()
+	if err != nil {
+		slog.Info("CreateInterviewQuestionRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &questionModel, nil
+}
+
+type UpdateInterviewQuestionRequest struct {
+	ID           uuid.UUID              `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	QuestionType *InterviewQuestionType `json:"question_type,omitempty" example:"askedByUs" extensions:"x-order=1"`
+	Question     *string                `json:"question,omitempty" example:"What does a typical week look like?" extensions:"x-order=2"`
+	Answer       *string                `json:"answer,omitempty" example:"Two planning meetings, the rest is focus time" extensions:"x-order=3"`
+	Topic        *string                `json:"topic,omitempty" example:"Team" extensions:"x-order=4"`
+}
+
+func (request *UpdateInterviewQuestionRequest) ToModel() (*models.UpdateInterviewQuestion, error) {
+	var questionType *models.InterviewQuestionType
+	if request.QuestionType != nil {
+		// can return ValidationError
+		modelQuestionType, err := request.QuestionType.ToModel()
+		if err != nil {
+			return nil, err
+		}
+		questionType = &modelQuestionType
+	}
+
+	questionModel := models.UpdateInterviewQuestion{
+		ID:           request.ID,
+		QuestionType: questionType,
+		Question:     request.Question,
+		Answer:       request.Answer,
+		Topic:        request.Topic,
+	}
+
+	// can return ValidationError
+	err := questionModel.Validate()
+	if err != nil {
+		slog.Info("UpdateInterviewQuestionRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &questionModel, nil
+}
+
+// InterviewQuestionType tells who asked an interview question.
+//
+// @enum askedToUs,askedByUs
+type InterviewQuestionType string
+
+const (
+	InterviewQuestionTypeAskedToUs = "askedToUs"
+	InterviewQuestionTypeAskedByUs = "askedByUs"
+)
+
+func (questionType InterviewQuestionType) String() string { return string(questionType) }
+
+// ToModel can return ValidationError
+func (questionType InterviewQuestionType) ToModel() (models.InterviewQuestionType, error) {
+	switch questionType {
+	case InterviewQuestionTypeAskedToUs:
+		return models.InterviewQuestionTypeAskedToUs, nil
+	case InterviewQuestionTypeAskedByUs:
+		return models.InterviewQuestionTypeAskedByUs, nil
+	default:
+		slog.Info("v1.types.toModel: Invalid InterviewQuestionType: '" + questionType.String() + "'")
+		questionTypeString := "QuestionType"
+		return "", internalErrors.NewValidationError(
+			&questionTypeString,
+			"invalid QuestionType: '"+questionType.String()+"'")
+	}
+}
+
+// NewInterviewQuestionType can return InternalServiceError
+func NewInterviewQuestionType(modelQuestionType models.InterviewQuestionType) (InterviewQuestionType, error) {
+	switch modelQuestionType {
+	case models.InterviewQuestionTypeAskedToUs:
+		return InterviewQuestionTypeAskedToUs, nil
+	case models.InterviewQuestionTypeAskedByUs:
+		return InterviewQuestionTypeAskedByUs, nil
+	default:
+		slog.Info("v1.types.NewInterviewQuestionType: Invalid modelQuestionType: '" + modelQuestionType.String() + "'")
+		return "", internalErrors.NewInternalServiceError(
+			"Error converting internal InterviewQuestionType to external InterviewQuestionType: '" +
+				modelQuestionType.String() + "'")
+	}
+}
@@ -0,0 +1,74 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- CreateInterviewQuestionRequest tests: --------
+
+func TestCreateInterviewQuestionRequestToModel_ShouldConvertToModel(t *testing.T) {
+	answer := "A token bucket"
+	request := CreateInterviewQuestionRequest{
+		EventID:      uuid.New(),
+		QuestionType: InterviewQuestionTypeAskedToUs,
+		Question:     "How would you design a rate limiter?",
+		Answer:       &answer,
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.Equal(t, request.EventID, model.EventID)
+	assert.Equal(t, models.InterviewQuestionType(models.InterviewQuestionTypeAskedToUs), model.QuestionType)
+	assert.Equal(t, request.Question, model.Question)
+	assert.Equal(t, answer, *model.Answer)
+}
+
+func TestCreateInterviewQuestionRequestToModel_ShouldReturnValidationErrorOnInvalidQuestionType(t *testing.T) {
+	request := CreateInterviewQuestionRequest{
+		EventID:      uuid.New(),
+		QuestionType: "askedByNobody",
+		Question:     "Why?",
+	}
+
+	model, err := request.ToModel()
+	assert.Nil(t, model)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'QuestionType': invalid QuestionType: 'askedByNobody'", err.Error())
+}
+
+// -------- UpdateInterviewPrepRequest tests: --------
+
+func TestUpdateInterviewPrepRequestToModel_ShouldReturnValidationErrorOnInvalidSelfRating(t *testing.T) {
+	selfRating := 10
+	request := UpdateInterviewPrepRequest{EventID: uuid.New(), SelfRating: &selfRating}
+
+	model, err := request.ToModel()
+	assert.Nil(t, model)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'SelfRating': SelfRating must be between 1 and 5", err.Error())
+}
+
+// -------- InterviewQuestionType tests: --------
+
+func TestNewInterviewQuestionType_ShouldConvertEveryModelType(t *testing.T) {
+	for _, modelType := range []models.InterviewQuestionType{
+		models.InterviewQuestionTypeAskedToUs, models.InterviewQuestionTypeAskedByUs} {
+
+		questionType, err := NewInterviewQuestionType(modelType)
+		assert.NoError(t, err)
+
+		convertedType, err := questionType.ToModel()
+		assert.NoError(t, err)
+		assert.Equal(t, modelType, convertedType)
+	}
+}
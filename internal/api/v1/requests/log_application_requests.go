@@ -0,0 +1,124 @@
+package requests
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// LogApplicationRequest represents a request to create an application together with its company, recruiter,
+// persons and initial event.
+//
+// `company` and `recruiter` create a new company. Alternatively, `application.company_id` and
+// `application.recruiter_id` may refer to existing companies. `persons` are created, `person_ids` refer to
+// existing persons. Every person and the event are associated with the application.
+type LogApplicationRequest struct {
+	Application CreateApplicationRequest `json:"application" extensions:"x-order=0"`
+	Company     *CreateCompanyRequest    `json:"company,omitempty" extensions:"x-order=1"`
+	Recruiter   *CreateCompanyRequest    `json:"recruiter,omitempty" extensions:"x-order=2"`
+	Persons     []*CreatePersonRequest   `json:"persons,omitempty" extensions:"x-order=3"`
+	PersonIDs   []uuid.UUID              `json:"person_ids,omitempty" swaggertype:"array,string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=4"`
+	Event       *CreateEventRequest      `json:"event,omitempty" extensions:"x-order=5"`
+}
+
+// ToModel can return ValidationError
+func (request *LogApplicationRequest) ToModel() (*models.LogApplication, error) {
+	application := request.Application
+
+	// can return ValidationError
+	company, err := inlineCompanyToModel(request.Company, &application.CompanyID, "company")
+	if err != nil {
+		return nil, err
+	}
+
+	// can return ValidationError
+	recruiter, err := inlineCompanyToModel(request.Recruiter, &application.RecruiterID, "recruiter")
+	if err != nil {
+		return nil, err
+	}
+
+	// can return ValidationError
+	applicationModel, err := application.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	persons := make([]*models.CreatePerson, 0, len(request.Persons))
+	for _, personRequest := range request.Persons {
+		if personRequest == nil {
+			persons := "persons"
+			return nil, internalErrors.NewValidationError(&persons, "persons contains a null person")
+		}
+
+		// can return ValidationError
+		person, err := personRequest.ToModel()
+		if err != nil {
+			return nil, err
+		}
+		persons = append(persons, person)
+	}
+
+	for _, personID := range request.PersonIDs {
+		if personID == uuid.Nil {
+			personIDs := "person_ids"
+			return nil, internalErrors.NewValidationError(&personIDs, "person_ids contains an empty ID")
+		}
+	}
+
+	var event *models.CreateEvent
+	if request.Event != nil {
+		// can return ValidationError
+		event, err = request.Event.ToModel()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logApplicationModel := models.LogApplication{
+		Application: applicationModel,
+		Company:     company,
+		Recruiter:   recruiter,
+		Persons:     persons,
+		PersonIDs:   request.PersonIDs,
+		Event:       event,
+	}
+
+	return &logApplicationModel, nil
+}
+
+// inlineCompanyToModel converts a company that is created together with the application. The company gets an ID if
+// it has none, and applicationCompanyID is set to that ID. An applicationCompanyID that is already set must match.
+func inlineCompanyToModel(
+	companyRequest *CreateCompanyRequest,
+	applicationCompanyID **uuid.UUID,
+	field string) (*models.CreateCompany, error) {
+
+	if companyRequest == nil {
+		return nil, nil
+	}
+
+	companyID := uuid.New()
+	if companyRequest.ID != nil {
+		companyID = *companyRequest.ID
+	}
+
+	if *applicationCompanyID != nil && **applicationCompanyID != companyID {
+		message := "application." + field + "_id does not match " + field + ".id"
+		slog.Info("LogApplicationRequest.ToModel: " + message)
+		return nil, internalErrors.NewValidationError(&field, message)
+	}
+
+	companyRequestWithID := *companyRequest
+	companyRequestWithID.ID = &companyID
+
+	// can return ValidationError
+	company, err := companyRequestWithID.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	*applicationCompanyID = &companyID
+	return company, nil
+}
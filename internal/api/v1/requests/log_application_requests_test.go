@@ -0,0 +1,142 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- LogApplicationRequest tests: --------
+
+func TestLogApplicationRequestToModel_ShouldConvertToModel(t *testing.T) {
+	companyID := uuid.New()
+	existingPersonID := uuid.New()
+
+	request := LogApplicationRequest{
+		Application: CreateApplicationRequest{
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: RemoteStatusTypeHybrid,
+		},
+		Company:   &CreateCompanyRequest{ID: &companyID, Name: "Company", CompanyType: CompanyTypeEmployer},
+		Recruiter: &CreateCompanyRequest{Name: "Recruiter", CompanyType: CompanyTypeRecruiter},
+		Persons:   []*CreatePersonRequest{{Name: "Person", PersonType: PersonTypeCTO}},
+		PersonIDs: []uuid.UUID{existingPersonID},
+		Event:     &CreateEventRequest{EventType: EventTypeApplied, EventDate: time.Now()},
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.NotNil(t, model)
+
+	assert.Equal(t, companyID, *model.Company.ID)
+	assert.Equal(t, companyID, *model.Application.CompanyID)
+
+	assert.NotNil(t, model.Recruiter.ID)
+	assert.Equal(t, *model.Recruiter.ID, *model.Application.RecruiterID)
+	assert.Nil(t, request.Recruiter.ID, "the request should not be modified")
+
+	assert.Len(t, model.Persons, 1)
+	assert.Equal(t, "Person", model.Persons[0].Name)
+	assert.Equal(t, []uuid.UUID{existingPersonID}, model.PersonIDs)
+	assert.Equal(t, EventTypeApplied, model.Event.EventType.String())
+
+	assert.NoError(t, model.Validate())
+}
+
+func TestLogApplicationRequestToModel_ShouldConvertToModelWithExistingCompany(t *testing.T) {
+	companyID := uuid.New()
+
+	request := LogApplicationRequest{
+		Application: CreateApplicationRequest{
+			CompanyID:        &companyID,
+			JobAdURL:         testutil.ToPtr("https://job.ad.url"),
+			RemoteStatusType: RemoteStatusTypeRemote,
+		},
+	}
+
+	model, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.NotNil(t, model)
+
+	assert.Equal(t, companyID, *model.Application.CompanyID)
+	assert.Nil(t, model.Company)
+	assert.Nil(t, model.Recruiter)
+	assert.Empty(t, model.Persons)
+	assert.Empty(t, model.PersonIDs)
+	assert.Nil(t, model.Event)
+}
+
+func TestLogApplicationRequestToModel_ShouldReturnValidationErrors(t *testing.T) {
+	application := CreateApplicationRequest{
+		JobTitle:         testutil.ToPtr("Job Title"),
+		RemoteStatusType: RemoteStatusTypeHybrid,
+	}
+	company := &CreateCompanyRequest{Name: "Company", CompanyType: CompanyTypeEmployer}
+
+	tests := []struct {
+		testName             string
+		request              LogApplicationRequest
+		expectedErrorMessage string
+	}{
+		{
+			testName:             "neither company nor recruiter",
+			request:              LogApplicationRequest{Application: application},
+			expectedErrorMessage: "validation error: CompanyID and RecruiterID cannot both be empty",
+		},
+		{
+			testName: "company_id does not match company",
+			request: LogApplicationRequest{
+				Application: CreateApplicationRequest{
+					CompanyID:        testutil.ToPtr(uuid.New()),
+					JobTitle:         testutil.ToPtr("Job Title"),
+					RemoteStatusType: RemoteStatusTypeHybrid,
+				},
+				Company: company,
+			},
+			expectedErrorMessage: "validation error on field 'company': application.company_id does not match company.id",
+		},
+		{
+			testName: "invalid company",
+			request: LogApplicationRequest{
+				Application: application,
+				Company:     &CreateCompanyRequest{CompanyType: CompanyTypeEmployer},
+			},
+			expectedErrorMessage: "validation error on field 'Name': Name is empty",
+		},
+		{
+			testName: "null person",
+			request: LogApplicationRequest{
+				Application: application,
+				Company:     company,
+				Persons:     []*CreatePersonRequest{nil},
+			},
+			expectedErrorMessage: "validation error on field 'persons': persons contains a null person",
+		},
+		{
+			testName: "empty person ID",
+			request: LogApplicationRequest{
+				Application: application,
+				Company:     company,
+				PersonIDs:   []uuid.UUID{uuid.Nil},
+			},
+			expectedErrorMessage: "validation error on field 'person_ids': person_ids contains an empty ID",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			model, err := test.request.ToModel()
+			assert.Nil(t, model)
+			assert.Error(t, err)
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedErrorMessage, validationError.Error())
+		})
+	}
+}
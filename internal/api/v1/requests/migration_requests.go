@@ -0,0 +1,61 @@
+package requests
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"log/slog"
+)
+
+type MigrateDownRequest struct {
+	Steps int `json:"steps" example:"1" extensions:"x-order=0"`
+}
+
+// Validate can return ValidationError
+func (request *MigrateDownRequest) Validate() error {
+	if request.Steps <= 0 {
+		message := "steps must be greater than 0"
+		slog.Info("MigrateDownRequest.Validate failed: " + message)
+		steps := "steps"
+		return internalErrors.NewValidationError(&steps, message)
+	}
+
+	return nil
+}
+
+type MigrateToVersionRequest struct {
+	Version *uint `json:"version" example:"8" extensions:"x-order=0"`
+}
+
+// Validate can return ValidationError
+func (request *MigrateToVersionRequest) Validate() error {
+	if request.Version == nil {
+		message := "version is required"
+		slog.Info("MigrateToVersionRequest.Validate failed: " + message)
+		version := "version"
+		return internalErrors.NewValidationError(&version, message)
+	}
+
+	return nil
+}
+
+type ForceMigrationVersionRequest struct {
+	Version *int `json:"version" example:"8" extensions:"x-order=0"`
+}
+
+// Validate can return ValidationError
+func (request *ForceMigrationVersionRequest) Validate() error {
+	if request.Version == nil {
+		message := "version is required"
+		slog.Info("ForceMigrationVersionRequest.Validate failed: " + message)
+		version := "version"
+		return internalErrors.NewValidationError(&version, message)
+	}
+
+	if *request.Version < -1 {
+		message := "version must be -1 or greater"
+		slog.Info("ForceMigrationVersionRequest.Validate failed: " + message)
+		version := "version"
+		return internalErrors.NewValidationError(&version, message)
+	}
+
+	return nil
+}
@@ -152,8 +152,6 @@ func (request *UpdatePersonRequest) ToModel() (*models.UpdatePerson, error) {
 }
 
 // PersonType represents the type of person.
-//
-// @enum CEO,CTO,developer,externalRecruiter,internalRecruiter,HR,jobAdvertiser,jobContact,other,unknown
 type PersonType string
 
 const (
@@ -169,47 +167,24 @@ const (
 	PersonTypeUnknown           = "unknown"
 )
 
+// IsValid reports whether personType is one of the person types, which can be managed through the type endpoints.
 func (personType PersonType) IsValid() bool {
-	switch personType {
-	case PersonTypeCEO, PersonTypeCTO, PersonTypeDeveloper, PersonTypeExternalRecruiter, PersonTypeInternalRecruiter,
-		PersonTypeHR, PersonTypeJobAdvertiser, PersonTypeJobContact, PersonTypeOther, PersonTypeUnknown:
-		return true
-	}
-	return false
+	return models.IsKnownType(models.TypeKindPerson, string(personType))
 }
 
 func (personType PersonType) String() string { return string(personType) }
 
 // ToModel can return ValidationError
 func (personType PersonType) ToModel() (models.PersonType, error) {
-	switch personType {
-	case PersonTypeCEO:
-		return models.PersonTypeCEO, nil
-	case PersonTypeCTO:
-		return models.PersonTypeCTO, nil
-	case PersonTypeDeveloper:
-		return models.PersonTypeDeveloper, nil
-	case PersonTypeExternalRecruiter:
-		return models.PersonTypeExternalRecruiter, nil
-	case PersonTypeInternalRecruiter:
-		return models.PersonTypeInternalRecruiter, nil
-	case PersonTypeHR:
-		return models.PersonTypeHR, nil
-	case PersonTypeJobAdvertiser:
-		return models.PersonTypeJobAdvertiser, nil
-	case PersonTypeJobContact:
-		return models.PersonTypeJobContact, nil
-	case PersonTypeOther:
-		return models.PersonTypeOther, nil
-	case PersonTypeUnknown:
-		return models.PersonTypeUnknown, nil
-	default:
+	if !personType.IsValid() {
 		slog.Info("v1.types.toModel: Invalid PersonType: '" + personType.String() + "'")
 		personTypeString := "PersonType"
 		return "", internalErrors.NewValidationError(
 			&personTypeString,
 			"invalid PersonType: '"+personType.String()+"'")
 	}
+
+	return models.PersonType(personType), nil
 }
 
 func NewPersonType(modelPersonType *models.PersonType) (PersonType, error) {
@@ -219,30 +194,11 @@ func NewPersonType(modelPersonType *models.PersonType) (PersonType, error) {
 			"Error trying to convert internal personType to external PersonType.")
 	}
 
-	switch *modelPersonType {
-	case models.PersonTypeCEO:
-		return PersonTypeCEO, nil
-	case models.PersonTypeCTO:
-		return PersonTypeCTO, nil
-	case models.PersonTypeDeveloper:
-		return PersonTypeDeveloper, nil
-	case models.PersonTypeExternalRecruiter:
-		return PersonTypeExternalRecruiter, nil
-	case models.PersonTypeInternalRecruiter:
-		return PersonTypeInternalRecruiter, nil
-	case models.PersonTypeHR:
-		return PersonTypeHR, nil
-	case models.PersonTypeJobAdvertiser:
-		return PersonTypeJobAdvertiser, nil
-	case models.PersonTypeJobContact:
-		return PersonTypeJobContact, nil
-	case models.PersonTypeOther:
-		return PersonTypeOther, nil
-	case models.PersonTypeUnknown:
-		return PersonTypeUnknown, nil
-	default:
+	if !models.IsKnownType(models.TypeKindPerson, string(*modelPersonType)) {
 		slog.Info("v1.types.NewPersonType: Invalid modelPersonType: '" + modelPersonType.String() + "'")
 		return "", internalErrors.NewInternalServiceError(
 			"Error converting internal PersonType to external PersonType: '" + modelPersonType.String() + "'")
 	}
+
+	return PersonType(*modelPersonType), nil
 }
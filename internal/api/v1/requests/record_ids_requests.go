@@ -0,0 +1,24 @@
+package requests
+
+import (
+	"jobsearchtracker/internal/models"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// GetByIDsRequest asks for up to 100 records by ID
+type GetByIDsRequest struct {
+	IDs []uuid.UUID `json:"ids" swaggertype:"array,string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+}
+
+// ToModel can return ValidationError
+func (request *GetByIDsRequest) ToModel() ([]uuid.UUID, error) {
+	// can return ValidationError
+	if err := models.ValidateIDs(request.IDs); err != nil {
+		slog.Info("requests.GetByIDsRequest.ToModel: IDs are invalid", "error", err)
+		return nil, err
+	}
+
+	return request.IDs, nil
+}
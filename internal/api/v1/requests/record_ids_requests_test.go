@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- GetByIDsRequest tests: --------
+
+func TestGetByIDsRequestToModel_ShouldReturnIDs(t *testing.T) {
+	request := GetByIDsRequest{IDs: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	ids, err := request.ToModel()
+	assert.NoError(t, err)
+	assert.Equal(t, request.IDs, ids)
+}
+
+func TestGetByIDsRequestToModel_ShouldReturnValidationErrorIfIDsAreEmpty(t *testing.T) {
+	request := GetByIDsRequest{}
+
+	ids, err := request.ToModel()
+	assert.Nil(t, ids)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'IDs': IDs is empty", err.Error())
+}
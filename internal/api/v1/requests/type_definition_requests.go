@@ -0,0 +1,131 @@
+package requests
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+)
+
+// CreateTypeDefinitionRequest represents a request to add an event, person or company type. The kind of type is taken
+// from the path.
+type CreateTypeDefinitionRequest struct {
+	Name          string         `json:"name" example:"technicalScreen" extensions:"x-order=0"`
+	DisplayName   string         `json:"display_name" example:"Technical screen" extensions:"x-order=1"`
+	SortOrder     int            `json:"sort_order" example:"65" extensions:"x-order=2"`
+	PipelineStage *PipelineStage `json:"pipeline_stage,omitempty" example:"interviewing" extensions:"x-order=3"`
+}
+
+// ToModel can return ValidationError
+func (request *CreateTypeDefinitionRequest) ToModel(kind string) (*models.CreateTypeDefinition, error) {
+	// can return ValidationError
+	pipelineStage, err := request.PipelineStage.toModel()
+	if err != nil {
+		return nil, err
+	}
+
+	typeDefinitionModel := models.CreateTypeDefinition{
+		Kind:          models.TypeKind(kind),
+		Name:          request.Name,
+		DisplayName:   request.DisplayName,
+		SortOrder:     request.SortOrder,
+		PipelineStage: pipelineStage,
+	}
+
+	// can return ValidationError
+	err = typeDefinitionModel.Validate()
+	if err != nil {
+		slog.Info("CreateTypeDefinitionRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &typeDefinitionModel, nil
+}
+
+// UpdateTypeDefinitionRequest represents a request to update an event, person or company type. The name of a type
+// cannot be changed, as it is stored on the events, persons and companies of that type.
+type UpdateTypeDefinitionRequest struct {
+	Name          string         `json:"name" example:"technicalScreen" extensions:"x-order=0"`
+	DisplayName   *string        `json:"display_name,omitempty" example:"Technical screen" extensions:"x-order=1"`
+	SortOrder     *int           `json:"sort_order,omitempty" example:"65" extensions:"x-order=2"`
+	PipelineStage *PipelineStage `json:"pipeline_stage,omitempty" example:"interviewing" extensions:"x-order=3"`
+}
+
+// ToModel can return ValidationError
+func (request *UpdateTypeDefinitionRequest) ToModel(kind string) (*models.UpdateTypeDefinition, error) {
+	// can return ValidationError
+	pipelineStage, err := request.PipelineStage.toModel()
+	if err != nil {
+		return nil, err
+	}
+
+	typeDefinitionModel := models.UpdateTypeDefinition{
+		Kind:          models.TypeKind(kind),
+		Name:          request.Name,
+		DisplayName:   request.DisplayName,
+		SortOrder:     request.SortOrder,
+		PipelineStage: pipelineStage,
+	}
+
+	// can return ValidationError
+	err = typeDefinitionModel.Validate()
+	if err != nil {
+		slog.Info("UpdateTypeDefinitionRequest.ToModel: request is invalid", "error", err)
+		return nil, err
+	}
+
+	return &typeDefinitionModel, nil
+}
+
+// PipelineStage is the stage of the application pipeline that a type belongs to.
+//
+// @enum applied,screening,interviewing,offer,hired,closed
+type PipelineStage string
+
+const (
+	PipelineStageApplied      = "applied"
+	PipelineStageScreening    = "screening"
+	PipelineStageInterviewing = "interviewing"
+	PipelineStageOffer        = "offer"
+	PipelineStageHired        = "hired"
+	PipelineStageClosed       = "closed"
+)
+
+func (pipelineStage PipelineStage) String() string { return string(pipelineStage) }
+
+// toModel returns nil if pipelineStage is nil.
+//
+// toModel can return ValidationError
+func (pipelineStage *PipelineStage) toModel() (*models.PipelineStage, error) {
+	if pipelineStage == nil {
+		return nil, nil
+	}
+
+	modelPipelineStage := models.PipelineStage(*pipelineStage)
+	if !modelPipelineStage.IsValid() {
+		slog.Info("v1.types.toModel: Invalid PipelineStage: '" + pipelineStage.String() + "'")
+		pipelineStageString := "PipelineStage"
+		return nil, internalErrors.NewValidationError(
+			&pipelineStageString,
+			"invalid PipelineStage: '"+pipelineStage.String()+"'")
+	}
+
+	return &modelPipelineStage, nil
+}
+
+// NewPipelineStage returns nil if modelPipelineStage is nil.
+//
+// NewPipelineStage can return InternalServiceError
+func NewPipelineStage(modelPipelineStage *models.PipelineStage) (*PipelineStage, error) {
+	if modelPipelineStage == nil {
+		return nil, nil
+	}
+
+	if !modelPipelineStage.IsValid() {
+		slog.Info("v1.types.NewPipelineStage: Invalid modelPipelineStage: '" + modelPipelineStage.String() + "'")
+		return nil, internalErrors.NewInternalServiceError(
+			"Error converting internal PipelineStage to external PipelineStage: '" + modelPipelineStage.String() + "'")
+	}
+
+	pipelineStage := PipelineStage(*modelPipelineStage)
+	return &pipelineStage, nil
+}
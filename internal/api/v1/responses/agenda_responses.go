@@ -0,0 +1,36 @@
+package responses
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+)
+
+// AgendaResponse lists the booked events in the requested period, soonest first, and the overdue booked events,
+// oldest first.
+type AgendaResponse struct {
+	Upcoming []*EventResponse `json:"upcoming" extensions:"x-order=0"`
+	Overdue  []*EventResponse `json:"overdue" extensions:"x-order=1"`
+}
+
+// NewAgendaResponse can return InternalServiceError
+func NewAgendaResponse(agenda *models.Agenda) (*AgendaResponse, error) {
+	if agenda == nil {
+		slog.Error("responses.NewAgendaResponse: agenda is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: Agenda is nil")
+	}
+
+	// can return InternalServiceError
+	upcoming, err := NewEventsResponse(agenda.Upcoming)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return InternalServiceError
+	overdue, err := NewEventsResponse(agenda.Overdue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AgendaResponse{Upcoming: upcoming, Overdue: overdue}, nil
+}
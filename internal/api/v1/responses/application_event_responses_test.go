@@ -31,6 +31,31 @@ func TestNewApplicationEventResponse_ReturnNilIfModelIsNil(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestNewApplicationEventResponse_ShouldIncludeLinkDetails(t *testing.T) {
+	role := "Hiring manager"
+	startDate := time.Now().AddDate(-1, 0, 0)
+	updatedDate := time.Now()
+	model := models.ApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+		LinkDetails: models.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+		CreatedDate: time.Now().AddDate(-1, 0, 0),
+		UpdatedDate: &updatedDate,
+	}
+
+	response := NewApplicationEventResponse(&model)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, role, *response.Role)
+	assert.Nil(t, response.Notes)
+	assert.Equal(t, startDate, *response.StartDate)
+	assert.Nil(t, response.EndDate)
+	assert.Equal(t, updatedDate, *response.UpdatedDate)
+}
+
 // -------- NewApplicationEventsResponse tests: --------
 
 func TestNewApplicationEventsResponse_ShouldWork(t *testing.T) {
@@ -10,7 +10,9 @@ import (
 type ApplicationPersonResponse struct {
 	ApplicationID uuid.UUID `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
 	PersonID      uuid.UUID `json:"person_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
-	CreatedDate   time.Time `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=2"`
+	LinkDetailsDTO
+	CreatedDate time.Time  `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=6"`
+	UpdatedDate *time.Time `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=7"`
 }
 
 func NewApplicationPersonResponse(model *models.ApplicationPerson) *ApplicationPersonResponse {
@@ -19,9 +21,11 @@ func NewApplicationPersonResponse(model *models.ApplicationPerson) *ApplicationP
 	}
 
 	response := &ApplicationPersonResponse{
-		ApplicationID: model.ApplicationID,
-		PersonID:      model.PersonID,
-		CreatedDate:   model.CreatedDate,
+		ApplicationID:  model.ApplicationID,
+		PersonID:       model.PersonID,
+		LinkDetailsDTO: NewLinkDetailsDTO(model.LinkDetails),
+		CreatedDate:    model.CreatedDate,
+		UpdatedDate:    model.UpdatedDate,
 	}
 
 	return response
@@ -31,6 +31,31 @@ func TestNewApplicationPersonResponse_ReturnNilIfModelIsNil(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestNewApplicationPersonResponse_ShouldIncludeLinkDetails(t *testing.T) {
+	role := "Hiring manager"
+	startDate := time.Now().AddDate(-1, 0, 0)
+	updatedDate := time.Now()
+	model := models.ApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+		LinkDetails: models.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+		CreatedDate: time.Now().AddDate(-1, 0, 0),
+		UpdatedDate: &updatedDate,
+	}
+
+	response := NewApplicationPersonResponse(&model)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, role, *response.Role)
+	assert.Nil(t, response.Notes)
+	assert.Equal(t, startDate, *response.StartDate)
+	assert.Nil(t, response.EndDate)
+	assert.Equal(t, updatedDate, *response.UpdatedDate)
+}
+
 // -------- NewApplicationPersonsResponse tests: --------
 
 func TestNewApplicationPersonsResponse_ShouldWork(t *testing.T) {
@@ -0,0 +1,48 @@
+package responses
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+)
+
+// BulkLinkResponse holds the outcome of each link of a bulk associate or delete request, in the order of the request.
+type BulkLinkResponse struct {
+	Succeeded int                       `json:"succeeded" example:"4" extensions:"x-order=0"`
+	Failed    int                       `json:"failed" example:"1" extensions:"x-order=1"`
+	Results   []*BulkLinkResultResponse `json:"results" extensions:"x-order=2"`
+}
+
+// BulkLinkResultResponse is the outcome of one link. `status` is `associated`, `deleted`, `conflict`, `notFound` or
+// `invalid`. `message` explains why the link failed.
+type BulkLinkResultResponse struct {
+	Index   int     `json:"index" example:"0" extensions:"x-order=0"`
+	Status  string  `json:"status" example:"conflict" extensions:"x-order=1"`
+	Message *string `json:"message,omitempty" example:"conflict error on insert: ..." extensions:"x-order=2"`
+}
+
+// NewBulkLinkResponse can return InternalServiceError
+func NewBulkLinkResponse(results []*models.BulkLinkResult) (*BulkLinkResponse, error) {
+	response := BulkLinkResponse{Results: make([]*BulkLinkResultResponse, len(results))}
+
+	for index, result := range results {
+		if result == nil {
+			slog.Error("responses.NewBulkLinkResponse: BulkLinkResult is nil")
+			return nil, internalErrors.NewInternalServiceError("Error building response: BulkLinkResult is nil")
+		}
+
+		if result.Status.IsSuccess() {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+
+		response.Results[index] = &BulkLinkResultResponse{
+			Index:   result.Index,
+			Status:  result.Status.String(),
+			Message: result.Message,
+		}
+	}
+
+	return &response, nil
+}
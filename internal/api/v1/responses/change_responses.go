@@ -0,0 +1,39 @@
+package responses
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeResponse is the data of one event of the change stream. For a link, `entity_id` is the ID of the first entity
+// in `entity_type` and `related_entity_id` the ID of the second, so for `company-person` they are the company ID and
+// the person ID.
+type ChangeResponse struct {
+	Sequence        int64      `json:"sequence" example:"42" extensions:"x-order=0"`
+	EntityType      string     `json:"entity_type" example:"application" extensions:"x-order=1"`
+	ChangeType      string     `json:"change_type" example:"updated" extensions:"x-order=2"`
+	EntityID        uuid.UUID  `json:"entity_id" example:"8abb6f3a-2d5e-4ee1-a4a6-2c7d1f0e5b11" extensions:"x-order=3"`
+	RelatedEntityID *uuid.UUID `json:"related_entity_id,omitempty" extensions:"x-order=4"`
+	ChangeDate      time.Time  `json:"change_date" example:"2025-12-31T23:59:00Z" extensions:"x-order=5"`
+}
+
+// NewChangeResponse can return InternalServiceError
+func NewChangeResponse(change *models.Change) (*ChangeResponse, error) {
+	if change == nil {
+		slog.Error("responses.NewChangeResponse: Change is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: Change is nil")
+	}
+
+	return &ChangeResponse{
+		Sequence:        change.Sequence,
+		EntityType:      change.EntityType.String(),
+		ChangeType:      change.ChangeType.String(),
+		EntityID:        change.EntityID,
+		RelatedEntityID: change.RelatedEntityID,
+		ChangeDate:      change.ChangeDate,
+	}, nil
+}
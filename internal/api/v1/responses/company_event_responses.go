@@ -8,9 +8,11 @@ import (
 )
 
 type CompanyEventResponse struct {
-	CompanyID   uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
-	EventID     uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
-	CreatedDate time.Time `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=2"`
+	CompanyID uuid.UUID `json:"company_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventID   uuid.UUID `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	LinkDetailsDTO
+	CreatedDate time.Time  `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=6"`
+	UpdatedDate *time.Time `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=7"`
 }
 
 func NewCompanyEventResponse(model *models.CompanyEvent) *CompanyEventResponse {
@@ -19,9 +21,11 @@ func NewCompanyEventResponse(model *models.CompanyEvent) *CompanyEventResponse {
 	}
 
 	response := &CompanyEventResponse{
-		CompanyID:   model.CompanyID,
-		EventID:     model.EventID,
-		CreatedDate: model.CreatedDate,
+		CompanyID:      model.CompanyID,
+		EventID:        model.EventID,
+		LinkDetailsDTO: NewLinkDetailsDTO(model.LinkDetails),
+		CreatedDate:    model.CreatedDate,
+		UpdatedDate:    model.UpdatedDate,
 	}
 
 	return response
@@ -31,6 +31,31 @@ func TestNewCompanyEventResponse_ReturnNilIfModelIsNil(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestNewCompanyEventResponse_ShouldIncludeLinkDetails(t *testing.T) {
+	role := "Hiring manager"
+	startDate := time.Now().AddDate(-1, 0, 0)
+	updatedDate := time.Now()
+	model := models.CompanyEvent{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+		LinkDetails: models.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+		CreatedDate: time.Now().AddDate(-1, 0, 0),
+		UpdatedDate: &updatedDate,
+	}
+
+	response := NewCompanyEventResponse(&model)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, role, *response.Role)
+	assert.Nil(t, response.Notes)
+	assert.Equal(t, startDate, *response.StartDate)
+	assert.Nil(t, response.EndDate)
+	assert.Equal(t, updatedDate, *response.UpdatedDate)
+}
+
 // -------- NewCompanyEventsResponse tests: --------
 
 func TestNewCompanyEventsResponse_ShouldWork(t *testing.T) {
@@ -31,6 +31,31 @@ func TestNewCompanyPersonResponse_ReturnNilIfModelIsNil(t *testing.T) {
 	assert.Nil(t, response)
 }
 
+func TestNewCompanyPersonResponse_ShouldIncludeLinkDetails(t *testing.T) {
+	role := "Hiring manager"
+	startDate := time.Now().AddDate(-1, 0, 0)
+	updatedDate := time.Now()
+	model := models.CompanyPerson{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+		LinkDetails: models.LinkDetails{
+			Role:      &role,
+			StartDate: &startDate,
+		},
+		CreatedDate: time.Now().AddDate(-1, 0, 0),
+		UpdatedDate: &updatedDate,
+	}
+
+	response := NewCompanyPersonResponse(&model)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, role, *response.Role)
+	assert.Nil(t, response.Notes)
+	assert.Equal(t, startDate, *response.StartDate)
+	assert.Nil(t, response.EndDate)
+	assert.Equal(t, updatedDate, *response.UpdatedDate)
+}
+
 // -------- NewCompanyPersonsResponse tests: --------
 
 func TestNewCompanyPersonsResponse_ShouldWork(t *testing.T) {
@@ -0,0 +1,95 @@
+package responses
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+)
+
+// CompanyDuplicateResponse represents two companies that are probably the same. `company` is the older one, and the
+// suggested survivor of a merge.
+type CompanyDuplicateResponse struct {
+	Company   *CompanyDTO `json:"company" extensions:"x-order=0"`
+	Duplicate *CompanyDTO `json:"duplicate" extensions:"x-order=1"`
+	Score     float64     `json:"score" example:"0.92" extensions:"x-order=2"`
+	Reasons   []string    `json:"reasons" example:"name" extensions:"x-order=3"`
+}
+
+// NewCompanyDuplicatesResponse can return InternalServiceError
+func NewCompanyDuplicatesResponse(duplicates []*models.CompanyDuplicate) ([]*CompanyDuplicateResponse, error) {
+	duplicatesResponse := make([]*CompanyDuplicateResponse, len(duplicates))
+	for index, duplicate := range duplicates {
+		if duplicate == nil {
+			slog.Error("responses.NewCompanyDuplicatesResponse: CompanyDuplicate is nil")
+			return nil, internalErrors.NewInternalServiceError("Error building response: CompanyDuplicate is nil")
+		}
+
+		// can return InternalServiceError
+		company, err := NewCompanyDTO(duplicate.Company)
+		if err != nil {
+			return nil, err
+		}
+
+		// can return InternalServiceError
+		duplicateCompany, err := NewCompanyDTO(duplicate.Duplicate)
+		if err != nil {
+			return nil, err
+		}
+
+		duplicatesResponse[index] = &CompanyDuplicateResponse{
+			Company:   company,
+			Duplicate: duplicateCompany,
+			Score:     duplicate.Score,
+			Reasons:   newDuplicateReasons(duplicate.Reasons),
+		}
+	}
+	return duplicatesResponse, nil
+}
+
+// PersonDuplicateResponse represents two persons that are probably the same. `person` is the older one, and the
+// suggested survivor of a merge.
+type PersonDuplicateResponse struct {
+	Person    *PersonDTO `json:"person" extensions:"x-order=0"`
+	Duplicate *PersonDTO `json:"duplicate" extensions:"x-order=1"`
+	Score     float64    `json:"score" example:"1" extensions:"x-order=2"`
+	Reasons   []string   `json:"reasons" example:"name,email,domain" extensions:"x-order=3"`
+}
+
+// NewPersonDuplicatesResponse can return InternalServiceError
+func NewPersonDuplicatesResponse(duplicates []*models.PersonDuplicate) ([]*PersonDuplicateResponse, error) {
+	duplicatesResponse := make([]*PersonDuplicateResponse, len(duplicates))
+	for index, duplicate := range duplicates {
+		if duplicate == nil {
+			slog.Error("responses.NewPersonDuplicatesResponse: PersonDuplicate is nil")
+			return nil, internalErrors.NewInternalServiceError("Error building response: PersonDuplicate is nil")
+		}
+
+		// can return InternalServiceError
+		person, err := NewPersonDTO(duplicate.Person)
+		if err != nil {
+			return nil, err
+		}
+
+		// can return InternalServiceError
+		duplicatePerson, err := NewPersonDTO(duplicate.Duplicate)
+		if err != nil {
+			return nil, err
+		}
+
+		duplicatesResponse[index] = &PersonDuplicateResponse{
+			Person:    person,
+			Duplicate: duplicatePerson,
+			Score:     duplicate.Score,
+			Reasons:   newDuplicateReasons(duplicate.Reasons),
+		}
+	}
+	return duplicatesResponse, nil
+}
+
+func newDuplicateReasons(reasons []models.DuplicateReason) []string {
+	reasonStrings := make([]string, len(reasons))
+	for index, reason := range reasons {
+		reasonStrings[index] = reason.String()
+	}
+	return reasonStrings
+}
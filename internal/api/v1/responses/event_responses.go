@@ -10,14 +10,21 @@ import (
 	"github.com/google/uuid"
 )
 
+// EventDTO is an event. `end_date` is `event_date` plus `duration_minutes`.
 type EventDTO struct {
-	ID          uuid.UUID           `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
-	EventType   *requests.EventType `json:"event_type,omitempty" example:"interviewCompleted" extensions:"x-order=2"`
-	Description *string             `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
-	Notes       *string             `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
-	EventDate   *time.Time          `json:"event_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
-	CreatedDate *time.Time          `json:"created_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=5"`
-	UpdatedDate *time.Time          `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=6"`
+	ID              uuid.UUID                 `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventType       *requests.EventType       `json:"event_type,omitempty" example:"interviewCompleted" extensions:"x-order=1"`
+	Description     *string                   `json:"description,omitempty" example:"Event Description" extensions:"x-order=2"`
+	Notes           *string                   `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+	EventDate       *time.Time                `json:"event_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=4"`
+	DurationMinutes *int                      `json:"duration_minutes,omitempty" example:"45" extensions:"x-order=5"`
+	EndDate         *time.Time                `json:"end_date,omitempty" example:"2026-01-01T00:44Z" extensions:"x-order=6"`
+	Location        *string                   `json:"location,omitempty" example:"Drottninggatan 1, Stockholm" extensions:"x-order=7"`
+	MeetingURL      *string                   `json:"meeting_url,omitempty" example:"https://meet.example.com/abc-defg-hij" extensions:"x-order=8"`
+	InterviewFormat *requests.InterviewFormat `json:"interview_format,omitempty" example:"video" extensions:"x-order=9"`
+	Timezone        *string                   `json:"timezone,omitempty" example:"Europe/Stockholm" extensions:"x-order=10"`
+	CreatedDate     *time.Time                `json:"created_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=11"`
+	UpdatedDate     *time.Time                `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=12"`
 }
 
 func NewEventDTO(eventModel *models.Event) (*EventDTO, error) {
@@ -35,14 +42,26 @@ func NewEventDTO(eventModel *models.Event) (*EventDTO, error) {
 		eventType = &nonNilEventType
 	}
 
+	// can return InternalServiceError
+	interviewFormat, err := requests.NewInterviewFormat(eventModel.InterviewFormat)
+	if err != nil {
+		return nil, err
+	}
+
 	eventDto := EventDTO{
-		ID:          eventModel.ID,
-		EventType:   eventType,
-		Description: eventModel.Description,
-		Notes:       eventModel.Notes,
-		EventDate:   eventModel.EventDate,
-		CreatedDate: eventModel.CreatedDate,
-		UpdatedDate: eventModel.UpdatedDate,
+		ID:              eventModel.ID,
+		EventType:       eventType,
+		Description:     eventModel.Description,
+		Notes:           eventModel.Notes,
+		EventDate:       eventModel.EventDate,
+		DurationMinutes: eventModel.DurationMinutes,
+		EndDate:         eventModel.EndDate(),
+		Location:        eventModel.Location,
+		MeetingURL:      eventModel.MeetingURL,
+		InterviewFormat: interviewFormat,
+		Timezone:        eventModel.Timezone,
+		CreatedDate:     eventModel.CreatedDate,
+		UpdatedDate:     eventModel.UpdatedDate,
 	}
 
 	return &eventDto, nil
@@ -64,11 +83,14 @@ func NewEventDTOs(events []*models.Event) ([]*EventDTO, error) {
 	return eventDTOs, nil
 }
 
+// EventResponse is an event with its related data. `overlapping_events` warns about other booked events that clash
+// with a booked event, and is left out if there are none.
 type EventResponse struct {
 	EventDTO
-	Applications *[]*ApplicationDTO `json:"applications" extensions:"x-order=7"`
-	Companies    *[]*CompanyDTO     `json:"companies" extensions:"x-order=8"`
-	Persons      *[]*PersonDTO      `json:"events" extensions:"x-order=9"`
+	Applications      *[]*ApplicationDTO `json:"applications" extensions:"x-order=13"`
+	Companies         *[]*CompanyDTO     `json:"companies" extensions:"x-order=14"`
+	Persons           *[]*PersonDTO      `json:"events" extensions:"x-order=15"`
+	OverlappingEvents []*EventDTO        `json:"overlapping_events,omitempty" extensions:"x-order=16"`
 }
 
 func NewEventResponse(eventModel *models.Event) (*EventResponse, error) {
@@ -109,11 +131,21 @@ func NewEventResponse(eventModel *models.Event) (*EventResponse, error) {
 		}
 	}
 
+	var overlappingEvents []*EventDTO
+	if len(eventModel.OverlappingEvents) > 0 {
+		// can return InternalServerError
+		overlappingEvents, err = NewEventDTOs(eventModel.OverlappingEvents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	eventResponse := EventResponse{
-		EventDTO:     *eventDto,
-		Applications: &applications,
-		Companies:    &companies,
-		Persons:      &persons,
+		EventDTO:          *eventDto,
+		Applications:      &applications,
+		Companies:         &companies,
+		Persons:           &persons,
+		OverlappingEvents: overlappingEvents,
 	}
 
 	return &eventResponse, nil
@@ -39,6 +39,31 @@ func TestNewEventDTO_ShouldWork(t *testing.T) {
 	testutil.AssertEqualFormattedDateTimes(t, model.UpdatedDate, eventDTO.UpdatedDate)
 }
 
+func TestNewEventDTO_ShouldConvertSchedulingFields(t *testing.T) {
+	var eventType models.EventType = models.EventTypeInterviewBooked
+	var interviewFormat models.InterviewFormat = models.InterviewFormatPhone
+	eventDate := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	model := models.Event{
+		ID:              uuid.New(),
+		EventType:       &eventType,
+		EventDate:       &eventDate,
+		DurationMinutes: testutil.ToPtr(20),
+		Location:        testutil.ToPtr("Phone"),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("Europe/London"),
+	}
+
+	eventDTO, err := NewEventDTO(&model)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 20, *eventDTO.DurationMinutes)
+	assert.Equal(t, eventDate.Add(20*time.Minute), *eventDTO.EndDate)
+	assert.Equal(t, "Phone", *eventDTO.Location)
+	assert.Nil(t, eventDTO.MeetingURL)
+	assert.Equal(t, "phone", eventDTO.InterviewFormat.String())
+	assert.Equal(t, "Europe/London", *eventDTO.Timezone)
+}
+
 func TestNewEventDTO_ShouldWorkWithOnlyID(t *testing.T) {
 	var model = models.Event{
 		ID: uuid.New(),
@@ -0,0 +1,98 @@
+package responses
+
+import (
+	"encoding/json"
+	internalErrors "jobsearchtracker/internal/errors"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SelectFields returns response with only the given top-level attributes. response is a response struct, or a slice
+// of them. The id is always kept, so that the records can still be told apart. Included records, such as the company
+// of an application, are kept whole. Without fields, response is returned unchanged.
+//
+// SelectFields can return InternalServiceError, ValidationError
+func SelectFields(response any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return response, nil
+	}
+
+	validFields := getJSONFieldNames(reflect.TypeOf(response))
+	selectedFields := map[string]bool{"id": true}
+	for _, field := range fields {
+		if !slices.Contains(validFields, field) {
+			fieldsField := "fields"
+			return nil, internalErrors.NewValidationError(
+				&fieldsField,
+				"'"+field+"' is not a valid field. Valid fields are: "+strings.Join(validFields, ", "))
+		}
+		selectedFields[field] = true
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, internalErrors.NewInternalServiceError("Error encoding response: " + err.Error())
+	}
+
+	selectObjectFields := func(object map[string]json.RawMessage) {
+		for name := range object {
+			if !selectedFields[name] {
+				delete(object, name)
+			}
+		}
+	}
+
+	responseType := reflect.TypeOf(response)
+	for responseType.Kind() == reflect.Pointer {
+		responseType = responseType.Elem()
+	}
+
+	if responseType.Kind() == reflect.Slice {
+		objects := []map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &objects); err != nil {
+			return nil, internalErrors.NewInternalServiceError("Error selecting fields: " + err.Error())
+		}
+		for _, object := range objects {
+			selectObjectFields(object)
+		}
+		return objects, nil
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, internalErrors.NewInternalServiceError("Error selecting fields: " + err.Error())
+	}
+	selectObjectFields(object)
+	return object, nil
+}
+
+// getJSONFieldNames returns the JSON names of the attributes of a response struct, or of the elements of a slice, in
+// order. The attributes of embedded DTOs are included.
+func getJSONFieldNames(responseType reflect.Type) []string {
+	for responseType.Kind() == reflect.Pointer || responseType.Kind() == reflect.Slice {
+		responseType = responseType.Elem()
+	}
+
+	var names []string
+	for index := 0; index < responseType.NumField(); index++ {
+		field := responseType.Field(index)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if field.Anonymous && name == "" {
+			names = append(names, getJSONFieldNames(field.Type)...)
+			continue
+		}
+
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
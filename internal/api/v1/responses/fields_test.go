@@ -0,0 +1,81 @@
+package responses
+
+import (
+	"encoding/json"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- SelectFields tests: --------
+
+func TestSelectFields_ShouldReturnResponseUnchangedWithoutFields(t *testing.T) {
+	response := &CompanyResponse{CompanyDTO: CompanyDTO{ID: uuid.New()}}
+
+	selected, err := SelectFields(response, nil)
+	assert.NoError(t, err)
+	assert.Same(t, response, selected)
+}
+
+func TestSelectFields_ShouldKeepIDAndSelectedFields(t *testing.T) {
+	companyID := uuid.New()
+	response := &CompanyResponse{
+		CompanyDTO: CompanyDTO{
+			ID:    companyID,
+			Name:  testutil.ToPtr("Company"),
+			Notes: testutil.ToPtr("Notes"),
+		},
+		Persons: &[]*PersonDTO{{ID: uuid.New(), Name: testutil.ToPtr("Person")}},
+	}
+
+	selected, err := SelectFields(response, []string{"name", "persons"})
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(selected)
+	assert.NoError(t, err)
+
+	var object map[string]any
+	assert.NoError(t, json.Unmarshal(data, &object))
+	assert.Len(t, object, 3)
+	assert.Equal(t, companyID.String(), object["id"])
+	assert.Equal(t, "Company", object["name"])
+	assert.Len(t, object["persons"], 1)
+}
+
+func TestSelectFields_ShouldSelectFieldsOfEveryElement(t *testing.T) {
+	response := []*CompanyResponse{
+		{CompanyDTO: CompanyDTO{ID: uuid.New(), Name: testutil.ToPtr("First"), Notes: testutil.ToPtr("Notes")}},
+		{CompanyDTO: CompanyDTO{ID: uuid.New(), Name: testutil.ToPtr("Second")}},
+	}
+
+	selected, err := SelectFields(response, []string{"name"})
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(selected)
+	assert.NoError(t, err)
+
+	var objects []map[string]any
+	assert.NoError(t, json.Unmarshal(data, &objects))
+	assert.Len(t, objects, 2)
+	assert.Equal(t, map[string]any{"id": response[0].ID.String(), "name": "First"}, objects[0])
+	assert.Equal(t, map[string]any{"id": response[1].ID.String(), "name": "Second"}, objects[1])
+}
+
+func TestSelectFields_ShouldReturnValidationErrorForUnknownField(t *testing.T) {
+	response := []*CompanyResponse{}
+
+	selected, err := SelectFields(response, []string{"name", "salary"})
+	assert.Nil(t, selected)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'fields': 'salary' is not a valid field. Valid fields are: id, name, "+
+			"company_type, notes, last_contact, created_date, updated_date, applications, persons, events",
+		err.Error())
+}
@@ -0,0 +1,53 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/models"
+)
+
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+)
+
+type LivenessResponse struct {
+	Status string `json:"status" example:"ok" extensions:"x-order=0"`
+}
+
+type ReadinessResponse struct {
+	Status           string  `json:"status" example:"ok" extensions:"x-order=0"`
+	Database         string  `json:"database" example:"ok" extensions:"x-order=1"`
+	DatabaseError    *string `json:"database_error,omitempty" extensions:"x-order=2"`
+	MigrationVersion *uint   `json:"migration_version,omitempty" example:"9" extensions:"x-order=3"`
+	MigrationDirty   *bool   `json:"migration_dirty,omitempty" example:"false" extensions:"x-order=4"`
+	PendingVersions  []uint  `json:"pending_versions,omitempty" extensions:"x-order=5"`
+	MigrationError   *string `json:"migration_error,omitempty" extensions:"x-order=6"`
+}
+
+func NewReadinessResponse(model *models.Readiness) *ReadinessResponse {
+	if model == nil {
+		return nil
+	}
+
+	response := ReadinessResponse{
+		Status:         HealthStatusUnavailable,
+		Database:       HealthStatusUnavailable,
+		DatabaseError:  model.DatabaseError,
+		MigrationError: model.MigrationError,
+	}
+
+	if model.IsReady() {
+		response.Status = HealthStatusOK
+	}
+
+	if model.IsDatabaseReachable {
+		response.Database = HealthStatusOK
+	}
+
+	if model.Migration != nil {
+		response.MigrationVersion = &model.Migration.Version
+		response.MigrationDirty = &model.Migration.Dirty
+		response.PendingVersions = model.Migration.PendingVersions
+	}
+
+	return &response
+}
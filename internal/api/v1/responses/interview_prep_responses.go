@@ -0,0 +1,114 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/api/v1/requests"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InterviewPrepResponse represents the interview prep of an interviewCompleted or codeTestCompleted event, with its
+// questions.
+type InterviewPrepResponse struct {
+	EventID         uuid.UUID                    `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	TechnicalTopics *string                      `json:"technical_topics,omitempty" example:"Go concurrency, SQL indexes" extensions:"x-order=1"`
+	SelfRating      *int                         `json:"self_rating,omitempty" example:"4" extensions:"x-order=2"`
+	Notes           *string                      `json:"notes,omitempty" example:"Notes go here" extensions:"x-order=3"`
+	Questions       []*InterviewQuestionResponse `json:"questions" extensions:"x-order=4"`
+	CreatedDate     *time.Time                   `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=5"`
+	UpdatedDate     *time.Time                   `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=6"`
+}
+
+// NewInterviewPrepResponse can return InternalServiceError
+func NewInterviewPrepResponse(prep *models.InterviewPrep) (*InterviewPrepResponse, error) {
+	if prep == nil {
+		slog.Error("responses.NewInterviewPrepResponse: InterviewPrep is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: InterviewPrep is nil")
+	}
+
+	// can return InternalServiceError
+	questions, err := NewInterviewQuestionsResponse(prep.Questions)
+	if err != nil {
+		return nil, err
+	}
+
+	prepResponse := InterviewPrepResponse{
+		EventID:         prep.EventID,
+		TechnicalTopics: prep.TechnicalTopics,
+		SelfRating:      prep.SelfRating,
+		Notes:           prep.Notes,
+		Questions:       questions,
+		CreatedDate:     prep.CreatedDate,
+		UpdatedDate:     prep.UpdatedDate,
+	}
+
+	return &prepResponse, nil
+}
+
+// InterviewQuestionResponse represents a question from an interview or code test. `event_type` and `event_date` are
+// those of the interview or code test.
+type InterviewQuestionResponse struct {
+	ID           uuid.UUID                      `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=0"`
+	EventID      uuid.UUID                      `json:"event_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=1"`
+	QuestionType requests.InterviewQuestionType `json:"question_type" example:"askedToUs" extensions:"x-order=2"`
+	Question     string                         `json:"question" example:"How would you design a rate limiter?" extensions:"x-order=3"`
+	Answer       *string                        `json:"answer,omitempty" example:"A token bucket per client" extensions:"x-order=4"`
+	Topic        *string                        `json:"topic,omitempty" example:"System design" extensions:"x-order=5"`
+	EventType    requests.EventType             `json:"event_type" example:"interviewCompleted" extensions:"x-order=6"`
+	EventDate    *time.Time                     `json:"event_date" example:"2025-12-31T23:59Z" extensions:"x-order=7"`
+	CreatedDate  *time.Time                     `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=8"`
+	UpdatedDate  *time.Time                     `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=9"`
+}
+
+// NewInterviewQuestionResponse can return InternalServiceError
+func NewInterviewQuestionResponse(question *models.InterviewQuestion) (*InterviewQuestionResponse, error) {
+	if question == nil {
+		slog.Error("responses.NewInterviewQuestionResponse: InterviewQuestion is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: InterviewQuestion is nil")
+	}
+
+	// can return InternalServiceError
+	questionType, err := requests.NewInterviewQuestionType(question.QuestionType)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return InternalServiceError
+	eventType, err := requests.NewEventType(&question.EventType)
+	if err != nil {
+		return nil, err
+	}
+
+	questionResponse := InterviewQuestionResponse{
+		ID:           question.ID,
+		EventID:      question.EventID,
+		QuestionType: questionType,
+		Question:     question.Question,
+		Answer:       question.Answer,
+		Topic:        question.Topic,
+		EventType:    eventType,
+		EventDate:    question.EventDate,
+		CreatedDate:  question.CreatedDate,
+		UpdatedDate:  question.UpdatedDate,
+	}
+
+	return &questionResponse, nil
+}
+
+// NewInterviewQuestionsResponse can return InternalServiceError
+func NewInterviewQuestionsResponse(questions []*models.InterviewQuestion) ([]*InterviewQuestionResponse, error) {
+	questionsResponse := make([]*InterviewQuestionResponse, len(questions))
+	for index, question := range questions {
+		// can return InternalServiceError
+		questionResponse, err := NewInterviewQuestionResponse(question)
+		if err != nil {
+			return nil, err
+		}
+		questionsResponse[index] = questionResponse
+	}
+
+	return questionsResponse, nil
+}
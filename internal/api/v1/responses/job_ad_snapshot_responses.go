@@ -0,0 +1,114 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/api/v1/requests"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobAdPostingResponse represents what could be read from a saved job ad. `application` can be used as a starting
+// point for a CreateApplicationRequest once a company or recruiter has been chosen.
+type JobAdPostingResponse struct {
+	Application requests.CreateApplicationRequest `json:"application" extensions:"x-order=0"`
+	CompanyName *string                           `json:"company_name,omitempty" example:"Company Name" extensions:"x-order=1"`
+	Salary      *string                           `json:"salary,omitempty" example:"45000-55000 SEK per month" extensions:"x-order=2"`
+}
+
+// NewJobAdPostingResponse can return InternalServiceError
+func NewJobAdPostingResponse(posting *models.JobAdPosting) (*JobAdPostingResponse, error) {
+	if posting == nil {
+		slog.Error("responses.NewJobAdPostingResponse: JobAdPosting is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: JobAdPosting is nil")
+	}
+
+	// can return InternalServiceError
+	remoteStatusType, err := requests.NewRemoteStatusType(&posting.RemoteStatusType)
+	if err != nil {
+		return nil, err
+	}
+
+	jobAdPostingResponse := JobAdPostingResponse{
+		Application: requests.CreateApplicationRequest{
+			JobTitle:         posting.JobTitle,
+			JobAdURL:         posting.JobAdURL,
+			Country:          posting.Country,
+			Area:             posting.Area,
+			RemoteStatusType: remoteStatusType,
+		},
+		CompanyName: posting.CompanyName,
+		Salary:      posting.Salary,
+	}
+
+	return &jobAdPostingResponse, nil
+}
+
+// JobAdSnapshotResponse represents a saved job ad and what was read from it. `content` is only included when a single
+// snapshot is retrieved.
+type JobAdSnapshotResponse struct {
+	ID               uuid.UUID                 `json:"id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=00"`
+	ApplicationID    uuid.UUID                 `json:"application_id" swaggertype:"string" format:"uuid" example:"123e4567-e89b-12d3-a456-426614174000" extensions:"x-order=01"`
+	SourceType       string                    `json:"source_type" enums:"html,jsonLd" example:"html" extensions:"x-order=02"`
+	JobTitle         *string                   `json:"job_title,omitempty" example:"Job Title" extensions:"x-order=03"`
+	CompanyName      *string                   `json:"company_name,omitempty" example:"Company Name" extensions:"x-order=04"`
+	JobAdURL         *string                   `json:"job_ad_url,omitempty" example:"https://job.ad.url" extensions:"x-order=05"`
+	Country          *string                   `json:"country,omitempty" example:"Sweden" extensions:"x-order=06"`
+	Area             *string                   `json:"area,omitempty" example:"Stockholm" extensions:"x-order=07"`
+	RemoteStatusType requests.RemoteStatusType `json:"remote_status_type" example:"hybrid" extensions:"x-order=08"`
+	Salary           *string                   `json:"salary,omitempty" example:"45000-55000 SEK per month" extensions:"x-order=09"`
+	Content          *string                   `json:"content,omitempty" example:"<html>...</html>" extensions:"x-order=10"`
+	CreatedDate      *time.Time                `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=11"`
+}
+
+// NewJobAdSnapshotResponse can return InternalServiceError
+func NewJobAdSnapshotResponse(snapshot *models.JobAdSnapshot) (*JobAdSnapshotResponse, error) {
+	if snapshot == nil {
+		slog.Error("responses.NewJobAdSnapshotResponse: JobAdSnapshot is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: JobAdSnapshot is nil")
+	}
+
+	// can return InternalServiceError
+	remoteStatusType, err := requests.NewRemoteStatusType(&snapshot.RemoteStatusType)
+	if err != nil {
+		return nil, err
+	}
+
+	jobAdSnapshotResponse := JobAdSnapshotResponse{
+		ID:               snapshot.ID,
+		ApplicationID:    snapshot.ApplicationID,
+		SourceType:       snapshot.SourceType.String(),
+		JobTitle:         snapshot.JobTitle,
+		CompanyName:      snapshot.CompanyName,
+		JobAdURL:         snapshot.JobAdURL,
+		Country:          snapshot.Country,
+		Area:             snapshot.Area,
+		RemoteStatusType: remoteStatusType,
+		Salary:           snapshot.Salary,
+		Content:          snapshot.Content,
+		CreatedDate:      snapshot.CreatedDate,
+	}
+
+	return &jobAdSnapshotResponse, nil
+}
+
+// NewJobAdSnapshotsResponse can return InternalServiceError
+func NewJobAdSnapshotsResponse(snapshots []*models.JobAdSnapshot) ([]*JobAdSnapshotResponse, error) {
+	if len(snapshots) == 0 {
+		return []*JobAdSnapshotResponse{}, nil
+	}
+
+	snapshotsResponse := make([]*JobAdSnapshotResponse, len(snapshots))
+	for index, snapshot := range snapshots {
+		// can return InternalServiceError
+		snapshotResponse, err := NewJobAdSnapshotResponse(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		snapshotsResponse[index] = snapshotResponse
+	}
+
+	return snapshotsResponse, nil
+}
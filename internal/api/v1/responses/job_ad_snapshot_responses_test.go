@@ -0,0 +1,89 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/api/v1/requests"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- NewJobAdPostingResponse tests: --------
+
+func TestNewJobAdPostingResponse_ShouldPrefillApplication(t *testing.T) {
+	model := models.JobAdPosting{
+		JobTitle:         testutil.ToPtr("Job Title"),
+		CompanyName:      testutil.ToPtr("Company Name"),
+		JobAdURL:         testutil.ToPtr("https://job.ad.url"),
+		Country:          testutil.ToPtr("Sweden"),
+		Area:             testutil.ToPtr("Stockholm"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+		Salary:           testutil.ToPtr("45000 SEK per month"),
+	}
+
+	response, err := NewJobAdPostingResponse(&model)
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+
+	assert.Nil(t, response.Application.ID)
+	assert.Nil(t, response.Application.CompanyID)
+	assert.Equal(t, model.JobTitle, response.Application.JobTitle)
+	assert.Equal(t, model.JobAdURL, response.Application.JobAdURL)
+	assert.Equal(t, model.Country, response.Application.Country)
+	assert.Equal(t, model.Area, response.Application.Area)
+	assert.Equal(t, requests.RemoteStatusType(requests.RemoteStatusTypeRemote), response.Application.RemoteStatusType)
+	assert.Equal(t, model.CompanyName, response.CompanyName)
+	assert.Equal(t, model.Salary, response.Salary)
+}
+
+func TestNewJobAdPostingResponse_ShouldReturnErrorIfModelIsNil(t *testing.T) {
+	response, err := NewJobAdPostingResponse(nil)
+	assert.Nil(t, response)
+	assert.Error(t, err)
+}
+
+// -------- NewJobAdSnapshotsResponse tests: --------
+
+func TestNewJobAdSnapshotsResponse_ShouldWork(t *testing.T) {
+	snapshotModels := []*models.JobAdSnapshot{
+		{
+			ID:            uuid.New(),
+			ApplicationID: uuid.New(),
+			SourceType:    models.JobAdSourceTypeHTML,
+			Content:       testutil.ToPtr("<html></html>"),
+			JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeUnknown},
+			CreatedDate:   testutil.ToPtr(time.Now()),
+		},
+		{
+			ID:            uuid.New(),
+			ApplicationID: uuid.New(),
+			SourceType:    models.JobAdSourceTypeJSONLD,
+			JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeHybrid},
+			CreatedDate:   testutil.ToPtr(time.Now()),
+		},
+	}
+
+	response, err := NewJobAdSnapshotsResponse(snapshotModels)
+	assert.NoError(t, err)
+	assert.Len(t, response, 2)
+
+	assert.Equal(t, snapshotModels[0].ID, response[0].ID)
+	assert.Equal(t, "html", response[0].SourceType)
+	assert.Equal(t, "<html></html>", *response[0].Content)
+	assert.Equal(t, requests.RemoteStatusType(requests.RemoteStatusTypeUnknown), response[0].RemoteStatusType)
+
+	assert.Equal(t, snapshotModels[1].ApplicationID, response[1].ApplicationID)
+	assert.Equal(t, "jsonLd", response[1].SourceType)
+	assert.Nil(t, response[1].Content)
+	assert.Equal(t, requests.RemoteStatusType(requests.RemoteStatusTypeHybrid), response[1].RemoteStatusType)
+}
+
+func TestNewJobAdSnapshotsResponse_ShouldReturnEmptySliceForNoSnapshots(t *testing.T) {
+	response, err := NewJobAdSnapshotsResponse(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, response)
+	assert.NotNil(t, response)
+}
@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/models"
+	"time"
+)
+
+// LinkDetailsDTO holds the optional details of a link between two entities, such as the role a person has at a
+// company and the period in which they had it.
+type LinkDetailsDTO struct {
+	Role      *string    `json:"role,omitempty" example:"Hiring manager" extensions:"x-order=2"`
+	Notes     *string    `json:"notes,omitempty" example:"Introduced by a former colleague" extensions:"x-order=3"`
+	StartDate *time.Time `json:"start_date,omitempty" example:"2025-01-01T00:00:00Z" extensions:"x-order=4"`
+	EndDate   *time.Time `json:"end_date,omitempty" example:"2025-12-31T00:00:00Z" extensions:"x-order=5"`
+}
+
+func NewLinkDetailsDTO(linkDetails models.LinkDetails) LinkDetailsDTO {
+	return LinkDetailsDTO{
+		Role:      linkDetails.Role,
+		Notes:     linkDetails.Notes,
+		StartDate: linkDetails.StartDate,
+		EndDate:   linkDetails.EndDate,
+	}
+}
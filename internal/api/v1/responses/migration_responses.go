@@ -0,0 +1,52 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/models"
+)
+
+type MigrationStatusResponse struct {
+	Version         uint   `json:"version" example:"9" extensions:"x-order=0"`
+	Dirty           bool   `json:"dirty" example:"false" extensions:"x-order=1"`
+	LatestVersion   uint   `json:"latest_version" example:"9" extensions:"x-order=2"`
+	AppliedVersions []uint `json:"applied_versions" extensions:"x-order=3"`
+	PendingVersions []uint `json:"pending_versions" extensions:"x-order=4"`
+}
+
+func NewMigrationStatusResponse(model *models.MigrationStatus) *MigrationStatusResponse {
+	if model == nil {
+		return nil
+	}
+
+	response := &MigrationStatusResponse{
+		Version:         model.Version,
+		Dirty:           model.Dirty,
+		LatestVersion:   model.LatestVersion,
+		AppliedVersions: model.AppliedVersions,
+		PendingVersions: model.PendingVersions,
+	}
+
+	return response
+}
+
+type MigrationResultResponse struct {
+	MigrationStatusResponse
+	BackupFile *string `json:"backup_file,omitempty" example:"resources/database/backups/20251231T235900.000Z_jobSearchDatabase.sqlite" extensions:"x-order=5"`
+}
+
+func NewMigrationResultResponse(model *models.MigrationResult) *MigrationResultResponse {
+	if model == nil {
+		return nil
+	}
+
+	var statusResponse MigrationStatusResponse
+	if model.Status != nil {
+		statusResponse = *NewMigrationStatusResponse(model.Status)
+	}
+
+	response := &MigrationResultResponse{
+		MigrationStatusResponse: statusResponse,
+		BackupFile:              model.BackupFile,
+	}
+
+	return response
+}
@@ -0,0 +1,208 @@
+package responses
+
+import (
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// markdownDateLayout is used for the dates of a timeline rendered as Markdown. Dates are shown in UTC.
+const markdownDateLayout = "2006-01-02 15:04"
+
+// TimelineResponse is the history of an application, company or person, oldest first.
+type TimelineResponse struct {
+	Title   string                   `json:"title" example:"Backend developer" extensions:"x-order=0"`
+	Entries []*TimelineEntryResponse `json:"entries" extensions:"x-order=1"`
+}
+
+// TimelineEntryResponse is one thing that happened at `date`. `entry_type` is `created`, `updated`, `event`, `linked`
+// or `linkUpdated`. One of `application`, `company`, `event` and `person` is set: the record that was created or
+// updated, the event, or the record that was linked. `link_details` is set for `linked` and `linkUpdated`.
+type TimelineEntryResponse struct {
+	Date        time.Time       `json:"date" example:"2025-12-31T23:59Z" extensions:"x-order=0"`
+	EntryType   string          `json:"entry_type" example:"event" extensions:"x-order=1"`
+	Summary     string          `json:"summary" example:"interviewBooked: Technical interview, with Jane Doe" extensions:"x-order=2"`
+	Application *ApplicationDTO `json:"application,omitempty" extensions:"x-order=3"`
+	Company     *CompanyDTO     `json:"company,omitempty" extensions:"x-order=4"`
+	Event       *EventResponse  `json:"event,omitempty" extensions:"x-order=5"`
+	Person      *PersonDTO      `json:"person,omitempty" extensions:"x-order=6"`
+	LinkDetails *LinkDetailsDTO `json:"link_details,omitempty" extensions:"x-order=7"`
+}
+
+// NewTimelineResponse can return InternalServiceError
+func NewTimelineResponse(timeline *models.Timeline) (*TimelineResponse, error) {
+	if timeline == nil {
+		slog.Error("responses.NewTimelineResponse: Timeline is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: Timeline is nil")
+	}
+
+	response := TimelineResponse{
+		Title:   timeline.Title,
+		Entries: make([]*TimelineEntryResponse, len(timeline.Entries)),
+	}
+
+	for index, entry := range timeline.Entries {
+		// can return InternalServiceError
+		entryResponse, err := newTimelineEntryResponse(entry)
+		if err != nil {
+			return nil, err
+		}
+		response.Entries[index] = entryResponse
+	}
+
+	return &response, nil
+}
+
+// NewTimelineMarkdown renders timeline as a Markdown list, one line per entry, for pasting into notes.
+//
+// NewTimelineMarkdown can return InternalServiceError
+func NewTimelineMarkdown(timeline *models.Timeline) (string, error) {
+	if timeline == nil {
+		slog.Error("responses.NewTimelineMarkdown: Timeline is nil")
+		return "", internalErrors.NewInternalServiceError("Error building response: Timeline is nil")
+	}
+
+	var markdown strings.Builder
+	markdown.WriteString("# Timeline: " + timeline.Title + "\n\n")
+
+	for _, entry := range timeline.Entries {
+		if entry == nil {
+			slog.Error("responses.NewTimelineMarkdown: TimelineEntry is nil")
+			return "", internalErrors.NewInternalServiceError("Error building response: TimelineEntry is nil")
+		}
+
+		date := entry.Date.UTC().Format(markdownDateLayout)
+		markdown.WriteString("- **" + date + "** " + timelineSummary(entry) + "\n")
+
+		// notes are indented, so that they belong to the list item
+		if entry.LinkDetails != nil && entry.LinkDetails.Notes != nil {
+			markdown.WriteString("  " + *entry.LinkDetails.Notes + "\n")
+		} else if entry.Event != nil && entry.Event.Notes != nil {
+			markdown.WriteString("  " + *entry.Event.Notes + "\n")
+		}
+	}
+
+	return markdown.String(), nil
+}
+
+// internal functions
+
+// newTimelineEntryResponse can return InternalServiceError
+func newTimelineEntryResponse(entry *models.TimelineEntry) (*TimelineEntryResponse, error) {
+	if entry == nil {
+		slog.Error("responses.newTimelineEntryResponse: TimelineEntry is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: TimelineEntry is nil")
+	}
+
+	response := TimelineEntryResponse{
+		Date:      entry.Date,
+		EntryType: entry.EntryType.String(),
+		Summary:   timelineSummary(entry),
+	}
+
+	var err error
+	if entry.Application != nil {
+		// can return InternalServiceError
+		if response.Application, err = NewApplicationDTO(entry.Application); err != nil {
+			return nil, err
+		}
+	}
+
+	if entry.Company != nil {
+		// can return InternalServiceError
+		if response.Company, err = NewCompanyDTO(entry.Company); err != nil {
+			return nil, err
+		}
+	}
+
+	if entry.Event != nil {
+		// can return InternalServiceError
+		if response.Event, err = NewEventResponse(entry.Event); err != nil {
+			return nil, err
+		}
+	}
+
+	if entry.Person != nil {
+		// can return InternalServiceError
+		if response.Person, err = NewPersonDTO(entry.Person); err != nil {
+			return nil, err
+		}
+	}
+
+	if entry.LinkDetails != nil {
+		linkDetails := NewLinkDetailsDTO(*entry.LinkDetails)
+		response.LinkDetails = &linkDetails
+	}
+
+	return &response, nil
+}
+
+// timelineSummary describes entry in one line, such as "Linked person 'Jane Doe' as Hiring manager".
+func timelineSummary(entry *models.TimelineEntry) string {
+	switch entry.EntryType {
+	case models.TimelineEntryTypeCreated:
+		return "Created " + timelineRecordName(entry)
+	case models.TimelineEntryTypeUpdated:
+		return "Updated " + timelineRecordName(entry)
+	case models.TimelineEntryTypeLinked:
+		summary := "Linked " + timelineRecordName(entry)
+		if entry.LinkDetails != nil && entry.LinkDetails.Role != nil {
+			summary += " as " + *entry.LinkDetails.Role
+		}
+		return summary
+	case models.TimelineEntryTypeLinkUpdated:
+		return "Updated link to " + timelineRecordName(entry)
+	case models.TimelineEntryTypeEvent:
+		return timelineEventSummary(entry.Event)
+	}
+	return entry.EntryType.String()
+}
+
+// timelineRecordName returns the kind and name of the record of entry, such as "company 'Acme'".
+func timelineRecordName(entry *models.TimelineEntry) string {
+	switch {
+	case entry.Application != nil:
+		return "application '" + timelineName(entry.Application.JobTitle, entry.Application.ID.String()) + "'"
+	case entry.Company != nil:
+		return "company '" + timelineName(entry.Company.Name, entry.Company.ID.String()) + "'"
+	case entry.Person != nil:
+		return "person '" + timelineName(entry.Person.Name, entry.Person.ID.String()) + "'"
+	case entry.Event != nil:
+		return "event '" + entry.Event.ID.String() + "'"
+	}
+	return "record"
+}
+
+func timelineEventSummary(event *models.Event) string {
+	if event == nil {
+		return "Event"
+	}
+
+	summary := "Event"
+	if event.EventType != nil {
+		summary = event.EventType.String()
+	}
+
+	if event.Description != nil && *event.Description != "" {
+		summary += ": " + *event.Description
+	}
+
+	if event.Persons != nil && len(*event.Persons) > 0 {
+		names := make([]string, 0, len(*event.Persons))
+		for _, person := range *event.Persons {
+			names = append(names, timelineName(person.Name, person.ID.String()))
+		}
+		summary += ", with " + strings.Join(names, ", ")
+	}
+
+	return summary
+}
+
+func timelineName(name *string, id string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return id
+}
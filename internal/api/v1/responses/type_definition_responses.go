@@ -0,0 +1,67 @@
+package responses
+
+import (
+	"jobsearchtracker/internal/api/v1/requests"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"time"
+)
+
+// TypeDefinitionResponse represents an event, person or company type. Built-in types cannot be deleted.
+type TypeDefinitionResponse struct {
+	Kind          string                  `json:"kind" example:"event" extensions:"x-order=0"`
+	Name          string                  `json:"name" example:"technicalScreen" extensions:"x-order=1"`
+	DisplayName   string                  `json:"display_name" example:"Technical screen" extensions:"x-order=2"`
+	SortOrder     int                     `json:"sort_order" example:"65" extensions:"x-order=3"`
+	PipelineStage *requests.PipelineStage `json:"pipeline_stage,omitempty" example:"interviewing" extensions:"x-order=4"`
+	IsBuiltIn     bool                    `json:"is_built_in" example:"false" extensions:"x-order=5"`
+	CreatedDate   *time.Time              `json:"created_date" example:"2025-12-31T23:59Z" extensions:"x-order=6"`
+	UpdatedDate   *time.Time              `json:"updated_date,omitempty" example:"2025-12-31T23:59Z" extensions:"x-order=7"`
+}
+
+// NewTypeDefinitionResponse can return InternalServiceError
+func NewTypeDefinitionResponse(typeDefinition *models.TypeDefinition) (*TypeDefinitionResponse, error) {
+	if typeDefinition == nil {
+		slog.Error("responses.NewTypeDefinitionResponse: TypeDefinition is nil")
+		return nil, internalErrors.NewInternalServiceError("Error building response: TypeDefinition is nil")
+	}
+
+	// can return InternalServiceError
+	pipelineStage, err := requests.NewPipelineStage(typeDefinition.PipelineStage)
+	if err != nil {
+		return nil, err
+	}
+
+	typeDefinitionResponse := TypeDefinitionResponse{
+		Kind:          typeDefinition.Kind.String(),
+		Name:          typeDefinition.Name,
+		DisplayName:   typeDefinition.DisplayName,
+		SortOrder:     typeDefinition.SortOrder,
+		PipelineStage: pipelineStage,
+		IsBuiltIn:     typeDefinition.IsBuiltIn,
+		CreatedDate:   typeDefinition.CreatedDate,
+		UpdatedDate:   typeDefinition.UpdatedDate,
+	}
+
+	return &typeDefinitionResponse, nil
+}
+
+// NewTypeDefinitionsResponse can return InternalServiceError
+func NewTypeDefinitionsResponse(typeDefinitions []*models.TypeDefinition) ([]*TypeDefinitionResponse, error) {
+	if typeDefinitions == nil {
+		return []*TypeDefinitionResponse{}, nil
+	}
+
+	typeDefinitionsResponse := make([]*TypeDefinitionResponse, len(typeDefinitions))
+	for index, typeDefinition := range typeDefinitions {
+		// can return InternalServiceError
+		typeDefinitionResponse, err := NewTypeDefinitionResponse(typeDefinition)
+		if err != nil {
+			return nil, err
+		}
+		typeDefinitionsResponse[index] = typeDefinitionResponse
+	}
+
+	return typeDefinitionsResponse, nil
+}
@@ -3,39 +3,166 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultConfigFilePath     = "configs/config.json"
+	EnvironmentVariablePrefix = "JOBSEARCHTRACKER_"
 )
 
 type Config struct {
-	DatabaseFilePath                     string `json:"database_file_path"`
-	DatabaseFileName                     string `json:"database_file_name"`
-	IsDatabaseFileLocationAbsolutePath   bool   `json:"is_database_file_location_absolute_path"`
-	DatabaseMigrationsPath               string `json:"database_migrations_path"`
-	IsDatabaseMigrationsPathAbsolutePath bool   `json:"is_database_migrations_path_absolute_path"`
-	ServerPort                           int    `json:"server_port"`
+	DatabaseFilePath                     string   `json:"database_file_path" usage:"directory containing the database file"`
+	DatabaseFileName                     string   `json:"database_file_name" usage:"name of the database file"`
+	IsDatabaseFileLocationAbsolutePath   bool     `json:"is_database_file_location_absolute_path" usage:"treat database_file_path as an absolute path"`
+	DatabaseMigrationsPath               string   `json:"database_migrations_path" usage:"read migrations from this directory instead of the embedded migrations"`
+	IsDatabaseMigrationsPathAbsolutePath bool     `json:"is_database_migrations_path_absolute_path" usage:"treat database_migrations_path as an absolute path"`
+	DatabaseBackupPath                   string   `json:"database_backup_path" usage:"directory for database backups taken before down migrations"`
+	EnableDestructiveMigrationEndpoints  bool     `json:"enable_destructive_migration_endpoints" usage:"serve the down, goto and force endpoints of /api/v1/admin/migrations, which can drop data. The migrate command is always available"`
+	DatabaseQueryTimeoutSeconds          int      `json:"database_query_timeout_seconds" usage:"maximum duration in seconds of a database query. 0 disables it"`
+	ServerPort                           int      `json:"server_port" usage:"port to listen on"`
+	BindAddress                          string   `json:"bind_address" usage:"address to listen on. Empty listens on all interfaces"`
+	ReadTimeoutSeconds                   int      `json:"read_timeout_seconds" usage:"maximum duration in seconds for reading a request"`
+	WriteTimeoutSeconds                  int      `json:"write_timeout_seconds" usage:"maximum duration in seconds for writing a response"`
+	IdleTimeoutSeconds                   int      `json:"idle_timeout_seconds" usage:"maximum duration in seconds to keep an idle keep-alive connection open"`
+	ShutdownTimeoutSeconds               int      `json:"shutdown_timeout_seconds" usage:"maximum duration in seconds to wait for in-flight requests on shutdown"`
+	LogLevel                             string   `json:"log_level" usage:"one of debug, info, warn, error"`
+	LogFormat                            string   `json:"log_format" usage:"one of json, text"`
+	CORSOrigins                          []string `json:"cors_origins" usage:"comma-separated origins allowed to make cross-origin requests. '*' allows any origin"`
+	EventTransitionMode                  string   `json:"event_transition_mode" usage:"one of strict, warn. strict rejects events that break the event type transition rules of an application, warn only logs them"`
+	ChangeStreamPollIntervalMilliseconds int      `json:"change_stream_poll_interval_milliseconds" usage:"how often in milliseconds the change stream checks for new changes"`
+	ChangeLogRetentionDays               int      `json:"change_log_retention_days" usage:"how long in days changes are kept in the change log for the change stream"`
+	IdempotencyKeyTTLHours               int      `json:"idempotency_key_ttl_hours" usage:"how long in hours the response to a request with an Idempotency-Key is replayed to retries"`
+	IdempotencyKeyLeaseSeconds           int      `json:"idempotency_key_lease_seconds" usage:"how long in seconds an Idempotency-Key without a response is held for its request, before a retry can take it over"`
 }
 
-func NewConfig() (*Config, error) {
-	return loadConfigFromFile("configs/config.json")
+// NewConfig builds the configuration from, in increasing order of precedence: defaults, the config file,
+// JOBSEARCHTRACKER_* environment variables, and command-line flags. The config file is read from --config,
+// JOBSEARCHTRACKER_CONFIG, or configs/config.json. The arguments remaining after the flags are returned.
+func NewConfig(arguments []string) (*Config, []string, error) {
+	return loadConfig(arguments, os.LookupEnv, os.Stderr)
 }
 
-func loadConfigFromFile(filePathAndName string) (*Config, error) {
-	data, err := os.ReadFile(filePathAndName)
+func NewDefaultConfig() *Config {
+	return &Config{
+		DatabaseFilePath:            "resources/database/",
+		DatabaseFileName:            "jobSearchDatabase.sqlite",
+		DatabaseQueryTimeoutSeconds: 10,
+		ServerPort:                  8080,
+		ReadTimeoutSeconds:          15,
+		WriteTimeoutSeconds:         15,
+		IdleTimeoutSeconds:          60,
+		ShutdownTimeoutSeconds:      15,
+		LogLevel:                    "info",
+		LogFormat:                   "json",
+		EventTransitionMode:         "warn",
+
+		ChangeStreamPollIntervalMilliseconds: 1000,
+		ChangeLogRetentionDays:               30,
+		IdempotencyKeyTTLHours:               24,
+		IdempotencyKeyLeaseSeconds:           60,
+	}
+}
+
+func loadConfig(
+	arguments []string,
+	lookupEnvironmentVariable func(string) (string, bool),
+	output io.Writer) (*Config, []string, error) {
+
+	config := NewDefaultConfig()
+
+	flagValues, remainingArguments, err := parseFlags(arguments, output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configFilePath, isConfigFilePathSet := flagValues["config"]
+	if !isConfigFilePathSet {
+		configFilePath, isConfigFilePathSet = lookupEnvironmentVariable(EnvironmentVariablePrefix + "CONFIG")
+	}
+	if !isConfigFilePathSet {
+		configFilePath = DefaultConfigFilePath
+	}
+
+	err = config.loadFromFile(configFilePath)
 	if err != nil {
-		return nil, err
+		// The default config file is optional. A config file that has been asked for is not.
+		if isConfigFilePathSet || !errors.Is(err, os.ErrNotExist) {
+			return nil, nil, err
+		}
+	}
+
+	for _, field := range configFields() {
+		value, isSet := lookupEnvironmentVariable(field.environmentVariable)
+		if !isSet {
+			continue
+		}
+		if err = config.setField(field, value); err != nil {
+			return nil, nil, fmt.Errorf("environment variable %s is invalid: %w", field.environmentVariable, err)
+		}
 	}
 
-	var config Config
-	if err := json.Unmarshal(data, &config); err != nil {
-		return nil, err
+	for _, field := range configFields() {
+		value, isSet := flagValues[field.flagName]
+		if !isSet {
+			continue
+		}
+		if err = config.setField(field, value); err != nil {
+			return nil, nil, fmt.Errorf("flag --%s is invalid: %w", field.flagName, err)
+		}
 	}
 
 	err = config.validate()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &config, nil
+	return config, remainingArguments, nil
+}
+
+// DatabaseQueryTimeout returns the maximum duration of a single database query. 0 means no timeout.
+func (config *Config) DatabaseQueryTimeout() time.Duration {
+	return time.Duration(config.DatabaseQueryTimeoutSeconds) * time.Second
+}
+
+// ChangeStreamPollInterval returns how often the change stream checks the change log for new changes.
+func (config *Config) ChangeStreamPollInterval() time.Duration {
+	return time.Duration(config.ChangeStreamPollIntervalMilliseconds) * time.Millisecond
+}
+
+// ChangeLogRetention returns how long changes are kept in the change log.
+func (config *Config) ChangeLogRetention() time.Duration {
+	return time.Duration(config.ChangeLogRetentionDays) * 24 * time.Hour
+}
+
+// IdempotencyKeyTTL returns how long an idempotency key and its response are kept.
+func (config *Config) IdempotencyKeyTTL() time.Duration {
+	return time.Duration(config.IdempotencyKeyTTLHours) * time.Hour
+}
+
+// IdempotencyKeyLease returns how long an idempotency key is held for a request that has not got a response yet.
+func (config *Config) IdempotencyKeyLease() time.Duration {
+	return time.Duration(config.IdempotencyKeyLeaseSeconds) * time.Second
+}
+
+func (config *Config) loadFromFile(filePathAndName string) error {
+	data, err := os.ReadFile(filePathAndName)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return fmt.Errorf("unable to parse config file %s: %w", filePathAndName, err)
+	}
+
+	return nil
 }
 
 func (config *Config) validate() error {
@@ -46,13 +173,171 @@ func (config *Config) validate() error {
 	if config.DatabaseFileName == "" {
 		return errors.New("config.DatabaseFileName is empty")
 	}
-	if config.DatabaseMigrationsPath == "" {
-		return errors.New("config.DatabaseMigrationsPath is empty")
+
+	if config.DatabaseQueryTimeoutSeconds < 0 {
+		return errors.New("config.DatabaseQueryTimeoutSeconds is invalid")
 	}
 
 	if config.ServerPort <= 0 || config.ServerPort > 65535 {
 		return errors.New("config.ServerPort is invalid")
 	}
 
+	if config.ReadTimeoutSeconds < 0 {
+		return errors.New("config.ReadTimeoutSeconds is invalid")
+	}
+
+	if config.WriteTimeoutSeconds < 0 {
+		return errors.New("config.WriteTimeoutSeconds is invalid")
+	}
+
+	if config.IdleTimeoutSeconds < 0 {
+		return errors.New("config.IdleTimeoutSeconds is invalid")
+	}
+
+	if config.ShutdownTimeoutSeconds <= 0 {
+		return errors.New("config.ShutdownTimeoutSeconds is invalid")
+	}
+
+	switch config.LogLevel {
+	case "debug", "info", "warn", "error":
+	default:
+		return errors.New("config.LogLevel is invalid. Accepted values are 'debug', 'info', 'warn', and 'error'")
+	}
+
+	switch config.LogFormat {
+	case "json", "text":
+	default:
+		return errors.New("config.LogFormat is invalid. Accepted values are 'json' and 'text'")
+	}
+
+	switch config.EventTransitionMode {
+	case "strict", "warn":
+	default:
+		return errors.New("config.EventTransitionMode is invalid. Accepted values are 'strict' and 'warn'")
+	}
+
+	if config.ChangeStreamPollIntervalMilliseconds <= 0 {
+		return errors.New("config.ChangeStreamPollIntervalMilliseconds is invalid")
+	}
+
+	if config.ChangeLogRetentionDays <= 0 {
+		return errors.New("config.ChangeLogRetentionDays is invalid")
+	}
+
+	if config.IdempotencyKeyTTLHours <= 0 {
+		return errors.New("config.IdempotencyKeyTTLHours is invalid")
+	}
+
+	if config.IdempotencyKeyLeaseSeconds <= 0 {
+		return errors.New("config.IdempotencyKeyLeaseSeconds is invalid")
+	}
+
+	for _, origin := range config.CORSOrigins {
+		if origin == "" {
+			return errors.New("config.CORSOrigins contains an empty origin")
+		}
+	}
+
 	return nil
 }
+
+type configField struct {
+	index               int
+	jsonName            string
+	flagName            string
+	environmentVariable string
+	usage               string
+}
+
+// configFields derives the flag and environment variable names of every Config field from its json tag.
+// `database_file_name` is set by --database-file-name and JOBSEARCHTRACKER_DATABASE_FILE_NAME.
+func configFields() []configField {
+	configType := reflect.TypeOf(Config{})
+
+	fields := make([]configField, configType.NumField())
+	for index := range configType.NumField() {
+		structField := configType.Field(index)
+		jsonName := strings.Split(structField.Tag.Get("json"), ",")[0]
+
+		fields[index] = configField{
+			index:               index,
+			jsonName:            jsonName,
+			flagName:            strings.ReplaceAll(jsonName, "_", "-"),
+			environmentVariable: EnvironmentVariablePrefix + strings.ToUpper(jsonName),
+			usage:               structField.Tag.Get("usage"),
+		}
+	}
+
+	return fields
+}
+
+func (config *Config) setField(field configField, value string) error {
+	fieldValue := reflect.ValueOf(config).Elem().Field(field.index)
+
+	switch fieldValue.Kind() {
+	case reflect.String:
+		fieldValue.SetString(value)
+	case reflect.Bool:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a boolean", value)
+		}
+		fieldValue.SetBool(boolValue)
+	case reflect.Int:
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("'%s' is not an integer", value)
+		}
+		fieldValue.SetInt(int64(intValue))
+	case reflect.Slice:
+		var values []string
+		for _, part := range strings.Split(value, ",") {
+			if trimmedPart := strings.TrimSpace(part); trimmedPart != "" {
+				values = append(values, trimmedPart)
+			}
+		}
+		fieldValue.Set(reflect.ValueOf(values))
+	default:
+		return fmt.Errorf("unsupported config field type %s", fieldValue.Kind())
+	}
+
+	return nil
+}
+
+// parseFlags returns the value of every flag that was set, keyed on the flag name, and the remaining arguments.
+func parseFlags(arguments []string, output io.Writer) (map[string]string, []string, error) {
+	flagValues := make(map[string]string)
+
+	flagSet := flag.NewFlagSet("jobsearchtracker", flag.ContinueOnError)
+	flagSet.SetOutput(output)
+
+	flagSet.Func("config", "path to the config file (default \""+DefaultConfigFilePath+"\")", func(value string) error {
+		flagValues["config"] = value
+		return nil
+	})
+
+	defaultConfigValue := reflect.ValueOf(NewDefaultConfig()).Elem()
+	for _, field := range configFields() {
+		usage := field.usage
+		if defaultValue := defaultConfigValue.Field(field.index); !defaultValue.IsZero() {
+			usage += fmt.Sprintf(" (default %v)", defaultValue.Interface())
+		}
+
+		setFlagValue := func(value string) error {
+			flagValues[field.flagName] = value
+			return nil
+		}
+
+		if defaultConfigValue.Field(field.index).Kind() == reflect.Bool {
+			flagSet.BoolFunc(field.flagName, usage, setFlagValue)
+		} else {
+			flagSet.Func(field.flagName, usage, setFlagValue)
+		}
+	}
+
+	if err := flagSet.Parse(arguments); err != nil {
+		return nil, nil, err
+	}
+
+	return flagValues, flagSet.Args(), nil
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	filePath := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(filePath, []byte(content), 0600)
+	assert.NoError(t, err)
+	return filePath
+}
+
+func lookupFrom(environment map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, isSet := environment[key]
+		return value, isSet
+	}
+}
+
+// -------- loadConfig tests: --------
+
+func TestLoadConfig_ShouldUseDefaultsIfDefaultConfigFileDoesNotExist(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	config, remainingArguments, err := loadConfig(nil, lookupFrom(nil), io.Discard)
+	assert.NoError(t, err)
+	assert.Empty(t, remainingArguments)
+	assert.Equal(t, NewDefaultConfig(), config)
+}
+
+func TestLoadConfig_ShouldLayerFileEnvironmentAndFlags(t *testing.T) {
+	configFilePath := writeConfigFile(t, `{
+		"database_file_path": "file/path/",
+		"database_file_name": "file.sqlite",
+		"server_port": 9000,
+		"log_level": "warn",
+		"cors_origins": ["https://file.example"]
+	}`)
+
+	environment := map[string]string{
+		"JOBSEARCHTRACKER_CONFIG":             configFilePath,
+		"JOBSEARCHTRACKER_DATABASE_FILE_NAME": "environment.sqlite",
+		"JOBSEARCHTRACKER_SERVER_PORT":        "9100",
+		"JOBSEARCHTRACKER_LOG_FORMAT":         "text",
+	}
+
+	arguments := []string{
+		"--server-port=9200",
+		"--cors-origins", "https://one.example, https://two.example",
+		"--is-database-file-location-absolute-path",
+		"migrate", "status",
+	}
+
+	config, remainingArguments, err := loadConfig(arguments, lookupFrom(environment), io.Discard)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"migrate", "status"}, remainingArguments)
+
+	// from the file
+	assert.Equal(t, "file/path/", config.DatabaseFilePath)
+	assert.Equal(t, "warn", config.LogLevel)
+
+	// from the environment, overriding the file
+	assert.Equal(t, "environment.sqlite", config.DatabaseFileName)
+	assert.Equal(t, "text", config.LogFormat)
+
+	// from flags, overriding the environment and the file
+	assert.Equal(t, 9200, config.ServerPort)
+	assert.Equal(t, []string{"https://one.example", "https://two.example"}, config.CORSOrigins)
+	assert.True(t, config.IsDatabaseFileLocationAbsolutePath)
+
+	// defaults
+	assert.Equal(t, 15, config.ReadTimeoutSeconds)
+	assert.Equal(t, "", config.BindAddress)
+	assert.False(t, config.EnableDestructiveMigrationEndpoints)
+}
+
+func TestLoadConfig_ShouldPreferConfigFlagOverEnvironment(t *testing.T) {
+	flagConfigFilePath := writeConfigFile(t, `{"server_port": 7000}`)
+	environmentConfigFilePath := writeConfigFile(t, `{"server_port": 7100}`)
+
+	config, _, err := loadConfig(
+		[]string{"--config", flagConfigFilePath},
+		lookupFrom(map[string]string{"JOBSEARCHTRACKER_CONFIG": environmentConfigFilePath}),
+		io.Discard)
+	assert.NoError(t, err)
+	assert.Equal(t, 7000, config.ServerPort)
+}
+
+func TestLoadConfig_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		testName     string
+		fileContent  string
+		environment  map[string]string
+		arguments    []string
+		errorMessage string
+	}{
+		{
+			testName:     "invalid JSON",
+			fileContent:  `{"server_port": }`,
+			errorMessage: "unable to parse config file",
+		},
+		{
+			testName:     "invalid port in file",
+			fileContent:  `{"server_port": 70000}`,
+			errorMessage: "config.ServerPort is invalid",
+		},
+		{
+			testName:     "empty database file name in file",
+			fileContent:  `{"database_file_name": ""}`,
+			errorMessage: "config.DatabaseFileName is empty",
+		},
+		{
+			testName:     "non-integer port in environment",
+			fileContent:  `{}`,
+			environment:  map[string]string{"JOBSEARCHTRACKER_SERVER_PORT": "eighty"},
+			errorMessage: "environment variable JOBSEARCHTRACKER_SERVER_PORT is invalid: 'eighty' is not an integer",
+		},
+		{
+			testName:     "non-boolean in environment",
+			fileContent:  `{}`,
+			environment:  map[string]string{"JOBSEARCHTRACKER_IS_DATABASE_FILE_LOCATION_ABSOLUTE_PATH": "maybe"},
+			errorMessage: "'maybe' is not a boolean",
+		},
+		{
+			testName:     "invalid log level flag",
+			fileContent:  `{}`,
+			arguments:    []string{"--log-level", "loud"},
+			errorMessage: "config.LogLevel is invalid",
+		},
+		{
+			testName:     "invalid log format flag",
+			fileContent:  `{}`,
+			arguments:    []string{"--log-format", "xml"},
+			errorMessage: "config.LogFormat is invalid",
+		},
+		{
+			testName:     "invalid event transition mode in environment",
+			fileContent:  `{}`,
+			environment:  map[string]string{"JOBSEARCHTRACKER_EVENT_TRANSITION_MODE": "lenient"},
+			errorMessage: "config.EventTransitionMode is invalid",
+		},
+		{
+			testName:     "negative read timeout flag",
+			fileContent:  `{}`,
+			arguments:    []string{"--read-timeout-seconds", "-1"},
+			errorMessage: "config.ReadTimeoutSeconds is invalid",
+		},
+		{
+			testName:     "negative database query timeout in environment",
+			fileContent:  `{}`,
+			environment:  map[string]string{"JOBSEARCHTRACKER_DATABASE_QUERY_TIMEOUT_SECONDS": "-1"},
+			errorMessage: "config.DatabaseQueryTimeoutSeconds is invalid",
+		},
+		{
+			testName:     "zero change stream poll interval flag",
+			fileContent:  `{}`,
+			arguments:    []string{"--change-stream-poll-interval-milliseconds", "0"},
+			errorMessage: "config.ChangeStreamPollIntervalMilliseconds is invalid",
+		},
+		{
+			testName:     "zero change log retention in file",
+			fileContent:  `{"change_log_retention_days": 0}`,
+			errorMessage: "config.ChangeLogRetentionDays is invalid",
+		},
+		{
+			testName:     "zero idempotency key ttl in file",
+			fileContent:  `{"idempotency_key_ttl_hours": 0}`,
+			errorMessage: "config.IdempotencyKeyTTLHours is invalid",
+		},
+		{
+			testName:     "zero idempotency key lease in environment",
+			fileContent:  `{}`,
+			environment:  map[string]string{"JOBSEARCHTRACKER_IDEMPOTENCY_KEY_LEASE_SECONDS": "0"},
+			errorMessage: "config.IdempotencyKeyLeaseSeconds is invalid",
+		},
+		{
+			testName:     "unknown flag",
+			fileContent:  `{}`,
+			arguments:    []string{"--no-such-flag"},
+			errorMessage: "flag provided but not defined: -no-such-flag",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			configFilePath := writeConfigFile(t, test.fileContent)
+			arguments := append([]string{"--config", configFilePath}, test.arguments...)
+
+			config, _, err := loadConfig(arguments, lookupFrom(test.environment), io.Discard)
+			assert.Nil(t, config)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), test.errorMessage)
+		})
+	}
+}
+
+func TestLoadConfig_ShouldReturnErrorIfRequestedConfigFileDoesNotExist(t *testing.T) {
+	config, _, err := loadConfig(
+		[]string{"--config", filepath.Join(t.TempDir(), "missing.json")}, lookupFrom(nil), io.Discard)
+	assert.Nil(t, config)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -4,29 +4,42 @@ import (
 	"database/sql"
 	"fmt"
 	"jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/metrics"
 	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
 
-	_ "modernc.org/sqlite"
+	"modernc.org/sqlite"
 )
 
+// fileConnectionParameters turn on foreign keys for every connection. Write-ahead logging lets reads go on while
+// another connection writes, and the busy timeout makes a writer wait up to 5 seconds for the lock instead of failing
+// at once with SQLITE_BUSY. Transactions take the write lock when they begin, since a transaction that reads first
+// cannot wait for it when it later writes.
+const fileConnectionParameters = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)" +
+	"&_txlock=immediate"
+
+// inMemoryConnectionParameters are the fileConnectionParameters without write-ahead logging, which an in-memory
+// database does not have.
+const inMemoryConnectionParameters = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate"
+
 type Database interface {
 	Connect(config *config.Config) (*sql.DB, error)
 }
 
-type FileDatabase struct{}
+type FileDatabase struct {
+	metrics *metrics.Metrics
+}
 
-func NewFileDatabase() Database {
-	return &FileDatabase{}
+// NewFileDatabase returns a Database whose statements are timed and recorded in metrics.
+func NewFileDatabase(metrics *metrics.Metrics) Database {
+	return &FileDatabase{metrics: metrics}
 }
 
 func (database *FileDatabase) Connect(config *config.Config) (*sql.DB, error) {
-	db, err := sql.Open("sqlite", database.buildAndEnsureFilePath(config))
-	if err != nil {
-		return nil, err
-	}
+	connector := newInstrumentedConnector(database.buildAndEnsureFilePath(config), &sqlite.Driver{}, database.metrics)
+	db := sql.OpenDB(connector)
 
 	fmt.Println("Connected to SQLite file database.")
 	return db, nil
@@ -56,7 +69,7 @@ func (database *FileDatabase) buildAndEnsureFilePath(config *config.Config) stri
 		}
 	}
 
-	dbFilePath += config.DatabaseFileName + "?_pragma=foreign_keys(1)"
+	dbFilePath += config.DatabaseFileName + fileConnectionParameters
 	return dbFilePath
 }
 
@@ -67,7 +80,7 @@ func NewInMemoryDatabase() Database {
 }
 
 func (database *InMemoryDatabase) Connect(_ *config.Config) (*sql.DB, error) {
-	db, err := sql.Open("sqlite", ":memory:?_pragma=foreign_keys(1)")
+	db, err := sql.Open("sqlite", ":memory:"+inMemoryConnectionParameters)
 	if err != nil {
 		return nil, err
 	}
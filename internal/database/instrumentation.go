@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/metrics"
+	"strings"
+	"time"
+)
+
+// instrumentedConnector opens connections whose statements are timed and recorded in metrics. Queries are timed
+// until the first row is available, which for SQLite includes evaluating any aggregation.
+type instrumentedConnector struct {
+	dataSourceName string
+	driver         driver.Driver
+	metrics        *metrics.Metrics
+}
+
+func newInstrumentedConnector(
+	dataSourceName string, driver driver.Driver, metrics *metrics.Metrics) *instrumentedConnector {
+
+	return &instrumentedConnector{dataSourceName: dataSourceName, driver: driver, metrics: metrics}
+}
+
+func (connector *instrumentedConnector) Connect(_ context.Context) (driver.Conn, error) {
+	conn, err := connector.driver.Open(connector.dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	return &instrumentedConn{Conn: conn, metrics: connector.metrics}, nil
+}
+
+func (connector *instrumentedConnector) Driver() driver.Driver {
+	return connector.driver
+}
+
+// instrumentedConn forwards to the wrapped connection. The wrapped connection must implement the context-aware
+// driver interfaces, as the modernc.org/sqlite connection does.
+type instrumentedConn struct {
+	driver.Conn
+	metrics *metrics.Metrics
+}
+
+func (conn *instrumentedConn) QueryContext(
+	ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+
+	start := time.Now()
+	rows, err := conn.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+	conn.observe(ctx, query, time.Since(start), err)
+	return rows, err
+}
+
+func (conn *instrumentedConn) ExecContext(
+	ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+
+	start := time.Now()
+	result, err := conn.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+	conn.observe(ctx, query, time.Since(start), err)
+	return result, err
+}
+
+func (conn *instrumentedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return conn.Conn.(driver.ConnPrepareContext).PrepareContext(ctx, query)
+}
+
+func (conn *instrumentedConn) BeginTx(ctx context.Context, options driver.TxOptions) (driver.Tx, error) {
+	return conn.Conn.(driver.ConnBeginTx).BeginTx(ctx, options)
+}
+
+func (conn *instrumentedConn) Ping(ctx context.Context) error {
+	return conn.Conn.(driver.Pinger).Ping(ctx)
+}
+
+func (conn *instrumentedConn) ResetSession(ctx context.Context) error {
+	return conn.Conn.(driver.SessionResetter).ResetSession(ctx)
+}
+
+func (conn *instrumentedConn) IsValid() bool {
+	return conn.Conn.(driver.Validator).IsValid()
+}
+
+func (conn *instrumentedConn) observe(ctx context.Context, query string, duration time.Duration, err error) {
+	operation := queryOperation(query)
+	conn.metrics.ObserveDatabaseQuery(operation, duration, err)
+
+	logging.FromContext(ctx).Debug(
+		"Executed database statement",
+		"operation", operation,
+		"durationMs", float64(duration.Microseconds())/1000,
+		"error", err)
+}
+
+// queryOperation returns the lower-cased leading keyword of a statement, such as "select", or "other" for anything
+// uncommon, so that the metric labels stay bounded.
+func queryOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "other"
+	}
+
+	switch keyword := strings.ToLower(fields[0]); keyword {
+	case "select", "insert", "update", "delete", "with", "begin", "commit", "rollback", "pragma", "vacuum":
+		return keyword
+	default:
+		return "other"
+	}
+}
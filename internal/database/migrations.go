@@ -1,23 +1,237 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/migrations"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/sqlite"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
 func RunMigrations(database *sql.DB, config *config.Config) error {
-	driver, err := sqlite.WithInstance(database, &sqlite.Config{})
+	migrator, closeSource, err := newMigrate(database, config)
 	if err != nil {
 		return err
 	}
+	defer closeSource()
+
+	if err := migrator.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
+
+// GetMigrationStatus returns the current schema version, whether it is dirty, and which migrations have not been
+// applied yet.
+func GetMigrationStatus(database *sql.DB, config *config.Config) (*models.MigrationStatus, error) {
+	migrator, closeSource, err := newMigrate(database, config)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSource()
+
+	version, dirty, err := migrator.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, err
+	}
+
+	availableVersions, err := GetAvailableMigrationVersions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildMigrationStatus(version, dirty, availableVersions), nil
+}
+
+// ReadMigrationStatus reads the schema version straight from the schema_migrations table and compares it against
+// the given available versions. Unlike GetMigrationStatus it does not open the migration source, so it is cheap
+// enough to call on every readiness probe.
+func ReadMigrationStatus(
+	ctx context.Context, database *sql.DB, availableVersions []uint) (*models.MigrationStatus, error) {
+
+	var version int64
+	var dirty bool
+	err := database.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").
+		Scan(&version, &dirty)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// golang-migrate stores -1 for a dirty database without a version
+	if version < 0 {
+		version = 0
+	}
+
+	return buildMigrationStatus(uint(version), dirty, availableVersions), nil
+}
+
+func buildMigrationStatus(version uint, dirty bool, availableVersions []uint) *models.MigrationStatus {
+	status := models.MigrationStatus{Version: version, Dirty: dirty}
+	status.AppliedVersions = []uint{}
+	status.PendingVersions = []uint{}
+	for _, availableVersion := range availableVersions {
+		if availableVersion > version {
+			status.PendingVersions = append(status.PendingVersions, availableVersion)
+		} else {
+			status.AppliedVersions = append(status.AppliedVersions, availableVersion)
+		}
+		status.LatestVersion = availableVersion
+	}
+
+	return &status
+}
+
+// MigrateUp applies all pending up migrations. It returns migrate.ErrNoChange if there is nothing to apply.
+func MigrateUp(database *sql.DB, config *config.Config) error {
+	migrator, closeSource, err := newMigrate(database, config)
+	if err != nil {
+		return err
+	}
+	defer closeSource()
+
+	return migrator.Up()
+}
+
+// MigrateDown rolls back the given number of migrations. It returns migrate.ErrNoChange if there is nothing to roll
+// back.
+func MigrateDown(database *sql.DB, config *config.Config, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be greater than 0, was %d", steps)
+	}
+
+	migrator, closeSource, err := newMigrate(database, config)
+	if err != nil {
+		return err
+	}
+	defer closeSource()
+
+	return migrator.Steps(-steps)
+}
+
+// MigrateToVersion migrates up or down to the given version. Version 0 rolls back every migration.
+func MigrateToVersion(database *sql.DB, config *config.Config, version uint) error {
+	migrator, closeSource, err := newMigrate(database, config)
+	if err != nil {
+		return err
+	}
+	defer closeSource()
+
+	if version == 0 {
+		return migrator.Down()
+	}
+
+	return migrator.Migrate(version)
+}
+
+// ForceMigrationVersion sets the schema version without running any migrations and clears the dirty flag. Version -1
+// marks the database as having no migrations applied.
+func ForceMigrationVersion(database *sql.DB, config *config.Config, version int) error {
+	migrator, closeSource, err := newMigrate(database, config)
+	if err != nil {
+		return err
+	}
+	defer closeSource()
+
+	return migrator.Force(version)
+}
+
+// BackupDatabase writes a copy of the database to the backup directory and returns the path of the copy.
+func BackupDatabase(database *sql.DB, config *config.Config) (string, error) {
+	backupPath, err := buildBackupPath(config)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(backupPath, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	databaseName := config.DatabaseFileName
+	if databaseName == "" {
+		databaseName = "database.sqlite"
+	}
+
+	backupFile := filepath.Join(
+		backupPath,
+		time.Now().UTC().Format("20060102T150405.000Z")+"_"+databaseName)
+
+	if _, err = database.Exec("VACUUM INTO ?", backupFile); err != nil {
+		return "", err
+	}
+
+	slog.Info("database.BackupDatabase: Created database backup", "file", backupFile)
+	return backupFile, nil
+}
+
+func buildBackupPath(config *config.Config) (string, error) {
+	if config.DatabaseBackupPath != "" {
+		return filepath.Abs(config.DatabaseBackupPath)
+	}
+
+	if config.DatabaseFilePath == "" {
+		return "", errors.New("no backup path configured: both DatabaseBackupPath and DatabaseFilePath are empty")
+	}
+
+	if config.IsDatabaseFileLocationAbsolutePath {
+		return filepath.Join(config.DatabaseFilePath, "backups"), nil
+	}
+
+	databaseFilePath, err := filepath.Abs(config.DatabaseFilePath)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(databaseFilePath, "backups"), nil
+}
+
+// newMigrate returns a migrator for database together with a function that closes its migration source. The caller
+// must call closeSource instead of migrator.Close, which would also close the shared database through the sqlite
+// driver.
+func newMigrate(database *sql.DB, config *config.Config) (migrator *migrate.Migrate, closeSource func(), err error) {
+	driver, err := sqlite.WithInstance(database, &sqlite.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourceDriver, err := openMigrationSource(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	closeSource = func() {
+		if err := sourceDriver.Close(); err != nil {
+			slog.Warn("database.newMigrate: Unable to close migration source", "error", err)
+		}
+	}
+
+	migrator, err = migrate.NewWithInstance("migrations", sourceDriver, "sqlite", driver)
+	if err != nil {
+		closeSource()
+		return nil, nil, err
+	}
+
+	return migrator, closeSource, nil
+}
+
+// openMigrationSource reads the migrations embedded in the binary, unless config.DatabaseMigrationsPath points to a
+// directory on disk.
+func openMigrationSource(config *config.Config) (source.Driver, error) {
+	if config.DatabaseMigrationsPath == "" {
+		return iofs.New(migrations.FS, ".")
+	}
 
 	var migrationsPath string
 	if config.IsDatabaseMigrationsPathAbsolutePath {
@@ -33,18 +247,33 @@ func RunMigrations(database *sql.DB, config *config.Config) error {
 
 	fullMigrationsPath, err := filepath.Abs(migrationsPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	migrations, err := migrate.NewWithDatabaseInstance("file://"+fullMigrationsPath, "sqlite", driver)
+	return (&file.File{}).Open("file://" + fullMigrationsPath)
+}
 
+// GetAvailableMigrationVersions returns the versions of every migration in the migration source, in order.
+func GetAvailableMigrationVersions(config *config.Config) ([]uint, error) {
+	sourceDriver, err := openMigrationSource(config)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer func() {
+		_ = sourceDriver.Close()
+	}()
 
-	if err := migrations.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return err
+	var versions []uint
+
+	version, err := sourceDriver.First()
+	for err == nil {
+		versions = append(versions, version)
+		version, err = sourceDriver.Next(version)
 	}
 
-	return nil
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	return versions, nil
 }
@@ -38,6 +38,18 @@ func (err *NotFoundError) Error() string {
 	return fmt.Sprintf("error: object not found: %s", err.message)
 }
 
+type PreconditionFailedError struct {
+	Message string
+}
+
+func NewPreconditionFailedError(message string) *PreconditionFailedError {
+	return &PreconditionFailedError{message}
+}
+
+func (err *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("precondition failed: %s", err.Message)
+}
+
 type ValidationError struct {
 	Field   *string
 	Message string
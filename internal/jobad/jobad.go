@@ -0,0 +1,388 @@
+// Package jobad reads job ads that have been saved to disk, either as the HTML page of the ad or as a schema.org
+// JobPosting in JSON-LD. Nothing is fetched from the network.
+package jobad
+
+import (
+	"bytes"
+	"encoding/json"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+var (
+	hybridPattern = regexp.MustCompile(`\bhybrid\b`)
+	officePattern = regexp.MustCompile(`\b(on-?site|on site|in-office|in office|no remote|not remote)\b`)
+	remotePattern = regexp.MustCompile(`\b(remote|work from home|distans)\b`)
+)
+
+// Parse reads content as JSON-LD if it starts with "{" or "[", and as HTML otherwise. A JobPosting embedded in an
+// HTML page is preferred over what can be read from the page itself.
+//
+// Parse can return ValidationError
+func Parse(content []byte) (*models.JobAdPosting, models.JobAdSourceType, error) {
+	trimmedContent := bytes.TrimSpace(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")))
+	if len(trimmedContent) == 0 {
+		return nil, "", internalErrors.NewValidationError(nil, "job ad is empty")
+	}
+
+	if trimmedContent[0] == '{' || trimmedContent[0] == '[' {
+		posting, err := parseJSONLD(trimmedContent)
+		return posting, models.JobAdSourceTypeJSONLD, err
+	}
+
+	posting, err := parseHTML(trimmedContent)
+	return posting, models.JobAdSourceTypeHTML, err
+}
+
+func parseJSONLD(content []byte) (*models.JobAdPosting, error) {
+	var document any
+	if err := json.Unmarshal(content, &document); err != nil {
+		return nil, internalErrors.NewValidationError(nil, "job ad is not valid JSON: "+err.Error())
+	}
+
+	jobPosting := findJobPosting(document)
+	if jobPosting == nil {
+		return nil, internalErrors.NewValidationError(nil, "job ad does not contain a JobPosting")
+	}
+
+	return postingFromJobPosting(jobPosting), nil
+}
+
+// page is what parseHTML reads from an HTML page, apart from embedded JSON-LD.
+type page struct {
+	title       string
+	heading     string
+	description string
+	siteName    string
+	url         string
+	text        strings.Builder
+	jsonLD      []string
+}
+
+func parseHTML(content []byte) (*models.JobAdPosting, error) {
+	document, err := html.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, internalErrors.NewValidationError(nil, "job ad is not valid HTML: "+err.Error())
+	}
+
+	var parsedPage page
+	parsedPage.read(document)
+
+	// The page's own metadata is a fallback for fields that the embedded JobPosting leaves out
+	var posting *models.JobAdPosting
+	for _, jsonLD := range parsedPage.jsonLD {
+		var document any
+		if json.Unmarshal([]byte(jsonLD), &document) != nil {
+			continue
+		}
+		if jobPosting := findJobPosting(document); jobPosting != nil {
+			posting = postingFromJobPosting(jobPosting)
+			break
+		}
+	}
+
+	if posting == nil {
+		posting = &models.JobAdPosting{
+			JobTitle:         firstNonEmpty(parsedPage.heading, parsedPage.title),
+			RemoteStatusType: remoteStatusFromText(parsedPage.title, parsedPage.description, parsedPage.text.String()),
+		}
+	}
+
+	if posting.JobTitle == nil {
+		posting.JobTitle = firstNonEmpty(parsedPage.heading, parsedPage.title)
+	}
+	if posting.CompanyName == nil {
+		posting.CompanyName = firstNonEmpty(parsedPage.siteName)
+	}
+	if posting.JobAdURL == nil {
+		posting.JobAdURL = firstNonEmpty(parsedPage.url)
+	}
+
+	return posting, nil
+}
+
+func (parsedPage *page) read(node *html.Node) {
+	if node.Type == html.TextNode {
+		parsedPage.text.WriteString(node.Data)
+		parsedPage.text.WriteString(" ")
+		return
+	}
+
+	if node.Type == html.ElementNode {
+		switch node.DataAtom {
+		case atom.Script:
+			if strings.EqualFold(attribute(node, "type"), "application/ld+json") {
+				parsedPage.jsonLD = append(parsedPage.jsonLD, textContent(node))
+			}
+			return
+		case atom.Style, atom.Noscript, atom.Template:
+			return
+		case atom.Title:
+			if parsedPage.title == "" {
+				parsedPage.title = textContent(node)
+			}
+		case atom.H1:
+			if parsedPage.heading == "" {
+				parsedPage.heading = textContent(node)
+			}
+		case atom.Link:
+			if strings.EqualFold(attribute(node, "rel"), "canonical") {
+				parsedPage.url = attribute(node, "href")
+			}
+		case atom.Meta:
+			parsedPage.readMeta(node)
+		}
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		parsedPage.read(child)
+	}
+}
+
+func (parsedPage *page) readMeta(node *html.Node) {
+	content := strings.TrimSpace(attribute(node, "content"))
+
+	switch strings.ToLower(firstNonEmptyString(attribute(node, "property"), attribute(node, "name"))) {
+	case "og:title":
+		parsedPage.title = content
+	case "og:site_name":
+		parsedPage.siteName = content
+	case "og:url":
+		if parsedPage.url == "" {
+			parsedPage.url = content
+		}
+	case "description", "og:description":
+		if parsedPage.description == "" {
+			parsedPage.description = content
+		}
+	}
+}
+
+// findJobPosting returns the first object with "@type" JobPosting in document, looking inside arrays and "@graph".
+func findJobPosting(document any) map[string]any {
+	switch value := document.(type) {
+	case []any:
+		for _, item := range value {
+			if jobPosting := findJobPosting(item); jobPosting != nil {
+				return jobPosting
+			}
+		}
+	case map[string]any:
+		if hasType(value["@type"], "JobPosting") {
+			return value
+		}
+		return findJobPosting(value["@graph"])
+	}
+	return nil
+}
+
+func postingFromJobPosting(jobPosting map[string]any) *models.JobAdPosting {
+	posting := &models.JobAdPosting{
+		JobTitle:    firstNonNil(text(jobPosting["title"]), text(jobPosting["name"])),
+		CompanyName: text(jobPosting["hiringOrganization"]),
+		JobAdURL:    text(jobPosting["url"]),
+		Salary:      salary(jobPosting["baseSalary"]),
+	}
+
+	if address := firstAddress(jobPosting["jobLocation"]); address != nil {
+		posting.Country = text(address["addressCountry"])
+		posting.Area = firstNonNil(text(address["addressLocality"]), text(address["addressRegion"]))
+	}
+
+	if hasType(jobPosting["jobLocationType"], "TELECOMMUTE") {
+		posting.RemoteStatusType = models.RemoteStatusTypeRemote
+	} else {
+		posting.RemoteStatusType = remoteStatusFromText(
+			valueOrEmpty(posting.JobTitle), htmlToText(valueOrEmpty(text(jobPosting["description"]))))
+	}
+
+	return posting
+}
+
+// firstAddress returns the address of the first place in jobLocation. An address that is only a string is read as
+// the locality.
+func firstAddress(jobLocation any) map[string]any {
+	if places, ok := jobLocation.([]any); ok {
+		if len(places) == 0 {
+			return nil
+		}
+		jobLocation = places[0]
+	}
+
+	place, ok := jobLocation.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	switch address := place["address"].(type) {
+	case map[string]any:
+		return address
+	case string:
+		return map[string]any{"addressLocality": address}
+	}
+	return nil
+}
+
+// salary formats a schema.org MonetaryAmount, such as "45000-55000 SEK per month".
+func salary(baseSalary any) *string {
+	amount, ok := baseSalary.(map[string]any)
+	if !ok {
+		return text(baseSalary)
+	}
+
+	var value string
+	var unit string
+	switch quantitativeValue := amount["value"].(type) {
+	case map[string]any:
+		minimum := valueOrEmpty(text(quantitativeValue["minValue"]))
+		maximum := valueOrEmpty(text(quantitativeValue["maxValue"]))
+		switch {
+		case minimum != "" && maximum != "" && minimum != maximum:
+			value = minimum + "-" + maximum
+		default:
+			value = firstNonEmptyString(valueOrEmpty(text(quantitativeValue["value"])), minimum, maximum)
+		}
+		unit = valueOrEmpty(text(quantitativeValue["unitText"]))
+	default:
+		value = valueOrEmpty(text(quantitativeValue))
+	}
+
+	if value == "" {
+		return nil
+	}
+
+	formatted := value
+	if currency := valueOrEmpty(text(amount["currency"])); currency != "" {
+		formatted += " " + currency
+	}
+	if unit != "" {
+		formatted += " per " + strings.ToLower(unit)
+	}
+
+	return &formatted
+}
+
+// remoteStatusFromText looks for words such as "hybrid", "remote" and "on-site" in texts, in that order.
+func remoteStatusFromText(texts ...string) models.RemoteStatusType {
+	lowerCaseText := strings.ToLower(strings.Join(texts, " "))
+
+	switch {
+	case hybridPattern.MatchString(lowerCaseText):
+		return models.RemoteStatusTypeHybrid
+	case officePattern.MatchString(lowerCaseText):
+		return models.RemoteStatusTypeOffice
+	case remotePattern.MatchString(lowerCaseText):
+		return models.RemoteStatusTypeRemote
+	default:
+		return models.RemoteStatusTypeUnknown
+	}
+}
+
+// text returns value as a trimmed string, or the "name" of an object, or nil if there is no text.
+func text(value any) *string {
+	switch typedValue := value.(type) {
+	case string:
+		return firstNonEmpty(typedValue)
+	case float64:
+		formatted := strconv.FormatFloat(typedValue, 'f', -1, 64)
+		return &formatted
+	case map[string]any:
+		return text(typedValue["name"])
+	case []any:
+		for _, item := range typedValue {
+			if itemText := text(item); itemText != nil {
+				return itemText
+			}
+		}
+	}
+	return nil
+}
+
+// hasType reports whether value, a string or an array of strings, contains expected.
+func hasType(value any, expected string) bool {
+	switch typedValue := value.(type) {
+	case string:
+		return strings.EqualFold(strings.TrimPrefix(typedValue, "https://schema.org/"), expected)
+	case []any:
+		for _, item := range typedValue {
+			if hasType(item, expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func htmlToText(fragment string) string {
+	context := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), context)
+	if err != nil {
+		return fragment
+	}
+
+	var parsedPage page
+	for _, node := range nodes {
+		parsedPage.read(node)
+	}
+	return parsedPage.text.String()
+}
+
+func textContent(node *html.Node) string {
+	var builder strings.Builder
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.TextNode {
+			builder.WriteString(child.Data)
+		} else {
+			builder.WriteString(textContent(child))
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+func attribute(node *html.Node, key string) string {
+	for _, nodeAttribute := range node.Attr {
+		if strings.EqualFold(nodeAttribute.Key, key) {
+			return nodeAttribute.Val
+		}
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) *string {
+	value := firstNonEmptyString(values...)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func firstNonEmptyString(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+func firstNonNil(values ...*string) *string {
+	for _, value := range values {
+		if value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
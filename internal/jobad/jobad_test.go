@@ -0,0 +1,118 @@
+package jobad
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	content, err := os.ReadFile(filepath.Join("testdata", name))
+	assert.NoError(t, err)
+	return content
+}
+
+// -------- Parse - JSON-LD tests: --------
+
+func TestParse_ShouldReadJobPostingFromJSONLD(t *testing.T) {
+	posting, sourceType, err := Parse(readFixture(t, "job_posting.jsonld"))
+	assert.NoError(t, err)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeJSONLD), sourceType)
+
+	assert.Equal(t, "Senior Backend Developer", *posting.JobTitle)
+	assert.Equal(t, "Acme AB", *posting.CompanyName)
+	assert.Equal(
+		t, "https://careers.acme.se/jobs/1234-senior-backend-developer?utm_source=linkedin", *posting.JobAdURL)
+	assert.Equal(t, "SE", *posting.Country)
+	assert.Equal(t, "Stockholm", *posting.Area)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeHybrid), posting.RemoteStatusType)
+	assert.Equal(t, "55000-70000 SEK per month", *posting.Salary)
+}
+
+func TestParse_ShouldReadJobPostingFromJSONLDGraph(t *testing.T) {
+	posting, sourceType, err := Parse(readFixture(t, "job_posting_graph.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeJSONLD), sourceType)
+
+	assert.Equal(t, "Platform Engineer", *posting.JobTitle)
+	assert.Equal(t, "Initech", *posting.CompanyName)
+	assert.Nil(t, posting.JobAdURL)
+	assert.Equal(t, "Sweden", *posting.Country)
+	assert.Equal(t, "Västra Götaland", *posting.Area)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeRemote), posting.RemoteStatusType)
+	assert.Equal(t, "72000 EUR per year", *posting.Salary)
+}
+
+func TestParse_ShouldReturnValidationErrorIfJSONLDHasNoJobPosting(t *testing.T) {
+	posting, _, err := Parse(readFixture(t, "not_a_job_posting.json"))
+	assert.Nil(t, posting)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: job ad does not contain a JobPosting", validationError.Error())
+}
+
+func TestParse_ShouldReturnValidationErrorIfJSONIsInvalid(t *testing.T) {
+	posting, _, err := Parse([]byte(`{"@type": "JobPosting",`))
+	assert.Nil(t, posting)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+}
+
+func TestParse_ShouldReturnValidationErrorIfContentIsEmpty(t *testing.T) {
+	posting, _, err := Parse([]byte(" \n\t"))
+	assert.Nil(t, posting)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: job ad is empty", validationError.Error())
+}
+
+// -------- Parse - HTML tests: --------
+
+func TestParse_ShouldPreferJSONLDEmbeddedInHTML(t *testing.T) {
+	posting, sourceType, err := Parse(readFixture(t, "job_ad_with_json_ld.html"))
+	assert.NoError(t, err)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeHTML), sourceType)
+
+	assert.Equal(t, "Frontend Developer", *posting.JobTitle)
+	assert.Equal(t, "Globex Corporation", *posting.CompanyName)
+	assert.Equal(t, "https://jobs.globex.com/frontend-developer-4711", *posting.JobAdURL, "from the canonical link")
+	assert.Equal(t, "Sweden", *posting.Country)
+	assert.Equal(t, "Göteborg", *posting.Area)
+	assert.Equal(
+		t,
+		models.RemoteStatusType(models.RemoteStatusTypeOffice),
+		posting.RemoteStatusType,
+		"the description says on-site, the 'Remote jobs' link in the header is ignored")
+	assert.Equal(t, "48000 SEK", *posting.Salary)
+}
+
+func TestParse_ShouldReadPageMetadataIfHTMLHasNoJSONLD(t *testing.T) {
+	posting, sourceType, err := Parse(readFixture(t, "job_ad_without_json_ld.html"))
+	assert.NoError(t, err)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeHTML), sourceType)
+
+	assert.Equal(t, "Data Engineer", *posting.JobTitle)
+	assert.Equal(t, "Hooli", *posting.CompanyName)
+	assert.Equal(t, "https://hooli.xyz/jobs/data-engineer", *posting.JobAdURL)
+	assert.Nil(t, posting.Country)
+	assert.Nil(t, posting.Area)
+	assert.Nil(t, posting.Salary)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeRemote), posting.RemoteStatusType)
+}
+
+func TestParse_ShouldReturnUnknownRemoteStatusIfThereAreNoHints(t *testing.T) {
+	posting, _, err := Parse([]byte("<html><head><title>Tester</title></head><body><p>Apply now</p></body></html>"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Tester", *posting.JobTitle)
+	assert.Nil(t, posting.CompanyName)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeUnknown), posting.RemoteStatusType)
+}
@@ -0,0 +1,72 @@
+package jobadurl
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// trackingParameters are dropped from the query, along with every parameter that starts with one of
+// trackingParameterPrefixes. They identify how the ad was found, not which ad it is. Generic names such as "ref" or
+// "src" are kept, since some job boards identify the ad with them.
+var trackingParameters = map[string]bool{
+	"fbclid": true, "gclid": true, "msclkid": true, "trk": true,
+}
+
+// trackingParameterPrefixes are the prefixes of the parameters of Google Analytics, Mailchimp and HubSpot.
+var trackingParameterPrefixes = []string{"utm_", "mc_", "_hs"}
+
+// Normalize returns rawURL in a form that is the same for every link to the same ad: the scheme is "https", the
+// host is lower-cased without "www." and without a default port, tracking parameters, trailing slashes and the
+// fragment are removed, and the remaining query parameters are sorted. A URL without a scheme is read as "https".
+// A fragment that starts with "/" or "!" is kept, since single-page job boards route to the ad with it.
+//
+// Normalize returns false if rawURL cannot be parsed, or if its host has no dot, which means that it is not a link
+// to an ad on the web.
+func Normalize(rawURL string) (string, bool) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || !strings.Contains(parsedURL.Hostname(), ".") {
+		return "", false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+	port := parsedURL.Port()
+	if port != "" && port != "80" && port != "443" {
+		host = net.JoinHostPort(host, port)
+	}
+
+	query := parsedURL.Query()
+	for parameter := range query {
+		lowerCaseParameter := strings.ToLower(parameter)
+		if trackingParameters[lowerCaseParameter] || hasTrackingParameterPrefix(lowerCaseParameter) {
+			query.Del(parameter)
+		}
+	}
+
+	normalizedURL := url.URL{
+		Scheme:   "https",
+		Host:     host,
+		Path:     strings.TrimRight(parsedURL.Path, "/"),
+		RawQuery: query.Encode(),
+	}
+	if strings.HasPrefix(parsedURL.Fragment, "/") || strings.HasPrefix(parsedURL.Fragment, "!") {
+		normalizedURL.Fragment = parsedURL.Fragment
+	}
+
+	return normalizedURL.String(), true
+}
+
+// hasTrackingParameterPrefix returns true if the lower-cased parameter starts with one of trackingParameterPrefixes.
+func hasTrackingParameterPrefix(parameter string) bool {
+	for _, prefix := range trackingParameterPrefixes {
+		if strings.HasPrefix(parameter, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package jobadurl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- Normalize tests: --------
+
+func TestNormalize_ShouldReturnTheSameURLForLinksToTheSameAd(t *testing.T) {
+	tests := []struct {
+		testName string
+		rawURL   string
+		expected string
+	}{
+		{"already normalized", "https://acme.se/jobs/123", "https://acme.se/jobs/123"},
+		{"http scheme", "http://acme.se/jobs/123", "https://acme.se/jobs/123"},
+		{"no scheme", "acme.se/jobs/123", "https://acme.se/jobs/123"},
+		{"upper-case scheme and host", "HTTPS://WWW.Acme.SE/jobs/123", "https://acme.se/jobs/123"},
+		{"trailing slashes", "https://acme.se/jobs/123//", "https://acme.se/jobs/123"},
+		{"root with trailing slash", "https://acme.se/", "https://acme.se"},
+		{"default port", "https://acme.se:443/jobs/123", "https://acme.se/jobs/123"},
+		{"fragment", "https://acme.se/jobs/123#apply", "https://acme.se/jobs/123"},
+		{"surrounding spaces", "  https://acme.se/jobs/123 ", "https://acme.se/jobs/123"},
+		{
+			"tracking parameters",
+			"https://acme.se/jobs/123?utm_source=linkedin&UTM_Medium=social&gclid=abc&trk=feed",
+			"https://acme.se/jobs/123",
+		},
+		{
+			"tracking parameter prefixes",
+			"https://acme.se/jobs/123?mc_cid=abc&_hsenc=def&_HSMI=ghi&fbclid=jkl&msclkid=mno",
+			"https://acme.se/jobs/123",
+		},
+		{
+			"generic parameters that can identify the ad are kept",
+			"https://jobs.acme.se/view?ref=JOB-123&src=board&refid=9",
+			"https://jobs.acme.se/view?ref=JOB-123&refid=9&src=board",
+		},
+		{
+			"other parameters are kept and sorted",
+			"https://acme.se/jobs?utm_campaign=spring&lang=sv&id=123",
+			"https://acme.se/jobs?id=123&lang=sv",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			normalized, ok := Normalize(test.rawURL)
+			assert.True(t, ok)
+			assert.Equal(t, test.expected, normalized)
+		})
+	}
+}
+
+func TestNormalize_ShouldKeepRoutingFragments(t *testing.T) {
+	tests := []struct {
+		testName string
+		rawURL   string
+		expected string
+	}{
+		{"hash route", "https://careers.acme.se/#/jobs/123", "https://careers.acme.se#/jobs/123"},
+		{"hashbang route", "https://careers.acme.se/#!/jobs/123", "https://careers.acme.se#!/jobs/123"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			normalized, ok := Normalize(test.rawURL)
+			assert.True(t, ok)
+			assert.Equal(t, test.expected, normalized)
+		})
+	}
+
+	first, _ := Normalize("https://careers.acme.se/#/jobs/123")
+	second, _ := Normalize("https://careers.acme.se/#/jobs/456")
+	assert.NotEqual(t, first, second)
+}
+
+func TestNormalize_ShouldKeepPathCaseAndNonDefaultPort(t *testing.T) {
+	normalized, ok := Normalize("https://Jobs.Acme.se:8443/Job/ABC")
+	assert.True(t, ok)
+	assert.Equal(t, "https://jobs.acme.se:8443/Job/ABC", normalized)
+}
+
+func TestNormalize_ShouldReturnFalseIfValueIsNotAWebURL(t *testing.T) {
+	rawURLs := []string{
+		"", "   ", "https://", "https:///jobs/123", "https://acme.se:port/jobs", "Job Ad URL", "localhost/jobs/123",
+	}
+	for _, rawURL := range rawURLs {
+		normalized, ok := Normalize(rawURL)
+		assert.False(t, ok, rawURL)
+		assert.Empty(t, normalized, rawURL)
+	}
+}
@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+type contextKey int
+
+const (
+	loggerContextKey contextKey = iota
+	requestIDContextKey
+)
+
+// WithLogger returns a copy of ctx carrying logger. Code further down the call chain retrieves it with FromContext.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey, logger)
+}
+
+// FromContext returns the request-scoped logger stored in ctx, or slog.Default() if there is none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerContextKey).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if requestID, ok := ctx.Value(requestIDContextKey).(string); ok {
+			return requestID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,269 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultDurationBuckets are the upper bounds, in seconds, of the latency histogram buckets.
+var DefaultDurationBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics collects request and database statistics and renders them in the Prometheus text exposition format.
+type Metrics struct {
+	httpRequestsTotal        *counterVec
+	httpRequestDuration      *histogramVec
+	databaseQueryDuration    *histogramVec
+	databaseQueryErrorsTotal *counterVec
+	registered               []metric
+}
+
+func NewMetrics() *Metrics {
+	metrics := &Metrics{
+		httpRequestsTotal: newCounterVec(
+			"http_requests_total",
+			"Number of HTTP requests handled, by method, route and status code.",
+			"method", "route", "status"),
+		httpRequestDuration: newHistogramVec(
+			"http_request_duration_seconds",
+			"Time taken to handle HTTP requests, by method and route.",
+			DefaultDurationBuckets,
+			"method", "route"),
+		databaseQueryDuration: newHistogramVec(
+			"db_query_duration_seconds",
+			"Time taken to execute database statements, by operation.",
+			DefaultDurationBuckets,
+			"operation"),
+		databaseQueryErrorsTotal: newCounterVec(
+			"db_query_errors_total",
+			"Number of database statements that returned an error, by operation.",
+			"operation"),
+	}
+
+	metrics.registered = []metric{
+		metrics.httpRequestsTotal,
+		metrics.httpRequestDuration,
+		metrics.databaseQueryDuration,
+		metrics.databaseQueryErrorsTotal,
+	}
+
+	return metrics
+}
+
+// ObserveHTTPRequest records one handled request. route should be the route template, not the raw path, so that
+// the number of series stays bounded.
+func (metrics *Metrics) ObserveHTTPRequest(method string, route string, status int, duration time.Duration) {
+	metrics.httpRequestsTotal.add(1, method, route, strconv.Itoa(status))
+	metrics.httpRequestDuration.observe(duration.Seconds(), method, route)
+}
+
+// ObserveDatabaseQuery records one executed statement. operation is a short, bounded name such as "select".
+func (metrics *Metrics) ObserveDatabaseQuery(operation string, duration time.Duration, err error) {
+	metrics.databaseQueryDuration.observe(duration.Seconds(), operation)
+	if err != nil {
+		metrics.databaseQueryErrorsTotal.add(1, operation)
+	}
+}
+
+// WriteTo writes every metric in the Prometheus text exposition format.
+func (metrics *Metrics) WriteTo(writer io.Writer) (int64, error) {
+	countingWriter := &countingWriter{writer: writer}
+	bufferedWriter := bufio.NewWriter(countingWriter)
+
+	for _, metric := range metrics.registered {
+		metric.writeTo(bufferedWriter)
+	}
+
+	err := bufferedWriter.Flush()
+	return countingWriter.count, err
+}
+
+// Handler serves the metrics for scraping.
+func (metrics *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = metrics.WriteTo(writer)
+	})
+}
+
+type metric interface {
+	writeTo(writer *bufio.Writer)
+}
+
+type counterVec struct {
+	name       string
+	help       string
+	labelNames []string
+
+	mutex  sync.Mutex
+	series map[string]*counterSeries
+}
+
+type counterSeries struct {
+	labelValues []string
+	value       float64
+}
+
+func newCounterVec(name string, help string, labelNames ...string) *counterVec {
+	return &counterVec{name: name, help: help, labelNames: labelNames, series: make(map[string]*counterSeries)}
+}
+
+func (counter *counterVec) add(value float64, labelValues ...string) {
+	key := seriesKey(labelValues)
+
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+
+	series, exists := counter.series[key]
+	if !exists {
+		series = &counterSeries{labelValues: labelValues}
+		counter.series[key] = series
+	}
+	series.value += value
+}
+
+func (counter *counterVec) writeTo(writer *bufio.Writer) {
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+
+	writeHeader(writer, counter.name, counter.help, "counter")
+	for _, key := range sortedKeys(counter.series) {
+		series := counter.series[key]
+		writeSample(writer, counter.name, counter.labelNames, series.labelValues, nil, series.value)
+	}
+}
+
+type histogramVec struct {
+	name       string
+	help       string
+	labelNames []string
+	buckets    []float64
+
+	mutex  sync.Mutex
+	series map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	labelValues  []string
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+func newHistogramVec(name string, help string, buckets []float64, labelNames ...string) *histogramVec {
+	return &histogramVec{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     make(map[string]*histogramSeries),
+	}
+}
+
+func (histogram *histogramVec) observe(value float64, labelValues ...string) {
+	key := seriesKey(labelValues)
+
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	series, exists := histogram.series[key]
+	if !exists {
+		series = &histogramSeries{labelValues: labelValues, bucketCounts: make([]uint64, len(histogram.buckets))}
+		histogram.series[key] = series
+	}
+
+	// Buckets are cumulative: a value is counted in every bucket whose upper bound it does not exceed.
+	for index, upperBound := range histogram.buckets {
+		if value <= upperBound {
+			series.bucketCounts[index]++
+		}
+	}
+	series.count++
+	series.sum += value
+}
+
+func (histogram *histogramVec) writeTo(writer *bufio.Writer) {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	writeHeader(writer, histogram.name, histogram.help, "histogram")
+	for _, key := range sortedKeys(histogram.series) {
+		series := histogram.series[key]
+
+		for index, upperBound := range histogram.buckets {
+			le := strconv.FormatFloat(upperBound, 'g', -1, 64)
+			writeSample(writer, histogram.name+"_bucket", histogram.labelNames, series.labelValues,
+				[]string{"le", le}, float64(series.bucketCounts[index]))
+		}
+		writeSample(writer, histogram.name+"_bucket", histogram.labelNames, series.labelValues,
+			[]string{"le", "+Inf"}, float64(series.count))
+		writeSample(writer, histogram.name+"_sum", histogram.labelNames, series.labelValues, nil, series.sum)
+		writeSample(writer, histogram.name+"_count", histogram.labelNames, series.labelValues, nil,
+			float64(series.count))
+	}
+}
+
+func writeHeader(writer *bufio.Writer, name string, help string, metricType string) {
+	_, _ = fmt.Fprintf(writer, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// writeSample writes one line. extraLabel is an optional name and value pair, used for the histogram `le` label.
+func writeSample(
+	writer *bufio.Writer,
+	name string,
+	labelNames []string,
+	labelValues []string,
+	extraLabel []string,
+	value float64) {
+
+	_, _ = writer.WriteString(name)
+
+	labels := make([]string, 0, len(labelNames)+1)
+	for index, labelName := range labelNames {
+		labels = append(labels, labelName+`="`+escapeLabelValue(labelValues[index])+`"`)
+	}
+	if extraLabel != nil {
+		labels = append(labels, extraLabel[0]+`="`+escapeLabelValue(extraLabel[1])+`"`)
+	}
+	if len(labels) > 0 {
+		_, _ = writer.WriteString("{" + strings.Join(labels, ",") + "}")
+	}
+
+	_, _ = writer.WriteString(" " + strconv.FormatFloat(value, 'g', -1, 64) + "\n")
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
+
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+func sortedKeys[V any](series map[string]V) []string {
+	keys := make([]string, 0, len(series))
+	for key := range series {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (countingWriter *countingWriter) Write(bytes []byte) (int, error) {
+	written, err := countingWriter.writer.Write(bytes)
+	countingWriter.count += int64(written)
+	return written, err
+}
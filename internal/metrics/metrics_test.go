@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTo_ShouldWriteCountersAndCumulativeHistogramBuckets(t *testing.T) {
+	metrics := NewMetrics()
+
+	metrics.ObserveHTTPRequest(http.MethodGet, "/healthz", http.StatusOK, 3*time.Millisecond)
+	metrics.ObserveHTTPRequest(http.MethodGet, "/healthz", http.StatusOK, 30*time.Millisecond)
+
+	var output bytes.Buffer
+	_, err := metrics.WriteTo(&output)
+	assert.NoError(t, err)
+
+	assert.Contains(t, output.String(), "# TYPE http_requests_total counter\n")
+	assert.Contains(t, output.String(), `http_requests_total{method="GET",route="/healthz",status="200"} 2`+"\n")
+	assert.Contains(t, output.String(), "# TYPE http_request_duration_seconds histogram\n")
+	assert.Contains(t, output.String(),
+		`http_request_duration_seconds_bucket{method="GET",route="/healthz",le="0.001"} 0`+"\n")
+	assert.Contains(t, output.String(),
+		`http_request_duration_seconds_bucket{method="GET",route="/healthz",le="0.005"} 1`+"\n")
+	assert.Contains(t, output.String(),
+		`http_request_duration_seconds_bucket{method="GET",route="/healthz",le="0.05"} 2`+"\n")
+	assert.Contains(t, output.String(),
+		`http_request_duration_seconds_bucket{method="GET",route="/healthz",le="+Inf"} 2`+"\n")
+	assert.Contains(t, output.String(), `http_request_duration_seconds_count{method="GET",route="/healthz"} 2`+"\n")
+}
+
+func TestObserveDatabaseQuery_ShouldCountErrors(t *testing.T) {
+	metrics := NewMetrics()
+
+	metrics.ObserveDatabaseQuery("select", time.Millisecond, nil)
+	metrics.ObserveDatabaseQuery("select", time.Millisecond, errors.New("boom"))
+
+	var output bytes.Buffer
+	_, err := metrics.WriteTo(&output)
+	assert.NoError(t, err)
+
+	assert.Contains(t, output.String(), `db_query_duration_seconds_count{operation="select"} 2`+"\n")
+	assert.Contains(t, output.String(), `db_query_errors_total{operation="select"} 1`+"\n")
+}
+
+func TestWriteTo_ShouldEscapeLabelValues(t *testing.T) {
+	metrics := NewMetrics()
+
+	metrics.ObserveHTTPRequest(http.MethodGet, "a\"b\\c\nd", http.StatusOK, time.Millisecond)
+
+	var output bytes.Buffer
+	_, err := metrics.WriteTo(&output)
+	assert.NoError(t, err)
+
+	assert.Contains(t, output.String(), `route="a\"b\\c\nd"`)
+}
+
+func TestHandler_ShouldServeTextFormat(t *testing.T) {
+	metrics := NewMetrics()
+
+	responseRecorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, responseRecorder.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", responseRecorder.Header().Get("Content-Type"))
+	assert.Contains(t, responseRecorder.Body.String(), "# HELP db_query_duration_seconds")
+}
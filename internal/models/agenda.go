@@ -0,0 +1,9 @@
+package models
+
+// Agenda holds the booked events in a period, and the booked events that are overdue. A booked event is overdue if
+// its date has passed without an event of its CompletedEventType for the same application or company. Only the
+// built-in types in BookedEventTypes are booked.
+type Agenda struct {
+	Upcoming []*Event
+	Overdue  []*Event
+}
@@ -22,6 +22,7 @@ type Application struct {
 	ApplicationDate      *time.Time
 	CreatedDate          *time.Time
 	UpdatedDate          *time.Time
+	Version              int
 	Company              *Company
 	Recruiter            *Company
 	Persons              *[]*Person
@@ -112,6 +113,7 @@ type UpdateApplication struct {
 	EstimatedCycleTime   *int
 	EstimatedCommuteTime *int
 	ApplicationDate      *time.Time
+	ExpectedVersion      *int
 }
 
 func (application *UpdateApplication) Validate() error {
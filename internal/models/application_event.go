@@ -10,13 +10,16 @@ import (
 type ApplicationEvent struct {
 	ApplicationID uuid.UUID
 	EventID       uuid.UUID
-	CreatedDate   time.Time
+	LinkDetails
+	CreatedDate time.Time
+	UpdatedDate *time.Time
 }
 
 type AssociateApplicationEvent struct {
 	ApplicationID uuid.UUID
 	EventID       uuid.UUID
-	CreatedDate   *time.Time
+	LinkDetails
+	CreatedDate *time.Time
 }
 
 // Validate can return ValidationError
@@ -29,7 +32,32 @@ func (applicationEvent *AssociateApplicationEvent) Validate() error {
 		return errors.NewValidationError(nil, "EventID is empty")
 	}
 
-	return nil
+	// can return ValidationError
+	return applicationEvent.LinkDetails.Validate()
+}
+
+type UpdateApplicationEvent struct {
+	ApplicationID uuid.UUID
+	EventID       uuid.UUID
+	LinkDetails
+}
+
+// Validate can return ValidationError
+func (applicationEvent *UpdateApplicationEvent) Validate() error {
+	if applicationEvent.ApplicationID == uuid.Nil {
+		return errors.NewValidationError(nil, "ApplicationID cannot be empty")
+	}
+
+	if applicationEvent.EventID == uuid.Nil {
+		return errors.NewValidationError(nil, "EventID cannot be empty")
+	}
+
+	if applicationEvent.LinkDetails.IsEmpty() {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	// can return ValidationError
+	return applicationEvent.LinkDetails.Validate()
 }
 
 type DeleteApplicationEvent struct {
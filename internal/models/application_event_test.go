@@ -62,6 +62,52 @@ func TestAssociateApplicationEventValidate_ShouldReturnValidationErrorIfEventIDI
 	assert.Equal(t, "validation error: EventID is empty", validationError.Error())
 }
 
+// -------- UpdateApplicationEvent.Validate tests: --------
+
+func TestUpdateApplicationEventValidate_ShouldReturnNilIfUpdateApplicationEventIsValid(t *testing.T) {
+	model := UpdateApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+		LinkDetails: LinkDetails{
+			Role: testutil.ToPtr("Recruiter"),
+		},
+	}
+	err := model.Validate()
+	assert.NoError(t, err)
+}
+
+func TestUpdateApplicationEventValidate_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	model := UpdateApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
+func TestUpdateApplicationEventValidate_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, -1, 0)
+	model := UpdateApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+		LinkDetails: LinkDetails{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		},
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", validationError.Error())
+}
+
 // -------- DeleteApplicationEvent.Validate tests: --------
 
 func TestDeleteApplicationEventValidate_ShouldReturnNilIfAssociateApplicationEventIsValid(t *testing.T) {
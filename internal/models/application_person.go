@@ -10,13 +10,16 @@ import (
 type ApplicationPerson struct {
 	ApplicationID uuid.UUID
 	PersonID      uuid.UUID
-	CreatedDate   time.Time
+	LinkDetails
+	CreatedDate time.Time
+	UpdatedDate *time.Time
 }
 
 type AssociateApplicationPerson struct {
 	ApplicationID uuid.UUID
 	PersonID      uuid.UUID
-	CreatedDate   *time.Time
+	LinkDetails
+	CreatedDate *time.Time
 }
 
 // Validate can return ValidationError
@@ -29,7 +32,32 @@ func (applicationPerson *AssociateApplicationPerson) Validate() error {
 		return errors.NewValidationError(nil, "PersonID is empty")
 	}
 
-	return nil
+	// can return ValidationError
+	return applicationPerson.LinkDetails.Validate()
+}
+
+type UpdateApplicationPerson struct {
+	ApplicationID uuid.UUID
+	PersonID      uuid.UUID
+	LinkDetails
+}
+
+// Validate can return ValidationError
+func (applicationPerson *UpdateApplicationPerson) Validate() error {
+	if applicationPerson.ApplicationID == uuid.Nil {
+		return errors.NewValidationError(nil, "ApplicationID cannot be empty")
+	}
+
+	if applicationPerson.PersonID == uuid.Nil {
+		return errors.NewValidationError(nil, "PersonID cannot be empty")
+	}
+
+	if applicationPerson.LinkDetails.IsEmpty() {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	// can return ValidationError
+	return applicationPerson.LinkDetails.Validate()
 }
 
 type DeleteApplicationPerson struct {
@@ -62,6 +62,52 @@ func TestAssociateApplicationPersonValidate_ShouldReturnValidationErrorIfPersonI
 	assert.Equal(t, "validation error: PersonID is empty", validationError.Error())
 }
 
+// -------- UpdateApplicationPerson.Validate tests: --------
+
+func TestUpdateApplicationPersonValidate_ShouldReturnNilIfUpdateApplicationPersonIsValid(t *testing.T) {
+	model := UpdateApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+		LinkDetails: LinkDetails{
+			Role: testutil.ToPtr("Recruiter"),
+		},
+	}
+	err := model.Validate()
+	assert.NoError(t, err)
+}
+
+func TestUpdateApplicationPersonValidate_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	model := UpdateApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
+func TestUpdateApplicationPersonValidate_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, -1, 0)
+	model := UpdateApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+		LinkDetails: LinkDetails{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		},
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", validationError.Error())
+}
+
 // -------- DeleteApplicationPerson.Validate tests: --------
 
 func TestDeleteApplicationPersonValidate_ShouldReturnNilIfAssociateApplicationPersonIsValid(t *testing.T) {
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"strconv"
+)
+
+// MaxBulkLinks is the largest number of links that can be associated or deleted in one bulk request.
+const MaxBulkLinks = 100
+
+type BulkLinkStatus string
+
+const (
+	BulkLinkStatusAssociated = "associated"
+	BulkLinkStatusDeleted    = "deleted"
+	BulkLinkStatusConflict   = "conflict"
+	BulkLinkStatusNotFound   = "notFound"
+	BulkLinkStatusInvalid    = "invalid"
+)
+
+func (bulkLinkStatus BulkLinkStatus) String() string {
+	return string(bulkLinkStatus)
+}
+
+// IsSuccess returns true if the link was associated or deleted.
+func (bulkLinkStatus BulkLinkStatus) IsSuccess() bool {
+	return bulkLinkStatus == BulkLinkStatusAssociated || bulkLinkStatus == BulkLinkStatusDeleted
+}
+
+// BulkLinkResult is the outcome for one link of a bulk request. Index is the position of the link in the request.
+// Message explains why the link failed, and is nil if it succeeded.
+type BulkLinkResult struct {
+	Index   int
+	Status  BulkLinkStatus
+	Message *string
+}
+
+// ValidateBulkLinkCount can return ValidationError
+func ValidateBulkLinkCount(count int) error {
+	links := "Links"
+
+	if count == 0 {
+		return errors.NewValidationError(&links, "Links is empty")
+	}
+
+	if count > MaxBulkLinks {
+		return errors.NewValidationError(
+			&links, "Links cannot contain more than "+strconv.Itoa(MaxBulkLinks)+" links. Got "+strconv.Itoa(count))
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- ValidateBulkLinkCount tests: --------
+
+func TestValidateBulkLinkCount_ShouldReturnNilForValidCount(t *testing.T) {
+	assert.NoError(t, ValidateBulkLinkCount(1))
+	assert.NoError(t, ValidateBulkLinkCount(MaxBulkLinks))
+}
+
+func TestValidateBulkLinkCount_ShouldReturnValidationErrorForInvalidCount(t *testing.T) {
+	tests := []struct {
+		testName      string
+		count         int
+		expectedError string
+	}{
+		{"no links", 0, "validation error on field 'Links': Links is empty"},
+		{
+			"too many links",
+			MaxBulkLinks + 1,
+			"validation error on field 'Links': Links cannot contain more than 100 links. Got 101",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := ValidateBulkLinkCount(test.count)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, test.expectedError, err.Error())
+		})
+	}
+}
+
+// -------- BulkLinkStatus.IsSuccess tests: --------
+
+func TestBulkLinkStatusIsSuccess_ShouldOnlyBeTrueForAssociatedAndDeleted(t *testing.T) {
+	assert.True(t, BulkLinkStatus(BulkLinkStatusAssociated).IsSuccess())
+	assert.True(t, BulkLinkStatus(BulkLinkStatusDeleted).IsSuccess())
+	assert.False(t, BulkLinkStatus(BulkLinkStatusConflict).IsSuccess())
+	assert.False(t, BulkLinkStatus(BulkLinkStatusNotFound).IsSuccess())
+	assert.False(t, BulkLinkStatus(BulkLinkStatusInvalid).IsSuccess())
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxChangesPerRead is the number of changes read from the change log at a time.
+const MaxChangesPerRead = 100
+
+// ChangeEntityType names the kind of record or link that changed. Links are named like their endpoints, such as
+// "company-person".
+type ChangeEntityType string
+
+const (
+	ChangeEntityTypeApplication       = "application"
+	ChangeEntityTypeCompany           = "company"
+	ChangeEntityTypeEvent             = "event"
+	ChangeEntityTypePerson            = "person"
+	ChangeEntityTypeApplicationEvent  = "application-event"
+	ChangeEntityTypeApplicationPerson = "application-person"
+	ChangeEntityTypeCompanyEvent      = "company-event"
+	ChangeEntityTypeCompanyPerson     = "company-person"
+	ChangeEntityTypeEventPerson       = "event-person"
+)
+
+func (changeEntityType ChangeEntityType) IsValid() bool {
+	switch changeEntityType {
+	case ChangeEntityTypeApplication, ChangeEntityTypeCompany, ChangeEntityTypeEvent, ChangeEntityTypePerson,
+		ChangeEntityTypeApplicationEvent, ChangeEntityTypeApplicationPerson, ChangeEntityTypeCompanyEvent,
+		ChangeEntityTypeCompanyPerson, ChangeEntityTypeEventPerson:
+		return true
+	}
+	return false
+}
+
+func (changeEntityType ChangeEntityType) String() string { return string(changeEntityType) }
+
+// ChangeType is what happened to the record or link. Links are associated rather than created.
+type ChangeType string
+
+const (
+	ChangeTypeCreated    = "created"
+	ChangeTypeUpdated    = "updated"
+	ChangeTypeDeleted    = "deleted"
+	ChangeTypeAssociated = "associated"
+)
+
+func (changeType ChangeType) String() string { return string(changeType) }
+
+// Change is an entry of the change log. For a link, EntityID is the ID of the first entity in its name and
+// RelatedEntityID the ID of the second, so for "company-person" they are the company ID and the person ID.
+type Change struct {
+	Sequence        int64
+	EntityType      ChangeEntityType
+	ChangeType      ChangeType
+	EntityID        uuid.UUID
+	RelatedEntityID *uuid.UUID
+	ChangeDate      time.Time
+}
+
+// ChangeFilter selects the changes with one of EntityTypes. An empty EntityTypes selects all changes.
+type ChangeFilter struct {
+	EntityTypes []ChangeEntityType
+}
+
+// Validate can return ValidationError
+func (changeFilter *ChangeFilter) Validate() error {
+	for _, entityType := range changeFilter.EntityTypes {
+		if !entityType.IsValid() {
+			entityTypeField := "entity_type"
+			return errors.NewValidationError(
+				&entityTypeField,
+				"'"+entityType.String()+"' is not a valid entity type. Valid types are: "+
+					strings.Join(changeEntityTypeNames(), ", "))
+		}
+	}
+	return nil
+}
+
+func changeEntityTypeNames() []string {
+	return []string{
+		ChangeEntityTypeApplication,
+		ChangeEntityTypeCompany,
+		ChangeEntityTypeEvent,
+		ChangeEntityTypePerson,
+		ChangeEntityTypeApplicationEvent,
+		ChangeEntityTypeApplicationPerson,
+		ChangeEntityTypeCompanyEvent,
+		ChangeEntityTypeCompanyPerson,
+		ChangeEntityTypeEventPerson,
+	}
+}
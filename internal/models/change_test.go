@@ -0,0 +1,25 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- ChangeFilter.Validate tests: --------
+
+func TestChangeFilterValidate_ShouldAcceptKnownEntityTypes(t *testing.T) {
+	filter := ChangeFilter{EntityTypes: []ChangeEntityType{ChangeEntityTypeApplication, ChangeEntityTypeEventPerson}}
+	assert.NoError(t, filter.Validate())
+
+	emptyFilter := ChangeFilter{}
+	assert.NoError(t, emptyFilter.Validate())
+}
+
+func TestChangeFilterValidate_ShouldReturnValidationErrorForUnknownEntityType(t *testing.T) {
+	filter := ChangeFilter{EntityTypes: []ChangeEntityType{ChangeEntityTypeCompany, "companies"}}
+
+	err := filter.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "validation error on field 'entity_type': 'companies' is not a valid entity type")
+}
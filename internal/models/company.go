@@ -15,6 +15,7 @@ type Company struct {
 	LastContact  *time.Time
 	CreatedDate  *time.Time
 	UpdatedDate  *time.Time
+	Version      int
 	Applications *[]*Application
 	Persons      *[]*Person
 	Events       *[]*Event
@@ -52,11 +53,12 @@ func (company *CreateCompany) Validate() error {
 }
 
 type UpdateCompany struct {
-	ID          uuid.UUID
-	Name        *string
-	CompanyType *CompanyType
-	Notes       *string
-	LastContact *time.Time
+	ID              uuid.UUID
+	Name            *string
+	CompanyType     *CompanyType
+	Notes           *string
+	LastContact     *time.Time
+	ExpectedVersion *int
 }
 
 // Validate can return ValidationError
@@ -79,12 +81,9 @@ const (
 	CompanyTypeConsultancy = "consultancy"
 )
 
+// IsValid reports whether companyType is in the company_type lookup table.
 func (companyType CompanyType) IsValid() bool {
-	switch companyType {
-	case CompanyTypeEmployer, CompanyTypeRecruiter, CompanyTypeConsultancy:
-		return true
-	}
-	return false
+	return IsKnownType(TypeKindCompany, string(companyType))
 }
 
 func (companyType CompanyType) String() string {
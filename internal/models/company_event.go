@@ -8,14 +8,17 @@ import (
 )
 
 type CompanyEvent struct {
-	CompanyID   uuid.UUID
-	EventID     uuid.UUID
+	CompanyID uuid.UUID
+	EventID   uuid.UUID
+	LinkDetails
 	CreatedDate time.Time
+	UpdatedDate *time.Time
 }
 
 type AssociateCompanyEvent struct {
-	CompanyID   uuid.UUID
-	EventID     uuid.UUID
+	CompanyID uuid.UUID
+	EventID   uuid.UUID
+	LinkDetails
 	CreatedDate *time.Time
 }
 
@@ -29,7 +32,32 @@ func (companyEvent *AssociateCompanyEvent) Validate() error {
 		return errors.NewValidationError(nil, "EventID is empty")
 	}
 
-	return nil
+	// can return ValidationError
+	return companyEvent.LinkDetails.Validate()
+}
+
+type UpdateCompanyEvent struct {
+	CompanyID uuid.UUID
+	EventID   uuid.UUID
+	LinkDetails
+}
+
+// Validate can return ValidationError
+func (companyEvent *UpdateCompanyEvent) Validate() error {
+	if companyEvent.CompanyID == uuid.Nil {
+		return errors.NewValidationError(nil, "CompanyID cannot be empty")
+	}
+
+	if companyEvent.EventID == uuid.Nil {
+		return errors.NewValidationError(nil, "EventID cannot be empty")
+	}
+
+	if companyEvent.LinkDetails.IsEmpty() {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	// can return ValidationError
+	return companyEvent.LinkDetails.Validate()
 }
 
 type DeleteCompanyEvent struct {
@@ -62,6 +62,52 @@ func TestAssociateCompanyEventValidate_ShouldReturnValidationErrorIfEventIDIsEmp
 	assert.Equal(t, "validation error: EventID is empty", validationError.Error())
 }
 
+// -------- UpdateCompanyEvent.Validate tests: --------
+
+func TestUpdateCompanyEventValidate_ShouldReturnNilIfUpdateCompanyEventIsValid(t *testing.T) {
+	model := UpdateCompanyEvent{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+		LinkDetails: LinkDetails{
+			Role: testutil.ToPtr("Recruiter"),
+		},
+	}
+	err := model.Validate()
+	assert.NoError(t, err)
+}
+
+func TestUpdateCompanyEventValidate_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	model := UpdateCompanyEvent{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
+func TestUpdateCompanyEventValidate_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, -1, 0)
+	model := UpdateCompanyEvent{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+		LinkDetails: LinkDetails{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		},
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", validationError.Error())
+}
+
 // -------- DeleteCompanyEvent.Validate tests: --------
 
 func TestDeleteCompanyEventValidate_ShouldReturnNilIfAssociateCompanyEventIsValid(t *testing.T) {
@@ -8,14 +8,17 @@ import (
 )
 
 type CompanyPerson struct {
-	CompanyID   uuid.UUID
-	PersonID    uuid.UUID
+	CompanyID uuid.UUID
+	PersonID  uuid.UUID
+	LinkDetails
 	CreatedDate time.Time
+	UpdatedDate *time.Time
 }
 
 type AssociateCompanyPerson struct {
-	CompanyID   uuid.UUID
-	PersonID    uuid.UUID
+	CompanyID uuid.UUID
+	PersonID  uuid.UUID
+	LinkDetails
 	CreatedDate *time.Time
 }
 
@@ -29,7 +32,32 @@ func (companyPerson *AssociateCompanyPerson) Validate() error {
 		return errors.NewValidationError(nil, "PersonID is empty")
 	}
 
-	return nil
+	// can return ValidationError
+	return companyPerson.LinkDetails.Validate()
+}
+
+type UpdateCompanyPerson struct {
+	CompanyID uuid.UUID
+	PersonID  uuid.UUID
+	LinkDetails
+}
+
+// Validate can return ValidationError
+func (companyPerson *UpdateCompanyPerson) Validate() error {
+	if companyPerson.CompanyID == uuid.Nil {
+		return errors.NewValidationError(nil, "CompanyID cannot be empty")
+	}
+
+	if companyPerson.PersonID == uuid.Nil {
+		return errors.NewValidationError(nil, "PersonID cannot be empty")
+	}
+
+	if companyPerson.LinkDetails.IsEmpty() {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	// can return ValidationError
+	return companyPerson.LinkDetails.Validate()
 }
 
 type DeleteCompanyPerson struct {
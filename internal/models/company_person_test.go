@@ -62,6 +62,52 @@ func TestAssociateCompanyPersonValidate_ShouldReturnValidationErrorIfPersonIDIsE
 	assert.Equal(t, "validation error: PersonID is empty", validationError.Error())
 }
 
+// -------- UpdateCompanyPerson.Validate tests: --------
+
+func TestUpdateCompanyPersonValidate_ShouldReturnNilIfUpdateCompanyPersonIsValid(t *testing.T) {
+	model := UpdateCompanyPerson{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+		LinkDetails: LinkDetails{
+			Role: testutil.ToPtr("Recruiter"),
+		},
+	}
+	err := model.Validate()
+	assert.NoError(t, err)
+}
+
+func TestUpdateCompanyPersonValidate_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	model := UpdateCompanyPerson{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
+func TestUpdateCompanyPersonValidate_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, -1, 0)
+	model := UpdateCompanyPerson{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+		LinkDetails: LinkDetails{
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		},
+	}
+	err := model.Validate()
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", validationError.Error())
+}
+
 // -------- DeleteCompanyPerson.Validate tests: --------
 
 func TestDeleteCompanyPersonValidate_ShouldReturnNilIfAssociateCompanyPersonIsValid(t *testing.T) {
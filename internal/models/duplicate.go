@@ -0,0 +1,64 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+
+	"github.com/google/uuid"
+)
+
+type DuplicateReason string
+
+const (
+	DuplicateReasonName  = "name"
+	DuplicateReasonEmail = "email"
+
+	// DuplicateReasonDomain is given to a pair whose names are similar, and whose email addresses have the same domain.
+	DuplicateReasonDomain = "domain"
+)
+
+func (duplicateReason DuplicateReason) String() string {
+	return string(duplicateReason)
+}
+
+// CompanyDuplicate is a pair of companies that are probably the same. Company is the older of the two, and the
+// suggested survivor of a merge.
+type CompanyDuplicate struct {
+	Company   *Company
+	Duplicate *Company
+	Score     float64
+	Reasons   []DuplicateReason
+}
+
+// PersonDuplicate is a pair of persons that are probably the same. Person is the older of the two, and the suggested
+// survivor of a merge.
+type PersonDuplicate struct {
+	Person    *Person
+	Duplicate *Person
+	Score     float64
+	Reasons   []DuplicateReason
+}
+
+// Merge moves every reference to DuplicateID onto SurvivorID, after which DuplicateID is deleted.
+type Merge struct {
+	SurvivorID  uuid.UUID
+	DuplicateID uuid.UUID
+}
+
+// Validate can return ValidationError
+func (merge *Merge) Validate() error {
+	if merge.SurvivorID == uuid.Nil {
+		survivorID := "SurvivorID"
+		return errors.NewValidationError(&survivorID, "SurvivorID is empty")
+	}
+
+	if merge.DuplicateID == uuid.Nil {
+		duplicateID := "DuplicateID"
+		return errors.NewValidationError(&duplicateID, "DuplicateID is empty")
+	}
+
+	if merge.SurvivorID == merge.DuplicateID {
+		return errors.NewValidationError(nil, "SurvivorID and DuplicateID cannot be the same")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- Merge.Validate tests: --------
+
+func TestMergeValidate_ShouldReturnNilIfMergeIsValid(t *testing.T) {
+	merge := Merge{SurvivorID: uuid.New(), DuplicateID: uuid.New()}
+	assert.NoError(t, merge.Validate())
+}
+
+func TestMergeValidate_ShouldReturnValidationErrorIfIDsAreMissingOrTheSame(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		testName      string
+		merge         Merge
+		expectedError string
+	}{
+		{
+			"empty SurvivorID",
+			Merge{DuplicateID: id},
+			"validation error on field 'SurvivorID': SurvivorID is empty",
+		},
+		{
+			"empty DuplicateID",
+			Merge{SurvivorID: id},
+			"validation error on field 'DuplicateID': DuplicateID is empty",
+		},
+		{
+			"same IDs",
+			Merge{SurvivorID: id, DuplicateID: id},
+			"validation error: SurvivorID and DuplicateID cannot be the same",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := test.merge.Validate()
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
@@ -2,32 +2,57 @@ package models
 
 import (
 	"jobsearchtracker/internal/errors"
+	"net/url"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Event struct {
-	ID           uuid.UUID
-	EventType    *EventType
-	Description  *string
-	Notes        *string
-	EventDate    *time.Time
-	CreatedDate  *time.Time
-	UpdatedDate  *time.Time
-	Applications *[]*Application
-	Companies    *[]*Company
-	Persons      *[]*Person
+	ID                uuid.UUID
+	EventType         *EventType
+	Description       *string
+	Notes             *string
+	EventDate         *time.Time
+	DurationMinutes   *int
+	Location          *string
+	MeetingURL        *string
+	InterviewFormat   *InterviewFormat
+	Timezone          *string
+	CreatedDate       *time.Time
+	UpdatedDate       *time.Time
+	Version           int
+	Applications      *[]*Application
+	Companies         *[]*Company
+	Persons           *[]*Person
+	OverlappingEvents []*Event
+}
+
+// DefaultBookedEventDuration is used for overlap detection of booked events without a duration.
+const DefaultBookedEventDuration = time.Hour
+
+// EndDate returns EventDate plus DurationMinutes, or nil if either is missing.
+func (event *Event) EndDate() *time.Time {
+	if event.EventDate == nil || event.DurationMinutes == nil {
+		return nil
+	}
+	endDate := event.EventDate.Add(time.Duration(*event.DurationMinutes) * time.Minute)
+	return &endDate
 }
 
 type CreateEvent struct {
-	ID          *uuid.UUID
-	EventType   EventType
-	Description *string
-	Notes       *string
-	EventDate   time.Time
-	CreatedDate *time.Time
-	UpdatedDate *time.Time
+	ID              *uuid.UUID
+	EventType       EventType
+	Description     *string
+	Notes           *string
+	EventDate       time.Time
+	DurationMinutes *int
+	Location        *string
+	MeetingURL      *string
+	InterviewFormat *InterviewFormat
+	Timezone        *string
+	CreatedDate     *time.Time
+	UpdatedDate     *time.Time
 }
 
 func (event CreateEvent) Validate() error {
@@ -52,6 +77,12 @@ func (event CreateEvent) Validate() error {
 			"event date is zero. It should be a recent date")
 	}
 
+	err := validateEventScheduling(
+		event.DurationMinutes, event.Location, event.MeetingURL, event.InterviewFormat, event.Timezone)
+	if err != nil {
+		return err
+	}
+
 	if event.CreatedDate != nil && event.CreatedDate.IsZero() {
 		createdDate := "createdDate"
 		return errors.NewValidationError(
@@ -70,11 +101,17 @@ func (event CreateEvent) Validate() error {
 }
 
 type UpdateEvent struct {
-	ID          uuid.UUID
-	EventType   *EventType
-	Description *string
-	Notes       *string
-	EventDate   *time.Time
+	ID              uuid.UUID
+	EventType       *EventType
+	Description     *string
+	Notes           *string
+	EventDate       *time.Time
+	DurationMinutes *int
+	Location        *string
+	MeetingURL      *string
+	InterviewFormat *InterviewFormat
+	Timezone        *string
+	ExpectedVersion *int
 }
 
 func (event UpdateEvent) Validate() error {
@@ -99,7 +136,8 @@ func (event UpdateEvent) Validate() error {
 			"event date is zero. It should either be 'nil' or a recent date")
 	}
 
-	return nil
+	return validateEventScheduling(
+		event.DurationMinutes, event.Location, event.MeetingURL, event.InterviewFormat, event.Timezone)
 }
 
 type EventType string
@@ -122,15 +160,124 @@ const (
 	EventTypeWithdrew                    = "withdrew"
 )
 
+// isValid reports whether eventType is in the event_type lookup table.
 func (eventType EventType) isValid() bool {
+	return IsKnownType(TypeKindEvent, string(eventType))
+}
+
+func (eventType EventType) String() string { return string(eventType) }
+
+// IsBooked reports whether an event of this type is an appointment in the future that can clash with other
+// appointments. Only built-in types are booked. A type added to the event_type table never is, as the table has no
+// column that tells which type completes it.
+func (eventType EventType) IsBooked() bool {
 	switch eventType {
-	case EventTypeApplied, EventTypeCallBooked, EventTypeCallCompleted, EventTypeCodeTestCompleted,
-		EventTypeCodeTestReceived, EventTypeInterviewBooked, EventTypeInterviewCompleted, EventTypePaused,
-		EventTypeOffer, EventTypeOther, EventTypeRecruiterInterviewBooked, EventTypeRecruiterInterviewCompleted,
-		EventTypeRejected, EventTypeSigned, EventTypeWithdrew:
+	case EventTypeCallBooked, EventTypeInterviewBooked, EventTypeRecruiterInterviewBooked:
 		return true
 	}
 	return false
 }
 
-func (eventType EventType) String() string { return string(eventType) }
+// CompletedEventType returns the type of the event that completes a booked event of this type, and false if this type
+// is not booked.
+func (eventType EventType) CompletedEventType() (EventType, bool) {
+	switch eventType {
+	case EventTypeCallBooked:
+		return EventTypeCallCompleted, true
+	case EventTypeInterviewBooked:
+		return EventTypeInterviewCompleted, true
+	case EventTypeRecruiterInterviewBooked:
+		return EventTypeRecruiterInterviewCompleted, true
+	}
+	return "", false
+}
+
+// BookedEventTypes lists every EventType for which IsBooked is true. These are all built-in types.
+var BookedEventTypes = []EventType{
+	EventTypeCallBooked, EventTypeInterviewBooked, EventTypeRecruiterInterviewBooked,
+}
+
+// EventFilter selects events. Empty fields do not filter. FromDate is inclusive and ToDate is exclusive.
+//
+// If OnlyNotCompleted is set, booked events are only included if no event of their CompletedEventType, dated at or
+// after them, is linked to one of the same applications or companies. Events of user-defined types are never booked,
+// so they are never excluded, and they never complete a booked event.
+type EventFilter struct {
+	ApplicationID    *uuid.UUID
+	CompanyID        *uuid.UUID
+	PersonID         *uuid.UUID
+	EventTypes       []EventType
+	FromDate         *time.Time
+	ToDate           *time.Time
+	OnlyNotCompleted bool
+}
+
+// InterviewFormat tells how an interview or call takes place.
+type InterviewFormat string
+
+const (
+	InterviewFormatPhone  = "phone"
+	InterviewFormatVideo  = "video"
+	InterviewFormatOnsite = "onsite"
+)
+
+func (interviewFormat InterviewFormat) IsValid() bool {
+	switch interviewFormat {
+	case InterviewFormatPhone, InterviewFormatVideo, InterviewFormatOnsite:
+		return true
+	}
+	return false
+}
+
+func (interviewFormat InterviewFormat) String() string { return string(interviewFormat) }
+
+// validateEventScheduling can return ValidationError
+func validateEventScheduling(
+	durationMinutes *int,
+	location *string,
+	meetingURL *string,
+	interviewFormat *InterviewFormat,
+	timezone *string) error {
+
+	if durationMinutes != nil && *durationMinutes <= 0 {
+		name := "durationMinutes"
+		return errors.NewValidationError(&name, "duration minutes must be greater than 0")
+	}
+
+	if location != nil && *location == "" {
+		name := "location"
+		return errors.NewValidationError(&name, "location is empty. It should either be 'nil' or a location")
+	}
+
+	if meetingURL != nil {
+		parsedURL, err := url.Parse(*meetingURL)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+			name := "meetingURL"
+			return errors.NewValidationError(&name, "meeting URL must be an absolute http or https URL")
+		}
+	}
+
+	if interviewFormat != nil && !interviewFormat.IsValid() {
+		name := "interviewFormat"
+		return errors.NewValidationError(&name, "interview format is invalid")
+	}
+
+	if timezone != nil {
+		if _, err := LoadTimezone(*timezone); err != nil {
+			name := "timezone"
+			return errors.NewValidationError(
+				&name, "timezone '"+*timezone+"' is not a valid IANA timezone, such as 'Europe/Stockholm'")
+		}
+	}
+
+	return nil
+}
+
+// LoadTimezone returns the location of an IANA timezone name. Unlike time.LoadLocation, it does not accept "" or
+// "Local", as those depend on the machine the server runs on.
+func LoadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" || timezone == "Local" {
+		return nil, errors.NewValidationError(nil, "timezone must be an IANA timezone name")
+	}
+	return time.LoadLocation(timezone)
+}
@@ -8,14 +8,17 @@ import (
 )
 
 type EventPerson struct {
-	EventID     uuid.UUID
-	PersonID    uuid.UUID
+	EventID  uuid.UUID
+	PersonID uuid.UUID
+	LinkDetails
 	CreatedDate time.Time
+	UpdatedDate *time.Time
 }
 
 type AssociateEventPerson struct {
-	EventID     uuid.UUID
-	PersonID    uuid.UUID
+	EventID  uuid.UUID
+	PersonID uuid.UUID
+	LinkDetails
 	CreatedDate *time.Time
 }
 
@@ -27,7 +30,32 @@ func (eventPerson *AssociateEventPerson) Validate() error {
 	if eventPerson.PersonID == uuid.Nil {
 		return errors.NewValidationError(nil, "PersonID is empty")
 	}
-	return nil
+	// can return ValidationError
+	return eventPerson.LinkDetails.Validate()
+}
+
+type UpdateEventPerson struct {
+	EventID  uuid.UUID
+	PersonID uuid.UUID
+	LinkDetails
+}
+
+// Validate can return ValidationError
+func (eventPerson *UpdateEventPerson) Validate() error {
+	if eventPerson.EventID == uuid.Nil {
+		return errors.NewValidationError(nil, "EventID cannot be empty")
+	}
+
+	if eventPerson.PersonID == uuid.Nil {
+		return errors.NewValidationError(nil, "PersonID cannot be empty")
+	}
+
+	if eventPerson.LinkDetails.IsEmpty() {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	// can return ValidationError
+	return eventPerson.LinkDetails.Validate()
 }
 
 type DeleteEventPerson struct {
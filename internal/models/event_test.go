@@ -232,3 +232,89 @@ func TestEventTypeIsValid_ShouldReturnFalseOnInvalidEventType(t *testing.T) {
 	var eventType EventType = "unknown"
 	assert.False(t, eventType.isValid())
 }
+
+// -------- Event scheduling tests: --------
+
+func TestCreateEventValidate_ShouldReturnValidationErrorOnInvalidSchedulingFields(t *testing.T) {
+	var invalidFormat InterviewFormat = "carrierPigeon"
+
+	tests := []struct {
+		testName      string
+		modify        func(event *CreateEvent)
+		expectedError string
+	}{
+		{
+			"zero duration",
+			func(event *CreateEvent) { event.DurationMinutes = testutil.ToPtr(0) },
+			"validation error on field 'durationMinutes': duration minutes must be greater than 0",
+		},
+		{
+			"empty location",
+			func(event *CreateEvent) { event.Location = testutil.ToPtr("") },
+			"validation error on field 'location': location is empty. It should either be 'nil' or a location",
+		},
+		{
+			"relative meeting URL",
+			func(event *CreateEvent) { event.MeetingURL = testutil.ToPtr("meet/abc") },
+			"validation error on field 'meetingURL': meeting URL must be an absolute http or https URL",
+		},
+		{
+			"invalid interview format",
+			func(event *CreateEvent) { event.InterviewFormat = &invalidFormat },
+			"validation error on field 'interviewFormat': interview format is invalid",
+		},
+		{
+			"invalid timezone",
+			func(event *CreateEvent) { event.Timezone = testutil.ToPtr("Mars/Olympus_Mons") },
+			"validation error on field 'timezone': timezone 'Mars/Olympus_Mons' is not a valid IANA timezone, " +
+				"such as 'Europe/Stockholm'",
+		},
+		{
+			"local timezone",
+			func(event *CreateEvent) { event.Timezone = testutil.ToPtr("Local") },
+			"validation error on field 'timezone': timezone 'Local' is not a valid IANA timezone, " +
+				"such as 'Europe/Stockholm'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			event := CreateEvent{EventType: EventTypeInterviewBooked, EventDate: time.Now()}
+			test.modify(&event)
+			err := event.Validate()
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
+
+func TestUpdateEventValidate_ShouldAcceptOnlySchedulingFields(t *testing.T) {
+	var interviewFormat InterviewFormat = InterviewFormatPhone
+	event := UpdateEvent{
+		ID:              uuid.New(),
+		DurationMinutes: testutil.ToPtr(30),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("Asia/Tokyo"),
+	}
+	assert.NoError(t, event.Validate())
+}
+
+func TestEventEndDate_ShouldAddDurationToEventDate(t *testing.T) {
+	eventDate := time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	event := Event{EventDate: &eventDate}
+	assert.Nil(t, event.EndDate())
+
+	event.DurationMinutes = testutil.ToPtr(90)
+	assert.Equal(t, eventDate.Add(90*time.Minute), *event.EndDate())
+}
+
+func TestEventTypeIsBooked_ShouldOnlyBeTrueForBookedEventTypes(t *testing.T) {
+	for _, eventType := range BookedEventTypes {
+		assert.True(t, eventType.IsBooked(), eventType.String())
+	}
+	assert.False(t, EventType(EventTypeInterviewCompleted).IsBooked())
+	assert.False(t, EventType(EventTypeApplied).IsBooked())
+}
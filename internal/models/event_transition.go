@@ -0,0 +1,116 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"slices"
+	"strings"
+)
+
+// EventTransitionMode tells what happens when an event breaks the EventTransitionRules of one of its applications.
+type EventTransitionMode string
+
+const (
+	// EventTransitionModeStrict rejects the event with a ValidationError.
+	EventTransitionModeStrict = "strict"
+	// EventTransitionModeWarn accepts the event and logs a warning.
+	EventTransitionModeWarn = "warn"
+)
+
+func (mode EventTransitionMode) IsValid() bool {
+	switch mode {
+	case EventTransitionModeStrict, EventTransitionModeWarn:
+		return true
+	}
+	return false
+}
+
+func (mode EventTransitionMode) String() string { return string(mode) }
+
+// EventTransitionRules are the rules that the events of a single application should follow.
+type EventTransitionRules struct {
+	// Prerequisites maps an EventType to the types of which at least one event must be dated at or before it.
+	Prerequisites map[EventType][]EventType
+	// FinalEventTypes close an application. Only events of a type in AllowedAfterFinal may be dated after them.
+	FinalEventTypes   []EventType
+	AllowedAfterFinal []EventType
+}
+
+// DefaultEventTransitionRules require a booking before a completed call or interview, a received code test before a
+// completed one, and an offer before signing. Nothing but other events may follow a rejection, withdrawal or signing.
+var DefaultEventTransitionRules = EventTransitionRules{
+	Prerequisites: map[EventType][]EventType{
+		EventTypeCallCompleted:               {EventTypeCallBooked},
+		EventTypeInterviewCompleted:          {EventTypeInterviewBooked},
+		EventTypeRecruiterInterviewCompleted: {EventTypeRecruiterInterviewBooked},
+		EventTypeCodeTestCompleted:           {EventTypeCodeTestReceived},
+		EventTypeSigned:                      {EventTypeOffer},
+	},
+	FinalEventTypes:   []EventType{EventTypeRejected, EventTypeWithdrew, EventTypeSigned},
+	AllowedAfterFinal: []EventType{EventTypeOther},
+}
+
+// Check returns the first rule that event breaks, given the other events of the same application. Events without a
+// type or a date are ignored, as is event itself if it is among otherEvents. Breaking a rule that does not involve
+// event is not reported.
+//
+// Check can return ValidationError
+func (rules *EventTransitionRules) Check(event *Event, otherEvents []*Event) error {
+	if event == nil || event.EventType == nil || event.EventDate == nil {
+		return nil
+	}
+
+	eventTypeField := "eventType"
+	eventType := *event.EventType
+
+	var comparableEvents []*Event
+	for _, otherEvent := range otherEvents {
+		if otherEvent.ID != event.ID && otherEvent.EventType != nil && otherEvent.EventDate != nil {
+			comparableEvents = append(comparableEvents, otherEvent)
+		}
+	}
+
+	if prerequisites, hasPrerequisites := rules.Prerequisites[eventType]; hasPrerequisites {
+		hasPrerequisite := slices.ContainsFunc(comparableEvents, func(otherEvent *Event) bool {
+			return slices.Contains(prerequisites, *otherEvent.EventType) && !otherEvent.EventDate.After(*event.EventDate)
+		})
+		if !hasPrerequisite {
+			prerequisiteNames := make([]string, len(prerequisites))
+			for index, prerequisite := range prerequisites {
+				prerequisiteNames[index] = prerequisite.String()
+			}
+			return errors.NewValidationError(
+				&eventTypeField,
+				eventType.String()+" requires an earlier "+strings.Join(prerequisiteNames, " or ")+" event")
+		}
+	}
+
+	isFinal := slices.Contains(rules.FinalEventTypes, eventType)
+	isAllowedAfterFinal := slices.Contains(rules.AllowedAfterFinal, eventType)
+
+	for _, otherEvent := range comparableEvents {
+		if !isAllowedAfterFinal &&
+			slices.Contains(rules.FinalEventTypes, *otherEvent.EventType) &&
+			otherEvent.EventDate.Before(*event.EventDate) {
+
+			return errors.NewValidationError(
+				&eventTypeField,
+				"the application was closed by a "+otherEvent.EventType.String()+" event on "+
+					otherEvent.EventDate.Format(eventTransitionDateFormat)+", so no "+eventType.String()+
+					" event can follow it")
+		}
+
+		if isFinal &&
+			!slices.Contains(rules.AllowedAfterFinal, *otherEvent.EventType) &&
+			otherEvent.EventDate.After(*event.EventDate) {
+
+			return errors.NewValidationError(
+				&eventTypeField,
+				eventType.String()+" would close the application before its "+otherEvent.EventType.String()+
+					" event on "+otherEvent.EventDate.Format(eventTransitionDateFormat))
+		}
+	}
+
+	return nil
+}
+
+const eventTransitionDateFormat = "2006-01-02 15:04"
@@ -0,0 +1,157 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTransitionEvent(eventType EventType, eventDate time.Time) *Event {
+	return &Event{ID: uuid.New(), EventType: &eventType, EventDate: &eventDate}
+}
+
+// -------- EventTransitionRules.Check tests: --------
+
+func TestEventTransitionRulesCheck_ShouldAcceptEventsThatFollowTheRules(t *testing.T) {
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName    string
+		event       *Event
+		otherEvents []*Event
+	}{
+		{
+			"event without prerequisites or final events",
+			newTransitionEvent(EventTypeApplied, day),
+			nil,
+		},
+		{
+			"completed interview after its booking",
+			newTransitionEvent(EventTypeInterviewCompleted, day),
+			[]*Event{newTransitionEvent(EventTypeInterviewBooked, day.AddDate(0, 0, -1))},
+		},
+		{
+			"signed on the same day as the offer",
+			newTransitionEvent(EventTypeSigned, day),
+			[]*Event{newTransitionEvent(EventTypeOffer, day)},
+		},
+		{
+			"other event after a rejection",
+			newTransitionEvent(EventTypeOther, day),
+			[]*Event{newTransitionEvent(EventTypeRejected, day.AddDate(0, 0, -1))},
+		},
+		{
+			"event before a rejection",
+			newTransitionEvent(EventTypeCallBooked, day),
+			[]*Event{newTransitionEvent(EventTypeRejected, day.AddDate(0, 0, 1))},
+		},
+		{
+			"rejection followed only by other events",
+			newTransitionEvent(EventTypeRejected, day),
+			[]*Event{newTransitionEvent(EventTypeOther, day.AddDate(0, 0, 1))},
+		},
+		{
+			"event without a type",
+			&Event{ID: uuid.New(), EventDate: &day},
+			[]*Event{newTransitionEvent(EventTypeRejected, day.AddDate(0, 0, -1))},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.NoError(t, DefaultEventTransitionRules.Check(test.event, test.otherEvents))
+		})
+	}
+}
+
+func TestEventTransitionRulesCheck_ShouldReturnValidationErrorForBrokenRules(t *testing.T) {
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName      string
+		event         *Event
+		otherEvents   []*Event
+		expectedError string
+	}{
+		{
+			"completed interview without a booking",
+			newTransitionEvent(EventTypeInterviewCompleted, day),
+			[]*Event{newTransitionEvent(EventTypeApplied, day.AddDate(0, 0, -1))},
+			"validation error on field 'eventType': interviewCompleted requires an earlier interviewBooked event",
+		},
+		{
+			"completed call before its booking",
+			newTransitionEvent(EventTypeCallCompleted, day),
+			[]*Event{newTransitionEvent(EventTypeCallBooked, day.AddDate(0, 0, 1))},
+			"validation error on field 'eventType': callCompleted requires an earlier callBooked event",
+		},
+		{
+			"signed after a rejection",
+			newTransitionEvent(EventTypeSigned, day),
+			[]*Event{
+				newTransitionEvent(EventTypeOffer, day.AddDate(0, 0, -2)),
+				newTransitionEvent(EventTypeRejected, day.AddDate(0, 0, -1)),
+			},
+			"validation error on field 'eventType': the application was closed by a rejected event on " +
+				"2026-03-01 10:00, so no signed event can follow it",
+		},
+		{
+			"withdrawal before a booked interview",
+			newTransitionEvent(EventTypeWithdrew, day),
+			[]*Event{newTransitionEvent(EventTypeInterviewBooked, day.AddDate(0, 0, 1))},
+			"validation error on field 'eventType': withdrew would close the application before its " +
+				"interviewBooked event on 2026-03-03 10:00",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := DefaultEventTransitionRules.Check(test.event, test.otherEvents)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, test.expectedError, err.Error())
+		})
+	}
+}
+
+func TestEventTransitionRulesCheck_ShouldIgnoreTheEventItselfAmongOtherEvents(t *testing.T) {
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	previousVersion := newTransitionEvent(EventTypeRejected, day.AddDate(0, 0, -1))
+	event := newTransitionEvent(EventTypeInterviewBooked, day)
+	event.ID = previousVersion.ID
+
+	assert.NoError(t, DefaultEventTransitionRules.Check(event, []*Event{previousVersion}))
+}
+
+func TestEventTransitionRulesCheck_ShouldUseCustomRules(t *testing.T) {
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	rules := EventTransitionRules{
+		Prerequisites: map[EventType][]EventType{EventTypeOffer: {EventTypeInterviewCompleted, EventTypeCallCompleted}},
+	}
+
+	err := rules.Check(newTransitionEvent(EventTypeOffer, day), nil)
+	assert.Equal(
+		t,
+		"validation error on field 'eventType': offer requires an earlier interviewCompleted or callCompleted event",
+		err.Error())
+
+	err = rules.Check(
+		newTransitionEvent(EventTypeOffer, day),
+		[]*Event{newTransitionEvent(EventTypeCallCompleted, day.AddDate(0, 0, -1))})
+	assert.NoError(t, err)
+}
+
+// -------- EventTransitionMode tests: --------
+
+func TestEventTransitionModeIsValid(t *testing.T) {
+	assert.True(t, EventTransitionMode(EventTransitionModeStrict).IsValid())
+	assert.True(t, EventTransitionMode(EventTransitionModeWarn).IsValid())
+	assert.False(t, EventTransitionMode("").IsValid())
+	assert.False(t, EventTransitionMode("lenient").IsValid())
+}
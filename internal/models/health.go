@@ -0,0 +1,16 @@
+package models
+
+type Readiness struct {
+	IsDatabaseReachable bool
+	DatabaseError       *string
+	Migration           *MigrationStatus
+	MigrationError      *string
+}
+
+// IsReady is true when the database can be reached and every migration has been applied cleanly.
+func (readiness *Readiness) IsReady() bool {
+	return readiness.IsDatabaseReachable &&
+		readiness.Migration != nil &&
+		!readiness.Migration.Dirty &&
+		len(readiness.Migration.PendingVersions) == 0
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// MaxIdempotencyKeyLength is the length of the longest Idempotency-Key header that is accepted.
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyKey is a request sent with an Idempotency-Key header, and the response to it. A key is scoped to the
+// path it was sent to. StatusCode is nil while the request is being handled.
+type IdempotencyKey struct {
+	Key          string
+	Path         string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  *string
+	ResponseBody []byte
+	CreatedDate  time.Time
+	ExpiryDate   time.Time
+}
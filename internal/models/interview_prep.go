@@ -0,0 +1,194 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InterviewPrep is the structured preparation for, and reflection on, an interview or a code test. It belongs to an
+// event of type interviewCompleted or codeTestCompleted, and uses the ID of that event as its own.
+type InterviewPrep struct {
+	EventID         uuid.UUID
+	TechnicalTopics *string
+	SelfRating      *int
+	Notes           *string
+	CreatedDate     *time.Time
+	UpdatedDate     *time.Time
+	Version         int
+	Questions       []*InterviewQuestion
+}
+
+type CreateInterviewPrep struct {
+	EventID         uuid.UUID
+	TechnicalTopics *string
+	SelfRating      *int
+	Notes           *string
+	CreatedDate     *time.Time
+}
+
+// Validate can return ValidationError
+func (prep *CreateInterviewPrep) Validate() error {
+	if prep.EventID == uuid.Nil {
+		eventID := "EventID"
+		return errors.NewValidationError(&eventID, "EventID is empty")
+	}
+
+	if prep.SelfRating != nil && !isValidSelfRating(*prep.SelfRating) {
+		selfRating := "SelfRating"
+		return errors.NewValidationError(&selfRating, "SelfRating must be between 1 and 5")
+	}
+
+	if prep.CreatedDate != nil && prep.CreatedDate.IsZero() {
+		createdDate := "CreatedDate"
+		return errors.NewValidationError(
+			&createdDate,
+			"CreatedDate is zero. It should either be 'nil' or a recent date. Given that this is an insert, it is recommended to use nil")
+	}
+
+	return nil
+}
+
+type UpdateInterviewPrep struct {
+	EventID         uuid.UUID
+	TechnicalTopics *string
+	SelfRating      *int
+	Notes           *string
+	ExpectedVersion *int
+}
+
+// Validate can return ValidationError
+func (prep *UpdateInterviewPrep) Validate() error {
+	if prep.EventID == uuid.Nil {
+		eventID := "EventID"
+		return errors.NewValidationError(&eventID, "EventID is empty")
+	}
+
+	if prep.TechnicalTopics == nil && prep.SelfRating == nil && prep.Notes == nil {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	if prep.SelfRating != nil && !isValidSelfRating(*prep.SelfRating) {
+		selfRating := "SelfRating"
+		return errors.NewValidationError(&selfRating, "SelfRating must be between 1 and 5")
+	}
+
+	return nil
+}
+
+// InterviewQuestion is a question from an interview or a code test. EventType and EventDate are those of the event
+// the question belongs to.
+type InterviewQuestion struct {
+	ID           uuid.UUID
+	EventID      uuid.UUID
+	QuestionType InterviewQuestionType
+	Question     string
+	Answer       *string
+	Topic        *string
+	EventType    EventType
+	EventDate    *time.Time
+	CreatedDate  *time.Time
+	UpdatedDate  *time.Time
+}
+
+type CreateInterviewQuestion struct {
+	ID           *uuid.UUID
+	EventID      uuid.UUID
+	QuestionType InterviewQuestionType
+	Question     string
+	Answer       *string
+	Topic        *string
+	CreatedDate  *time.Time
+}
+
+// Validate can return ValidationError
+func (question *CreateInterviewQuestion) Validate() error {
+	if question.ID != nil && *question.ID == uuid.Nil {
+		id := "ID"
+		return errors.NewValidationError(&id, "ID is empty. It should either be 'nil' or a valid UUID")
+	}
+
+	if question.EventID == uuid.Nil {
+		eventID := "EventID"
+		return errors.NewValidationError(&eventID, "EventID is empty")
+	}
+
+	if !question.QuestionType.IsValid() {
+		questionType := "QuestionType"
+		return errors.NewValidationError(&questionType, "QuestionType is invalid")
+	}
+
+	if question.Question == "" {
+		questionField := "Question"
+		return errors.NewValidationError(&questionField, "Question is empty")
+	}
+
+	if question.CreatedDate != nil && question.CreatedDate.IsZero() {
+		createdDate := "CreatedDate"
+		return errors.NewValidationError(
+			&createdDate,
+			"CreatedDate is zero. It should either be 'nil' or a recent date. Given that this is an insert, it is recommended to use nil")
+	}
+
+	return nil
+}
+
+type UpdateInterviewQuestion struct {
+	ID           uuid.UUID
+	QuestionType *InterviewQuestionType
+	Question     *string
+	Answer       *string
+	Topic        *string
+}
+
+// Validate can return ValidationError
+func (question *UpdateInterviewQuestion) Validate() error {
+	if question.ID == uuid.Nil {
+		id := "ID"
+		return errors.NewValidationError(&id, "ID is empty")
+	}
+
+	if question.QuestionType == nil && question.Question == nil && question.Answer == nil && question.Topic == nil {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	if question.QuestionType != nil && !question.QuestionType.IsValid() {
+		questionType := "QuestionType"
+		return errors.NewValidationError(&questionType, "QuestionType is invalid")
+	}
+
+	if question.Question != nil && *question.Question == "" {
+		questionField := "Question"
+		return errors.NewValidationError(&questionField, "Question is empty")
+	}
+
+	return nil
+}
+
+// InterviewQuestionType tells who asked an interview question.
+type InterviewQuestionType string
+
+const (
+	InterviewQuestionTypeAskedToUs = "askedToUs"
+	InterviewQuestionTypeAskedByUs = "askedByUs"
+)
+
+func (questionType InterviewQuestionType) IsValid() bool {
+	switch questionType {
+	case InterviewQuestionTypeAskedToUs, InterviewQuestionTypeAskedByUs:
+		return true
+	}
+	return false
+}
+
+func (questionType InterviewQuestionType) String() string { return string(questionType) }
+
+// HasInterviewPrep reports whether an event of this type can have an InterviewPrep.
+func (eventType EventType) HasInterviewPrep() bool {
+	return eventType == EventTypeInterviewCompleted || eventType == EventTypeCodeTestCompleted
+}
+
+func isValidSelfRating(selfRating int) bool {
+	return selfRating >= 1 && selfRating <= 5
+}
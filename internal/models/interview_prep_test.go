@@ -0,0 +1,132 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- CreateInterviewPrep.Validate tests: --------
+
+func TestCreateInterviewPrepValidate_ShouldReturnValidationErrorIfPrepIsInvalid(t *testing.T) {
+	zeroRating := 0
+	tooHighRating := 6
+	zeroDate := time.Time{}
+
+	tests := []struct {
+		testName      string
+		prep          CreateInterviewPrep
+		expectedError string
+	}{
+		{
+			"empty EventID",
+			CreateInterviewPrep{},
+			"validation error on field 'EventID': EventID is empty",
+		},
+		{
+			"SelfRating too low",
+			CreateInterviewPrep{EventID: uuid.New(), SelfRating: &zeroRating},
+			"validation error on field 'SelfRating': SelfRating must be between 1 and 5",
+		},
+		{
+			"SelfRating too high",
+			CreateInterviewPrep{EventID: uuid.New(), SelfRating: &tooHighRating},
+			"validation error on field 'SelfRating': SelfRating must be between 1 and 5",
+		},
+		{
+			"zero CreatedDate",
+			CreateInterviewPrep{EventID: uuid.New(), CreatedDate: &zeroDate},
+			"validation error on field 'CreatedDate': CreatedDate is zero. It should either be 'nil' or a recent date. " +
+				"Given that this is an insert, it is recommended to use nil",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := test.prep.Validate()
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
+
+// -------- UpdateInterviewPrep.Validate tests: --------
+
+func TestUpdateInterviewPrepValidate_ShouldReturnValidationErrorIfThereIsNothingToUpdate(t *testing.T) {
+	prep := UpdateInterviewPrep{EventID: uuid.New()}
+
+	err := prep.Validate()
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
+// -------- CreateInterviewQuestion.Validate tests: --------
+
+func TestCreateInterviewQuestionValidate_ShouldReturnValidationErrorIfQuestionIsInvalid(t *testing.T) {
+	validQuestion := func() CreateInterviewQuestion {
+		return CreateInterviewQuestion{
+			EventID:      uuid.New(),
+			QuestionType: InterviewQuestionTypeAskedToUs,
+			Question:     "Why us?",
+		}
+	}
+
+	nilID := uuid.Nil
+
+	tests := []struct {
+		testName      string
+		modify        func(question *CreateInterviewQuestion)
+		expectedError string
+	}{
+		{
+			"empty ID",
+			func(question *CreateInterviewQuestion) { question.ID = &nilID },
+			"validation error on field 'ID': ID is empty. It should either be 'nil' or a valid UUID",
+		},
+		{
+			"empty EventID",
+			func(question *CreateInterviewQuestion) { question.EventID = uuid.Nil },
+			"validation error on field 'EventID': EventID is empty",
+		},
+		{
+			"invalid QuestionType",
+			func(question *CreateInterviewQuestion) { question.QuestionType = "askedByNobody" },
+			"validation error on field 'QuestionType': QuestionType is invalid",
+		},
+		{
+			"empty Question",
+			func(question *CreateInterviewQuestion) { question.Question = "" },
+			"validation error on field 'Question': Question is empty",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			question := validQuestion()
+			assert.NoError(t, question.Validate())
+
+			test.modify(&question)
+			err := question.Validate()
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
+
+// -------- EventType.HasInterviewPrep tests: --------
+
+func TestEventTypeHasInterviewPrep_ShouldOnlyBeTrueForInterviewsAndCodeTests(t *testing.T) {
+	assert.True(t, EventType(EventTypeInterviewCompleted).HasInterviewPrep())
+	assert.True(t, EventType(EventTypeCodeTestCompleted).HasInterviewPrep())
+	assert.False(t, EventType(EventTypeApplied).HasInterviewPrep())
+	assert.False(t, EventType(EventTypeInterviewBooked).HasInterviewPrep())
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobAdPosting holds what could be read from a saved job ad. Fields that were not found are nil.
+type JobAdPosting struct {
+	JobTitle         *string
+	CompanyName      *string
+	JobAdURL         *string
+	Country          *string
+	Area             *string
+	RemoteStatusType RemoteStatusType
+	Salary           *string
+}
+
+// JobAdSnapshot is a copy of a job ad, kept so that the ad can be read after it has been taken down.
+type JobAdSnapshot struct {
+	ID            uuid.UUID
+	ApplicationID uuid.UUID
+	SourceType    JobAdSourceType
+	Content       *string
+	JobAdPosting
+	CreatedDate *time.Time
+}
+
+type CreateJobAdSnapshot struct {
+	ID            *uuid.UUID
+	ApplicationID uuid.UUID
+	SourceType    JobAdSourceType
+	Content       string
+	JobAdPosting
+	CreatedDate *time.Time
+}
+
+// Validate can return ValidationError
+func (snapshot *CreateJobAdSnapshot) Validate() error {
+	if snapshot.ID != nil && *snapshot.ID == uuid.Nil {
+		id := "ID"
+		return errors.NewValidationError(&id, "ID is empty. It should either be 'nil' or a valid UUID")
+	}
+
+	if snapshot.ApplicationID == uuid.Nil {
+		applicationID := "ApplicationID"
+		return errors.NewValidationError(&applicationID, "ApplicationID is empty")
+	}
+
+	if !snapshot.SourceType.IsValid() {
+		sourceType := "SourceType"
+		return errors.NewValidationError(&sourceType, "SourceType is invalid")
+	}
+
+	if snapshot.Content == "" {
+		content := "Content"
+		return errors.NewValidationError(&content, "Content is empty")
+	}
+
+	if !snapshot.RemoteStatusType.IsValid() {
+		remoteStatusType := "RemoteStatusType"
+		return errors.NewValidationError(&remoteStatusType, "RemoteStatusType is invalid")
+	}
+
+	if snapshot.CreatedDate != nil && snapshot.CreatedDate.IsZero() {
+		createdDate := "CreatedDate"
+		return errors.NewValidationError(
+			&createdDate,
+			"CreatedDate is zero. It should either be 'nil' or a recent date. Given that this is an insert, it is recommended to use nil")
+	}
+
+	return nil
+}
+
+// JobAdSourceType is the format of a saved job ad.
+type JobAdSourceType string
+
+const (
+	JobAdSourceTypeHTML   = "html"
+	JobAdSourceTypeJSONLD = "jsonLd"
+)
+
+func (sourceType JobAdSourceType) IsValid() bool {
+	switch sourceType {
+	case JobAdSourceTypeHTML, JobAdSourceTypeJSONLD:
+		return true
+	}
+	return false
+}
+
+func (sourceType JobAdSourceType) String() string {
+	return string(sourceType)
+}
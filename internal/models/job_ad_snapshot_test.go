@@ -0,0 +1,87 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- CreateJobAdSnapshot.Validate tests: --------
+
+func TestCreateJobAdSnapshotValidate_ShouldReturnNilIfSnapshotIsValid(t *testing.T) {
+	snapshot := CreateJobAdSnapshot{
+		ApplicationID: uuid.New(),
+		SourceType:    JobAdSourceTypeHTML,
+		Content:       "<html></html>",
+		JobAdPosting:  JobAdPosting{RemoteStatusType: RemoteStatusTypeUnknown},
+	}
+	assert.NoError(t, snapshot.Validate())
+}
+
+func TestCreateJobAdSnapshotValidate_ShouldReturnValidationErrorIfSnapshotIsInvalid(t *testing.T) {
+	validSnapshot := func() CreateJobAdSnapshot {
+		return CreateJobAdSnapshot{
+			ApplicationID: uuid.New(),
+			SourceType:    JobAdSourceTypeJSONLD,
+			Content:       "{}",
+			JobAdPosting:  JobAdPosting{RemoteStatusType: RemoteStatusTypeRemote},
+		}
+	}
+
+	nilID := uuid.Nil
+	zeroDate := time.Time{}
+
+	tests := []struct {
+		testName      string
+		modify        func(snapshot *CreateJobAdSnapshot)
+		expectedError string
+	}{
+		{
+			"empty ID",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.ID = &nilID },
+			"validation error on field 'ID': ID is empty. It should either be 'nil' or a valid UUID",
+		},
+		{
+			"empty ApplicationID",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.ApplicationID = uuid.Nil },
+			"validation error on field 'ApplicationID': ApplicationID is empty",
+		},
+		{
+			"invalid SourceType",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.SourceType = "pdf" },
+			"validation error on field 'SourceType': SourceType is invalid",
+		},
+		{
+			"empty Content",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.Content = "" },
+			"validation error on field 'Content': Content is empty",
+		},
+		{
+			"invalid RemoteStatusType",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.RemoteStatusType = "" },
+			"validation error on field 'RemoteStatusType': RemoteStatusType is invalid",
+		},
+		{
+			"zero CreatedDate",
+			func(snapshot *CreateJobAdSnapshot) { snapshot.CreatedDate = &zeroDate },
+			"validation error on field 'CreatedDate': CreatedDate is zero. It should either be 'nil' or a recent date. " +
+				"Given that this is an insert, it is recommended to use nil",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			snapshot := validSnapshot()
+			test.modify(&snapshot)
+			err := snapshot.Validate()
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
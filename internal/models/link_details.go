@@ -0,0 +1,43 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"time"
+)
+
+// LinkDetails holds what is known about a link between two entities, such as the role a person had at a company and
+// the period in which they had it. All fields are optional.
+type LinkDetails struct {
+	Role      *string
+	Notes     *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// IsEmpty returns true if none of the details are set.
+func (linkDetails *LinkDetails) IsEmpty() bool {
+	return linkDetails.Role == nil && linkDetails.Notes == nil &&
+		linkDetails.StartDate == nil && linkDetails.EndDate == nil
+}
+
+// Validate can return ValidationError
+func (linkDetails *LinkDetails) Validate() error {
+	if linkDetails.StartDate != nil && linkDetails.StartDate.IsZero() {
+		startDate := "StartDate"
+		return errors.NewValidationError(&startDate, "StartDate is zero. It should either be 'nil' or a valid date")
+	}
+
+	if linkDetails.EndDate != nil && linkDetails.EndDate.IsZero() {
+		endDate := "EndDate"
+		return errors.NewValidationError(&endDate, "EndDate is zero. It should either be 'nil' or a valid date")
+	}
+
+	if linkDetails.StartDate != nil && linkDetails.EndDate != nil &&
+		linkDetails.EndDate.Before(*linkDetails.StartDate) {
+
+		endDate := "EndDate"
+		return errors.NewValidationError(&endDate, "EndDate cannot be before StartDate")
+	}
+
+	return nil
+}
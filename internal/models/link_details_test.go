@@ -0,0 +1,65 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/testutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- LinkDetails.IsEmpty tests: --------
+
+func TestLinkDetailsIsEmpty_ShouldReturnTrueIfNoDetailsAreSet(t *testing.T) {
+	linkDetails := LinkDetails{}
+	assert.True(t, linkDetails.IsEmpty())
+}
+
+func TestLinkDetailsIsEmpty_ShouldReturnFalseIfADetailIsSet(t *testing.T) {
+	linkDetails := LinkDetails{Notes: testutil.ToPtr("")}
+	assert.False(t, linkDetails.IsEmpty())
+}
+
+// -------- LinkDetails.Validate tests: --------
+
+func TestLinkDetailsValidate_ShouldReturnNilIfLinkDetailsAreValid(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	linkDetails := LinkDetails{
+		Role:      testutil.ToPtr("Hiring manager"),
+		Notes:     testutil.ToPtr("Introduced by a former colleague"),
+		StartDate: &startDate,
+		EndDate:   &startDate,
+	}
+	assert.NoError(t, linkDetails.Validate())
+}
+
+func TestLinkDetailsValidate_ShouldReturnNilIfOnlyOneDateIsSet(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	linkDetails := LinkDetails{StartDate: &startDate}
+	assert.NoError(t, linkDetails.Validate())
+}
+
+func TestLinkDetailsValidate_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.Add(-time.Hour)
+	linkDetails := LinkDetails{StartDate: &startDate, EndDate: &endDate}
+
+	err := linkDetails.Validate()
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", validationError.Error())
+}
+
+func TestLinkDetailsValidate_ShouldReturnValidationErrorIfStartDateIsZero(t *testing.T) {
+	linkDetails := LinkDetails{StartDate: &time.Time{}}
+
+	err := linkDetails.Validate()
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(
+		t,
+		"validation error on field 'StartDate': StartDate is zero. It should either be 'nil' or a valid date",
+		validationError.Error())
+}
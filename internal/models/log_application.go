@@ -0,0 +1,67 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+
+	"github.com/google/uuid"
+)
+
+// LogApplication describes a new application together with everything that is created alongside it.
+//
+// Company and Recruiter are created inline, and must have the same ID as Application.CompanyID and
+// Application.RecruiterID respectively. Persons are created and linked to the application, PersonIDs are existing
+// persons that are only linked. Event is created and linked to the application.
+type LogApplication struct {
+	Application *CreateApplication
+	Company     *CreateCompany
+	Recruiter   *CreateCompany
+	Persons     []*CreatePerson
+	PersonIDs   []uuid.UUID
+	Event       *CreateEvent
+
+	// Force creates the application even if an application with the same job ad URL, or the same company and job
+	// title, already exists.
+	Force bool
+}
+
+// Validate can return ValidationError
+func (logApplication *LogApplication) Validate() error {
+	if logApplication.Application == nil {
+		application := "Application"
+		return errors.NewValidationError(&application, "Application is nil")
+	}
+
+	if logApplication.Company != nil {
+		if logApplication.Company.ID == nil || logApplication.Application.CompanyID == nil ||
+			*logApplication.Company.ID != *logApplication.Application.CompanyID {
+
+			company := "Company"
+			return errors.NewValidationError(&company, "Company ID does not match Application CompanyID")
+		}
+	}
+
+	if logApplication.Recruiter != nil {
+		if logApplication.Recruiter.ID == nil || logApplication.Application.RecruiterID == nil ||
+			*logApplication.Recruiter.ID != *logApplication.Application.RecruiterID {
+
+			recruiter := "Recruiter"
+			return errors.NewValidationError(&recruiter, "Recruiter ID does not match Application RecruiterID")
+		}
+	}
+
+	for _, person := range logApplication.Persons {
+		if person == nil {
+			persons := "Persons"
+			return errors.NewValidationError(&persons, "Persons contains a nil person")
+		}
+	}
+
+	for _, personID := range logApplication.PersonIDs {
+		if personID == uuid.Nil {
+			personIDs := "PersonIDs"
+			return errors.NewValidationError(&personIDs, "PersonIDs contains an empty ID")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/testutil"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- LogApplication.Validate tests: --------
+
+func TestLogApplicationValidate_ShouldReturnNilIfLogApplicationIsValid(t *testing.T) {
+	companyID := uuid.New()
+	recruiterID := uuid.New()
+
+	logApplication := LogApplication{
+		Application: &CreateApplication{
+			CompanyID:        &companyID,
+			RecruiterID:      &recruiterID,
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: RemoteStatusTypeRemote,
+		},
+		Company:   &CreateCompany{ID: &companyID, Name: "Company", CompanyType: CompanyTypeEmployer},
+		Recruiter: &CreateCompany{ID: &recruiterID, Name: "Recruiter", CompanyType: CompanyTypeRecruiter},
+		Persons:   []*CreatePerson{{Name: "Person", PersonType: PersonTypeCTO}},
+		PersonIDs: []uuid.UUID{uuid.New()},
+		Event:     &CreateEvent{EventType: EventTypeApplied},
+	}
+
+	err := logApplication.Validate()
+	assert.NoError(t, err)
+}
+
+func TestLogApplicationValidate_ShouldReturnValidationErrors(t *testing.T) {
+	companyID := uuid.New()
+
+	tests := []struct {
+		testName       string
+		logApplication LogApplication
+		expectedError  string
+	}{
+		{
+			testName:       "nil Application",
+			logApplication: LogApplication{},
+			expectedError:  "validation error on field 'Application': Application is nil",
+		},
+		{
+			testName: "Company without ID",
+			logApplication: LogApplication{
+				Application: &CreateApplication{CompanyID: &companyID},
+				Company:     &CreateCompany{Name: "Company"},
+			},
+			expectedError: "validation error on field 'Company': Company ID does not match Application CompanyID",
+		},
+		{
+			testName: "Company ID differs from Application CompanyID",
+			logApplication: LogApplication{
+				Application: &CreateApplication{CompanyID: &companyID},
+				Company:     &CreateCompany{ID: testutil.ToPtr(uuid.New()), Name: "Company"},
+			},
+			expectedError: "validation error on field 'Company': Company ID does not match Application CompanyID",
+		},
+		{
+			testName: "Recruiter without Application RecruiterID",
+			logApplication: LogApplication{
+				Application: &CreateApplication{CompanyID: &companyID},
+				Recruiter:   &CreateCompany{ID: testutil.ToPtr(uuid.New()), Name: "Recruiter"},
+			},
+			expectedError: "validation error on field 'Recruiter': Recruiter ID does not match Application RecruiterID",
+		},
+		{
+			testName: "nil person",
+			logApplication: LogApplication{
+				Application: &CreateApplication{CompanyID: &companyID},
+				Persons:     []*CreatePerson{nil},
+			},
+			expectedError: "validation error on field 'Persons': Persons contains a nil person",
+		},
+		{
+			testName: "empty person ID",
+			logApplication: LogApplication{
+				Application: &CreateApplication{CompanyID: &companyID},
+				PersonIDs:   []uuid.UUID{uuid.Nil},
+			},
+			expectedError: "validation error on field 'PersonIDs': PersonIDs contains an empty ID",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := test.logApplication.Validate()
+			assert.Error(t, err)
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.expectedError, validationError.Error())
+		})
+	}
+}
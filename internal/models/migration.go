@@ -0,0 +1,14 @@
+package models
+
+type MigrationStatus struct {
+	Version         uint
+	Dirty           bool
+	LatestVersion   uint
+	AppliedVersions []uint
+	PendingVersions []uint
+}
+
+type MigrationResult struct {
+	Status     *MigrationStatus
+	BackupFile *string
+}
@@ -16,6 +16,7 @@ type Person struct {
 	Notes        *string
 	CreatedDate  *time.Time
 	UpdatedDate  *time.Time
+	Version      int
 	Companies    *[]*Company
 	Events       *[]*Event
 	Applications *[]*Application
@@ -67,12 +68,13 @@ func (person *CreatePerson) Validate() error {
 }
 
 type UpdatePerson struct {
-	ID         uuid.UUID
-	Name       *string
-	PersonType *PersonType
-	Email      *string
-	Phone      *string
-	Notes      *string
+	ID              uuid.UUID
+	Name            *string
+	PersonType      *PersonType
+	Email           *string
+	Phone           *string
+	Notes           *string
+	ExpectedVersion *int
 }
 
 // Validate can return ValidationError
@@ -101,13 +103,9 @@ const (
 	PersonTypeUnknown           = "unknown"
 )
 
+// IsValid reports whether personType is in the person_type lookup table.
 func (personType PersonType) IsValid() bool {
-	switch personType {
-	case PersonTypeCEO, PersonTypeCTO, PersonTypeDeveloper, PersonTypeExternalRecruiter, PersonTypeInternalRecruiter,
-		PersonTypeHR, PersonTypeJobAdvertiser, PersonTypeJobContact, PersonTypeOther, PersonTypeUnknown:
-		return true
-	}
-	return false
+	return IsKnownType(TypeKindPerson, string(personType))
 }
 
 func (personType PersonType) String() string { return string(personType) }
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// MaxIDsPerRequest is the largest number of records that can be fetched by ID in one request.
+const MaxIDsPerRequest = 100
+
+// ValidateIDs can return ValidationError
+func ValidateIDs(ids []uuid.UUID) error {
+	idsField := "IDs"
+
+	if len(ids) == 0 {
+		return errors.NewValidationError(&idsField, "IDs is empty")
+	}
+
+	if len(ids) > MaxIDsPerRequest {
+		return errors.NewValidationError(
+			&idsField,
+			"IDs cannot contain more than "+strconv.Itoa(MaxIDsPerRequest)+" IDs. Got "+strconv.Itoa(len(ids)))
+	}
+
+	for index, id := range ids {
+		if id == uuid.Nil {
+			field := "IDs[" + strconv.Itoa(index) + "]"
+			return errors.NewValidationError(&field, "ID cannot be the nil UUID")
+		}
+	}
+
+	return nil
+}
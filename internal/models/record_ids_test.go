@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- ValidateIDs tests: --------
+
+func TestValidateIDs_ShouldReturnNilForValidIDs(t *testing.T) {
+	maxIDs := make([]uuid.UUID, MaxIDsPerRequest)
+	for index := range maxIDs {
+		maxIDs[index] = uuid.New()
+	}
+
+	assert.NoError(t, ValidateIDs([]uuid.UUID{uuid.New()}))
+	assert.NoError(t, ValidateIDs(maxIDs))
+}
+
+func TestValidateIDs_ShouldReturnValidationErrorForInvalidIDs(t *testing.T) {
+	tooManyIDs := make([]uuid.UUID, MaxIDsPerRequest+1)
+	for index := range tooManyIDs {
+		tooManyIDs[index] = uuid.New()
+	}
+
+	tests := []struct {
+		testName      string
+		ids           []uuid.UUID
+		expectedError string
+	}{
+		{"no IDs", nil, "validation error on field 'IDs': IDs is empty"},
+		{
+			"too many IDs",
+			tooManyIDs,
+			"validation error on field 'IDs': IDs cannot contain more than 100 IDs. Got 101",
+		},
+		{
+			"nil UUID",
+			[]uuid.UUID{uuid.New(), uuid.Nil},
+			"validation error on field 'IDs[1]': ID cannot be the nil UUID",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := ValidateIDs(test.ids)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, test.expectedError, err.Error())
+		})
+	}
+}
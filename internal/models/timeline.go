@@ -0,0 +1,47 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// Timeline is the history of one application, company or person, oldest first. Title names its subject.
+type Timeline struct {
+	Title   string
+	Entries []*TimelineEntry
+}
+
+type TimelineEntryType string
+
+const (
+	TimelineEntryTypeCreated     = "created"
+	TimelineEntryTypeUpdated     = "updated"
+	TimelineEntryTypeEvent       = "event"
+	TimelineEntryTypeLinked      = "linked"
+	TimelineEntryTypeLinkUpdated = "linkUpdated"
+)
+
+func (timelineEntryType TimelineEntryType) String() string {
+	return string(timelineEntryType)
+}
+
+// TimelineEntry is one thing that happened at Date. One of Application, Company, Event and Person is set:
+//   - created and updated: the record that was created or updated.
+//   - event: the event, with its persons.
+//   - linked and linkUpdated: the record the subject was linked to. LinkDetails holds the details of the link.
+type TimelineEntry struct {
+	Date        time.Time
+	EntryType   TimelineEntryType
+	Application *Application
+	Company     *Company
+	Event       *Event
+	Person      *Person
+	LinkDetails *LinkDetails
+}
+
+// SortTimelineEntries sorts entries oldest first. Entries with the same date keep their order.
+func SortTimelineEntries(entries []*TimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- SortTimelineEntries tests: --------
+
+func TestSortTimelineEntries_ShouldSortOldestFirstAndKeepOrderOfSameDate(t *testing.T) {
+	date := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	created := &TimelineEntry{Date: date, EntryType: TimelineEntryTypeCreated}
+	linked := &TimelineEntry{Date: date, EntryType: TimelineEntryTypeLinked}
+	event := &TimelineEntry{Date: date.Add(-time.Hour), EntryType: TimelineEntryTypeEvent}
+	updated := &TimelineEntry{Date: date.Add(time.Hour), EntryType: TimelineEntryTypeUpdated}
+
+	entries := []*TimelineEntry{updated, created, linked, event}
+	SortTimelineEntries(entries)
+
+	assert.Equal(t, []*TimelineEntry{event, created, linked, updated}, entries)
+}
@@ -0,0 +1,197 @@
+package models
+
+import (
+	"jobsearchtracker/internal/errors"
+	"sync"
+	"time"
+)
+
+// TypeKind names one of the lookup tables of user-defined types.
+type TypeKind string
+
+const (
+	TypeKindCompany = "company"
+	TypeKindEvent   = "event"
+	TypeKindPerson  = "person"
+)
+
+func (typeKind TypeKind) IsValid() bool {
+	switch typeKind {
+	case TypeKindCompany, TypeKindEvent, TypeKindPerson:
+		return true
+	}
+	return false
+}
+
+func (typeKind TypeKind) String() string { return string(typeKind) }
+
+// PipelineStage is the stage of the application pipeline that a type belongs to.
+type PipelineStage string
+
+const (
+	PipelineStageApplied      = "applied"
+	PipelineStageScreening    = "screening"
+	PipelineStageInterviewing = "interviewing"
+	PipelineStageOffer        = "offer"
+	PipelineStageHired        = "hired"
+	PipelineStageClosed       = "closed"
+)
+
+func (pipelineStage PipelineStage) IsValid() bool {
+	switch pipelineStage {
+	case PipelineStageApplied, PipelineStageScreening, PipelineStageInterviewing, PipelineStageOffer,
+		PipelineStageHired, PipelineStageClosed:
+		return true
+	}
+	return false
+}
+
+func (pipelineStage PipelineStage) String() string { return string(pipelineStage) }
+
+// TypeDefinition is a row of one of the lookup tables of event, person and company types. Name is the value that is
+// stored on the events, persons and companies. Built-in types are seeded by the migrations and cannot be deleted.
+type TypeDefinition struct {
+	Kind          TypeKind
+	Name          string
+	DisplayName   string
+	SortOrder     int
+	PipelineStage *PipelineStage
+	IsBuiltIn     bool
+	CreatedDate   *time.Time
+	UpdatedDate   *time.Time
+}
+
+type CreateTypeDefinition struct {
+	Kind          TypeKind
+	Name          string
+	DisplayName   string
+	SortOrder     int
+	PipelineStage *PipelineStage
+	CreatedDate   *time.Time
+}
+
+// Validate can return ValidationError
+func (typeDefinition *CreateTypeDefinition) Validate() error {
+	if !typeDefinition.Kind.IsValid() {
+		kind := "Kind"
+		return errors.NewValidationError(&kind, "Kind is invalid")
+	}
+
+	if typeDefinition.Name == "" {
+		name := "Name"
+		return errors.NewValidationError(&name, "Name is empty")
+	}
+
+	if typeDefinition.DisplayName == "" {
+		displayName := "DisplayName"
+		return errors.NewValidationError(&displayName, "DisplayName is empty")
+	}
+
+	if typeDefinition.PipelineStage != nil && !typeDefinition.PipelineStage.IsValid() {
+		pipelineStage := "PipelineStage"
+		return errors.NewValidationError(&pipelineStage, "PipelineStage is invalid")
+	}
+
+	if typeDefinition.CreatedDate != nil && typeDefinition.CreatedDate.IsZero() {
+		createdDate := "CreatedDate"
+		return errors.NewValidationError(
+			&createdDate,
+			"CreatedDate is zero. It should either be 'nil' or a recent date. Given that this is an insert, it is recommended to use nil")
+	}
+
+	return nil
+}
+
+type UpdateTypeDefinition struct {
+	Kind          TypeKind
+	Name          string
+	DisplayName   *string
+	SortOrder     *int
+	PipelineStage *PipelineStage
+}
+
+// Validate can return ValidationError
+func (typeDefinition *UpdateTypeDefinition) Validate() error {
+	if !typeDefinition.Kind.IsValid() {
+		kind := "Kind"
+		return errors.NewValidationError(&kind, "Kind is invalid")
+	}
+
+	if typeDefinition.Name == "" {
+		name := "Name"
+		return errors.NewValidationError(&name, "Name is empty")
+	}
+
+	if typeDefinition.DisplayName == nil && typeDefinition.SortOrder == nil && typeDefinition.PipelineStage == nil {
+		return errors.NewValidationError(nil, "nothing to update")
+	}
+
+	if typeDefinition.DisplayName != nil && *typeDefinition.DisplayName == "" {
+		displayName := "DisplayName"
+		return errors.NewValidationError(&displayName, "DisplayName is empty")
+	}
+
+	if typeDefinition.PipelineStage != nil && !typeDefinition.PipelineStage.IsValid() {
+		pipelineStage := "PipelineStage"
+		return errors.NewValidationError(&pipelineStage, "PipelineStage is invalid")
+	}
+
+	return nil
+}
+
+// knownTypes holds the names in the lookup tables, by kind. The IsValid checks of EventType, PersonType and
+// CompanyType use it. It holds the built-in types until SetKnownTypes is called with the contents of the tables.
+var knownTypes = struct {
+	sync.RWMutex
+	names map[TypeKind]map[string]bool
+}{
+	names: builtInTypes(),
+}
+
+// builtInTypes returns the names of the types that the lookup tables are created with, by kind.
+func builtInTypes() map[TypeKind]map[string]bool {
+	return map[TypeKind]map[string]bool{
+		TypeKindCompany: toNameSet(CompanyTypeEmployer, CompanyTypeRecruiter, CompanyTypeConsultancy),
+		TypeKindEvent: toNameSet(
+			EventTypeApplied, EventTypeCallBooked, EventTypeCallCompleted, EventTypeCodeTestCompleted,
+			EventTypeCodeTestReceived, EventTypeInterviewBooked, EventTypeInterviewCompleted, EventTypePaused,
+			EventTypeOffer, EventTypeOther, EventTypeRecruiterInterviewBooked, EventTypeRecruiterInterviewCompleted,
+			EventTypeRejected, EventTypeSigned, EventTypeWithdrew),
+		TypeKindPerson: toNameSet(
+			PersonTypeCEO, PersonTypeCTO, PersonTypeDeveloper, PersonTypeExternalRecruiter,
+			PersonTypeInternalRecruiter, PersonTypeHR, PersonTypeJobAdvertiser, PersonTypeJobContact, PersonTypeOther,
+			PersonTypeUnknown),
+	}
+}
+
+// SetKnownTypes replaces the known names of kind.
+func SetKnownTypes(kind TypeKind, names []string) {
+	knownTypes.Lock()
+	defer knownTypes.Unlock()
+
+	knownTypes.names[kind] = toNameSet(names...)
+}
+
+// ResetKnownTypes replaces the known names of every kind with the built-in types.
+func ResetKnownTypes() {
+	knownTypes.Lock()
+	defer knownTypes.Unlock()
+
+	knownTypes.names = builtInTypes()
+}
+
+// IsKnownType reports whether name is in the lookup table of kind.
+func IsKnownType(kind TypeKind, name string) bool {
+	knownTypes.RLock()
+	defer knownTypes.RUnlock()
+
+	return knownTypes.names[kind][name]
+}
+
+func toNameSet(names ...string) map[string]bool {
+	nameSet := make(map[string]bool, len(names))
+	for _, name := range names {
+		nameSet[name] = true
+	}
+	return nameSet
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- Known type tests: --------
+
+func TestIsKnownType_ShouldKnowBuiltInTypes(t *testing.T) {
+	assert.True(t, IsKnownType(TypeKindCompany, CompanyTypeConsultancy))
+	assert.True(t, IsKnownType(TypeKindEvent, EventTypeWithdrew))
+	assert.True(t, IsKnownType(TypeKindPerson, PersonTypeJobContact))
+
+	assert.False(t, IsKnownType(TypeKindCompany, EventTypeWithdrew))
+	assert.False(t, IsKnownType("application", EventTypeWithdrew))
+}
+
+func TestSetKnownTypes_ShouldReplaceTypesOfKind(t *testing.T) {
+	SetKnownTypes(TypeKindCompany, []string{CompanyTypeEmployer, "agency"})
+	t.Cleanup(func() {
+		SetKnownTypes(TypeKindCompany, []string{CompanyTypeEmployer, CompanyTypeRecruiter, CompanyTypeConsultancy})
+	})
+
+	assert.True(t, CompanyType("agency").IsValid())
+	assert.True(t, CompanyType(CompanyTypeEmployer).IsValid())
+	assert.False(t, CompanyType(CompanyTypeRecruiter).IsValid())
+	assert.True(t, PersonType(PersonTypeCEO).IsValid())
+}
+
+// -------- CreateTypeDefinition.Validate tests: --------
+
+func TestCreateTypeDefinitionValidate_ShouldReturnValidationError(t *testing.T) {
+	invalidPipelineStage := PipelineStage("archived")
+
+	tests := []struct {
+		testName       string
+		typeDefinition CreateTypeDefinition
+		expectedError  string
+	}{
+		{
+			"invalid kind",
+			CreateTypeDefinition{Kind: "application", Name: "remote", DisplayName: "Remote"},
+			"validation error on field 'Kind': Kind is invalid",
+		},
+		{
+			"empty name",
+			CreateTypeDefinition{Kind: TypeKindEvent, DisplayName: "Ghosted"},
+			"validation error on field 'Name': Name is empty",
+		},
+		{
+			"empty display name",
+			CreateTypeDefinition{Kind: TypeKindEvent, Name: "ghosted"},
+			"validation error on field 'DisplayName': DisplayName is empty",
+		},
+		{
+			"invalid pipeline stage",
+			CreateTypeDefinition{
+				Kind: TypeKindEvent, Name: "ghosted", DisplayName: "Ghosted", PipelineStage: &invalidPipelineStage},
+			"validation error on field 'PipelineStage': PipelineStage is invalid",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := test.typeDefinition.Validate()
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, test.expectedError, err.Error())
+		})
+	}
+}
+
+// -------- UpdateTypeDefinition.Validate tests: --------
+
+func TestUpdateTypeDefinitionValidate_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	typeDefinition := UpdateTypeDefinition{Kind: TypeKindPerson, Name: PersonTypeHR}
+
+	err := typeDefinition.Validate()
+	assert.Equal(t, "validation error: nothing to update", err.Error())
+}
@@ -1,13 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -16,22 +17,24 @@ import (
 )
 
 type ApplicationEventRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewApplicationEventRepository(database *sql.DB) *ApplicationEventRepository {
-	return &ApplicationEventRepository{database: database}
+func NewApplicationEventRepository(database Executor, queryTimeout time.Duration) *ApplicationEventRepository {
+	return &ApplicationEventRepository{database: database, queryTimeout: queryTimeout}
 }
 
 // AssociateApplicationEvent can return ConflictError, InternalServiceError
 func (repository *ApplicationEventRepository) AssociateApplicationEvent(
-	associateModel *models.AssociateApplicationEvent) (*models.ApplicationEvent, error) {
+	ctx context.Context, associateModel *models.AssociateApplicationEvent) (*models.ApplicationEvent, error) {
 
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO application_event (
-			application_id, event_id, created_date
-		) VALUES (?, ?, ?) 
-		RETURNING application_id, event_id, created_date; `
+			application_id, event_id, created_date, role, notes, start_date, end_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?) 
+		RETURNING application_id, event_id, created_date, role, notes, start_date, end_date, updated_date; `
 
 	var createdDate string
 	if associateModel.CreatedDate != nil {
@@ -40,38 +43,45 @@ func (repository *ApplicationEventRepository) AssociateApplicationEvent(
 		createdDate = time.Now().UTC().Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		associateModel.ApplicationID,
 		associateModel.EventID,
 		createdDate,
+		associateModel.Role,
+		associateModel.Notes,
+		formatNullableLinkDate(associateModel.StartDate),
+		formatNullableLinkDate(associateModel.EndDate),
 	)
 
 	if row.Err() != nil {
-		if row.Err().Error() ==
-			"constraint failed: UNIQUE constraint failed: application_event.application_id, application_event.event_id (1555)" {
-
-			slog.Info(
+		if isUniqueViolation(row.Err(), "application_event.application_id, application_event.event_id") {
+			logger.Info(
 				"application_event_repository.associateToApplication: UNIQUE constraint failed",
 				"application_id", associateModel.ApplicationID,
 				"event_id", associateModel.EventID)
 
 			return nil, internalErrors.NewConflictError(
 				"ApplicationID and EventID combination already exists in database.")
-		} else if row.Err().Error() == "constraint failed: FOREIGN KEY constraint failed (787)" {
-			// TODO: Use foreign key constraint names (in 0003_add_application.up.sql) once modernc.org/sqlite
-			// supports it.
-			slog.Info("application_event_repository.Create: FOREIGN KEY constraint failed (787)")
-			return nil, internalErrors.NewValidationError(nil, "Foreign key does not exist")
+		} else if err := linkDatesError(row.Err()); err != nil {
+			logger.Info("application_event_repository.Create: CHECK constraint failed", "error", row.Err())
+			return nil, err
+		} else if err := translateConstraintError(row.Err(), "ApplicationID or EventID"); err != nil {
+			logger.Info("application_event_repository.Create: constraint failed", "error", row.Err())
+			return nil, err
 		}
 		return nil, row.Err()
 	}
 
 	// can return InternalServiceError
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("application_event_repository.create: No result found.", "error", err.Error())
+			logger.Info("application_event_repository.create: No result found.", "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("Unable to map ApplicationEvent")
 		}
 		return nil, err
@@ -82,8 +92,9 @@ func (repository *ApplicationEventRepository) AssociateApplicationEvent(
 
 // GetByID can return ValidationError, InternalServiceError
 func (repository *ApplicationEventRepository) GetByID(
-	applicationID *uuid.UUID, eventID *uuid.UUID) ([]*models.ApplicationEvent, error) {
+	ctx context.Context, applicationID *uuid.UUID, eventID *uuid.UUID) ([]*models.ApplicationEvent, error) {
 
+	logger := logging.FromContext(ctx)
 	if (applicationID == nil || *applicationID == uuid.Nil) && (eventID == nil || *eventID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "applicationID and eventID cannot both be empty")
 	}
@@ -93,7 +104,7 @@ func (repository *ApplicationEventRepository) GetByID(
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
-		SELECT application_id, event_id, created_date 
+		SELECT application_id, event_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM application_event 
 		WHERE `)
 
@@ -115,7 +126,7 @@ func (repository *ApplicationEventRepository) GetByID(
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, " ", nil)
 	if err != nil {
 		//var message = "unable to join SQL statement string"
-		slog.Error("application_event_repository.GetByID: unable to join SQL statement string", "error", err)
+		logger.Error("application_event_repository.GetByID: unable to join SQL statement string", "error", err)
 		//return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -123,7 +134,10 @@ func (repository *ApplicationEventRepository) GetByID(
 
 	sqlString.WriteString("\n\t\tORDER BY created_date DESC ")
 
-	rows, err := repository.database.Query(sqlString.String(), sqlVars...)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlString.String(), sqlVars...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -134,9 +148,9 @@ func (repository *ApplicationEventRepository) GetByID(
 	var results []*models.ApplicationEvent
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "getByID")
+		result, err := repository.mapRow(ctx, rows, "getByID")
 		if err != nil {
-			slog.Error("application_event_repository.getByID: Error mapping row", "error", err)
+			logger.Error("application_event_repository.getByID: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing event data: " + err.Error())
 		}
 
@@ -146,7 +160,7 @@ func (repository *ApplicationEventRepository) GetByID(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_event_repository.getByID: Error iterating rows", "error", err)
+		logger.Error("application_event_repository.getByID: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading EventCompanies from database: " + err.Error())
 	}
@@ -155,13 +169,17 @@ func (repository *ApplicationEventRepository) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (repository *ApplicationEventRepository) GetAll() ([]*models.ApplicationEvent, error) {
+func (repository *ApplicationEventRepository) GetAll(ctx context.Context) ([]*models.ApplicationEvent, error) {
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT application_id, event_id, created_date 
+		SELECT application_id, event_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM application_event 
 		ORDER BY created_date DESC; `
 
-	rows, err := repository.database.Query(sqlSelect)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -172,9 +190,9 @@ func (repository *ApplicationEventRepository) GetAll() ([]*models.ApplicationEve
 	var results []*models.ApplicationEvent
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("application_event_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("application_event_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing event data: " + err.Error())
 		}
 
@@ -184,7 +202,7 @@ func (repository *ApplicationEventRepository) GetAll() ([]*models.ApplicationEve
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_event_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("application_event_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading EventCompanies from database: " + err.Error())
 	}
@@ -192,16 +210,82 @@ func (repository *ApplicationEventRepository) GetAll() ([]*models.ApplicationEve
 	return results, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (repository *ApplicationEventRepository) Update(ctx context.Context, model *models.UpdateApplicationEvent) error {
+	logger := logging.FromContext(ctx)
+
+	var sqlString strings.Builder
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	sqlString.WriteString(`
+		UPDATE application_event SET
+			updated_date = ?,
+			`)
+	sqlVars = append(sqlVars, time.Now().UTC().Format(timeutil.RFC3339Milli_Write))
+
+	appendLinkDetailsUpdates(&model.LinkDetails, &sqlParts, &sqlVars)
+
+	if len(sqlParts) == 0 {
+		logger.Info(
+			"application_event_repository.Update: nothing to update",
+			"applicationID", model.ApplicationID,
+			"eventID", model.EventID)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("application_event_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlString.WriteString(sqlPayload)
+	sqlString.WriteString(`
+		WHERE application_id = ? 
+		AND event_id = ? `)
+	sqlVars = append(sqlVars, model.ApplicationID, model.EventID)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlString.String(), sqlVars...)
+	if err != nil {
+		// can return ValidationError
+		if datesErr := linkDatesError(err); datesErr != nil {
+			logger.Info("application_event_repository.Update: CHECK constraint failed", "error", err)
+			return datesErr
+		}
+
+		logger.Error(
+			"application_event_repository.Update: Error trying to update ApplicationEvent",
+			"applicationID", model.ApplicationID,
+			"eventID", model.EventID,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(
+		result,
+		"ApplicationEvent does not exist. applicationID: "+model.ApplicationID.String()+
+			", eventID: "+model.EventID.String())
+}
+
 // Delete can return InternalServiceError, NotFoundError
-func (repository *ApplicationEventRepository) Delete(model *models.DeleteApplicationEvent) error {
+func (repository *ApplicationEventRepository) Delete(ctx context.Context, model *models.DeleteApplicationEvent) error {
+	logger := logging.FromContext(ctx)
 	sqlDelete := `
 		DELETE FROM application_event 
 		WHERE application_id = ? 
 		AND event_id = ?; `
 
-	result, err := repository.database.Exec(sqlDelete, model.ApplicationID, model.EventID)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlDelete, model.ApplicationID, model.EventID)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_event_repository.Delete: Error trying to delete ApplicationEvent",
 			"applicationID", model.ApplicationID,
 			"eventID", model.EventID,
@@ -211,7 +295,7 @@ func (repository *ApplicationEventRepository) Delete(model *models.DeleteApplica
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_event_repository.Delete: Error trying to delete ApplicationEvent",
 			"applicationID", model.ApplicationID,
 			"eventID", model.EventID,
@@ -231,13 +315,19 @@ func (repository *ApplicationEventRepository) Delete(model *models.DeleteApplica
 }
 
 // mapRow can return InternalServiceError
-func (repository *ApplicationEventRepository) mapRow(scanner interface{ Scan(...interface{}) error },
+func (repository *ApplicationEventRepository) mapRow(
+	ctx context.Context, scanner interface{ Scan(...interface{}) error },
+
 	methodName string) (*models.ApplicationEvent, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.ApplicationEvent
 	var createdDate sql.NullString
+	var linkDetails linkDetailsRow
 
-	err := scanner.Scan(&result.ApplicationID, &result.EventID, &createdDate)
+	destinations := []interface{}{&result.ApplicationID, &result.EventID, &createdDate}
+	err := scanner.Scan(append(destinations, linkDetails.scanDestinations()...)...)
 
 	if err != nil {
 		return nil, err
@@ -246,7 +336,7 @@ func (repository *ApplicationEventRepository) mapRow(scanner interface{ Scan(...
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("application_event_repository."+methodName+": Error parsing createdDate",
+			logger.Error("application_event_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -254,5 +344,11 @@ func (repository *ApplicationEventRepository) mapRow(scanner interface{ Scan(...
 		result.CreatedDate = timestamp
 	}
 
+	result.LinkDetails, result.UpdatedDate, err = linkDetails.toModel()
+	if err != nil {
+		logger.Error("application_event_repository."+methodName+": Error parsing link details", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError(err.Error())
+	}
+
 	return &result, nil
 }
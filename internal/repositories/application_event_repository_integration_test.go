@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -71,7 +72,8 @@ func TestAssociateApplicationToEvent_ShouldWork(t *testing.T) {
 		EventID:       event.ID,
 		CreatedDate:   testutil.ToPtr(time.Now()),
 	}
-	associatedApplicationEvent, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	associatedApplicationEvent, err := applicationEventRepository.AssociateApplicationEvent(
+		context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedApplicationEvent)
 
@@ -92,7 +94,8 @@ func TestAssociateApplicationToEvent_ShouldWorkWithOnlyRequiredFields(t *testing
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	associatedApplicationEvent, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	associatedApplicationEvent, err := applicationEventRepository.AssociateApplicationEvent(
+		context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedApplicationEvent)
 
@@ -115,7 +118,7 @@ func TestAssociateApplicationToEvent_ShouldAssociateAnApplicationToMultipleEvent
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -123,10 +126,10 @@ func TestAssociateApplicationToEvent_ShouldAssociateAnApplicationToMultipleEvent
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
-	eventCompanies, err := applicationEventRepository.GetAll()
+	eventCompanies, err := applicationEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, eventCompanies)
 	assert.Len(t, eventCompanies, 2)
@@ -156,7 +159,7 @@ func TestAssociateApplicationToEvent_ShouldAssociateMultipleApplicationsToAEvent
 		EventID:       event.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -164,10 +167,10 @@ func TestAssociateApplicationToEvent_ShouldAssociateMultipleApplicationsToAEvent
 		EventID:       event.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
-	eventCompanies, err := applicationEventRepository.GetAll()
+	eventCompanies, err := applicationEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, eventCompanies)
 	assert.Len(t, eventCompanies, 2)
@@ -195,10 +198,10 @@ func TestAssociateApplicationToEvent_ShouldReturnConflictErrorIfApplicationIDAnd
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -219,12 +222,15 @@ func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfEventIDDoesNot
 		ApplicationID: application.ID,
 		EventID:       uuid.New(),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or EventID': ApplicationID or EventID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfApplicationIDDoesNotExist(t *testing.T) {
@@ -236,12 +242,15 @@ func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfApplicationIDD
 		ApplicationID: uuid.New(),
 		EventID:       event.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or EventID': ApplicationID or EventID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfApplicationIDAndEventIDDoNotExist(t *testing.T) {
@@ -251,12 +260,15 @@ func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfApplicationIDA
 		ApplicationID: uuid.New(),
 		EventID:       uuid.New(),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or EventID': ApplicationID or EventID does not exist",
+		validationError.Error())
 }
 
 // -------- GetByID tests: --------
@@ -277,7 +289,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingApplicationID(t *testin
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -285,7 +297,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingApplicationID(t *testin
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -293,10 +305,10 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingApplicationID(t *testin
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	applicationEvents, err := applicationEventRepository.GetByID(&application1.ID, nil)
+	applicationEvents, err := applicationEventRepository.GetByID(context.Background(), &application1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, applicationEvents, 2)
 
@@ -323,7 +335,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -331,7 +343,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -339,10 +351,10 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	applicationEvents, err := applicationEventRepository.GetByID(nil, &event1.ID)
+	applicationEvents, err := applicationEventRepository.GetByID(context.Background(), nil, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, applicationEvents, 2)
 
@@ -368,24 +380,24 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationIDAndEventID(t *testing.T) {
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
 		ApplicationID: application1.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	events, err := applicationEventRepository.GetByID(&application1.ID, &event1.ID)
+	events, err := applicationEventRepository.GetByID(context.Background(), &application1.ID, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, events, 1)
 	assert.Equal(t, application1.ID, events[0].ApplicationID)
@@ -406,24 +418,24 @@ func TestApplicationEventGetByID_ShouldGetNoRecordsIfApplicationIDDoesNotMatch(t
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
 		ApplicationID: application1.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	events, err := applicationEventRepository.GetByID(testutil.ToPtr(uuid.New()), &event1.ID)
+	events, err := applicationEventRepository.GetByID(context.Background(), testutil.ToPtr(uuid.New()), &event1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -442,24 +454,25 @@ func TestApplicationEventGetByID_ShouldGetNoRecordsIfEventIDDoesNotMatch(t *test
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
 		ApplicationID: application1.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	events, err := applicationEventRepository.GetByID(&application1.ID, testutil.ToPtr(uuid.New()))
+	events, err := applicationEventRepository.GetByID(
+		context.Background(), &application1.ID, testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -478,24 +491,25 @@ func TestGetByID_ShouldGetNoRecordsIfApplicationIDAndEventIDDoesNotMatch(t *test
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
 		ApplicationID: application1.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	events, err := applicationEventRepository.GetByID(testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
+	events, err := applicationEventRepository.GetByID(
+		context.Background(), testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -510,7 +524,7 @@ func TestApplicationEventGetByID_ShouldGetNoRecordsIfNoRecordsInDB(t *testing.T)
 	event1 := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
 	repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
 
-	events, err := applicationEventRepository.GetByID(&application1.ID, &event1.ID)
+	events, err := applicationEventRepository.GetByID(context.Background(), &application1.ID, &event1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -532,7 +546,7 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -540,7 +554,7 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -548,10 +562,10 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventRepository.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	eventCompanies, err := applicationEventRepository.GetAll()
+	eventCompanies, err := applicationEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, eventCompanies, 3)
@@ -575,11 +589,103 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 func TestGetAllApplicationEvents_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	applicationEventRepository, _, _, _ := setupApplicationEventRepository(t)
 
-	results, err := applicationEventRepository.GetAll()
+	results, err := applicationEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
 
+// -------- Update tests: --------
+
+func TestUpdateApplicationEvent_ShouldUpdateLinkDetails(t *testing.T) {
+	applicationEventRepository, applicationRepository, eventRepository, companyRepository :=
+		setupApplicationEventRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateApplicationEvent{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails: models.LinkDetails{
+			Role:      testutil.ToPtr("Hiring manager"),
+			StartDate: &startDate,
+		},
+	}
+	applicationEvent, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &associateModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hiring manager", *applicationEvent.Role)
+	assert.Nil(t, applicationEvent.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, applicationEvent.StartDate)
+	assert.Nil(t, applicationEvent.EndDate)
+	assert.Nil(t, applicationEvent.UpdatedDate)
+
+	endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	err = applicationEventRepository.Update(context.Background(), &models.UpdateApplicationEvent{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails: models.LinkDetails{
+			Notes:   testutil.ToPtr("Moved to another team"),
+			EndDate: &endDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	applicationEvents, err := applicationEventRepository.GetByID(context.Background(), &application.ID, &event.ID)
+	assert.NoError(t, err)
+	assert.Len(t, applicationEvents, 1)
+
+	updatedApplicationEvent := applicationEvents[0]
+	assert.Equal(t, "Hiring manager", *updatedApplicationEvent.Role)
+	assert.Equal(t, "Moved to another team", *updatedApplicationEvent.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, updatedApplicationEvent.StartDate)
+	testutil.AssertEqualFormattedDateTimes(t, &endDate, updatedApplicationEvent.EndDate)
+	assert.NotNil(t, updatedApplicationEvent.UpdatedDate)
+}
+
+func TestUpdateApplicationEvent_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	applicationEventRepository, applicationRepository, eventRepository, companyRepository :=
+		setupApplicationEventRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateApplicationEvent{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails:   models.LinkDetails{StartDate: &startDate},
+	}
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &associateModel)
+	assert.NoError(t, err)
+
+	endDate := startDate.AddDate(0, 0, -1)
+	err = applicationEventRepository.Update(context.Background(), &models.UpdateApplicationEvent{
+		ApplicationID: application.ID,
+		EventID:       event.ID,
+		LinkDetails:   models.LinkDetails{EndDate: &endDate},
+	})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", err.Error())
+}
+
+func TestUpdateApplicationEvent_ShouldReturnNotFoundErrorIfLinkDoesNotExist(t *testing.T) {
+	applicationEventRepository, _, _, _ := setupApplicationEventRepository(t)
+
+	err := applicationEventRepository.Update(context.Background(), &models.UpdateApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+		LinkDetails:   models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
 // -------- Delete tests: --------
 
 func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
@@ -593,7 +699,7 @@ func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	model := models.DeleteApplicationEvent{
@@ -601,7 +707,7 @@ func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
 		EventID:       event.ID,
 	}
 
-	err = applicationEventRepository.Delete(&model)
+	err = applicationEventRepository.Delete(context.Background(), &model)
 	assert.NoError(t, err)
 }
 
@@ -616,7 +722,7 @@ func TestDeleteApplicationEvent_ShouldReturnNotFoundErrorIfNoMatchingApplication
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventRepository.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventRepository.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	model := models.DeleteApplicationEvent{
@@ -624,7 +730,7 @@ func TestDeleteApplicationEvent_ShouldReturnNotFoundErrorIfNoMatchingApplication
 		EventID:       uuid.New(),
 	}
 
-	err = applicationEventRepository.Delete(&model)
+	err = applicationEventRepository.Delete(context.Background(), &model)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/testutil"
 	"testing"
@@ -39,9 +40,9 @@ func TestApplicationEventGetByID_ShouldReturnValidationError(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			repository := NewApplicationEventRepository(nil)
+			repository := NewApplicationEventRepository(nil, 0)
 
-			eventCompanies, err := repository.GetByID(test.applicationID, test.eventID)
+			eventCompanies, err := repository.GetByID(context.Background(), test.applicationID, test.eventID)
 			assert.Nil(t, eventCompanies)
 
 			assert.Error(t, err)
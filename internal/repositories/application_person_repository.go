@@ -1,13 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -16,22 +17,24 @@ import (
 )
 
 type ApplicationPersonRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewApplicationPersonRepository(database *sql.DB) *ApplicationPersonRepository {
-	return &ApplicationPersonRepository{database: database}
+func NewApplicationPersonRepository(database Executor, queryTimeout time.Duration) *ApplicationPersonRepository {
+	return &ApplicationPersonRepository{database: database, queryTimeout: queryTimeout}
 }
 
 // AssociateApplicationPerson can return ConflictError, InternalServiceError
 func (repository *ApplicationPersonRepository) AssociateApplicationPerson(
-	associateModel *models.AssociateApplicationPerson) (*models.ApplicationPerson, error) {
+	ctx context.Context, associateModel *models.AssociateApplicationPerson) (*models.ApplicationPerson, error) {
 
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO application_person (
-			application_id, person_id, created_date
-		) VALUES (?, ?, ?) 
-		RETURNING application_id, person_id, created_date; `
+			application_id, person_id, created_date, role, notes, start_date, end_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?) 
+		RETURNING application_id, person_id, created_date, role, notes, start_date, end_date, updated_date; `
 
 	var createdDate string
 	if associateModel.CreatedDate != nil {
@@ -40,38 +43,45 @@ func (repository *ApplicationPersonRepository) AssociateApplicationPerson(
 		createdDate = time.Now().UTC().Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		associateModel.ApplicationID,
 		associateModel.PersonID,
 		createdDate,
+		associateModel.Role,
+		associateModel.Notes,
+		formatNullableLinkDate(associateModel.StartDate),
+		formatNullableLinkDate(associateModel.EndDate),
 	)
 
 	if row.Err() != nil {
-		if row.Err().Error() ==
-			"constraint failed: UNIQUE constraint failed: application_person.application_id, application_person.person_id (1555)" {
-
-			slog.Info(
+		if isUniqueViolation(row.Err(), "application_person.application_id, application_person.person_id") {
+			logger.Info(
 				"application_person_repository.associateToApplication: UNIQUE constraint failed",
 				"application_id", associateModel.ApplicationID,
 				"person_id", associateModel.PersonID)
 
 			return nil, internalErrors.NewConflictError(
 				"ApplicationID and PersonID combination already exists in database.")
-		} else if row.Err().Error() == "constraint failed: FOREIGN KEY constraint failed (787)" {
-			// TODO: Use foreign key constraint names (in 0003_add_application.up.sql) once modernc.org/sqlite
-			// supports it.
-			slog.Info("application_person_repository.Create: FOREIGN KEY constraint failed (787)")
-			return nil, internalErrors.NewValidationError(nil, "Foreign key does not exist")
+		} else if err := linkDatesError(row.Err()); err != nil {
+			logger.Info("application_person_repository.Create: CHECK constraint failed", "error", row.Err())
+			return nil, err
+		} else if err := translateConstraintError(row.Err(), "ApplicationID or PersonID"); err != nil {
+			logger.Info("application_person_repository.Create: constraint failed", "error", row.Err())
+			return nil, err
 		}
 		return nil, row.Err()
 	}
 
 	// can return InternalServiceError
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("application_person_repository.create: No result found.", "error", err.Error())
+			logger.Info("application_person_repository.create: No result found.", "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("Unable to map ApplicationPerson")
 		}
 		return nil, err
@@ -82,8 +92,9 @@ func (repository *ApplicationPersonRepository) AssociateApplicationPerson(
 
 // GetByID can return ValidationError, InternalServiceError
 func (repository *ApplicationPersonRepository) GetByID(
-	applicationID *uuid.UUID, personID *uuid.UUID) ([]*models.ApplicationPerson, error) {
+	ctx context.Context, applicationID *uuid.UUID, personID *uuid.UUID) ([]*models.ApplicationPerson, error) {
 
+	logger := logging.FromContext(ctx)
 	if (applicationID == nil || *applicationID == uuid.Nil) && (personID == nil || *personID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "applicationID and personID cannot both be empty")
 	}
@@ -93,7 +104,7 @@ func (repository *ApplicationPersonRepository) GetByID(
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
-		SELECT application_id, person_id, created_date 
+		SELECT application_id, person_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM application_person 
 		WHERE `)
 
@@ -115,7 +126,7 @@ func (repository *ApplicationPersonRepository) GetByID(
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, " ", nil)
 	if err != nil {
 		//var message = "unable to join SQL statement string"
-		slog.Error("application_person_repository.GetByID: unable to join SQL statement string", "error", err)
+		logger.Error("application_person_repository.GetByID: unable to join SQL statement string", "error", err)
 		//return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -123,7 +134,10 @@ func (repository *ApplicationPersonRepository) GetByID(
 
 	sqlString.WriteString("\n\t\tORDER BY created_date DESC ")
 
-	rows, err := repository.database.Query(sqlString.String(), sqlVars...)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlString.String(), sqlVars...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -134,9 +148,9 @@ func (repository *ApplicationPersonRepository) GetByID(
 	var results []*models.ApplicationPerson
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "getByID")
+		result, err := repository.mapRow(ctx, rows, "getByID")
 		if err != nil {
-			slog.Error("application_person_repository.getByID: Error mapping row", "error", err)
+			logger.Error("application_person_repository.getByID: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -146,7 +160,7 @@ func (repository *ApplicationPersonRepository) GetByID(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_person_repository.getByID: Error iterating rows", "error", err)
+		logger.Error("application_person_repository.getByID: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading PersonCompanies from database: " + err.Error())
 	}
@@ -155,13 +169,17 @@ func (repository *ApplicationPersonRepository) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (repository *ApplicationPersonRepository) GetAll() ([]*models.ApplicationPerson, error) {
+func (repository *ApplicationPersonRepository) GetAll(ctx context.Context) ([]*models.ApplicationPerson, error) {
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT application_id, person_id, created_date 
+		SELECT application_id, person_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM application_person 
 		ORDER BY created_date DESC; `
 
-	rows, err := repository.database.Query(sqlSelect)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -172,9 +190,9 @@ func (repository *ApplicationPersonRepository) GetAll() ([]*models.ApplicationPe
 	var results []*models.ApplicationPerson
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("application_person_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("application_person_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -184,7 +202,7 @@ func (repository *ApplicationPersonRepository) GetAll() ([]*models.ApplicationPe
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_person_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("application_person_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading PersonCompanies from database: " + err.Error())
 	}
@@ -192,16 +210,86 @@ func (repository *ApplicationPersonRepository) GetAll() ([]*models.ApplicationPe
 	return results, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (repository *ApplicationPersonRepository) Update(
+	ctx context.Context, model *models.UpdateApplicationPerson) error {
+
+	logger := logging.FromContext(ctx)
+
+	var sqlString strings.Builder
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	sqlString.WriteString(`
+		UPDATE application_person SET
+			updated_date = ?,
+			`)
+	sqlVars = append(sqlVars, time.Now().UTC().Format(timeutil.RFC3339Milli_Write))
+
+	appendLinkDetailsUpdates(&model.LinkDetails, &sqlParts, &sqlVars)
+
+	if len(sqlParts) == 0 {
+		logger.Info(
+			"application_person_repository.Update: nothing to update",
+			"applicationID", model.ApplicationID,
+			"personID", model.PersonID)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("application_person_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlString.WriteString(sqlPayload)
+	sqlString.WriteString(`
+		WHERE application_id = ? 
+		AND person_id = ? `)
+	sqlVars = append(sqlVars, model.ApplicationID, model.PersonID)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlString.String(), sqlVars...)
+	if err != nil {
+		// can return ValidationError
+		if datesErr := linkDatesError(err); datesErr != nil {
+			logger.Info("application_person_repository.Update: CHECK constraint failed", "error", err)
+			return datesErr
+		}
+
+		logger.Error(
+			"application_person_repository.Update: Error trying to update ApplicationPerson",
+			"applicationID", model.ApplicationID,
+			"personID", model.PersonID,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(
+		result,
+		"ApplicationPerson does not exist. applicationID: "+model.ApplicationID.String()+
+			", personID: "+model.PersonID.String())
+}
+
 // Delete can return InternalServiceError, NotFoundError
-func (repository *ApplicationPersonRepository) Delete(model *models.DeleteApplicationPerson) error {
+func (repository *ApplicationPersonRepository) Delete(
+	ctx context.Context, model *models.DeleteApplicationPerson) error {
+
+	logger := logging.FromContext(ctx)
 	sqlDelete := `
 		DELETE FROM application_person 
 		WHERE application_id = ? 
 		AND person_id = ?; `
 
-	result, err := repository.database.Exec(sqlDelete, model.ApplicationID, model.PersonID)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlDelete, model.ApplicationID, model.PersonID)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_person_repository.Delete: Error trying to delete ApplicationPerson",
 			"applicationID", model.ApplicationID,
 			"personID", model.PersonID,
@@ -211,7 +299,7 @@ func (repository *ApplicationPersonRepository) Delete(model *models.DeleteApplic
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_person_repository.Delete: Error trying to delete ApplicationPerson",
 			"applicationID", model.ApplicationID,
 			"personID", model.PersonID,
@@ -231,13 +319,19 @@ func (repository *ApplicationPersonRepository) Delete(model *models.DeleteApplic
 }
 
 // mapRow can return InternalServiceError
-func (repository *ApplicationPersonRepository) mapRow(scanner interface{ Scan(...interface{}) error },
+func (repository *ApplicationPersonRepository) mapRow(
+	ctx context.Context, scanner interface{ Scan(...interface{}) error },
+
 	methodName string) (*models.ApplicationPerson, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.ApplicationPerson
 	var createdDate sql.NullString
+	var linkDetails linkDetailsRow
 
-	err := scanner.Scan(&result.ApplicationID, &result.PersonID, &createdDate)
+	destinations := []interface{}{&result.ApplicationID, &result.PersonID, &createdDate}
+	err := scanner.Scan(append(destinations, linkDetails.scanDestinations()...)...)
 
 	if err != nil {
 		return nil, err
@@ -246,7 +340,7 @@ func (repository *ApplicationPersonRepository) mapRow(scanner interface{ Scan(..
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("application_person_repository."+methodName+": Error parsing createdDate",
+			logger.Error("application_person_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -254,5 +348,11 @@ func (repository *ApplicationPersonRepository) mapRow(scanner interface{ Scan(..
 		result.CreatedDate = timestamp
 	}
 
+	result.LinkDetails, result.UpdatedDate, err = linkDetails.toModel()
+	if err != nil {
+		logger.Error("application_person_repository."+methodName+": Error parsing link details", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError(err.Error())
+	}
+
 	return &result, nil
 }
@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -71,7 +72,8 @@ func TestAssociateApplicationToPerson_ShouldWork(t *testing.T) {
 		PersonID:      person.ID,
 		CreatedDate:   testutil.ToPtr(time.Now()),
 	}
-	associatedApplicationPerson, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	associatedApplicationPerson, err := applicationPersonRepository.AssociateApplicationPerson(
+		context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedApplicationPerson)
 
@@ -92,7 +94,8 @@ func TestAssociateApplicationToPerson_ShouldWorkWithOnlyRequiredFields(t *testin
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	associatedApplicationPerson, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	associatedApplicationPerson, err := applicationPersonRepository.AssociateApplicationPerson(
+		context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedApplicationPerson)
 
@@ -115,7 +118,7 @@ func TestAssociateApplicationToPerson_ShouldAssociateAnApplicationToMultiplePers
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -123,10 +126,10 @@ func TestAssociateApplicationToPerson_ShouldAssociateAnApplicationToMultiplePers
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := applicationPersonRepository.GetAll()
+	personCompanies, err := applicationPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -156,7 +159,7 @@ func TestAssociateApplicationToPerson_ShouldAssociateMultipleApplicationsToAPers
 		PersonID:      person.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -164,10 +167,10 @@ func TestAssociateApplicationToPerson_ShouldAssociateMultipleApplicationsToAPers
 		PersonID:      person.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := applicationPersonRepository.GetAll()
+	personCompanies, err := applicationPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -195,10 +198,10 @@ func TestAssociateApplicationToPerson_ShouldReturnConflictErrorIfApplicationIDAn
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -219,12 +222,15 @@ func TestAssociateApplicationToPerson_ShouldReturnValidationErrorIfPersonIDDoesN
 		ApplicationID: application.ID,
 		PersonID:      uuid.New(),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or PersonID': ApplicationID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateApplicationToPerson_ShouldReturnValidationErrorIfApplicationIDDoesNotExist(t *testing.T) {
@@ -236,12 +242,15 @@ func TestAssociateApplicationToPerson_ShouldReturnValidationErrorIfApplicationID
 		ApplicationID: uuid.New(),
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or PersonID': ApplicationID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateApplicationToPerson_ShouldReturnValidationErrorIfApplicationIDAndPersonIDDoNotExist(t *testing.T) {
@@ -251,12 +260,15 @@ func TestAssociateApplicationToPerson_ShouldReturnValidationErrorIfApplicationID
 		ApplicationID: uuid.New(),
 		PersonID:      uuid.New(),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID or PersonID': ApplicationID or PersonID does not exist",
+		validationError.Error())
 }
 
 // -------- GetByID tests: --------
@@ -277,7 +289,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -285,7 +297,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -293,10 +305,10 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	applicationPersons, err := applicationPersonRepository.GetByID(&application1.ID, nil)
+	applicationPersons, err := applicationPersonRepository.GetByID(context.Background(), &application1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, applicationPersons, 2)
 
@@ -323,7 +335,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -331,7 +343,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -339,10 +351,10 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	applicationPersons, err := applicationPersonRepository.GetByID(nil, &person1.ID)
+	applicationPersons, err := applicationPersonRepository.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, applicationPersons, 2)
 
@@ -368,24 +380,24 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationIDAndPersonID(t *testing.T)
 		ApplicationID: application1.ID,
 		PersonID:      person1.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
 		ApplicationID: application1.ID,
 		PersonID:      person2.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
 		ApplicationID: application2.ID,
 		PersonID:      person1.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	persons, err := applicationPersonRepository.GetByID(&application1.ID, &person1.ID)
+	persons, err := applicationPersonRepository.GetByID(context.Background(), &application1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, application1.ID, persons[0].ApplicationID)
@@ -406,24 +418,24 @@ func TestApplicationPersonGetByID_ShouldGetNoRecordsIfApplicationIDDoesNotMatch(
 		ApplicationID: application1.ID,
 		PersonID:      person1.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
 		ApplicationID: application1.ID,
 		PersonID:      person2.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
 		ApplicationID: application2.ID,
 		PersonID:      person1.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	persons, err := applicationPersonRepository.GetByID(testutil.ToPtr(uuid.New()), &person1.ID)
+	persons, err := applicationPersonRepository.GetByID(context.Background(), testutil.ToPtr(uuid.New()), &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -442,24 +454,25 @@ func TestApplicationPersonGetByID_ShouldGetNoRecordsIfPersonIDDoesNotMatch(t *te
 		ApplicationID: application1.ID,
 		PersonID:      person1.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
 		ApplicationID: application1.ID,
 		PersonID:      person2.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
 		ApplicationID: application2.ID,
 		PersonID:      person1.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	persons, err := applicationPersonRepository.GetByID(&application1.ID, testutil.ToPtr(uuid.New()))
+	persons, err := applicationPersonRepository.GetByID(
+		context.Background(), &application1.ID, testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -478,24 +491,25 @@ func TestGetByID_ShouldGetNoRecordsIfApplicationIDAndPersonIDDoesNotMatch(t *tes
 		ApplicationID: application1.ID,
 		PersonID:      person1.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
 		ApplicationID: application1.ID,
 		PersonID:      person2.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
 		ApplicationID: application2.ID,
 		PersonID:      person1.ID,
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	persons, err := applicationPersonRepository.GetByID(testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
+	persons, err := applicationPersonRepository.GetByID(
+		context.Background(), testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -510,7 +524,7 @@ func TestApplicationPersonGetByID_ShouldGetNoRecordsIfNoRecordsInDB(t *testing.T
 	person1 := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 
-	persons, err := applicationPersonRepository.GetByID(&application1.ID, &person1.ID)
+	persons, err := applicationPersonRepository.GetByID(context.Background(), &application1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -532,7 +546,7 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -540,7 +554,7 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -548,10 +562,10 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonRepository.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := applicationPersonRepository.GetAll()
+	personCompanies, err := applicationPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -575,11 +589,104 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 func TestGetAllApplicationPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	applicationPersonRepository, _, _, _ := setupApplicationPersonRepository(t)
 
-	results, err := applicationPersonRepository.GetAll()
+	results, err := applicationPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
 
+// -------- Update tests: --------
+
+func TestUpdateApplicationPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	applicationPersonRepository, applicationRepository, personRepository, companyRepository :=
+		setupApplicationPersonRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateApplicationPerson{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails: models.LinkDetails{
+			Role:      testutil.ToPtr("Hiring manager"),
+			StartDate: &startDate,
+		},
+	}
+	applicationPerson, err :=
+		applicationPersonRepository.AssociateApplicationPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hiring manager", *applicationPerson.Role)
+	assert.Nil(t, applicationPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, applicationPerson.StartDate)
+	assert.Nil(t, applicationPerson.EndDate)
+	assert.Nil(t, applicationPerson.UpdatedDate)
+
+	endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	err = applicationPersonRepository.Update(context.Background(), &models.UpdateApplicationPerson{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails: models.LinkDetails{
+			Notes:   testutil.ToPtr("Moved to another team"),
+			EndDate: &endDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	applicationPersons, err := applicationPersonRepository.GetByID(context.Background(), &application.ID, &person.ID)
+	assert.NoError(t, err)
+	assert.Len(t, applicationPersons, 1)
+
+	updatedApplicationPerson := applicationPersons[0]
+	assert.Equal(t, "Hiring manager", *updatedApplicationPerson.Role)
+	assert.Equal(t, "Moved to another team", *updatedApplicationPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, updatedApplicationPerson.StartDate)
+	testutil.AssertEqualFormattedDateTimes(t, &endDate, updatedApplicationPerson.EndDate)
+	assert.NotNil(t, updatedApplicationPerson.UpdatedDate)
+}
+
+func TestUpdateApplicationPerson_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	applicationPersonRepository, applicationRepository, personRepository, companyRepository :=
+		setupApplicationPersonRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateApplicationPerson{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails:   models.LinkDetails{StartDate: &startDate},
+	}
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+
+	endDate := startDate.AddDate(0, 0, -1)
+	err = applicationPersonRepository.Update(context.Background(), &models.UpdateApplicationPerson{
+		ApplicationID: application.ID,
+		PersonID:      person.ID,
+		LinkDetails:   models.LinkDetails{EndDate: &endDate},
+	})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", err.Error())
+}
+
+func TestUpdateApplicationPerson_ShouldReturnNotFoundErrorIfLinkDoesNotExist(t *testing.T) {
+	applicationPersonRepository, _, _, _ := setupApplicationPersonRepository(t)
+
+	err := applicationPersonRepository.Update(context.Background(), &models.UpdateApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+		LinkDetails:   models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
 // -------- Delete tests: --------
 
 func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
@@ -593,7 +700,7 @@ func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteApplicationPerson{
@@ -601,7 +708,7 @@ func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
 		PersonID:      person.ID,
 	}
 
-	err = applicationPersonRepository.Delete(&model)
+	err = applicationPersonRepository.Delete(context.Background(), &model)
 	assert.NoError(t, err)
 }
 
@@ -616,7 +723,7 @@ func TestDeleteApplicationPerson_ShouldReturnNotFoundErrorIfNoMatchingApplicatio
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonRepository.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonRepository.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteApplicationPerson{
@@ -624,7 +731,7 @@ func TestDeleteApplicationPerson_ShouldReturnNotFoundErrorIfNoMatchingApplicatio
 		PersonID:      uuid.New(),
 	}
 
-	err = applicationPersonRepository.Delete(&model)
+	err = applicationPersonRepository.Delete(context.Background(), &model)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
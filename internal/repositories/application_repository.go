@@ -1,15 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
-	"fmt"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -18,15 +17,19 @@ import (
 )
 
 type ApplicationRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewApplicationRepository(database *sql.DB) *ApplicationRepository {
-	return &ApplicationRepository{database: database}
+func NewApplicationRepository(database Executor, queryTimeout time.Duration) *ApplicationRepository {
+	return &ApplicationRepository{database: database, queryTimeout: queryTimeout}
 }
 
 // Create can return ConflictError, InternalServiceError, ValidationError
-func (repository *ApplicationRepository) Create(application *models.CreateApplication) (*models.Application, error) {
+func (repository *ApplicationRepository) Create(
+	ctx context.Context, application *models.CreateApplication) (*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO application (
 	 		id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, weekdays_in_office, 
@@ -35,7 +38,7 @@ func (repository *ApplicationRepository) Create(application *models.CreateApplic
 	  	RETURNING 
 			id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, 
 		    weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date, 
-		    updated_date, null, null, null, null; `
+		    updated_date, version; `
 
 	var applicationID uuid.UUID
 	if application.ID != nil {
@@ -60,7 +63,11 @@ func (repository *ApplicationRepository) Create(application *models.CreateApplic
 		updatedDate = application.UpdatedDate.Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		applicationID,
 		application.CompanyID,
@@ -78,29 +85,27 @@ func (repository *ApplicationRepository) Create(application *models.CreateApplic
 		updatedDate,
 	)
 
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("application_repository.Create: No result found for ID",
+			logger.Info("application_repository.Create: No result found for ID",
 				"ID", applicationID,
 				"error", err.Error())
-		} else if err.Error() == "constraint failed: CHECK constraint failed: company_reference_not_null (275)" {
-			slog.Error("application_repository.Create: CHECK constraint failed: company_reference_not_null")
+		} else if isCheckViolation(err, "company_reference_not_null") {
+			logger.Error("application_repository.Create: CHECK constraint failed: company_reference_not_null")
 			return nil, internalErrors.NewValidationError(nil, "CompanyID and RecruiterID cannot both be empty")
-		} else if err.Error() == "constraint failed: CHECK constraint failed: job_title_job_url_not_null (275)" {
-			slog.Error("application_repository.Create: CHECK constraint failed: job_title_job_url_not_null")
+		} else if isCheckViolation(err, "job_title_job_url_not_null") {
+			logger.Error("application_repository.Create: CHECK constraint failed: job_title_job_url_not_null")
 			return nil, internalErrors.NewValidationError(nil, "JobTitle and JobAdURL cannot both be empty")
-		} else if err.Error() == "constraint failed: UNIQUE constraint failed: application.id (1555)" {
-			slog.Info(
+		} else if isUniqueViolation(err, "application.id") {
+			logger.Info(
 				"application_repository.createApplication: UNIQUE constraint failed",
 				"ID", applicationID.String())
 			return nil, internalErrors.NewConflictError(
 				"ID already exists in database: '" + applicationID.String() + "'")
-		} else if err.Error() == "constraint failed: FOREIGN KEY constraint failed (787)" {
-			// TODO: Use foreign key constraint names (in 0003_add_application.up.sql) once modernc.org/sqlite
-			// supports it.
-			slog.Info("application_repository.Create: FOREIGN KEY constraint failed (787)")
-			return nil, internalErrors.NewValidationError(nil, "Foreign key does not exist")
+		} else if constraintErr := translateConstraintError(err, "CompanyID or RecruiterID"); constraintErr != nil {
+			logger.Info("application_repository.Create: constraint failed", "error", err)
+			return nil, constraintErr
 		}
 		return nil, err
 	}
@@ -109,26 +114,30 @@ func (repository *ApplicationRepository) Create(application *models.CreateApplic
 }
 
 // GetById can return InternalServiceError, NotFoundError, ValidationError
-func (repository *ApplicationRepository) GetById(id *uuid.UUID) (*models.Application, error) {
+func (repository *ApplicationRepository) GetById(ctx context.Context, id *uuid.UUID) (*models.Application, error) {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Info("application_repository.GetById: ID is nil")
+		logger.Info("application_repository.GetById: ID is nil")
 		return nil, internalErrors.NewValidationError(nil, "ID is nil")
 	}
 
 	sqlSelect := `
 		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, 
 		   weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date, 
-		   updated_date, null, null, null, null 
+		   updated_date, version 
 		FROM application 
 		WHERE id = ? `
 
-	row := repository.database.QueryRow(sqlSelect, id)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, id)
 
 	// can return ConflictError, InternalServiceError
-	result, err := repository.mapRow(row, "GetById")
+	result, err := repository.mapRow(ctx, row, "GetById")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("application_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
+			logger.Info("application_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
 		}
 		return nil, err
@@ -137,23 +146,80 @@ func (repository *ApplicationRepository) GetById(id *uuid.UUID) (*models.Applica
 	return result, nil
 }
 
+// GetAllByIDs returns the applications with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetAllByIDs can return InternalServiceError, ValidationError
+func (repository *ApplicationRepository) GetAllByIDs(
+	ctx context.Context, ids []uuid.UUID) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	if err := models.ValidateIDs(ids); err != nil {
+		logger.Info("application_repository.GetAllByIDs: IDs are invalid", "error", err)
+		return nil, err
+	}
+
+	placeholders, sqlVars := buildIDPlaceholders(ids)
+	sqlSelect := `
+		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type,
+		   weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date,
+		   updated_date, version
+		FROM application
+		WHERE id IN (` + placeholders + `) `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("application_repository.GetAllByIDs: Error trying to query applications", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query applications: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.Application
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByIDs")
+		if err != nil {
+			logger.Error("application_repository.GetAllByIDs: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("application_repository.GetAllByIDs: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+
+	return orderByIDs(ids, results, func(application *models.Application) uuid.UUID { return application.ID }), nil
+}
+
 // GetAllByJobTitle can return InternalServiceError, NotFoundError, ValidationError
-func (repository *ApplicationRepository) GetAllByJobTitle(jobTitle *string) ([]*models.Application, error) {
+func (repository *ApplicationRepository) GetAllByJobTitle(
+	ctx context.Context, jobTitle *string) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
 	if jobTitle == nil {
-		slog.Info("application_repository.GetAllByJobTitle: JobTitle is nil")
+		logger.Info("application_repository.GetAllByJobTitle: JobTitle is nil")
 		return nil, internalErrors.NewValidationError(nil, "JobTitle is nil")
 	}
 
 	sqlSelect := `
 		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, 
 		   weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date, 
-		   updated_date, null, null, null, null 
+		   updated_date, version 
 		FROM application 
 		WHERE job_title LIKE ? 
 		ORDER BY updated_Date DESC `
 
 	wildcardJobTitle := "%" + *jobTitle + "%"
-	rows, err := repository.database.Query(sqlSelect, wildcardJobTitle)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, wildcardJobTitle)
 	if err != nil {
 		return nil, err
 	}
@@ -162,9 +228,9 @@ func (repository *ApplicationRepository) GetAllByJobTitle(jobTitle *string) ([]*
 
 	for rows.Next() {
 		// can return ConflictError, InternalServiceError
-		result, err := repository.mapRow(rows, "GetAllByJobTitle")
+		result, err := repository.mapRow(ctx, rows, "GetAllByJobTitle")
 		if err != nil {
-			slog.Error("application_repository.GetAllByJobTitle: Error mapping row", "error", err)
+			logger.Error("application_repository.GetAllByJobTitle: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
 		}
 
@@ -174,63 +240,149 @@ func (repository *ApplicationRepository) GetAllByJobTitle(jobTitle *string) ([]*
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_repository.GetAllByJobTitle: Error iterating rows", "error", err)
+		logger.Error("application_repository.GetAllByJobTitle: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
 	}
 
 	if len(results) == 0 {
-		slog.Info("application_repository.GetAllByJobTitle: No result found for JobTitle", "JobTitle", jobTitle)
+		logger.Info("application_repository.GetAllByJobTitle: No result found for JobTitle", "JobTitle", jobTitle)
 		return nil, internalErrors.NewNotFoundError("JobTitle: '" + *jobTitle + "'")
 	}
 
 	return results, nil
 }
 
+// GetAllByJobAdURLOrCompanyAndJobTitle returns the applications whose job ad URL equals jobAdURL, or whose company
+// and job title equal companyID and jobTitle, ignoring the case of the job title. Nil arguments are not matched.
+//
+// GetAllByJobAdURLOrCompanyAndJobTitle can return InternalServiceError
+func (repository *ApplicationRepository) GetAllByJobAdURLOrCompanyAndJobTitle(
+	ctx context.Context, jobAdURL *string, companyID *uuid.UUID, jobTitle *string) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+	sqlSelect := `
+		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, 
+		   weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date, 
+		   updated_date, version 
+		FROM application 
+		WHERE job_ad_url = ? 
+		   OR (company_id = ? AND lower(trim(job_title)) = lower(trim(?))) 
+		ORDER BY created_date `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, jobAdURL, companyID, jobTitle)
+	if err != nil {
+		logger.Error("application_repository.GetAllByJobAdURLOrCompanyAndJobTitle: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+
+	var results []*models.Application
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByJobAdURLOrCompanyAndJobTitle")
+		if err != nil {
+			logger.Error(
+				"application_repository.GetAllByJobAdURLOrCompanyAndJobTitle: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
+		}
+
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("application_repository.GetAllByJobAdURLOrCompanyAndJobTitle: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// GetAllByCompanyID returns the applications with companyID as their company or recruiter, oldest first.
+//
+// GetAllByCompanyID can return InternalServiceError
+func (repository *ApplicationRepository) GetAllByCompanyID(
+	ctx context.Context, companyID *uuid.UUID) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+	sqlSelect := `
+		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type, 
+		   weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date, 
+		   updated_date, version 
+		FROM application 
+		WHERE company_id = ? 
+		   OR recruiter_id = ? 
+		ORDER BY created_date `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, companyID, companyID)
+	if err != nil {
+		logger.Error("application_repository.GetAllByCompanyID: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+	defer rows.Close()
+
+	results := []*models.Application{}
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByCompanyID")
+		if err != nil {
+			logger.Error("application_repository.GetAllByCompanyID: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
+		}
+
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("application_repository.GetAllByCompanyID: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
 // GetAll can return InternalServiceError
 func (repository *ApplicationRepository) GetAll(
+	ctx context.Context,
 	includeCompany models.IncludeExtraDataType,
 	includeRecruiter models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType) ([]*models.Application, error) {
 
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT a.id, a.company_id, a.recruiter_id, a.job_title, a.job_ad_url, a.country, a.area, a.remote_status_type, 
-			a.weekdays_in_office, a.estimated_cycle_time, a.estimated_commute_time, a.application_date, a.created_date, 
-			a.updated_date, %s, %s, %s, %s
-		FROM application a %s %s %s %s
-		GROUP BY a.id
-		ORDER BY a.created_date DESC `
-
-	companyCoalesceString, companyJoinString := repository.buildCompanyCoalesceAndJoin(includeCompany)
-	recruiterCoalesceString, recruiterJoinString := repository.buildRecruiterCoalesceAndJoin(includeRecruiter)
-	personsCoalesceString, personsJoinString := repository.buildPersonsCoalesceAndJoin(includePersons)
-	eventsCoalesceString, eventsJoinString := repository.buildEventsCoalesceAndJoin(includeEvents)
-
-	sqlSelect = fmt.Sprintf(
-		sqlSelect,
-		companyCoalesceString,
-		recruiterCoalesceString,
-		personsCoalesceString,
-		eventsCoalesceString,
-		companyJoinString,
-		recruiterJoinString,
-		personsJoinString,
-		eventsJoinString)
-
-	rows, err := repository.database.Query(sqlSelect)
+		SELECT id, company_id, recruiter_id, job_title, job_ad_url, country, area, remote_status_type,
+			weekdays_in_office, estimated_cycle_time, estimated_commute_time, application_date, created_date,
+			updated_date, version
+		FROM application
+		ORDER BY created_date DESC `
+
+	queryCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(queryCtx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	defer rows.Close()
 
 	var results []*models.Application
 	for rows.Next() {
 		// can return ConflictError, InternalServiceError
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("application_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("application_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
 		}
 
@@ -240,22 +392,30 @@ func (repository *ApplicationRepository) GetAll(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("application_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("application_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
 	}
 
+	// can return InternalServiceError
+	err = repository.loadRelations(ctx, results, includeCompany, includeRecruiter, includePersons, includeEvents)
+	if err != nil {
+		return nil, err
+	}
+
 	return results, nil
 }
 
-// Update can return InternalServiceError, ValidationError
-func (repository *ApplicationRepository) Update(application *models.UpdateApplication) error {
+// Update can return ConflictError, InternalServiceError, ValidationError
+func (repository *ApplicationRepository) Update(ctx context.Context, application *models.UpdateApplication) error {
+	logger := logging.FromContext(ctx)
 	var sqlString strings.Builder
 	var sqlParts []string
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
 		UPDATE application SET
-			updated_date = ?, 
+			updated_date = ?,
+			version = version + 1,
 			`)
 	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
 
@@ -328,14 +488,14 @@ func (repository *ApplicationRepository) Update(application *models.UpdateApplic
 	}
 
 	if updateItemCount == 0 {
-		slog.Info("application_repository.Update: nothing to update", "id", application.ID)
+		logger.Info("application_repository.Update: nothing to update", "id", application.ID)
 		return internalErrors.NewValidationError(nil, "nothing to update")
 	}
 
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
 	if err != nil {
 		var message = "unable to join SQL statement string"
-		slog.Error("application_repository.Update: unable to join SQL statement string", "error", err)
+		logger.Error("application_repository.Update: unable to join SQL statement string", "error", err)
 		return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -345,34 +505,133 @@ func (repository *ApplicationRepository) Update(application *models.UpdateApplic
 		WHERE id = ? `)
 	sqlVars = append(sqlVars, application.ID)
 
-	_, err = repository.database.Exec(
+	if application.ExpectedVersion != nil {
+		sqlString.WriteString("AND version = ? ")
+		sqlVars = append(sqlVars, *application.ExpectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
 		sqlString.String(),
 		sqlVars...,
 	)
 
 	if err != nil {
-		slog.Error(
+		// can return ConflictError, ValidationError
+		if constraintErr := translateConstraintError(err, "CompanyID or RecruiterID"); constraintErr != nil {
+			logger.Info("application_repository.Update: constraint failed", "id", application.ID, "error", err)
+			return constraintErr
+		}
+
+		logger.Error(
 			"application_repository.Update: unable to update application",
 			"id", application.ID,
 			"error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
-	return err
+	if application.ExpectedVersion != nil {
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error(
+				"application_repository.Update: unable to update application",
+				"id", application.ID,
+				"error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+		if rowsAffected == 0 {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"application", "id", "Application", application.ID, *application.ExpectedVersion)
+		}
+	}
+
+	return nil
 }
 
-// Delete can return InternalServiceError, NotFoundError, ValidationError
-func (repository *ApplicationRepository) Delete(id *uuid.UUID) error {
+// GetAllJobAdURLs returns the job ad URL of every application that has one, by application ID.
+//
+// GetAllJobAdURLs can return InternalServiceError
+func (repository *ApplicationRepository) GetAllJobAdURLs(ctx context.Context) (map[uuid.UUID]string, error) {
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(
+		ctx, "SELECT id, job_ad_url FROM application WHERE job_ad_url IS NOT NULL")
+	if err != nil {
+		logger.Error("application_repository.GetAllJobAdURLs: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+	defer rows.Close()
+
+	results := make(map[uuid.UUID]string)
+	for rows.Next() {
+		var id uuid.UUID
+		var jobAdURL string
+		if err := rows.Scan(&id, &jobAdURL); err != nil {
+			logger.Error("application_repository.GetAllJobAdURLs: Error scanning row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing application data: " + err.Error())
+		}
+		results[id] = jobAdURL
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("application_repository.GetAllJobAdURLs: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading applications from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// UpdateJobAdURL replaces the job ad URL of the application with id, and increments its version. The updated date is
+// kept, as the URL is only stored in another form.
+//
+// UpdateJobAdURL can return InternalServiceError, NotFoundError
+func (repository *ApplicationRepository) UpdateJobAdURL(ctx context.Context, id uuid.UUID, jobAdURL string) error {
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx, "UPDATE application SET job_ad_url = ?, version = version + 1 WHERE id = ?", jobAdURL, id)
+	if err != nil {
+		logger.Error("application_repository.UpdateJobAdURL: unable to update application", "id", id, "error", err)
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, "Application does not exist. ID: "+id.String())
+}
+
+// Delete deletes the application with id. If expectedVersion is set, it is only deleted if it still has that version.
+//
+// Delete can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *ApplicationRepository) Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Error("application_repository.Delete: ID is nil")
+		logger.Error("application_repository.Delete: ID is nil")
 		return internalErrors.NewValidationError(nil, "ID is nil")
 	}
 
 	sqlDelete := "DELETE FROM application WHERE id = ?"
+	sqlVars := []interface{}{id}
+	if expectedVersion != nil {
+		sqlDelete += " AND version = ?"
+		sqlVars = append(sqlVars, *expectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
 
-	result, err := repository.database.Exec(sqlDelete, id)
+	result, err := repository.database.ExecContext(ctx, sqlDelete, sqlVars...)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_repository.Delete: Error trying to delete application",
 			"id", id,
 			"error", err.Error())
@@ -381,13 +640,18 @@ func (repository *ApplicationRepository) Delete(id *uuid.UUID) error {
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"application_repository.Delete: Error trying to delete application",
 			"id", id,
 			"error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 	if rowsAffected == 0 {
+		if expectedVersion != nil {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"application", "id", "Application", *id, *expectedVersion)
+		}
 		return internalErrors.NewNotFoundError("Application does not exist. ID: " + id.String())
 	} else if rowsAffected > 1 {
 		return internalErrors.NewInternalServiceError(
@@ -398,16 +662,13 @@ func (repository *ApplicationRepository) Delete(id *uuid.UUID) error {
 }
 
 func (repository *ApplicationRepository) mapRow(
+	ctx context.Context,
 	scanner interface{ Scan(...interface{}) error }, methodName string) (*models.Application, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.Application
-	var applicationDate,
-		createdDate,
-		updatedDate,
-		companyString,
-		recruiterString,
-		personsString,
-		eventsString sql.NullString
+	var applicationDate, createdDate, updatedDate sql.NullString
 
 	err := scanner.Scan(
 		&result.ID,
@@ -424,10 +685,7 @@ func (repository *ApplicationRepository) mapRow(
 		&applicationDate,
 		&createdDate,
 		&updatedDate,
-		&companyString,
-		&recruiterString,
-		&personsString,
-		&eventsString,
+		&result.Version,
 	)
 
 	if err != nil {
@@ -437,7 +695,7 @@ func (repository *ApplicationRepository) mapRow(
 	if applicationDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, applicationDate.String)
 		if err != nil {
-			slog.Error(
+			logger.Error(
 				"application_repository."+methodName+": Error parsing applicationDate",
 				"applicationDate", applicationDate,
 				"error", err.Error())
@@ -449,7 +707,7 @@ func (repository *ApplicationRepository) mapRow(
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("application_repository."+methodName+": Error parsing createdDate",
+			logger.Error("application_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -460,7 +718,7 @@ func (repository *ApplicationRepository) mapRow(
 	if updatedDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, updatedDate.String)
 		if err != nil {
-			slog.Error("application_repository."+methodName+": Error parsing updatedDate",
+			logger.Error("application_repository."+methodName+": Error parsing updatedDate",
 				"updatedDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing updatedDate: " + err.Error())
@@ -468,190 +726,101 @@ func (repository *ApplicationRepository) mapRow(
 		result.UpdatedDate = &timestamp
 	}
 
-	if companyString.Valid {
-		var company *models.Company
-		if err := json.NewDecoder(strings.NewReader(companyString.String)).Decode(&company); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing company: " + err.Error())
-		}
-		if company != nil {
-			result.Company = company
-		}
-	}
-
-	if recruiterString.Valid {
-		var recruiter *models.Company
-		if err := json.NewDecoder(strings.NewReader(recruiterString.String)).Decode(&recruiter); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing recruiter: " + err.Error())
-		}
-		if recruiter != nil {
-			result.Recruiter = recruiter
-		}
-	}
-
-	if personsString.Valid {
-		var persons []*models.Person
-		if err := json.NewDecoder(strings.NewReader(personsString.String)).Decode(&persons); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing persons: " + err.Error())
-		}
-
-		if len(persons) > 0 {
-			result.Persons = &persons
-		}
-	}
-
-	if eventsString.Valid {
-		var events []*models.Event
-		if err := json.NewDecoder(strings.NewReader(eventsString.String)).Decode(&events); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing events: " + err.Error())
-		}
-
-		if len(events) > 0 {
-			result.Events = &events
-		}
-	}
-
 	return &result, nil
 }
 
-func (repository *ApplicationRepository) buildCompanyCoalesceAndJoin(
-	includeCompany models.IncludeExtraDataType) (string, string) {
-
-	if includeCompany == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		CASE 
-			WHEN c.id IS NOT NULL THEN JSON_OBJECT(
-				'ID', c.id%s
-			)
-			ELSE NULL
-		END as company`
-
-	allColumns := ""
-	if includeCompany == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-				'Name', c.name, 
-				'CompanyType', c.company_type,  
-				'Notes', c.notes, 
-				'LastContact', c.last_contact, 
-				'CreatedDate', c.created_date, 
-				'UpdatedDate', c.updated_date`
-	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString := "\n\t\tLEFT JOIN company c ON (a.company_id = c.id)"
-
-	return coalesceString, joinString
-}
-
-func (repository *ApplicationRepository) buildRecruiterCoalesceAndJoin(
-	includeRecruiter models.IncludeExtraDataType) (string, string) {
-
-	if includeRecruiter == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		CASE 
-			WHEN r.id IS NOT NULL THEN JSON_OBJECT(
-				'ID', r.id%s
-			)
-			ELSE NULL
-		END as recruiter`
+// loadRelations adds the company, recruiter, persons and events to applications, as selected by the include types.
+// Each relation is loaded with batched queries, instead of joins that repeat each application for every relation.
+//
+// loadRelations can return InternalServiceError
+func (repository *ApplicationRepository) loadRelations(
+	ctx context.Context,
+	applications []*models.Application,
+	includeCompany models.IncludeExtraDataType,
+	includeRecruiter models.IncludeExtraDataType,
+	includePersons models.IncludeExtraDataType,
+	includeEvents models.IncludeExtraDataType) error {
 
-	allColumns := ""
-	if includeRecruiter == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-				'Name', r.name, 
-				'CompanyType', r.company_type,  
-				'Notes', r.notes, 
-				'LastContact', r.last_contact, 
-				'CreatedDate', r.created_date, 
-				'UpdatedDate', r.updated_date`
+	applicationIDs := make([]uuid.UUID, len(applications))
+	for index, application := range applications {
+		applicationIDs[index] = application.ID
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
 
-	joinString := "\n\t\tLEFT JOIN company r ON (a.recruiter_id = r.id)"
+	if includeCompany != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "application", ownerColumn: "id", relatedColumn: "company_id", relatedTable: "company"}
 
-	return coalesceString, joinString
-}
-
-func (repository *ApplicationRepository) buildPersonsCoalesceAndJoin(
-	includePersons models.IncludeExtraDataType) (string, string) {
-
-	if includePersons == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+		// can return InternalServiceError
+		companies, err := loadRelatedCompanies(
+			ctx, repository.database, repository.queryTimeout, link, applicationIDs, includeCompany)
+		if err != nil {
+			return err
+		}
+		for _, application := range applications {
+			if company := companies[application.ID]; len(company) > 0 {
+				application.Company = company[0]
+			}
+		}
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', p.id%s
-				) ORDER BY p.created_date DESC
-			) FILTER (WHERE p.id IS NOT NULL),
-			JSON_ARRAY()
-		) as persons
-`
-
-	allColumns := ""
-	if includePersons == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'Name', p.name,
-					'PersonType', p.person_type,
-					'Email', p.email,
-					'Phone', p.phone,
-					'Notes', p.notes,
-					'CreatedDate', p.created_date,
-					'UpdatedDate', p.updated_date`
+	if includeRecruiter != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "application", ownerColumn: "id", relatedColumn: "recruiter_id", relatedTable: "company"}
 
+		// can return InternalServiceError
+		recruiters, err := loadRelatedCompanies(
+			ctx, repository.database, repository.queryTimeout, link, applicationIDs, includeRecruiter)
+		if err != nil {
+			return err
+		}
+		for _, application := range applications {
+			if recruiter := recruiters[application.ID]; len(recruiter) > 0 {
+				application.Recruiter = recruiter[0]
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString :=
-		`LEFT JOIN application_person ap ON (ap.application_id = a.id)
-		LEFT JOIN person p ON (ap.person_id = p.id)
-`
-
-	return coalesceString, joinString
-}
 
-func (repository *ApplicationRepository) buildEventsCoalesceAndJoin(
-	includeEvents models.IncludeExtraDataType) (string, string) {
+	if includePersons != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable:     "application_person",
+			ownerColumn:   "application_id",
+			relatedColumn: "person_id",
+			relatedTable:  "person",
+		}
 
-	if includeEvents == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+		// can return InternalServiceError
+		persons, err := loadRelatedPersons(
+			ctx, repository.database, repository.queryTimeout, link, applicationIDs, includePersons)
+		if err != nil {
+			return err
+		}
+		for _, application := range applications {
+			if applicationPersons := persons[application.ID]; len(applicationPersons) > 0 {
+				application.Persons = &applicationPersons
+			}
+		}
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', e.id%s
-				) ORDER BY e.event_date DESC
-			) FILTER (WHERE e.id IS NOT NULL),
-			JSON_ARRAY()
-		) as events
-`
-
-	allColumns := ""
-	if includeEvents == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'EventType', e.event_type,
-					'Description', e.description,
-					'Notes', e.notes,
-					'EventDate', e.event_date,
-					'CreatedDate', e.created_date,
-					'UpdatedDate', e.updated_date`
+	if includeEvents != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable:     "application_event",
+			ownerColumn:   "application_id",
+			relatedColumn: "event_id",
+			relatedTable:  "event",
+		}
 
+		// can return InternalServiceError
+		events, err := loadRelatedEvents(
+			ctx, repository.database, repository.queryTimeout, link, applicationIDs, includeEvents)
+		if err != nil {
+			return err
+		}
+		for _, application := range applications {
+			if applicationEvents := events[application.ID]; len(applicationEvents) > 0 {
+				application.Events = &applicationEvents
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString :=
-		`LEFT JOIN application_event ae ON (ae.application_id = a.id)
-		LEFT JOIN event e ON (ae.event_id = e.id)
-`
 
-	return coalesceString, joinString
+	return nil
 }
@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -100,7 +101,7 @@ func TestCreate_ShouldInsertAndReturnApplication(t *testing.T) {
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
 
-	insertedApplication, err := applicationRepository.Create(&application)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &application)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -131,7 +132,7 @@ func TestCreate_ShouldInsertAndReturnWithMinimumRequiredFields(t *testing.T) {
 	}
 
 	createdDateApproximation := time.Now()
-	insertedApplication, err := applicationRepository.Create(&application)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &application)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -161,7 +162,7 @@ func TestCreate_ShouldReturnErrorIfCompanyIDAndRecruiterIDIsNil(t *testing.T) {
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
 
-	application, err := applicationRepository.Create(&createApplication)
+	application, err := applicationRepository.Create(context.Background(), &createApplication)
 	assert.Nil(t, application)
 	assert.Error(t, err)
 
@@ -180,13 +181,16 @@ func TestCreate_ShouldReturnErrorIfCompanyIDIsNotInCompany(t *testing.T) {
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
 
-	application, err := applicationRepository.Create(&createApplication)
+	application, err := applicationRepository.Create(context.Background(), &createApplication)
 	assert.Nil(t, application)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or RecruiterID': CompanyID or RecruiterID does not exist",
+		validationError.Error())
 }
 
 func TestCreate_ShouldReturnErrorIfRecruiterIDIsNotInCompany(t *testing.T) {
@@ -199,13 +203,16 @@ func TestCreate_ShouldReturnErrorIfRecruiterIDIsNotInCompany(t *testing.T) {
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
 
-	application, err := applicationRepository.Create(&createApplication)
+	application, err := applicationRepository.Create(context.Background(), &createApplication)
 	assert.Nil(t, application)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or RecruiterID': CompanyID or RecruiterID does not exist",
+		validationError.Error())
 }
 
 func TestCreate_ShouldReturnErrorIfJobTitleAndJobAdURLIsNil(t *testing.T) {
@@ -220,7 +227,7 @@ func TestCreate_ShouldReturnErrorIfJobTitleAndJobAdURLIsNil(t *testing.T) {
 		RemoteStatusType: models.RemoteStatusTypeOffice,
 	}
 
-	application, err := applicationRepository.Create(&createApplication)
+	application, err := applicationRepository.Create(context.Background(), &createApplication)
 	assert.Nil(t, application)
 	assert.Error(t, err)
 
@@ -254,11 +261,11 @@ func TestGetById_ShouldGetApplication(t *testing.T) {
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
 
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
-	retrievedApplication, err := applicationRepository.GetById(applicationToInsert.ID)
+	retrievedApplication, err := applicationRepository.GetById(context.Background(), applicationToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedApplication)
 
@@ -280,7 +287,7 @@ func TestGetById_ShouldGetApplication(t *testing.T) {
 func TestGetById_ShouldReturnErrorIfApplicationIDIsNil(t *testing.T) {
 	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
 
-	response, err := applicationRepository.GetById(nil)
+	response, err := applicationRepository.GetById(context.Background(), nil)
 	assert.Nil(t, response)
 	assert.Error(t, err)
 
@@ -294,7 +301,7 @@ func TestGetById_ShouldReturnErrorIfApplicationIDDoesNotExist(t *testing.T) {
 
 	id := uuid.New()
 
-	response, err := applicationRepository.GetById(&id)
+	response, err := applicationRepository.GetById(context.Background(), &id)
 	assert.Nil(t, response)
 	assert.Error(t, err)
 
@@ -327,7 +334,7 @@ func TestGetAllByJobTitle_ShouldReturnApplications(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedApplication1, err := applicationRepository.Create(&application1ToInsert)
+	insertedApplication1, err := applicationRepository.Create(context.Background(), &application1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
@@ -337,11 +344,11 @@ func TestGetAllByJobTitle_ShouldReturnApplications(t *testing.T) {
 		JobTitle:         testutil.ToPtr("Another Job name"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	insertedApplication2, err := applicationRepository.Create(&application2ToInsert)
+	insertedApplication2, err := applicationRepository.Create(context.Background(), &application2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication2)
 
-	applications, err := applicationRepository.GetAllByJobTitle(testutil.ToPtr("Job"))
+	applications, err := applicationRepository.GetAllByJobTitle(context.Background(), testutil.ToPtr("Job"))
 	assert.NoError(t, err)
 	assert.NotNil(t, applications)
 	assert.Len(t, applications, 2)
@@ -366,7 +373,7 @@ func TestGetAllByJobTitle_ShouldReturnApplications(t *testing.T) {
 func TestGetAllByJobTitle_ShouldReturnValidationErrorIfApplicationNameIsNil(t *testing.T) {
 	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
 
-	retrievedApplications, err := applicationRepository.GetAllByJobTitle(nil)
+	retrievedApplications, err := applicationRepository.GetAllByJobTitle(context.Background(), nil)
 	assert.Nil(t, retrievedApplications)
 	assert.Error(t, err)
 
@@ -380,7 +387,7 @@ func TestGetAllByJobTitle_ShouldReturnNotFoundErrorIfApplicationNameDoesNotExist
 
 	jobTitle := "Doesnt Exist"
 
-	application, err := applicationRepository.GetAllByJobTitle(&jobTitle)
+	application, err := applicationRepository.GetAllByJobTitle(context.Background(), &jobTitle)
 	assert.Nil(t, application)
 	assert.Error(t, err)
 
@@ -389,6 +396,110 @@ func TestGetAllByJobTitle_ShouldReturnNotFoundErrorIfApplicationNameDoesNotExist
 	assert.Equal(t, "error: object not found: JobTitle: '"+jobTitle+"'", notFoundError.Error())
 }
 
+// -------- GetAllByJobAdURLOrCompanyAndJobTitle tests: --------
+
+func TestGetAllByJobAdURLOrCompanyAndJobTitle_ShouldReturnApplicationsWithSameURLOrCompanyAndJobTitle(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	otherCompanyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	sameURL, err := applicationRepository.Create(context.Background(), &models.CreateApplication{
+		CompanyID:        otherCompanyID,
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/1"),
+		RemoteStatusType: models.RemoteStatusTypeOffice,
+		CreatedDate:      testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
+	})
+	assert.NoError(t, err)
+
+	sameCompanyAndJobTitle, err := applicationRepository.Create(context.Background(), &models.CreateApplication{
+		CompanyID:        companyID,
+		JobTitle:         testutil.ToPtr("backend developer "),
+		RemoteStatusType: models.RemoteStatusTypeOffice,
+		CreatedDate:      testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
+	})
+	assert.NoError(t, err)
+
+	// same job title at another company
+	_, err = applicationRepository.Create(context.Background(), &models.CreateApplication{
+		CompanyID:        otherCompanyID,
+		JobTitle:         testutil.ToPtr("Backend Developer"),
+		RemoteStatusType: models.RemoteStatusTypeOffice,
+	})
+	assert.NoError(t, err)
+
+	applications, err := applicationRepository.GetAllByJobAdURLOrCompanyAndJobTitle(
+		context.Background(), testutil.ToPtr("https://acme.se/jobs/1"), companyID, testutil.ToPtr("Backend Developer"))
+	assert.NoError(t, err)
+	assert.Len(t, applications, 2)
+	assert.Equal(t, sameURL.ID, applications[0].ID)
+	assert.Equal(t, sameCompanyAndJobTitle.ID, applications[1].ID)
+}
+
+func TestGetAllByJobAdURLOrCompanyAndJobTitle_ShouldReturnNothingIfArgumentsAreNil(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	repositoryhelpers.CreateApplication(t, applicationRepository, nil, companyID, nil, nil)
+
+	applications, err := applicationRepository.GetAllByJobAdURLOrCompanyAndJobTitle(
+		context.Background(), nil, companyID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, applications)
+}
+
+// -------- GetAllByCompanyID tests: --------
+
+func TestGetAllByCompanyID_ShouldReturnApplicationsWithCompanyAsCompanyOrRecruiter(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	otherCompanyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	asCompany := repositoryhelpers.CreateApplication(
+		t, applicationRepository, nil, companyID, nil, testutil.ToPtr(time.Now().AddDate(0, 0, -2)))
+	asRecruiter := repositoryhelpers.CreateApplication(
+		t, applicationRepository, nil, otherCompanyID, companyID, testutil.ToPtr(time.Now().AddDate(0, 0, -1)))
+	repositoryhelpers.CreateApplication(t, applicationRepository, nil, otherCompanyID, nil, nil)
+
+	applications, err := applicationRepository.GetAllByCompanyID(context.Background(), companyID)
+	assert.NoError(t, err)
+	assert.Len(t, applications, 2)
+	assert.Equal(t, asCompany.ID, applications[0].ID)
+	assert.Equal(t, asRecruiter.ID, applications[1].ID)
+}
+
+// -------- GetAllByIDs tests: --------
+
+func TestGetAllByIDs_ShouldReturnApplicationsInOrderOfIDs(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+	companyID := &repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	application1 := repositoryhelpers.CreateApplication(t, applicationRepository, nil, companyID, nil, nil)
+	application2 := repositoryhelpers.CreateApplication(t, applicationRepository, nil, companyID, nil, nil)
+	repositoryhelpers.CreateApplication(t, applicationRepository, nil, companyID, nil, nil)
+
+	applications, err := applicationRepository.GetAllByIDs(
+		context.Background(), []uuid.UUID{application2.ID, uuid.New(), application1.ID, application2.ID})
+	assert.NoError(t, err)
+	assert.Len(t, applications, 2)
+	assert.Equal(t, application2.ID, applications[0].ID)
+	assert.Equal(t, application1.ID, applications[1].ID)
+	assert.Equal(t, application1.JobTitle, applications[1].JobTitle)
+	assert.Equal(t, 1, applications[1].Version)
+}
+
+func TestGetAllByIDs_ShouldReturnValidationErrorIfIDsAreEmpty(t *testing.T) {
+	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
+
+	applications, err := applicationRepository.GetAllByIDs(context.Background(), []uuid.UUID{})
+	assert.Nil(t, applications)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'IDs': IDs is empty", err.Error())
+}
+
 // -------- GetAll - Base tests: --------
 
 func TestGetAll_ShouldReturnAllApplications(t *testing.T) {
@@ -412,7 +523,7 @@ func TestGetAll_ShouldReturnAllApplications(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedApplication1, err := applicationRepository.Create(&application1ToInsert)
+	insertedApplication1, err := applicationRepository.Create(context.Background(), &application1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
@@ -426,6 +537,7 @@ func TestGetAll_ShouldReturnAllApplications(t *testing.T) {
 	)
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -457,6 +569,7 @@ func TestGetAll_ShouldReturnNilIfNoApplicationsInDatabase(t *testing.T) {
 	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
 
 	applications, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -481,7 +594,7 @@ func TestGetAll_ShouldReturnCompanyIfIncludeCompanyIsSetToAll(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -490,13 +603,14 @@ func TestGetAll_ShouldReturnCompanyIfIncludeCompanyIsSetToAll(t *testing.T) {
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -535,13 +649,14 @@ func TestGetAll_ShouldReturnNoCompanyIfIncludeCompanyIsSetToAllAndThereIsNoCompa
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -571,7 +686,7 @@ func TestGetAll_ShouldReturnCompanyWithOnlyIDIfIncludeCompanyIsSetToIDs(t *testi
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -580,13 +695,14 @@ func TestGetAll_ShouldReturnCompanyWithOnlyIDIfIncludeCompanyIsSetToIDs(t *testi
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -624,13 +740,14 @@ func TestGetAll_ShouldReturnNoCompanyIncludeCompanyIsSetToIDsAndThereIsNoCompany
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -659,13 +776,14 @@ func TestGetAll_ShouldReturnNoCompanyIfIncludeCompanyIsSetToNone(t *testing.T) {
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication1, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication1, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -697,7 +815,7 @@ func TestGetAll_ShouldReturnRecruiterIfIncludeRecruiterIsSetToAll(t *testing.T)
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -706,13 +824,14 @@ func TestGetAll_ShouldReturnRecruiterIfIncludeRecruiterIsSetToAll(t *testing.T)
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
@@ -750,13 +869,14 @@ func TestGetAll_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToAllAndThereIsNoR
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
@@ -786,7 +906,7 @@ func TestGetAll_ShouldReturnRecruiterWithOnlyIDIfIncludeRecruiterIsSetToIDs(t *t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -795,13 +915,14 @@ func TestGetAll_ShouldReturnRecruiterWithOnlyIDIfIncludeRecruiterIsSetToIDs(t *t
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
@@ -839,13 +960,14 @@ func TestGetAll_ShouldReturnNoCompanyIncludeRecruiterIsSetToIDsAndThereIsNoRecru
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -875,7 +997,7 @@ func TestGetAll_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToNone(t *testing.
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&recruiterToInsert)
+	_, err := companyRepository.Create(context.Background(), &recruiterToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -884,13 +1006,14 @@ func TestGetAll_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToNone(t *testing.
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication1, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication1, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -921,7 +1044,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -936,7 +1059,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = eventRepository.Create(&event1ToInsert)
+	_, err = eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -958,6 +1081,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -997,7 +1121,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToA
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1006,6 +1130,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToA
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1034,7 +1159,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToI
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1049,7 +1174,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToI
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = eventRepository.Create(&event1ToInsert)
+	_, err = eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -1071,6 +1196,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToI
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1110,7 +1236,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToI
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1119,6 +1245,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToI
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1147,7 +1274,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToN
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1165,6 +1292,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToN
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1195,7 +1323,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToA
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1209,7 +1337,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToA
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = personRepository.Create(&person1ToInsert)
+	_, err = personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1231,6 +1359,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToA
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
@@ -1272,7 +1401,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1281,6 +1410,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
@@ -1309,7 +1439,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetT
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1323,7 +1453,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetT
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = personRepository.Create(&person1ToInsert)
+	_, err = personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1345,6 +1475,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetT
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1386,7 +1517,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1395,6 +1526,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1423,7 +1555,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1439,6 +1571,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all applications
 
 	results, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1492,6 +1625,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnTwoApplicationsEvenIfOnePersonI
 	// ensure that two applications are returned
 
 	applicationsWithEvents, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1544,6 +1678,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnTwoApplicationsEvenIfOneEventIs
 	// ensure that two results are returned, each with a person
 
 	applicationsWithEvents, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1600,6 +1735,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnApplicationWithOnePersonAndTwoE
 	// Ensure that the application is returned with a single person and two events
 
 	applicationWithPersonAndEvents, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1659,6 +1795,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnApplicationTwoPersonsAndOneEven
 	// Ensure that the application is returned with two persons and one event
 
 	applicationWithPersonAndEvents, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1734,6 +1871,7 @@ func TestApplicationRepositoryGetAll_ShouldReturnApplicationTwoPersonsAndTwoEven
 	// Ensure that the application is returned with two persons and two events
 
 	applicationWithPersonAndEvents, err := applicationRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1777,7 +1915,7 @@ func TestUpdate_ShouldUpdateApplication(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 20)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 30)),
 	}
-	insertedApplication, err := applicationRepository.Create(&applicationToInsert)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1803,12 +1941,12 @@ func TestUpdate_ShouldUpdateApplication(t *testing.T) {
 	}
 
 	updatedDateApproximation := time.Now()
-	err = applicationRepository.Update(&applicationToUpdate)
+	err = applicationRepository.Update(context.Background(), &applicationToUpdate)
 	assert.NoError(t, err)
 
 	// get the company and verify that it's updated
 
-	retrievedApplication, err := applicationRepository.GetById(applicationToInsert.ID)
+	retrievedApplication, err := applicationRepository.GetById(context.Background(), applicationToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedApplication)
 
@@ -1834,7 +1972,7 @@ func TestUpdate_ShouldReturnValidationErrorIfNoApplicationFieldsToUpdate(t *test
 		ID: uuid.New(),
 	}
 
-	err := applicationRepository.Update(&applicationToUpdate)
+	err := applicationRepository.Update(context.Background(), &applicationToUpdate)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -1849,8 +1987,108 @@ func TestUpdate_ShouldNotReturnErrorIfApplicationDoesNotExist(t *testing.T) {
 		ID:       uuid.New(),
 		JobTitle: testutil.ToPtr("Another Job Title"),
 	}
-	err := applicationRepository.Update(&applicationToUpdate)
+	err := applicationRepository.Update(context.Background(), &applicationToUpdate)
+	assert.NoError(t, err)
+}
+
+func TestUpdate_ShouldIncrementApplicationVersion(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	assert.Equal(t, 1, application.Version)
+
+	err := applicationRepository.Update(context.Background(), &models.UpdateApplication{
+		ID:              application.ID,
+		JobTitle:        testutil.ToPtr("New job title"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	assert.NoError(t, err)
+
+	err = applicationRepository.Update(context.Background(), &models.UpdateApplication{
+		ID:       application.ID,
+		JobTitle: testutil.ToPtr("Newer job title"),
+	})
+	assert.NoError(t, err)
+
+	updatedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
 	assert.NoError(t, err)
+	assert.Equal(t, 3, updatedApplication.Version)
+	assert.Equal(t, "Newer job title", *updatedApplication.JobTitle)
+}
+
+func TestUpdate_ShouldReturnPreconditionFailedErrorIfApplicationVersionDoesNotMatch(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+
+	err := applicationRepository.Update(context.Background(), &models.UpdateApplication{
+		ID:              application.ID,
+		JobTitle:        testutil.ToPtr("New job title"),
+		ExpectedVersion: testutil.ToPtr(2),
+	})
+	assert.Error(t, err)
+
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+	assert.Equal(
+		t,
+		"precondition failed: Application has been changed since it was read. Expected version 2, current version is 1",
+		preconditionFailedError.Error())
+
+	retrievedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, retrievedApplication.Version)
+	assert.Equal(t, "JobTitle", *retrievedApplication.JobTitle)
+}
+
+func TestUpdate_ShouldReturnNotFoundErrorIfExpectedVersionIsSetAndApplicationDoesNotExist(t *testing.T) {
+	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
+
+	id := uuid.New()
+	err := applicationRepository.Update(context.Background(), &models.UpdateApplication{
+		ID:              id,
+		JobTitle:        testutil.ToPtr("New job title"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	assert.Error(t, err)
+
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+	assert.Equal(t, "error: object not found: Application does not exist. ID: "+id.String(), notFoundError.Error())
+}
+
+// -------- GetAllJobAdURLs and UpdateJobAdURL tests: --------
+
+func TestUpdateJobAdURL_ShouldReplaceURLReturnedByGetAllJobAdURLs(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	application, err := applicationRepository.Create(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobAdURL:         testutil.ToPtr("www.acme.se/jobs/123/"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	jobAdURLs, err := applicationRepository.GetAllJobAdURLs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]string{application.ID: "www.acme.se/jobs/123/"}, jobAdURLs)
+
+	err = applicationRepository.UpdateJobAdURL(context.Background(), application.ID, "https://acme.se/jobs/123")
+	assert.NoError(t, err)
+
+	retrievedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.se/jobs/123", *retrievedApplication.JobAdURL)
+	assert.Equal(t, 2, retrievedApplication.Version)
+	assert.Nil(t, retrievedApplication.UpdatedDate)
+
+	err = applicationRepository.UpdateJobAdURL(context.Background(), uuid.New(), "https://acme.se/jobs/123")
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
 }
 
 // -------- Delete tests: --------
@@ -1868,13 +2106,13 @@ func TestDelete_ShouldDeleteApplication(t *testing.T) {
 		JobTitle:         testutil.ToPtr("JobTitle"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	_, err := applicationRepository.Create(&applicationToAdd)
+	_, err := applicationRepository.Create(context.Background(), &applicationToAdd)
 	assert.NoError(t, err)
 
-	err = applicationRepository.Delete(&id)
+	err = applicationRepository.Delete(context.Background(), &id, nil)
 	assert.NoError(t, err)
 
-	retrievedApplication, err := applicationRepository.GetById(&id)
+	retrievedApplication, err := applicationRepository.GetById(context.Background(), &id)
 	assert.Nil(t, retrievedApplication)
 	assert.Error(t, err)
 }
@@ -1882,7 +2120,7 @@ func TestDelete_ShouldDeleteApplication(t *testing.T) {
 func TestDelete_ShouldReturnValidationErrorIfApplicationIDIsNil(t *testing.T) {
 	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
 
-	err := applicationRepository.Delete(nil)
+	err := applicationRepository.Delete(context.Background(), nil, nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -1894,10 +2132,31 @@ func TestDelete_ShouldReturnNotFoundErrorIfApplicationIdDoesNotExist(t *testing.
 	applicationRepository, _, _, _, _, _ := setupApplicationRepository(t)
 
 	id := uuid.New()
-	err := applicationRepository.Delete(&id)
+	err := applicationRepository.Delete(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: Application does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+func TestDelete_ShouldOnlyDeleteApplicationIfVersionMatches(t *testing.T) {
+	applicationRepository, companyRepository, _, _, _, _ := setupApplicationRepository(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+
+	err := applicationRepository.Delete(context.Background(), &application.ID, testutil.ToPtr(2))
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	_, err = applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+
+	err = applicationRepository.Delete(context.Background(), &application.ID, testutil.ToPtr(1))
+	assert.NoError(t, err)
+
+	err = applicationRepository.Delete(context.Background(), &application.ID, testutil.ToPtr(1))
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
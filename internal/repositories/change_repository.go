@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/pkg/timeutil"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeRepository reads and prunes the change log. The log is written by triggers on the record and link tables, so
+// it has no Create.
+type ChangeRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewChangeRepository(database Executor, queryTimeout time.Duration) *ChangeRepository {
+	return &ChangeRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// GetLatestSequence returns the sequence of the latest change, or 0 if nothing has changed yet.
+//
+// GetLatestSequence can return InternalServiceError
+func (repository *ChangeRepository) GetLatestSequence(ctx context.Context) (int64, error) {
+	logger := logging.FromContext(ctx)
+
+	sqlSelect := `SELECT COALESCE(MAX(sequence), 0) FROM change_log `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	var sequence int64
+	err := repository.database.QueryRowContext(ctx, sqlSelect).Scan(&sequence)
+	if err != nil {
+		logger.Error("change_repository.GetLatestSequence: Error trying to query latest sequence", "error", err)
+		return 0, internalErrors.NewInternalServiceError("Error trying to query latest sequence: " + err.Error())
+	}
+
+	return sequence, nil
+}
+
+// GetAllAfterSequence returns up to limit changes with a sequence after afterSequence that match filter, oldest
+// first.
+//
+// GetAllAfterSequence can return InternalServiceError, ValidationError
+func (repository *ChangeRepository) GetAllAfterSequence(
+	ctx context.Context, afterSequence int64, filter *models.ChangeFilter, limit int) ([]*models.Change, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if limit <= 0 {
+		logger.Info("change_repository.GetAllAfterSequence: limit is not positive", "limit", limit)
+		return nil, internalErrors.NewValidationError(nil, "limit must be greater than 0")
+	}
+
+	sqlWhere := "WHERE sequence > ?"
+	sqlVars := []interface{}{afterSequence}
+
+	if filter != nil && len(filter.EntityTypes) > 0 {
+		placeholders := make([]string, len(filter.EntityTypes))
+		for index, entityType := range filter.EntityTypes {
+			placeholders[index] = "?"
+			sqlVars = append(sqlVars, entityType.String())
+		}
+		sqlWhere += " AND entity_type IN (" + strings.Join(placeholders, ", ") + ")"
+	}
+	sqlVars = append(sqlVars, limit)
+
+	sqlSelect := `
+		SELECT sequence, entity_type, change_type, entity_id, related_entity_id, change_date
+		FROM change_log
+		` + sqlWhere + `
+		ORDER BY sequence
+		LIMIT ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("change_repository.GetAllAfterSequence: Error trying to query changes", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query changes: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.Change
+	for rows.Next() {
+		// can return InternalServiceError
+		result, err := repository.mapRow(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("change_repository.GetAllAfterSequence: Error iterating over rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error iterating over rows: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// DeleteOlderThan deletes the changes made before before, and returns how many were deleted.
+//
+// DeleteOlderThan can return InternalServiceError
+func (repository *ChangeRepository) DeleteOlderThan(ctx context.Context, before time.Time) (int64, error) {
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
+		"DELETE FROM change_log WHERE change_date < ?",
+		before.UTC().Format(timeutil.RFC3339Milli_Write))
+	if err != nil {
+		logger.Error("change_repository.DeleteOlderThan: Error trying to delete changes", "error", err)
+		return 0, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("change_repository.DeleteOlderThan: Error reading rows affected", "error", err)
+		return 0, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	return rowsAffected, nil
+}
+
+// internal functions
+
+// mapRow can return InternalServiceError
+func (repository *ChangeRepository) mapRow(ctx context.Context, rows *sql.Rows) (*models.Change, error) {
+	logger := logging.FromContext(ctx)
+
+	var result models.Change
+	var relatedEntityID *uuid.UUID
+	var changeDate string
+
+	err := rows.Scan(
+		&result.Sequence,
+		&result.EntityType,
+		&result.ChangeType,
+		&result.EntityID,
+		&relatedEntityID,
+		&changeDate,
+	)
+	if err != nil {
+		logger.Error("change_repository.mapRow: Error scanning row", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error scanning change: " + err.Error())
+	}
+	result.RelatedEntityID = relatedEntityID
+
+	timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, changeDate)
+	if err != nil {
+		logger.Error("change_repository.mapRow: Error parsing changeDate", "changeDate", changeDate, "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error parsing changeDate: " + err.Error())
+	}
+	result.ChangeDate = timestamp
+
+	return &result, nil
+}
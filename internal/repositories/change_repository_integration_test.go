@@ -0,0 +1,168 @@
+package repositories_test
+
+import (
+	"context"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupChangeRepository(t *testing.T) (
+	*repositories.ChangeRepository,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository,
+	*repositories.CompanyPersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupChangeRepositoryTestContainer(t, *config)
+
+	var changeRepository *repositories.ChangeRepository
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	var companyPersonRepository *repositories.CompanyPersonRepository
+	err := container.Invoke(func(
+		change *repositories.ChangeRepository,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository,
+		companyPerson *repositories.CompanyPersonRepository) {
+
+		changeRepository = change
+		companyRepository = company
+		personRepository = person
+		companyPersonRepository = companyPerson
+	})
+	assert.NoError(t, err)
+
+	return changeRepository, companyRepository, personRepository, companyPersonRepository
+}
+
+// -------- GetLatestSequence tests: --------
+
+func TestGetLatestSequence_ShouldReturnZeroIfNothingHasChanged(t *testing.T) {
+	changeRepository, _, _, _ := setupChangeRepository(t)
+
+	sequence, err := changeRepository.GetLatestSequence(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sequence)
+}
+
+// -------- GetAllAfterSequence tests: --------
+
+func TestGetAllAfterSequence_ShouldReturnChangesRecordedByTriggers(t *testing.T) {
+	changeRepository, companyRepository, personRepository, companyPersonRepository := setupChangeRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, person.ID, nil)
+
+	err := companyRepository.Update(context.Background(), &models.UpdateCompany{
+		ID:   company.ID,
+		Name: testutil.ToPtr("New name"),
+	})
+	assert.NoError(t, err)
+
+	err = companyPersonRepository.Delete(
+		context.Background(), &models.DeleteCompanyPerson{CompanyID: company.ID, PersonID: person.ID})
+	assert.NoError(t, err)
+
+	changes, err := changeRepository.GetAllAfterSequence(context.Background(), 0, nil, models.MaxChangesPerRead)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 5)
+
+	expected := []struct {
+		entityType models.ChangeEntityType
+		changeType models.ChangeType
+	}{
+		{models.ChangeEntityTypeCompany, models.ChangeTypeCreated},
+		{models.ChangeEntityTypePerson, models.ChangeTypeCreated},
+		{models.ChangeEntityTypeCompanyPerson, models.ChangeTypeAssociated},
+		{models.ChangeEntityTypeCompany, models.ChangeTypeUpdated},
+		{models.ChangeEntityTypeCompanyPerson, models.ChangeTypeDeleted},
+	}
+	for index, change := range changes {
+		assert.Equal(t, int64(index+1), change.Sequence)
+		assert.Equal(t, expected[index].entityType, change.EntityType)
+		assert.Equal(t, expected[index].changeType, change.ChangeType)
+		assert.False(t, change.ChangeDate.IsZero())
+	}
+
+	assert.Equal(t, company.ID, changes[0].EntityID)
+	assert.Nil(t, changes[0].RelatedEntityID)
+
+	assert.Equal(t, company.ID, changes[2].EntityID)
+	assert.Equal(t, person.ID, *changes[2].RelatedEntityID)
+
+	latestSequence, err := changeRepository.GetLatestSequence(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), latestSequence)
+}
+
+func TestGetAllAfterSequence_ShouldFilterBySequenceEntityTypeAndLimit(t *testing.T) {
+	changeRepository, companyRepository, personRepository, _ := setupChangeRepository(t)
+
+	firstCompany := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	secondCompany := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	thirdCompany := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	filter := &models.ChangeFilter{EntityTypes: []models.ChangeEntityType{models.ChangeEntityTypeCompany}}
+
+	changes, err := changeRepository.GetAllAfterSequence(context.Background(), 0, filter, 2)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, firstCompany.ID, changes[0].EntityID)
+	assert.Equal(t, secondCompany.ID, changes[1].EntityID)
+
+	changes, err = changeRepository.GetAllAfterSequence(context.Background(), changes[1].Sequence, filter, 2)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, thirdCompany.ID, changes[0].EntityID)
+}
+
+func TestGetAllAfterSequence_ShouldReturnValidationErrorIfLimitIsNotPositive(t *testing.T) {
+	changeRepository, _, _, _ := setupChangeRepository(t)
+
+	changes, err := changeRepository.GetAllAfterSequence(context.Background(), 0, nil, 0)
+	assert.Nil(t, changes)
+	assert.EqualError(t, err, "validation error: limit must be greater than 0")
+}
+
+// -------- DeleteOlderThan tests: --------
+
+func TestDeleteOlderThan_ShouldOnlyDeleteChangesMadeBeforeTheGivenTime(t *testing.T) {
+	changeRepository, companyRepository, personRepository, _ := setupChangeRepository(t)
+
+	repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	deleted, err := changeRepository.DeleteOlderThan(context.Background(), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = changeRepository.DeleteOlderThan(context.Background(), time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+
+	changes, err := changeRepository.GetAllAfterSequence(context.Background(), 0, nil, models.MaxChangesPerRead)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// sequences are not reused after the changes are pruned
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	changes, err = changeRepository.GetAllAfterSequence(context.Background(), 0, nil, models.MaxChangesPerRead)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, int64(3), changes[0].Sequence)
+	assert.Equal(t, company.ID, changes[0].EntityID)
+}
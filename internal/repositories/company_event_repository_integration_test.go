@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -62,7 +63,7 @@ func TestAssociateCompanyToEvent_ShouldWork(t *testing.T) {
 		EventID:     eventID,
 		CreatedDate: testutil.ToPtr(time.Now()),
 	}
-	associatedCompanyEvent, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	associatedCompanyEvent, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedCompanyEvent)
 
@@ -81,7 +82,7 @@ func TestAssociateCompanyToEvent_ShouldWorkWithOnlyRequiredFields(t *testing.T)
 		CompanyID: companyID,
 		EventID:   eventID,
 	}
-	associatedCompanyEvent, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	associatedCompanyEvent, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedCompanyEvent)
 
@@ -102,7 +103,7 @@ func TestAssociateCompanyToEvent_ShouldAssociateAnCompanyToMultipleEvents(t *tes
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -110,10 +111,10 @@ func TestAssociateCompanyToEvent_ShouldAssociateAnCompanyToMultipleEvents(t *tes
 		EventID:     event2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
-	eventCompanies, err := companyEventRepository.GetAll()
+	eventCompanies, err := companyEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, eventCompanies)
 	assert.Len(t, eventCompanies, 2)
@@ -141,7 +142,7 @@ func TestAssociateCompanyToEvent_ShouldAssociateMultipleCompaniesToAEvent(t *tes
 		EventID:     event.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -149,10 +150,10 @@ func TestAssociateCompanyToEvent_ShouldAssociateMultipleCompaniesToAEvent(t *tes
 		EventID:     event.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
-	eventCompanies, err := companyEventRepository.GetAll()
+	eventCompanies, err := companyEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, eventCompanies)
 	assert.Len(t, eventCompanies, 2)
@@ -178,10 +179,10 @@ func TestAssociateCompanyToEvent_ShouldReturnConflictErrorIfCompanyIDAndEventIDC
 		CompanyID: companyID,
 		EventID:   eventID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -201,12 +202,15 @@ func TestAssociateCompanyToEvent_ShouldReturnValidationErrorIfEventIDDoesNotExis
 		CompanyID: companyID,
 		EventID:   uuid.New(),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or EventID': CompanyID or EventID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateCompanyToEvent_ShouldReturnValidationErrorIfCompanyIDDoesNotExist(t *testing.T) {
@@ -218,12 +222,15 @@ func TestAssociateCompanyToEvent_ShouldReturnValidationErrorIfCompanyIDDoesNotEx
 		CompanyID: uuid.New(),
 		EventID:   eventID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or EventID': CompanyID or EventID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateCompanyToEvent_ShouldReturnValidationErrorIfCompanyIDAndEventIDDoNotExist(t *testing.T) {
@@ -233,12 +240,15 @@ func TestAssociateCompanyToEvent_ShouldReturnValidationErrorIfCompanyIDAndEventI
 		CompanyID: uuid.New(),
 		EventID:   uuid.New(),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or EventID': CompanyID or EventID does not exist",
+		validationError.Error())
 }
 
 // -------- GetByID tests: --------
@@ -257,7 +267,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -265,7 +275,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		EventID:     event2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -273,10 +283,10 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	companyEvents, err := companyEventRepository.GetByID(&company1ID, nil)
+	companyEvents, err := companyEventRepository.GetByID(context.Background(), &company1ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, companyEvents, 2)
 
@@ -301,7 +311,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -309,7 +319,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -317,10 +327,10 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	companyEvents, err := companyEventRepository.GetByID(nil, &event1ID)
+	companyEvents, err := companyEventRepository.GetByID(context.Background(), nil, &event1ID)
 	assert.NoError(t, err)
 	assert.Len(t, companyEvents, 2)
 
@@ -344,24 +354,24 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyIDAndEventID(t *testing.T) {
 		CompanyID: company1ID,
 		EventID:   event1ID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
 		CompanyID: company1ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	events, err := companyEventRepository.GetByID(&company1ID, &event1ID)
+	events, err := companyEventRepository.GetByID(context.Background(), &company1ID, &event1ID)
 	assert.NoError(t, err)
 	assert.Len(t, events, 1)
 	assert.Equal(t, company1ID, events[0].CompanyID)
@@ -381,24 +391,24 @@ func TestCompanyEventGetByID_ShouldGetNoRecordsIfCompanyIDDoesNotMatch(t *testin
 		CompanyID: company1ID,
 		EventID:   event1ID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
 		CompanyID: company1ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	events, err := companyEventRepository.GetByID(testutil.ToPtr(uuid.New()), &event1ID)
+	events, err := companyEventRepository.GetByID(context.Background(), testutil.ToPtr(uuid.New()), &event1ID)
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -416,24 +426,24 @@ func TestCompanyEventGetByID_ShouldGetNoRecordsIfEventIDDoesNotMatch(t *testing.
 		CompanyID: company1ID,
 		EventID:   event1ID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
 		CompanyID: company1ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	events, err := companyEventRepository.GetByID(&company1ID, testutil.ToPtr(uuid.New()))
+	events, err := companyEventRepository.GetByID(context.Background(), &company1ID, testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -451,24 +461,25 @@ func TestGetByID_ShouldGetNoRecordsIfCompanyIDAndEventIDDoesNotMatch(t *testing.
 		CompanyID: company1ID,
 		EventID:   event1ID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
 		CompanyID: company1ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	events, err := companyEventRepository.GetByID(testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
+	events, err := companyEventRepository.GetByID(
+		context.Background(), testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -480,7 +491,7 @@ func TestCompanyEventGetByID_ShouldGetNoRecordsIfNoRecordsInDB(t *testing.T) {
 
 	eventID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
 
-	events, err := companyEventRepository.GetByID(&companyID, &eventID)
+	events, err := companyEventRepository.GetByID(context.Background(), &companyID, &eventID)
 	assert.NoError(t, err)
 	assert.Nil(t, events)
 }
@@ -501,7 +512,7 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -509,7 +520,7 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -517,10 +528,10 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	eventCompanies, err := companyEventRepository.GetAll()
+	eventCompanies, err := companyEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, eventCompanies, 3)
@@ -544,11 +555,99 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 func TestGetAllCompanyEvents_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	companyEventRepository, _, _ := setupCompanyEventRepository(t)
 
-	results, err := companyEventRepository.GetAll()
+	results, err := companyEventRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
 
+// -------- Update tests: --------
+
+func TestUpdateCompanyEvent_ShouldUpdateLinkDetails(t *testing.T) {
+	companyEventRepository, companyRepository, eventRepository := setupCompanyEventRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateCompanyEvent{
+		CompanyID: company.ID,
+		EventID:   event.ID,
+		LinkDetails: models.LinkDetails{
+			Role:      testutil.ToPtr("Hiring manager"),
+			StartDate: &startDate,
+		},
+	}
+	companyEvent, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &associateModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hiring manager", *companyEvent.Role)
+	assert.Nil(t, companyEvent.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, companyEvent.StartDate)
+	assert.Nil(t, companyEvent.EndDate)
+	assert.Nil(t, companyEvent.UpdatedDate)
+
+	endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	err = companyEventRepository.Update(context.Background(), &models.UpdateCompanyEvent{
+		CompanyID: company.ID,
+		EventID:   event.ID,
+		LinkDetails: models.LinkDetails{
+			Notes:   testutil.ToPtr("Moved to another team"),
+			EndDate: &endDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	companyEvents, err := companyEventRepository.GetByID(context.Background(), &company.ID, &event.ID)
+	assert.NoError(t, err)
+	assert.Len(t, companyEvents, 1)
+
+	updatedCompanyEvent := companyEvents[0]
+	assert.Equal(t, "Hiring manager", *updatedCompanyEvent.Role)
+	assert.Equal(t, "Moved to another team", *updatedCompanyEvent.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, updatedCompanyEvent.StartDate)
+	testutil.AssertEqualFormattedDateTimes(t, &endDate, updatedCompanyEvent.EndDate)
+	assert.NotNil(t, updatedCompanyEvent.UpdatedDate)
+}
+
+func TestUpdateCompanyEvent_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	companyEventRepository, companyRepository, eventRepository := setupCompanyEventRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateCompanyEvent{
+		CompanyID:   company.ID,
+		EventID:     event.ID,
+		LinkDetails: models.LinkDetails{StartDate: &startDate},
+	}
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &associateModel)
+	assert.NoError(t, err)
+
+	endDate := startDate.AddDate(0, 0, -1)
+	err = companyEventRepository.Update(context.Background(), &models.UpdateCompanyEvent{
+		CompanyID:   company.ID,
+		EventID:     event.ID,
+		LinkDetails: models.LinkDetails{EndDate: &endDate},
+	})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", err.Error())
+}
+
+func TestUpdateCompanyEvent_ShouldReturnNotFoundErrorIfLinkDoesNotExist(t *testing.T) {
+	companyEventRepository, _, _ := setupCompanyEventRepository(t)
+
+	err := companyEventRepository.Update(context.Background(), &models.UpdateCompanyEvent{
+		CompanyID:   uuid.New(),
+		EventID:     uuid.New(),
+		LinkDetails: models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
 // -------- Delete tests: --------
 
 func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
@@ -561,7 +660,7 @@ func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
 		CompanyID: companyID,
 		EventID:   eventID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	model := models.DeleteCompanyEvent{
@@ -569,7 +668,7 @@ func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
 		EventID:   eventID,
 	}
 
-	err = companyEventRepository.Delete(&model)
+	err = companyEventRepository.Delete(context.Background(), &model)
 	assert.NoError(t, err)
 }
 
@@ -583,7 +682,7 @@ func TestDeleteCompanyEvent_ShouldReturnNotFoundErrorIfNoMatchingCompanyEventInD
 		CompanyID: companyID,
 		EventID:   eventID,
 	}
-	_, err := companyEventRepository.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventRepository.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	model := models.DeleteCompanyEvent{
@@ -591,7 +690,7 @@ func TestDeleteCompanyEvent_ShouldReturnNotFoundErrorIfNoMatchingCompanyEventInD
 		EventID:   uuid.New(),
 	}
 
-	err = companyEventRepository.Delete(&model)
+	err = companyEventRepository.Delete(context.Background(), &model)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
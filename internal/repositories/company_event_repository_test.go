@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/testutil"
 	"testing"
@@ -39,9 +40,9 @@ func TestCompanyEventGetByID_ShouldReturnValidationError(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			repository := NewCompanyEventRepository(nil)
+			repository := NewCompanyEventRepository(nil, 0)
 
-			eventCompanies, err := repository.GetByID(test.companyID, test.eventID)
+			eventCompanies, err := repository.GetByID(context.Background(), test.companyID, test.eventID)
 			assert.Nil(t, eventCompanies)
 
 			assert.Error(t, err)
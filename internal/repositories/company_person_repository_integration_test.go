@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -60,7 +61,7 @@ func TestAssociateCompanyToPerson_ShouldWork(t *testing.T) {
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now()),
 	}
-	associatedCompanyPerson, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	associatedCompanyPerson, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedCompanyPerson)
 
@@ -79,7 +80,7 @@ func TestAssociateCompanyToPerson_ShouldWorkWithOnlyRequiredFields(t *testing.T)
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	associatedCompanyPerson, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	associatedCompanyPerson, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedCompanyPerson)
 
@@ -100,7 +101,7 @@ func TestAssociateCompanyToPerson_ShouldAssociateACompanyToMultiplePersons(t *te
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -108,10 +109,10 @@ func TestAssociateCompanyToPerson_ShouldAssociateACompanyToMultiplePersons(t *te
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := companyPersonRepository.GetAll()
+	personCompanies, err := companyPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -139,7 +140,7 @@ func TestAssociateCompanyToPerson_ShouldAssociateMultipleCompaniesToAPerson(t *t
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -147,10 +148,10 @@ func TestAssociateCompanyToPerson_ShouldAssociateMultipleCompaniesToAPerson(t *t
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := companyPersonRepository.GetAll()
+	personCompanies, err := companyPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -176,10 +177,10 @@ func TestAssociateCompanyToPerson_ShouldReturnConflictErrorIfCompanyIDAndPersonI
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -199,12 +200,15 @@ func TestAssociateCompanyToPerson_ShouldReturnValidationErrorIfPersonIDDoesNotEx
 		CompanyID: company.ID,
 		PersonID:  uuid.New(),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or PersonID': CompanyID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateCompanyToPerson_ShouldReturnValidationErrorIfCompanyIDDoesNotExist(t *testing.T) {
@@ -216,12 +220,15 @@ func TestAssociateCompanyToPerson_ShouldReturnValidationErrorIfCompanyIDDoesNotE
 		CompanyID: uuid.New(),
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or PersonID': CompanyID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateCompanyToPerson_ShouldReturnValidationErrorIfCompanyIDAndPersonIDDoNotExist(t *testing.T) {
@@ -231,12 +238,15 @@ func TestAssociateCompanyToPerson_ShouldReturnValidationErrorIfCompanyIDAndPerso
 		CompanyID: uuid.New(),
 		PersonID:  uuid.New(),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or PersonID': CompanyID or PersonID does not exist",
+		validationError.Error())
 }
 
 // -------- GetByID tests: --------
@@ -255,7 +265,7 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -263,7 +273,7 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -271,10 +281,10 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	companyPersons, err := companyPersonRepository.GetByID(&company1.ID, nil)
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, companyPersons, 2)
 
@@ -299,7 +309,7 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -307,7 +317,7 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -315,10 +325,10 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	companyPersons, err := companyPersonRepository.GetByID(nil, &person1.ID)
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, companyPersons, 2)
 
@@ -342,24 +352,24 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyIDAndPersonID(t *testing.T) {
 		CompanyID: company1.ID,
 		PersonID:  person1.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
 		CompanyID: company1.ID,
 		PersonID:  person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
 		CompanyID: company2.ID,
 		PersonID:  person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	persons, err := companyPersonRepository.GetByID(&company1.ID, &person1.ID)
+	persons, err := companyPersonRepository.GetByID(context.Background(), &company1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, company1.ID, persons[0].CompanyID)
@@ -379,24 +389,24 @@ func TestGetByID_ShouldGetNoRecordsIfCompanyIDDoesNotMatch(t *testing.T) {
 		CompanyID: company1.ID,
 		PersonID:  person1.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
 		CompanyID: company1.ID,
 		PersonID:  person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
 		CompanyID: company2.ID,
 		PersonID:  person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	persons, err := companyPersonRepository.GetByID(testutil.ToPtr(uuid.New()), &person1.ID)
+	persons, err := companyPersonRepository.GetByID(context.Background(), testutil.ToPtr(uuid.New()), &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -414,24 +424,24 @@ func TestCompanyPersonGetByID_ShouldGetNoRecordsIfPersonIDDoesNotMatch(t *testin
 		CompanyID: company1.ID,
 		PersonID:  person1.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
 		CompanyID: company1.ID,
 		PersonID:  person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
 		CompanyID: company2.ID,
 		PersonID:  person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	persons, err := companyPersonRepository.GetByID(&company1.ID, testutil.ToPtr(uuid.New()))
+	persons, err := companyPersonRepository.GetByID(context.Background(), &company1.ID, testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -449,24 +459,25 @@ func TestGetByID_ShouldGetNoRecordsIfCompanyIDAndPersonIDDoesNotMatch(t *testing
 		CompanyID: company1.ID,
 		PersonID:  person1.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
 		CompanyID: company1.ID,
 		PersonID:  person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
 		CompanyID: company2.ID,
 		PersonID:  person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	persons, err := companyPersonRepository.GetByID(testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
+	persons, err := companyPersonRepository.GetByID(
+		context.Background(), testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -480,7 +491,7 @@ func TestCompanyPersonGetByID_ShouldGetNoRecordsIfNoRecordsInDB(t *testing.T) {
 	person1 := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 
-	persons, err := companyPersonRepository.GetByID(&company1.ID, &person1.ID)
+	persons, err := companyPersonRepository.GetByID(context.Background(), &company1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -501,7 +512,7 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -509,7 +520,7 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -517,10 +528,10 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := companyPersonRepository.GetAll()
+	personCompanies, err := companyPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -544,11 +555,99 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 func TestGetAllCompanyPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	companyPersonRepository, _, _ := setupCompanyPersonRepository(t)
 
-	results, err := companyPersonRepository.GetAll()
+	results, err := companyPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
 
+// -------- Update tests: --------
+
+func TestUpdateCompanyPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	companyPersonRepository, companyRepository, personRepository := setupCompanyPersonRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateCompanyPerson{
+		CompanyID: company.ID,
+		PersonID:  person.ID,
+		LinkDetails: models.LinkDetails{
+			Role:      testutil.ToPtr("Hiring manager"),
+			StartDate: &startDate,
+		},
+	}
+	companyPerson, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hiring manager", *companyPerson.Role)
+	assert.Nil(t, companyPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, companyPerson.StartDate)
+	assert.Nil(t, companyPerson.EndDate)
+	assert.Nil(t, companyPerson.UpdatedDate)
+
+	endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	err = companyPersonRepository.Update(context.Background(), &models.UpdateCompanyPerson{
+		CompanyID: company.ID,
+		PersonID:  person.ID,
+		LinkDetails: models.LinkDetails{
+			Notes:   testutil.ToPtr("Moved to another team"),
+			EndDate: &endDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company.ID, &person.ID)
+	assert.NoError(t, err)
+	assert.Len(t, companyPersons, 1)
+
+	updatedCompanyPerson := companyPersons[0]
+	assert.Equal(t, "Hiring manager", *updatedCompanyPerson.Role)
+	assert.Equal(t, "Moved to another team", *updatedCompanyPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, updatedCompanyPerson.StartDate)
+	testutil.AssertEqualFormattedDateTimes(t, &endDate, updatedCompanyPerson.EndDate)
+	assert.NotNil(t, updatedCompanyPerson.UpdatedDate)
+}
+
+func TestUpdateCompanyPerson_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	companyPersonRepository, companyRepository, personRepository := setupCompanyPersonRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateCompanyPerson{
+		CompanyID:   company.ID,
+		PersonID:    person.ID,
+		LinkDetails: models.LinkDetails{StartDate: &startDate},
+	}
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+
+	endDate := startDate.AddDate(0, 0, -1)
+	err = companyPersonRepository.Update(context.Background(), &models.UpdateCompanyPerson{
+		CompanyID:   company.ID,
+		PersonID:    person.ID,
+		LinkDetails: models.LinkDetails{EndDate: &endDate},
+	})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", err.Error())
+}
+
+func TestUpdateCompanyPerson_ShouldReturnNotFoundErrorIfLinkDoesNotExist(t *testing.T) {
+	companyPersonRepository, _, _ := setupCompanyPersonRepository(t)
+
+	err := companyPersonRepository.Update(context.Background(), &models.UpdateCompanyPerson{
+		CompanyID:   uuid.New(),
+		PersonID:    uuid.New(),
+		LinkDetails: models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
 // -------- Delete tests: --------
 
 func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
@@ -561,7 +660,7 @@ func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteCompanyPerson{
@@ -569,7 +668,7 @@ func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
 		PersonID:  person.ID,
 	}
 
-	err = companyPersonRepository.Delete(&model)
+	err = companyPersonRepository.Delete(context.Background(), &model)
 	assert.NoError(t, err)
 }
 
@@ -583,7 +682,7 @@ func TestDeleteCompanyPerson_ShouldReturnNotFoundErrorIfNoMatchingCompanyPersonI
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteCompanyPerson{
@@ -591,7 +690,7 @@ func TestDeleteCompanyPerson_ShouldReturnNotFoundErrorIfNoMatchingCompanyPersonI
 		PersonID:  uuid.New(),
 	}
 
-	err = companyPersonRepository.Delete(&model)
+	err = companyPersonRepository.Delete(context.Background(), &model)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
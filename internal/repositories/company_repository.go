@@ -1,15 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
-	"fmt"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -18,21 +17,25 @@ import (
 )
 
 type CompanyRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewCompanyRepository(database *sql.DB) *CompanyRepository {
-	return &CompanyRepository{database: database}
+func NewCompanyRepository(database Executor, queryTimeout time.Duration) *CompanyRepository {
+	return &CompanyRepository{database: database, queryTimeout: queryTimeout}
 }
 
-// Create can return ConflictError, InternalServiceError
-func (repository *CompanyRepository) Create(company *models.CreateCompany) (*models.Company, error) {
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *CompanyRepository) Create(
+	ctx context.Context, company *models.CreateCompany) (*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO company (
 			id, name, company_type, notes, last_contact, created_date, updated_date
 		) VALUES (?, ?, ?, ?, ?, ?, ?)
 		RETURNING 
-		    id, name, company_type, notes, last_contact, created_date, updated_date, null, null, null; `
+		    id, name, company_type, notes, last_contact, created_date, updated_date, version; `
 
 	var companyID uuid.UUID
 	if company.ID != nil {
@@ -57,7 +60,11 @@ func (repository *CompanyRepository) Create(company *models.CreateCompany) (*mod
 		updatedDate = company.UpdatedDate.Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		companyID,
 		company.Name,
@@ -69,10 +76,10 @@ func (repository *CompanyRepository) Create(company *models.CreateCompany) (*mod
 	)
 
 	// can return ConflictError, InternalServiceError
-	result, err := repository.mapRow(row, "Create", &companyID)
+	result, err := repository.mapRow(ctx, row, "Create", &companyID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("company_repository.Create: No result found for ID", "ID", companyID, "error", err.Error())
+			logger.Info("company_repository.Create: No result found for ID", "ID", companyID, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + companyID.String() + "'")
 		}
 		return nil, err
@@ -82,25 +89,29 @@ func (repository *CompanyRepository) Create(company *models.CreateCompany) (*mod
 }
 
 // GetById can return InternalServiceError, NotFoundError, ValidationError
-func (repository *CompanyRepository) GetById(id *uuid.UUID) (*models.Company, error) {
+func (repository *CompanyRepository) GetById(ctx context.Context, id *uuid.UUID) (*models.Company, error) {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Info("company_repository.GetById: ID is nil")
+		logger.Info("company_repository.GetById: ID is nil")
 		var id = "ID"
 		return nil, internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlSelect := `
-		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, null, null, null 
+		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, version 
 		FROM company 
 		WHERE id = ? `
 
-	row := repository.database.QueryRow(sqlSelect, id)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, id)
 
 	// can return ConflictError, InternalServiceError
-	result, err := repository.mapRow(row, "GetById", id)
+	result, err := repository.mapRow(ctx, row, "GetById", id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("company_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
+			logger.Info("company_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
 		}
 		return nil, err
@@ -109,21 +120,72 @@ func (repository *CompanyRepository) GetById(id *uuid.UUID) (*models.Company, er
 	return result, err
 }
 
+// GetAllByIDs returns the companies with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetAllByIDs can return InternalServiceError, ValidationError
+func (repository *CompanyRepository) GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Company, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	if err := models.ValidateIDs(ids); err != nil {
+		logger.Info("company_repository.GetAllByIDs: IDs are invalid", "error", err)
+		return nil, err
+	}
+
+	placeholders, sqlVars := buildIDPlaceholders(ids)
+	sqlSelect := `
+		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, version
+		FROM company
+		WHERE id IN (` + placeholders + `) `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("company_repository.GetAllByIDs: Error trying to query companies", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query companies: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.Company
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByIDs", nil)
+		if err != nil {
+			logger.Error("company_repository.GetAllByIDs: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing company data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("company_repository.GetAllByIDs: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading companies from database: " + err.Error())
+	}
+
+	return orderByIDs(ids, results, func(company *models.Company) uuid.UUID { return company.ID }), nil
+}
+
 // GetAllByName can return InternalServiceError, NotFoundError, ValidationError
-func (repository *CompanyRepository) GetAllByName(name *string) ([]*models.Company, error) {
+func (repository *CompanyRepository) GetAllByName(ctx context.Context, name *string) ([]*models.Company, error) {
+	logger := logging.FromContext(ctx)
 	if name == nil {
-		slog.Info("company_repository.GetAllByName: name is nil")
+		logger.Info("company_repository.GetAllByName: name is nil")
 		return nil, internalErrors.NewValidationError(nil, "name is nil")
 	}
 
 	sqlSelect := `
-		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, null, null, null 
+		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, version 
 		FROM company 
 		WHERE name LIKE ? 
 		ORDER BY name ASC `
 
 	wildcardName := "%" + *name + "%"
-	rows, err := repository.database.Query(sqlSelect, wildcardName)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, wildcardName)
 	if err != nil {
 		return nil, err
 	}
@@ -132,9 +194,9 @@ func (repository *CompanyRepository) GetAllByName(name *string) ([]*models.Compa
 
 	for rows.Next() {
 		// can return ConflictError, InternalServiceError
-		result, err := repository.mapRow(rows, "GetAllByName", nil)
+		result, err := repository.mapRow(ctx, rows, "GetAllByName", nil)
 		if err != nil {
-			slog.Error("company_repository.GetAllByName: Error mapping row", "error", err)
+			logger.Error("company_repository.GetAllByName: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing company data: " + err.Error())
 		}
 
@@ -144,12 +206,12 @@ func (repository *CompanyRepository) GetAllByName(name *string) ([]*models.Compa
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("company_repository.GetAllByName: Error iterating rows", "error", err)
+		logger.Error("company_repository.GetAllByName: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading companies from database: " + err.Error())
 	}
 
 	if len(results) == 0 {
-		slog.Info("company_repository.GetAllByName: No result found for Name", "Name", name)
+		logger.Info("company_repository.GetAllByName: No result found for Name", "Name", name)
 		return nil, internalErrors.NewNotFoundError("Name: '" + *name + "'")
 	}
 
@@ -158,47 +220,35 @@ func (repository *CompanyRepository) GetAllByName(name *string) ([]*models.Compa
 
 // GetAll can return InternalServiceError
 func (repository *CompanyRepository) GetAll(
+	ctx context.Context,
 	includeApplications models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType) ([]*models.Company, error) {
 
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT c.id, c.name, c.company_type, c.notes, c.last_contact, c.created_date, c.updated_date, %s, %s, %s
-		FROM company c %s %s %s
-		GROUP BY c.id
-		ORDER BY c.created_date DESC;
-		`
-
-	applicationsCoalesceString, applicationsJoinString :=
-		repository.buildApplicationsCoalesceAndJoin(includeApplications)
-
-	eventsCoalesceString, eventsJoinString := repository.buildEventsCoalesceAndJoin(includeEvents)
-
-	personsCoalesceString, personsJoinString := repository.buildPersonsCoalesceAndJoin(includePersons)
+		SELECT id, name, company_type, notes, last_contact, created_date, updated_date, version
+		FROM company
+		ORDER BY created_date DESC `
 
-	sqlSelect = fmt.Sprintf(
-		sqlSelect,
-		applicationsCoalesceString,
-		personsCoalesceString,
-		eventsCoalesceString,
-		applicationsJoinString,
-		personsJoinString,
-		eventsJoinString)
+	queryCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
 
-	rows, err := repository.database.Query(sqlSelect)
+	rows, err := repository.database.QueryContext(queryCtx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	defer rows.Close()
 
 	var results []*models.Company
 	for rows.Next() {
 		// can return ConflictError, InternalServiceError
-		result, err := repository.mapRow(rows, "GetAll", nil)
+		result, err := repository.mapRow(ctx, rows, "GetAll", nil)
 		if err != nil {
-			slog.Error("company_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("company_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing company data: " + err.Error())
 		}
 
@@ -208,22 +258,30 @@ func (repository *CompanyRepository) GetAll(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("company_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("company_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading companies from database: " + err.Error())
 	}
 
+	// can return InternalServiceError
+	err = repository.loadRelations(ctx, results, includeApplications, includePersons, includeEvents)
+	if err != nil {
+		return nil, err
+	}
+
 	return results, nil
 }
 
-// Update can return InternalServiceError, ValidationError
-func (repository *CompanyRepository) Update(company *models.UpdateCompany) error {
+// Update can return ConflictError, InternalServiceError, ValidationError
+func (repository *CompanyRepository) Update(ctx context.Context, company *models.UpdateCompany) error {
+	logger := logging.FromContext(ctx)
 	var sqlString strings.Builder
 	var sqlParts []string
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
 		UPDATE company SET 
-   		updated_date = ?,  
+   		updated_date = ?,
+			version = version + 1,
    		`)
 	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
 
@@ -254,14 +312,14 @@ func (repository *CompanyRepository) Update(company *models.UpdateCompany) error
 	}
 
 	if updateItemCount == 0 {
-		slog.Info("company_repository.Update: nothing to update", "id", company.ID)
+		logger.Info("company_repository.Update: nothing to update", "id", company.ID)
 		return internalErrors.NewValidationError(nil, "nothing to update")
 	}
 
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
 	if err != nil {
 		var message = "unable to join SQL statement string"
-		slog.Error("company_repository.Update: unable to join SQL statement string", "error", err)
+		logger.Error("company_repository.Update: unable to join SQL statement string", "error", err)
 		return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -271,41 +329,89 @@ func (repository *CompanyRepository) Update(company *models.UpdateCompany) error
 		WHERE id = ? `)
 	sqlVars = append(sqlVars, company.ID)
 
-	_, err = repository.database.Exec(
+	if company.ExpectedVersion != nil {
+		sqlString.WriteString("AND version = ? ")
+		sqlVars = append(sqlVars, *company.ExpectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
 		sqlString.String(),
 		sqlVars...,
 	)
 
 	if err != nil {
-		slog.Error("company_repository.Update: unable to update company", "id", company.ID, "error", err.Error())
+		if isForeignKeyViolation(err) {
+			logger.Info("company_repository.Update: FOREIGN KEY constraint failed", "id", company.ID)
+			companyType := "CompanyType"
+			return internalErrors.NewValidationError(
+				&companyType, "company type does not exist: '"+company.CompanyType.String()+"'")
+		} else if constraintErr := translateConstraintError(err, "Name"); constraintErr != nil {
+			logger.Info("company_repository.Update: constraint failed", "id", company.ID, "error", err)
+			return constraintErr
+		}
+		logger.Error("company_repository.Update: unable to update company", "id", company.ID, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
-	return err
+	if company.ExpectedVersion != nil {
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error(
+				"company_repository.Update: unable to update company", "id", company.ID, "error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+		if rowsAffected == 0 {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"company", "id", "Company", company.ID, *company.ExpectedVersion)
+		}
+	}
+
+	return nil
 }
 
-// Delete can return InternalServiceError, NotFoundError, ValidationError
-func (repository *CompanyRepository) Delete(id *uuid.UUID) error {
+// Delete deletes the company with id. If expectedVersion is set, it is only deleted if it still has that version.
+//
+// Delete can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *CompanyRepository) Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Error("company_repository.Delete: ID is nil")
+		logger.Error("company_repository.Delete: ID is nil")
 		id := "ID"
 		return internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlDelete := "DELETE FROM company WHERE id = ?"
+	sqlVars := []interface{}{id}
+	if expectedVersion != nil {
+		sqlDelete += " AND version = ?"
+		sqlVars = append(sqlVars, *expectedVersion)
+	}
 
-	result, err := repository.database.Exec(sqlDelete, id)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlDelete, sqlVars...)
 	if err != nil {
-		slog.Error("company_repository.Delete: Error trying to delete company", "id", id, "error", err.Error())
+		logger.Error("company_repository.Delete: Error trying to delete company", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error("company_repository.Delete: Error trying to delete company", "id", id, "error", err.Error())
+		logger.Error("company_repository.Delete: Error trying to delete company", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 	if rowsAffected == 0 {
+		if expectedVersion != nil {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"company", "id", "Company", *id, *expectedVersion)
+		}
 		return internalErrors.NewNotFoundError("Company does not exist. ID: " + id.String())
 	} else if rowsAffected > 1 {
 		return internalErrors.NewInternalServiceError(
@@ -315,14 +421,73 @@ func (repository *CompanyRepository) Delete(id *uuid.UUID) error {
 	return nil
 }
 
+// MoveReferences points every application, company_person and company_event that references the company with
+// fromID to the company with toID instead. Links that toID already has are dropped rather than duplicated. It should
+// be run in a transaction, as it executes several statements.
+//
+// MoveReferences can return InternalServiceError, ValidationError
+func (repository *CompanyRepository) MoveReferences(ctx context.Context, fromID *uuid.UUID, toID *uuid.UUID) error {
+	logger := logging.FromContext(ctx)
+	if fromID == nil || toID == nil {
+		logger.Error("company_repository.MoveReferences: ID is nil")
+		return internalErrors.NewValidationError(nil, "fromID and toID are required")
+	}
+
+	statements := []string{
+		"UPDATE application SET company_id = ?, version = version + 1 WHERE company_id = ?",
+		"UPDATE application SET recruiter_id = ?, version = version + 1 WHERE recruiter_id = ?",
+		`INSERT OR IGNORE INTO company_person (
+				company_id, person_id, created_date, role, notes, start_date, end_date, updated_date)
+			SELECT ?, person_id, created_date, role, notes, start_date, end_date, updated_date
+			FROM company_person WHERE company_id = ?`,
+		`INSERT OR IGNORE INTO company_event (
+				company_id, event_id, created_date, role, notes, start_date, end_date, updated_date)
+			SELECT ?, event_id, created_date, role, notes, start_date, end_date, updated_date
+			FROM company_event WHERE company_id = ?`,
+	}
+	deleteStatements := []string{
+		"DELETE FROM company_person WHERE company_id = ?",
+		"DELETE FROM company_event WHERE company_id = ?",
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	for _, statement := range statements {
+		if _, err := repository.database.ExecContext(ctx, statement, toID, fromID); err != nil {
+			logger.Error(
+				"company_repository.MoveReferences: unable to move references",
+				"fromID", fromID,
+				"toID", toID,
+				"error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+	}
+
+	for _, statement := range deleteStatements {
+		if _, err := repository.database.ExecContext(ctx, statement, fromID); err != nil {
+			logger.Error(
+				"company_repository.MoveReferences: unable to delete moved references",
+				"fromID", fromID,
+				"error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+	}
+
+	return nil
+}
+
 // internal functions
 
 // mapRow can return ConflictError, InternalServiceError
 func (repository *CompanyRepository) mapRow(
+	ctx context.Context,
 	scanner interface{ Scan(...interface{}) error }, methodName string, ID *uuid.UUID) (*models.Company, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.Company
-	var lastContact, createdDate, updatedDate, applicationsString, personsString, eventsString sql.NullString
+	var lastContact, createdDate, updatedDate sql.NullString
 
 	err := scanner.Scan(
 		&result.ID,
@@ -332,24 +497,29 @@ func (repository *CompanyRepository) mapRow(
 		&lastContact,
 		&createdDate,
 		&updatedDate,
-		&applicationsString,
-		&personsString,
-		&eventsString,
+		&result.Version,
 	)
 
 	if err != nil {
-		if err.Error() == "constraint failed: UNIQUE constraint failed: company.id (1555)" {
+		if isUniqueViolation(err, "company.id") {
 			var IDString string
 			if ID != nil {
 				IDString = ID.String()
 			} else {
 				IDString = "[not set]"
 			}
-			slog.Info(
+			logger.Info(
 				"company_repository.createCompany: UNIQUE constraint failed",
 				"ID", IDString)
 			return nil, internalErrors.NewConflictError(
 				"ID already exists in database: '" + IDString + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("company_repository." + methodName + ": FOREIGN KEY constraint failed")
+			companyType := "CompanyType"
+			return nil, internalErrors.NewValidationError(&companyType, "company type does not exist")
+		} else if constraintErr := translateConstraintError(err, "Name"); constraintErr != nil {
+			logger.Info("company_repository."+methodName+": constraint failed", "error", err)
+			return nil, constraintErr
 		}
 
 		return nil, err
@@ -358,7 +528,7 @@ func (repository *CompanyRepository) mapRow(
 	if lastContact.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, lastContact.String)
 		if err != nil {
-			slog.Error(
+			logger.Error(
 				"company_repository."+methodName+": Error parsing lastContact",
 				"lastContact", lastContact,
 				"error", err.Error())
@@ -370,7 +540,7 @@ func (repository *CompanyRepository) mapRow(
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("company_repository."+methodName+": Error parsing createdDate",
+			logger.Error("company_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -381,7 +551,7 @@ func (repository *CompanyRepository) mapRow(
 	if updatedDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, updatedDate.String)
 		if err != nil {
-			slog.Error("company_repository."+methodName+": Error parsing updatedDate",
+			logger.Error("company_repository."+methodName+": Error parsing updatedDate",
 				"updatedDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing updatedDate: " + err.Error())
@@ -389,160 +559,83 @@ func (repository *CompanyRepository) mapRow(
 		result.UpdatedDate = &timestamp
 	}
 
-	if applicationsString.Valid {
-		var applications []*models.Application
-		if err := json.NewDecoder(strings.NewReader(applicationsString.String)).Decode(&applications); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing applications: " + err.Error())
-		}
+	return &result, nil
+}
 
-		if len(applications) > 0 {
-			result.Applications = &applications
+// loadRelations adds the applications, persons and events to companies, as selected by the include types. Each
+// relation is loaded with batched queries, instead of joins that repeat each company for every relation.
+//
+// loadRelations can return InternalServiceError
+func (repository *CompanyRepository) loadRelations(
+	ctx context.Context,
+	companies []*models.Company,
+	includeApplications models.IncludeExtraDataType,
+	includePersons models.IncludeExtraDataType,
+	includeEvents models.IncludeExtraDataType) error {
+
+	companyIDs := make([]uuid.UUID, len(companies))
+	for index, company := range companies {
+		companyIDs[index] = company.ID
+	}
+
+	if includeApplications != models.IncludeExtraDataTypeNone {
+		// a company is related to the applications it is either the company or the recruiter of
+		link := relationLink{
+			linkTable: `(
+				SELECT company_id, id AS application_id FROM application
+				UNION
+				SELECT recruiter_id, id FROM application)`,
+			ownerColumn:   "company_id",
+			relatedColumn: "application_id",
+			relatedTable:  "application",
 		}
-	}
 
-	if eventsString.Valid {
-		var events []*models.Event
-		if err := json.NewDecoder(strings.NewReader(eventsString.String)).Decode(&events); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing events: " + err.Error())
+		// can return InternalServiceError
+		applications, err := loadRelatedApplications(
+			ctx, repository.database, repository.queryTimeout, link, companyIDs, includeApplications, true)
+		if err != nil {
+			return err
 		}
-
-		if len(events) > 0 {
-			result.Events = &events
+		for _, company := range companies {
+			if companyApplications := applications[company.ID]; len(companyApplications) > 0 {
+				company.Applications = &companyApplications
+			}
 		}
 	}
 
-	if personsString.Valid {
-		var persons []*models.Person
-		if err := json.NewDecoder(strings.NewReader(personsString.String)).Decode(&persons); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing persons: " + err.Error())
-		}
+	if includePersons != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "company_person", ownerColumn: "company_id", relatedColumn: "person_id", relatedTable: "person"}
 
-		if len(persons) > 0 {
-			result.Persons = &persons
+		// can return InternalServiceError
+		persons, err := loadRelatedPersons(
+			ctx, repository.database, repository.queryTimeout, link, companyIDs, includePersons)
+		if err != nil {
+			return err
+		}
+		for _, company := range companies {
+			if companyPersons := persons[company.ID]; len(companyPersons) > 0 {
+				company.Persons = &companyPersons
+			}
 		}
 	}
 
-	return &result, nil
-}
-
-func (repository *CompanyRepository) buildApplicationsCoalesceAndJoin(
-	includeApplications models.IncludeExtraDataType) (string, string) {
-
-	if includeApplications == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', a.id,
-					'CompanyID', a.company_id,
-					'RecruiterID', a.recruiter_id%s
-				) ORDER BY a.created_date DESC
-			) FILTER (WHERE a.id IS NOT NULL),
-			JSON_ARRAY()
-		) as applications
-	`
-
-	allColumns := ""
-	if includeApplications == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'JobTitle', a.job_title,
-					'JobAdURL', a.job_ad_url,
-					'Country', a.country,
-					'Area', a.area,
-					'RemoteStatusType', a.remote_status_type,
-					'WeekdaysInOffice', a.weekdays_in_office,
-					'EstimatedCycleTime', a.estimated_cycle_time,
-					'EstimatedCommuteTime', a.estimated_commute_time,
-					'ApplicationDate', a.application_date,
-					'CreatedDate', a.created_date,
-					'UpdatedDate', a.updated_date`
-
-	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString := "\n\t\tLEFT JOIN application a ON (c.id = a.company_id OR c.id = a.recruiter_id) \n"
-
-	return coalesceString, joinString
-}
-
-func (repository *CompanyRepository) buildEventsCoalesceAndJoin(
-	includeEvents models.IncludeExtraDataType) (string, string) {
-
-	if includeEvents == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', e.id%s
-				) ORDER BY e.event_date DESC
-			) FILTER (WHERE e.id IS NOT NULL),
-			JSON_ARRAY()
-		) as events
-`
-
-	allColumns := ""
-	if includeEvents == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'EventType', e.event_type,
-					'Description', e.description,
-					'Notes', e.notes,
-					'EventDate', e.event_date,
-					'CreatedDate', e.created_date,
-					'UpdatedDate', e.updated_date`
-
-	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString :=
-		`LEFT JOIN company_event ce ON (ce.company_id = c.id)
-		LEFT JOIN event e ON (ce.event_id = e.id)
-`
-
-	return coalesceString, joinString
-}
-
-func (repository *CompanyRepository) buildPersonsCoalesceAndJoin(
-	includePersons models.IncludeExtraDataType) (string, string) {
-
-	if includePersons == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', p.id%s
-				) ORDER BY p.created_date DESC
-			) FILTER (WHERE p.id IS NOT NULL),
-			JSON_ARRAY()
-		) as persons
-`
-
-	allColumns := ""
-	if includePersons == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'Name', p.name,
-					'PersonType', p.person_type,
-					'Email', p.email,
-					'Phone', p.phone,
-					'Notes', p.notes,
-					'CreatedDate', p.created_date,
-					'UpdatedDate', p.updated_date`
+	if includeEvents != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "company_event", ownerColumn: "company_id", relatedColumn: "event_id", relatedTable: "event"}
 
+		// can return InternalServiceError
+		events, err := loadRelatedEvents(
+			ctx, repository.database, repository.queryTimeout, link, companyIDs, includeEvents)
+		if err != nil {
+			return err
+		}
+		for _, company := range companies {
+			if companyEvents := events[company.ID]; len(companyEvents) > 0 {
+				company.Events = &companyEvents
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString :=
-		`LEFT JOIN company_person cp ON (cp.company_id = c.id)
-		LEFT JOIN person p ON (cp.person_id = p.id)
-`
 
-	return coalesceString, joinString
+	return nil
 }
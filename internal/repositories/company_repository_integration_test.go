@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -89,7 +90,7 @@ func TestCreate_ShouldInsertAndReturnCompany(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany, err := companyRepository.Create(&company)
+	insertedCompany, err := companyRepository.Create(context.Background(), &company)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
@@ -111,7 +112,7 @@ func TestCreate_ShouldInsertCompanyWithMinimumRequiredFields(t *testing.T) {
 		CompanyType: models.CompanyTypeEmployer,
 	}
 	createdDateApproximation := time.Now()
-	insertedCompany, err := companyRepository.Create(&company)
+	insertedCompany, err := companyRepository.Create(context.Background(), &company)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
@@ -133,7 +134,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicateCompanyId(t *testing.T) {
 		Name:        "companyName",
 		CompanyType: models.CompanyTypeRecruiter,
 	}
-	firstInsertedCompany, err := companyRepository.Create(&firstCompany)
+	firstInsertedCompany, err := companyRepository.Create(context.Background(), &firstCompany)
 	assert.NoError(t, err)
 	assert.NotNil(t, firstInsertedCompany)
 	assert.Equal(t, firstInsertedCompany.ID, id)
@@ -143,7 +144,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicateCompanyId(t *testing.T) {
 		Name:        "companyName",
 		CompanyType: models.CompanyTypeRecruiter,
 	}
-	shouldBeNil, err := companyRepository.Create(&secondCompany)
+	shouldBeNil, err := companyRepository.Create(context.Background(), &secondCompany)
 	assert.Nil(t, shouldBeNil)
 	assert.Error(t, err)
 
@@ -169,11 +170,11 @@ func TestGetById_ShouldGetCompany(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany, err := companyRepository.Create(&companyToInsert)
+	insertedCompany, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
-	retrievedCompany, err := companyRepository.GetById(&id)
+	retrievedCompany, err := companyRepository.GetById(context.Background(), &id)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompany)
 
@@ -189,7 +190,7 @@ func TestGetById_ShouldGetCompany(t *testing.T) {
 func TestGetById_ShouldReturnErrorIfCompanyIDIsNil(t *testing.T) {
 	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
 
-	response, err := companyRepository.GetById(nil)
+	response, err := companyRepository.GetById(context.Background(), nil)
 	assert.Nil(t, response)
 	assert.Error(t, err)
 
@@ -202,7 +203,7 @@ func TestGetById_ShouldReturnErrorIfCompanyIDDoesNotExist(t *testing.T) {
 	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
 
 	id := uuid.New()
-	response, err := companyRepository.GetById(&id)
+	response, err := companyRepository.GetById(context.Background(), &id)
 	assert.Nil(t, response)
 	assert.NotNil(t, err, err)
 
@@ -220,11 +221,11 @@ func TestGetAllByName_ShouldReturnCompany(t *testing.T) {
 		Name:        "Company Bee",
 		CompanyType: models.CompanyTypeRecruiter,
 	}
-	insertedCompany, err := companyRepository.Create(&companyToInsert)
+	insertedCompany, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
-	retrievedCompanies, err := companyRepository.GetAllByName(insertedCompany.Name)
+	retrievedCompanies, err := companyRepository.GetAllByName(context.Background(), insertedCompany.Name)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompanies)
 	assert.Len(t, retrievedCompanies, 1)
@@ -235,7 +236,7 @@ func TestGetAllByName_ShouldReturnCompany(t *testing.T) {
 func TestGetAllByName_ShouldReturnValidationErrorIfCompanyNameIsNil(t *testing.T) {
 	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
 
-	retrievedCompanies, err := companyRepository.GetAllByName(nil)
+	retrievedCompanies, err := companyRepository.GetAllByName(context.Background(), nil)
 	assert.Nil(t, retrievedCompanies)
 	assert.Error(t, err)
 
@@ -249,7 +250,7 @@ func TestGetAllByName_ShouldReturnNotFoundErrorIfCompanyNameDoesNotExist(t *test
 
 	name := "Doesnt Exist"
 
-	company, err := companyRepository.GetAllByName(&name)
+	company, err := companyRepository.GetAllByName(context.Background(), &name)
 	assert.Nil(t, company)
 	assert.Error(t, err)
 
@@ -268,7 +269,7 @@ func TestGetAllByName_ShouldReturnMultipleCompaniesWithSameNameSubstring(t *test
 		Name:        "Some Name AB",
 		CompanyType: models.CompanyTypeRecruiter,
 	}
-	insertedCompany1, err := companyRepository.Create(&company1)
+	insertedCompany1, err := companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -277,7 +278,7 @@ func TestGetAllByName_ShouldReturnMultipleCompaniesWithSameNameSubstring(t *test
 		Name:        "Brand AB",
 		CompanyType: models.CompanyTypeEmployer,
 	}
-	insertedCompany2, err := companyRepository.Create(&company2)
+	insertedCompany2, err := companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
@@ -286,13 +287,13 @@ func TestGetAllByName_ShouldReturnMultipleCompaniesWithSameNameSubstring(t *test
 		Name:        "Another Company",
 		CompanyType: models.CompanyTypeEmployer,
 	}
-	insertedCompany3, err := companyRepository.Create(&company3)
+	insertedCompany3, err := companyRepository.Create(context.Background(), &company3)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany3)
 
 	// get all companies with a name that contains "ab"
 
-	retrievedCompanies, err := companyRepository.GetAllByName(testutil.ToPtr("ab"))
+	retrievedCompanies, err := companyRepository.GetAllByName(context.Background(), testutil.ToPtr("ab"))
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompanies)
 	assert.Len(t, retrievedCompanies, 2)
@@ -304,6 +305,23 @@ func TestGetAllByName_ShouldReturnMultipleCompaniesWithSameNameSubstring(t *test
 	assert.Equal(t, insertedCompany1.ID, foundCompany2.ID)
 }
 
+// -------- GetAllByIDs tests: --------
+
+func TestGetAllByIDs_ShouldReturnCompaniesInOrderOfIDs(t *testing.T) {
+	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
+
+	company1 := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	company2 := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	companies, err := companyRepository.GetAllByIDs(
+		context.Background(), []uuid.UUID{company2.ID, uuid.New(), company1.ID})
+	assert.NoError(t, err)
+	assert.Len(t, companies, 2)
+	assert.Equal(t, company2.ID, companies[0].ID)
+	assert.Equal(t, company1.ID, companies[1].ID)
+	assert.Equal(t, company1.Name, companies[1].Name)
+}
+
 // -------- GetAll - Base tests: --------
 
 func TestGetAll_ShouldReturnAllCompanies(t *testing.T) {
@@ -318,7 +336,7 @@ func TestGetAll_ShouldReturnAllCompanies(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany1, err := companyRepository.Create(&company1ToInsert)
+	insertedCompany1, err := companyRepository.Create(context.Background(), &company1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -327,11 +345,12 @@ func TestGetAll_ShouldReturnAllCompanies(t *testing.T) {
 		Name:        "company2Name",
 		CompanyType: models.CompanyTypeConsultancy,
 	}
-	insertedCompany2, err := companyRepository.Create(&company2ToInsert)
+	insertedCompany2, err := companyRepository.Create(context.Background(), &company2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
 	results, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -353,6 +372,7 @@ func TestGetAll_ShouldReturnNilIfNoCompaniesInDatabase(t *testing.T) {
 	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -360,6 +380,21 @@ func TestGetAll_ShouldReturnNilIfNoCompaniesInDatabase(t *testing.T) {
 	assert.Nil(t, companies)
 }
 
+func TestGetAll_ShouldReturnErrorIfContextIsCancelled(t *testing.T) {
+	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	companies, err := companyRepository.GetAll(
+		ctx,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, companies)
+}
+
 // -------- GetAll - Applications tests: --------
 
 func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testing.T) {
@@ -374,7 +409,7 @@ func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testi
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -384,7 +419,7 @@ func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testi
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	company3ID := uuid.New()
@@ -394,7 +429,7 @@ func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testi
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyRepository.Create(&createCompany3)
+	_, err = companyRepository.Create(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create applications
@@ -417,7 +452,7 @@ func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testi
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationRepository.Create(&createApplication1)
+	_, err = applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := uuid.New()
@@ -443,6 +478,7 @@ func TestGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSetToAll(t *testi
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -502,7 +538,7 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToAllAndThe
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -512,10 +548,11 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToAllAndThe
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -543,7 +580,7 @@ func TestGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(t *tes
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -553,7 +590,7 @@ func TestGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(t *tes
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	company3ID := uuid.New()
@@ -563,7 +600,7 @@ func TestGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(t *tes
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyRepository.Create(&createCompany3)
+	_, err = companyRepository.Create(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create applications
@@ -586,7 +623,7 @@ func TestGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(t *tes
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationRepository.Create(&createApplication1)
+	_, err = applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := uuid.New()
@@ -612,6 +649,7 @@ func TestGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIsSetToIDs(t *tes
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -671,7 +709,7 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToIDsAndThe
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -681,10 +719,11 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToIDsAndThe
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -712,7 +751,7 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToNone(t *t
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany)
+	_, err := companyRepository.Create(context.Background(), &createCompany)
 	assert.NoError(t, err)
 
 	// create application
@@ -725,12 +764,13 @@ func TestGetAll_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetToNone(t *t
 		JobTitle:         testutil.ToPtr("ApplicationJobTitle"),
 		RemoteStatusType: applicationRemoteStatusType,
 	}
-	_, err = applicationRepository.Create(&createApplication1)
+	_, err = applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -777,7 +817,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 8)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 9)),
 	}
-	_, err := eventRepository.Create(&createEvent1)
+	_, err := eventRepository.Create(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -795,6 +835,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -834,6 +875,7 @@ func TestGetAll_ShouldReturnNilEventsIfIncludeEventsIsSetToAllAndThereAreNoEvent
 	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -879,7 +921,7 @@ func TestGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 8)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 9)),
 	}
-	_, err := eventRepository.Create(&createEvent1)
+	_, err := eventRepository.Create(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -898,6 +940,7 @@ func TestGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *testing.T) {
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -937,6 +980,7 @@ func TestGetAll_ShouldReturnNilEventsIfIncludeEventsIsSetToIDsAndThereAreNoEvent
 	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -963,6 +1007,7 @@ func TestGetAll_ShouldReturnNilEventsIfIncludeEventsIsSetToIDsAndThereAreNoCompa
 	// get all events
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -993,6 +1038,7 @@ func TestGetAll_ShouldReturnNilEventsIfIncludeEventsIsSetToNone(t *testing.T) {
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1019,7 +1065,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -1029,7 +1075,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	company3ID := uuid.New()
@@ -1039,7 +1085,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyRepository.Create(&createCompany3)
+	_, err = companyRepository.Create(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1056,7 +1102,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2ID := uuid.New()
@@ -1074,7 +1120,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		PersonID:    person1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -1082,7 +1128,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -1090,12 +1136,13 @@ func TestCompanyRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1140,7 +1187,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToAllAndThereAreNoPer
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -1150,10 +1197,11 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToAllAndThereAreNoPer
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1181,7 +1229,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -1191,7 +1239,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	company3ID := uuid.New()
@@ -1201,7 +1249,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyRepository.Create(&createCompany3)
+	_, err = companyRepository.Create(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1218,7 +1266,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2ID := uuid.New()
@@ -1236,7 +1284,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		PersonID:    person1ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -1244,7 +1292,7 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -1252,12 +1300,13 @@ func TestGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *testing.T) {
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1302,7 +1351,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoPer
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -1312,10 +1361,11 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoPer
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1343,7 +1393,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoCom
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := uuid.New()
@@ -1353,7 +1403,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoCom
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyRepository.Create(&createCompany2)
+	_, err = companyRepository.Create(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1370,7 +1420,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoCom
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2ID := uuid.New()
@@ -1383,6 +1433,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToIDsAndThereAreNoCom
 	// get all persons
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1409,7 +1460,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToNone(t *testing.T)
 		Name:        "companyName",
 		CompanyType: models.CompanyTypeConsultancy,
 	}
-	_, err := companyRepository.Create(&createCompany)
+	_, err := companyRepository.Create(context.Background(), &createCompany)
 	assert.NoError(t, err)
 
 	// create person
@@ -1420,7 +1471,7 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToNone(t *testing.T)
 		Name:       "Person1Name",
 		PersonType: models.PersonTypeJobContact,
 	}
-	_, err = personRepository.Create(&person)
+	_, err = personRepository.Create(context.Background(), &person)
 	assert.NoError(t, err)
 
 	// create companyPerson
@@ -1430,12 +1481,13 @@ func TestGetAll_ShouldReturnNilPersonsIfIncludePersonsIsSetToNone(t *testing.T)
 		PersonID:    personID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	// get companies
 
 	companies, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1481,6 +1533,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnTwoCompaniesEvenIfOneApplicationIsS
 	// ensure that two companies are returned
 
 	companiesWithApplications, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1522,6 +1575,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnTwoCompaniesEvenIfOneEventIsSharedB
 	// ensure that two results are returned, each with an event
 
 	companiesWithEvents, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1563,6 +1617,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnTwoCompaniesEvenIfOnePersonIsShared
 	// ensure that two results are returned, each with a person
 
 	companiesWithEvents, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1614,6 +1669,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoEven
 	// ensure that the company is returned with one application
 
 	companyWithApplications, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1625,6 +1681,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoEven
 	// ensure that two events are returned with the company
 
 	companyWithEvents, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1638,6 +1695,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoEven
 	// Ensure that the company is returned with one application and two events
 
 	companyWithApplicationsAndEvent, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1697,6 +1755,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndOneEve
 	// Ensure that the company is returned with two applications and an event
 
 	companyWithApplicationsAndEvent, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1764,6 +1823,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndTwoEve
 	// Ensure that the company is returned with two applications and two events
 
 	companyWithApplicationsAndEvent, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1815,6 +1875,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoPers
 	// ensure that the company is returned with one application
 
 	companyWithApplications, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1826,6 +1887,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoPers
 	// ensure that two persons are returned with the company
 
 	companyWithPersons, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1839,6 +1901,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithOneApplicationAndTwoPers
 	// Ensure that the company is returned with one application and two persons
 
 	companyWithApplicationsAndPerson, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1891,6 +1954,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndOnePer
 	// ensure that the company is returned with two applications
 
 	companyWithApplications, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1904,6 +1968,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndOnePer
 	// ensure that a single person is returned with the company
 
 	companyWithPersons, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1915,6 +1980,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndOnePer
 	// Ensure that the company is returned with two applications and a single person
 
 	companyWithApplicationsAndPerson, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1978,6 +2044,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndTwoPer
 	// Ensure that the company is returned with two applications and two persons
 
 	companyWithApplicationsAndPerson, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -2045,6 +2112,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoEventsAndTwoPersons(t
 	// Ensure that the company is returned with two events and two persons
 
 	companyWithEventsAndPerson, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -2129,6 +2197,7 @@ func TestCompanyRepositoryGetAll_ShouldReturnCompanyWithTwoApplicationsAndTwoEve
 	// Ensure that the company is returned with two applications and two events and two persons
 
 	company, err := companyRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -2167,7 +2236,7 @@ func TestUpdate_ShouldUpdateCompany(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
 
-	insertedCompany, err := companyRepository.Create(&companyToInsert)
+	insertedCompany, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -2185,12 +2254,12 @@ func TestUpdate_ShouldUpdateCompany(t *testing.T) {
 	}
 
 	updatedDateApproximation := time.Now()
-	err = companyRepository.Update(&updateModel)
+	err = companyRepository.Update(context.Background(), &updateModel)
 	assert.NoError(t, err)
 
 	// get the company and verify that it's updated
 
-	retrievedCompany, err := companyRepository.GetById(&id)
+	retrievedCompany, err := companyRepository.GetById(context.Background(), &id)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompany)
 
@@ -2218,7 +2287,7 @@ func TestUpdateCompany_ShouldUpdateASingleField(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany, err := companyRepository.Create(&companyToInsert)
+	insertedCompany, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -2271,20 +2340,54 @@ func TestUpdate_ShouldNotReturnErrorIfCompanyDoesNotExist(t *testing.T) {
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 2, 0)),
 	}
 
-	err := companyRepository.Update(&updateModel)
+	err := companyRepository.Update(context.Background(), &updateModel)
 	assert.NoError(t, err)
 }
 
+func TestUpdate_ShouldReturnPreconditionFailedErrorIfCompanyVersionDoesNotMatch(t *testing.T) {
+	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	assert.Equal(t, 1, company.Version)
+
+	err := companyRepository.Update(context.Background(), &models.UpdateCompany{
+		ID:              company.ID,
+		Name:            testutil.ToPtr("New name"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	assert.NoError(t, err)
+
+	// a second update based on the version that was first read
+	err = companyRepository.Update(context.Background(), &models.UpdateCompany{
+		ID:              company.ID,
+		Name:            testutil.ToPtr("Other name"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	assert.Error(t, err)
+
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+	assert.Equal(
+		t,
+		"precondition failed: Company has been changed since it was read. Expected version 1, current version is 2",
+		preconditionFailedError.Error())
+
+	retrievedCompany, err := companyRepository.GetById(context.Background(), &company.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, retrievedCompany.Version)
+	assert.Equal(t, "New name", *retrievedCompany.Name)
+}
+
 func updateAndGetCompany(
 	t *testing.T,
 	companyRepository *repositories.CompanyRepository,
 	updateCompany models.UpdateCompany,
 ) *models.Company {
 
-	err := companyRepository.Update(&updateCompany)
+	err := companyRepository.Update(context.Background(), &updateCompany)
 	assert.NoError(t, err)
 
-	retrievedCompany, err := companyRepository.GetById(&updateCompany.ID)
+	retrievedCompany, err := companyRepository.GetById(context.Background(), &updateCompany.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompany)
 	assert.Equal(t, updateCompany.ID, retrievedCompany.ID)
@@ -2305,10 +2408,10 @@ func TestDelete_ShouldDeleteCompany(t *testing.T) {
 		nil,
 	)
 
-	err := companyRepository.Delete(&id)
+	err := companyRepository.Delete(context.Background(), &id, nil)
 	assert.NoError(t, err)
 
-	deletedCompany, err := companyRepository.GetById(&id)
+	deletedCompany, err := companyRepository.GetById(context.Background(), &id)
 	assert.Nil(t, deletedCompany)
 	assert.Error(t, err)
 
@@ -2320,7 +2423,7 @@ func TestDelete_ShouldDeleteCompany(t *testing.T) {
 func TestDelete_ShouldReturnErrorIfCompanyIdIsNil(t *testing.T) {
 	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
 
-	err := companyRepository.Delete(nil)
+	err := companyRepository.Delete(context.Background(), nil, nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -2333,10 +2436,85 @@ func TestDelete_ShouldReturnNotFoundErrorIfCompanyIdDoesNotExist(t *testing.T) {
 
 	id := uuid.New()
 
-	err := companyRepository.Delete(&id)
+	err := companyRepository.Delete(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: Company does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+func TestDelete_ShouldReturnPreconditionFailedErrorIfCompanyVersionDoesNotMatch(t *testing.T) {
+	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	err := companyRepository.Delete(context.Background(), &company.ID, testutil.ToPtr(3))
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	err = companyRepository.Delete(context.Background(), &company.ID, testutil.ToPtr(1))
+	assert.NoError(t, err)
+}
+
+// -------- MoveReferences tests: --------
+
+func TestMoveReferences_ShouldMoveApplicationsPersonsAndEventsToOtherCompany(t *testing.T) {
+	companyRepository,
+		applicationRepository,
+		eventRepository,
+		personRepository,
+		companyEventRepository,
+		companyPersonRepository := setupCompanyRepository(t)
+
+	survivor := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	duplicate := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &duplicate.ID, &duplicate.ID, nil)
+
+	sharedPerson := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+	duplicateOnlyPerson := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, survivor.ID, sharedPerson.ID, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, duplicate.ID, sharedPerson.ID, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, duplicate.ID, duplicateOnlyPerson.ID, nil)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateCompanyEvent(t, companyEventRepository, duplicate.ID, event.ID, nil)
+
+	err := companyRepository.MoveReferences(context.Background(), &duplicate.ID, &survivor.ID)
+	assert.NoError(t, err)
+
+	movedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, *movedApplication.CompanyID)
+	assert.Equal(t, survivor.ID, *movedApplication.RecruiterID)
+
+	survivorPersons, err := companyPersonRepository.GetByID(context.Background(), &survivor.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, survivorPersons, 2)
+
+	duplicatePersons, err := companyPersonRepository.GetByID(context.Background(), &duplicate.ID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, duplicatePersons)
+
+	survivorEvents, err := companyEventRepository.GetByID(context.Background(), &survivor.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, survivorEvents, 1)
+	assert.Equal(t, event.ID, survivorEvents[0].EventID)
+
+	// Nothing references the duplicate anymore, so it can be deleted
+	err = companyRepository.Delete(context.Background(), &duplicate.ID, nil)
+	assert.NoError(t, err)
+}
+
+func TestMoveReferences_ShouldReturnValidationErrorIfCompanyIDIsNil(t *testing.T) {
+	companyRepository, _, _, _, _, _ := setupCompanyRepository(t)
+
+	err := companyRepository.MoveReferences(context.Background(), nil, testutil.ToPtr(uuid.New()))
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: fromID and toID are required", validationError.Error())
+}
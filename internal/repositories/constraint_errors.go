@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"strconv"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// getConstraintCode returns the extended result code of the constraint violation that caused err, such as
+// SQLITE_CONSTRAINT_UNIQUE, and 0 if err is not caused by one.
+func getConstraintCode(err error) int {
+	var sqliteError *sqlite.Error
+	if !errors.As(err, &sqliteError) || sqliteError.Code()&0xff != sqlite3.SQLITE_CONSTRAINT {
+		return 0
+	}
+	return sqliteError.Code()
+}
+
+// isConstraintOn returns true if err is caused by the constraint target. SQLite only names the table and columns of a
+// unique or primary key constraint, or the name or expression of a check constraint, in its message, such as
+// "UNIQUE constraint failed: person.email", so this is the only way to tell apart several constraints of one table.
+// The driver adds the result code to the end of the message.
+func isConstraintOn(err error, target string) bool {
+	var sqliteError *sqlite.Error
+	if !errors.As(err, &sqliteError) {
+		return false
+	}
+	message := strings.TrimSuffix(sqliteError.Error(), " ("+strconv.Itoa(sqliteError.Code())+")")
+	return strings.HasSuffix(message, " constraint failed: "+target)
+}
+
+// isUniqueViolation returns true if err is caused by a unique or primary key constraint on target, such as
+// "company_person.company_id, company_person.person_id".
+func isUniqueViolation(err error, target string) bool {
+	code := getConstraintCode(err)
+	return (code == sqlite3.SQLITE_CONSTRAINT_UNIQUE || code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY) &&
+		isConstraintOn(err, target)
+}
+
+// isForeignKeyViolation returns true if err is caused by a foreign key constraint.
+func isForeignKeyViolation(err error) bool {
+	return getConstraintCode(err) == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
+
+// isCheckViolation returns true if err is caused by the check constraint target, which is its name, or its
+// expression if it has no name.
+func isCheckViolation(err error, target string) bool {
+	return getConstraintCode(err) == sqlite3.SQLITE_CONSTRAINT_CHECK && isConstraintOn(err, target)
+}
+
+// translateConstraintError returns a ConflictError if err is caused by a unique or primary key constraint, and a
+// ValidationError on field if it is caused by a foreign key, not null or check constraint. field is the attribute
+// that the caller writes to the failing constraint, such as "Email", as SQLite does not report which foreign key
+// failed. It returns nil if err is not caused by a constraint violation.
+//
+// translateConstraintError can return ConflictError, ValidationError
+func translateConstraintError(err error, field string) error {
+	switch getConstraintCode(err) {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+		return internalErrors.NewConflictError(field + " already exists in database.")
+	case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+		return internalErrors.NewValidationError(&field, field+" does not exist")
+	case sqlite3.SQLITE_CONSTRAINT_NOTNULL:
+		return internalErrors.NewValidationError(&field, field+" cannot be empty")
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		return internalErrors.NewValidationError(&field, field+" is invalid")
+	default:
+		return nil
+	}
+}
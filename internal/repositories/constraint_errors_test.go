@@ -0,0 +1,133 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// setupConstraintDatabase returns an in-memory database with a table for each kind of constraint.
+func setupConstraintDatabase(t *testing.T) *sql.DB {
+	database, err := sql.Open("sqlite", ":memory:?_pragma=foreign_keys(1)")
+	assert.NoError(t, err)
+	database.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = database.Close() })
+
+	_, err = database.Exec(`
+		CREATE TABLE parent (
+			id      TEXT    PRIMARY KEY,
+			email   TEXT    UNIQUE
+		);
+		CREATE TABLE child (
+			id          TEXT        PRIMARY KEY,
+			parent_id   TEXT        NOT NULL    REFERENCES parent (id),
+			start_date  DATETIME    NULLABLE,
+			end_date    DATETIME    NULLABLE    CHECK (end_date >= start_date),
+			CONSTRAINT positive_id CHECK (id > 0)
+		);
+		INSERT INTO parent (id, email) VALUES ('1', 'some@email.tld');`)
+	assert.NoError(t, err)
+
+	return database
+}
+
+// -------- getConstraintCode tests: --------
+
+func TestGetConstraintCode_ShouldReturnZeroIfErrorIsNotAConstraintViolation(t *testing.T) {
+	database := setupConstraintDatabase(t)
+
+	_, err := database.Exec("SELECT * FROM does_not_exist")
+	assert.Error(t, err)
+	assert.Zero(t, getConstraintCode(err))
+	assert.Zero(t, getConstraintCode(errors.New("constraint failed: FOREIGN KEY constraint failed (787)")))
+	assert.Zero(t, getConstraintCode(nil))
+}
+
+// -------- isUniqueViolation tests: --------
+
+func TestIsUniqueViolation_ShouldMatchPrimaryKeyAndUniqueColumns(t *testing.T) {
+	database := setupConstraintDatabase(t)
+
+	_, primaryKeyErr := database.Exec("INSERT INTO parent (id) VALUES ('1')")
+	assert.True(t, isUniqueViolation(primaryKeyErr, "parent.id"))
+	assert.False(t, isUniqueViolation(primaryKeyErr, "parent.email"))
+
+	_, uniqueErr := database.Exec("INSERT INTO parent (id, email) VALUES ('2', 'some@email.tld')")
+	assert.True(t, isUniqueViolation(uniqueErr, "parent.email"))
+	assert.False(t, isUniqueViolation(uniqueErr, "parent.id"))
+}
+
+// -------- isCheckViolation tests: --------
+
+func TestIsCheckViolation_ShouldMatchConstraintNameOrExpression(t *testing.T) {
+	database := setupConstraintDatabase(t)
+
+	_, namedErr := database.Exec("INSERT INTO child (id, parent_id) VALUES (-1, '1')")
+	assert.True(t, isCheckViolation(namedErr, "positive_id"))
+	assert.False(t, isCheckViolation(namedErr, "end_date >= start_date"))
+
+	_, expressionErr := database.Exec(
+		"INSERT INTO child (id, parent_id, start_date, end_date) VALUES ('1', '1', '2025-02-01', '2025-01-01')")
+	assert.True(t, isCheckViolation(expressionErr, "end_date >= start_date"))
+	assert.False(t, isForeignKeyViolation(expressionErr))
+}
+
+// -------- translateConstraintError tests: --------
+
+func TestTranslateConstraintError_ShouldReturnConflictErrorForUniqueConstraint(t *testing.T) {
+	database := setupConstraintDatabase(t)
+
+	_, err := database.Exec("INSERT INTO parent (id, email) VALUES ('2', 'some@email.tld')")
+
+	var conflictError *internalErrors.ConflictError
+	translatedErr := translateConstraintError(err, "Email")
+	assert.True(t, errors.As(translatedErr, &conflictError))
+	assert.Equal(t, "conflict error on insert: Email already exists in database.", translatedErr.Error())
+}
+
+func TestTranslateConstraintError_ShouldReturnValidationErrorForOtherConstraints(t *testing.T) {
+	database := setupConstraintDatabase(t)
+
+	tests := []struct {
+		testName        string
+		sqlInsert       string
+		field           string
+		expectedMessage string
+	}{
+		{
+			"foreign key",
+			"INSERT INTO child (id, parent_id) VALUES ('1', '2')",
+			"ParentID",
+			"validation error on field 'ParentID': ParentID does not exist"},
+		{
+			"not null",
+			"INSERT INTO child (id) VALUES ('1')",
+			"ParentID",
+			"validation error on field 'ParentID': ParentID cannot be empty"},
+		{
+			"check",
+			"INSERT INTO child (id, parent_id) VALUES (-1, '1')",
+			"ID",
+			"validation error on field 'ID': ID is invalid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			_, err := database.Exec(test.sqlInsert)
+
+			var validationError *internalErrors.ValidationError
+			translatedErr := translateConstraintError(err, test.field)
+			assert.True(t, errors.As(translatedErr, &validationError))
+			assert.Equal(t, test.expectedMessage, translatedErr.Error())
+			assert.Equal(t, test.field, *validationError.Field)
+		})
+	}
+}
+
+func TestTranslateConstraintError_ShouldReturnNilIfErrorIsNotAConstraintViolation(t *testing.T) {
+	assert.Nil(t, translateConstraintError(errors.New("UNIQUE constraint failed: parent.id"), "ID"))
+	assert.Nil(t, translateConstraintError(nil, "ID"))
+}
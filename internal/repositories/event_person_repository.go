@@ -1,13 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -16,22 +17,24 @@ import (
 )
 
 type EventPersonRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewEventPersonRepository(database *sql.DB) *EventPersonRepository {
-	return &EventPersonRepository{database: database}
+func NewEventPersonRepository(database Executor, queryTimeout time.Duration) *EventPersonRepository {
+	return &EventPersonRepository{database: database, queryTimeout: queryTimeout}
 }
 
 // AssociateEventPerson can return ConflictError, InternalServiceError
 func (repository *EventPersonRepository) AssociateEventPerson(
-	associateModel *models.AssociateEventPerson) (*models.EventPerson, error) {
+	ctx context.Context, associateModel *models.AssociateEventPerson) (*models.EventPerson, error) {
 
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO event_person (
-			event_id, person_id, created_date
-		) VALUES (?, ?, ?) 
-		RETURNING event_id, person_id, created_date; `
+			event_id, person_id, created_date, role, notes, start_date, end_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?) 
+		RETURNING event_id, person_id, created_date, role, notes, start_date, end_date, updated_date; `
 
 	var createdDate string
 	if associateModel.CreatedDate != nil {
@@ -40,38 +43,45 @@ func (repository *EventPersonRepository) AssociateEventPerson(
 		createdDate = time.Now().UTC().Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		associateModel.EventID,
 		associateModel.PersonID,
 		createdDate,
+		associateModel.Role,
+		associateModel.Notes,
+		formatNullableLinkDate(associateModel.StartDate),
+		formatNullableLinkDate(associateModel.EndDate),
 	)
 
 	if row.Err() != nil {
-		if row.Err().Error() ==
-			"constraint failed: UNIQUE constraint failed: event_person.event_id, event_person.person_id (1555)" {
-
-			slog.Info(
+		if isUniqueViolation(row.Err(), "event_person.event_id, event_person.person_id") {
+			logger.Info(
 				"event_person_repository.associateToEvent: UNIQUE constraint failed",
 				"event_id", associateModel.EventID,
 				"person_id", associateModel.PersonID)
 
 			return nil, internalErrors.NewConflictError(
 				"EventID and PersonID combination already exists in database.")
-		} else if row.Err().Error() == "constraint failed: FOREIGN KEY constraint failed (787)" {
-			// TODO: Use foreign key constraint names (in 0003_add_application.up.sql) once modernc.org/sqlite
-			// supports it.
-			slog.Info("event_person_repository.Create: FOREIGN KEY constraint failed (787)")
-			return nil, internalErrors.NewValidationError(nil, "Foreign key does not exist")
+		} else if err := linkDatesError(row.Err()); err != nil {
+			logger.Info("event_person_repository.Create: CHECK constraint failed", "error", row.Err())
+			return nil, err
+		} else if err := translateConstraintError(row.Err(), "EventID or PersonID"); err != nil {
+			logger.Info("event_person_repository.Create: constraint failed", "error", row.Err())
+			return nil, err
 		}
 		return nil, row.Err()
 	}
 
 	// can return InternalServiceError
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("event_person_repository.create: No result found.", "error", err.Error())
+			logger.Info("event_person_repository.create: No result found.", "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("Unable to map EventPerson")
 		}
 		return nil, err
@@ -82,8 +92,9 @@ func (repository *EventPersonRepository) AssociateEventPerson(
 
 // GetByID can return ValidationError, InternalServiceError
 func (repository *EventPersonRepository) GetByID(
-	eventID *uuid.UUID, personID *uuid.UUID) ([]*models.EventPerson, error) {
+	ctx context.Context, eventID *uuid.UUID, personID *uuid.UUID) ([]*models.EventPerson, error) {
 
+	logger := logging.FromContext(ctx)
 	if (eventID == nil || *eventID == uuid.Nil) && (personID == nil || *personID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "eventID and personID cannot both be empty")
 	}
@@ -93,7 +104,7 @@ func (repository *EventPersonRepository) GetByID(
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
-		SELECT event_id, person_id, created_date 
+		SELECT event_id, person_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM event_person 
 		WHERE `)
 
@@ -115,7 +126,7 @@ func (repository *EventPersonRepository) GetByID(
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, " ", nil)
 	if err != nil {
 		//var message = "unable to join SQL statement string"
-		slog.Error("event_person_repository.GetByID: unable to join SQL statement string", "error", err)
+		logger.Error("event_person_repository.GetByID: unable to join SQL statement string", "error", err)
 		//return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -123,7 +134,10 @@ func (repository *EventPersonRepository) GetByID(
 
 	sqlString.WriteString("\n\t\tORDER BY created_date DESC ")
 
-	rows, err := repository.database.Query(sqlString.String(), sqlVars...)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlString.String(), sqlVars...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -134,9 +148,9 @@ func (repository *EventPersonRepository) GetByID(
 	var results []*models.EventPerson
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "getByID")
+		result, err := repository.mapRow(ctx, rows, "getByID")
 		if err != nil {
-			slog.Error("event_person_repository.getByID: Error mapping row", "error", err)
+			logger.Error("event_person_repository.getByID: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -146,7 +160,7 @@ func (repository *EventPersonRepository) GetByID(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("event_person_repository.getByID: Error iterating rows", "error", err)
+		logger.Error("event_person_repository.getByID: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading PersonCompanies from database: " + err.Error())
 	}
@@ -155,13 +169,17 @@ func (repository *EventPersonRepository) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (repository *EventPersonRepository) GetAll() ([]*models.EventPerson, error) {
+func (repository *EventPersonRepository) GetAll(ctx context.Context) ([]*models.EventPerson, error) {
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT event_id, person_id, created_date 
+		SELECT event_id, person_id, created_date, role, notes, start_date, end_date, updated_date 
 		FROM event_person 
 		ORDER BY created_date DESC; `
 
-	rows, err := repository.database.Query(sqlSelect)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -172,9 +190,9 @@ func (repository *EventPersonRepository) GetAll() ([]*models.EventPerson, error)
 	var results []*models.EventPerson
 	for rows.Next() {
 		// mapRow can return InternalServiceError
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("event_person_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("event_person_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -184,7 +202,7 @@ func (repository *EventPersonRepository) GetAll() ([]*models.EventPerson, error)
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("event_person_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("event_person_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError(
 			"Error reading PersonCompanies from database: " + err.Error())
 	}
@@ -192,16 +210,82 @@ func (repository *EventPersonRepository) GetAll() ([]*models.EventPerson, error)
 	return results, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (repository *EventPersonRepository) Update(ctx context.Context, model *models.UpdateEventPerson) error {
+	logger := logging.FromContext(ctx)
+
+	var sqlString strings.Builder
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	sqlString.WriteString(`
+		UPDATE event_person SET
+			updated_date = ?,
+			`)
+	sqlVars = append(sqlVars, time.Now().UTC().Format(timeutil.RFC3339Milli_Write))
+
+	appendLinkDetailsUpdates(&model.LinkDetails, &sqlParts, &sqlVars)
+
+	if len(sqlParts) == 0 {
+		logger.Info(
+			"event_person_repository.Update: nothing to update",
+			"eventID", model.EventID,
+			"personID", model.PersonID)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("event_person_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlString.WriteString(sqlPayload)
+	sqlString.WriteString(`
+		WHERE event_id = ? 
+		AND person_id = ? `)
+	sqlVars = append(sqlVars, model.EventID, model.PersonID)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlString.String(), sqlVars...)
+	if err != nil {
+		// can return ValidationError
+		if datesErr := linkDatesError(err); datesErr != nil {
+			logger.Info("event_person_repository.Update: CHECK constraint failed", "error", err)
+			return datesErr
+		}
+
+		logger.Error(
+			"event_person_repository.Update: Error trying to update EventPerson",
+			"eventID", model.EventID,
+			"personID", model.PersonID,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(
+		result,
+		"EventPerson does not exist. eventID: "+model.EventID.String()+
+			", personID: "+model.PersonID.String())
+}
+
 // Delete can return InternalServiceError, NotFoundError
-func (repository *EventPersonRepository) Delete(model *models.DeleteEventPerson) error {
+func (repository *EventPersonRepository) Delete(ctx context.Context, model *models.DeleteEventPerson) error {
+	logger := logging.FromContext(ctx)
 	sqlDelete := `
 		DELETE FROM event_person 
 		WHERE event_id = ? 
 		AND person_id = ?; `
 
-	result, err := repository.database.Exec(sqlDelete, model.EventID, model.PersonID)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlDelete, model.EventID, model.PersonID)
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"event_person_repository.Delete: Error trying to delete EventPerson",
 			"eventID", model.EventID,
 			"personID", model.PersonID,
@@ -211,7 +295,7 @@ func (repository *EventPersonRepository) Delete(model *models.DeleteEventPerson)
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error(
+		logger.Error(
 			"event_person_repository.Delete: Error trying to delete EventPerson",
 			"eventID", model.EventID,
 			"personID", model.PersonID,
@@ -231,13 +315,17 @@ func (repository *EventPersonRepository) Delete(model *models.DeleteEventPerson)
 }
 
 // mapRow can return InternalServiceError
-func (repository *EventPersonRepository) mapRow(scanner interface{ Scan(...interface{}) error },
+func (repository *EventPersonRepository) mapRow(ctx context.Context, scanner interface{ Scan(...interface{}) error },
 	methodName string) (*models.EventPerson, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.EventPerson
 	var createdDate sql.NullString
+	var linkDetails linkDetailsRow
 
-	err := scanner.Scan(&result.EventID, &result.PersonID, &createdDate)
+	destinations := []interface{}{&result.EventID, &result.PersonID, &createdDate}
+	err := scanner.Scan(append(destinations, linkDetails.scanDestinations()...)...)
 
 	if err != nil {
 		return nil, err
@@ -246,7 +334,7 @@ func (repository *EventPersonRepository) mapRow(scanner interface{ Scan(...inter
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("event_person_repository."+methodName+": Error parsing createdDate",
+			logger.Error("event_person_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -254,5 +342,11 @@ func (repository *EventPersonRepository) mapRow(scanner interface{ Scan(...inter
 		result.CreatedDate = timestamp
 	}
 
+	result.LinkDetails, result.UpdatedDate, err = linkDetails.toModel()
+	if err != nil {
+		logger.Error("event_person_repository."+methodName+": Error parsing link details", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError(err.Error())
+	}
+
 	return &result, nil
 }
@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -60,7 +61,7 @@ func TestAssociateEventToPerson_ShouldWork(t *testing.T) {
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now()),
 	}
-	associatedEventPerson, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	associatedEventPerson, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedEventPerson)
 
@@ -79,7 +80,7 @@ func TestAssociateEventToPerson_ShouldWorkWithOnlyRequiredFields(t *testing.T) {
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	associatedEventPerson, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	associatedEventPerson, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 	assert.NotNil(t, associatedEventPerson)
 
@@ -100,7 +101,7 @@ func TestAssociateEventToPerson_ShouldAssociateAEventToMultiplePersons(t *testin
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -108,10 +109,10 @@ func TestAssociateEventToPerson_ShouldAssociateAEventToMultiplePersons(t *testin
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := eventPersonRepository.GetAll()
+	personCompanies, err := eventPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -139,7 +140,7 @@ func TestAssociateEventToPerson_ShouldAssociateMultipleCompaniesToAPerson(t *tes
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -147,10 +148,10 @@ func TestAssociateEventToPerson_ShouldAssociateMultipleCompaniesToAPerson(t *tes
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
-	personCompanies, err := eventPersonRepository.GetAll()
+	personCompanies, err := eventPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.NotNil(t, personCompanies)
 	assert.Len(t, personCompanies, 2)
@@ -176,10 +177,10 @@ func TestAssociateEventToPerson_ShouldReturnConflictErrorIfEventIDAndPersonIDCom
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -199,12 +200,15 @@ func TestAssociateEventToPerson_ShouldReturnValidationErrorIfPersonIDDoesNotExis
 		EventID:  event.ID,
 		PersonID: uuid.New(),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'EventID or PersonID': EventID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateEventToPerson_ShouldReturnValidationErrorIfEventIDDoesNotExist(t *testing.T) {
@@ -216,12 +220,15 @@ func TestAssociateEventToPerson_ShouldReturnValidationErrorIfEventIDDoesNotExist
 		EventID:  uuid.New(),
 		PersonID: person.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'EventID or PersonID': EventID or PersonID does not exist",
+		validationError.Error())
 }
 
 func TestAssociateEventToPerson_ShouldReturnValidationErrorIfEventIDAndPersonIDDoNotExist(t *testing.T) {
@@ -231,12 +238,15 @@ func TestAssociateEventToPerson_ShouldReturnValidationErrorIfEventIDAndPersonIDD
 		EventID:  uuid.New(),
 		PersonID: uuid.New(),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'EventID or PersonID': EventID or PersonID does not exist",
+		validationError.Error())
 }
 
 // -------- GetByID tests: --------
@@ -255,7 +265,7 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -263,7 +273,7 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -271,10 +281,10 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	eventPersons, err := eventPersonRepository.GetByID(&event1.ID, nil)
+	eventPersons, err := eventPersonRepository.GetByID(context.Background(), &event1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, eventPersons, 2)
 
@@ -299,7 +309,7 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -307,7 +317,7 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -315,10 +325,10 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	eventPersons, err := eventPersonRepository.GetByID(nil, &person1.ID)
+	eventPersons, err := eventPersonRepository.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, eventPersons, 2)
 
@@ -342,24 +352,24 @@ func TestGetByID_ShouldGetRecordsMatchingEventIDAndPersonID(t *testing.T) {
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
 		EventID:  event1.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	persons, err := eventPersonRepository.GetByID(&event1.ID, &person1.ID)
+	persons, err := eventPersonRepository.GetByID(context.Background(), &event1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, event1.ID, persons[0].EventID)
@@ -379,24 +389,24 @@ func TestGetByID_ShouldGetNoRecordsIfEventIDDoesNotMatch(t *testing.T) {
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
 		EventID:  event1.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	persons, err := eventPersonRepository.GetByID(testutil.ToPtr(uuid.New()), &person1.ID)
+	persons, err := eventPersonRepository.GetByID(context.Background(), testutil.ToPtr(uuid.New()), &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -414,24 +424,24 @@ func TestEventPersonGetByID_ShouldGetNoRecordsIfPersonIDDoesNotMatch(t *testing.
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
 		EventID:  event1.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	persons, err := eventPersonRepository.GetByID(&event1.ID, testutil.ToPtr(uuid.New()))
+	persons, err := eventPersonRepository.GetByID(context.Background(), &event1.ID, testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -449,24 +459,25 @@ func TestGetByID_ShouldGetNoRecordsIfEventIDAndPersonIDDoesNotMatch(t *testing.T
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
 		EventID:  event1.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	persons, err := eventPersonRepository.GetByID(testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
+	persons, err := eventPersonRepository.GetByID(
+		context.Background(), testutil.ToPtr(uuid.New()), testutil.ToPtr(uuid.New()))
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -480,7 +491,7 @@ func TestEventPersonGetByID_ShouldGetNoRecordsIfNoRecordsInDB(t *testing.T) {
 	person1 := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
 
-	persons, err := eventPersonRepository.GetByID(&event1.ID, &person1.ID)
+	persons, err := eventPersonRepository.GetByID(context.Background(), &event1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Nil(t, persons)
 }
@@ -501,7 +512,7 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -509,7 +520,7 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -517,10 +528,10 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := eventPersonRepository.GetAll()
+	personCompanies, err := eventPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -544,11 +555,99 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 func TestGetAllEventPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	eventPersonRepository, _, _ := setupEventPersonRepository(t)
 
-	results, err := eventPersonRepository.GetAll()
+	results, err := eventPersonRepository.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
 
+// -------- Update tests: --------
+
+func TestUpdateEventPerson_ShouldUpdateLinkDetails(t *testing.T) {
+	eventPersonRepository, eventRepository, personRepository := setupEventPersonRepository(t)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateEventPerson{
+		EventID:  event.ID,
+		PersonID: person.ID,
+		LinkDetails: models.LinkDetails{
+			Role:      testutil.ToPtr("Hiring manager"),
+			StartDate: &startDate,
+		},
+	}
+	eventPerson, err := eventPersonRepository.AssociateEventPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+	assert.Equal(t, "Hiring manager", *eventPerson.Role)
+	assert.Nil(t, eventPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, eventPerson.StartDate)
+	assert.Nil(t, eventPerson.EndDate)
+	assert.Nil(t, eventPerson.UpdatedDate)
+
+	endDate := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	err = eventPersonRepository.Update(context.Background(), &models.UpdateEventPerson{
+		EventID:  event.ID,
+		PersonID: person.ID,
+		LinkDetails: models.LinkDetails{
+			Notes:   testutil.ToPtr("Moved to another team"),
+			EndDate: &endDate,
+		},
+	})
+	assert.NoError(t, err)
+
+	eventPersons, err := eventPersonRepository.GetByID(context.Background(), &event.ID, &person.ID)
+	assert.NoError(t, err)
+	assert.Len(t, eventPersons, 1)
+
+	updatedEventPerson := eventPersons[0]
+	assert.Equal(t, "Hiring manager", *updatedEventPerson.Role)
+	assert.Equal(t, "Moved to another team", *updatedEventPerson.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &startDate, updatedEventPerson.StartDate)
+	testutil.AssertEqualFormattedDateTimes(t, &endDate, updatedEventPerson.EndDate)
+	assert.NotNil(t, updatedEventPerson.UpdatedDate)
+}
+
+func TestUpdateEventPerson_ShouldReturnValidationErrorIfEndDateIsBeforeStartDate(t *testing.T) {
+	eventPersonRepository, eventRepository, personRepository := setupEventPersonRepository(t)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	associateModel := models.AssociateEventPerson{
+		EventID:     event.ID,
+		PersonID:    person.ID,
+		LinkDetails: models.LinkDetails{StartDate: &startDate},
+	}
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &associateModel)
+	assert.NoError(t, err)
+
+	endDate := startDate.AddDate(0, 0, -1)
+	err = eventPersonRepository.Update(context.Background(), &models.UpdateEventPerson{
+		EventID:     event.ID,
+		PersonID:    person.ID,
+		LinkDetails: models.LinkDetails{EndDate: &endDate},
+	})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'EndDate': EndDate cannot be before StartDate", err.Error())
+}
+
+func TestUpdateEventPerson_ShouldReturnNotFoundErrorIfLinkDoesNotExist(t *testing.T) {
+	eventPersonRepository, _, _ := setupEventPersonRepository(t)
+
+	err := eventPersonRepository.Update(context.Background(), &models.UpdateEventPerson{
+		EventID:     uuid.New(),
+		PersonID:    uuid.New(),
+		LinkDetails: models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
 // -------- Delete tests: --------
 
 func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
@@ -561,7 +660,7 @@ func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteEventPerson{
@@ -569,7 +668,7 @@ func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
 		PersonID: person.ID,
 	}
 
-	err = eventPersonRepository.Delete(&model)
+	err = eventPersonRepository.Delete(context.Background(), &model)
 	assert.NoError(t, err)
 }
 
@@ -583,7 +682,7 @@ func TestDeleteEventPerson_ShouldReturnNotFoundErrorIfNoMatchingEventPersonInDat
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonRepository.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	model := models.DeleteEventPerson{
@@ -591,7 +690,7 @@ func TestDeleteEventPerson_ShouldReturnNotFoundErrorIfNoMatchingEventPersonInDat
 		PersonID: uuid.New(),
 	}
 
-	err = eventPersonRepository.Delete(&model)
+	err = eventPersonRepository.Delete(context.Background(), &model)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/testutil"
 	"testing"
@@ -39,9 +40,9 @@ func TestEventPersonGetByID_ShouldReturnValidationError(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			repository := NewEventPersonRepository(nil)
+			repository := NewEventPersonRepository(nil, 0)
 
-			personCompanies, err := repository.GetByID(test.eventID, test.personID)
+			personCompanies, err := repository.GetByID(context.Background(), test.eventID, test.personID)
 			assert.Nil(t, personCompanies)
 
 			assert.Error(t, err)
@@ -1,15 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -17,21 +17,27 @@ import (
 	"github.com/google/uuid"
 )
 
+const sqlEventColumns = `id, event_type, description, notes, event_date, duration_minutes, location, meeting_url,
+			interview_format, timezone, created_date, updated_date, version`
+
 type EventRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewEventRepository(database *sql.DB) *EventRepository {
-	return &EventRepository{database: database}
+func NewEventRepository(database Executor, queryTimeout time.Duration) *EventRepository {
+	return &EventRepository{database: database, queryTimeout: queryTimeout}
 }
 
-// Create can return ConflictError, InternalServiceError
-func (repository *EventRepository) Create(event *models.CreateEvent) (*models.Event, error) {
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *EventRepository) Create(ctx context.Context, event *models.CreateEvent) (*models.Event, error) {
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO event (
-			id, event_type, description, notes, event_date, created_date, updated_date
-		) VALUES (?, ?, ?, ?, ?, ?, ?)
-		RETURNING id, event_type, description, notes, event_date, created_date, updated_date, null, null, null`
+			id, event_type, description, notes, event_date, duration_minutes, location, meeting_url, interview_format,
+			timezone, created_date, updated_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING ` + sqlEventColumns + ``
 
 	var eventID uuid.UUID
 	if event.ID != nil {
@@ -42,7 +48,7 @@ func (repository *EventRepository) Create(event *models.CreateEvent) (*models.Ev
 
 	var eventDate, createdDate, updatedDate interface{}
 
-	eventDate = event.EventDate.Format(timeutil.RFC3339Milli_Write)
+	eventDate = formatEventDate(event.EventDate, event.Timezone)
 
 	if event.CreatedDate != nil {
 		createdDate = event.CreatedDate.Format(timeutil.RFC3339Milli_Write)
@@ -54,25 +60,43 @@ func (repository *EventRepository) Create(event *models.CreateEvent) (*models.Ev
 		updatedDate = event.UpdatedDate.Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		eventID,
 		event.EventType,
 		event.Description,
 		event.Notes,
 		eventDate,
+		event.DurationMinutes,
+		event.Location,
+		event.MeetingURL,
+		event.InterviewFormat,
+		event.Timezone,
 		createdDate,
 		updatedDate,
 	)
 
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
-		if err.Error() == "constraint failed: UNIQUE constraint failed: event.id (1555)" {
-			slog.Info(
+		if isUniqueViolation(err, "event.id") {
+			logger.Info(
 				"event_repository.CreateEvent: UNIQUE constraint failed",
 				"ID", eventID)
 			return nil, internalErrors.NewConflictError(
 				"ID already exists in database: '" + eventID.String() + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("event_repository.CreateEvent: FOREIGN KEY constraint failed", "ID", eventID)
+			eventType := "eventType"
+			return nil, internalErrors.NewValidationError(
+				&eventType, "event type does not exist: '"+event.EventType.String()+"'")
+		} else if constraintErr := translateConstraintError(
+			err, "DurationMinutes or InterviewFormat"); constraintErr != nil {
+			logger.Info("event_repository.CreateEvent: constraint failed", "ID", eventID, "error", err)
+			return nil, constraintErr
 		}
 		return nil, err
 	}
@@ -81,23 +105,27 @@ func (repository *EventRepository) Create(event *models.CreateEvent) (*models.Ev
 }
 
 // GetById can return InternalServiceError, NotFoundError, ValidationError
-func (repository *EventRepository) GetByID(id *uuid.UUID) (*models.Event, error) {
+func (repository *EventRepository) GetByID(ctx context.Context, id *uuid.UUID) (*models.Event, error) {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Info("event_repository.GetById: ID is nil")
+		logger.Info("event_repository.GetById: ID is nil")
 		var id = "ID"
 		return nil, internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlSelect := `
-		SELECT id, event_type, description, notes, event_date, created_date, updated_date, null, null, null
+		SELECT ` + sqlEventColumns + `
 		FROM event
 		WHERE id = ? `
 
-	row := repository.database.QueryRow(sqlSelect, id)
-	result, err := repository.mapRow(row, "GetById")
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, id)
+	result, err := repository.mapRow(ctx, row, "GetById")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("event_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
+			logger.Info("event_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
 		}
 		return nil, err
@@ -106,45 +134,84 @@ func (repository *EventRepository) GetByID(id *uuid.UUID) (*models.Event, error)
 	return result, err
 }
 
+// GetAllByIDs returns the events with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetAllByIDs can return InternalServiceError, ValidationError
+func (repository *EventRepository) GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Event, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	if err := models.ValidateIDs(ids); err != nil {
+		logger.Info("event_repository.GetAllByIDs: IDs are invalid", "error", err)
+		return nil, err
+	}
+
+	placeholders, sqlVars := buildIDPlaceholders(ids)
+	sqlSelect := `
+		SELECT ` + sqlEventColumns + `
+		FROM event
+		WHERE id IN (` + placeholders + `) `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("event_repository.GetAllByIDs: Error trying to query events", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query events: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.Event
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByIDs")
+		if err != nil {
+			logger.Error("event_repository.GetAllByIDs: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing event data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("event_repository.GetAllByIDs: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
+	}
+
+	return orderByIDs(ids, results, func(event *models.Event) uuid.UUID { return event.ID }), nil
+}
+
 // GetAll can return InternalServiceError
 func (repository *EventRepository) GetAll(
+	ctx context.Context,
 	includeApplications models.IncludeExtraDataType,
 	includeCompanies models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType) ([]*models.Event, error) {
+
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-		SELECT e.id, e.event_type, e.description, e.notes, e.event_date, e.created_date, e.updated_date, %s, %s, %s
-		FROM event e %s %s %s
-		GROUP BY e.ID
-		ORDER BY e.event_date DESC`
-
-	applicationsCoalesceString, applicationsJoinString :=
-		repository.buildApplicationsCoalesceAndJoin(includeApplications)
-	companiesCoalesceString, companiesJoinString := repository.buildCompaniesCoalesceAndJoin(includeCompanies)
-	personsCoalesceString, personsJoinString := repository.buildPersonsCoalesceAndJoin(includePersons)
-
-	sqlSelect = fmt.Sprintf(
-		sqlSelect,
-		applicationsCoalesceString,
-		companiesCoalesceString,
-		personsCoalesceString,
-		applicationsJoinString,
-		companiesJoinString,
-		personsJoinString)
-
-	rows, err := repository.database.Query(sqlSelect)
+		SELECT ` + sqlEventColumns + `
+		FROM event
+		ORDER BY event_date DESC`
+
+	queryCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(queryCtx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	defer rows.Close()
 
 	var results []*models.Event
 
 	for rows.Next() {
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("event_repository.GetAll: mapping row", "error", err)
+			logger.Error("event_repository.GetAll: mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("error processing event data" + err.Error())
 		}
 
@@ -154,22 +221,90 @@ func (repository *EventRepository) GetAll(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("event_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("event_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
 	}
 
+	// can return InternalServiceError
+	err = repository.loadRelations(ctx, results, includeApplications, includeCompanies, includePersons)
+	if err != nil {
+		return nil, err
+	}
+
 	return results, nil
 }
 
-// Update can return InternalServiceError, ValidationError
-func (repository *EventRepository) Update(event *models.UpdateEvent) error {
+// GetAllByFilter returns the events selected by filter, oldest first.
+//
+// GetAllByFilter can return InternalServiceError, ValidationError
+func (repository *EventRepository) GetAllByFilter(
+	ctx context.Context,
+	filter *models.EventFilter,
+	includeApplications models.IncludeExtraDataType,
+	includeCompanies models.IncludeExtraDataType,
+	includePersons models.IncludeExtraDataType) ([]*models.Event, error) {
+
+	logger := logging.FromContext(ctx)
+	if filter == nil {
+		logger.Error("event_repository.GetAllByFilter: filter is nil")
+		return nil, internalErrors.NewValidationError(nil, "filter is nil")
+	}
+
+	sqlSelect := `
+		SELECT e.id, e.event_type, e.description, e.notes, e.event_date, e.duration_minutes, e.location, e.meeting_url,
+			e.interview_format, e.timezone, e.created_date, e.updated_date, e.version
+		FROM event e
+		%s
+		ORDER BY julianday(e.event_date), e.created_date`
+
+	whereString, sqlVars := repository.buildFilterWhere(filter)
+	sqlSelect = fmt.Sprintf(sqlSelect, whereString)
+
+	queryCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(queryCtx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("event_repository.GetAllByFilter: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
+	}
+	defer rows.Close()
+
+	results := []*models.Event{}
+	for rows.Next() {
+		result, err := repository.mapRow(ctx, rows, "GetAllByFilter")
+		if err != nil {
+			logger.Error("event_repository.GetAllByFilter: mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("error processing event data" + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("event_repository.GetAllByFilter: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
+	}
+
+	// can return InternalServiceError
+	err = repository.loadRelations(ctx, results, includeApplications, includeCompanies, includePersons)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Update can return ConflictError, InternalServiceError, ValidationError
+func (repository *EventRepository) Update(ctx context.Context, event *models.UpdateEvent) error {
+	logger := logging.FromContext(ctx)
 	var sqlString strings.Builder
 	var sqlParts []string
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
 		UPDATE event SET 
-			updated_date = ?, 
+			updated_date = ?,
+			version = version + 1,
 			`)
 	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
 
@@ -195,19 +330,49 @@ func (repository *EventRepository) Update(event *models.UpdateEvent) error {
 
 	if event.EventDate != nil {
 		sqlParts = append(sqlParts, "event_date = ?")
-		sqlVars = append(sqlVars, event.EventDate.Format(timeutil.RFC3339Milli_Write))
+		sqlVars = append(sqlVars, formatEventDate(*event.EventDate, event.Timezone))
+		updateItemCount++
+	}
+
+	if event.DurationMinutes != nil {
+		sqlParts = append(sqlParts, "duration_minutes = ?")
+		sqlVars = append(sqlVars, *event.DurationMinutes)
+		updateItemCount++
+	}
+
+	if event.Location != nil {
+		sqlParts = append(sqlParts, "location = ?")
+		sqlVars = append(sqlVars, *event.Location)
+		updateItemCount++
+	}
+
+	if event.MeetingURL != nil {
+		sqlParts = append(sqlParts, "meeting_url = ?")
+		sqlVars = append(sqlVars, *event.MeetingURL)
+		updateItemCount++
+	}
+
+	if event.InterviewFormat != nil {
+		sqlParts = append(sqlParts, "interview_format = ?")
+		sqlVars = append(sqlVars, *event.InterviewFormat)
+		updateItemCount++
+	}
+
+	if event.Timezone != nil {
+		sqlParts = append(sqlParts, "timezone = ?")
+		sqlVars = append(sqlVars, *event.Timezone)
 		updateItemCount++
 	}
 
 	if updateItemCount == 0 {
-		slog.Info("event_repository.Update: nothing to update", "id", event.ID)
+		logger.Info("event_repository.Update: nothing to update", "id", event.ID)
 		return internalErrors.NewValidationError(nil, "nothing to update")
 	}
 
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
 	if err != nil {
 		var message = "unable to join SQL statement string"
-		slog.Error("event_repository.Update: unable to join SQL statement string", "error", err)
+		logger.Error("event_repository.Update: unable to join SQL statement string", "error", err)
 		return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -217,41 +382,153 @@ func (repository *EventRepository) Update(event *models.UpdateEvent) error {
 		WHERE id = ? `)
 	sqlVars = append(sqlVars, event.ID)
 
-	_, err = repository.database.Exec(
+	if event.ExpectedVersion != nil {
+		sqlString.WriteString("AND version = ? ")
+		sqlVars = append(sqlVars, *event.ExpectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
 		sqlString.String(),
 		sqlVars...,
 	)
 
 	if err != nil {
-		slog.Error("event_repository.Update: unable to update event", "id", event.ID, "error", err.Error())
+		if isForeignKeyViolation(err) {
+			logger.Info("event_repository.Update: FOREIGN KEY constraint failed", "id", event.ID)
+			eventType := "eventType"
+			return internalErrors.NewValidationError(
+				&eventType, "event type does not exist: '"+event.EventType.String()+"'")
+		} else if constraintErr := translateConstraintError(
+			err, "DurationMinutes or InterviewFormat"); constraintErr != nil {
+			logger.Info("event_repository.Update: constraint failed", "id", event.ID, "error", err)
+			return constraintErr
+		}
+		logger.Error("event_repository.Update: unable to update event", "id", event.ID, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
-	return err
+	if event.ExpectedVersion != nil {
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error(
+				"event_repository.Update: unable to update event", "id", event.ID, "error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+		if rowsAffected == 0 {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"event", "id", "event", event.ID, *event.ExpectedVersion)
+		}
+	}
+
+	return nil
 }
 
-// Delete can return InternalServiceError, NotFoundError, ValidationError
-func (repository *EventRepository) Delete(id *uuid.UUID) error {
+// GetOverlappingBookedEvents returns the booked events, other than the event with excludedID, that overlap the period
+// from startDate to endDate. Booked events without a duration are assumed to last models.DefaultBookedEventDuration.
+// Dates are compared as instants, so events stored with different UTC offsets are compared correctly.
+//
+// GetOverlappingBookedEvents can return InternalServiceError
+func (repository *EventRepository) GetOverlappingBookedEvents(
+	ctx context.Context, excludedID *uuid.UUID, startDate time.Time, endDate time.Time) ([]*models.Event, error) {
+
+	logger := logging.FromContext(ctx)
+	bookedTypePlaceholders := strings.TrimSuffix(strings.Repeat("?, ", len(models.BookedEventTypes)), ", ")
+	sqlSelect := `
+		SELECT ` + sqlEventColumns + `
+		FROM event
+		WHERE event_type IN (` + bookedTypePlaceholders + `)
+			AND id != ?
+			AND julianday(event_date) < julianday(?)
+			AND julianday(event_date, '+' || COALESCE(duration_minutes, ?) || ' minutes') > julianday(?)
+		ORDER BY julianday(event_date)`
+
+	excluded := uuid.Nil
+	if excludedID != nil {
+		excluded = *excludedID
+	}
+
+	sqlVars := make([]interface{}, 0, len(models.BookedEventTypes)+4)
+	for _, eventType := range models.BookedEventTypes {
+		sqlVars = append(sqlVars, eventType)
+	}
+	sqlVars = append(
+		sqlVars,
+		excluded,
+		endDate.Format(timeutil.RFC3339Milli_Write),
+		int(models.DefaultBookedEventDuration/time.Minute),
+		startDate.Format(timeutil.RFC3339Milli_Write))
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("event_repository.GetOverlappingBookedEvents: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
+	}
+	defer rows.Close()
+
+	results := []*models.Event{}
+	for rows.Next() {
+		result, err := repository.mapRow(ctx, rows, "GetOverlappingBookedEvents")
+		if err != nil {
+			logger.Error("event_repository.GetOverlappingBookedEvents: mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("error processing event data" + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("event_repository.GetOverlappingBookedEvents: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading events from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// Delete deletes the event with id. If expectedVersion is set, it is only deleted if it still has that version.
+//
+// Delete can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *EventRepository) Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Error("event_repository.Delete: ID is nil")
+		logger.Error("event_repository.Delete: ID is nil")
 		id := "ID"
 		return internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlDelete := "DELETE FROM event WHERE id = ?"
+	sqlVars := []interface{}{id}
+	if expectedVersion != nil {
+		sqlDelete += " AND version = ?"
+		sqlVars = append(sqlVars, *expectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
 
-	result, err := repository.database.Exec(sqlDelete, id)
+	result, err := repository.database.ExecContext(ctx, sqlDelete, sqlVars...)
 	if err != nil {
-		slog.Error("event_repository.Delete: Error trying to delete event", "id", id, "error", err.Error())
+		logger.Error("event_repository.Delete: Error trying to delete event", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error("event_repository.Delete: Error trying to delete event", "id", id, "error", err.Error())
+		logger.Error("event_repository.Delete: Error trying to delete event", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 	if rowsAffected == 0 {
+		if expectedVersion != nil {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"event", "id", "event", *id, *expectedVersion)
+		}
 		return internalErrors.NewNotFoundError("event does not exist. ID: " + id.String())
 	} else if rowsAffected > 1 {
 		return internalErrors.NewInternalServiceError(
@@ -263,11 +540,14 @@ func (repository *EventRepository) Delete(id *uuid.UUID) error {
 
 // mapRow can return InternalServiceError
 func (repository *EventRepository) mapRow(
+	ctx context.Context,
 	scanner interface{ Scan(...interface{}) error },
 	methodName string) (*models.Event, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.Event
-	var eventDate, createdDate, updatedDate, applicationsString, companiesString, personsString sql.NullString
+	var eventDate, createdDate, updatedDate sql.NullString
 
 	err := scanner.Scan(
 		&result.ID,
@@ -275,11 +555,14 @@ func (repository *EventRepository) mapRow(
 		&result.Description,
 		&result.Notes,
 		&eventDate,
+		&result.DurationMinutes,
+		&result.Location,
+		&result.MeetingURL,
+		&result.InterviewFormat,
+		&result.Timezone,
 		&createdDate,
 		&updatedDate,
-		&applicationsString,
-		&companiesString,
-		&personsString)
+		&result.Version)
 
 	if err != nil {
 		return nil, err
@@ -288,18 +571,23 @@ func (repository *EventRepository) mapRow(
 	if eventDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, eventDate.String)
 		if err != nil {
-			slog.Error("event_repository."+methodName+": Error parsing eventDate in mapRow",
+			logger.Error("event_repository."+methodName+": Error parsing eventDate in mapRow",
 				"eventDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing eventDate: " + err.Error())
 		}
+		if result.Timezone != nil {
+			if location, err := models.LoadTimezone(*result.Timezone); err == nil {
+				timestamp = timestamp.In(location)
+			}
+		}
 		result.EventDate = &timestamp
 	}
 
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("event_repository."+methodName+": Error parsing createdDate in mapRow",
+			logger.Error("event_repository."+methodName+": Error parsing createdDate in mapRow",
 				"createdDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -310,7 +598,7 @@ func (repository *EventRepository) mapRow(
 	if updatedDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, updatedDate.String)
 		if err != nil {
-			slog.Error("event_repository."+methodName+": Error parsing updatedDate in mapRow",
+			logger.Error("event_repository."+methodName+": Error parsing updatedDate in mapRow",
 				"updatedDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing updatedDate: " + err.Error())
@@ -318,157 +606,165 @@ func (repository *EventRepository) mapRow(
 		result.UpdatedDate = &timestamp
 	}
 
-	if applicationsString.Valid {
-		var applications []*models.Application
-		if err = json.Unmarshal([]byte(applicationsString.String), &applications); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing applications: " + err.Error())
-		}
+	return &result, nil
+}
 
-		if len(applications) > 0 {
-			result.Applications = &applications
+// formatEventDate writes eventDate with the UTC offset of timezone, so that all events scheduled in the same timezone
+// are stored with consistent offsets. eventDate is written as given if timezone is nil or invalid.
+func formatEventDate(eventDate time.Time, timezone *string) string {
+	if timezone != nil {
+		if location, err := models.LoadTimezone(*timezone); err == nil {
+			eventDate = eventDate.In(location)
 		}
 	}
+	return eventDate.Format(timeutil.RFC3339Milli_Write)
+}
 
-	if companiesString.Valid {
-		var companies []*models.Company
-		if err = json.Unmarshal([]byte(companiesString.String), &companies); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing companies: " + err.Error())
-		}
+// buildFilterWhere returns the WHERE clause for filter, and its variables. The clause is empty if filter selects
+// every event.
+func (repository *EventRepository) buildFilterWhere(filter *models.EventFilter) (string, []interface{}) {
+	var conditions []string
+	var sqlVars []interface{}
 
-		if len(companies) > 0 {
-			result.Companies = &companies
-		}
+	if filter.ApplicationID != nil {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM application_event filter_ae
+			WHERE filter_ae.event_id = e.id AND filter_ae.application_id = ?)`)
+		sqlVars = append(sqlVars, *filter.ApplicationID)
 	}
 
-	if personsString.Valid {
-		var persons []*models.Person
-		if err = json.Unmarshal([]byte(personsString.String), &persons); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing persons: " + err.Error())
-		}
-
-		if len(persons) > 0 {
-			result.Persons = &persons
-		}
+	if filter.CompanyID != nil {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM company_event filter_ce
+			WHERE filter_ce.event_id = e.id AND filter_ce.company_id = ?)`)
+		sqlVars = append(sqlVars, *filter.CompanyID)
 	}
 
-	return &result, nil
-}
+	if filter.PersonID != nil {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM event_person filter_ep
+			WHERE filter_ep.event_id = e.id AND filter_ep.person_id = ?)`)
+		sqlVars = append(sqlVars, *filter.PersonID)
+	}
 
-func (repository *EventRepository) buildApplicationsCoalesceAndJoin(
-	includeApplications models.IncludeExtraDataType) (string, string) {
-
-	if includeApplications == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', a.id%s
-				) ORDER BY a.created_date DESC
-			) FILTER (WHERE a.id IS NOT NULL),
-			JSON_ARRAY()
-		) as applications
-		`
-
-	allColumns := ""
-	if includeApplications == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'CompanyID', a.company_id,
-					'RecruiterID', a.recruiter_id,
-					'JobTitle', a.job_title,
-					'JobAdURL', a.job_ad_url,
-					'Country', a.country,
-					'Area', a.area,
-					'RemoteStatusType', a.remote_status_type,
-					'WeekdaysInOffice', a.weekdays_in_office,
-					'EstimatedCycleTime', a.estimated_cycle_time,
-					'EstimatedCommuteTime', a.estimated_commute_time,
-					'ApplicationDate', a.application_date,
-					'CreatedDate', a.created_date,
-					'UpdatedDate', a.updated_date`
-	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString := `
-		LEFT JOIN application_event ae ON ae.event_id = e.id 
-		LEFT JOIN application a ON a.id = ae.application_id `
-
-	return coalesceString, joinString
-}
+	if len(filter.EventTypes) > 0 {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(filter.EventTypes)), ", ")
+		conditions = append(conditions, "e.event_type IN ("+placeholders+")")
+		for _, eventType := range filter.EventTypes {
+			sqlVars = append(sqlVars, eventType)
+		}
+	}
 
-func (repository *EventRepository) buildCompaniesCoalesceAndJoin(
-	includeCompanies models.IncludeExtraDataType) (string, string) {
+	if filter.FromDate != nil {
+		conditions = append(conditions, "julianday(e.event_date) >= julianday(?)")
+		sqlVars = append(sqlVars, filter.FromDate.Format(timeutil.RFC3339Milli_Write))
+	}
 
-	if includeCompanies == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+	if filter.ToDate != nil {
+		conditions = append(conditions, "julianday(e.event_date) < julianday(?)")
+		sqlVars = append(sqlVars, filter.ToDate.Format(timeutil.RFC3339Milli_Write))
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', c.id%s
-				) ORDER BY c.created_date DESC
-			) FILTER (WHERE c.id IS NOT NULL),
-			JSON_ARRAY()
-		) as companies`
+	if filter.OnlyNotCompleted {
+		var completedTypeCases strings.Builder
+		for _, bookedType := range models.BookedEventTypes {
+			completedType, _ := bookedType.CompletedEventType()
+			completedTypeCases.WriteString(" WHEN ? THEN ?")
+			sqlVars = append(sqlVars, bookedType, completedType)
+		}
 
-	allColumns := ""
-	if includeCompanies == models.IncludeExtraDataTypeAll {
-		allColumns = `, 
-					'Name', c.name, 
-					'CompanyType', c.company_type, 
-					'Notes', c.notes, 
-					'LastContact', c.last_contact, 
-					'CreatedDate', c.created_date, 
-					'UpdatedDate', c.updated_date `
+		conditions = append(conditions, `NOT EXISTS (
+			SELECT 1 FROM event completed
+			WHERE completed.event_type = CASE e.event_type`+completedTypeCases.String()+` END
+				AND julianday(completed.event_date) >= julianday(e.event_date)
+				AND (
+					EXISTS (
+						SELECT 1 FROM application_event booked_ae
+						JOIN application_event completed_ae ON completed_ae.application_id = booked_ae.application_id
+						WHERE booked_ae.event_id = e.id AND completed_ae.event_id = completed.id)
+					OR EXISTS (
+						SELECT 1 FROM company_event booked_ce
+						JOIN company_event completed_ce ON completed_ce.company_id = booked_ce.company_id
+						WHERE booked_ce.event_id = e.id AND completed_ce.event_id = completed.id)))`)
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
 
-	joinString := `
-		LEFT JOIN company_event ce ON ce.event_id = e.id 
-		LEFT JOIN company c ON c.id = ce.company_id `
+	if len(conditions) == 0 {
+		return "", nil
+	}
 
-	return coalesceString, joinString
+	return "WHERE " + strings.Join(conditions, "\n\t\t\tAND "), sqlVars
 }
 
-func (repository *EventRepository) buildPersonsCoalesceAndJoin(
-	includePersons models.IncludeExtraDataType) (string, string) {
+// loadRelations adds the applications, companies and persons to events, as selected by the include types. Each
+// relation is loaded with batched queries, instead of joins that repeat each event for every relation.
+//
+// loadRelations can return InternalServiceError
+func (repository *EventRepository) loadRelations(
+	ctx context.Context,
+	events []*models.Event,
+	includeApplications models.IncludeExtraDataType,
+	includeCompanies models.IncludeExtraDataType,
+	includePersons models.IncludeExtraDataType) error {
 
-	if includePersons == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+	eventIDs := make([]uuid.UUID, len(events))
+	for index, event := range events {
+		eventIDs[index] = event.ID
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', p.id%s
-				) ORDER BY p.created_date DESC
-			) FILTER (WHERE p.id IS NOT NULL),
-			JSON_ARRAY()
-		) as persons
-`
+	if includeApplications != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable:     "application_event",
+			ownerColumn:   "event_id",
+			relatedColumn: "application_id",
+			relatedTable:  "application",
+		}
+
+		// can return InternalServiceError
+		applications, err := loadRelatedApplications(
+			ctx, repository.database, repository.queryTimeout, link, eventIDs, includeApplications, false)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if eventApplications := applications[event.ID]; len(eventApplications) > 0 {
+				event.Applications = &eventApplications
+			}
+		}
+	}
 
-	allColumns := ""
-	if includePersons == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'Name', p.name,
-					'PersonType', p.person_type,
-					'Email', p.email,
-					'Phone', p.phone,
-					'Notes', p.notes,
-					'CreatedDate', p.created_date,
-					'UpdatedDate', p.updated_date`
+	if includeCompanies != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "company_event", ownerColumn: "event_id", relatedColumn: "company_id", relatedTable: "company"}
 
+		// can return InternalServiceError
+		companies, err := loadRelatedCompanies(
+			ctx, repository.database, repository.queryTimeout, link, eventIDs, includeCompanies)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if eventCompanies := companies[event.ID]; len(eventCompanies) > 0 {
+				event.Companies = &eventCompanies
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
 
-	joinString := `
-		LEFT JOIN event_person ep ON ep.event_id = e.id 
-		LEFT JOIN person p ON p.id = ep.person_id `
+	if includePersons != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "event_person", ownerColumn: "event_id", relatedColumn: "person_id", relatedTable: "person"}
 
-	return coalesceString, joinString
+		// can return InternalServiceError
+		persons, err := loadRelatedPersons(
+			ctx, repository.database, repository.queryTimeout, link, eventIDs, includePersons)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if eventPersons := persons[event.ID]; len(eventPersons) > 0 {
+				event.Persons = &eventPersons
+			}
+		}
+	}
+
+	return nil
 }
@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	"jobsearchtracker/internal/api/v1/requests"
 	configPackage "jobsearchtracker/internal/config"
@@ -98,7 +99,7 @@ func TestCreate_ShouldInsertEvent(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	insertedEvent, err := eventRepository.Create(&createEvent)
+	insertedEvent, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedEvent)
 
@@ -121,7 +122,7 @@ func TestCreate_ShouldInsertEventWithOnlyRequiredFields(t *testing.T) {
 	}
 	createdDateApproximation := time.Now()
 
-	insertedEvent, err := eventRepository.Create(&createEvent)
+	insertedEvent, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedEvent)
 
@@ -144,7 +145,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicateEventID(t *testing.T) {
 		EventType: models.EventTypeApplied,
 		EventDate: time.Now().AddDate(0, 12, 0),
 	}
-	_, err := eventRepository.Create(&event1)
+	_, err := eventRepository.Create(context.Background(), &event1)
 	assert.NoError(t, err)
 
 	event2 := models.CreateEvent{
@@ -152,7 +153,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicateEventID(t *testing.T) {
 		EventType: models.EventTypeOffer,
 		EventDate: time.Now().AddDate(0, 3, 0),
 	}
-	nilEvent, err := eventRepository.Create(&event2)
+	nilEvent, err := eventRepository.Create(context.Background(), &event2)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -177,10 +178,10 @@ func TestGetByID_ShouldGetEvent(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 6, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 5, 0)),
 	}
-	_, err := eventRepository.Create(&createEvent)
+	_, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
-	event, err := eventRepository.GetByID(createEvent.ID)
+	event, err := eventRepository.GetByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -197,7 +198,7 @@ func TestGetByID_ShouldReturnNotFoundErrorIfEventIDDoesNotExist(t *testing.T) {
 	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
 
 	id := uuid.New()
-	nilEvent, err := eventRepository.GetByID(&id)
+	nilEvent, err := eventRepository.GetByID(context.Background(), &id)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -208,6 +209,22 @@ func TestGetByID_ShouldReturnNotFoundErrorIfEventIDDoesNotExist(t *testing.T) {
 		notFoundError.Error())
 }
 
+// -------- GetAllByIDs tests: --------
+
+func TestGetAllByIDs_ShouldReturnEventsInOrderOfIDs(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	event1 := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	event2 := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	events, err := eventRepository.GetAllByIDs(context.Background(), []uuid.UUID{event2.ID, uuid.New(), event1.ID})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, event2.ID, events[0].ID)
+	assert.Equal(t, event1.ID, events[1].ID)
+	assert.Equal(t, event1.EventType, events[1].EventType)
+}
+
 // -------- GetAll - Base tests: --------
 
 func TestGetAll_ShouldReturnAllEvents(t *testing.T) {
@@ -222,12 +239,13 @@ func TestGetAll_ShouldReturnAllEvents(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	_, err := eventRepository.Create(&createEvent1)
+	_, err := eventRepository.Create(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 
 	createEvent2 := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -256,6 +274,7 @@ func TestGetAll_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -313,7 +332,7 @@ func TestEventRepositoryGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSe
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -333,6 +352,7 @@ func TestEventRepositoryGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsSe
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -384,6 +404,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsIs
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -430,7 +451,7 @@ func TestEventRepositoryGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIs
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -449,6 +470,7 @@ func TestEventRepositoryGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsIs
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -497,6 +519,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsIs
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -542,6 +565,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsIs
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -593,7 +617,7 @@ func TestEventRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 4, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 3, 0)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -608,26 +632,27 @@ func TestEventRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -673,6 +698,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToA
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -725,7 +751,7 @@ func TestEventRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToID
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 4, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 3, 0)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -740,26 +766,27 @@ func TestEventRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToID
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -802,6 +829,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToI
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -832,6 +860,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToN
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -885,7 +914,7 @@ func TestEventRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -900,26 +929,27 @@ func TestEventRepositoryGetAll_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *
 		PersonID: *createPerson1.ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person1Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person1Event1)
 	assert.NoError(t, err)
 
 	Person2Event1 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event1)
 	assert.NoError(t, err)
 
 	Person2Event2 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event2ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -966,6 +996,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetToAllAn
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -1020,7 +1051,7 @@ func TestEventRepositoryGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1035,26 +1066,27 @@ func TestEventRepositoryGetAll_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t
 		PersonID: *createPerson1.ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person1Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person1Event1)
 	assert.NoError(t, err)
 
 	Person2Event1 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event1)
 	assert.NoError(t, err)
 
 	Person2Event2 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event2ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1098,6 +1130,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetToIDsAn
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1128,6 +1161,7 @@ func TestEventRepositoryGetAll_ShouldReturnNoPersonsIfIncludePersonsIsSetToNone(
 	// get all events
 
 	events, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1185,6 +1219,7 @@ func TestEventRepositoryGetAll_ShouldReturnTwoEventsEvenIfOneApplicationIsShared
 	// ensure that two events are returned
 
 	eventsWithApplications, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1225,6 +1260,7 @@ func TestEventRepositoryGetAll_ShouldReturnTwoEventsEvenIfOneCompanyIsSharedBetw
 	// ensure that two companies are returned
 
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1265,6 +1301,7 @@ func TestEventRepositoryGetAll_ShouldReturnTwoEventsEvenIfOnePersonIsSharedBetwe
 	// ensure that two companies are returned
 
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1314,6 +1351,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithOneApplicationAndTwoCompanie
 
 	// Ensure that the event is returned with one company and two companies
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1367,6 +1405,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoApplicationsAndOneCompany
 	// Ensure that the event is returned with one company and two companies
 
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1431,6 +1470,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoApplicationsAndTwoCompani
 
 	// Ensure that the event is returned with one company and two companies
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1483,6 +1523,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithOneApplicationAndTwoPersons(
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1538,6 +1579,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoApplicationsAndOnePerson(
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1604,6 +1646,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoApplicationsAndTwoPersons
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1655,6 +1698,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithOneCompanyAndTwoPersons(t *t
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1706,6 +1750,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoCompaniesAndOnePerson(t *
 	// Ensure that the event is returned with one person and two persons
 
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1767,6 +1812,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoCompaniesAndTwoPersons(t
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1849,6 +1895,7 @@ func TestEventRepositoryGetAll_ShouldReturnEventWithTwoApplicationsAndTwoCompani
 
 	// Ensure that the event is returned with one person and two persons
 	results, err := eventRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1883,7 +1930,7 @@ func TestUpdate_ShouldUpdateEvent(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	_, err := eventRepository.Create(&createEvent)
+	_, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
 	var eventType models.EventType = models.EventTypeCallBooked
@@ -1895,10 +1942,10 @@ func TestUpdate_ShouldUpdateEvent(t *testing.T) {
 		EventDate:   testutil.ToPtr(time.Now().AddDate(0, -3, 0)),
 	}
 	updatedDateApproximation := time.Now()
-	err = eventRepository.Update(&updateEvent)
+	err = eventRepository.Update(context.Background(), &updateEvent)
 	assert.NoError(t, err)
 
-	event, err := eventRepository.GetByID(createEvent.ID)
+	event, err := eventRepository.GetByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -1919,17 +1966,17 @@ func TestUpdateEvent_ShouldUpdateASingleField(t *testing.T) {
 		EventType: models.EventTypeApplied,
 		EventDate: time.Now().AddDate(0, 12, 0),
 	}
-	_, err := eventRepository.Create(&createEvent)
+	_, err := eventRepository.Create(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
 	updateEvent := models.UpdateEvent{
 		ID:    *createEvent.ID,
 		Notes: testutil.ToPtr("New Notes"),
 	}
-	err = eventRepository.Update(&updateEvent)
+	err = eventRepository.Update(context.Background(), &updateEvent)
 	assert.NoError(t, err)
 
-	event, err := eventRepository.GetByID(createEvent.ID)
+	event, err := eventRepository.GetByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -1944,7 +1991,7 @@ func TestUpdate_ShouldNotReturnErrorIfEventDoesNotExist(t *testing.T) {
 		ID:    uuid.New(),
 		Notes: testutil.ToPtr("New Notes"),
 	}
-	err := eventRepository.Update(&updateEvent)
+	err := eventRepository.Update(context.Background(), &updateEvent)
 	assert.NoError(t, err)
 }
 
@@ -1955,10 +2002,10 @@ func TestDelete_ShouldDeleteEvent(t *testing.T) {
 
 	eventID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
 
-	err := eventRepository.Delete(&eventID)
+	err := eventRepository.Delete(context.Background(), &eventID, nil)
 	assert.NoError(t, err)
 
-	retrievedPerson, err := eventRepository.GetByID(&eventID)
+	retrievedPerson, err := eventRepository.GetByID(context.Background(), &eventID)
 	assert.Nil(t, retrievedPerson)
 	assert.Error(t, err)
 }
@@ -1967,10 +2014,309 @@ func TestDelete_ShouldReturnNotFoundErrorIfEventIDDoesNotExist(t *testing.T) {
 	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
 
 	id := uuid.New()
-	err := eventRepository.Delete(&id)
+	err := eventRepository.Delete(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: event does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+func TestUpdateAndDelete_ShouldReturnPreconditionFailedErrorIfEventVersionDoesNotMatch(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	assert.Equal(t, 1, event.Version)
+
+	err := eventRepository.Update(context.Background(), &models.UpdateEvent{
+		ID:              event.ID,
+		Description:     testutil.ToPtr("Description"),
+		ExpectedVersion: testutil.ToPtr(2),
+	})
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	err = eventRepository.Delete(context.Background(), &event.ID, testutil.ToPtr(2))
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	retrievedEvent, err := eventRepository.GetByID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, retrievedEvent.Version)
+	assert.Nil(t, retrievedEvent.Description)
+}
+
+// -------- Scheduling tests: --------
+
+func TestCreate_ShouldInsertSchedulingFieldsAndUseOffsetOfTimezone(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	interviewFormat := models.InterviewFormat(models.InterviewFormatVideo)
+	eventDate := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+	event := models.CreateEvent{
+		EventType:       models.EventTypeInterviewBooked,
+		EventDate:       eventDate,
+		DurationMinutes: testutil.ToPtr(45),
+		Location:        testutil.ToPtr("Drottninggatan 1"),
+		MeetingURL:      testutil.ToPtr("https://meet.example.com/abc"),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("Europe/Stockholm"),
+	}
+
+	insertedEvent, err := eventRepository.Create(context.Background(), &event)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 45, *insertedEvent.DurationMinutes)
+	assert.Equal(t, "Drottninggatan 1", *insertedEvent.Location)
+	assert.Equal(t, "https://meet.example.com/abc", *insertedEvent.MeetingURL)
+	assert.Equal(t, interviewFormat, *insertedEvent.InterviewFormat)
+	assert.Equal(t, "Europe/Stockholm", *insertedEvent.Timezone)
+
+	assert.True(t, eventDate.Equal(*insertedEvent.EventDate))
+	assert.Equal(t, "2026-01-15T10:00:00+01:00", insertedEvent.EventDate.Format(time.RFC3339))
+	assert.Equal(t, "2026-01-15T10:45:00+01:00", insertedEvent.EndDate().Format(time.RFC3339))
+}
+
+func TestUpdate_ShouldUpdateSchedulingFields(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	eventID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, testutil.ToPtr(time.Now())).ID
+
+	interviewFormat := models.InterviewFormat(models.InterviewFormatOnsite)
+	err := eventRepository.Update(context.Background(), &models.UpdateEvent{
+		ID:              eventID,
+		DurationMinutes: testutil.ToPtr(90),
+		Location:        testutil.ToPtr("Main office"),
+		InterviewFormat: &interviewFormat,
+		Timezone:        testutil.ToPtr("America/New_York"),
+	})
+	assert.NoError(t, err)
+
+	event, err := eventRepository.GetByID(context.Background(), &eventID)
+	assert.NoError(t, err)
+	assert.Equal(t, 90, *event.DurationMinutes)
+	assert.Equal(t, "Main office", *event.Location)
+	assert.Nil(t, event.MeetingURL)
+	assert.Equal(t, interviewFormat, *event.InterviewFormat)
+	assert.Equal(t, "America/New_York", event.EventDate.Location().String())
+}
+
+// -------- GetOverlappingBookedEvents tests: --------
+
+func TestGetOverlappingBookedEvents_ShouldReturnClashingBookedEventsAcrossOffsets(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	createEvent := func(
+		eventType models.EventType, eventDate time.Time, durationMinutes *int, timezone *string) *models.Event {
+
+		event, err := eventRepository.Create(context.Background(), &models.CreateEvent{
+			EventType:       eventType,
+			EventDate:       eventDate,
+			DurationMinutes: durationMinutes,
+			Timezone:        timezone,
+		})
+		assert.NoError(t, err)
+		return event
+	}
+
+	start := time.Date(2026, 3, 2, 13, 0, 0, 0, time.UTC)
+
+	// stored as 14:30+01:00, which is during the period
+	clashing := createEvent(models.EventTypeCallBooked, start.Add(30*time.Minute), nil, testutil.ToPtr("Europe/Paris"))
+	// starts an hour before and lasts the default hour, so it ends when the period starts
+	createEvent(models.EventTypeInterviewBooked, start.Add(-time.Hour), nil, nil)
+	// lasts two hours, so it ends during the period
+	longClash := createEvent(
+		models.EventTypeRecruiterInterviewBooked, start.Add(-90*time.Minute), testutil.ToPtr(120), nil)
+	// not booked
+	createEvent(models.EventTypeInterviewCompleted, start.Add(10*time.Minute), nil, nil)
+	// starts when the period ends
+	createEvent(models.EventTypeInterviewBooked, start.Add(time.Hour), nil, nil)
+
+	overlappingEvents, err := eventRepository.GetOverlappingBookedEvents(
+		context.Background(), nil, start, start.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, overlappingEvents, 2)
+	assert.Equal(t, longClash.ID, overlappingEvents[0].ID)
+	assert.Equal(t, clashing.ID, overlappingEvents[1].ID)
+
+	overlappingEvents, err = eventRepository.GetOverlappingBookedEvents(
+		context.Background(), &clashing.ID, start, start.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, overlappingEvents, 1)
+	assert.Equal(t, longClash.ID, overlappingEvents[0].ID)
+}
+
+// -------- GetAllByFilter tests: --------
+
+func TestGetAllByFilter_ShouldFilterByTypeAndDateOldestFirst(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	start := time.Date(2026, 5, 4, 8, 0, 0, 0, time.UTC)
+	createEvent := func(eventType models.EventType, eventDate time.Time) *models.Event {
+		return repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, &eventDate)
+	}
+
+	later := createEvent(models.EventTypeInterviewBooked, start.Add(48*time.Hour))
+	sooner := createEvent(models.EventTypeCallBooked, start)
+	createEvent(models.EventTypeInterviewCompleted, start.Add(time.Hour))
+	createEvent(models.EventTypeCallBooked, start.Add(-time.Minute))
+	createEvent(models.EventTypeRecruiterInterviewBooked, start.Add(72*time.Hour))
+
+	toDate := start.Add(72 * time.Hour)
+	events, err := eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{EventTypes: models.BookedEventTypes, FromDate: &start, ToDate: &toDate},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, sooner.ID, events[0].ID)
+	assert.Equal(t, later.ID, events[1].ID)
+
+	events, err = eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, events, 5)
+}
+
+func TestGetAllByFilter_ShouldOnlyReturnBookedEventsWithoutMatchingCompletedEvent(t *testing.T) {
+	eventRepository,
+		applicationRepository,
+		companyRepository,
+		_,
+		applicationEventRepository,
+		companyEventRepository,
+		_ := setupEventRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+	otherApplication := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+
+	bookedDate := time.Now().AddDate(0, 0, -7)
+	createEvent := func(eventType models.EventType, eventDate time.Time) *models.Event {
+		return repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, &eventDate)
+	}
+	linkToApplication := func(applicationID uuid.UUID, event *models.Event) {
+		repositoryhelpers.AssociateApplicationEvent(t, applicationEventRepository, applicationID, event.ID, nil)
+	}
+
+	// completed for the same application
+	completedInterview := createEvent(models.EventTypeInterviewBooked, bookedDate)
+	linkToApplication(application.ID, completedInterview)
+	linkToApplication(application.ID, createEvent(models.EventTypeInterviewCompleted, bookedDate.Add(time.Hour)))
+
+	// completed for the same company
+	completedCall := createEvent(models.EventTypeCallBooked, bookedDate)
+	repositoryhelpers.AssociateCompanyEvent(t, companyEventRepository, company.ID, completedCall.ID, nil)
+	completingCall := createEvent(models.EventTypeCallCompleted, bookedDate)
+	repositoryhelpers.AssociateCompanyEvent(t, companyEventRepository, company.ID, completingCall.ID, nil)
+
+	// completed for another application, after the interview of the same application was completed
+	otherApplicationInterview := createEvent(models.EventTypeInterviewBooked, bookedDate.Add(90*time.Minute))
+	linkToApplication(application.ID, otherApplicationInterview)
+	linkToApplication(otherApplication.ID, createEvent(models.EventTypeInterviewCompleted, bookedDate.Add(2*time.Hour)))
+
+	// only completed before it was booked
+	earlierCompleted := createEvent(models.EventTypeRecruiterInterviewBooked, bookedDate.Add(2*time.Hour))
+	linkToApplication(otherApplication.ID, earlierCompleted)
+	linkToApplication(otherApplication.ID, createEvent(models.EventTypeRecruiterInterviewCompleted, bookedDate))
+
+	// not linked to anything
+	unlinked := createEvent(models.EventTypeCallBooked, bookedDate.Add(3*time.Hour))
+
+	now := time.Now()
+	events, err := eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{EventTypes: models.BookedEventTypes, ToDate: &now, OnlyNotCompleted: true},
+		models.IncludeExtraDataTypeIDs,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, otherApplicationInterview.ID, events[0].ID)
+	assert.Len(t, *events[0].Applications, 1)
+	assert.Equal(t, application.ID, (*events[0].Applications)[0].ID)
+	assert.Equal(t, earlierCompleted.ID, events[1].ID)
+	assert.Equal(t, unlinked.ID, events[2].ID)
+}
+
+func TestGetAllByFilter_ShouldReturnValidationErrorIfFilterIsNil(t *testing.T) {
+	eventRepository, _, _, _, _, _, _ := setupEventRepository(t)
+
+	events, err := eventRepository.GetAllByFilter(
+		context.Background(),
+		nil,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.Nil(t, events)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+}
+
+func TestGetAllByFilter_ShouldFilterByApplicationID(t *testing.T) {
+	eventRepository, applicationRepository, companyRepository, _, applicationEventRepository, _, _ :=
+		setupEventRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+	otherApplication := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateApplicationEvent(t, applicationEventRepository, application.ID, event.ID, nil)
+	otherEvent := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateApplicationEvent(t, applicationEventRepository, otherApplication.ID, otherEvent.ID, nil)
+	repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	events, err := eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{ApplicationID: &application.ID},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, event.ID, events[0].ID)
+}
+
+func TestGetAllByFilter_ShouldFilterByCompanyIDAndPersonID(t *testing.T) {
+	eventRepository, _, companyRepository, personRepository, _, companyEventRepository, eventPersonRepository :=
+		setupEventRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	companyEvent := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateCompanyEvent(t, companyEventRepository, company.ID, companyEvent.ID, nil)
+	personEvent := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateEventPerson(t, eventPersonRepository, personEvent.ID, person.ID, nil)
+	repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	events, err := eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{CompanyID: &company.ID},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, companyEvent.ID, events[0].ID)
+
+	events, err = eventRepository.GetAllByFilter(
+		context.Background(),
+		&models.EventFilter{PersonID: &person.ID},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeAll)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, personEvent.ID, events[0].ID)
+	assert.Len(t, *events[0].Persons, 1)
+	assert.Equal(t, person.ID, (*events[0].Persons)[0].ID)
+}
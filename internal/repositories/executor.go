@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Executor runs SQL statements. It is satisfied by both *sql.DB and *sql.Tx, so that repositories created with the
+// same *sql.Tx take part in the same transaction.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
@@ -0,0 +1,158 @@
+package repositories_test
+
+import (
+	"context"
+	"database/sql"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	benchmarkCompanyCount     = 250
+	benchmarkApplicationCount = 2000
+	benchmarkPersonCount      = 1000
+	benchmarkEventCount       = 3000
+)
+
+type benchmarkRepositories struct {
+	application *repositories.ApplicationRepository
+	company     *repositories.CompanyRepository
+	event       *repositories.EventRepository
+	person      *repositories.PersonRepository
+}
+
+// setupSeededRepositories returns repositories for a database with thousands of companies, applications, persons and
+// events, every one of them linked to a few of the others.
+func setupSeededRepositories(b *testing.B) benchmarkRepositories {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupDatabaseTestContainer(b, config)
+
+	var database *sql.DB
+	err := container.Invoke(func(db *sql.DB) {
+		database = db
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	queryTimeout := config.DatabaseQueryTimeout()
+	seeded := benchmarkRepositories{
+		application: repositories.NewApplicationRepository(database, queryTimeout),
+		company:     repositories.NewCompanyRepository(database, queryTimeout),
+		event:       repositories.NewEventRepository(database, queryTimeout),
+		person:      repositories.NewPersonRepository(database, queryTimeout),
+	}
+	applicationEventRepository := repositories.NewApplicationEventRepository(database, queryTimeout)
+	applicationPersonRepository := repositories.NewApplicationPersonRepository(database, queryTimeout)
+	companyEventRepository := repositories.NewCompanyEventRepository(database, queryTimeout)
+	companyPersonRepository := repositories.NewCompanyPersonRepository(database, queryTimeout)
+	eventPersonRepository := repositories.NewEventPersonRepository(database, queryTimeout)
+
+	start := time.Now().AddDate(-1, 0, 0)
+	dateOf := func(index int) *time.Time {
+		date := start.Add(time.Duration(index) * time.Minute)
+		return &date
+	}
+
+	companyIDs := make([]uuid.UUID, benchmarkCompanyCount)
+	for index := range companyIDs {
+		companyIDs[index] = repositoryhelpers.CreateCompany(b, seeded.company, nil, dateOf(index)).ID
+	}
+
+	personIDs := make([]uuid.UUID, benchmarkPersonCount)
+	for index := range personIDs {
+		personIDs[index] = repositoryhelpers.CreatePerson(b, seeded.person, nil, dateOf(index)).ID
+		repositoryhelpers.AssociateCompanyPerson(
+			b, companyPersonRepository, companyIDs[index%benchmarkCompanyCount], personIDs[index], nil)
+	}
+
+	eventIDs := make([]uuid.UUID, benchmarkEventCount)
+	for index := range eventIDs {
+		eventIDs[index] = repositoryhelpers.CreateEvent(b, seeded.event, nil, nil, dateOf(index)).ID
+		repositoryhelpers.AssociateCompanyEvent(
+			b, companyEventRepository, companyIDs[index%benchmarkCompanyCount], eventIDs[index], nil)
+		repositoryhelpers.AssociateEventPerson(
+			b, eventPersonRepository, eventIDs[index], personIDs[index%benchmarkPersonCount], nil)
+	}
+
+	for index := 0; index < benchmarkApplicationCount; index++ {
+		companyID := companyIDs[index%benchmarkCompanyCount]
+		recruiterID := companyIDs[(index+1)%benchmarkCompanyCount]
+		applicationID := repositoryhelpers.CreateApplication(
+			b, seeded.application, nil, &companyID, &recruiterID, dateOf(index)).ID
+
+		for link := 0; link < 2; link++ {
+			repositoryhelpers.AssociateApplicationEvent(
+				b, applicationEventRepository, applicationID, eventIDs[(2*index+link)%benchmarkEventCount], nil)
+			repositoryhelpers.AssociateApplicationPerson(
+				b, applicationPersonRepository, applicationID, personIDs[(2*index+link)%benchmarkPersonCount], nil)
+		}
+	}
+
+	return seeded
+}
+
+// includeBenchmarks are the include types that the GetAll benchmarks are run with.
+var includeBenchmarks = []struct {
+	name    string
+	include models.IncludeExtraDataType
+}{
+	{"include=none", models.IncludeExtraDataTypeNone},
+	{"include=ids", models.IncludeExtraDataTypeIDs},
+	{"include=all", models.IncludeExtraDataTypeAll},
+}
+
+func BenchmarkGetAll(b *testing.B) {
+	seeded := setupSeededRepositories(b)
+	ctx := context.Background()
+
+	for _, benchmark := range includeBenchmarks {
+		include := benchmark.include
+
+		b.Run("applications/"+benchmark.name, func(b *testing.B) {
+			for b.Loop() {
+				applications, err := seeded.application.GetAll(ctx, include, include, include, include)
+				if err != nil || len(applications) != benchmarkApplicationCount {
+					b.Fatalf("GetAll returned %d applications, error: %v", len(applications), err)
+				}
+			}
+		})
+
+		b.Run("companies/"+benchmark.name, func(b *testing.B) {
+			for b.Loop() {
+				companies, err := seeded.company.GetAll(ctx, include, include, include)
+				if err != nil || len(companies) != benchmarkCompanyCount {
+					b.Fatalf("GetAll returned %d companies, error: %v", len(companies), err)
+				}
+			}
+		})
+
+		b.Run("events/"+benchmark.name, func(b *testing.B) {
+			for b.Loop() {
+				events, err := seeded.event.GetAll(ctx, include, include, include)
+				if err != nil || len(events) != benchmarkEventCount {
+					b.Fatalf("GetAll returned %d events, error: %v", len(events), err)
+				}
+			}
+		})
+
+		b.Run("persons/"+benchmark.name, func(b *testing.B) {
+			for b.Loop() {
+				persons, err := seeded.person.GetAll(ctx, include, include, include)
+				if err != nil || len(persons) != benchmarkPersonCount {
+					b.Fatalf("GetAll returned %d persons, error: %v", len(persons), err)
+				}
+			}
+		})
+	}
+}
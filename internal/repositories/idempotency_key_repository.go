@@ -0,0 +1,215 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/pkg/timeutil"
+	"time"
+)
+
+// IdempotencyKeyRepository stores the requests sent with an Idempotency-Key header and the responses to them. Dates
+// are stored in UTC, so that they can be compared as text.
+type IdempotencyKeyRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewIdempotencyKeyRepository(database Executor, queryTimeout time.Duration) *IdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// Reserve stores idempotencyKey without a response, unless the key has already been used for the path and has not
+// expired yet. An expired key is replaced, and so is a key without a response that was reserved before
+// leaseExpiredBefore. It returns whether idempotencyKey was stored.
+//
+// Reserve can return InternalServiceError
+func (repository *IdempotencyKeyRepository) Reserve(
+	ctx context.Context, idempotencyKey *models.IdempotencyKey, leaseExpiredBefore time.Time) (bool, error) {
+
+	logger := logging.FromContext(ctx)
+
+	sqlInsert := `
+		INSERT INTO idempotency_key (
+			key, path, request_hash, status_code, content_type, response_body, created_date, expiry_date
+		) VALUES (?, ?, ?, NULL, NULL, NULL, ?, ?)
+		ON CONFLICT (key, path) DO UPDATE SET
+			request_hash = excluded.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_date = excluded.created_date,
+			expiry_date = excluded.expiry_date
+		WHERE idempotency_key.expiry_date <= excluded.created_date
+			OR (idempotency_key.status_code IS NULL AND idempotency_key.created_date <= ?) `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
+		sqlInsert,
+		idempotencyKey.Key,
+		idempotencyKey.Path,
+		idempotencyKey.RequestHash,
+		idempotencyKey.CreatedDate.UTC().Format(timeutil.RFC3339Milli_Write),
+		idempotencyKey.ExpiryDate.UTC().Format(timeutil.RFC3339Milli_Write),
+		leaseExpiredBefore.UTC().Format(timeutil.RFC3339Milli_Write),
+	)
+	if err != nil {
+		logger.Error(
+			"idempotency_key_repository.Reserve: Error trying to insert idempotency key",
+			"path", idempotencyKey.Path,
+			"error", err)
+		return false, internalErrors.NewInternalServiceError("Error trying to insert idempotency key: " + err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("idempotency_key_repository.Reserve: Error reading rows affected", "error", err)
+		return false, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// GetByKeyAndPath can return InternalServiceError, NotFoundError
+func (repository *IdempotencyKeyRepository) GetByKeyAndPath(
+	ctx context.Context, key string, path string) (*models.IdempotencyKey, error) {
+
+	logger := logging.FromContext(ctx)
+
+	sqlSelect := `
+		SELECT key, path, request_hash, status_code, content_type, response_body, created_date, expiry_date
+		FROM idempotency_key
+		WHERE key = ? AND path = ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, key, path)
+
+	var result models.IdempotencyKey
+	var createdDate, expiryDate string
+
+	err := row.Scan(
+		&result.Key,
+		&result.Path,
+		&result.RequestHash,
+		&result.StatusCode,
+		&result.ContentType,
+		&result.ResponseBody,
+		&createdDate,
+		&expiryDate,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("idempotency_key_repository.GetByKeyAndPath: No result found", "path", path)
+			return nil, internalErrors.NewNotFoundError("Idempotency key does not exist. Path: " + path)
+		}
+		logger.Error("idempotency_key_repository.GetByKeyAndPath: Error scanning row", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error scanning idempotency key: " + err.Error())
+	}
+
+	result.CreatedDate, err = time.Parse(timeutil.RFC3339Milli_Read, createdDate)
+	if err != nil {
+		logger.Error("idempotency_key_repository.GetByKeyAndPath: Error parsing createdDate", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
+	}
+
+	result.ExpiryDate, err = time.Parse(timeutil.RFC3339Milli_Read, expiryDate)
+	if err != nil {
+		logger.Error("idempotency_key_repository.GetByKeyAndPath: Error parsing expiryDate", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error parsing expiryDate: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+// SaveResponse stores the status code, content type and body of the response to a reserved idempotency key.
+//
+// SaveResponse can return InternalServiceError, NotFoundError
+func (repository *IdempotencyKeyRepository) SaveResponse(
+	ctx context.Context, idempotencyKey *models.IdempotencyKey) error {
+
+	logger := logging.FromContext(ctx)
+
+	sqlUpdate := `
+		UPDATE idempotency_key SET
+			status_code = ?,
+			content_type = ?,
+			response_body = ?
+		WHERE key = ? AND path = ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
+		sqlUpdate,
+		idempotencyKey.StatusCode,
+		idempotencyKey.ContentType,
+		idempotencyKey.ResponseBody,
+		idempotencyKey.Key,
+		idempotencyKey.Path,
+	)
+	if err != nil {
+		logger.Error(
+			"idempotency_key_repository.SaveResponse: unable to update idempotency key",
+			"path", idempotencyKey.Path,
+			"error", err)
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, "Idempotency key does not exist. Path: "+idempotencyKey.Path)
+}
+
+// Delete can return InternalServiceError, NotFoundError
+func (repository *IdempotencyKeyRepository) Delete(ctx context.Context, key string, path string) error {
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx, "DELETE FROM idempotency_key WHERE key = ? AND path = ?", key, path)
+	if err != nil {
+		logger.Error(
+			"idempotency_key_repository.Delete: Error trying to delete idempotency key", "path", path, "error", err)
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, "Idempotency key does not exist. Path: "+path)
+}
+
+// DeleteExpired deletes the idempotency keys that expired at or before now, and returns how many were deleted.
+//
+// DeleteExpired can return InternalServiceError
+func (repository *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
+		"DELETE FROM idempotency_key WHERE expiry_date <= ?",
+		now.UTC().Format(timeutil.RFC3339Milli_Write))
+	if err != nil {
+		logger.Error("idempotency_key_repository.DeleteExpired: Error trying to delete idempotency keys", "error", err)
+		return 0, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("idempotency_key_repository.DeleteExpired: Error reading rows affected", "error", err)
+		return 0, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,203 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIdempotencyKeyRepository(t *testing.T) *repositories.IdempotencyKeyRepository {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupIdempotencyKeyRepositoryTestContainer(t, *config)
+
+	var idempotencyKeyRepository *repositories.IdempotencyKeyRepository
+	err := container.Invoke(func(repository *repositories.IdempotencyKeyRepository) {
+		idempotencyKeyRepository = repository
+	})
+	assert.NoError(t, err)
+
+	return idempotencyKeyRepository
+}
+
+func newIdempotencyKey(key string, path string, createdDate time.Time) *models.IdempotencyKey {
+	return &models.IdempotencyKey{
+		Key:         key,
+		Path:        path,
+		RequestHash: "hash of " + key,
+		CreatedDate: createdDate,
+		ExpiryDate:  createdDate.Add(time.Hour),
+	}
+}
+
+// -------- Reserve tests: --------
+
+func TestReserve_ShouldReserveKeyOncePerPath(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	now := time.Now()
+
+	isReserved, err := idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", now), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+
+	isReserved, err = idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", now), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.False(t, isReserved)
+
+	// the same key can be used for another path
+	isReserved, err = idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/person/new", now), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+}
+
+func TestReserve_ShouldReplaceExpiredKey(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	earlier := time.Now().Add(-2 * time.Hour)
+
+	expiredKey := newIdempotencyKey("key-1", "/api/v1/company/new", earlier)
+	isReserved, err := idempotencyKeyRepository.Reserve(context.Background(), expiredKey, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+
+	expiredKey.StatusCode = testutil.ToPtr(http.StatusCreated)
+	assert.NoError(t, idempotencyKeyRepository.SaveResponse(context.Background(), expiredKey))
+
+	newKey := newIdempotencyKey("key-1", "/api/v1/company/new", time.Now())
+	newKey.RequestHash = "another hash"
+	isReserved, err = idempotencyKeyRepository.Reserve(context.Background(), newKey, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+
+	storedKey, err := idempotencyKeyRepository.GetByKeyAndPath(context.Background(), "key-1", "/api/v1/company/new")
+	assert.NoError(t, err)
+	assert.Equal(t, "another hash", storedKey.RequestHash)
+	assert.Nil(t, storedKey.StatusCode)
+}
+
+func TestReserve_ShouldTakeOverKeyWithoutResponseAfterLease(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	earlier := time.Now().Add(-2 * time.Minute)
+
+	_, err := idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", earlier), earlier.Add(-time.Minute))
+	assert.NoError(t, err)
+
+	// the lease of the first reservation has not run out yet
+	isReserved, err := idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", time.Now()), earlier.Add(-time.Second))
+	assert.NoError(t, err)
+	assert.False(t, isReserved)
+
+	isReserved, err = idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", time.Now()), earlier.Add(time.Second))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+}
+
+func TestReserve_ShouldNotTakeOverKeyWithResponse(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	earlier := time.Now().Add(-2 * time.Minute)
+
+	idempotencyKey := newIdempotencyKey("key-1", "/api/v1/company/new", earlier)
+	_, err := idempotencyKeyRepository.Reserve(context.Background(), idempotencyKey, earlier.Add(-time.Minute))
+	assert.NoError(t, err)
+	idempotencyKey.StatusCode = testutil.ToPtr(http.StatusCreated)
+	assert.NoError(t, idempotencyKeyRepository.SaveResponse(context.Background(), idempotencyKey))
+
+	isReserved, err := idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("key-1", "/api/v1/company/new", time.Now()), time.Now())
+	assert.NoError(t, err)
+	assert.False(t, isReserved)
+}
+
+// -------- GetByKeyAndPath tests: --------
+
+func TestGetByKeyAndPath_ShouldReturnSavedResponse(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	now := time.Now()
+
+	idempotencyKey := newIdempotencyKey("key-1", "/api/v1/company/new", now)
+	_, err := idempotencyKeyRepository.Reserve(context.Background(), idempotencyKey, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+
+	idempotencyKey.StatusCode = testutil.ToPtr(http.StatusCreated)
+	idempotencyKey.ContentType = testutil.ToPtr("application/json")
+	idempotencyKey.ResponseBody = []byte(`{"id":"1"}`)
+	assert.NoError(t, idempotencyKeyRepository.SaveResponse(context.Background(), idempotencyKey))
+
+	storedKey, err := idempotencyKeyRepository.GetByKeyAndPath(context.Background(), "key-1", "/api/v1/company/new")
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", storedKey.Key)
+	assert.Equal(t, "/api/v1/company/new", storedKey.Path)
+	assert.Equal(t, "hash of key-1", storedKey.RequestHash)
+	assert.Equal(t, http.StatusCreated, *storedKey.StatusCode)
+	assert.Equal(t, "application/json", *storedKey.ContentType)
+	assert.Equal(t, []byte(`{"id":"1"}`), storedKey.ResponseBody)
+	assert.WithinDuration(t, now, storedKey.CreatedDate, time.Millisecond)
+	assert.WithinDuration(t, now.Add(time.Hour), storedKey.ExpiryDate, time.Millisecond)
+}
+
+func TestGetByKeyAndPath_ShouldReturnNotFoundError(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+
+	storedKey, err := idempotencyKeyRepository.GetByKeyAndPath(context.Background(), "key-1", "/api/v1/company/new")
+	assert.Nil(t, storedKey)
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+// -------- Delete tests: --------
+
+func TestDelete_ShouldReleaseIdempotencyKey(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+
+	idempotencyKey := newIdempotencyKey("key-1", "/api/v1/company/new", time.Now())
+	_, err := idempotencyKeyRepository.Reserve(context.Background(), idempotencyKey, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+
+	assert.NoError(t, idempotencyKeyRepository.Delete(context.Background(), "key-1", "/api/v1/company/new"))
+
+	isReserved, err := idempotencyKeyRepository.Reserve(context.Background(), idempotencyKey, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, isReserved)
+}
+
+// -------- DeleteExpired tests: --------
+
+func TestDeleteExpired_ShouldOnlyDeleteExpiredKeys(t *testing.T) {
+	idempotencyKeyRepository := setupIdempotencyKeyRepository(t)
+	now := time.Now()
+
+	_, err := idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("expired", "/api/v1/company/new", now.Add(-2*time.Hour)), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	_, err = idempotencyKeyRepository.Reserve(
+		context.Background(), newIdempotencyKey("current", "/api/v1/company/new", now), time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+
+	deleted, err := idempotencyKeyRepository.DeleteExpired(context.Background(), now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = idempotencyKeyRepository.GetByKeyAndPath(context.Background(), "expired", "/api/v1/company/new")
+	assert.Error(t, err)
+	_, err = idempotencyKeyRepository.GetByKeyAndPath(context.Background(), "current", "/api/v1/company/new")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,313 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/utils"
+	"jobsearchtracker/pkg/timeutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InterviewPrepRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewInterviewPrepRepository(database Executor, queryTimeout time.Duration) *InterviewPrepRepository {
+	return &InterviewPrepRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *InterviewPrepRepository) Create(
+	ctx context.Context, prep *models.CreateInterviewPrep) (*models.InterviewPrep, error) {
+
+	logger := logging.FromContext(ctx)
+	sqlInsert := `
+		INSERT INTO interview_prep (
+			event_id, technical_topics, self_rating, notes, created_date
+		) VALUES (?, ?, ?, ?, ?)
+		RETURNING event_id, technical_topics, self_rating, notes, created_date, updated_date, version; `
+
+	var createdDate string
+	if prep.CreatedDate != nil {
+		createdDate = prep.CreatedDate.Format(timeutil.RFC3339Milli_Write)
+	} else {
+		createdDate = time.Now().Format(timeutil.RFC3339Milli_Write)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
+		sqlInsert,
+		prep.EventID,
+		prep.TechnicalTopics,
+		prep.SelfRating,
+		prep.Notes,
+		createdDate,
+	)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, "Create")
+	if err != nil {
+		if isUniqueViolation(err, "interview_prep.event_id") {
+			logger.Info("interview_prep_repository.Create: UNIQUE constraint failed", "eventID", prep.EventID)
+			return nil, internalErrors.NewConflictError(
+				"interview prep already exists for event: '" + prep.EventID.String() + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("interview_prep_repository.Create: FOREIGN KEY constraint failed")
+			eventID := "EventID"
+			return nil, internalErrors.NewValidationError(
+				&eventID, "Event does not exist: '"+prep.EventID.String()+"'")
+		} else if constraintErr := translateConstraintError(err, "SelfRating"); constraintErr != nil {
+			logger.Info("interview_prep_repository.Create: constraint failed", "error", err)
+			return nil, constraintErr
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetByEventID returns the prep without its Questions.
+//
+// GetByEventID can return InternalServiceError, NotFoundError, ValidationError
+func (repository *InterviewPrepRepository) GetByEventID(
+	ctx context.Context, eventID *uuid.UUID) (*models.InterviewPrep, error) {
+
+	logger := logging.FromContext(ctx)
+	if eventID == nil {
+		logger.Info("interview_prep_repository.GetByEventID: eventID is nil")
+		return nil, internalErrors.NewValidationError(nil, "eventID is nil")
+	}
+
+	sqlSelect := `
+		SELECT event_id, technical_topics, self_rating, notes, created_date, updated_date, version
+		FROM interview_prep
+		WHERE event_id = ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, eventID)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, "GetByEventID")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("interview_prep_repository.GetByEventID: No result found for eventID", "eventID", eventID)
+			return nil, internalErrors.NewNotFoundError("EventID: '" + eventID.String() + "'")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update increments the version of the prep. If ExpectedVersion is set, the prep is only updated if it still has that
+// version.
+//
+// Update can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *InterviewPrepRepository) Update(ctx context.Context, prep *models.UpdateInterviewPrep) error {
+	logger := logging.FromContext(ctx)
+	var sqlString strings.Builder
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	sqlString.WriteString(`
+		UPDATE interview_prep SET
+			updated_date = ?,
+			version = version + 1,
+			`)
+	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
+
+	if prep.TechnicalTopics != nil {
+		sqlParts = append(sqlParts, "technical_topics = ?")
+		sqlVars = append(sqlVars, *prep.TechnicalTopics)
+	}
+
+	if prep.SelfRating != nil {
+		sqlParts = append(sqlParts, "self_rating = ?")
+		sqlVars = append(sqlVars, *prep.SelfRating)
+	}
+
+	if prep.Notes != nil {
+		sqlParts = append(sqlParts, "notes = ?")
+		sqlVars = append(sqlVars, *prep.Notes)
+	}
+
+	if len(sqlParts) == 0 {
+		logger.Info("interview_prep_repository.Update: nothing to update", "eventID", prep.EventID)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("interview_prep_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlString.WriteString(sqlPayload)
+	sqlString.WriteString(`
+		WHERE event_id = ? `)
+	sqlVars = append(sqlVars, prep.EventID)
+
+	if prep.ExpectedVersion != nil {
+		sqlString.WriteString("AND version = ? ")
+		sqlVars = append(sqlVars, *prep.ExpectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlString.String(), sqlVars...)
+	if err != nil {
+		logger.Error(
+			"interview_prep_repository.Update: unable to update interview prep",
+			"eventID", prep.EventID,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	err = checkSingleRowAffected(result, "interview prep does not exist. EventID: "+prep.EventID.String())
+	var notFoundErr *internalErrors.NotFoundError
+	if prep.ExpectedVersion != nil && errors.As(err, &notFoundErr) {
+		// can return InternalServiceError, NotFoundError, PreconditionFailedError
+		return getVersionMismatchError(
+			ctx, repository.database, repository.queryTimeout,
+			"interview_prep", "event_id", "Interview prep", prep.EventID, *prep.ExpectedVersion)
+	}
+	return err
+}
+
+// Delete also deletes the questions of the prep. If expectedVersion is set, the prep is only deleted if it still has
+// that version.
+//
+// Delete can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *InterviewPrepRepository) Delete(
+	ctx context.Context, eventID *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+	if eventID == nil {
+		logger.Error("interview_prep_repository.Delete: eventID is nil")
+		eventIDField := "EventID"
+		return internalErrors.NewValidationError(&eventIDField, "EventID is nil")
+	}
+
+	sqlDelete := "DELETE FROM interview_prep WHERE event_id = ?"
+	sqlVars := []interface{}{eventID}
+	if expectedVersion != nil {
+		sqlDelete += " AND version = ?"
+		sqlVars = append(sqlVars, *expectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlDelete, sqlVars...)
+	if err != nil {
+		logger.Error(
+			"interview_prep_repository.Delete: Error trying to delete interview prep",
+			"eventID", eventID,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	err = checkSingleRowAffected(result, "interview prep does not exist. EventID: "+eventID.String())
+	var notFoundErr *internalErrors.NotFoundError
+	if expectedVersion != nil && errors.As(err, &notFoundErr) {
+		// can return InternalServiceError, NotFoundError, PreconditionFailedError
+		return getVersionMismatchError(
+			ctx, repository.database, repository.queryTimeout,
+			"interview_prep", "event_id", "Interview prep", *eventID, *expectedVersion)
+	}
+	return err
+}
+
+// internal functions
+
+func (repository *InterviewPrepRepository) mapRow(
+	ctx context.Context,
+	scanner interface{ Scan(...interface{}) error }, methodName string) (*models.InterviewPrep, error) {
+
+	logger := logging.FromContext(ctx)
+
+	var result models.InterviewPrep
+	var selfRating sql.NullInt64
+	var createdDate string
+	var updatedDate sql.NullString
+
+	err := scanner.Scan(
+		&result.EventID,
+		&result.TechnicalTopics,
+		&selfRating,
+		&result.Notes,
+		&createdDate,
+		&updatedDate,
+		&result.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if selfRating.Valid {
+		value := int(selfRating.Int64)
+		result.SelfRating = &value
+	}
+
+	result.CreatedDate, result.UpdatedDate, err = parseCreatedAndUpdatedDates(createdDate, updatedDate)
+	if err != nil {
+		logger.Error("interview_prep_repository."+methodName+": Error parsing dates", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error parsing dates: " + err.Error())
+	}
+
+	return &result, nil
+}
+
+// checkSingleRowAffected returns a NotFoundError with notFoundMessage if no row was affected.
+//
+// checkSingleRowAffected can return InternalServiceError, NotFoundError
+func checkSingleRowAffected(result sql.Result, notFoundMessage string) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+	if rowsAffected == 0 {
+		return internalErrors.NewNotFoundError(notFoundMessage)
+	} else if rowsAffected > 1 {
+		return internalErrors.NewInternalServiceError(
+			"Unexpected number of rows affected: " + strconv.FormatInt(rowsAffected, 10))
+	}
+	return nil
+}
+
+func parseCreatedAndUpdatedDates(
+	createdDate string, updatedDate sql.NullString) (*time.Time, *time.Time, error) {
+
+	created, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !updatedDate.Valid {
+		return &created, nil, nil
+	}
+
+	updated, err := time.Parse(timeutil.RFC3339Milli_Read, updatedDate.String)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &created, &updated, nil
+}
@@ -0,0 +1,417 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type interviewPrepTestRepositories struct {
+	interviewPrep     *repositories.InterviewPrepRepository
+	interviewQuestion *repositories.InterviewQuestionRepository
+	application       *repositories.ApplicationRepository
+	applicationEvent  *repositories.ApplicationEventRepository
+	company           *repositories.CompanyRepository
+	companyEvent      *repositories.CompanyEventRepository
+	event             *repositories.EventRepository
+}
+
+func setupInterviewPrepRepository(t *testing.T) *interviewPrepTestRepositories {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupInterviewPrepRepositoryTestContainer(t, *config)
+
+	var testRepositories interviewPrepTestRepositories
+	err := container.Invoke(func(
+		interviewPrep *repositories.InterviewPrepRepository,
+		interviewQuestion *repositories.InterviewQuestionRepository,
+		application *repositories.ApplicationRepository,
+		applicationEvent *repositories.ApplicationEventRepository,
+		company *repositories.CompanyRepository,
+		companyEvent *repositories.CompanyEventRepository,
+		event *repositories.EventRepository) {
+
+		testRepositories = interviewPrepTestRepositories{
+			interviewPrep:     interviewPrep,
+			interviewQuestion: interviewQuestion,
+			application:       application,
+			applicationEvent:  applicationEvent,
+			company:           company,
+			companyEvent:      companyEvent,
+			event:             event,
+		}
+	})
+	assert.NoError(t, err)
+
+	return &testRepositories
+}
+
+// createInterviewWithPrep creates an interviewCompleted event on eventDate, and an interview prep for it.
+func createInterviewWithPrep(
+	t *testing.T, testRepositories *interviewPrepTestRepositories, eventDate time.Time) *models.Event {
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, testRepositories.event, nil, &eventType, &eventDate)
+
+	_, err := testRepositories.interviewPrep.Create(
+		context.Background(), &models.CreateInterviewPrep{EventID: event.ID})
+	assert.NoError(t, err)
+
+	return event
+}
+
+func createInterviewQuestion(
+	t *testing.T,
+	testRepositories *interviewPrepTestRepositories,
+	eventID uuid.UUID,
+	question string) *models.InterviewQuestion {
+
+	insertedQuestion, err := testRepositories.interviewQuestion.Create(
+		context.Background(),
+		&models.CreateInterviewQuestion{
+			EventID:      eventID,
+			QuestionType: models.InterviewQuestionTypeAskedToUs,
+			Question:     question,
+		})
+	assert.NoError(t, err)
+
+	return insertedQuestion
+}
+
+// -------- InterviewPrepRepository tests: --------
+
+func TestInterviewPrepRepositoryCreate_ShouldInsertAndRetrievePrep(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	var eventType models.EventType = models.EventTypeCodeTestCompleted
+	event := repositoryhelpers.CreateEvent(t, testRepositories.event, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	createdDate := time.Now().AddDate(0, 0, -1)
+	insertedPrep, err := testRepositories.interviewPrep.Create(context.Background(), &models.CreateInterviewPrep{
+		EventID:         event.ID,
+		TechnicalTopics: testutil.ToPtr("Go, SQL"),
+		SelfRating:      testutil.ToPtr(4),
+		Notes:           testutil.ToPtr("Went well"),
+		CreatedDate:     &createdDate,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, insertedPrep)
+
+	assert.Equal(t, event.ID, insertedPrep.EventID)
+	assert.Equal(t, "Go, SQL", *insertedPrep.TechnicalTopics)
+	assert.Equal(t, 4, *insertedPrep.SelfRating)
+	assert.Equal(t, "Went well", *insertedPrep.Notes)
+	testutil.AssertEqualFormattedDateTimes(t, &createdDate, insertedPrep.CreatedDate)
+	assert.Nil(t, insertedPrep.UpdatedDate)
+
+	retrievedPrep, err := testRepositories.interviewPrep.GetByEventID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, insertedPrep, retrievedPrep)
+}
+
+func TestInterviewPrepRepositoryCreate_ShouldReturnConflictErrorIfEventAlreadyHasPrep(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+
+	insertedPrep, err := testRepositories.interviewPrep.Create(
+		context.Background(), &models.CreateInterviewPrep{EventID: event.ID})
+	assert.Nil(t, insertedPrep)
+
+	var conflictErr *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Equal(
+		t,
+		"conflict error on insert: interview prep already exists for event: '"+event.ID.String()+"'",
+		err.Error())
+}
+
+func TestInterviewPrepRepositoryCreate_ShouldReturnValidationErrorIfEventDoesNotExist(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	eventID := uuid.New()
+	insertedPrep, err := testRepositories.interviewPrep.Create(
+		context.Background(), &models.CreateInterviewPrep{EventID: eventID})
+	assert.Nil(t, insertedPrep)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t, "validation error on field 'EventID': Event does not exist: '"+eventID.String()+"'", err.Error())
+}
+
+func TestInterviewPrepRepositoryUpdate_ShouldUpdateGivenFields(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+
+	err := testRepositories.interviewPrep.Update(context.Background(), &models.UpdateInterviewPrep{
+		EventID:    event.ID,
+		SelfRating: testutil.ToPtr(2),
+	})
+	assert.NoError(t, err)
+
+	retrievedPrep, err := testRepositories.interviewPrep.GetByEventID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *retrievedPrep.SelfRating)
+	assert.Nil(t, retrievedPrep.TechnicalTopics)
+	assert.NotNil(t, retrievedPrep.UpdatedDate)
+}
+
+func TestInterviewPrepRepositoryUpdate_ShouldReturnNotFoundErrorForUnknownEvent(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	err := testRepositories.interviewPrep.Update(context.Background(), &models.UpdateInterviewPrep{
+		EventID: uuid.New(),
+		Notes:   testutil.ToPtr("Notes"),
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestInterviewPrepRepositoryUpdate_ShouldReturnPreconditionFailedErrorIfVersionDoesNotMatch(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+
+	err := testRepositories.interviewPrep.Update(context.Background(), &models.UpdateInterviewPrep{
+		EventID:         event.ID,
+		Notes:           testutil.ToPtr("First"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	assert.NoError(t, err)
+
+	// a second update based on the version that was first read
+	err = testRepositories.interviewPrep.Update(context.Background(), &models.UpdateInterviewPrep{
+		EventID:         event.ID,
+		Notes:           testutil.ToPtr("Second"),
+		ExpectedVersion: testutil.ToPtr(1),
+	})
+	var preconditionFailedErr *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedErr))
+	assert.Equal(
+		t,
+		"precondition failed: Interview prep has been changed since it was read. Expected version 1, current "+
+			"version is 2",
+		err.Error())
+
+	retrievedPrep, err := testRepositories.interviewPrep.GetByEventID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, retrievedPrep.Version)
+	assert.Equal(t, "First", *retrievedPrep.Notes)
+}
+
+func TestInterviewPrepRepository_ShouldIncrementVersionWhenQuestionsChange(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+
+	question := createInterviewQuestion(t, testRepositories, event.ID, "Why us?")
+
+	err := testRepositories.interviewQuestion.Update(context.Background(), &models.UpdateInterviewQuestion{
+		ID:     question.ID,
+		Answer: testutil.ToPtr("Because"),
+	})
+	assert.NoError(t, err)
+
+	err = testRepositories.interviewQuestion.Delete(context.Background(), &question.ID)
+	assert.NoError(t, err)
+
+	retrievedPrep, err := testRepositories.interviewPrep.GetByEventID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, retrievedPrep.Version)
+}
+
+func TestInterviewPrepRepositoryDelete_ShouldDeleteQuestionsToo(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+	question := createInterviewQuestion(t, testRepositories, event.ID, "Why us?")
+
+	err := testRepositories.interviewPrep.Delete(context.Background(), &event.ID, nil)
+	assert.NoError(t, err)
+
+	_, err = testRepositories.interviewQuestion.GetByID(context.Background(), &question.ID)
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+
+	err = testRepositories.interviewPrep.Delete(context.Background(), &event.ID, nil)
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestInterviewPrepRepository_ShouldBeDeletedWithItsEvent(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+	createInterviewQuestion(t, testRepositories, event.ID, "Why us?")
+
+	err := testRepositories.event.Delete(context.Background(), &event.ID, nil)
+	assert.NoError(t, err)
+
+	_, err = testRepositories.interviewPrep.GetByEventID(context.Background(), &event.ID)
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+// -------- InterviewQuestionRepository tests: --------
+
+func TestInterviewQuestionRepositoryCreate_ShouldInsertQuestionWithEventTypeAndDate(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	eventDate := time.Now().AddDate(0, 0, -3)
+	event := createInterviewWithPrep(t, testRepositories, eventDate)
+
+	id := uuid.New()
+	insertedQuestion, err := testRepositories.interviewQuestion.Create(
+		context.Background(),
+		&models.CreateInterviewQuestion{
+			ID:           &id,
+			EventID:      event.ID,
+			QuestionType: models.InterviewQuestionTypeAskedByUs,
+			Question:     "What does a typical week look like?",
+			Answer:       testutil.ToPtr("Mostly focus time"),
+			Topic:        testutil.ToPtr("Team"),
+		})
+	assert.NoError(t, err)
+	assert.NotNil(t, insertedQuestion)
+
+	assert.Equal(t, id, insertedQuestion.ID)
+	assert.Equal(t, event.ID, insertedQuestion.EventID)
+	assert.Equal(
+		t, models.InterviewQuestionType(models.InterviewQuestionTypeAskedByUs), insertedQuestion.QuestionType)
+	assert.Equal(t, "What does a typical week look like?", insertedQuestion.Question)
+	assert.Equal(t, "Mostly focus time", *insertedQuestion.Answer)
+	assert.Equal(t, "Team", *insertedQuestion.Topic)
+	assert.Equal(t, models.EventType(models.EventTypeInterviewCompleted), insertedQuestion.EventType)
+	testutil.AssertEqualFormattedDateTimes(t, &eventDate, insertedQuestion.EventDate)
+	assert.NotNil(t, insertedQuestion.CreatedDate)
+}
+
+func TestInterviewQuestionRepositoryCreate_ShouldReturnValidationErrorIfThereIsNoPrep(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, testRepositories.event, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	insertedQuestion, err := testRepositories.interviewQuestion.Create(
+		context.Background(),
+		&models.CreateInterviewQuestion{
+			EventID:      event.ID,
+			QuestionType: models.InterviewQuestionTypeAskedToUs,
+			Question:     "Why us?",
+		})
+	assert.Nil(t, insertedQuestion)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'EventID': Interview prep does not exist for event: '"+event.ID.String()+"'",
+		err.Error())
+}
+
+func TestInterviewQuestionRepositoryUpdate_ShouldUpdateGivenFields(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+	question := createInterviewQuestion(t, testRepositories, event.ID, "Why us?")
+
+	var questionType models.InterviewQuestionType = models.InterviewQuestionTypeAskedByUs
+	err := testRepositories.interviewQuestion.Update(context.Background(), &models.UpdateInterviewQuestion{
+		ID:           question.ID,
+		QuestionType: &questionType,
+		Answer:       testutil.ToPtr("Because of the product"),
+	})
+	assert.NoError(t, err)
+
+	updatedQuestion, err := testRepositories.interviewQuestion.GetByID(context.Background(), &question.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, questionType, updatedQuestion.QuestionType)
+	assert.Equal(t, "Why us?", updatedQuestion.Question)
+	assert.Equal(t, "Because of the product", *updatedQuestion.Answer)
+	assert.NotNil(t, updatedQuestion.UpdatedDate)
+}
+
+func TestInterviewQuestionRepositoryDelete_ShouldReturnNotFoundErrorForUnknownID(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	id := uuid.New()
+	err := testRepositories.interviewQuestion.Delete(context.Background(), &id)
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, "error: object not found: interview question does not exist. ID: "+id.String(), err.Error())
+}
+
+func TestInterviewQuestionRepositoryGetAll_ShouldAggregateByEventApplicationAndCompany(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+
+	company := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, nil)
+	otherCompany := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, nil)
+	application := repositoryhelpers.CreateApplication(
+		t, testRepositories.application, nil, &company.ID, nil, nil)
+
+	// an interview for the application, a later interview with the company, and an interview with another company
+	applicationInterview := createInterviewWithPrep(t, testRepositories, time.Now().AddDate(0, 0, -2))
+	repositoryhelpers.AssociateApplicationEvent(
+		t, testRepositories.applicationEvent, application.ID, applicationInterview.ID, nil)
+	companyInterview := createInterviewWithPrep(t, testRepositories, time.Now().AddDate(0, 0, -1))
+	repositoryhelpers.AssociateCompanyEvent(t, testRepositories.companyEvent, company.ID, companyInterview.ID, nil)
+	otherInterview := createInterviewWithPrep(t, testRepositories, time.Now())
+	repositoryhelpers.AssociateCompanyEvent(
+		t, testRepositories.companyEvent, otherCompany.ID, otherInterview.ID, nil)
+
+	firstQuestion := createInterviewQuestion(t, testRepositories, applicationInterview.ID, "Tell us about yourself")
+	secondQuestion := createInterviewQuestion(t, testRepositories, applicationInterview.ID, "Why us?")
+	companyQuestion := createInterviewQuestion(t, testRepositories, companyInterview.ID, "Design a URL shortener")
+	createInterviewQuestion(t, testRepositories, otherInterview.ID, "Reverse a linked list")
+
+	eventQuestions, err := testRepositories.interviewQuestion.GetAllByEventID(
+		context.Background(), &applicationInterview.ID)
+	assert.NoError(t, err)
+	assert.Len(t, eventQuestions, 2)
+	assert.Equal(t, firstQuestion.ID, eventQuestions[0].ID)
+	assert.Equal(t, secondQuestion.ID, eventQuestions[1].ID)
+
+	applicationQuestions, err := testRepositories.interviewQuestion.GetAllByApplicationID(
+		context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Len(t, applicationQuestions, 2)
+	assert.Equal(t, firstQuestion.ID, applicationQuestions[0].ID)
+	assert.Equal(t, secondQuestion.ID, applicationQuestions[1].ID)
+
+	companyQuestions, err := testRepositories.interviewQuestion.GetAllByCompanyID(context.Background(), &company.ID)
+	assert.NoError(t, err)
+	assert.Len(t, companyQuestions, 3)
+	assert.Equal(t, companyQuestion.ID, companyQuestions[0].ID)
+	assert.Equal(t, firstQuestion.ID, companyQuestions[1].ID)
+	assert.Equal(t, secondQuestion.ID, companyQuestions[2].ID)
+
+	noQuestions, err := testRepositories.interviewQuestion.GetAllByCompanyID(
+		context.Background(), testutil.ToPtr(uuid.New()))
+	assert.NoError(t, err)
+	assert.Empty(t, noQuestions)
+}
+
+func TestInterviewPrepRepositoryDelete_ShouldReturnPreconditionFailedErrorIfVersionDoesNotMatch(t *testing.T) {
+	testRepositories := setupInterviewPrepRepository(t)
+	event := createInterviewWithPrep(t, testRepositories, time.Now())
+
+	err := testRepositories.interviewPrep.Delete(context.Background(), &event.ID, testutil.ToPtr(3))
+	var preconditionFailedErr *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedErr))
+
+	err = testRepositories.interviewPrep.Delete(context.Background(), &event.ID, testutil.ToPtr(1))
+	assert.NoError(t, err)
+
+	err = testRepositories.interviewPrep.Delete(context.Background(), &event.ID, testutil.ToPtr(1))
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
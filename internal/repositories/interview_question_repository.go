@@ -0,0 +1,367 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/utils"
+	"jobsearchtracker/pkg/timeutil"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sqlSelectInterviewQuestion is followed by a WHERE clause. Every column of the question is selected, together with
+// the type and date of its event.
+const sqlSelectInterviewQuestion = `
+		SELECT interview_question.id, interview_question.event_id, interview_question.question_type,
+			interview_question.question, interview_question.answer, interview_question.topic, event.event_type,
+			event.event_date, interview_question.created_date, interview_question.updated_date
+		FROM interview_question
+		INNER JOIN event ON event.id = interview_question.event_id `
+
+type InterviewQuestionRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewInterviewQuestionRepository(database Executor, queryTimeout time.Duration) *InterviewQuestionRepository {
+	return &InterviewQuestionRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *InterviewQuestionRepository) Create(
+	ctx context.Context, question *models.CreateInterviewQuestion) (*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+	sqlInsert := `
+		INSERT INTO interview_question (
+			id, event_id, question_type, question, answer, topic, created_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?) `
+
+	var questionID uuid.UUID
+	if question.ID != nil {
+		questionID = *question.ID
+	} else {
+		questionID = uuid.New()
+	}
+
+	var createdDate string
+	if question.CreatedDate != nil {
+		createdDate = question.CreatedDate.Format(timeutil.RFC3339Milli_Write)
+	} else {
+		createdDate = time.Now().Format(timeutil.RFC3339Milli_Write)
+	}
+
+	insertCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	_, err := repository.database.ExecContext(
+		insertCtx,
+		sqlInsert,
+		questionID,
+		question.EventID,
+		question.QuestionType,
+		question.Question,
+		question.Answer,
+		question.Topic,
+		createdDate,
+	)
+	if err != nil {
+		if isUniqueViolation(err, "interview_question.id") {
+			logger.Info("interview_question_repository.Create: UNIQUE constraint failed", "ID", questionID.String())
+			return nil, internalErrors.NewConflictError(
+				"ID already exists in database: '" + questionID.String() + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("interview_question_repository.Create: FOREIGN KEY constraint failed")
+			eventID := "EventID"
+			return nil, internalErrors.NewValidationError(
+				&eventID, "Interview prep does not exist for event: '"+question.EventID.String()+"'")
+		} else if constraintErr := translateConstraintError(err, "QuestionType"); constraintErr != nil {
+			logger.Info("interview_question_repository.Create: constraint failed", "error", err)
+			return nil, constraintErr
+		}
+		logger.Error("interview_question_repository.Create: unable to insert question", "error", err)
+		return nil, internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return repository.GetByID(ctx, &questionID)
+}
+
+// GetByID can return InternalServiceError, NotFoundError, ValidationError
+func (repository *InterviewQuestionRepository) GetByID(
+	ctx context.Context, id *uuid.UUID) (*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+	if id == nil {
+		logger.Info("interview_question_repository.GetByID: ID is nil")
+		return nil, internalErrors.NewValidationError(nil, "ID is nil")
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelectInterviewQuestion+"WHERE interview_question.id = ? ", id)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, "GetByID")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("interview_question_repository.GetByID: No result found for ID", "ID", id)
+			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAllByEventID returns the questions of an interview or code test, oldest first.
+//
+// GetAllByEventID can return InternalServiceError, ValidationError
+func (repository *InterviewQuestionRepository) GetAllByEventID(
+	ctx context.Context, eventID *uuid.UUID) ([]*models.InterviewQuestion, error) {
+
+	if eventID == nil {
+		logging.FromContext(ctx).Info("interview_question_repository.GetAllByEventID: eventID is nil")
+		return nil, internalErrors.NewValidationError(nil, "eventID is nil")
+	}
+
+	// can return InternalServiceError
+	return repository.getAll(
+		ctx,
+		"GetAllByEventID",
+		`WHERE interview_question.event_id = ?
+		ORDER BY interview_question.created_date `,
+		eventID)
+}
+
+// GetAllByApplicationID returns the questions of every interview and code test of an application, newest event
+// first.
+//
+// GetAllByApplicationID can return InternalServiceError, ValidationError
+func (repository *InterviewQuestionRepository) GetAllByApplicationID(
+	ctx context.Context, applicationID *uuid.UUID) ([]*models.InterviewQuestion, error) {
+
+	if applicationID == nil {
+		logging.FromContext(ctx).Info("interview_question_repository.GetAllByApplicationID: applicationID is nil")
+		return nil, internalErrors.NewValidationError(nil, "applicationID is nil")
+	}
+
+	// can return InternalServiceError
+	return repository.getAll(
+		ctx,
+		"GetAllByApplicationID",
+		`WHERE interview_question.event_id IN (
+			SELECT event_id FROM application_event WHERE application_id = ?
+		)
+		ORDER BY event.event_date DESC, interview_question.created_date `,
+		applicationID)
+}
+
+// GetAllByCompanyID returns the questions of every interview and code test of a company, newest event first. An
+// event belongs to a company if it is associated with the company, or with an application whose employer is the
+// company.
+//
+// GetAllByCompanyID can return InternalServiceError, ValidationError
+func (repository *InterviewQuestionRepository) GetAllByCompanyID(
+	ctx context.Context, companyID *uuid.UUID) ([]*models.InterviewQuestion, error) {
+
+	if companyID == nil {
+		logging.FromContext(ctx).Info("interview_question_repository.GetAllByCompanyID: companyID is nil")
+		return nil, internalErrors.NewValidationError(nil, "companyID is nil")
+	}
+
+	// can return InternalServiceError
+	return repository.getAll(
+		ctx,
+		"GetAllByCompanyID",
+		`WHERE interview_question.event_id IN (
+			SELECT event_id FROM company_event WHERE company_id = ?
+			UNION
+			SELECT application_event.event_id
+			FROM application_event
+			INNER JOIN application ON application.id = application_event.application_id
+			WHERE application.company_id = ?
+		)
+		ORDER BY event.event_date DESC, interview_question.created_date `,
+		companyID,
+		companyID)
+}
+
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (repository *InterviewQuestionRepository) Update(
+	ctx context.Context, question *models.UpdateInterviewQuestion) error {
+
+	logger := logging.FromContext(ctx)
+	var sqlString strings.Builder
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	sqlString.WriteString(`
+		UPDATE interview_question SET
+			updated_date = ?,
+			`)
+	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
+
+	if question.QuestionType != nil {
+		sqlParts = append(sqlParts, "question_type = ?")
+		sqlVars = append(sqlVars, *question.QuestionType)
+	}
+
+	if question.Question != nil {
+		sqlParts = append(sqlParts, "question = ?")
+		sqlVars = append(sqlVars, *question.Question)
+	}
+
+	if question.Answer != nil {
+		sqlParts = append(sqlParts, "answer = ?")
+		sqlVars = append(sqlVars, *question.Answer)
+	}
+
+	if question.Topic != nil {
+		sqlParts = append(sqlParts, "topic = ?")
+		sqlVars = append(sqlVars, *question.Topic)
+	}
+
+	if len(sqlParts) == 0 {
+		logger.Info("interview_question_repository.Update: nothing to update", "ID", question.ID)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("interview_question_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlString.WriteString(sqlPayload)
+	sqlString.WriteString(`
+		WHERE id = ? `)
+	sqlVars = append(sqlVars, question.ID)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlString.String(), sqlVars...)
+	if err != nil {
+		logger.Error(
+			"interview_question_repository.Update: unable to update question", "ID", question.ID, "error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, "interview question does not exist. ID: "+question.ID.String())
+}
+
+// Delete can return InternalServiceError, NotFoundError, ValidationError
+func (repository *InterviewQuestionRepository) Delete(ctx context.Context, id *uuid.UUID) error {
+	logger := logging.FromContext(ctx)
+	if id == nil {
+		logger.Error("interview_question_repository.Delete: ID is nil")
+		idField := "ID"
+		return internalErrors.NewValidationError(&idField, "ID is nil")
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, "DELETE FROM interview_question WHERE id = ?", id)
+	if err != nil {
+		logger.Error(
+			"interview_question_repository.Delete: Error trying to delete question", "ID", id, "error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, "interview question does not exist. ID: "+id.String())
+}
+
+// internal functions
+
+// getAll can return InternalServiceError
+func (repository *InterviewQuestionRepository) getAll(
+	ctx context.Context,
+	methodName string,
+	whereClause string,
+	args ...interface{}) ([]*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelectInterviewQuestion+whereClause, args...)
+	if err != nil {
+		logger.Error("interview_question_repository."+methodName+": Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError(
+			"Error reading interview questions from database: " + err.Error())
+	}
+
+	results := []*models.InterviewQuestion{}
+	for rows.Next() {
+		// can return InternalServiceError
+		result, err := repository.mapRow(ctx, rows, methodName)
+		if err != nil {
+			logger.Error("interview_question_repository."+methodName+": Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError(
+				"Error processing interview question data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("interview_question_repository."+methodName+": Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError(
+			"Error reading interview questions from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
+func (repository *InterviewQuestionRepository) mapRow(
+	ctx context.Context,
+	scanner interface{ Scan(...interface{}) error }, methodName string) (*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+
+	var result models.InterviewQuestion
+	var eventDate, createdDate string
+	var updatedDate sql.NullString
+
+	err := scanner.Scan(
+		&result.ID,
+		&result.EventID,
+		&result.QuestionType,
+		&result.Question,
+		&result.Answer,
+		&result.Topic,
+		&result.EventType,
+		&eventDate,
+		&createdDate,
+		&updatedDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, eventDate)
+	if err != nil {
+		logger.Error("interview_question_repository."+methodName+": Error parsing eventDate", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error parsing eventDate: " + err.Error())
+	}
+	result.EventDate = &timestamp
+
+	result.CreatedDate, result.UpdatedDate, err = parseCreatedAndUpdatedDates(createdDate, updatedDate)
+	if err != nil {
+		logger.Error("interview_question_repository."+methodName+": Error parsing dates", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error parsing dates: " + err.Error())
+	}
+
+	return &result, nil
+}
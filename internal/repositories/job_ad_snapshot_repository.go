@@ -0,0 +1,228 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/pkg/timeutil"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobAdSnapshotRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewJobAdSnapshotRepository(database Executor, queryTimeout time.Duration) *JobAdSnapshotRepository {
+	return &JobAdSnapshotRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// Create returns the snapshot without its Content.
+//
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *JobAdSnapshotRepository) Create(
+	ctx context.Context, snapshot *models.CreateJobAdSnapshot) (*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+	sqlInsert := `
+		INSERT INTO job_ad_snapshot (
+			id, application_id, source_type, content, job_title, company_name, job_ad_url, country, area,
+			remote_status_type, salary, created_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING
+			id, application_id, source_type, null, job_title, company_name, job_ad_url, country, area,
+			remote_status_type, salary, created_date; `
+
+	var snapshotID uuid.UUID
+	if snapshot.ID != nil {
+		snapshotID = *snapshot.ID
+	} else {
+		snapshotID = uuid.New()
+	}
+
+	var createdDate string
+	if snapshot.CreatedDate != nil {
+		createdDate = snapshot.CreatedDate.Format(timeutil.RFC3339Milli_Write)
+	} else {
+		createdDate = time.Now().Format(timeutil.RFC3339Milli_Write)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
+		sqlInsert,
+		snapshotID,
+		snapshot.ApplicationID,
+		snapshot.SourceType,
+		snapshot.Content,
+		snapshot.JobTitle,
+		snapshot.CompanyName,
+		snapshot.JobAdURL,
+		snapshot.Country,
+		snapshot.Area,
+		snapshot.RemoteStatusType,
+		snapshot.Salary,
+		createdDate,
+	)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, "Create")
+	if err != nil {
+		if isUniqueViolation(err, "job_ad_snapshot.id") {
+			logger.Info("job_ad_snapshot_repository.Create: UNIQUE constraint failed", "ID", snapshotID.String())
+			return nil, internalErrors.NewConflictError(
+				"ID already exists in database: '" + snapshotID.String() + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("job_ad_snapshot_repository.Create: FOREIGN KEY constraint failed")
+			applicationID := "ApplicationID"
+			return nil, internalErrors.NewValidationError(
+				&applicationID, "Application does not exist: '"+snapshot.ApplicationID.String()+"'")
+		} else if constraintErr := translateConstraintError(
+			err, "SourceType or RemoteStatusType"); constraintErr != nil {
+			logger.Info("job_ad_snapshot_repository.Create: constraint failed", "error", err)
+			return nil, constraintErr
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetById returns the snapshot with its Content.
+//
+// GetById can return InternalServiceError, NotFoundError, ValidationError
+func (repository *JobAdSnapshotRepository) GetById(
+	ctx context.Context, id *uuid.UUID) (*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+	if id == nil {
+		logger.Info("job_ad_snapshot_repository.GetById: ID is nil")
+		return nil, internalErrors.NewValidationError(nil, "ID is nil")
+	}
+
+	sqlSelect := `
+		SELECT id, application_id, source_type, content, job_title, company_name, job_ad_url, country, area,
+			remote_status_type, salary, created_date
+		FROM job_ad_snapshot
+		WHERE id = ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, id)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, "GetById")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("job_ad_snapshot_repository.GetById: No result found for ID", "ID", id)
+			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAllByApplicationID returns the snapshots of an application without their Content, newest first.
+//
+// GetAllByApplicationID can return InternalServiceError, ValidationError
+func (repository *JobAdSnapshotRepository) GetAllByApplicationID(
+	ctx context.Context, applicationID *uuid.UUID) ([]*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+	if applicationID == nil {
+		logger.Info("job_ad_snapshot_repository.GetAllByApplicationID: applicationID is nil")
+		return nil, internalErrors.NewValidationError(nil, "applicationID is nil")
+	}
+
+	sqlSelect := `
+		SELECT id, application_id, source_type, null, job_title, company_name, job_ad_url, country, area,
+			remote_status_type, salary, created_date
+		FROM job_ad_snapshot
+		WHERE application_id = ?
+		ORDER BY created_date DESC `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, applicationID)
+	if err != nil {
+		logger.Error("job_ad_snapshot_repository.GetAllByApplicationID: Error querying", "error", err)
+		return nil, internalErrors.NewInternalServiceError(
+			"Error reading job ad snapshots from database: " + err.Error())
+	}
+
+	var results []*models.JobAdSnapshot
+	for rows.Next() {
+		// can return InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByApplicationID")
+		if err != nil {
+			logger.Error("job_ad_snapshot_repository.GetAllByApplicationID: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing job ad snapshot data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("job_ad_snapshot_repository.GetAllByApplicationID: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError(
+			"Error reading job ad snapshots from database: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// internal functions
+
+func (repository *JobAdSnapshotRepository) mapRow(
+	ctx context.Context,
+	scanner interface{ Scan(...interface{}) error }, methodName string) (*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+
+	var result models.JobAdSnapshot
+	var content sql.NullString
+	var createdDate string
+
+	err := scanner.Scan(
+		&result.ID,
+		&result.ApplicationID,
+		&result.SourceType,
+		&content,
+		&result.JobTitle,
+		&result.CompanyName,
+		&result.JobAdURL,
+		&result.Country,
+		&result.Area,
+		&result.RemoteStatusType,
+		&result.Salary,
+		&createdDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if content.Valid {
+		result.Content = &content.String
+	}
+
+	timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate)
+	if err != nil {
+		logger.Error(
+			"job_ad_snapshot_repository."+methodName+": Error parsing createdDate",
+			"createdDate", createdDate,
+			"error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
+	}
+	result.CreatedDate = &timestamp
+
+	return &result, nil
+}
@@ -0,0 +1,245 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupJobAdSnapshotRepository(t *testing.T) (
+	*repositories.JobAdSnapshotRepository,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupJobAdSnapshotRepositoryTestContainer(t, *config)
+
+	var jobAdSnapshotRepository *repositories.JobAdSnapshotRepository
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		snapshot *repositories.JobAdSnapshotRepository,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository) {
+
+		jobAdSnapshotRepository = snapshot
+		applicationRepository = application
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	return jobAdSnapshotRepository, applicationRepository, companyRepository
+}
+
+func createTestApplicationForSnapshot(
+	t *testing.T,
+	applicationRepository *repositories.ApplicationRepository,
+	companyRepository *repositories.CompanyRepository) *models.Application {
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	return repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+}
+
+// -------- Create tests: --------
+
+func TestJobAdSnapshotRepositoryCreate_ShouldInsertSnapshot(t *testing.T) {
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	id := uuid.New()
+	createdDate := time.Now().AddDate(0, 0, -1)
+	snapshotToInsert := models.CreateJobAdSnapshot{
+		ID:            &id,
+		ApplicationID: application.ID,
+		SourceType:    models.JobAdSourceTypeHTML,
+		Content:       "<html><h1>Backend Developer</h1></html>",
+		JobAdPosting: models.JobAdPosting{
+			JobTitle:         testutil.ToPtr("Backend Developer"),
+			CompanyName:      testutil.ToPtr("Acme AB"),
+			JobAdURL:         testutil.ToPtr("https://acme.se/jobs/1"),
+			Country:          testutil.ToPtr("SE"),
+			Area:             testutil.ToPtr("Stockholm"),
+			RemoteStatusType: models.RemoteStatusTypeHybrid,
+			Salary:           testutil.ToPtr("50000 SEK per month"),
+		},
+		CreatedDate: &createdDate,
+	}
+
+	insertedSnapshot, err := jobAdSnapshotRepository.Create(context.Background(), &snapshotToInsert)
+	assert.NoError(t, err)
+	assert.NotNil(t, insertedSnapshot)
+
+	assert.Equal(t, id, insertedSnapshot.ID)
+	assert.Equal(t, application.ID, insertedSnapshot.ApplicationID)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeHTML), insertedSnapshot.SourceType)
+	assert.Nil(t, insertedSnapshot.Content, "Create should not return the content")
+	assert.Equal(t, "Backend Developer", *insertedSnapshot.JobTitle)
+	assert.Equal(t, "Acme AB", *insertedSnapshot.CompanyName)
+	assert.Equal(t, "https://acme.se/jobs/1", *insertedSnapshot.JobAdURL)
+	assert.Equal(t, "SE", *insertedSnapshot.Country)
+	assert.Equal(t, "Stockholm", *insertedSnapshot.Area)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeHybrid), insertedSnapshot.RemoteStatusType)
+	assert.Equal(t, "50000 SEK per month", *insertedSnapshot.Salary)
+	testutil.AssertEqualFormattedDateTimes(t, &createdDate, insertedSnapshot.CreatedDate)
+}
+
+func TestJobAdSnapshotRepositoryCreate_ShouldReturnValidationErrorIfApplicationDoesNotExist(t *testing.T) {
+	jobAdSnapshotRepository, _, _ := setupJobAdSnapshotRepository(t)
+
+	snapshotToInsert := models.CreateJobAdSnapshot{
+		ApplicationID: uuid.New(),
+		SourceType:    models.JobAdSourceTypeJSONLD,
+		Content:       "{}",
+		JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeUnknown},
+	}
+
+	insertedSnapshot, err := jobAdSnapshotRepository.Create(context.Background(), &snapshotToInsert)
+	assert.Nil(t, insertedSnapshot)
+	assert.Error(t, err)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID': Application does not exist: '"+
+			snapshotToInsert.ApplicationID.String()+"'",
+		err.Error())
+}
+
+func TestJobAdSnapshotRepositoryCreate_ShouldReturnConflictErrorOnDuplicateID(t *testing.T) {
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	id := uuid.New()
+	snapshotToInsert := models.CreateJobAdSnapshot{
+		ID:            &id,
+		ApplicationID: application.ID,
+		SourceType:    models.JobAdSourceTypeJSONLD,
+		Content:       "{}",
+		JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeUnknown},
+	}
+
+	_, err := jobAdSnapshotRepository.Create(context.Background(), &snapshotToInsert)
+	assert.NoError(t, err)
+
+	insertedSnapshot, err := jobAdSnapshotRepository.Create(context.Background(), &snapshotToInsert)
+	assert.Nil(t, insertedSnapshot)
+
+	var conflictErr *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+}
+
+// -------- GetById tests: --------
+
+func TestJobAdSnapshotRepositoryGetById_ShouldReturnContent(t *testing.T) {
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	insertedSnapshot, err := jobAdSnapshotRepository.Create(context.Background(), &models.CreateJobAdSnapshot{
+		ApplicationID: application.ID,
+		SourceType:    models.JobAdSourceTypeJSONLD,
+		Content:       `{"@type": "JobPosting"}`,
+		JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeRemote},
+	})
+	assert.NoError(t, err)
+
+	retrievedSnapshot, err := jobAdSnapshotRepository.GetById(context.Background(), &insertedSnapshot.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, retrievedSnapshot)
+
+	assert.Equal(t, insertedSnapshot.ID, retrievedSnapshot.ID)
+	assert.Equal(t, `{"@type": "JobPosting"}`, *retrievedSnapshot.Content)
+	assert.Nil(t, retrievedSnapshot.JobTitle)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeRemote), retrievedSnapshot.RemoteStatusType)
+}
+
+func TestJobAdSnapshotRepositoryGetById_ShouldReturnNotFoundErrorForUnknownID(t *testing.T) {
+	jobAdSnapshotRepository, _, _ := setupJobAdSnapshotRepository(t)
+
+	id := uuid.New()
+	retrievedSnapshot, err := jobAdSnapshotRepository.GetById(context.Background(), &id)
+	assert.Nil(t, retrievedSnapshot)
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, "error: object not found: ID: '"+id.String()+"'", err.Error())
+}
+
+// -------- GetAllByApplicationID tests: --------
+
+func TestJobAdSnapshotRepositoryGetAllByApplicationID_ShouldReturnNewestFirstWithoutContent(t *testing.T) {
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+	otherApplication := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	createSnapshot := func(applicationID uuid.UUID, createdDate time.Time) *models.JobAdSnapshot {
+		snapshot, err := jobAdSnapshotRepository.Create(context.Background(), &models.CreateJobAdSnapshot{
+			ApplicationID: applicationID,
+			SourceType:    models.JobAdSourceTypeHTML,
+			Content:       "<html></html>",
+			JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeUnknown},
+			CreatedDate:   &createdDate,
+		})
+		assert.NoError(t, err)
+		return snapshot
+	}
+
+	older := createSnapshot(application.ID, time.Now().AddDate(0, 0, -2))
+	newer := createSnapshot(application.ID, time.Now().AddDate(0, 0, -1))
+	createSnapshot(otherApplication.ID, time.Now())
+
+	snapshots, err := jobAdSnapshotRepository.GetAllByApplicationID(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+
+	assert.Equal(t, newer.ID, snapshots[0].ID)
+	assert.Equal(t, older.ID, snapshots[1].ID)
+	assert.Nil(t, snapshots[0].Content)
+	assert.Nil(t, snapshots[1].Content)
+}
+
+func TestJobAdSnapshotRepositoryGetAllByApplicationID_ShouldReturnNothingForApplicationWithoutSnapshots(
+	t *testing.T) {
+
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	snapshots, err := jobAdSnapshotRepository.GetAllByApplicationID(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots)
+}
+
+func TestJobAdSnapshotRepository_ShouldDeleteSnapshotsWithTheirApplication(t *testing.T) {
+	jobAdSnapshotRepository, applicationRepository, companyRepository := setupJobAdSnapshotRepository(t)
+	application := createTestApplicationForSnapshot(t, applicationRepository, companyRepository)
+
+	insertedSnapshot, err := jobAdSnapshotRepository.Create(context.Background(), &models.CreateJobAdSnapshot{
+		ApplicationID: application.ID,
+		SourceType:    models.JobAdSourceTypeHTML,
+		Content:       "<html></html>",
+		JobAdPosting:  models.JobAdPosting{RemoteStatusType: models.RemoteStatusTypeUnknown},
+	})
+	assert.NoError(t, err)
+
+	err = applicationRepository.Delete(context.Background(), &application.ID, nil)
+	assert.NoError(t, err)
+
+	_, err = jobAdSnapshotRepository.GetById(context.Background(), &insertedSnapshot.ID)
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
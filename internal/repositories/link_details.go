@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/pkg/timeutil"
+	"time"
+)
+
+// linkDetailsRow receives the role, notes, start_date, end_date and updated_date columns that every link table has
+// next to the two IDs and created_date.
+type linkDetailsRow struct {
+	role        sql.NullString
+	notes       sql.NullString
+	startDate   sql.NullString
+	endDate     sql.NullString
+	updatedDate sql.NullString
+}
+
+func (row *linkDetailsRow) scanDestinations() []interface{} {
+	return []interface{}{&row.role, &row.notes, &row.startDate, &row.endDate, &row.updatedDate}
+}
+
+// toModel returns the details and the updated date of the link.
+func (row *linkDetailsRow) toModel() (models.LinkDetails, *time.Time, error) {
+	var linkDetails models.LinkDetails
+	if row.role.Valid {
+		linkDetails.Role = &row.role.String
+	}
+	if row.notes.Valid {
+		linkDetails.Notes = &row.notes.String
+	}
+
+	var err error
+	if linkDetails.StartDate, err = parseNullableLinkDate(row.startDate); err != nil {
+		return models.LinkDetails{}, nil, err
+	}
+	if linkDetails.EndDate, err = parseNullableLinkDate(row.endDate); err != nil {
+		return models.LinkDetails{}, nil, err
+	}
+
+	updatedDate, err := parseNullableLinkDate(row.updatedDate)
+	if err != nil {
+		return models.LinkDetails{}, nil, err
+	}
+
+	return linkDetails, updatedDate, nil
+}
+
+// appendLinkDetailsUpdates adds the details that are set to the SET clause of an update.
+func appendLinkDetailsUpdates(linkDetails *models.LinkDetails, sqlParts *[]string, sqlVars *[]interface{}) {
+	if linkDetails.Role != nil {
+		*sqlParts = append(*sqlParts, "role = ?")
+		*sqlVars = append(*sqlVars, *linkDetails.Role)
+	}
+
+	if linkDetails.Notes != nil {
+		*sqlParts = append(*sqlParts, "notes = ?")
+		*sqlVars = append(*sqlVars, *linkDetails.Notes)
+	}
+
+	if linkDetails.StartDate != nil {
+		*sqlParts = append(*sqlParts, "start_date = ?")
+		*sqlVars = append(*sqlVars, formatNullableLinkDate(linkDetails.StartDate))
+	}
+
+	if linkDetails.EndDate != nil {
+		*sqlParts = append(*sqlParts, "end_date = ?")
+		*sqlVars = append(*sqlVars, formatNullableLinkDate(linkDetails.EndDate))
+	}
+}
+
+// linkDatesError returns a ValidationError if err is caused by the end_date of a link being before its start_date,
+// and nil otherwise.
+func linkDatesError(err error) error {
+	if !isCheckViolation(err, "end_date >= start_date") {
+		return nil
+	}
+
+	endDate := "EndDate"
+	return internalErrors.NewValidationError(&endDate, "EndDate cannot be before StartDate")
+}
+
+// internal functions
+
+// formatNullableLinkDate stores dates in UTC, so that the database can compare start_date and end_date as text.
+func formatNullableLinkDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.UTC().Format(timeutil.RFC3339Milli_Write)
+	return &formatted
+}
+
+func parseNullableLinkDate(date sql.NullString) (*time.Time, error) {
+	if !date.Valid {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(timeutil.RFC3339Milli_Read, date.String)
+	if err != nil {
+		return nil, errors.New("Error parsing date '" + date.String + "': " + err.Error())
+	}
+
+	return &parsed, nil
+}
@@ -1,15 +1,15 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"fmt"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/utils"
 	"jobsearchtracker/pkg/timeutil"
-	"log/slog"
 	"strconv"
 	"strings"
 	"time"
@@ -18,20 +18,22 @@ import (
 )
 
 type PersonRepository struct {
-	database *sql.DB
+	database     Executor
+	queryTimeout time.Duration
 }
 
-func NewPersonRepository(database *sql.DB) *PersonRepository {
-	return &PersonRepository{database: database}
+func NewPersonRepository(database Executor, queryTimeout time.Duration) *PersonRepository {
+	return &PersonRepository{database: database, queryTimeout: queryTimeout}
 }
 
-// Create can return ConflictError, InternalServiceError
-func (repository *PersonRepository) Create(person *models.CreatePerson) (*models.Person, error) {
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *PersonRepository) Create(ctx context.Context, person *models.CreatePerson) (*models.Person, error) {
+	logger := logging.FromContext(ctx)
 	sqlInsert := `
 		INSERT INTO person (
 			id, name, person_type, email, phone, notes, created_date, updated_date
 		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-		RETURNING id, name, person_type, email, phone, notes, created_date, updated_date, null, null, null`
+		RETURNING id, name, person_type, email, phone, notes, created_date, updated_date, version`
 
 	var personID uuid.UUID
 	if person.ID != nil {
@@ -52,7 +54,11 @@ func (repository *PersonRepository) Create(person *models.CreatePerson) (*models
 		updatedDate = person.UpdatedDate.Format(timeutil.RFC3339Milli_Write)
 	}
 
-	row := repository.database.QueryRow(
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
 		sqlInsert,
 		personID,
 		person.Name,
@@ -65,16 +71,24 @@ func (repository *PersonRepository) Create(person *models.CreatePerson) (*models
 	)
 
 	// can return ConflictError, InternalServiceError
-	result, err := repository.mapRow(row, "Create")
+	result, err := repository.mapRow(ctx, row, "Create")
 	if err != nil {
-		if err.Error() == "constraint failed: UNIQUE constraint failed: person.id (1555)" {
-			slog.Info(
+		if isUniqueViolation(err, "person.id") {
+			logger.Info(
 				"person_repository.CreatePerson: UNIQUE constraint failed",
 				"ID", personID)
 			return nil, internalErrors.NewConflictError(
 				"ID already exists in database: '" + personID.String() + "'")
+		} else if isForeignKeyViolation(err) {
+			logger.Info("person_repository.CreatePerson: FOREIGN KEY constraint failed", "ID", personID)
+			personType := "PersonType"
+			return nil, internalErrors.NewValidationError(
+				&personType, "person type does not exist: '"+person.PersonType.String()+"'")
+		} else if constraintErr := translateConstraintError(err, "Email"); constraintErr != nil {
+			logger.Info("person_repository.CreatePerson: constraint failed", "ID", personID, "error", err)
+			return nil, constraintErr
 		} else if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("person_repository.create: No result found for ID", "ID", personID, "error", err.Error())
+			logger.Info("person_repository.create: No result found for ID", "ID", personID, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + personID.String() + "'")
 		}
 		return nil, err
@@ -84,23 +98,27 @@ func (repository *PersonRepository) Create(person *models.CreatePerson) (*models
 }
 
 // GetById can return InternalServiceError, NotFoundError, ValidationError
-func (repository *PersonRepository) GetById(id *uuid.UUID) (*models.Person, error) {
+func (repository *PersonRepository) GetById(ctx context.Context, id *uuid.UUID) (*models.Person, error) {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Info("person_repository.GetById: ID is nil")
+		logger.Info("person_repository.GetById: ID is nil")
 		var id = "ID"
 		return nil, internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlSelect := `
-		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, null, null, null 
+		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, version 
 		FROM person
 		WHERE id = ? `
 
-	row := repository.database.QueryRow(sqlSelect, id)
-	result, err := repository.mapRow(row, "GetById")
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, id)
+	result, err := repository.mapRow(ctx, row, "GetById")
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			slog.Info("person_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
+			logger.Info("person_repository.GetById: No result found for ID", "ID", id, "error", err.Error())
 			return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
 		}
 		return nil, err
@@ -109,10 +127,58 @@ func (repository *PersonRepository) GetById(id *uuid.UUID) (*models.Person, erro
 	return result, err
 }
 
+// GetAllByIDs returns the persons with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetAllByIDs can return InternalServiceError, ValidationError
+func (repository *PersonRepository) GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Person, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	if err := models.ValidateIDs(ids); err != nil {
+		logger.Info("person_repository.GetAllByIDs: IDs are invalid", "error", err)
+		return nil, err
+	}
+
+	placeholders, sqlVars := buildIDPlaceholders(ids)
+	sqlSelect := `
+		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, version
+		FROM person
+		WHERE id IN (` + placeholders + `) `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("person_repository.GetAllByIDs: Error trying to query persons", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query persons: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.Person
+	for rows.Next() {
+		// can return ConflictError, InternalServiceError
+		result, err := repository.mapRow(ctx, rows, "GetAllByIDs")
+		if err != nil {
+			logger.Error("person_repository.GetAllByIDs: Error mapping row", "error", err)
+			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("person_repository.GetAllByIDs: Error iterating rows", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error reading persons from database: " + err.Error())
+	}
+
+	return orderByIDs(ids, results, func(person *models.Person) uuid.UUID { return person.ID }), nil
+}
+
 // GetAllByName can return InternalServiceError, NotFoundError, ValidationError
-func (repository *PersonRepository) GetAllByName(name *string) ([]*models.Person, error) {
+func (repository *PersonRepository) GetAllByName(ctx context.Context, name *string) ([]*models.Person, error) {
+	logger := logging.FromContext(ctx)
 	if name == nil {
-		slog.Info("person_repository.name: Name is nil")
+		logger.Info("person_repository.name: Name is nil")
 		var id = "Name"
 		return nil, internalErrors.NewValidationError(&id, "Name is nil")
 	}
@@ -120,12 +186,15 @@ func (repository *PersonRepository) GetAllByName(name *string) ([]*models.Person
 	wildcardName := "%" + *name + "%"
 
 	sqlSelect := `
-		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, null, null, null 
+		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, version 
 		FROM person
 		WHERE name LIKE ?
 		ORDER BY name ASC `
 
-	rows, err := repository.database.Query(sqlSelect, wildcardName)
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect, wildcardName)
 	if err != nil {
 		return nil, err
 	}
@@ -133,9 +202,9 @@ func (repository *PersonRepository) GetAllByName(name *string) ([]*models.Person
 	var results []*models.Person
 
 	for rows.Next() {
-		result, err := repository.mapRow(rows, "GetAllByName")
+		result, err := repository.mapRow(ctx, rows, "GetAllByName")
 		if err != nil {
-			slog.Error("person_repository.GetAllByName: Error mapping row", "error", err)
+			logger.Error("person_repository.GetAllByName: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -145,12 +214,12 @@ func (repository *PersonRepository) GetAllByName(name *string) ([]*models.Person
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("person_repository.GetAllByName: Error iterating rows", "error", err)
+		logger.Error("person_repository.GetAllByName: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading persons from database: " + err.Error())
 	}
 
 	if len(results) == 0 {
-		slog.Info("person_repository.GetByName: No result found for Name", "Name", name)
+		logger.Info("person_repository.GetByName: No result found for Name", "Name", name)
 		return nil, internalErrors.NewNotFoundError("Name: '" + *name + "'")
 	}
 
@@ -159,43 +228,35 @@ func (repository *PersonRepository) GetAllByName(name *string) ([]*models.Person
 
 // GetAll can return InternalServiceError
 func (repository *PersonRepository) GetAll(
+	ctx context.Context,
 	includeCompanies models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType,
 	includeApplications models.IncludeExtraDataType) ([]*models.Person, error) {
+
+	logger := logging.FromContext(ctx)
 	sqlSelect := `
-        SELECT p.id, p.name, p.person_type, p.email, p.phone, p.notes, p.created_date, p.updated_date, %s, %s, %s
-        FROM person p %s %s %s
-        GROUP BY p.id
-        ORDER BY p.created_date DESC; `
-
-	companiesCoalesceString, companiesJoinString := repository.buildCompaniesCoalesceAndJoin(includeCompanies)
-	eventsCoalesceString, eventsJoinString := repository.buildEventsCoalesceAndJoin(includeEvents)
-	applicationsCoalesceString, applicationsJoinString :=
-		repository.buildApplicationsCoalesceAndJoin(includeApplications)
-
-	sqlSelect = fmt.Sprintf(
-		sqlSelect,
-		companiesCoalesceString,
-		eventsCoalesceString,
-		applicationsCoalesceString,
-		companiesJoinString,
-		eventsJoinString,
-		applicationsJoinString)
-
-	rows, err := repository.database.Query(sqlSelect)
+		SELECT id, name, person_type, email, phone, notes, created_date, updated_date, version
+		FROM person
+		ORDER BY created_date DESC `
+
+	queryCtx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(queryCtx, sqlSelect)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	defer rows.Close()
 
 	var results []*models.Person
 
 	for rows.Next() {
-		result, err := repository.mapRow(rows, "GetAll")
+		result, err := repository.mapRow(ctx, rows, "GetAll")
 		if err != nil {
-			slog.Error("person_repository.GetAll: Error mapping row", "error", err)
+			logger.Error("person_repository.GetAll: Error mapping row", "error", err)
 			return nil, internalErrors.NewInternalServiceError("Error processing person data: " + err.Error())
 		}
 
@@ -205,22 +266,30 @@ func (repository *PersonRepository) GetAll(
 	}
 
 	if err = rows.Err(); err != nil {
-		slog.Error("person_repository.GetAll: Error iterating rows", "error", err)
+		logger.Error("person_repository.GetAll: Error iterating rows", "error", err)
 		return nil, internalErrors.NewInternalServiceError("Error reading persons from database: " + err.Error())
 	}
 
+	// can return InternalServiceError
+	err = repository.loadRelations(ctx, results, includeCompanies, includeEvents, includeApplications)
+	if err != nil {
+		return nil, err
+	}
+
 	return results, nil
 }
 
-// Update can return InternalServiceError, ValidationError
-func (repository *PersonRepository) Update(person *models.UpdatePerson) error {
+// Update can return ConflictError, InternalServiceError, ValidationError
+func (repository *PersonRepository) Update(ctx context.Context, person *models.UpdatePerson) error {
+	logger := logging.FromContext(ctx)
 	var sqlString strings.Builder
 	var sqlParts []string
 	var sqlVars []interface{}
 
 	sqlString.WriteString(`
 		UPDATE person SET 
-			updated_date = ?, 
+			updated_date = ?,
+			version = version + 1,
 			`)
 	sqlVars = append(sqlVars, time.Now().Format(timeutil.RFC3339Milli_Write))
 
@@ -257,14 +326,14 @@ func (repository *PersonRepository) Update(person *models.UpdatePerson) error {
 	}
 
 	if updateItemCount == 0 {
-		slog.Info("person_repository.Update: nothing to update", "id", person.ID)
+		logger.Info("person_repository.Update: nothing to update", "id", person.ID)
 		return internalErrors.NewValidationError(nil, "nothing to update")
 	}
 
 	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
 	if err != nil {
 		var message = "unable to join SQL statement string"
-		slog.Error("person_repository.Update: unable to join SQL statement string", "error", err)
+		logger.Error("person_repository.Update: unable to join SQL statement string", "error", err)
 		return internalErrors.NewInternalServiceError(message)
 	}
 
@@ -274,41 +343,89 @@ func (repository *PersonRepository) Update(person *models.UpdatePerson) error {
 		WHERE id = ? `)
 	sqlVars = append(sqlVars, person.ID)
 
-	_, err = repository.database.Exec(
+	if person.ExpectedVersion != nil {
+		sqlString.WriteString("AND version = ? ")
+		sqlVars = append(sqlVars, *person.ExpectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(
+		ctx,
 		sqlString.String(),
 		sqlVars...,
 	)
 
 	if err != nil {
-		slog.Error("person_repository.Update: unable to update person", "id", person.ID, "error", err.Error())
+		if isForeignKeyViolation(err) {
+			logger.Info("person_repository.Update: FOREIGN KEY constraint failed", "id", person.ID)
+			personType := "PersonType"
+			return internalErrors.NewValidationError(
+				&personType, "person type does not exist: '"+person.PersonType.String()+"'")
+		} else if constraintErr := translateConstraintError(err, "Email"); constraintErr != nil {
+			logger.Info("person_repository.Update: constraint failed", "id", person.ID, "error", err)
+			return constraintErr
+		}
+		logger.Error("person_repository.Update: unable to update person", "id", person.ID, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
-	return err
+	if person.ExpectedVersion != nil {
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error(
+				"person_repository.Update: unable to update person", "id", person.ID, "error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+		if rowsAffected == 0 {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"person", "id", "Person", person.ID, *person.ExpectedVersion)
+		}
+	}
+
+	return nil
 }
 
-// Delete can return InternalServiceError, NotFoundError, ValidationError
-func (repository *PersonRepository) Delete(id *uuid.UUID) error {
+// Delete deletes the person with id. If expectedVersion is set, it is only deleted if it still has that version.
+//
+// Delete can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (repository *PersonRepository) Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error {
+	logger := logging.FromContext(ctx)
 	if id == nil {
-		slog.Error("person_repository.Delete: ID is nil")
+		logger.Error("person_repository.Delete: ID is nil")
 		id := "ID"
 		return internalErrors.NewValidationError(&id, "ID is nil")
 	}
 
 	sqlDelete := "DELETE FROM person WHERE id = ?"
+	sqlVars := []interface{}{id}
+	if expectedVersion != nil {
+		sqlDelete += " AND version = ?"
+		sqlVars = append(sqlVars, *expectedVersion)
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
 
-	result, err := repository.database.Exec(sqlDelete, id)
+	result, err := repository.database.ExecContext(ctx, sqlDelete, sqlVars...)
 	if err != nil {
-		slog.Error("person_repository.Delete: Error trying to delete person", "id", id, "error", err.Error())
+		logger.Error("person_repository.Delete: Error trying to delete person", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		slog.Error("person_repository.Delete: Error trying to delete person", "id", id, "error", err.Error())
+		logger.Error("person_repository.Delete: Error trying to delete person", "id", id, "error", err.Error())
 		return internalErrors.NewInternalServiceError(err.Error())
 	}
 	if rowsAffected == 0 {
+		if expectedVersion != nil {
+			return getVersionMismatchError(
+				ctx, repository.database, repository.queryTimeout,
+				"person", "id", "Person", *id, *expectedVersion)
+		}
 		return internalErrors.NewNotFoundError("Person does not exist. ID: " + id.String())
 	} else if rowsAffected > 1 {
 		return internalErrors.NewInternalServiceError(
@@ -318,13 +435,72 @@ func (repository *PersonRepository) Delete(id *uuid.UUID) error {
 	return nil
 }
 
+// MoveReferences points every company_person, application_person and event_person that references the person with
+// fromID to the person with toID instead. Links that toID already has are dropped rather than duplicated. It should
+// be run in a transaction, as it executes several statements.
+//
+// MoveReferences can return InternalServiceError, ValidationError
+func (repository *PersonRepository) MoveReferences(ctx context.Context, fromID *uuid.UUID, toID *uuid.UUID) error {
+	logger := logging.FromContext(ctx)
+	if fromID == nil || toID == nil {
+		logger.Error("person_repository.MoveReferences: ID is nil")
+		return internalErrors.NewValidationError(nil, "fromID and toID are required")
+	}
+
+	linkTables := []struct {
+		table       string
+		otherColumn string
+	}{
+		{table: "company_person", otherColumn: "company_id"},
+		{table: "application_person", otherColumn: "application_id"},
+		{table: "event_person", otherColumn: "event_id"},
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	for _, linkTable := range linkTables {
+		sqlInsert := fmt.Sprintf(`
+			INSERT OR IGNORE INTO %[1]s (
+					%[2]s, person_id, created_date, role, notes, start_date, end_date, updated_date)
+			SELECT %[2]s, ?, created_date, role, notes, start_date, end_date, updated_date
+			FROM %[1]s WHERE person_id = ?`,
+			linkTable.table, linkTable.otherColumn)
+		sqlDelete := fmt.Sprintf("DELETE FROM %s WHERE person_id = ?", linkTable.table)
+
+		if _, err := repository.database.ExecContext(ctx, sqlInsert, toID, fromID); err != nil {
+			logger.Error(
+				"person_repository.MoveReferences: unable to move references",
+				"table", linkTable.table,
+				"fromID", fromID,
+				"toID", toID,
+				"error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+
+		if _, err := repository.database.ExecContext(ctx, sqlDelete, fromID); err != nil {
+			logger.Error(
+				"person_repository.MoveReferences: unable to delete moved references",
+				"table", linkTable.table,
+				"fromID", fromID,
+				"error", err.Error())
+			return internalErrors.NewInternalServiceError(err.Error())
+		}
+	}
+
+	return nil
+}
+
 // mapRow can return InternalServiceError
 func (repository *PersonRepository) mapRow(
+	ctx context.Context,
 	scanner interface{ Scan(...interface{}) error },
 	methodName string) (*models.Person, error) {
 
+	logger := logging.FromContext(ctx)
+
 	var result models.Person
-	var createdDate, updatedDate, applicationsString, companiesString, eventsString sql.NullString
+	var createdDate, updatedDate sql.NullString
 
 	err := scanner.Scan(
 		&result.ID,
@@ -335,9 +511,7 @@ func (repository *PersonRepository) mapRow(
 		&result.Notes,
 		&createdDate,
 		&updatedDate,
-		&companiesString,
-		&eventsString,
-		&applicationsString,
+		&result.Version,
 	)
 
 	if err != nil {
@@ -347,7 +521,7 @@ func (repository *PersonRepository) mapRow(
 	if createdDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, createdDate.String)
 		if err != nil {
-			slog.Error("person_repository."+methodName+": Error parsing createdDate",
+			logger.Error("person_repository."+methodName+": Error parsing createdDate",
 				"createdDate", createdDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing createdDate: " + err.Error())
@@ -358,7 +532,7 @@ func (repository *PersonRepository) mapRow(
 	if updatedDate.Valid {
 		timestamp, err := time.Parse(timeutil.RFC3339Milli_Read, updatedDate.String)
 		if err != nil {
-			slog.Error("person_repository."+methodName+": Error parsing updatedDate",
+			logger.Error("person_repository."+methodName+": Error parsing updatedDate",
 				"updatedDate", updatedDate,
 				"error", err.Error())
 			return nil, internalErrors.NewInternalServiceError("Error parsing updatedDate: " + err.Error())
@@ -366,154 +540,79 @@ func (repository *PersonRepository) mapRow(
 		result.UpdatedDate = &timestamp
 	}
 
-	if applicationsString.Valid {
-		var applications []*models.Application
-		if err = json.Unmarshal([]byte(applicationsString.String), &applications); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing applications: " + err.Error())
-		}
-
-		if len(applications) > 0 {
-			result.Applications = &applications
-		}
-	}
-
-	if companiesString.Valid {
-		var companies []*models.Company
-		if err = json.Unmarshal([]byte(companiesString.String), &companies); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing companies: " + err.Error())
-		}
-
-		if len(companies) > 0 {
-			result.Companies = &companies
-		}
-	}
-
-	if eventsString.Valid {
-		var events []*models.Event
-		if err = json.Unmarshal([]byte(eventsString.String), &events); err != nil {
-			return nil, internalErrors.NewInternalServiceError("Error parsing events: " + err.Error())
-		}
-
-		if len(events) > 0 {
-			result.Events = &events
-		}
-	}
-
 	return &result, nil
 }
 
-func (repository *PersonRepository) buildApplicationsCoalesceAndJoin(
-	includeApplications models.IncludeExtraDataType) (string, string) {
-
-	if includeApplications == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
-	}
-
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', a.id%s
-				) ORDER BY a.created_date DESC
-			) FILTER (WHERE a.id IS NOT NULL),
-			JSON_ARRAY()
-		) as applications
-		`
-
-	allColumns := ""
-	if includeApplications == models.IncludeExtraDataTypeAll {
-		allColumns = `,
-					'CompanyID', a.company_id,
-					'RecruiterID', a.recruiter_id,
-					'JobTitle', a.job_title,
-					'JobAdURL', a.job_ad_url,
-					'Country', a.country,
-					'Area', a.area,
-					'RemoteStatusType', a.remote_status_type,
-					'WeekdaysInOffice', a.weekdays_in_office,
-					'EstimatedCycleTime', a.estimated_cycle_time,
-					'EstimatedCommuteTime', a.estimated_commute_time,
-					'ApplicationDate', a.application_date,
-					'CreatedDate', a.created_date,
-					'UpdatedDate', a.updated_date`
-	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
-
-	joinString := `
-		LEFT JOIN application_person ap ON ap.person_id = p.id 
-		LEFT JOIN application a ON a.id = ap.application_id `
-
-	return coalesceString, joinString
-}
-
-func (repository *PersonRepository) buildCompaniesCoalesceAndJoin(
-	includeCompanies models.IncludeExtraDataType) (string, string) {
+// loadRelations adds the companies, events and applications to persons, as selected by the include types. Each
+// relation is loaded with batched queries, instead of joins that repeat each person for every relation.
+//
+// loadRelations can return InternalServiceError
+func (repository *PersonRepository) loadRelations(
+	ctx context.Context,
+	persons []*models.Person,
+	includeCompanies models.IncludeExtraDataType,
+	includeEvents models.IncludeExtraDataType,
+	includeApplications models.IncludeExtraDataType) error {
 
-	if includeCompanies == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+	personIDs := make([]uuid.UUID, len(persons))
+	for index, person := range persons {
+		personIDs[index] = person.ID
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', c.id%s
-				) ORDER BY c.created_date DESC
-			) FILTER (WHERE c.id IS NOT NULL),
-			JSON_ARRAY()
-		) as companies`
+	if includeCompanies != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "company_person", ownerColumn: "person_id", relatedColumn: "company_id", relatedTable: "company"}
 
-	allColumns := ""
-	if includeCompanies == models.IncludeExtraDataTypeAll {
-		allColumns = `, 
-					'Name', c.name, 
-					'CompanyType', c.company_type, 
-					'Notes', c.notes, 
-					'LastContact', c.last_contact, 
-					'CreatedDate', c.created_date, 
-					'UpdatedDate', c.updated_date `
+		// can return InternalServiceError
+		companies, err := loadRelatedCompanies(
+			ctx, repository.database, repository.queryTimeout, link, personIDs, includeCompanies)
+		if err != nil {
+			return err
+		}
+		for _, person := range persons {
+			if personCompanies := companies[person.ID]; len(personCompanies) > 0 {
+				person.Companies = &personCompanies
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
 
-	joinString := `
-		LEFT JOIN company_person cp ON cp.person_id = p.id 
-		LEFT JOIN company c ON c.id = cp.company_id `
+	if includeEvents != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable: "event_person", ownerColumn: "person_id", relatedColumn: "event_id", relatedTable: "event"}
 
-	return coalesceString, joinString
-}
-
-func (repository *PersonRepository) buildEventsCoalesceAndJoin(
-	includeEvents models.IncludeExtraDataType) (string, string) {
-
-	if includeEvents == models.IncludeExtraDataTypeNone {
-		return "null \n", ""
+		// can return InternalServiceError
+		events, err := loadRelatedEvents(
+			ctx, repository.database, repository.queryTimeout, link, personIDs, includeEvents)
+		if err != nil {
+			return err
+		}
+		for _, person := range persons {
+			if personEvents := events[person.ID]; len(personEvents) > 0 {
+				person.Events = &personEvents
+			}
+		}
 	}
 
-	coalesceString := `
-		COALESCE(
-			JSON_GROUP_ARRAY(
-				DISTINCT JSON_OBJECT(
-					'ID', e.id%s
-				) ORDER BY e.event_date DESC
-			) FILTER (WHERE e.id IS NOT NULL),
-			JSON_ARRAY()
-		) as events`
+	if includeApplications != models.IncludeExtraDataTypeNone {
+		link := relationLink{
+			linkTable:     "application_person",
+			ownerColumn:   "person_id",
+			relatedColumn: "application_id",
+			relatedTable:  "application",
+		}
 
-	allColumns := ""
-	if includeEvents == models.IncludeExtraDataTypeAll {
-		allColumns = `, 
-					'EventType', e.event_type, 
-					'Description', e.description,  
-					'Notes', e.notes, 
-					'EventDate', e.event_date, 
-					'CreatedDate', e.created_date, 
-					'UpdatedDate', e.updated_date `
+		// can return InternalServiceError
+		applications, err := loadRelatedApplications(
+			ctx, repository.database, repository.queryTimeout, link, personIDs, includeApplications, false)
+		if err != nil {
+			return err
+		}
+		for _, person := range persons {
+			if personApplications := applications[person.ID]; len(personApplications) > 0 {
+				person.Applications = &personApplications
+			}
+		}
 	}
-	coalesceString = fmt.Sprintf(coalesceString, allColumns)
 
-	joinString := `
-		LEFT JOIN event_person ep ON ep.person_id = p.id 
-		LEFT JOIN event e ON e.id = ep.event_id `
-
-	return coalesceString, joinString
+	return nil
 }
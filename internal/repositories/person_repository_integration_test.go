@@ -1,6 +1,7 @@
 package repositories_test
 
 import (
+	"context"
 	"errors"
 	"jobsearchtracker/internal/api/v1/requests"
 	configPackage "jobsearchtracker/internal/config"
@@ -100,7 +101,7 @@ func TestCreate_ShouldInsertPerson(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 	}
 
-	insertedPerson, err := personRepository.Create(&person)
+	insertedPerson, err := personRepository.Create(context.Background(), &person)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
@@ -123,7 +124,7 @@ func TestCreate_ShouldInsertPersonWithMinimumRequiredFields(t *testing.T) {
 	}
 
 	createdDateApproximation := time.Now()
-	insertedPerson, err := personRepository.Create(&person)
+	insertedPerson, err := personRepository.Create(context.Background(), &person)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
@@ -147,7 +148,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicatePersonId(t *testing.T) {
 		Name:       "Not Real",
 		PersonType: models.PersonTypeJobContact,
 	}
-	insertedPerson1, err := personRepository.Create(&person1)
+	insertedPerson1, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson1)
 	assert.NotNil(t, insertedPerson1.ID)
@@ -157,7 +158,7 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicatePersonId(t *testing.T) {
 		Name:       "Never Duplicated",
 		PersonType: models.PersonTypeJobAdvertiser,
 	}
-	insertedPerson2, err := personRepository.Create(&person2)
+	insertedPerson2, err := personRepository.Create(context.Background(), &person2)
 	assert.Nil(t, insertedPerson2)
 	assert.Error(t, err)
 
@@ -168,6 +169,31 @@ func TestCreate_ShouldReturnConflictErrorOnDuplicatePersonId(t *testing.T) {
 		conflictError.Error())
 }
 
+func TestCreate_ShouldReturnConflictErrorOnDuplicatePersonEmail(t *testing.T) {
+	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
+
+	person1 := models.CreatePerson{
+		Name:       "Not Real",
+		PersonType: models.PersonTypeJobContact,
+		Email:      testutil.ToPtr("some@email.tld"),
+	}
+	_, err := personRepository.Create(context.Background(), &person1)
+	assert.NoError(t, err)
+
+	person2 := models.CreatePerson{
+		Name:       "Never Duplicated",
+		PersonType: models.PersonTypeJobAdvertiser,
+		Email:      testutil.ToPtr("some@email.tld"),
+	}
+	insertedPerson2, err := personRepository.Create(context.Background(), &person2)
+	assert.Nil(t, insertedPerson2)
+	assert.Error(t, err)
+
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+	assert.Equal(t, "conflict error on insert: Email already exists in database.", conflictError.Error())
+}
+
 // -------- GetById tests: --------
 
 func TestGetById_ShouldGetPerson(t *testing.T) {
@@ -178,11 +204,11 @@ func TestGetById_ShouldGetPerson(t *testing.T) {
 		Name:       "Joe Sparks",
 		PersonType: models.PersonTypeDeveloper,
 	}
-	insertedPerson, err := personRepository.Create(&personToInsert)
+	insertedPerson, err := personRepository.Create(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
-	retrievedPerson, err := personRepository.GetById(personToInsert.ID)
+	retrievedPerson, err := personRepository.GetById(context.Background(), personToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPerson)
 
@@ -194,7 +220,7 @@ func TestGetById_ShouldReturnNotFoundErrorIfPersonIDDoesNotExist(t *testing.T) {
 
 	id := uuid.New()
 
-	person, err := personRepository.GetById(&id)
+	person, err := personRepository.GetById(context.Background(), &id)
 	assert.Nil(t, person)
 	assert.Error(t, err)
 
@@ -215,11 +241,11 @@ func TestGetAllByName_ShouldReturnPerson(t *testing.T) {
 		Name:       "John Smith",
 		PersonType: models.PersonTypeDeveloper,
 	}
-	insertedPerson, err := personRepository.Create(&personToInsert)
+	insertedPerson, err := personRepository.Create(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
-	retrievedPersons, err := personRepository.GetAllByName(insertedPerson.Name)
+	retrievedPersons, err := personRepository.GetAllByName(context.Background(), insertedPerson.Name)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPersons)
 	assert.Len(t, retrievedPersons, 1)
@@ -235,7 +261,7 @@ func TestGetAllByName_ShouldReturnNotFoundErrorIfPersonNameDoesNotExist(t *testi
 
 	name := "Doesnt Exist"
 
-	person, err := personRepository.GetAllByName(&name)
+	person, err := personRepository.GetAllByName(context.Background(), &name)
 	assert.Nil(t, person)
 	assert.Error(t, err)
 
@@ -257,7 +283,7 @@ func TestGetAllByName_ShouldReturnMultiplePersonsWithSameNameSubstring(t *testin
 		Name:       "frank john",
 		PersonType: models.PersonTypeCEO,
 	}
-	insertedPerson1, err := personRepository.Create(&person1)
+	insertedPerson1, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson1)
 
@@ -266,7 +292,7 @@ func TestGetAllByName_ShouldReturnMultiplePersonsWithSameNameSubstring(t *testin
 		Name:       "Frank Jones",
 		PersonType: models.PersonTypeCEO,
 	}
-	insertedPerson2, err := personRepository.Create(&person2)
+	insertedPerson2, err := personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson2)
 
@@ -275,14 +301,14 @@ func TestGetAllByName_ShouldReturnMultiplePersonsWithSameNameSubstring(t *testin
 		Name:       "Frank John",
 		PersonType: models.PersonTypeCEO,
 	}
-	insertedPerson3, err := personRepository.Create(&person3)
+	insertedPerson3, err := personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson3)
 
 	// get humans with name Frank John
 	frankJohn := "Frank John"
 
-	retrievedPersons, err := personRepository.GetAllByName(&frankJohn)
+	retrievedPersons, err := personRepository.GetAllByName(context.Background(), &frankJohn)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPersons)
 	assert.Len(t, retrievedPersons, 2)
@@ -294,6 +320,22 @@ func TestGetAllByName_ShouldReturnMultiplePersonsWithSameNameSubstring(t *testin
 	assert.Equal(t, insertedPerson1.ID, foundPerson2.ID)
 }
 
+// -------- GetAllByIDs tests: --------
+
+func TestGetAllByIDs_ShouldReturnPersonsInOrderOfIDs(t *testing.T) {
+	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
+
+	person1 := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	person2 := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+
+	persons, err := personRepository.GetAllByIDs(context.Background(), []uuid.UUID{person2.ID, uuid.New(), person1.ID})
+	assert.NoError(t, err)
+	assert.Len(t, persons, 2)
+	assert.Equal(t, person2.ID, persons[0].ID)
+	assert.Equal(t, person1.ID, persons[1].ID)
+	assert.Equal(t, person1.Name, persons[1].Name)
+}
+
 // -------- GetAll - Base tests: --------
 
 func TestGetAll_ShouldReturnAllPersons(t *testing.T) {
@@ -311,7 +353,7 @@ func TestGetAll_ShouldReturnAllPersons(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	insertedPerson1, err := personRepository.Create(&person1)
+	insertedPerson1, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson1)
 
@@ -321,12 +363,13 @@ func TestGetAll_ShouldReturnAllPersons(t *testing.T) {
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	insertedPerson2, err := personRepository.Create(&person2)
+	insertedPerson2, err := personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson2)
 
 	// get all humans
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -350,6 +393,7 @@ func TestGetAll_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -399,7 +443,7 @@ func TestPersonRepositoryGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsS
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -419,6 +463,7 @@ func TestPersonRepositoryGetAll_ShouldReturnApplicationsIfIncludeApplicationsIsS
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -470,6 +515,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsI
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -510,7 +556,7 @@ func TestPersonRepositoryGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsI
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -529,6 +575,7 @@ func TestPersonRepositoryGetAll_ShouldReturnApplicationIDsIfIncludeApplicationsI
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -577,6 +624,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsI
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -616,6 +664,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoApplicationsIfIncludeApplicationsI
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -641,7 +690,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personRepository.Create(&person1)
+	_, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -650,7 +699,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person2)
+	_, err = personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -659,7 +708,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personRepository.Create(&person3)
+	_, err = personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -673,7 +722,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -681,7 +730,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -690,26 +739,27 @@ func TestPersonRepositoryGetAll_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAl
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -752,7 +802,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personRepository.Create(&person1)
+	_, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -761,7 +811,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person2)
+	_, err = personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -770,7 +820,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personRepository.Create(&person3)
+	_, err = personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -784,7 +834,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -792,12 +842,13 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -830,7 +881,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personRepository.Create(&person1)
+	_, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -839,7 +890,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person2)
+	_, err = personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -848,7 +899,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personRepository.Create(&person3)
+	_, err = personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -862,7 +913,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -870,7 +921,7 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -879,26 +930,27 @@ func TestPersonRepositoryGetAll_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToI
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -938,7 +990,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personRepository.Create(&person1)
+	_, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -947,7 +999,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person2)
+	_, err = personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -956,7 +1008,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personRepository.Create(&person3)
+	_, err = personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -970,7 +1022,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -978,12 +1030,13 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1016,7 +1069,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personRepository.Create(&person1)
+	_, err := personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -1025,7 +1078,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person2)
+	_, err = personRepository.Create(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -1034,7 +1087,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personRepository.Create(&person3)
+	_, err = personRepository.Create(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -1048,7 +1101,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -1056,7 +1109,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -1065,26 +1118,27 @@ func TestPersonRepositoryGetAll_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetTo
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1123,7 +1177,7 @@ func TestPersonRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -1141,6 +1195,7 @@ func TestPersonRepositoryGetAll_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *t
 	// get all persons
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1176,6 +1231,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToAllAnd
 	// get all persons
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1207,7 +1263,7 @@ func TestPersonRepositoryGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToIds(t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -1225,6 +1281,7 @@ func TestPersonRepositoryGetAll_ShouldReturnEventIDsIfIncludeEventsIsSetToIds(t
 	// get all persons
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1260,6 +1317,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoEventIDsIfIncludeEventsIsSetToIDsA
 	// get all persons
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1296,6 +1354,7 @@ func TestPersonRepositoryGetAll_ShouldReturnNoEventsIfIncludeEventsIsSetToNone(t
 	// get all persons
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1343,6 +1402,7 @@ func TestPersonRepositoryGetAll_ShouldReturnTwoPersonsEvenIfOneApplicationIsShar
 	// ensure that two companies are returned
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1381,6 +1441,7 @@ func TestPersonRepositoryGetAll_ShouldReturnTwoPersonsEvenIfOneCompanyIsSharedBe
 	// ensure that two companies are returned
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1419,6 +1480,7 @@ func TestPersonRepositoryGetAll_ShouldReturnTwoPersonsEvenIfOneEventIsSharedBetw
 	// ensure that two companies are returned
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1467,6 +1529,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithOneApplicationAndTwoCompan
 
 	// Ensure that the person is returned with one company and two companies
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1519,6 +1582,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoApplicationsAndOneCompa
 
 	// Ensure that the person is returned with one company and two companies
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1583,6 +1647,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoApplicationsAndTwoCompa
 
 	// Ensure that the person is returned with one company and two companies
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1639,6 +1704,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithOneApplicationAndTwoEvents
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1695,6 +1761,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoApplicationsAndOneEvent
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1765,6 +1832,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoApplicationsAndTwoEvent
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -1819,6 +1887,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithOneCompanyAndTwoEvents(t *
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1869,6 +1938,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoCompaniesAndOneEvent(t
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1932,6 +2002,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoCompaniesAndTwoEvents(t
 
 	// Ensure that the person is returned with one company and two events
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -2017,6 +2088,7 @@ func TestPersonRepositoryGetAll_ShouldReturnPersonWithTwoApplicationsAndTwoCompa
 	// Ensure that the person is returned with one company and two events
 
 	results, err := personRepository.GetAll(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeIDs)
@@ -2049,7 +2121,7 @@ func TestUpdate_ShouldUpdatePerson(t *testing.T) {
 		Name:       "Arr Grr",
 		PersonType: models.PersonTypeOther,
 	}
-	insertedPerson, err := personRepository.Create(&personToInsert)
+	insertedPerson, err := personRepository.Create(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
@@ -2066,11 +2138,11 @@ func TestUpdate_ShouldUpdatePerson(t *testing.T) {
 	// update the person
 
 	updatedDateApproximation := time.Now()
-	err = personRepository.Update(&personToUpdate)
+	err = personRepository.Update(context.Background(), &personToUpdate)
 	assert.NoError(t, err)
 
 	// get the company and verify that it's updated
-	retrievedPerson, err := personRepository.GetById(&id)
+	retrievedPerson, err := personRepository.GetById(context.Background(), &id)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPerson)
 
@@ -2094,7 +2166,7 @@ func TestUpdate_ShouldNotReturnErrorIfPersonDoesNotExist(t *testing.T) {
 		Name: &name,
 	}
 
-	err := personRepository.Update(&personToUpdate)
+	err := personRepository.Update(context.Background(), &personToUpdate)
 	assert.NoError(t, err)
 }
 
@@ -2109,13 +2181,13 @@ func TestDelete_ShouldDeletePerson(t *testing.T) {
 		Name:       "Some Name",
 		PersonType: models.PersonTypeUnknown,
 	}
-	_, err := personRepository.Create(&personToAdd)
+	_, err := personRepository.Create(context.Background(), &personToAdd)
 	assert.NoError(t, err)
 
-	err = personRepository.Delete(&id)
+	err = personRepository.Delete(context.Background(), &id, nil)
 	assert.NoError(t, err)
 
-	retrievedPerson, err := personRepository.GetById(&id)
+	retrievedPerson, err := personRepository.GetById(context.Background(), &id)
 	assert.Nil(t, retrievedPerson)
 	assert.Error(t, err)
 }
@@ -2124,10 +2196,118 @@ func TestDelete_ShouldReturnNotFoundErrorIfPersonIdDoesNotExist(t *testing.T) {
 	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
 
 	id := uuid.New()
-	err := personRepository.Delete(&id)
+	err := personRepository.Delete(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: Person does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+func TestUpdateAndDelete_ShouldReturnPreconditionFailedErrorIfPersonVersionDoesNotMatch(t *testing.T) {
+	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
+
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	assert.Equal(t, 1, person.Version)
+
+	err := personRepository.Update(context.Background(), &models.UpdatePerson{
+		ID:              person.ID,
+		Name:            testutil.ToPtr("New name"),
+		ExpectedVersion: testutil.ToPtr(2),
+	})
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	err = personRepository.Delete(context.Background(), &person.ID, testutil.ToPtr(2))
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	retrievedPerson, err := personRepository.GetById(context.Background(), &person.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, retrievedPerson.Version)
+	assert.Equal(t, "PersonName", *retrievedPerson.Name)
+}
+
+// -------- MoveReferences tests: --------
+
+func TestMoveReferences_ShouldMoveCompaniesApplicationsAndEventsToOtherPerson(t *testing.T) {
+	personRepository,
+		applicationRepository,
+		companyRepository,
+		eventRepository,
+		applicationPersonRepository,
+		companyPersonRepository,
+		eventPersonRepository := setupPersonRepository(t)
+
+	survivor := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+	duplicate := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, survivor.ID, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, duplicate.ID, nil)
+
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &company.ID, nil, nil)
+	repositoryhelpers.AssociateApplicationPerson(t, applicationPersonRepository, application.ID, duplicate.ID, nil)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	repositoryhelpers.AssociateEventPerson(t, eventPersonRepository, event.ID, duplicate.ID, nil)
+
+	err := personRepository.MoveReferences(context.Background(), &duplicate.ID, &survivor.ID)
+	assert.NoError(t, err)
+
+	survivorCompanies, err := companyPersonRepository.GetByID(context.Background(), nil, &survivor.ID)
+	assert.NoError(t, err)
+	assert.Len(t, survivorCompanies, 1)
+
+	survivorApplications, err := applicationPersonRepository.GetByID(context.Background(), nil, &survivor.ID)
+	assert.NoError(t, err)
+	assert.Len(t, survivorApplications, 1)
+	assert.Equal(t, application.ID, survivorApplications[0].ApplicationID)
+
+	survivorEvents, err := eventPersonRepository.GetByID(context.Background(), nil, &survivor.ID)
+	assert.NoError(t, err)
+	assert.Len(t, survivorEvents, 1)
+	assert.Equal(t, event.ID, survivorEvents[0].EventID)
+
+	// Nothing references the duplicate anymore, so it can be deleted
+	err = personRepository.Delete(context.Background(), &duplicate.ID, nil)
+	assert.NoError(t, err)
+}
+
+func TestMoveReferences_ShouldKeepLinkDetailsOfMovedReferences(t *testing.T) {
+	personRepository, _, _, eventRepository, _, _, eventPersonRepository := setupPersonRepository(t)
+
+	survivor := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+	duplicate := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	_, err := eventPersonRepository.AssociateEventPerson(context.Background(), &models.AssociateEventPerson{
+		EventID:  event.ID,
+		PersonID: duplicate.ID,
+		LinkDetails: models.LinkDetails{
+			Role:  testutil.ToPtr("Interviewer"),
+			Notes: testutil.ToPtr("Asked about system design"),
+		},
+	})
+	assert.NoError(t, err)
+
+	err = personRepository.MoveReferences(context.Background(), &duplicate.ID, &survivor.ID)
+	assert.NoError(t, err)
+
+	survivorEvents, err := eventPersonRepository.GetByID(context.Background(), nil, &survivor.ID)
+	assert.NoError(t, err)
+	assert.Len(t, survivorEvents, 1)
+	assert.Equal(t, "Interviewer", *survivorEvents[0].Role)
+	assert.Equal(t, "Asked about system design", *survivorEvents[0].Notes)
+}
+
+func TestMoveReferences_ShouldReturnValidationErrorIfPersonIDIsNil(t *testing.T) {
+	personRepository, _, _, _, _, _, _ := setupPersonRepository(t)
+
+	err := personRepository.MoveReferences(context.Background(), testutil.ToPtr(uuid.New()), nil)
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: fromID and toID are required", validationError.Error())
+}
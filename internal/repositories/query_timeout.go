@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// withQueryTimeout bounds ctx by the configured query timeout, so that a slow query is cancelled even if the caller
+// waits. A timeout of 0 leaves ctx unbounded.
+func withQueryTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,26 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithQueryTimeout_ShouldSetDeadline(t *testing.T) {
+	ctx, cancel := withQueryTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	deadline, hasDeadline := ctx.Deadline()
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
+
+func TestWithQueryTimeout_ShouldNotSetDeadlineIfTimeoutIsZero(t *testing.T) {
+	ctx, cancel := withQueryTimeout(context.Background(), 0)
+	defer cancel()
+
+	_, hasDeadline := ctx.Deadline()
+	assert.False(t, hasDeadline)
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// buildIDPlaceholders returns the placeholders and the arguments of an `id IN (...)` clause for ids. Duplicate IDs are
+// only included once.
+func buildIDPlaceholders(ids []uuid.UUID) (string, []interface{}) {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	sqlVars := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			sqlVars = append(sqlVars, id)
+		}
+	}
+
+	return strings.TrimSuffix(strings.Repeat("?, ", len(sqlVars)), ", "), sqlVars
+}
+
+// orderByIDs returns records in the order of ids, once each. IDs without a record are skipped.
+func orderByIDs[Record any](ids []uuid.UUID, records []*Record, getID func(record *Record) uuid.UUID) []*Record {
+	recordsByID := make(map[uuid.UUID]*Record, len(records))
+	for _, record := range records {
+		recordsByID[getID(record)] = record
+	}
+
+	ordered := make([]*Record, 0, len(records))
+	for _, id := range ids {
+		if record, ok := recordsByID[id]; ok {
+			ordered = append(ordered, record)
+			delete(recordsByID, id)
+		}
+	}
+
+	return ordered
+}
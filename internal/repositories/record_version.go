@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// getVersionMismatchError is used when an update or delete that is conditional on the version of a record affected no
+// rows. It tells a record that does not exist apart from one that has been changed since the caller read it. idColumn
+// is the primary key of table.
+//
+// getVersionMismatchError can return InternalServiceError, NotFoundError, PreconditionFailedError
+func getVersionMismatchError(
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	table string,
+	idColumn string,
+	entityName string,
+	id uuid.UUID,
+	expectedVersion int) error {
+
+	logger := logging.FromContext(ctx)
+
+	ctx, cancel := withQueryTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var currentVersion int
+	sqlSelect := "SELECT version FROM " + table + " WHERE " + idColumn + " = ?"
+	err := database.QueryRowContext(ctx, sqlSelect, id).Scan(&currentVersion)
+	if errors.Is(err, sql.ErrNoRows) {
+		return internalErrors.NewNotFoundError(entityName + " does not exist. ID: " + id.String())
+	} else if err != nil {
+		logger.Error(
+			"repositories.getVersionMismatchError: Error reading version", "table", table, "id", id, "error", err)
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	logger.Info(
+		"repositories.getVersionMismatchError: version does not match",
+		"table", table,
+		"id", id,
+		"expectedVersion", expectedVersion,
+		"currentVersion", currentVersion)
+	return internalErrors.NewPreconditionFailedError(fmt.Sprintf(
+		"%s has been changed since it was read. Expected version %d, current version is %d",
+		entityName, expectedVersion, currentVersion))
+}
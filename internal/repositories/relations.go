@@ -0,0 +1,274 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// relationBatchSize is the number of records that relations are loaded for per query. It keeps the number of SQL
+// variables far below the SQLite limit.
+const relationBatchSize = 500
+
+// The columns read by the mapRow of each repository, for the related record of buildRelatedSelect.
+const (
+	sqlRelatedApplicationColumns = `related.id, related.company_id, related.recruiter_id, related.job_title,
+			related.job_ad_url, related.country, related.area, related.remote_status_type, related.weekdays_in_office,
+			related.estimated_cycle_time, related.estimated_commute_time, related.application_date,
+			related.created_date, related.updated_date, related.version`
+	sqlRelatedCompanyColumns = `related.id, related.name, related.company_type, related.notes, related.last_contact,
+			related.created_date, related.updated_date, related.version`
+	sqlRelatedEventColumns = `related.id, related.event_type, related.description, related.notes, related.event_date,
+			related.duration_minutes, related.location, related.meeting_url, related.interview_format,
+			related.timezone, related.created_date, related.updated_date, related.version`
+	sqlRelatedPersonColumns = `related.id, related.name, related.person_type, related.email, related.phone,
+			related.notes, related.created_date, related.updated_date, related.version`
+)
+
+// relationLink describes how records are linked to the records of relatedTable. Each row of linkTable, which can be a
+// subquery, pairs the ID of a record in ownerColumn with the ID of a related record in relatedColumn.
+type relationLink struct {
+	linkTable     string
+	ownerColumn   string
+	relatedColumn string
+	relatedTable  string
+}
+
+// buildRelatedSelect returns the query for the owner ID and the columns of the records related by link to the owners
+// in placeholders, ordered by orderBy. The related table is aliased as related. The CROSS JOIN makes SQLite read the
+// link table first. Otherwise it can read the related table first, and look up every owner for every related record.
+func buildRelatedSelect(link relationLink, columns string, orderBy string, placeholders string) string {
+	return `
+		SELECT link.` + link.ownerColumn + `, ` + columns + `
+		FROM ` + link.linkTable + ` link
+		CROSS JOIN ` + link.relatedTable + ` related ON related.id = link.` + link.relatedColumn + `
+		WHERE link.` + link.ownerColumn + ` IN (` + placeholders + `)
+		ORDER BY ` + orderBy
+}
+
+// ownerScanner scans the owner ID of a row of buildRelatedSelect, so that the rest of the row can be scanned by the
+// mapRow of the related repository.
+type ownerScanner struct {
+	rows    *sql.Rows
+	ownerID *uuid.UUID
+}
+
+func (scanner ownerScanner) Scan(dest ...interface{}) error {
+	return scanner.rows.Scan(append([]interface{}{scanner.ownerID}, dest...)...)
+}
+
+// loadRelated returns the records related by link to ownerIDs, by owner ID, in the order of orderBy. The records are
+// loaded relationBatchSize owners at a time, and mapped from columns by mapRelated.
+//
+// loadRelated can return InternalServiceError
+func loadRelated[Related any](
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	columns string,
+	orderBy string,
+	mapRelated func(scanner interface{ Scan(...interface{}) error }) (*Related, error),
+) (map[uuid.UUID][]*Related, error) {
+
+	results := make(map[uuid.UUID][]*Related)
+	for start := 0; start < len(ownerIDs); start += relationBatchSize {
+		batch := ownerIDs[start:min(start+relationBatchSize, len(ownerIDs))]
+
+		// can return InternalServiceError
+		err := loadRelatedBatch(ctx, database, queryTimeout, link, batch, columns, orderBy, mapRelated, results)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// loadRelatedBatch adds the records related by link to ownerIDs to results.
+//
+// loadRelatedBatch can return InternalServiceError
+func loadRelatedBatch[Related any](
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	columns string,
+	orderBy string,
+	mapRelated func(scanner interface{ Scan(...interface{}) error }) (*Related, error),
+	results map[uuid.UUID][]*Related) error {
+
+	logger := logging.FromContext(ctx)
+
+	placeholders, sqlVars := buildIDPlaceholders(ownerIDs)
+	sqlSelect := buildRelatedSelect(link, columns, orderBy, placeholders)
+
+	ctx, cancel := withQueryTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	rows, err := database.QueryContext(ctx, sqlSelect, sqlVars...)
+	if err != nil {
+		logger.Error("repositories.loadRelated: Error querying "+link.relatedTable, "error", err)
+		return internalErrors.NewInternalServiceError(
+			"Error reading " + link.relatedTable + " relations from database: " + err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ownerID uuid.UUID
+		related, err := mapRelated(ownerScanner{rows: rows, ownerID: &ownerID})
+		if err != nil {
+			logger.Error("repositories.loadRelated: Error mapping "+link.relatedTable, "error", err)
+			return internalErrors.NewInternalServiceError(
+				"Error processing " + link.relatedTable + " data: " + err.Error())
+		}
+		results[ownerID] = append(results[ownerID], related)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.Error("repositories.loadRelated: Error iterating "+link.relatedTable+" rows", "error", err)
+		return internalErrors.NewInternalServiceError(
+			"Error reading " + link.relatedTable + " relations from database: " + err.Error())
+	}
+
+	return nil
+}
+
+// loadRelatedApplications returns the applications related by link to ownerIDs, newest first, by owner ID. With
+// IncludeExtraDataTypeIDs only their IDs are loaded, and also their company and recruiter IDs if withCompanyIDs is
+// true.
+//
+// loadRelatedApplications can return InternalServiceError
+func loadRelatedApplications(
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	includeApplications models.IncludeExtraDataType,
+	withCompanyIDs bool) (map[uuid.UUID][]*models.Application, error) {
+
+	orderBy := "related.created_date DESC"
+
+	if includeApplications == models.IncludeExtraDataTypeAll {
+		applicationRepository := NewApplicationRepository(database, queryTimeout)
+		return loadRelated(ctx, database, queryTimeout, link, ownerIDs, sqlRelatedApplicationColumns, orderBy,
+			func(scanner interface{ Scan(...interface{}) error }) (*models.Application, error) {
+				return applicationRepository.mapRow(ctx, scanner, "loadRelatedApplications")
+			})
+	}
+
+	if withCompanyIDs {
+		columns := "related.id, related.company_id, related.recruiter_id"
+		return loadRelated(ctx, database, queryTimeout, link, ownerIDs, columns, orderBy,
+			func(scanner interface{ Scan(...interface{}) error }) (*models.Application, error) {
+				var application models.Application
+				err := scanner.Scan(&application.ID, &application.CompanyID, &application.RecruiterID)
+				return &application, err
+			})
+	}
+
+	return loadRelated(ctx, database, queryTimeout, link, ownerIDs, "related.id", orderBy,
+		func(scanner interface{ Scan(...interface{}) error }) (*models.Application, error) {
+			var application models.Application
+			err := scanner.Scan(&application.ID)
+			return &application, err
+		})
+}
+
+// loadRelatedCompanies returns the companies related by link to ownerIDs, newest first, by owner ID. With
+// IncludeExtraDataTypeIDs only their IDs are loaded.
+//
+// loadRelatedCompanies can return InternalServiceError
+func loadRelatedCompanies(
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	includeCompanies models.IncludeExtraDataType) (map[uuid.UUID][]*models.Company, error) {
+
+	orderBy := "related.created_date DESC"
+
+	if includeCompanies == models.IncludeExtraDataTypeAll {
+		companyRepository := NewCompanyRepository(database, queryTimeout)
+		return loadRelated(ctx, database, queryTimeout, link, ownerIDs, sqlRelatedCompanyColumns, orderBy,
+			func(scanner interface{ Scan(...interface{}) error }) (*models.Company, error) {
+				return companyRepository.mapRow(ctx, scanner, "loadRelatedCompanies", nil)
+			})
+	}
+
+	return loadRelated(ctx, database, queryTimeout, link, ownerIDs, "related.id", orderBy,
+		func(scanner interface{ Scan(...interface{}) error }) (*models.Company, error) {
+			var company models.Company
+			err := scanner.Scan(&company.ID)
+			return &company, err
+		})
+}
+
+// loadRelatedEvents returns the events related by link to ownerIDs, latest first, by owner ID. With
+// IncludeExtraDataTypeIDs only their IDs are loaded.
+//
+// loadRelatedEvents can return InternalServiceError
+func loadRelatedEvents(
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	includeEvents models.IncludeExtraDataType) (map[uuid.UUID][]*models.Event, error) {
+
+	orderBy := "related.event_date DESC"
+
+	if includeEvents == models.IncludeExtraDataTypeAll {
+		eventRepository := NewEventRepository(database, queryTimeout)
+		return loadRelated(ctx, database, queryTimeout, link, ownerIDs, sqlRelatedEventColumns, orderBy,
+			func(scanner interface{ Scan(...interface{}) error }) (*models.Event, error) {
+				return eventRepository.mapRow(ctx, scanner, "loadRelatedEvents")
+			})
+	}
+
+	return loadRelated(ctx, database, queryTimeout, link, ownerIDs, "related.id", orderBy,
+		func(scanner interface{ Scan(...interface{}) error }) (*models.Event, error) {
+			var event models.Event
+			err := scanner.Scan(&event.ID)
+			return &event, err
+		})
+}
+
+// loadRelatedPersons returns the persons related by link to ownerIDs, newest first, by owner ID. With
+// IncludeExtraDataTypeIDs only their IDs are loaded.
+//
+// loadRelatedPersons can return InternalServiceError
+func loadRelatedPersons(
+	ctx context.Context,
+	database Executor,
+	queryTimeout time.Duration,
+	link relationLink,
+	ownerIDs []uuid.UUID,
+	includePersons models.IncludeExtraDataType) (map[uuid.UUID][]*models.Person, error) {
+
+	orderBy := "related.created_date DESC"
+
+	if includePersons == models.IncludeExtraDataTypeAll {
+		personRepository := NewPersonRepository(database, queryTimeout)
+		return loadRelated(ctx, database, queryTimeout, link, ownerIDs, sqlRelatedPersonColumns, orderBy,
+			func(scanner interface{ Scan(...interface{}) error }) (*models.Person, error) {
+				return personRepository.mapRow(ctx, scanner, "loadRelatedPersons")
+			})
+	}
+
+	return loadRelated(ctx, database, queryTimeout, link, ownerIDs, "related.id", orderBy,
+		func(scanner interface{ Scan(...interface{}) error }) (*models.Person, error) {
+			var person models.Person
+			err := scanner.Scan(&person.ID)
+			return &person, err
+		})
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- buildRelatedSelect tests: --------
+
+func TestBuildRelatedSelect_ShouldSelectOwnerIDAndColumnsOfRelatedRecords(t *testing.T) {
+	link := relationLink{
+		linkTable:     "application_person",
+		ownerColumn:   "application_id",
+		relatedColumn: "person_id",
+		relatedTable:  "person",
+	}
+
+	sqlSelect := buildRelatedSelect(link, "related.id", "related.created_date DESC", "?, ?")
+
+	expectedSelect := `
+		SELECT link.application_id, related.id
+		FROM application_person link
+		CROSS JOIN person related ON related.id = link.person_id
+		WHERE link.application_id IN (?, ?)
+		ORDER BY related.created_date DESC`
+	assert.Equal(t, expectedSelect, sqlSelect)
+}
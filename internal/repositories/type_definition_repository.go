@@ -0,0 +1,354 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/utils"
+	"jobsearchtracker/pkg/timeutil"
+	"time"
+)
+
+// typeDefinitionTables maps each TypeKind to its lookup table. Table names cannot be query parameters, so only the
+// names in this map are ever put into a query.
+var typeDefinitionTables = map[models.TypeKind]string{
+	models.TypeKindCompany: "company_type",
+	models.TypeKindEvent:   "event_type",
+	models.TypeKindPerson:  "person_type",
+}
+
+type TypeDefinitionRepository struct {
+	database     Executor
+	queryTimeout time.Duration
+}
+
+func NewTypeDefinitionRepository(database Executor, queryTimeout time.Duration) *TypeDefinitionRepository {
+	return &TypeDefinitionRepository{database: database, queryTimeout: queryTimeout}
+}
+
+// Create can return ConflictError, InternalServiceError, ValidationError
+func (repository *TypeDefinitionRepository) Create(
+	ctx context.Context, typeDefinition *models.CreateTypeDefinition) (*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	table, err := repository.getTable(ctx, typeDefinition.Kind, "Create")
+	if err != nil {
+		return nil, err
+	}
+
+	sqlInsert := `
+		INSERT INTO ` + table + ` (
+			name, display_name, sort_order, pipeline_stage, is_built_in, created_date
+		) VALUES (?, ?, ?, ?, FALSE, ?)
+		RETURNING name, display_name, sort_order, pipeline_stage, is_built_in, created_date, updated_date; `
+
+	var createdDate string
+	if typeDefinition.CreatedDate != nil {
+		createdDate = typeDefinition.CreatedDate.Format(timeutil.RFC3339Milli_Write)
+	} else {
+		createdDate = time.Now().Format(timeutil.RFC3339Milli_Write)
+	}
+
+	var pipelineStage *string
+	if typeDefinition.PipelineStage != nil {
+		pipelineStageString := typeDefinition.PipelineStage.String()
+		pipelineStage = &pipelineStageString
+	}
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(
+		ctx,
+		sqlInsert,
+		typeDefinition.Name,
+		typeDefinition.DisplayName,
+		typeDefinition.SortOrder,
+		pipelineStage,
+		createdDate,
+	)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, typeDefinition.Kind, "Create")
+	if err != nil {
+		if isUniqueViolation(err, table+".name") {
+			logger.Info(
+				"type_definition_repository.Create: UNIQUE constraint failed",
+				"kind", typeDefinition.Kind,
+				"name", typeDefinition.Name)
+			return nil, internalErrors.NewConflictError(
+				typeDefinition.Kind.String() + " type already exists: '" + typeDefinition.Name + "'")
+		} else if constraintErr := translateConstraintError(err, "PipelineStage"); constraintErr != nil {
+			logger.Info("type_definition_repository.Create: constraint failed", "error", err)
+			return nil, constraintErr
+		}
+
+		var internalServiceError *internalErrors.InternalServiceError
+		if errors.As(err, &internalServiceError) {
+			return nil, err
+		}
+		logger.Error(
+			"type_definition_repository.Create: Error trying to insert type",
+			"kind", typeDefinition.Kind,
+			"name", typeDefinition.Name,
+			"error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error trying to insert type: " + err.Error())
+	}
+
+	return result, nil
+}
+
+// GetByName can return InternalServiceError, NotFoundError, ValidationError
+func (repository *TypeDefinitionRepository) GetByName(
+	ctx context.Context, kind models.TypeKind, name string) (*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	table, err := repository.getTable(ctx, kind, "GetByName")
+	if err != nil {
+		return nil, err
+	}
+
+	sqlSelect := `
+		SELECT name, display_name, sort_order, pipeline_stage, is_built_in, created_date, updated_date
+		FROM ` + table + `
+		WHERE name = ? `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	row := repository.database.QueryRowContext(ctx, sqlSelect, name)
+
+	// can return InternalServiceError
+	result, err := repository.mapRow(ctx, row, kind, "GetByName")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("type_definition_repository.GetByName: No result found", "kind", kind, "name", name)
+			return nil, internalErrors.NewNotFoundError(kind.String() + " type: '" + name + "'")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAll returns the types of kind ordered by their sort order, then by name.
+//
+// GetAll can return InternalServiceError, ValidationError
+func (repository *TypeDefinitionRepository) GetAll(
+	ctx context.Context, kind models.TypeKind) ([]*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	table, err := repository.getTable(ctx, kind, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	sqlSelect := `
+		SELECT name, display_name, sort_order, pipeline_stage, is_built_in, created_date, updated_date
+		FROM ` + table + `
+		ORDER BY sort_order, name `
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	rows, err := repository.database.QueryContext(ctx, sqlSelect)
+	if err != nil {
+		logger.Error("type_definition_repository.GetAll: Error trying to query types", "kind", kind, "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error trying to query types: " + err.Error())
+	}
+	defer rows.Close()
+
+	var results []*models.TypeDefinition
+	for rows.Next() {
+		// can return InternalServiceError
+		result, err := repository.mapRow(ctx, rows, kind, "GetAll")
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("type_definition_repository.GetAll: Error iterating over rows", "kind", kind, "error", err)
+		return nil, internalErrors.NewInternalServiceError("Error iterating over rows: " + err.Error())
+	}
+
+	return results, nil
+}
+
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (repository *TypeDefinitionRepository) Update(
+	ctx context.Context, typeDefinition *models.UpdateTypeDefinition) error {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	table, err := repository.getTable(ctx, typeDefinition.Kind, "Update")
+	if err != nil {
+		return err
+	}
+
+	var sqlParts []string
+	var sqlVars []interface{}
+
+	if typeDefinition.DisplayName != nil {
+		sqlParts = append(sqlParts, "display_name = ?")
+		sqlVars = append(sqlVars, *typeDefinition.DisplayName)
+	}
+
+	if typeDefinition.SortOrder != nil {
+		sqlParts = append(sqlParts, "sort_order = ?")
+		sqlVars = append(sqlVars, *typeDefinition.SortOrder)
+	}
+
+	if typeDefinition.PipelineStage != nil {
+		sqlParts = append(sqlParts, "pipeline_stage = ?")
+		sqlVars = append(sqlVars, typeDefinition.PipelineStage.String())
+	}
+
+	if len(sqlParts) == 0 {
+		logger.Info(
+			"type_definition_repository.Update: nothing to update",
+			"kind", typeDefinition.Kind,
+			"name", typeDefinition.Name)
+		return internalErrors.NewValidationError(nil, "nothing to update")
+	}
+
+	sqlPayload, err := utils.JoinToString(&sqlParts, nil, ", \n\t\t\t", nil)
+	if err != nil {
+		logger.Error("type_definition_repository.Update: unable to join SQL statement string", "error", err)
+		return internalErrors.NewInternalServiceError("unable to join SQL statement string")
+	}
+
+	sqlUpdate := `
+		UPDATE ` + table + ` SET
+			updated_date = ?,
+			` + sqlPayload + `
+		WHERE name = ? `
+	sqlVars = append([]interface{}{time.Now().Format(timeutil.RFC3339Milli_Write)}, sqlVars...)
+	sqlVars = append(sqlVars, typeDefinition.Name)
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, sqlUpdate, sqlVars...)
+	if err != nil {
+		logger.Error(
+			"type_definition_repository.Update: unable to update type",
+			"kind", typeDefinition.Kind,
+			"name", typeDefinition.Name,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(
+		result, typeDefinition.Kind.String()+" type does not exist. Name: "+typeDefinition.Name)
+}
+
+// Delete refuses to delete built-in types, and types that are still in use.
+//
+// Delete can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+func (repository *TypeDefinitionRepository) Delete(ctx context.Context, kind models.TypeKind, name string) error {
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	typeDefinition, err := repository.GetByName(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+
+	if typeDefinition.IsBuiltIn {
+		logger.Info("type_definition_repository.Delete: Cannot delete built-in type", "kind", kind, "name", name)
+		nameField := "Name"
+		return internalErrors.NewValidationError(
+			&nameField, "built-in "+kind.String()+" type cannot be deleted: '"+name+"'")
+	}
+
+	// the table name was checked by GetByName
+	table := typeDefinitionTables[kind]
+
+	ctx, cancel := withQueryTimeout(ctx, repository.queryTimeout)
+	defer cancel()
+
+	result, err := repository.database.ExecContext(ctx, "DELETE FROM "+table+" WHERE name = ?", name)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			logger.Info("type_definition_repository.Delete: Type is still in use", "kind", kind, "name", name)
+			return internalErrors.NewConflictError(kind.String() + " type is still in use: '" + name + "'")
+		}
+		logger.Error(
+			"type_definition_repository.Delete: Error trying to delete type",
+			"kind", kind,
+			"name", name,
+			"error", err.Error())
+		return internalErrors.NewInternalServiceError(err.Error())
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return checkSingleRowAffected(result, kind.String()+" type does not exist. Name: "+name)
+}
+
+// internal functions
+
+// getTable can return ValidationError
+func (repository *TypeDefinitionRepository) getTable(
+	ctx context.Context, kind models.TypeKind, methodName string) (string, error) {
+
+	table, ok := typeDefinitionTables[kind]
+	if !ok {
+		logging.FromContext(ctx).Info("type_definition_repository."+methodName+": Invalid kind", "kind", kind)
+		kindField := "Kind"
+		return "", internalErrors.NewValidationError(&kindField, "invalid type kind: '"+kind.String()+"'")
+	}
+	return table, nil
+}
+
+func (repository *TypeDefinitionRepository) mapRow(
+	ctx context.Context,
+	scanner interface{ Scan(...interface{}) error },
+	kind models.TypeKind,
+	methodName string) (*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	result := models.TypeDefinition{Kind: kind}
+	var pipelineStage sql.NullString
+	var createdDate string
+	var updatedDate sql.NullString
+
+	err := scanner.Scan(
+		&result.Name,
+		&result.DisplayName,
+		&result.SortOrder,
+		&pipelineStage,
+		&result.IsBuiltIn,
+		&createdDate,
+		&updatedDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineStage.Valid {
+		stage := models.PipelineStage(pipelineStage.String)
+		result.PipelineStage = &stage
+	}
+
+	result.CreatedDate, result.UpdatedDate, err = parseCreatedAndUpdatedDates(createdDate, updatedDate)
+	if err != nil {
+		logger.Error("type_definition_repository."+methodName+": Error parsing dates", "error", err.Error())
+		return nil, internalErrors.NewInternalServiceError("Error parsing dates: " + err.Error())
+	}
+
+	return &result, nil
+}
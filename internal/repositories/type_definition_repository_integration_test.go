@@ -0,0 +1,232 @@
+package repositories_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTypeDefinitionRepository(t *testing.T) (
+	*repositories.TypeDefinitionRepository, *repositories.EventRepository, *repositories.PersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupTypeDefinitionRepositoryTestContainer(t, *config)
+
+	var typeDefinitionRepository *repositories.TypeDefinitionRepository
+	var eventRepository *repositories.EventRepository
+	var personRepository *repositories.PersonRepository
+	err := container.Invoke(func(
+		typeDefinitionRepo *repositories.TypeDefinitionRepository,
+		eventRepo *repositories.EventRepository,
+		personRepo *repositories.PersonRepository) {
+
+		typeDefinitionRepository = typeDefinitionRepo
+		eventRepository = eventRepo
+		personRepository = personRepo
+	})
+	assert.NoError(t, err)
+
+	return typeDefinitionRepository, eventRepository, personRepository
+}
+
+func createTechnicalScreenEventType(
+	t *testing.T, typeDefinitionRepository *repositories.TypeDefinitionRepository) *models.TypeDefinition {
+
+	pipelineStage := models.PipelineStage(models.PipelineStageInterviewing)
+	typeDefinition, err := typeDefinitionRepository.Create(context.Background(), &models.CreateTypeDefinition{
+		Kind:          models.TypeKindEvent,
+		Name:          "technicalScreen",
+		DisplayName:   "Technical screen",
+		SortOrder:     65,
+		PipelineStage: &pipelineStage,
+	})
+	assert.NoError(t, err)
+	return typeDefinition
+}
+
+// -------- Create tests: --------
+
+func TestTypeDefinitionCreate_ShouldInsertType(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	typeDefinition := createTechnicalScreenEventType(t, typeDefinitionRepository)
+	assert.NotNil(t, typeDefinition)
+	assert.Equal(t, models.TypeKind(models.TypeKindEvent), typeDefinition.Kind)
+	assert.Equal(t, "technicalScreen", typeDefinition.Name)
+	assert.Equal(t, "Technical screen", typeDefinition.DisplayName)
+	assert.Equal(t, 65, typeDefinition.SortOrder)
+	assert.Equal(t, models.PipelineStage(models.PipelineStageInterviewing), *typeDefinition.PipelineStage)
+	assert.False(t, typeDefinition.IsBuiltIn)
+	assert.NotNil(t, typeDefinition.CreatedDate)
+	assert.Nil(t, typeDefinition.UpdatedDate)
+}
+
+func TestTypeDefinitionCreate_ShouldReturnConflictErrorIfTypeExists(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	typeDefinition, err := typeDefinitionRepository.Create(context.Background(), &models.CreateTypeDefinition{
+		Kind:        models.TypeKindCompany,
+		Name:        models.CompanyTypeRecruiter,
+		DisplayName: "Recruiter",
+	})
+	assert.Nil(t, typeDefinition)
+
+	var conflictErr *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, "conflict error on insert: company type already exists: 'recruiter'", err.Error())
+}
+
+func TestTypeDefinitionCreate_ShouldReturnInternalServiceErrorIfInsertFails(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	typeDefinition, err := typeDefinitionRepository.Create(ctx, &models.CreateTypeDefinition{
+		Kind:        models.TypeKindCompany,
+		Name:        "agency",
+		DisplayName: "Agency",
+	})
+	assert.Nil(t, typeDefinition)
+
+	var internalServiceErr *internalErrors.InternalServiceError
+	assert.True(t, errors.As(err, &internalServiceErr))
+}
+
+// -------- GetAll tests: --------
+
+func TestTypeDefinitionGetAll_ShouldReturnBuiltInTypesInSortOrder(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	createTechnicalScreenEventType(t, typeDefinitionRepository)
+
+	typeDefinitions, err := typeDefinitionRepository.GetAll(context.Background(), models.TypeKindEvent)
+	assert.NoError(t, err)
+	assert.Len(t, typeDefinitions, 16)
+
+	for index := 1; index < len(typeDefinitions); index++ {
+		assert.LessOrEqual(t, typeDefinitions[index-1].SortOrder, typeDefinitions[index].SortOrder)
+	}
+
+	applied := typeDefinitions[0]
+	assert.Equal(t, models.EventTypeApplied, applied.Name)
+	assert.True(t, applied.IsBuiltIn)
+	assert.Equal(t, models.PipelineStage(models.PipelineStageApplied), *applied.PipelineStage)
+}
+
+func TestTypeDefinitionGetAll_ShouldReturnValidationErrorForInvalidKind(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	typeDefinitions, err := typeDefinitionRepository.GetAll(context.Background(), "application")
+	assert.Nil(t, typeDefinitions)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'Kind': invalid type kind: 'application'", err.Error())
+}
+
+// -------- Update tests: --------
+
+func TestTypeDefinitionUpdate_ShouldUpdateType(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	pipelineStage := models.PipelineStage(models.PipelineStageScreening)
+	err := typeDefinitionRepository.Update(context.Background(), &models.UpdateTypeDefinition{
+		Kind:          models.TypeKindPerson,
+		Name:          models.PersonTypeHR,
+		DisplayName:   testutil.ToPtr("Human resources"),
+		SortOrder:     testutil.ToPtr(5),
+		PipelineStage: &pipelineStage,
+	})
+	assert.NoError(t, err)
+
+	typeDefinition, err := typeDefinitionRepository.GetByName(
+		context.Background(), models.TypeKindPerson, models.PersonTypeHR)
+	assert.NoError(t, err)
+	assert.Equal(t, "Human resources", typeDefinition.DisplayName)
+	assert.Equal(t, 5, typeDefinition.SortOrder)
+	assert.Equal(t, pipelineStage, *typeDefinition.PipelineStage)
+	assert.True(t, typeDefinition.IsBuiltIn)
+	assert.NotNil(t, typeDefinition.UpdatedDate)
+}
+
+func TestTypeDefinitionUpdate_ShouldReturnNotFoundErrorIfTypeDoesNotExist(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	err := typeDefinitionRepository.Update(context.Background(), &models.UpdateTypeDefinition{
+		Kind:        models.TypeKindPerson,
+		Name:        "designer",
+		DisplayName: testutil.ToPtr("Designer"),
+	})
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+// -------- Delete tests: --------
+
+func TestTypeDefinitionDelete_ShouldDeleteUnusedType(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	createTechnicalScreenEventType(t, typeDefinitionRepository)
+
+	err := typeDefinitionRepository.Delete(context.Background(), models.TypeKindEvent, "technicalScreen")
+	assert.NoError(t, err)
+
+	_, err = typeDefinitionRepository.GetByName(context.Background(), models.TypeKindEvent, "technicalScreen")
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestTypeDefinitionDelete_ShouldReturnValidationErrorForBuiltInType(t *testing.T) {
+	typeDefinitionRepository, _, _ := setupTypeDefinitionRepository(t)
+
+	err := typeDefinitionRepository.Delete(context.Background(), models.TypeKindEvent, models.EventTypeOther)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'Name': built-in event type cannot be deleted: 'other'", err.Error())
+}
+
+func TestTypeDefinitionDelete_ShouldReturnConflictErrorForTypeInUse(t *testing.T) {
+	typeDefinitionRepository, eventRepository, _ := setupTypeDefinitionRepository(t)
+
+	createTechnicalScreenEventType(t, typeDefinitionRepository)
+	var eventType models.EventType = "technicalScreen"
+	repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, nil)
+
+	err := typeDefinitionRepository.Delete(context.Background(), models.TypeKindEvent, "technicalScreen")
+
+	var conflictErr *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.Equal(t, "conflict error on insert: event type is still in use: 'technicalScreen'", err.Error())
+}
+
+// -------- Foreign key tests: --------
+
+func TestTypeDefinition_ShouldRejectPersonWithUnknownType(t *testing.T) {
+	_, _, personRepository := setupTypeDefinitionRepository(t)
+
+	person, err := personRepository.Create(context.Background(), &models.CreatePerson{
+		Name:       "Jane Doe",
+		PersonType: "designer",
+	})
+	assert.Nil(t, person)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'PersonType': person type does not exist: 'designer'", err.Error())
+}
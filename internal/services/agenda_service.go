@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"time"
+)
+
+// AgendaService lists booked calls and interviews, and the ones that were never completed.
+type AgendaService struct {
+	eventRepository EventRepository
+}
+
+func NewAgendaService(eventRepository EventRepository) *AgendaService {
+	return &AgendaService{eventRepository: eventRepository}
+}
+
+// GetAgenda returns the booked events from fromDate up to toDate, soonest first, and every overdue booked event,
+// oldest first. fromDate defaults to now, and a nil toDate does not limit the upcoming events. Events include their
+// applications, companies and persons with all fields.
+//
+// GetAgenda can return InternalServiceError, ValidationError
+func (agendaService *AgendaService) GetAgenda(
+	ctx context.Context, fromDate *time.Time, toDate *time.Time) (*models.Agenda, error) {
+
+	logger := logging.FromContext(ctx)
+
+	now := time.Now()
+	if fromDate == nil {
+		fromDate = &now
+	}
+
+	if toDate != nil && !toDate.After(*fromDate) {
+		logger.Info("agenda_service.GetAgenda: toDate is not after fromDate", "from", fromDate, "to", toDate)
+		toField := "to"
+		return nil, internalErrors.NewValidationError(&toField, "to must be after from")
+	}
+
+	// can return InternalServiceError, ValidationError
+	upcoming, err := agendaService.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{EventTypes: models.BookedEventTypes, FromDate: fromDate, ToDate: toDate},
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return InternalServiceError, ValidationError
+	overdue, err := agendaService.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{EventTypes: models.BookedEventTypes, ToDate: &now, OnlyNotCompleted: true},
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"agenda_service.GetAgenda: Retrieved agenda", "upcomingCount", len(upcoming), "overdueCount", len(overdue))
+	return &models.Agenda{Upcoming: upcoming, Overdue: overdue}, nil
+}
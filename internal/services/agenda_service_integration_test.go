@@ -0,0 +1,103 @@
+package services_test
+
+import (
+	"context"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupAgendaService(t *testing.T) (
+	*services.AgendaService,
+	*repositories.EventRepository,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.ApplicationEventRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupAgendaServiceTestContainer(t, *config)
+
+	var agendaService *services.AgendaService
+	var eventRepository *repositories.EventRepository
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	var applicationEventRepository *repositories.ApplicationEventRepository
+	err := container.Invoke(func(
+		service *services.AgendaService,
+		event *repositories.EventRepository,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		applicationEvent *repositories.ApplicationEventRepository) {
+
+		agendaService = service
+		eventRepository = event
+		applicationRepository = application
+		companyRepository = company
+		applicationEventRepository = applicationEvent
+	})
+	assert.NoError(t, err)
+
+	return agendaService, eventRepository, applicationRepository, companyRepository, applicationEventRepository
+}
+
+// -------- GetAgenda tests: --------
+
+func TestGetAgenda_ShouldReturnUpcomingAndOverdueBookedEvents(t *testing.T) {
+	agendaService, eventRepository, applicationRepository, companyRepository, applicationEventRepository :=
+		setupAgendaService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+
+	createEvent := func(eventType models.EventType, eventDate time.Time) *models.Event {
+		event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, &eventDate)
+		repositoryhelpers.AssociateApplicationEvent(t, applicationEventRepository, application.ID, event.ID, nil)
+		return event
+	}
+
+	now := time.Now()
+	nextWeek := createEvent(models.EventTypeInterviewBooked, now.AddDate(0, 0, 7))
+	tomorrow := createEvent(models.EventTypeCallBooked, now.AddDate(0, 0, 1))
+	createEvent(models.EventTypeInterviewBooked, now.AddDate(0, 1, 0))
+	createEvent(models.EventTypeApplied, now.AddDate(0, 0, 2))
+
+	overdueCall := createEvent(models.EventTypeCallBooked, now.AddDate(0, 0, -3))
+	doneInterview := createEvent(models.EventTypeInterviewBooked, now.AddDate(0, 0, -2))
+	createEvent(models.EventTypeInterviewCompleted, *doneInterview.EventDate)
+
+	agenda, err := agendaService.GetAgenda(context.Background(), nil, testutil.ToPtr(now.AddDate(0, 0, 14)))
+	assert.NoError(t, err)
+
+	assert.Len(t, agenda.Upcoming, 2)
+	assert.Equal(t, tomorrow.ID, agenda.Upcoming[0].ID)
+	assert.Equal(t, nextWeek.ID, agenda.Upcoming[1].ID)
+	assert.NotNil(t, agenda.Upcoming[0].Applications)
+	assert.Equal(t, application.ID, (*agenda.Upcoming[0].Applications)[0].ID)
+	assert.Equal(t, company.ID, *(*agenda.Upcoming[0].Applications)[0].CompanyID)
+
+	assert.Len(t, agenda.Overdue, 1)
+	assert.Equal(t, overdueCall.ID, agenda.Overdue[0].ID)
+}
+
+func TestGetAgenda_ShouldReturnEmptyListsWithoutBookedEvents(t *testing.T) {
+	agendaService, _, _, _, _ := setupAgendaService(t)
+
+	agenda, err := agendaService.GetAgenda(context.Background(), nil, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, agenda.Upcoming)
+	assert.Empty(t, agenda.Upcoming)
+	assert.NotNil(t, agenda.Overdue)
+	assert.Empty(t, agenda.Overdue)
+}
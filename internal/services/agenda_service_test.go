@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- GetAgenda tests: --------
+
+func TestGetAgenda_ShouldReturnValidationErrorIfToIsNotAfterFrom(t *testing.T) {
+	agendaService := NewAgendaService(nil)
+
+	fromDate := time.Now()
+	agenda, err := agendaService.GetAgenda(context.Background(), &fromDate, &fromDate)
+	assert.Nil(t, agenda)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'to': to must be after from", err.Error())
+}
@@ -1,48 +1,63 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 
 	"github.com/google/uuid"
 )
 
 type ApplicationEventService struct {
-	applicationEventRepository *repositories.ApplicationEventRepository
+	applicationEventRepository ApplicationEventRepository
+	eventTransitionValidator   *EventTransitionValidator
 }
 
 func NewApplicationEventService(
-	applicationEventRepository *repositories.ApplicationEventRepository) *ApplicationEventService {
+	applicationEventRepository ApplicationEventRepository,
+	eventTransitionValidator *EventTransitionValidator) *ApplicationEventService {
 
-	return &ApplicationEventService{applicationEventRepository: applicationEventRepository}
+	return &ApplicationEventService{
+		applicationEventRepository: applicationEventRepository,
+		eventTransitionValidator:   eventTransitionValidator,
+	}
 }
 
 // AssociateApplicationEvent can return ConflictError, InternalServiceError, ValidationError
 func (applicationEventService *ApplicationEventService) AssociateApplicationEvent(
+	ctx context.Context,
 	AssociateApplicationEvent *models.AssociateApplicationEvent) (*models.ApplicationEvent, error) {
 
+	logger := logging.FromContext(ctx)
+
 	if AssociateApplicationEvent == nil {
-		slog.Error("event_service.AssociateApplicationEvent: model is nil")
+		logger.Error("event_service.AssociateApplicationEvent: model is nil")
 		return nil, internalErrors.NewValidationError(nil, "AssociateApplicationEvent model is nil")
 	}
 
 	err := AssociateApplicationEvent.Validate()
 	if err != nil {
-		slog.Info("event_service.AssociateApplicationEvent: ApplicationEvent to create is invalid", "error", err)
+		logger.Info("event_service.AssociateApplicationEvent: ApplicationEvent to create is invalid", "error", err)
+
+		return nil, err
+	}
 
+	// can return InternalServiceError, ValidationError
+	err = applicationEventService.eventTransitionValidator.ValidateAssociation(
+		ctx, AssociateApplicationEvent.ApplicationID, AssociateApplicationEvent.EventID)
+	if err != nil {
 		return nil, err
 	}
 
 	insertedApplicationEvent, err :=
-		applicationEventService.applicationEventRepository.AssociateApplicationEvent(AssociateApplicationEvent)
+		applicationEventService.applicationEventRepository.AssociateApplicationEvent(ctx, AssociateApplicationEvent)
 
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info(
+	logger.Info(
 		"event_service.AssociateApplicationEvent: Associated application to event.",
 		"event.ApplicationID", insertedApplicationEvent.ApplicationID,
 		"event.EventID", insertedApplicationEvent.EventID)
@@ -51,21 +66,23 @@ func (applicationEventService *ApplicationEventService) AssociateApplicationEven
 
 // GetByID can return ValidationError, InternalServiceError
 func (applicationEventService *ApplicationEventService) GetByID(
-	applicationID *uuid.UUID, eventID *uuid.UUID) ([]*models.ApplicationEvent, error) {
+	ctx context.Context, applicationID *uuid.UUID, eventID *uuid.UUID) ([]*models.ApplicationEvent, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if (applicationID == nil || *applicationID == uuid.Nil) && (eventID == nil || *eventID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "applicationID and eventID cannot both be empty")
 	}
 
-	applicationEvents, err := applicationEventService.applicationEventRepository.GetByID(applicationID, eventID)
+	applicationEvents, err := applicationEventService.applicationEventRepository.GetByID(ctx, applicationID, eventID)
 	if err != nil {
 		return nil, err
 	}
 
 	if applicationEvents == nil {
-		slog.Info("ApplicationEventService.GetByID: Retrieved zero events")
+		logger.Info("ApplicationEventService.GetByID: Retrieved zero events")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationEventService.GetByID: Retrieved " + string(rune(len(applicationEvents))) + " events")
 	}
 
@@ -73,28 +90,55 @@ func (applicationEventService *ApplicationEventService) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (applicationEventService *ApplicationEventService) GetAll() ([]*models.ApplicationEvent, error) {
-	applicationEvents, err := applicationEventService.applicationEventRepository.GetAll()
+func (applicationEventService *ApplicationEventService) GetAll(
+	ctx context.Context) ([]*models.ApplicationEvent, error) {
+
+	logger := logging.FromContext(ctx)
+
+	applicationEvents, err := applicationEventService.applicationEventRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if applicationEvents == nil {
-		slog.Info("ApplicationEventService.GetAllApplicationEvents: Retrieved zero events")
+		logger.Info("ApplicationEventService.GetAllApplicationEvents: Retrieved zero events")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationEventService.GetAllApplicationEvents: Retrieved " + string(rune(len(applicationEvents))) + " events")
 	}
 
 	return applicationEvents, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (applicationEventService *ApplicationEventService) Update(
+	ctx context.Context, model *models.UpdateApplicationEvent) error {
+
+	logger := logging.FromContext(ctx)
+
+	if model == nil {
+		logger.Error("application_event_service.Update: model is nil")
+		return internalErrors.NewValidationError(nil, "UpdateApplicationEvent model is nil")
+	}
+
+	err := model.Validate()
+	if err != nil {
+		logger.Info("application_event_service.Update: ApplicationEvent to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return applicationEventService.applicationEventRepository.Update(ctx, model)
+}
+
 // Delete can return InternalServiceError, NotFoundError, ValidationError
-func (applicationEventService *ApplicationEventService) Delete(model *models.DeleteApplicationEvent) error {
+func (applicationEventService *ApplicationEventService) Delete(
+	ctx context.Context, model *models.DeleteApplicationEvent) error {
+
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
 
-	return applicationEventService.applicationEventRepository.Delete(model)
+	return applicationEventService.applicationEventRepository.Delete(ctx, model)
 }
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -74,7 +75,8 @@ func TestAssociateApplicationToEvent_ShouldAssociateAApplicationToAEvent(t *test
 		EventID:       event.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	associatedApplicationEvent, err := applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	associatedApplicationEvent, err := applicationEventService.AssociateApplicationEvent(
+		context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	assert.Equal(t, applicationEvent.ApplicationID, associatedApplicationEvent.ApplicationID)
@@ -96,7 +98,8 @@ func TestAssociateApplicationToEvent_ShouldAssociateAApplicationToAEventWithOnly
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	associatedApplicationEvent, err := applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	associatedApplicationEvent, err := applicationEventService.AssociateApplicationEvent(
+		context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	assert.Equal(t, applicationEvent.ApplicationID, associatedApplicationEvent.ApplicationID)
@@ -118,10 +121,10 @@ func TestAssociateApplicationToEvent_ShouldReturnConflictErrorIfApplicationIDAnd
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -132,6 +135,48 @@ func TestAssociateApplicationToEvent_ShouldReturnConflictErrorIfApplicationIDAnd
 		conflictError.Error())
 }
 
+func TestAssociateApplicationToEvent_ShouldReturnValidationErrorIfEventBreaksTransitionRulesInStrictMode(t *testing.T) {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		EventTransitionMode:                  models.EventTransitionModeStrict,
+	}
+	container := dependencyinjection.SetupApplicationEventServiceTestContainer(t, config)
+
+	var applicationEventService *services.ApplicationEventService
+	var applicationRepository *repositories.ApplicationRepository
+	var eventRepository *repositories.EventRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		service *services.ApplicationEventService,
+		application *repositories.ApplicationRepository,
+		event *repositories.EventRepository,
+		company *repositories.CompanyRepository) {
+
+		applicationEventService = service
+		applicationRepository = application
+		eventRepository = event
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, nil)
+
+	associatedApplicationEvent, err := applicationEventService.AssociateApplicationEvent(
+		context.Background(), &models.AssociateApplicationEvent{ApplicationID: application.ID, EventID: event.ID})
+	assert.Nil(t, associatedApplicationEvent)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'eventType': interviewCompleted requires an earlier interviewBooked event",
+		err.Error())
+}
+
 // -------- GetByID tests: --------
 
 func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testing.T) {
@@ -149,7 +194,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -157,7 +202,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -165,10 +210,10 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationID(t *testi
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	applicationEvents, err := applicationEventService.GetByID(&application1.ID, nil)
+	applicationEvents, err := applicationEventService.GetByID(context.Background(), &application1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, applicationEvents, 2)
 
@@ -194,7 +239,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -202,7 +247,7 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -210,10 +255,10 @@ func TestApplicationEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	applicationEvents, err := applicationEventService.GetByID(nil, &event1.ID)
+	applicationEvents, err := applicationEventService.GetByID(context.Background(), nil, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, applicationEvents, 2)
 
@@ -238,24 +283,24 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationIDAndEventID(t *testing.T) {
 		ApplicationID: application1.ID,
 		EventID:       event1.ID,
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
 		ApplicationID: application1.ID,
 		EventID:       event2.ID,
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
 		ApplicationID: application2.ID,
 		EventID:       event1.ID,
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	events, err := applicationEventService.GetByID(&application1.ID, &event1.ID)
+	events, err := applicationEventService.GetByID(context.Background(), &application1.ID, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, events, 1)
 	assert.Equal(t, application1.ID, events[0].ApplicationID)
@@ -279,7 +324,7 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent1)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent1)
 	assert.NoError(t, err)
 
 	applicationEvent2 := models.AssociateApplicationEvent{
@@ -287,7 +332,7 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent2)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent2)
 	assert.NoError(t, err)
 
 	applicationEvent3 := models.AssociateApplicationEvent{
@@ -295,10 +340,10 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 		EventID:       event2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationEventService.AssociateApplicationEvent(&applicationEvent3)
+	_, err = applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent3)
 	assert.NoError(t, err)
 
-	eventCompanies, err := applicationEventService.GetAll()
+	eventCompanies, err := applicationEventService.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, eventCompanies, 3)
@@ -322,7 +367,7 @@ func TestGetAllApplicationEvents_ShouldReturnAllApplicationEvents(t *testing.T)
 func TestGetAllApplicationEvents_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	applicationEventService, _, _, _ := setupApplicationEventService(t)
 
-	results, err := applicationEventService.GetAll()
+	results, err := applicationEventService.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
@@ -340,7 +385,7 @@ func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteApplicationEvent{
@@ -348,7 +393,7 @@ func TestDeleteApplicationEvent_ShouldDeleteApplicationEvent(t *testing.T) {
 		EventID:       event.ID,
 	}
 
-	err = applicationEventService.Delete(&deleteModel)
+	err = applicationEventService.Delete(context.Background(), &deleteModel)
 	assert.NoError(t, err)
 }
 
@@ -363,7 +408,7 @@ func TestDeleteApplicationEvent_ShouldReturnNotFoundErrorIfNoMatchingApplication
 		ApplicationID: application.ID,
 		EventID:       event.ID,
 	}
-	_, err := applicationEventService.AssociateApplicationEvent(&applicationEvent)
+	_, err := applicationEventService.AssociateApplicationEvent(context.Background(), &applicationEvent)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteApplicationEvent{
@@ -371,7 +416,7 @@ func TestDeleteApplicationEvent_ShouldReturnNotFoundErrorIfNoMatchingApplication
 		EventID:       uuid.New(),
 	}
 
-	err = applicationEventService.Delete(&deleteModel)
+	err = applicationEventService.Delete(context.Background(), &deleteModel)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/testutil"
@@ -13,9 +14,9 @@ import (
 // -------- AssociateApplicationEvent tests: --------
 
 func TestAssociateApplicationEvent_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
-	service := NewApplicationEventService(nil)
+	service := NewApplicationEventService(nil, nil)
 
-	nilApplication, err := service.AssociateApplicationEvent(nil)
+	nilApplication, err := service.AssociateApplicationEvent(context.Background(), nil)
 	assert.Nil(t, nilApplication)
 
 	var validationError *internalErrors.ValidationError
@@ -56,9 +57,9 @@ func TestGetByID_ShouldReturnValidationErrorIfApplicationIDAndEventIDAreEmpty(t
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			service := NewApplicationEventService(nil)
+			service := NewApplicationEventService(nil, nil)
 
-			eventCompanies, err := service.GetByID(test.applicationID, test.eventID)
+			eventCompanies, err := service.GetByID(context.Background(), test.applicationID, test.eventID)
 			assert.Nil(t, eventCompanies)
 
 			assert.Error(t, err)
@@ -70,6 +71,31 @@ func TestGetByID_ShouldReturnValidationErrorIfApplicationIDAndEventIDAreEmpty(t
 	}
 }
 
+// -------- Update tests: --------
+
+func TestUpdateApplicationEvent_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
+	service := NewApplicationEventService(nil, nil)
+
+	err := service.Update(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: UpdateApplicationEvent model is nil", validationError.Error())
+}
+
+func TestUpdateApplicationEvent_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	service := NewApplicationEventService(nil, nil)
+
+	err := service.Update(context.Background(), &models.UpdateApplicationEvent{
+		ApplicationID: uuid.New(),
+		EventID:       uuid.New(),
+	})
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
 // -------- Delete tests: --------
 
 func TestDelete_ShouldReturnValidationErrorIfApplicationIDOrEventIDisEmpty(t *testing.T) {
@@ -101,14 +127,14 @@ func TestDelete_ShouldReturnValidationErrorIfApplicationIDOrEventIDisEmpty(t *te
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
-			service := NewApplicationEventService(nil)
+			service := NewApplicationEventService(nil, nil)
 
 			deleteModel := models.DeleteApplicationEvent{
 				ApplicationID: test.applicationID,
 				EventID:       test.eventID,
 			}
 
-			err := service.Delete(&deleteModel)
+			err := service.Delete(context.Background(), &deleteModel)
 			assert.Error(t, err)
 
 			var validationError *internalErrors.ValidationError
@@ -1,46 +1,49 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 
 	"github.com/google/uuid"
 )
 
 type ApplicationPersonService struct {
-	applicationPersonRepository *repositories.ApplicationPersonRepository
+	applicationPersonRepository ApplicationPersonRepository
 }
 
-func NewApplicationPersonService(applicationPersonRepository *repositories.ApplicationPersonRepository) *ApplicationPersonService {
+func NewApplicationPersonService(applicationPersonRepository ApplicationPersonRepository) *ApplicationPersonService {
 	return &ApplicationPersonService{applicationPersonRepository: applicationPersonRepository}
 }
 
 // AssociateApplicationPerson can return ConflictError, InternalServiceError, ValidationError
 func (applicationPersonService *ApplicationPersonService) AssociateApplicationPerson(
+	ctx context.Context,
 	AssociateApplicationPerson *models.AssociateApplicationPerson) (*models.ApplicationPerson, error) {
 
+	logger := logging.FromContext(ctx)
+
 	if AssociateApplicationPerson == nil {
-		slog.Error("person_service.AssociateApplicationPerson: model is nil")
+		logger.Error("person_service.AssociateApplicationPerson: model is nil")
 		return nil, internalErrors.NewValidationError(nil, "AssociateApplicationPerson model is nil")
 	}
 
 	err := AssociateApplicationPerson.Validate()
 	if err != nil {
-		slog.Info("person_service.AssociateApplicationPerson: ApplicationPerson to create is invalid", "error", err)
+		logger.Info("person_service.AssociateApplicationPerson: ApplicationPerson to create is invalid", "error", err)
 
 		return nil, err
 	}
 
 	insertedApplicationPerson, err :=
-		applicationPersonService.applicationPersonRepository.AssociateApplicationPerson(AssociateApplicationPerson)
+		applicationPersonService.applicationPersonRepository.AssociateApplicationPerson(ctx, AssociateApplicationPerson)
 
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info(
+	logger.Info(
 		"person_service.AssociateApplicationPerson: Associated application to person.",
 		"person.ApplicationID", insertedApplicationPerson.ApplicationID,
 		"person.PersonID", insertedApplicationPerson.PersonID)
@@ -49,21 +52,23 @@ func (applicationPersonService *ApplicationPersonService) AssociateApplicationPe
 
 // GetByID can return ValidationError, InternalServiceError
 func (applicationPersonService *ApplicationPersonService) GetByID(
-	applicationID *uuid.UUID, personID *uuid.UUID) ([]*models.ApplicationPerson, error) {
+	ctx context.Context, applicationID *uuid.UUID, personID *uuid.UUID) ([]*models.ApplicationPerson, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if (applicationID == nil || *applicationID == uuid.Nil) && (personID == nil || *personID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "applicationID and personID cannot both be empty")
 	}
 
-	applicationPersons, err := applicationPersonService.applicationPersonRepository.GetByID(applicationID, personID)
+	applicationPersons, err := applicationPersonService.applicationPersonRepository.GetByID(ctx, applicationID, personID)
 	if err != nil {
 		return nil, err
 	}
 
 	if applicationPersons == nil {
-		slog.Info("ApplicationPersonService.GetByID: Retrieved zero persons")
+		logger.Info("ApplicationPersonService.GetByID: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationPersonService.GetByID: Retrieved " + string(rune(len(applicationPersons))) + " persons")
 	}
 
@@ -71,28 +76,55 @@ func (applicationPersonService *ApplicationPersonService) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (applicationPersonService *ApplicationPersonService) GetAll() ([]*models.ApplicationPerson, error) {
-	applicationPersons, err := applicationPersonService.applicationPersonRepository.GetAll()
+func (applicationPersonService *ApplicationPersonService) GetAll(
+	ctx context.Context) ([]*models.ApplicationPerson, error) {
+
+	logger := logging.FromContext(ctx)
+
+	applicationPersons, err := applicationPersonService.applicationPersonRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if applicationPersons == nil {
-		slog.Info("ApplicationPersonService.GetAllApplicationPersons: Retrieved zero persons")
+		logger.Info("ApplicationPersonService.GetAllApplicationPersons: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationPersonService.GetAllApplicationPersons: Retrieved " + string(rune(len(applicationPersons))) + " persons")
 	}
 
 	return applicationPersons, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (applicationPersonService *ApplicationPersonService) Update(
+	ctx context.Context, model *models.UpdateApplicationPerson) error {
+
+	logger := logging.FromContext(ctx)
+
+	if model == nil {
+		logger.Error("application_person_service.Update: model is nil")
+		return internalErrors.NewValidationError(nil, "UpdateApplicationPerson model is nil")
+	}
+
+	err := model.Validate()
+	if err != nil {
+		logger.Info("application_person_service.Update: ApplicationPerson to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return applicationPersonService.applicationPersonRepository.Update(ctx, model)
+}
+
 // Delete can return InternalServiceError, NotFoundError, ValidationError
-func (applicationPersonService *ApplicationPersonService) Delete(model *models.DeleteApplicationPerson) error {
+func (applicationPersonService *ApplicationPersonService) Delete(
+	ctx context.Context, model *models.DeleteApplicationPerson) error {
+
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
 
-	return applicationPersonService.applicationPersonRepository.Delete(model)
+	return applicationPersonService.applicationPersonRepository.Delete(ctx, model)
 }
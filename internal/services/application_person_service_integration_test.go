@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -71,7 +72,8 @@ func TestAssociateApplicationToPerson_ShouldAssociateAApplicationToAPerson(t *te
 		PersonID:      person.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	associatedApplicationPerson, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	associatedApplicationPerson, err := applicationPersonService.AssociateApplicationPerson(
+		context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, applicationPerson.ApplicationID, associatedApplicationPerson.ApplicationID)
@@ -90,7 +92,8 @@ func TestAssociateApplicationToPerson_ShouldAssociateAApplicationToAPersonWithOn
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	associatedApplicationPerson, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	associatedApplicationPerson, err := applicationPersonService.AssociateApplicationPerson(
+		context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, applicationPerson.ApplicationID, associatedApplicationPerson.ApplicationID)
@@ -109,10 +112,10 @@ func TestAssociateApplicationToPerson_ShouldReturnConflictErrorIfApplicationIDAn
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -140,7 +143,7 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationID(t *testing.T) {
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -148,7 +151,7 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationID(t *testing.T) {
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -156,10 +159,10 @@ func TestGetByID_ShouldGetRecordsMatchingApplicationID(t *testing.T) {
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	applicationPersons, err := applicationPersonService.GetByID(&application1.ID, nil)
+	applicationPersons, err := applicationPersonService.GetByID(context.Background(), &application1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, applicationPersons, 2)
 
@@ -185,7 +188,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -193,7 +196,7 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -201,10 +204,10 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T)
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	applicationPersons, err := applicationPersonService.GetByID(nil, &person1.ID)
+	applicationPersons, err := applicationPersonService.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, applicationPersons, 2)
 
@@ -229,24 +232,24 @@ func TestApplicationPersonGetByID_ShouldGetRecordsMatchingApplicationIDAndPerson
 		ApplicationID: application1.ID,
 		PersonID:      person1.ID,
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
 		ApplicationID: application1.ID,
 		PersonID:      person2.ID,
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
 		ApplicationID: application2.ID,
 		PersonID:      person1.ID,
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	persons, err := applicationPersonService.GetByID(&application1.ID, &person1.ID)
+	persons, err := applicationPersonService.GetByID(context.Background(), &application1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, application1.ID, persons[0].ApplicationID)
@@ -270,7 +273,7 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person1.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson1)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson1)
 	assert.NoError(t, err)
 
 	applicationPerson2 := models.AssociateApplicationPerson{
@@ -278,7 +281,7 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson2)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson2)
 	assert.NoError(t, err)
 
 	applicationPerson3 := models.AssociateApplicationPerson{
@@ -286,10 +289,10 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 		PersonID:      person2.ID,
 		CreatedDate:   testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = applicationPersonService.AssociateApplicationPerson(&applicationPerson3)
+	_, err = applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := applicationPersonService.GetAll()
+	personCompanies, err := applicationPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -313,7 +316,7 @@ func TestGetAllApplicationPersons_ShouldReturnAllApplicationPersons(t *testing.T
 func TestGetAllApplicationPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	applicationPersonService, _, _, _ := setupApplicationPersonService(t)
 
-	results, err := applicationPersonService.GetAll()
+	results, err := applicationPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
@@ -331,7 +334,7 @@ func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteApplicationPerson{
@@ -339,7 +342,7 @@ func TestDeleteApplicationPerson_ShouldDeleteApplicationPerson(t *testing.T) {
 		PersonID:      person.ID,
 	}
 
-	err = applicationPersonService.Delete(&deleteModel)
+	err = applicationPersonService.Delete(context.Background(), &deleteModel)
 	assert.NoError(t, err)
 }
 
@@ -354,7 +357,7 @@ func TestDeleteApplicationPerson_ShouldReturnNotFoundErrorIfNoMatchingApplicatio
 		ApplicationID: application.ID,
 		PersonID:      person.ID,
 	}
-	_, err := applicationPersonService.AssociateApplicationPerson(&applicationPerson)
+	_, err := applicationPersonService.AssociateApplicationPerson(context.Background(), &applicationPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteApplicationPerson{
@@ -362,7 +365,7 @@ func TestDeleteApplicationPerson_ShouldReturnNotFoundErrorIfNoMatchingApplicatio
 		PersonID:      uuid.New(),
 	}
 
-	err = applicationPersonService.Delete(&deleteModel)
+	err = applicationPersonService.Delete(context.Background(), &deleteModel)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
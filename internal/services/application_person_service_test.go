@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/testutil"
@@ -15,7 +16,7 @@ import (
 func TestAssociateApplicationPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
 	service := NewApplicationPersonService(nil)
 
-	nilApplication, err := service.AssociateApplicationPerson(nil)
+	nilApplication, err := service.AssociateApplicationPerson(context.Background(), nil)
 	assert.Nil(t, nilApplication)
 
 	var validationError *internalErrors.ValidationError
@@ -58,7 +59,7 @@ func TestGetByID_ShouldReturnValidationErrorIfApplicationIDAndPersonIDAreEmpty(t
 		t.Run(test.testName, func(t *testing.T) {
 			service := NewApplicationPersonService(nil)
 
-			personCompanies, err := service.GetByID(test.applicationID, test.personID)
+			personCompanies, err := service.GetByID(context.Background(), test.applicationID, test.personID)
 			assert.Nil(t, personCompanies)
 
 			assert.Error(t, err)
@@ -67,6 +68,31 @@ func TestGetByID_ShouldReturnValidationErrorIfApplicationIDAndPersonIDAreEmpty(t
 	}
 }
 
+// -------- Update tests: --------
+
+func TestUpdateApplicationPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
+	service := NewApplicationPersonService(nil)
+
+	err := service.Update(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: UpdateApplicationPerson model is nil", validationError.Error())
+}
+
+func TestUpdateApplicationPerson_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	service := NewApplicationPersonService(nil)
+
+	err := service.Update(context.Background(), &models.UpdateApplicationPerson{
+		ApplicationID: uuid.New(),
+		PersonID:      uuid.New(),
+	})
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
 // -------- Delete tests: --------
 
 func TestDelete_ShouldReturnValidationErrorIfApplicationIDOrPersonIDisEmpty(t *testing.T) {
@@ -105,7 +131,7 @@ func TestDelete_ShouldReturnValidationErrorIfApplicationIDOrPersonIDisEmpty(t *t
 				PersonID:      test.personID,
 			}
 
-			err := service.Delete(&deleteModel)
+			err := service.Delete(context.Background(), &deleteModel)
 			assert.Error(t, err)
 
 			var validationError *internalErrors.ValidationError
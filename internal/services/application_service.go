@@ -1,28 +1,32 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/jobadurl"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type ApplicationService struct {
-	applicationRepository *repositories.ApplicationRepository
+	applicationRepository ApplicationRepository
 }
 
-func NewApplicationService(applicationRepository *repositories.ApplicationRepository) *ApplicationService {
+func NewApplicationService(applicationRepository ApplicationRepository) *ApplicationService {
 	return &ApplicationService{applicationRepository: applicationRepository}
 }
 
 // CreateApplication can return  ConflictError, InternalServiceError, ValidationError
 func (applicationService *ApplicationService) CreateApplication(
-	application *models.CreateApplication) (*models.Application, error) {
+	ctx context.Context, application *models.CreateApplication) (*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if application == nil {
-		slog.Error("application_service.CreateApplication: application is nil")
+		logger.Error("application_service.CreateApplication: application is nil")
 		return nil, internalErrors.NewValidationError(nil, "CreateApplication is nil")
 	}
 
@@ -34,75 +38,138 @@ func (applicationService *ApplicationService) CreateApplication(
 		} else {
 			applicationID = "[not set]"
 		}
-		slog.Info(
+		logger.Info(
 			"company_service.CreateApplication: Application to create is invalid. ",
 			"ID", applicationID,
 			"error", err)
 		return nil, err
 	}
 
+	application.JobAdURL = normalizeJobAdURL(application.JobAdURL)
+
 	if application.CreatedDate == nil {
 		createdDate := time.Now()
 		application.CreatedDate = &createdDate
 	} else if application.CreatedDate.IsZero() {
 		createdDate := time.Now()
 		application.CreatedDate = &createdDate
-		slog.Info(
+		logger.Info(
 			"application_service.createApplication: application.CreatedDate is zero. Setting to '" +
 				application.CreatedDate.String() + "'")
 	}
 
 	// can return ConflictError, InternalServiceError, ValidationError
-	insertedApplication, err := applicationService.applicationRepository.Create(application)
+	insertedApplication, err := applicationService.applicationRepository.Create(ctx, application)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("application_service.createApplication: Inserted application.", "application.ID", insertedApplication.ID)
+	logger.Info("application_service.createApplication: Inserted application.", "application.ID", insertedApplication.ID)
 	return insertedApplication, nil
 }
 
+// CheckForDuplicateApplication returns a ConflictError if an application with the same normalized job ad URL, or with
+// the same company and job title, already exists.
+//
+// CheckForDuplicateApplication can return ConflictError, InternalServiceError, ValidationError
+func (applicationService *ApplicationService) CheckForDuplicateApplication(
+	ctx context.Context, application *models.CreateApplication) error {
+
+	logger := logging.FromContext(ctx)
+
+	if application == nil {
+		logger.Error("ApplicationService.CheckForDuplicateApplication: application is nil")
+		return internalErrors.NewValidationError(nil, "CreateApplication is nil")
+	}
+
+	// can return InternalServiceError
+	duplicates, err := applicationService.applicationRepository.GetAllByJobAdURLOrCompanyAndJobTitle(
+		ctx, normalizeJobAdURL(application.JobAdURL), application.CompanyID, application.JobTitle)
+	if err != nil {
+		return err
+	}
+
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	logger.Info(
+		"ApplicationService.CheckForDuplicateApplication: Found duplicate application",
+		"duplicate.ID", duplicates[0].ID)
+	return internalErrors.NewConflictError(
+		"an application with the same job ad URL, or the same company and job title, already exists: '" +
+			duplicates[0].ID.String() + "'")
+}
+
 // GetApplicationById can return  ConflictError, InternalServiceError, NewValidationError
 func (applicationService *ApplicationService) GetApplicationById(
-	applicationId *uuid.UUID) (*models.Application, error) {
+	ctx context.Context, applicationId *uuid.UUID) (*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if applicationId == nil {
 		applicationIdString := "application ID"
 		err := internalErrors.NewValidationError(&applicationIdString, "applicationId is required")
-		slog.Info("applicationService.GetApplicationById: Failed to get application", "error", err)
+		logger.Info("applicationService.GetApplicationById: Failed to get application", "error", err)
 		return nil, err
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	application, err := applicationService.applicationRepository.GetById(applicationId)
+	application, err := applicationService.applicationRepository.GetById(ctx, applicationId)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("ApplicationService.GetApplicationById: Retrieved company.", "company.ID", application.ID.String())
+	logger.Info("ApplicationService.GetApplicationById: Retrieved company.", "company.ID", application.ID.String())
 
 	return application, nil
 }
 
+// GetApplicationsByIDs returns the applications with the given IDs, in the order of ids. IDs that do not exist are
+// skipped.
+//
+// GetApplicationsByIDs can return InternalServiceError, ValidationError
+func (applicationService *ApplicationService) GetApplicationsByIDs(
+	ctx context.Context, ids []uuid.UUID) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, ValidationError
+	applications, err := applicationService.applicationRepository.GetAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"ApplicationService.GetApplicationsByIDs: Retrieved applications",
+		"requested", len(ids),
+		"found", len(applications))
+
+	return applications, nil
+}
+
 // GetApplicationsByJobTitle can return InternalServiceError, NotFoundError, ValidationError
 func (applicationService *ApplicationService) GetApplicationsByJobTitle(
-	applicationJobTitle *string) ([]*models.Application, error) {
+	ctx context.Context, applicationJobTitle *string) ([]*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if applicationJobTitle == nil || *applicationJobTitle == "" {
 		applicationJobTitleString := "applicationJobTitle"
 		err := internalErrors.NewValidationError(&applicationJobTitleString, "applicationJobTitle is required")
-		slog.Info("applicationService.GetApplicationByJobTitle: Failed to get applications", "error", err)
+		logger.Info("applicationService.GetApplicationByJobTitle: Failed to get applications", "error", err)
 		return nil, err
 	}
 
-	applications, err := applicationService.applicationRepository.GetAllByJobTitle(applicationJobTitle)
+	applications, err := applicationService.applicationRepository.GetAllByJobTitle(ctx, applicationJobTitle)
 	if err != nil {
 		return nil, err
 	}
 
 	if applications == nil {
-		slog.Info("ApplicationService.GetApplicationsByJobTitle: Retrieved zero applications")
+		logger.Info("ApplicationService.GetApplicationsByJobTitle: Retrieved zero applications")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationService.GetApplicationsByJobTitle: Retrieved " +
 				string(rune(len(applications))) +
 				" applications")
@@ -113,13 +180,17 @@ func (applicationService *ApplicationService) GetApplicationsByJobTitle(
 
 // GetAllApplications can return InternalServiceError
 func (applicationService *ApplicationService) GetAllApplications(
+	ctx context.Context,
 	includeCompany models.IncludeExtraDataType,
 	includeRecruiter models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType) ([]*models.Application, error) {
 
+	logger := logging.FromContext(ctx)
+
 	// can return InternalServiceError
 	applications, err := applicationService.applicationRepository.GetAll(
+		ctx,
 		includeCompany,
 		includeRecruiter,
 		includePersons,
@@ -130,52 +201,114 @@ func (applicationService *ApplicationService) GetAllApplications(
 	}
 
 	if applications == nil {
-		slog.Info("ApplicationService.GetAllApplications: Retrieved zero applications")
+		logger.Info("ApplicationService.GetAllApplications: Retrieved zero applications")
 	} else {
-		slog.Info(
+		logger.Info(
 			"ApplicationService.GetAllApplications: Retrieved " + string(rune(len(applications))) + " applications")
 	}
 
 	return applications, nil
 }
 
-// UpdateApplication can return InternalServiceError, ValidationError
-func (applicationService *ApplicationService) UpdateApplication(application *models.UpdateApplication) error {
+// UpdateApplication can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (applicationService *ApplicationService) UpdateApplication(
+	ctx context.Context, application *models.UpdateApplication) error {
+
+	logger := logging.FromContext(ctx)
+
 	if application == nil {
-		slog.Error("ApplicationService.UpdateApplication: UpdateApplication is nil")
+		logger.Error("ApplicationService.UpdateApplication: UpdateApplication is nil")
 		return internalErrors.NewValidationError(nil, "UpdateApplication model is nil")
 	}
 
 	// can return ValidationError
 	err := application.Validate()
 	if err != nil {
-		slog.Info("ApplicationService.UpdateApplication: UpdateApplication model is invalid. ", "error", err)
+		logger.Info("ApplicationService.UpdateApplication: UpdateApplication model is invalid. ", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = applicationService.applicationRepository.Update(application)
+	application.JobAdURL = normalizeJobAdURL(application.JobAdURL)
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = applicationService.applicationRepository.Update(ctx, application)
 	if err != nil {
-		slog.Error("ApplicationService.UpdateApplication: Error updating application", "error", err)
+		logger.Error("ApplicationService.UpdateApplication: Error updating application", "error", err)
 	}
 
 	return err
 }
 
-// DeleteApplication can return InternalServiceError, NotFoundError, ValidationError
-func (applicationService *ApplicationService) DeleteApplication(applicationId *uuid.UUID) error {
+// DeleteApplication deletes the application. If expectedVersion is set, it is only deleted if it has that version.
+//
+// DeleteApplication can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (applicationService *ApplicationService) DeleteApplication(
+	ctx context.Context, applicationId *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+
 	if applicationId == nil {
 		applicationIdString := "application ID"
 		err := internalErrors.NewValidationError(&applicationIdString, "applicationId is required")
-		slog.Info("ApplicationService.DeleteApplication: Error deleting application", "error", err)
+		logger.Info("ApplicationService.DeleteApplication: Error deleting application", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err := applicationService.applicationRepository.Delete(applicationId)
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err := applicationService.applicationRepository.Delete(ctx, applicationId, expectedVersion)
 	if err != nil {
-		slog.Error("ApplicationService.DeleteApplication: Error deleting application", "error", err)
+		logger.Error("ApplicationService.DeleteApplication: Error deleting application", "error", err)
 	}
 
 	return err
 }
+
+// NormalizeJobAdURLs stores the job ad URLs that were saved before job ad URLs were normalized in their normalized
+// form, so that duplicate applications are found by their URL. It should be called once the database is migrated,
+// and returns how many URLs were changed.
+//
+// NormalizeJobAdURLs can return InternalServiceError, NotFoundError
+func (applicationService *ApplicationService) NormalizeJobAdURLs(ctx context.Context) (int, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError
+	jobAdURLs, err := applicationService.applicationRepository.GetAllJobAdURLs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := 0
+	for id, jobAdURL := range jobAdURLs {
+		normalizedJobAdURL := normalizeJobAdURL(&jobAdURL)
+		if *normalizedJobAdURL == jobAdURL {
+			continue
+		}
+
+		// can return InternalServiceError, NotFoundError
+		err = applicationService.applicationRepository.UpdateJobAdURL(ctx, id, *normalizedJobAdURL)
+		if err != nil {
+			return changed, err
+		}
+		changed++
+	}
+
+	if changed > 0 {
+		logger.Info("ApplicationService.NormalizeJobAdURLs: Normalized job ad URLs", "changed", changed)
+	}
+	return changed, nil
+}
+
+// normalizeJobAdURL returns the normalized form of jobAdURL. Values that are not links to a web page are kept as
+// they are.
+func normalizeJobAdURL(jobAdURL *string) *string {
+	if jobAdURL == nil {
+		return nil
+	}
+
+	normalizedJobAdURL, ok := jobadurl.Normalize(*jobAdURL)
+	if !ok {
+		return jobAdURL
+	}
+
+	return &normalizedJobAdURL
+}
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -101,7 +102,7 @@ func TestCreateApplication_ShouldWork(t *testing.T) {
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
 
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -133,7 +134,7 @@ func TestCreateApplication_ShouldHandleEmptyFields(t *testing.T) {
 	}
 
 	createdDateApproximation := time.Now()
-	insertedApplication, err := applicationService.CreateApplication(&application)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -161,13 +162,16 @@ func TestCreateApplication_ShouldReturnErrorIfCompanyIdIsNotInCompany(t *testing
 		JobAdURL:         testutil.ToPtr("Job Ad URL"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	response, err := applicationService.CreateApplication(&application)
+	response, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.Nil(t, response)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or RecruiterID': CompanyID or RecruiterID does not exist",
+		validationError.Error())
 }
 
 func TestCreateApplication_ShouldReturnErrorIfRecruiterIdIsNotInCompany(t *testing.T) {
@@ -178,13 +182,176 @@ func TestCreateApplication_ShouldReturnErrorIfRecruiterIdIsNotInCompany(t *testi
 		JobAdURL:         testutil.ToPtr("Job Ad URL"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	response, err := applicationService.CreateApplication(&application)
+	response, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.Nil(t, response)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
-	assert.Equal(t, "validation error: Foreign key does not exist", validationError.Error())
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or RecruiterID': CompanyID or RecruiterID does not exist",
+		validationError.Error())
+}
+
+func TestCreateApplication_ShouldNormalizeJobAdURL(t *testing.T) {
+	applicationService, companyRepository, _, _, _, _ := setupApplicationService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	application, err := applicationService.CreateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobAdURL:         testutil.ToPtr("HTTP://www.Acme.se/jobs/123/?utm_source=linkedin"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.se/jobs/123", *application.JobAdURL)
+}
+
+// -------- NormalizeJobAdURLs tests: --------
+
+func TestNormalizeJobAdURLs_ShouldNormalizeURLsSavedBeforeNormalization(t *testing.T) {
+	config := configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+	container := dependencyinjection.SetupApplicationServiceTestContainer(t, config)
+
+	var applicationService *services.ApplicationService
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		service *services.ApplicationService,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository) {
+
+		applicationService = service
+		applicationRepository = application
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	// the repository stores URLs as they are given, as applications did before URLs were normalized
+	createWithJobAdURL := func(jobAdURL string) *models.Application {
+		application, err := applicationRepository.Create(context.Background(), &models.CreateApplication{
+			CompanyID:        &companyID,
+			JobAdURL:         &jobAdURL,
+			RemoteStatusType: models.RemoteStatusTypeRemote,
+		})
+		assert.NoError(t, err)
+		return application
+	}
+	old := createWithJobAdURL("HTTP://www.Acme.se/jobs/123/?utm_source=linkedin")
+	normalized := createWithJobAdURL("https://acme.se/jobs/456")
+	notAURL := createWithJobAdURL("ask Anna")
+
+	changed, err := applicationService.NormalizeJobAdURLs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	retrieved, err := applicationRepository.GetById(context.Background(), &old.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.se/jobs/123", *retrieved.JobAdURL)
+	assert.Equal(t, 2, retrieved.Version)
+
+	retrieved, err = applicationRepository.GetById(context.Background(), &normalized.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, retrieved.Version)
+
+	retrieved, err = applicationRepository.GetById(context.Background(), &notAURL.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "ask Anna", *retrieved.JobAdURL)
+
+	// the old application is now found by the URL of a new one
+	recruiterID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	err = applicationService.CheckForDuplicateApplication(context.Background(), &models.CreateApplication{
+		RecruiterID:      &recruiterID,
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/123?utm_medium=email"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+
+	changed, err = applicationService.NormalizeJobAdURLs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, changed)
+}
+
+// -------- CheckForDuplicateApplication tests: --------
+
+func TestCheckForDuplicateApplication_ShouldReturnConflictErrorIfNormalizedJobAdURLExists(t *testing.T) {
+	applicationService, companyRepository, _, _, _, _ := setupApplicationService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	recruiterID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	existing, err := applicationService.CreateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/123"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	err = applicationService.CheckForDuplicateApplication(context.Background(), &models.CreateApplication{
+		RecruiterID:      &recruiterID,
+		JobAdURL:         testutil.ToPtr("acme.se/jobs/123/?utm_medium=email#apply"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+	assert.Equal(
+		t,
+		"conflict error on insert: an application with the same job ad URL, or the same company and job title, "+
+			"already exists: '"+existing.ID.String()+"'",
+		conflictError.Error())
+}
+
+func TestCheckForDuplicateApplication_ShouldReturnConflictErrorIfCompanyAndJobTitleExist(t *testing.T) {
+	applicationService, companyRepository, _, _, _, _ := setupApplicationService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	_, err := applicationService.CreateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobTitle:         testutil.ToPtr("Backend Developer"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	err = applicationService.CheckForDuplicateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobTitle:         testutil.ToPtr("backend developer"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+}
+
+func TestCheckForDuplicateApplication_ShouldReturnNilIfThereIsNoDuplicate(t *testing.T) {
+	applicationService, companyRepository, _, _, _, _ := setupApplicationService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	otherCompanyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+
+	_, err := applicationService.CreateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobTitle:         testutil.ToPtr("Backend Developer"),
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/123"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	err = applicationService.CheckForDuplicateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &otherCompanyID,
+		JobTitle:         testutil.ToPtr("Backend Developer"),
+		JobAdURL:         testutil.ToPtr("https://acme.se/jobs/124"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
 }
 
 // -------- GetApplicationById tests: --------
@@ -212,11 +379,11 @@ func TestGetApplicationById_ShouldWork(t *testing.T) {
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
 
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
-	retrievedApplication, err := applicationService.GetApplicationById(applicationToInsert.ID)
+	retrievedApplication, err := applicationService.GetApplicationById(context.Background(), applicationToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedApplication)
 
@@ -239,7 +406,7 @@ func TestGetApplicationById_ShouldReturnNotFoundErrorForAnIdThatDoesNotExist(t *
 	applicationService, _, _, _, _, _ := setupApplicationService(t)
 
 	id := uuid.New()
-	nilApplication, err := applicationService.GetApplicationById(&id)
+	nilApplication, err := applicationService.GetApplicationById(context.Background(), &id)
 	assert.Nil(t, nilApplication)
 
 	assert.Error(t, err)
@@ -274,7 +441,7 @@ func TestGetApplicationsByJobTitle_ShouldReturnApplications(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedApplication, err := applicationService.CreateApplication(&application1ToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &application1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -284,7 +451,7 @@ func TestGetApplicationsByJobTitle_ShouldReturnApplications(t *testing.T) {
 		JobTitle:         testutil.ToPtr("Backend Developer"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	_, err = applicationService.CreateApplication(&application2ToInsert)
+	_, err = applicationService.CreateApplication(context.Background(), &application2ToInsert)
 	assert.NoError(t, err)
 
 	application3ToInsert := models.CreateApplication{
@@ -293,12 +460,12 @@ func TestGetApplicationsByJobTitle_ShouldReturnApplications(t *testing.T) {
 		JobTitle:         testutil.ToPtr("utvecklare till en företag"),
 		RemoteStatusType: models.RemoteStatusTypeRemote,
 	}
-	_, err = applicationService.CreateApplication(&application3ToInsert)
+	_, err = applicationService.CreateApplication(context.Background(), &application3ToInsert)
 	assert.NoError(t, err)
 
 	// GetByJobTitle
 
-	applications, err := applicationService.GetApplicationsByJobTitle(testutil.ToPtr("developer"))
+	applications, err := applicationService.GetApplicationsByJobTitle(context.Background(), testutil.ToPtr("developer"))
 	assert.NoError(t, err)
 	assert.NotNil(t, applications)
 	assert.Len(t, applications, 2)
@@ -333,13 +500,13 @@ func TestGetApplicationsByJobTitle_ShouldReturnNotFoundErrorIfNoNamesMatch(t *te
 		JobTitle:         testutil.ToPtr("Backend Engineer"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	_, err := applicationService.CreateApplication(&applicationToInsert)
+	_, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 
 	// GetByJobTitle
 
 	jobTitleToGet := "Developer"
-	applications, err := applicationService.GetApplicationsByJobTitle(&jobTitleToGet)
+	applications, err := applicationService.GetApplicationsByJobTitle(context.Background(), &jobTitleToGet)
 	assert.Nil(t, applications)
 	assert.Error(t, err)
 
@@ -374,7 +541,7 @@ func TestGetAlLApplications_ShouldWork(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, -8)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := applicationService.CreateApplication(&applicationToInsert1)
+	_, err := applicationService.CreateApplication(context.Background(), &applicationToInsert1)
 	assert.NoError(t, err)
 
 	applicationToInsert2 := models.CreateApplication{
@@ -382,13 +549,14 @@ func TestGetAlLApplications_ShouldWork(t *testing.T) {
 		JobTitle:         testutil.ToPtr("JobTitle2"),
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
-	insertedApplication2, err := applicationService.CreateApplication(&applicationToInsert2)
+	insertedApplication2, err := applicationService.CreateApplication(context.Background(), &applicationToInsert2)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication2)
 
 	// getAll
 
 	applications, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -421,6 +589,7 @@ func TestGetAlLApplications_ShouldReturnNilIfNoApplicationsInDatabase(t *testing
 	applicationService, _, _, _, _, _ := setupApplicationService(t)
 
 	applications, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -446,7 +615,7 @@ func TestGetAllApplications_ShouldReturnCompanyIfIncludeCompanyIsSetToAll(t *tes
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -455,13 +624,14 @@ func TestGetAllApplications_ShouldReturnCompanyIfIncludeCompanyIsSetToAll(t *tes
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -500,13 +670,14 @@ func TestGetAllApplications_ShouldReturnNoCompanyIfIncludeCompanyIsSetToAllAndTh
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -537,7 +708,7 @@ func TestGetAllApplications_ShouldReturnCompanyWithOnlyIDIfIncludeCompanyIsSetTo
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -546,13 +717,14 @@ func TestGetAllApplications_ShouldReturnCompanyWithOnlyIDIfIncludeCompanyIsSetTo
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -591,13 +763,14 @@ func TestGetAllApplications_ShouldReturnNoCompanyIfIncludeCompanyIsSetToIDsAndTh
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -628,7 +801,7 @@ func TestGetAllApplications_ShouldReturnNoCompanyIfIncludeCompanyIsSetToNone(t *
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -637,13 +810,14 @@ func TestGetAllApplications_ShouldReturnNoCompanyIfIncludeCompanyIsSetToNone(t *
 		JobTitle:         testutil.ToPtr("Job Title1"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication1, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication1, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -676,7 +850,7 @@ func TestGetAllApplications_ShouldReturnRecruiterIfIncludeRecruiterIsSetToAll(t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -685,13 +859,14 @@ func TestGetAllApplications_ShouldReturnRecruiterIfIncludeRecruiterIsSetToAll(t
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
@@ -730,13 +905,14 @@ func TestGetAllApplications_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToAllA
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
@@ -767,7 +943,7 @@ func TestGetAllApplications_ShouldReturnRecruiterWithOnlyIDIfIncludeRecruiterIsS
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -776,13 +952,14 @@ func TestGetAllApplications_ShouldReturnRecruiterWithOnlyIDIfIncludeRecruiterIsS
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
@@ -821,13 +998,14 @@ func TestGetAllApplications_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToIDsA
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
@@ -858,7 +1036,7 @@ func TestGetAllApplications_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToNone
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -6)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -5)),
 	}
-	_, err := companyRepository.Create(&companyToInsert)
+	_, err := companyRepository.Create(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	applicationToInsert := models.CreateApplication{
@@ -867,13 +1045,14 @@ func TestGetAllApplications_ShouldReturnNoRecruiterIfIncludeRecruiterIsSetToNone
 		JobTitle:         testutil.ToPtr("Job Title1"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication1, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication1, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication1)
 
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -906,7 +1085,7 @@ func TestGetAllApplications_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testi
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -921,7 +1100,7 @@ func TestGetAllApplications_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testi
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = eventRepository.Create(&event1ToInsert)
+	_, err = eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -943,6 +1122,7 @@ func TestGetAllApplications_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testi
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -982,7 +1162,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToAllAndTher
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -991,6 +1171,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToAllAndTher
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1019,7 +1200,7 @@ func TestGetAllApplications_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *tes
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1034,7 +1215,7 @@ func TestGetAllApplications_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *tes
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = eventRepository.Create(&event1ToInsert)
+	_, err = eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
@@ -1056,6 +1237,7 @@ func TestGetAllApplications_ShouldReturnEventIDsIfIncludeEventsIsSetToIDs(t *tes
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1095,7 +1277,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToIDsAndTher
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1104,6 +1286,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToIDsAndTher
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1132,7 +1315,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToNone(t *te
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1150,6 +1333,7 @@ func TestGetAllApplications_ShouldReturnNoEventsIfIncludeEventsIsSetToNone(t *te
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1180,7 +1364,7 @@ func TestGetAllApplications_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *tes
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1194,7 +1378,7 @@ func TestGetAllApplications_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *tes
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = personRepository.Create(&person1ToInsert)
+	_, err = personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1216,6 +1400,7 @@ func TestGetAllApplications_ShouldReturnPersonsIfIncludePersonsIsSetToAll(t *tes
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
@@ -1257,7 +1442,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToAllAndTh
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1266,6 +1451,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToAllAndTh
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
@@ -1294,7 +1480,7 @@ func TestGetAllApplications_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *t
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1308,7 +1494,7 @@ func TestGetAllApplications_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *t
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err = personRepository.Create(&person1ToInsert)
+	_, err = personRepository.Create(context.Background(), &person1ToInsert)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(t, personRepository, nil, nil).ID
@@ -1330,6 +1516,7 @@ func TestGetAllApplications_ShouldReturnPersonIDsIfIncludePersonsIsSetToIDs(t *t
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1371,7 +1558,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToIDsAndTh
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1380,6 +1567,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToIDsAndTh
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
@@ -1408,7 +1596,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToNone(t *
 		JobTitle:         testutil.ToPtr("Job Title"),
 		RemoteStatusType: models.RemoteStatusTypeUnknown,
 	}
-	insertedApplication, err := applicationService.CreateApplication(&applicationToInsert)
+	insertedApplication, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedApplication)
 
@@ -1424,6 +1612,7 @@ func TestGetAllApplications_ShouldReturnNoPersonsIfIncludePersonsIsSetToNone(t *
 	// get all applications
 
 	results, err := applicationService.GetAllApplications(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
@@ -1465,7 +1654,7 @@ func TestUpdateApplication_ShouldWork(t *testing.T) {
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(2, 0, 0)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(3, 0, 0)),
 	}
-	_, err := applicationService.CreateApplication(&applicationToInsert)
+	_, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 
 	// update application
@@ -1490,12 +1679,12 @@ func TestUpdateApplication_ShouldWork(t *testing.T) {
 	}
 
 	updatedDateApproximation := time.Now()
-	err = applicationService.UpdateApplication(&applicationToUpdate)
+	err = applicationService.UpdateApplication(context.Background(), &applicationToUpdate)
 	assert.NoError(t, err)
 
 	// get ById
 
-	application, err := applicationService.GetApplicationById(&id)
+	application, err := applicationService.GetApplicationById(context.Background(), &id)
 	assert.NoError(t, err)
 	assert.NotNil(t, application)
 
@@ -1514,6 +1703,28 @@ func TestUpdateApplication_ShouldWork(t *testing.T) {
 	testutil.AssertDateTimesWithinDelta(t, &updatedDateApproximation, application.UpdatedDate, time.Second)
 }
 
+func TestUpdateApplication_ShouldNormalizeJobAdURL(t *testing.T) {
+	applicationService, companyRepository, _, _, _, _ := setupApplicationService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application, err := applicationService.CreateApplication(context.Background(), &models.CreateApplication{
+		CompanyID:        &companyID,
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	assert.NoError(t, err)
+
+	err = applicationService.UpdateApplication(context.Background(), &models.UpdateApplication{
+		ID:       application.ID,
+		JobAdURL: testutil.ToPtr("https://WWW.ACME.SE/jobs/123?gclid=abc&id=7"),
+	})
+	assert.NoError(t, err)
+
+	updatedApplication, err := applicationService.GetApplicationById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.se/jobs/123?id=7", *updatedApplication.JobAdURL)
+}
+
 func TestUpdateApplication_ShouldNotReturnErrorIfIdToUpdateDoesNotExist(t *testing.T) {
 	applicationService, _, _, _, _, _ := setupApplicationService(t)
 
@@ -1522,7 +1733,7 @@ func TestUpdateApplication_ShouldNotReturnErrorIfIdToUpdateDoesNotExist(t *testi
 		JobTitle: testutil.ToPtr("JobTitle"),
 	}
 
-	err := applicationService.UpdateApplication(&applicationToUpdate)
+	err := applicationService.UpdateApplication(context.Background(), &applicationToUpdate)
 	assert.NoError(t, err)
 }
 
@@ -1541,17 +1752,17 @@ func TestDeleteApplication_ShouldWork(t *testing.T) {
 		JobAdURL:         testutil.ToPtr("JobAdURL"),
 		RemoteStatusType: models.PersonTypeUnknown,
 	}
-	_, err := applicationService.CreateApplication(&applicationToInsert)
+	_, err := applicationService.CreateApplication(context.Background(), &applicationToInsert)
 	assert.NoError(t, err)
 
 	// delete application
 
-	err = applicationService.DeleteApplication(&id)
+	err = applicationService.DeleteApplication(context.Background(), &id, nil)
 	assert.NoError(t, err)
 
 	//ensure that application is deleted
 
-	retrievedApplication, err := applicationService.GetApplicationById(&id)
+	retrievedApplication, err := applicationService.GetApplicationById(context.Background(), &id)
 	assert.Nil(t, retrievedApplication)
 	assert.Error(t, err)
 
@@ -1564,7 +1775,7 @@ func TestDeleteApplication_ShouldReturnNotFoundErrorIfIdToDeleteDoesNotExist(t *
 	applicationService, _, _, _, _, _ := setupApplicationService(t)
 
 	id := uuid.New()
-	err := applicationService.DeleteApplication(&id)
+	err := applicationService.DeleteApplication(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
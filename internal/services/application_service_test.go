@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
@@ -17,7 +18,7 @@ import (
 func TestCreateApplication_ShouldReturnValidationErrorOnNilApplication(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	nilApplication, err := applicationService.CreateApplication(nil)
+	nilApplication, err := applicationService.CreateApplication(context.Background(), nil)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -36,7 +37,7 @@ func TestCreateApplication_ShouldReturnValidationErrorOnNilCompanyIDAndNilRecrui
 		RemoteStatusType: models.RemoteStatusTypeRemote,
 	}
 
-	nilApplication, err := applicationService.CreateApplication(&application)
+	nilApplication, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -89,7 +90,7 @@ func TestCreateApplication_ShouldReturnValidationErrorOnNilOrEmptyCompanyIDAndNi
 				JobAdURL:         test.jobAdURL,
 				RemoteStatusType: models.RemoteStatusTypeRemote,
 			}
-			nilApplication, err := applicationService.CreateApplication(&application)
+			nilApplication, err := applicationService.CreateApplication(context.Background(), &application)
 			assert.Nil(t, nilApplication)
 			assert.Error(t, err)
 
@@ -109,7 +110,7 @@ func TestCreateApplication_ShouldReturnValidationErrorOnInvalidRemoteStatusType(
 		JobAdURL:         testutil.ToPtr("jobAdURL"),
 		RemoteStatusType: remoteStatusType,
 	}
-	nilApplication, err := applicationService.CreateApplication(&application)
+	nilApplication, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -127,7 +128,7 @@ func TestCreateApplication_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *test
 		RemoteStatusType: models.RemoteStatusTypeRemote,
 		UpdatedDate:      &time.Time{},
 	}
-	nilApplication, err := applicationService.CreateApplication(&application)
+	nilApplication, err := applicationService.CreateApplication(context.Background(), &application)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -139,12 +140,24 @@ func TestCreateApplication_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *test
 		validationError.Error())
 }
 
+// -------- CheckForDuplicateApplication tests: --------
+
+func TestCheckForDuplicateApplication_ShouldReturnValidationErrorOnNilApplication(t *testing.T) {
+	applicationService := NewApplicationService(nil)
+
+	err := applicationService.CheckForDuplicateApplication(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: CreateApplication is nil", validationError.Error())
+}
+
 // -------- GetApplicationById tests: --------
 
 func TestGetApplicationById_ShouldReturnValidationErrorIfApplicationIdIsNil(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	nilApplication, err := applicationService.GetApplicationById(nil)
+	nilApplication, err := applicationService.GetApplicationById(context.Background(), nil)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -158,7 +171,7 @@ func TestGetApplicationById_ShouldReturnValidationErrorIfApplicationIdIsNil(t *t
 func TestGetApplicationsByJobTitle_ShouldReturnValidationErrorIfJobTitleIsNil(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	nilApplication, err := applicationService.GetApplicationsByJobTitle(nil)
+	nilApplication, err := applicationService.GetApplicationsByJobTitle(context.Background(), nil)
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -173,7 +186,7 @@ func TestGetApplicationsByJobTitle_ShouldReturnValidationErrorIfJobTitleIsNil(t
 func TestGetApplicationsByJobTitle_ShouldReturnValidationErrorIfJobTitleIsEmpty(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	nilApplication, err := applicationService.GetApplicationsByJobTitle(testutil.ToPtr(""))
+	nilApplication, err := applicationService.GetApplicationsByJobTitle(context.Background(), testutil.ToPtr(""))
 	assert.Nil(t, nilApplication)
 	assert.Error(t, err)
 
@@ -190,7 +203,7 @@ func TestGetApplicationsByJobTitle_ShouldReturnValidationErrorIfJobTitleIsEmpty(
 func TestUpdateApplication_ShouldReturnValidationErrorIfApplicationIsNil(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	err := applicationService.UpdateApplication(nil)
+	err := applicationService.UpdateApplication(context.Background(), nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -205,7 +218,7 @@ func TestUpdateApplication_ShouldReturnValidationErrorIfApplicationContainsNothi
 		ID: uuid.New(),
 	}
 
-	err := applicationService.UpdateApplication(&application)
+	err := applicationService.UpdateApplication(context.Background(), &application)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -219,7 +232,7 @@ func TestUpdateApplication_ShouldReturnValidationErrorIfApplicationContainsNothi
 func TestDeleteApplication_ShouldReturnValidationErrorIfApplicationIdIsNil(t *testing.T) {
 	applicationService := NewApplicationService(nil)
 
-	err := applicationService.DeleteApplication(nil)
+	err := applicationService.DeleteApplication(context.Background(), nil, nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+)
+
+// BulkLinkService associates or deletes many links in a single transaction. A link that conflicts, does not exist or
+// is invalid is reported in its result instead of failing the whole batch. Any other error rolls back every link.
+type BulkLinkService struct {
+	unitOfWork               UnitOfWork
+	eventTransitionValidator *EventTransitionValidator
+}
+
+func NewBulkLinkService(unitOfWork UnitOfWork, eventTransitionValidator *EventTransitionValidator) *BulkLinkService {
+	return &BulkLinkService{unitOfWork: unitOfWork, eventTransitionValidator: eventTransitionValidator}
+}
+
+// AssociateApplicationEvents can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) AssociateApplicationEvents(
+	ctx context.Context, links []*models.AssociateApplicationEvent) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusAssociated,
+		func(repositories *Repositories) func(link *models.AssociateApplicationEvent) error {
+			applicationEventService := bulkLinkService.newApplicationEventService(repositories)
+			return func(link *models.AssociateApplicationEvent) error {
+				_, err := applicationEventService.AssociateApplicationEvent(ctx, link)
+				return err
+			}
+		})
+}
+
+// DeleteApplicationEvents can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) DeleteApplicationEvents(
+	ctx context.Context, links []*models.DeleteApplicationEvent) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusDeleted,
+		func(repositories *Repositories) func(link *models.DeleteApplicationEvent) error {
+			applicationEventService := bulkLinkService.newApplicationEventService(repositories)
+			return func(link *models.DeleteApplicationEvent) error {
+				return applicationEventService.Delete(ctx, link)
+			}
+		})
+}
+
+// AssociateApplicationPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) AssociateApplicationPersons(
+	ctx context.Context, links []*models.AssociateApplicationPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusAssociated,
+		func(repositories *Repositories) func(link *models.AssociateApplicationPerson) error {
+			applicationPersonService := NewApplicationPersonService(repositories.ApplicationPerson)
+			return func(link *models.AssociateApplicationPerson) error {
+				_, err := applicationPersonService.AssociateApplicationPerson(ctx, link)
+				return err
+			}
+		})
+}
+
+// DeleteApplicationPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) DeleteApplicationPersons(
+	ctx context.Context, links []*models.DeleteApplicationPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusDeleted,
+		func(repositories *Repositories) func(link *models.DeleteApplicationPerson) error {
+			applicationPersonService := NewApplicationPersonService(repositories.ApplicationPerson)
+			return func(link *models.DeleteApplicationPerson) error {
+				return applicationPersonService.Delete(ctx, link)
+			}
+		})
+}
+
+// AssociateCompanyEvents can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) AssociateCompanyEvents(
+	ctx context.Context, links []*models.AssociateCompanyEvent) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusAssociated,
+		func(repositories *Repositories) func(link *models.AssociateCompanyEvent) error {
+			companyEventService := NewCompanyEventService(repositories.CompanyEvent)
+			return func(link *models.AssociateCompanyEvent) error {
+				_, err := companyEventService.AssociateCompanyEvent(ctx, link)
+				return err
+			}
+		})
+}
+
+// DeleteCompanyEvents can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) DeleteCompanyEvents(
+	ctx context.Context, links []*models.DeleteCompanyEvent) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusDeleted,
+		func(repositories *Repositories) func(link *models.DeleteCompanyEvent) error {
+			companyEventService := NewCompanyEventService(repositories.CompanyEvent)
+			return func(link *models.DeleteCompanyEvent) error {
+				return companyEventService.Delete(ctx, link)
+			}
+		})
+}
+
+// AssociateCompanyPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) AssociateCompanyPersons(
+	ctx context.Context, links []*models.AssociateCompanyPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusAssociated,
+		func(repositories *Repositories) func(link *models.AssociateCompanyPerson) error {
+			companyPersonService := NewCompanyPersonService(repositories.CompanyPerson)
+			return func(link *models.AssociateCompanyPerson) error {
+				_, err := companyPersonService.AssociateCompanyPerson(ctx, link)
+				return err
+			}
+		})
+}
+
+// DeleteCompanyPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) DeleteCompanyPersons(
+	ctx context.Context, links []*models.DeleteCompanyPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusDeleted,
+		func(repositories *Repositories) func(link *models.DeleteCompanyPerson) error {
+			companyPersonService := NewCompanyPersonService(repositories.CompanyPerson)
+			return func(link *models.DeleteCompanyPerson) error {
+				return companyPersonService.Delete(ctx, link)
+			}
+		})
+}
+
+// AssociateEventPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) AssociateEventPersons(
+	ctx context.Context, links []*models.AssociateEventPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusAssociated,
+		func(repositories *Repositories) func(link *models.AssociateEventPerson) error {
+			eventPersonService := NewEventPersonService(repositories.EventPerson)
+			return func(link *models.AssociateEventPerson) error {
+				_, err := eventPersonService.AssociateEventPerson(ctx, link)
+				return err
+			}
+		})
+}
+
+// DeleteEventPersons can return InternalServiceError, ValidationError
+func (bulkLinkService *BulkLinkService) DeleteEventPersons(
+	ctx context.Context, links []*models.DeleteEventPerson) ([]*models.BulkLinkResult, error) {
+
+	return applyBulkLinks(
+		ctx, bulkLinkService.unitOfWork, links, models.BulkLinkStatusDeleted,
+		func(repositories *Repositories) func(link *models.DeleteEventPerson) error {
+			eventPersonService := NewEventPersonService(repositories.EventPerson)
+			return func(link *models.DeleteEventPerson) error {
+				return eventPersonService.Delete(ctx, link)
+			}
+		})
+}
+
+// newApplicationEventService checks event transitions against the transactional repositories, so that each link sees
+// the links made before it in the same batch.
+func (bulkLinkService *BulkLinkService) newApplicationEventService(
+	repositories *Repositories) *ApplicationEventService {
+
+	eventTransitionValidator := bulkLinkService.eventTransitionValidator.withRepositories(
+		repositories.Event, repositories.ApplicationEvent)
+	return NewApplicationEventService(repositories.ApplicationEvent, eventTransitionValidator)
+}
+
+// applyBulkLinks calls the function returned by newApply for each link in one transaction. ConflictError,
+// NotFoundError and ValidationError are reported in the result of the link. Any other error rolls back the
+// transaction and is returned.
+//
+// applyBulkLinks can return InternalServiceError, ValidationError
+func applyBulkLinks[Link any](
+	ctx context.Context,
+	unitOfWork UnitOfWork,
+	links []*Link,
+	successStatus models.BulkLinkStatus,
+	newApply func(repositories *Repositories) func(link *Link) error) ([]*models.BulkLinkResult, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	err := models.ValidateBulkLinkCount(len(links))
+	if err != nil {
+		logger.Info("BulkLinkService: links are invalid", "error", err)
+		return nil, err
+	}
+
+	var results []*models.BulkLinkResult
+	err = unitOfWork.WithTx(ctx, func(repositories *Repositories) error {
+		apply := newApply(repositories)
+
+		results = make([]*models.BulkLinkResult, len(links))
+		for index, link := range links {
+			result := &models.BulkLinkResult{Index: index, Status: successStatus}
+
+			linkErr := apply(link)
+			if linkErr != nil {
+				status, ok := bulkLinkFailureStatus(linkErr)
+				if !ok {
+					return linkErr
+				}
+
+				message := linkErr.Error()
+				result.Status = status
+				result.Message = &message
+			}
+
+			results[index] = result
+		}
+
+		return nil
+	})
+	if err != nil {
+		var internalServiceErr *internalErrors.InternalServiceError
+		if errors.As(err, &internalServiceErr) {
+			return nil, err
+		}
+		return nil, internalErrors.NewInternalServiceError("Unable to apply links: " + err.Error())
+	}
+
+	logger.Info("BulkLinkService: Applied links.", "count", len(results))
+	return results, nil
+}
+
+// bulkLinkFailureStatus returns the status of a link that failed with err, and false if err should fail the whole
+// batch instead.
+func bulkLinkFailureStatus(err error) (models.BulkLinkStatus, bool) {
+	var conflictErr *internalErrors.ConflictError
+	var notFoundErr *internalErrors.NotFoundError
+	var validationErr *internalErrors.ValidationError
+
+	if errors.As(err, &conflictErr) {
+		return models.BulkLinkStatusConflict, true
+	} else if errors.As(err, &notFoundErr) {
+		return models.BulkLinkStatusNotFound, true
+	} else if errors.As(err, &validationErr) {
+		return models.BulkLinkStatusInvalid, true
+	}
+
+	return "", false
+}
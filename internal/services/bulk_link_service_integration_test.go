@@ -0,0 +1,141 @@
+package services_test
+
+import (
+	"context"
+	configPackage "jobsearchtracker/internal/config"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupBulkLinkService(t *testing.T) (
+	*services.BulkLinkService,
+	*repositories.CompanyRepository,
+	*repositories.EventRepository,
+	*repositories.PersonRepository,
+	*repositories.CompanyPersonRepository,
+	*repositories.EventPersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupBulkLinkServiceTestContainer(t, *config)
+
+	var bulkLinkService *services.BulkLinkService
+	var companyRepository *repositories.CompanyRepository
+	var eventRepository *repositories.EventRepository
+	var personRepository *repositories.PersonRepository
+	var companyPersonRepository *repositories.CompanyPersonRepository
+	var eventPersonRepository *repositories.EventPersonRepository
+	err := container.Invoke(func(
+		service *services.BulkLinkService,
+		company *repositories.CompanyRepository,
+		event *repositories.EventRepository,
+		person *repositories.PersonRepository,
+		companyPerson *repositories.CompanyPersonRepository,
+		eventPerson *repositories.EventPersonRepository) {
+
+		bulkLinkService = service
+		companyRepository = company
+		eventRepository = event
+		personRepository = person
+		companyPersonRepository = companyPerson
+		eventPersonRepository = eventPerson
+	})
+	assert.NoError(t, err)
+
+	return bulkLinkService, companyRepository, eventRepository, personRepository, companyPersonRepository,
+		eventPersonRepository
+}
+
+// -------- AssociateEventPersons tests: --------
+
+func TestAssociateEventPersons_ShouldAssociateAllPersons(t *testing.T) {
+	bulkLinkService, _, eventRepository, personRepository, _, eventPersonRepository := setupBulkLinkService(t)
+
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+	links := make([]*models.AssociateEventPerson, 5)
+	for index := range links {
+		person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+		links[index] = &models.AssociateEventPerson{EventID: event.ID, PersonID: person.ID}
+	}
+
+	results, err := bulkLinkService.AssociateEventPersons(context.Background(), links)
+	assert.NoError(t, err)
+	assert.Len(t, results, 5)
+	for index, result := range results {
+		assert.Equal(t, index, result.Index)
+		assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusAssociated), result.Status)
+		assert.Nil(t, result.Message)
+	}
+
+	eventPersons, err := eventPersonRepository.GetByID(context.Background(), &event.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, eventPersons, 5)
+}
+
+// -------- AssociateCompanyPersons tests: --------
+
+func TestAssociateCompanyPersons_ShouldReportConflictsAndMissingForeignKeys(t *testing.T) {
+	bulkLinkService, companyRepository, _, personRepository, companyPersonRepository, _ := setupBulkLinkService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	existingPerson := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	newPerson := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, existingPerson.ID, nil)
+
+	results, err := bulkLinkService.AssociateCompanyPersons(context.Background(), []*models.AssociateCompanyPerson{
+		{CompanyID: company.ID, PersonID: existingPerson.ID},
+		{CompanyID: company.ID, PersonID: uuid.New()},
+		{CompanyID: company.ID, PersonID: newPerson.ID},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusConflict), results[0].Status)
+	assert.Contains(t, *results[0].Message, "conflict error on insert")
+
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusInvalid), results[1].Status)
+	assert.Equal(
+		t,
+		"validation error on field 'CompanyID or PersonID': CompanyID or PersonID does not exist",
+		*results[1].Message)
+
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusAssociated), results[2].Status)
+	assert.Nil(t, results[2].Message)
+
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, companyPersons, 2)
+}
+
+// -------- DeleteCompanyPersons tests: --------
+
+func TestDeleteCompanyPersons_ShouldDeleteExistingAndReportMissingLinks(t *testing.T) {
+	bulkLinkService, companyRepository, _, personRepository, companyPersonRepository, _ := setupBulkLinkService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	person := repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(t, companyPersonRepository, company.ID, person.ID, nil)
+
+	results, err := bulkLinkService.DeleteCompanyPersons(context.Background(), []*models.DeleteCompanyPerson{
+		{CompanyID: company.ID, PersonID: uuid.New()},
+		{CompanyID: company.ID, PersonID: person.ID},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusNotFound), results[0].Status)
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusDeleted), results[1].Status)
+
+	companyPersons, err := companyPersonRepository.GetByID(context.Background(), &company.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, companyPersons, 0)
+}
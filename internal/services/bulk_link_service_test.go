@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeUnitOfWork struct {
+	repositories *Repositories
+}
+
+func (unitOfWork *fakeUnitOfWork) WithTx(_ context.Context, fn func(repositories *Repositories) error) error {
+	return fn(unitOfWork.repositories)
+}
+
+type fakeCompanyPersonRepository struct {
+	CompanyPersonRepository
+	errs map[uuid.UUID]error
+}
+
+func (repository *fakeCompanyPersonRepository) Delete(_ context.Context, model *models.DeleteCompanyPerson) error {
+	return repository.errs[model.PersonID]
+}
+
+// -------- DeleteCompanyPersons tests: --------
+
+func TestDeleteCompanyPersons_ShouldReturnValidationErrorOnNoLinks(t *testing.T) {
+	bulkLinkService := NewBulkLinkService(nil, nil)
+
+	results, err := bulkLinkService.DeleteCompanyPersons(context.Background(), nil)
+	assert.Nil(t, results)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'Links': Links is empty", validationError.Error())
+}
+
+func TestDeleteCompanyPersons_ShouldReportEachFailedLink(t *testing.T) {
+	notFoundPersonID := uuid.New()
+	repository := &fakeCompanyPersonRepository{
+		errs: map[uuid.UUID]error{notFoundPersonID: internalErrors.NewNotFoundError("CompanyPerson does not exist")},
+	}
+	bulkLinkService := NewBulkLinkService(
+		&fakeUnitOfWork{repositories: &Repositories{CompanyPerson: repository}}, nil)
+
+	results, err := bulkLinkService.DeleteCompanyPersons(context.Background(), []*models.DeleteCompanyPerson{
+		{CompanyID: uuid.New(), PersonID: uuid.New()},
+		{CompanyID: uuid.New(), PersonID: notFoundPersonID},
+		{CompanyID: uuid.New()},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	assert.Equal(t, 0, results[0].Index)
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusDeleted), results[0].Status)
+	assert.Nil(t, results[0].Message)
+
+	assert.Equal(t, 1, results[1].Index)
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusNotFound), results[1].Status)
+	assert.Equal(t, "error: object not found: CompanyPerson does not exist", *results[1].Message)
+
+	assert.Equal(t, 2, results[2].Index)
+	assert.Equal(t, models.BulkLinkStatus(models.BulkLinkStatusInvalid), results[2].Status)
+	assert.NotNil(t, results[2].Message)
+}
+
+func TestDeleteCompanyPersons_ShouldFailTheBatchOnInternalServiceError(t *testing.T) {
+	failingPersonID := uuid.New()
+	repository := &fakeCompanyPersonRepository{
+		errs: map[uuid.UUID]error{failingPersonID: internalErrors.NewInternalServiceError("database is locked")},
+	}
+	bulkLinkService := NewBulkLinkService(
+		&fakeUnitOfWork{repositories: &Repositories{CompanyPerson: repository}}, nil)
+
+	results, err := bulkLinkService.DeleteCompanyPersons(context.Background(), []*models.DeleteCompanyPerson{
+		{CompanyID: uuid.New(), PersonID: uuid.New()},
+		{CompanyID: uuid.New(), PersonID: failingPersonID},
+	})
+	assert.Nil(t, results)
+
+	var internalServiceError *internalErrors.InternalServiceError
+	assert.True(t, errors.As(err, &internalServiceError))
+	assert.Equal(t, "internal service error: database is locked", err.Error())
+}
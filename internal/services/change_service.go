@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"sync"
+	"time"
+)
+
+// ChangeService pushes the changes recorded in the change log to its watchers. Each watcher reads the log from its
+// own sequence, so a watcher that falls behind or reconnects misses nothing, unless its changes are older than
+// retention and have been pruned.
+//
+// The services do not emit changes themselves. The change log is written by triggers in the database instead, so that
+// changes made inside a transaction, by a merge or by a cascading delete are recorded without every service having
+// to remember to, and only once their transaction has committed.
+type ChangeService struct {
+	changeRepository ChangeRepository
+	pollInterval     time.Duration
+	retention        time.Duration
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// changePruneInterval is how often StartPruning deletes the changes that are older than the retention.
+const changePruneInterval = time.Hour
+
+func NewChangeService(
+	changeRepository ChangeRepository, pollInterval time.Duration, retention time.Duration) *ChangeService {
+
+	return &ChangeService{
+		changeRepository: changeRepository,
+		pollInterval:     pollInterval,
+		retention:        retention,
+		closed:           make(chan struct{}),
+	}
+}
+
+// Watch calls onChanges with the changes that match filter, oldest first, until ctx is done, the service is closed
+// or onChanges returns an error. It starts after lastSequence, or after the latest change if lastSequence is nil.
+// onChanges is called once straight away, then after every poll, with an empty slice if nothing has changed, so
+// that the caller can keep its connection alive. Watch returns nil when ctx is done or the service is closed.
+//
+// Watch can return InternalServiceError, ValidationError, and the errors returned by onChanges
+func (changeService *ChangeService) Watch(
+	ctx context.Context,
+	filter *models.ChangeFilter,
+	lastSequence *int64,
+	onChanges func(changes []*models.Change) error) error {
+
+	logger := logging.FromContext(ctx)
+
+	if filter == nil {
+		filter = &models.ChangeFilter{}
+	}
+
+	// can return ValidationError
+	if err := filter.Validate(); err != nil {
+		logger.Info("change_service.Watch: Filter is invalid", "error", err)
+		return err
+	}
+
+	var sequence int64
+	if lastSequence != nil {
+		if *lastSequence < 0 {
+			logger.Info("change_service.Watch: lastSequence is negative", "lastSequence", *lastSequence)
+			lastEventIDField := "Last-Event-ID"
+			return internalErrors.NewValidationError(&lastEventIDField, "Last-Event-ID cannot be negative")
+		}
+		sequence = *lastSequence
+	} else {
+		// can return InternalServiceError
+		latestSequence, err := changeService.changeRepository.GetLatestSequence(ctx)
+		if err != nil {
+			return err
+		}
+		sequence = latestSequence
+	}
+
+	logger.Info("change_service.Watch: Watching changes", "afterSequence", sequence)
+
+	ticker := time.NewTicker(changeService.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// can return InternalServiceError, ValidationError
+		changes, err := changeService.changeRepository.GetAllAfterSequence(
+			ctx, sequence, filter, models.MaxChangesPerRead)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if err = onChanges(changes); err != nil {
+			return err
+		}
+		if len(changes) > 0 {
+			sequence = changes[len(changes)-1].Sequence
+		}
+		if len(changes) == models.MaxChangesPerRead {
+			// a watcher that is far behind catches up without waiting for the next poll
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("change_service.Watch: Watcher is gone", "lastSequence", sequence)
+			return nil
+		case <-changeService.closed:
+			logger.Info("change_service.Watch: Service is closed", "lastSequence", sequence)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// PruneChanges deletes the changes that are older than the retention, and returns how many were deleted.
+//
+// PruneChanges can return InternalServiceError
+func (changeService *ChangeService) PruneChanges(ctx context.Context) (int64, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError
+	deleted, err := changeService.changeRepository.DeleteOlderThan(ctx, time.Now().Add(-changeService.retention))
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Info("change_service.PruneChanges: Pruned change log", "deleted", deleted)
+	return deleted, nil
+}
+
+// StartPruning runs PruneChanges straight away, and then every hour, until the service is closed.
+func (changeService *ChangeService) StartPruning() {
+	go func() {
+		ctx := context.Background()
+		ticker := time.NewTicker(changePruneInterval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := changeService.PruneChanges(ctx); err != nil {
+				logging.FromContext(ctx).Warn("change_service.StartPruning: Unable to prune change log", "error", err)
+			}
+
+			select {
+			case <-changeService.closed:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Close stops the pruning and makes every running Watch return, so that the server can shut down without waiting for
+// its watchers.
+func (changeService *ChangeService) Close() {
+	changeService.closeOnce.Do(func() {
+		close(changeService.closed)
+	})
+}
@@ -0,0 +1,184 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupChangeService(t *testing.T) (
+	*services.ChangeService, *repositories.CompanyRepository, *repositories.PersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		ChangeStreamPollIntervalMilliseconds: 10,
+		ChangeLogRetentionDays:               1,
+	}
+
+	container := dependencyinjection.SetupChangeServiceTestContainer(t, *config)
+
+	var changeService *services.ChangeService
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	err := container.Invoke(func(
+		service *services.ChangeService,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository) {
+
+		changeService = service
+		companyRepository = company
+		personRepository = person
+	})
+	assert.NoError(t, err)
+
+	return changeService, companyRepository, personRepository
+}
+
+// -------- Watch tests: --------
+
+func TestWatch_ShouldResumeAfterLastSequenceAndFilterByEntityType(t *testing.T) {
+	changeService, companyRepository, personRepository := setupChangeService(t)
+
+	repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	repositoryhelpers.CreatePerson(t, personRepository, nil, nil)
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var received []*models.Change
+	err := changeService.Watch(
+		ctx,
+		&models.ChangeFilter{EntityTypes: []models.ChangeEntityType{models.ChangeEntityTypeCompany}},
+		testutil.ToPtr(int64(1)),
+		func(changes []*models.Change) error {
+			received = append(received, changes...)
+			cancel()
+			return nil
+		})
+	assert.NoError(t, err)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, int64(3), received[0].Sequence)
+	assert.Equal(t, company.ID, received[0].EntityID)
+}
+
+func TestWatch_ShouldPushChangesMadeWhileWatching(t *testing.T) {
+	changeService, companyRepository, _ := setupChangeService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	calls := 0
+	var received []*models.Change
+	err := changeService.Watch(ctx, nil, nil, func(changes []*models.Change) error {
+		calls++
+		if calls == 1 {
+			// Without lastSequence the changes made before watching are skipped.
+			assert.Empty(t, changes)
+			err := companyRepository.Update(context.Background(), &models.UpdateCompany{
+				ID:   company.ID,
+				Name: testutil.ToPtr("New name"),
+			})
+			assert.NoError(t, err)
+			return nil
+		}
+
+		received = append(received, changes...)
+		if len(received) > 0 {
+			changeService.Close()
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, models.ChangeType(models.ChangeTypeUpdated), received[0].ChangeType)
+}
+
+func TestWatch_ShouldReturnErrorOfOnChanges(t *testing.T) {
+	changeService, _, _ := setupChangeService(t)
+
+	onChangesErr := errors.New("client is gone")
+	err := changeService.Watch(context.Background(), nil, nil, func(changes []*models.Change) error {
+		return onChangesErr
+	})
+	assert.ErrorIs(t, err, onChangesErr)
+}
+
+func TestWatch_ShouldReturnValidationError(t *testing.T) {
+	changeService, _, _ := setupChangeService(t)
+
+	tests := []struct {
+		testName      string
+		filter        *models.ChangeFilter
+		lastSequence  *int64
+		expectedError string
+	}{
+		{
+			"invalid entity type",
+			&models.ChangeFilter{EntityTypes: []models.ChangeEntityType{"interview-prep"}},
+			nil,
+			"validation error on field 'entity_type': 'interview-prep' is not a valid entity type. Valid types " +
+				"are: application, company, event, person, application-event, application-person, company-event, " +
+				"company-person, event-person",
+		},
+		{
+			"negative last sequence",
+			nil,
+			testutil.ToPtr(int64(-1)),
+			"validation error on field 'Last-Event-ID': Last-Event-ID cannot be negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			err := changeService.Watch(
+				context.Background(), test.filter, test.lastSequence, func(changes []*models.Change) error {
+					t.Error("onChanges should not be called")
+					return nil
+				})
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+// -------- PruneChanges tests: --------
+
+func TestPruneChanges_ShouldKeepChangesWithinRetention(t *testing.T) {
+	changeService, companyRepository, _ := setupChangeService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+
+	deleted, err := changeService.PruneChanges(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	var received []*models.Change
+	err = changeService.Watch(
+		context.Background(), nil, testutil.ToPtr(int64(0)), func(changes []*models.Change) error {
+			received = changes
+			return errors.New("stop")
+		})
+	assert.EqualError(t, err, "stop")
+
+	assert.Len(t, received, 1)
+	assert.Equal(t, company.ID, received[0].EntityID)
+}
@@ -1,48 +1,50 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 
 	"github.com/google/uuid"
 )
 
 type CompanyEventService struct {
-	companyEventRepository *repositories.CompanyEventRepository
+	companyEventRepository CompanyEventRepository
 }
 
 func NewCompanyEventService(
-	companyEventRepository *repositories.CompanyEventRepository) *CompanyEventService {
+	companyEventRepository CompanyEventRepository) *CompanyEventService {
 
 	return &CompanyEventService{companyEventRepository: companyEventRepository}
 }
 
 // AssociateCompanyEvent can return ConflictError, InternalServiceError, ValidationError
 func (companyEventService *CompanyEventService) AssociateCompanyEvent(
-	AssociateCompanyEvent *models.AssociateCompanyEvent) (*models.CompanyEvent, error) {
+	ctx context.Context, AssociateCompanyEvent *models.AssociateCompanyEvent) (*models.CompanyEvent, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if AssociateCompanyEvent == nil {
-		slog.Error("event_service.AssociateCompanyEvent: model is nil")
+		logger.Error("event_service.AssociateCompanyEvent: model is nil")
 		return nil, internalErrors.NewValidationError(nil, "AssociateCompanyEvent model is nil")
 	}
 
 	err := AssociateCompanyEvent.Validate()
 	if err != nil {
-		slog.Info("event_service.AssociateCompanyEvent: CompanyEvent to create is invalid", "error", err)
+		logger.Info("event_service.AssociateCompanyEvent: CompanyEvent to create is invalid", "error", err)
 
 		return nil, err
 	}
 
 	insertedCompanyEvent, err :=
-		companyEventService.companyEventRepository.AssociateCompanyEvent(AssociateCompanyEvent)
+		companyEventService.companyEventRepository.AssociateCompanyEvent(ctx, AssociateCompanyEvent)
 
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info(
+	logger.Info(
 		"event_service.AssociateCompanyEvent: Associated company to event.",
 		"event.CompanyID", insertedCompanyEvent.CompanyID,
 		"event.EventID", insertedCompanyEvent.EventID)
@@ -51,21 +53,23 @@ func (companyEventService *CompanyEventService) AssociateCompanyEvent(
 
 // GetByID can return ValidationError, InternalServiceError
 func (companyEventService *CompanyEventService) GetByID(
-	companyID *uuid.UUID, eventID *uuid.UUID) ([]*models.CompanyEvent, error) {
+	ctx context.Context, companyID *uuid.UUID, eventID *uuid.UUID) ([]*models.CompanyEvent, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if (companyID == nil || *companyID == uuid.Nil) && (eventID == nil || *eventID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "companyID and eventID cannot both be empty")
 	}
 
-	companyEvents, err := companyEventService.companyEventRepository.GetByID(companyID, eventID)
+	companyEvents, err := companyEventService.companyEventRepository.GetByID(ctx, companyID, eventID)
 	if err != nil {
 		return nil, err
 	}
 
 	if companyEvents == nil {
-		slog.Info("CompanyEventService.GetByID: Retrieved zero events")
+		logger.Info("CompanyEventService.GetByID: Retrieved zero events")
 	} else {
-		slog.Info(
+		logger.Info(
 			"CompanyEventService.GetByID: Retrieved " + string(rune(len(companyEvents))) + " events")
 	}
 
@@ -73,28 +77,49 @@ func (companyEventService *CompanyEventService) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (companyEventService *CompanyEventService) GetAll() ([]*models.CompanyEvent, error) {
-	companyEvents, err := companyEventService.companyEventRepository.GetAll()
+func (companyEventService *CompanyEventService) GetAll(ctx context.Context) ([]*models.CompanyEvent, error) {
+	logger := logging.FromContext(ctx)
+
+	companyEvents, err := companyEventService.companyEventRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if companyEvents == nil {
-		slog.Info("CompanyEventService.GetAllCompanyEvents: Retrieved zero events")
+		logger.Info("CompanyEventService.GetAllCompanyEvents: Retrieved zero events")
 	} else {
-		slog.Info(
+		logger.Info(
 			"CompanyEventService.GetAllCompanyEvents: Retrieved " + string(rune(len(companyEvents))) + " events")
 	}
 
 	return companyEvents, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (companyEventService *CompanyEventService) Update(ctx context.Context, model *models.UpdateCompanyEvent) error {
+	logger := logging.FromContext(ctx)
+
+	if model == nil {
+		logger.Error("company_event_service.Update: model is nil")
+		return internalErrors.NewValidationError(nil, "UpdateCompanyEvent model is nil")
+	}
+
+	err := model.Validate()
+	if err != nil {
+		logger.Info("company_event_service.Update: CompanyEvent to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return companyEventService.companyEventRepository.Update(ctx, model)
+}
+
 // Delete can return InternalServiceError, NotFoundError, ValidationError
-func (companyEventService *CompanyEventService) Delete(model *models.DeleteCompanyEvent) error {
+func (companyEventService *CompanyEventService) Delete(ctx context.Context, model *models.DeleteCompanyEvent) error {
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
 
-	return companyEventService.companyEventRepository.Delete(model)
+	return companyEventService.companyEventRepository.Delete(ctx, model)
 }
@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -61,7 +62,7 @@ func TestAssociateCompanyToEvent_ShouldAssociateACompanyToAEvent(t *testing.T) {
 		EventID:     event.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	associatedCompanyEvent, err := companyEventService.AssociateCompanyEvent(&companyEvent)
+	associatedCompanyEvent, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	assert.Equal(t, companyEvent.CompanyID, associatedCompanyEvent.CompanyID)
@@ -79,7 +80,7 @@ func TestAssociateCompanyToEvent_ShouldAssociateACompanyToAEventWithOnlyRequired
 		CompanyID: company.ID,
 		EventID:   event.ID,
 	}
-	associatedCompanyEvent, err := companyEventService.AssociateCompanyEvent(&companyEvent)
+	associatedCompanyEvent, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	assert.Equal(t, companyEvent.CompanyID, associatedCompanyEvent.CompanyID)
@@ -97,10 +98,10 @@ func TestAssociateCompanyToEvent_ShouldReturnConflictErrorIfCompanyIDAndEventIDC
 		CompanyID: company.ID,
 		EventID:   event.ID,
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -127,7 +128,7 @@ func TestCompanyEventServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testing
 		EventID:     event1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -135,7 +136,7 @@ func TestCompanyEventServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testing
 		EventID:     event2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -143,10 +144,10 @@ func TestCompanyEventServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testing
 		EventID:     event1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	companyEvents, err := companyEventService.GetByID(&company1.ID, nil)
+	companyEvents, err := companyEventService.GetByID(context.Background(), &company1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, companyEvents, 2)
 
@@ -171,7 +172,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -179,7 +180,7 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -187,10 +188,10 @@ func TestCompanyEventGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		EventID:     event1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	companyEvents, err := companyEventService.GetByID(nil, &event1.ID)
+	companyEvents, err := companyEventService.GetByID(context.Background(), nil, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, companyEvents, 2)
 
@@ -214,24 +215,24 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyIDAndEventID(t *testing.T) {
 		CompanyID: company1.ID,
 		EventID:   event1.ID,
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
 		CompanyID: company1.ID,
 		EventID:   event2.ID,
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
 		CompanyID: company2.ID,
 		EventID:   event1.ID,
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	events, err := companyEventService.GetByID(&company1.ID, &event1.ID)
+	events, err := companyEventService.GetByID(context.Background(), &company1.ID, &event1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, events, 1)
 	assert.Equal(t, company1.ID, events[0].CompanyID)
@@ -254,7 +255,7 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent1)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent1)
 	assert.NoError(t, err)
 
 	companyEvent2 := models.AssociateCompanyEvent{
@@ -262,7 +263,7 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent2)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent2)
 	assert.NoError(t, err)
 
 	companyEvent3 := models.AssociateCompanyEvent{
@@ -270,10 +271,10 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 		EventID:     event2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyEventService.AssociateCompanyEvent(&companyEvent3)
+	_, err = companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent3)
 	assert.NoError(t, err)
 
-	eventCompanies, err := companyEventService.GetAll()
+	eventCompanies, err := companyEventService.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, eventCompanies, 3)
@@ -297,7 +298,7 @@ func TestGetAllCompanyEvents_ShouldReturnAllCompanyEvents(t *testing.T) {
 func TestGetAllCompanyEvents_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	companyEventService, _, _ := setupCompanyEventService(t)
 
-	results, err := companyEventService.GetAll()
+	results, err := companyEventService.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
@@ -314,7 +315,7 @@ func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
 		CompanyID: company.ID,
 		EventID:   event.ID,
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteCompanyEvent{
@@ -322,7 +323,7 @@ func TestDeleteCompanyEvent_ShouldDeleteCompanyEvent(t *testing.T) {
 		EventID:   event.ID,
 	}
 
-	err = companyEventService.Delete(&deleteModel)
+	err = companyEventService.Delete(context.Background(), &deleteModel)
 	assert.NoError(t, err)
 }
 
@@ -336,7 +337,7 @@ func TestDeleteCompanyEvent_ShouldReturnNotFoundErrorIfNoMatchingCompanyEventInD
 		CompanyID: company.ID,
 		EventID:   event.ID,
 	}
-	_, err := companyEventService.AssociateCompanyEvent(&companyEvent)
+	_, err := companyEventService.AssociateCompanyEvent(context.Background(), &companyEvent)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteCompanyEvent{
@@ -344,7 +345,7 @@ func TestDeleteCompanyEvent_ShouldReturnNotFoundErrorIfNoMatchingCompanyEventInD
 		EventID:   uuid.New(),
 	}
 
-	err = companyEventService.Delete(&deleteModel)
+	err = companyEventService.Delete(context.Background(), &deleteModel)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/testutil"
@@ -15,7 +16,7 @@ import (
 func TestAssociateCompanyEvent_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
 	service := NewCompanyEventService(nil)
 
-	nilCompany, err := service.AssociateCompanyEvent(nil)
+	nilCompany, err := service.AssociateCompanyEvent(context.Background(), nil)
 	assert.Nil(t, nilCompany)
 
 	var validationError *internalErrors.ValidationError
@@ -58,7 +59,7 @@ func TestGetByID_ShouldReturnValidationErrorIfCompanyIDAndEventIDAreEmpty(t *tes
 		t.Run(test.testName, func(t *testing.T) {
 			service := NewCompanyEventService(nil)
 
-			eventCompanies, err := service.GetByID(test.companyID, test.eventID)
+			eventCompanies, err := service.GetByID(context.Background(), test.companyID, test.eventID)
 			assert.Nil(t, eventCompanies)
 
 			assert.Error(t, err)
@@ -70,6 +71,31 @@ func TestGetByID_ShouldReturnValidationErrorIfCompanyIDAndEventIDAreEmpty(t *tes
 	}
 }
 
+// -------- Update tests: --------
+
+func TestUpdateCompanyEvent_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
+	service := NewCompanyEventService(nil)
+
+	err := service.Update(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: UpdateCompanyEvent model is nil", validationError.Error())
+}
+
+func TestUpdateCompanyEvent_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	service := NewCompanyEventService(nil)
+
+	err := service.Update(context.Background(), &models.UpdateCompanyEvent{
+		CompanyID: uuid.New(),
+		EventID:   uuid.New(),
+	})
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
 // -------- Delete tests: --------
 
 func TestDelete_ShouldReturnValidationErrorIfCompanyIDOrEventIDisEmpty(t *testing.T) {
@@ -108,7 +134,7 @@ func TestDelete_ShouldReturnValidationErrorIfCompanyIDOrEventIDisEmpty(t *testin
 				EventID:   test.eventID,
 			}
 
-			err := service.Delete(&deleteModel)
+			err := service.Delete(context.Background(), &deleteModel)
 			assert.Error(t, err)
 
 			var validationError *internalErrors.ValidationError
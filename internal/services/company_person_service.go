@@ -1,46 +1,48 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 
 	"github.com/google/uuid"
 )
 
 type CompanyPersonService struct {
-	companyPersonRepository *repositories.CompanyPersonRepository
+	companyPersonRepository CompanyPersonRepository
 }
 
-func NewCompanyPersonService(companyPersonRepository *repositories.CompanyPersonRepository) *CompanyPersonService {
+func NewCompanyPersonService(companyPersonRepository CompanyPersonRepository) *CompanyPersonService {
 	return &CompanyPersonService{companyPersonRepository: companyPersonRepository}
 }
 
 // AssociateCompanyPerson can return ConflictError, InternalServiceError, ValidationError
 func (companyPersonService *CompanyPersonService) AssociateCompanyPerson(
-	AssociateCompanyPerson *models.AssociateCompanyPerson) (*models.CompanyPerson, error) {
+	ctx context.Context, AssociateCompanyPerson *models.AssociateCompanyPerson) (*models.CompanyPerson, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if AssociateCompanyPerson == nil {
-		slog.Error("person_service.AssociateCompanyPerson: model is nil")
+		logger.Error("person_service.AssociateCompanyPerson: model is nil")
 		return nil, internalErrors.NewValidationError(nil, "AssociateCompanyPerson model is nil")
 	}
 
 	err := AssociateCompanyPerson.Validate()
 	if err != nil {
-		slog.Info("person_service.AssociateCompanyPerson: CompanyPerson to create is invalid", "error", err)
+		logger.Info("person_service.AssociateCompanyPerson: CompanyPerson to create is invalid", "error", err)
 
 		return nil, err
 	}
 
 	insertedCompanyPerson, err :=
-		companyPersonService.companyPersonRepository.AssociateCompanyPerson(AssociateCompanyPerson)
+		companyPersonService.companyPersonRepository.AssociateCompanyPerson(ctx, AssociateCompanyPerson)
 
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info(
+	logger.Info(
 		"person_service.AssociateCompanyPerson: Associated company to person.",
 		"person.CompanyID", insertedCompanyPerson.CompanyID,
 		"person.PersonID", insertedCompanyPerson.PersonID)
@@ -49,21 +51,23 @@ func (companyPersonService *CompanyPersonService) AssociateCompanyPerson(
 
 // GetByID can return ValidationError, InternalServiceError
 func (companyPersonService *CompanyPersonService) GetByID(
-	companyID *uuid.UUID, personID *uuid.UUID) ([]*models.CompanyPerson, error) {
+	ctx context.Context, companyID *uuid.UUID, personID *uuid.UUID) ([]*models.CompanyPerson, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if (companyID == nil || *companyID == uuid.Nil) && (personID == nil || *personID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "companyID and personID cannot both be empty")
 	}
 
-	companyPersons, err := companyPersonService.companyPersonRepository.GetByID(companyID, personID)
+	companyPersons, err := companyPersonService.companyPersonRepository.GetByID(ctx, companyID, personID)
 	if err != nil {
 		return nil, err
 	}
 
 	if companyPersons == nil {
-		slog.Info("CompanyPersonService.GetByID: Retrieved zero persons")
+		logger.Info("CompanyPersonService.GetByID: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"CompanyPersonService.GetByID: Retrieved " + string(rune(len(companyPersons))) + " persons")
 	}
 
@@ -71,28 +75,49 @@ func (companyPersonService *CompanyPersonService) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (companyPersonService *CompanyPersonService) GetAll() ([]*models.CompanyPerson, error) {
-	companyPersons, err := companyPersonService.companyPersonRepository.GetAll()
+func (companyPersonService *CompanyPersonService) GetAll(ctx context.Context) ([]*models.CompanyPerson, error) {
+	logger := logging.FromContext(ctx)
+
+	companyPersons, err := companyPersonService.companyPersonRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if companyPersons == nil {
-		slog.Info("CompanyPersonService.GetAllCompanyPersons: Retrieved zero persons")
+		logger.Info("CompanyPersonService.GetAllCompanyPersons: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"CompanyPersonService.GetAllCompanyPersons: Retrieved " + string(rune(len(companyPersons))) + " persons")
 	}
 
 	return companyPersons, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (companyPersonService *CompanyPersonService) Update(ctx context.Context, model *models.UpdateCompanyPerson) error {
+	logger := logging.FromContext(ctx)
+
+	if model == nil {
+		logger.Error("company_person_service.Update: model is nil")
+		return internalErrors.NewValidationError(nil, "UpdateCompanyPerson model is nil")
+	}
+
+	err := model.Validate()
+	if err != nil {
+		logger.Info("company_person_service.Update: CompanyPerson to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return companyPersonService.companyPersonRepository.Update(ctx, model)
+}
+
 // Delete can return InternalServiceError, NotFoundError, ValidationError
-func (companyPersonService *CompanyPersonService) Delete(model *models.DeleteCompanyPerson) error {
+func (companyPersonService *CompanyPersonService) Delete(ctx context.Context, model *models.DeleteCompanyPerson) error {
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
 
-	return companyPersonService.companyPersonRepository.Delete(model)
+	return companyPersonService.companyPersonRepository.Delete(ctx, model)
 }
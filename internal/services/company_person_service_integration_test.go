@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -61,7 +62,7 @@ func TestAssociateCompanyToPerson_ShouldAssociateACompanyToAPerson(t *testing.T)
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	associatedCompanyPerson, err := companyPersonService.AssociateCompanyPerson(&companyPerson)
+	associatedCompanyPerson, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, companyPerson.CompanyID, associatedCompanyPerson.CompanyID)
@@ -79,7 +80,7 @@ func TestAssociateCompanyToPerson_ShouldAssociateACompanyToAPersonWithOnlyRequir
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	associatedCompanyPerson, err := companyPersonService.AssociateCompanyPerson(&companyPerson)
+	associatedCompanyPerson, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, companyPerson.CompanyID, associatedCompanyPerson.CompanyID)
@@ -97,10 +98,10 @@ func TestAssociateCompanyToPerson_ShouldReturnConflictErrorIfCompanyIDAndPersonI
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -127,7 +128,7 @@ func TestCompanyPersonServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testin
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -135,7 +136,7 @@ func TestCompanyPersonServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testin
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -143,10 +144,10 @@ func TestCompanyPersonServiceGetByID_ShouldGetRecordsMatchingCompanyID(t *testin
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	companyPersons, err := companyPersonService.GetByID(&company1.ID, nil)
+	companyPersons, err := companyPersonService.GetByID(context.Background(), &company1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, companyPersons, 2)
 
@@ -171,7 +172,7 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -179,7 +180,7 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -187,10 +188,10 @@ func TestCompanyPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	companyPersons, err := companyPersonService.GetByID(nil, &person1.ID)
+	companyPersons, err := companyPersonService.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, companyPersons, 2)
 
@@ -214,24 +215,24 @@ func TestGetByID_ShouldGetRecordsMatchingCompanyIDAndPersonID(t *testing.T) {
 		CompanyID: company1.ID,
 		PersonID:  person1.ID,
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
 		CompanyID: company1.ID,
 		PersonID:  person2.ID,
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
 		CompanyID: company2.ID,
 		PersonID:  person1.ID,
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	persons, err := companyPersonService.GetByID(&company1.ID, &person1.ID)
+	persons, err := companyPersonService.GetByID(context.Background(), &company1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, company1.ID, persons[0].CompanyID)
@@ -254,7 +255,7 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson1)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -262,7 +263,7 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -270,10 +271,10 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonService.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := companyPersonService.GetAll()
+	personCompanies, err := companyPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -297,7 +298,7 @@ func TestGetAllCompanyPersons_ShouldReturnAllCompanyPersons(t *testing.T) {
 func TestGetAllCompanyPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	companyPersonService, _, _ := setupCompanyPersonService(t)
 
-	results, err := companyPersonService.GetAll()
+	results, err := companyPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
@@ -314,7 +315,7 @@ func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteCompanyPerson{
@@ -322,7 +323,7 @@ func TestDeleteCompanyPerson_ShouldDeleteCompanyPerson(t *testing.T) {
 		PersonID:  person.ID,
 	}
 
-	err = companyPersonService.Delete(&deleteModel)
+	err = companyPersonService.Delete(context.Background(), &deleteModel)
 	assert.NoError(t, err)
 }
 
@@ -336,7 +337,7 @@ func TestDeleteCompanyPerson_ShouldReturnNotFoundErrorIfNoMatchingCompanyPersonI
 		CompanyID: company.ID,
 		PersonID:  person.ID,
 	}
-	_, err := companyPersonService.AssociateCompanyPerson(&companyPerson)
+	_, err := companyPersonService.AssociateCompanyPerson(context.Background(), &companyPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteCompanyPerson{
@@ -344,7 +345,7 @@ func TestDeleteCompanyPerson_ShouldReturnNotFoundErrorIfNoMatchingCompanyPersonI
 		PersonID:  uuid.New(),
 	}
 
-	err = companyPersonService.Delete(&deleteModel)
+	err = companyPersonService.Delete(context.Background(), &deleteModel)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
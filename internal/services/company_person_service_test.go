@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/testutil"
@@ -15,7 +16,7 @@ import (
 func TestAssociateCompanyPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
 	service := NewCompanyPersonService(nil)
 
-	nilCompany, err := service.AssociateCompanyPerson(nil)
+	nilCompany, err := service.AssociateCompanyPerson(context.Background(), nil)
 	assert.Nil(t, nilCompany)
 
 	var validationError *internalErrors.ValidationError
@@ -58,7 +59,7 @@ func TestGetByID_ShouldReturnValidationErrorIfCompanyIDAndPersonIDAreEmpty(t *te
 		t.Run(test.testName, func(t *testing.T) {
 			service := NewCompanyPersonService(nil)
 
-			personCompanies, err := service.GetByID(test.companyID, test.personID)
+			personCompanies, err := service.GetByID(context.Background(), test.companyID, test.personID)
 			assert.Nil(t, personCompanies)
 
 			assert.Error(t, err)
@@ -67,6 +68,31 @@ func TestGetByID_ShouldReturnValidationErrorIfCompanyIDAndPersonIDAreEmpty(t *te
 	}
 }
 
+// -------- Update tests: --------
+
+func TestUpdateCompanyPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
+	service := NewCompanyPersonService(nil)
+
+	err := service.Update(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: UpdateCompanyPerson model is nil", validationError.Error())
+}
+
+func TestUpdateCompanyPerson_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	service := NewCompanyPersonService(nil)
+
+	err := service.Update(context.Background(), &models.UpdateCompanyPerson{
+		CompanyID: uuid.New(),
+		PersonID:  uuid.New(),
+	})
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
 // -------- Delete tests: --------
 
 func TestDelete_ShouldReturnValidationErrorIfCompanyIDOrPersonIDisEmpty(t *testing.T) {
@@ -105,7 +131,7 @@ func TestDelete_ShouldReturnValidationErrorIfCompanyIDOrPersonIDisEmpty(t *testi
 				PersonID:  test.personID,
 			}
 
-			err := service.Delete(&deleteModel)
+			err := service.Delete(context.Background(), &deleteModel)
 			assert.Error(t, err)
 
 			var validationError *internalErrors.ValidationError
@@ -1,27 +1,31 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type CompanyService struct {
-	companyRepository *repositories.CompanyRepository
+	companyRepository CompanyRepository
 }
 
-func NewCompanyService(companyRepository *repositories.CompanyRepository) *CompanyService {
+func NewCompanyService(companyRepository CompanyRepository) *CompanyService {
 	return &CompanyService{companyRepository: companyRepository}
 }
 
 // CreateCompany can return ConflictError, InternalServiceError, ValidationError
-func (companyService *CompanyService) CreateCompany(company *models.CreateCompany) (*models.Company, error) {
+func (companyService *CompanyService) CreateCompany(
+	ctx context.Context, company *models.CreateCompany) (*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if company == nil {
-		slog.Error("company_service.CreateCompany: company is nil")
+		logger.Error("company_service.CreateCompany: company is nil")
 		return nil, internalErrors.NewValidationError(nil, "CreateCompany is nil")
 	}
 
@@ -34,7 +38,7 @@ func (companyService *CompanyService) CreateCompany(company *models.CreateCompan
 			companyId = "[not set]"
 		}
 
-		slog.Info("company_service.CreateCompany: Company to create is invalid. ", "ID", companyId, "error", err)
+		logger.Info("company_service.CreateCompany: Company to create is invalid. ", "ID", companyId, "error", err)
 		return nil, err
 	}
 
@@ -44,71 +48,96 @@ func (companyService *CompanyService) CreateCompany(company *models.CreateCompan
 	} else if company.CreatedDate.IsZero() {
 		createdDate := time.Now()
 		company.CreatedDate = &createdDate
-		slog.Info(
+		logger.Info(
 			"company_service.createCompany: company.CreatedDate is zero. Setting to '" +
 				company.CreatedDate.String() + "'")
 	}
 
 	if company.LastContact != nil && company.LastContact.IsZero() {
 		company.LastContact = company.CreatedDate
-		slog.Info(
+		logger.Info(
 			"company_service.createCompany: company.LastContact is zero. Setting to CreatedDate: '" +
 				company.LastContact.String() + "'")
 	}
 
 	// can return ConflictError, InternalServiceError
-	insertedCompany, err := companyService.companyRepository.Create(company)
+	insertedCompany, err := companyService.companyRepository.Create(ctx, company)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("CompanyService.CreateCompany: inserted company.", "company.ID", insertedCompany.ID.String())
+	logger.Info("CompanyService.CreateCompany: inserted company.", "company.ID", insertedCompany.ID.String())
 	return insertedCompany, nil
 }
 
 // GetCompanyById can return InternalServiceError, NotFoundError, ValidationError
 func (companyService *CompanyService) GetCompanyById(
-	companyId *uuid.UUID) (*models.Company, error) {
+	ctx context.Context, companyId *uuid.UUID) (*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if companyId == nil {
 		companyIdString := "company ID"
 		err := internalErrors.NewValidationError(&companyIdString, "companyId is required")
-		slog.Info("CompanyService.GetCompanyById: Failed to get company", "error", err)
+		logger.Info("CompanyService.GetCompanyById: Failed to get company", "error", err)
 		return nil, err
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	company, err := companyService.companyRepository.GetById(companyId)
+	company, err := companyService.companyRepository.GetById(ctx, companyId)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("CompanyService.GetCompanyById: Retrieved company.", "company.ID", company.ID.String())
+	logger.Info("CompanyService.GetCompanyById: Retrieved company.", "company.ID", company.ID.String())
 	return company, nil
 }
 
+// GetCompaniesByIDs returns the companies with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetCompaniesByIDs can return InternalServiceError, ValidationError
+func (companyService *CompanyService) GetCompaniesByIDs(
+	ctx context.Context, ids []uuid.UUID) ([]*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, ValidationError
+	companies, err := companyService.companyRepository.GetAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("CompanyService.GetCompaniesByIDs: Retrieved companies", "requested", len(ids), "found", len(companies))
+
+	return companies, nil
+}
+
 // GetCompaniesByName can return InternalServiceError, NotFoundError, ValidationError
-func (companyService *CompanyService) GetCompaniesByName(companyName *string) ([]*models.Company, error) {
+func (companyService *CompanyService) GetCompaniesByName(
+	ctx context.Context, companyName *string) ([]*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if companyName == nil {
 		err := internalErrors.NewValidationError(nil, "companyName is required")
-		slog.Info("companyService.GetCompanyByName: Failed to get company", "error", err)
+		logger.Info("companyService.GetCompanyByName: Failed to get company", "error", err)
 		return nil, err
 	}
 	if *companyName == "" {
 		err := internalErrors.NewValidationError(nil, "companyName is required")
-		slog.Info("companyService.GetCompanyByName: Failed to get company", "error", err)
+		logger.Info("companyService.GetCompanyByName: Failed to get company", "error", err)
 		return nil, err
 	}
 
-	companies, err := companyService.companyRepository.GetAllByName(companyName)
+	companies, err := companyService.companyRepository.GetAllByName(ctx, companyName)
 	if err != nil {
 		return nil, err
 	}
 
 	if companies == nil {
-		slog.Info("CompanyService.GetAllCompanies: Retrieved zero companies")
+		logger.Info("CompanyService.GetAllCompanies: Retrieved zero companies")
 	} else {
-		slog.Info("CompanyService.GetAllCompanies: Retrieved " + string(rune(len(companies))) + " companies")
+		logger.Info("CompanyService.GetAllCompanies: Retrieved " + string(rune(len(companies))) + " companies")
 	}
 
 	return companies, nil
@@ -116,60 +145,71 @@ func (companyService *CompanyService) GetCompaniesByName(companyName *string) ([
 
 // GetAllCompanies can return InternalServiceError
 func (companyService *CompanyService) GetAllCompanies(
+	ctx context.Context,
 	includeApplications models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType) ([]*models.Company, error) {
 
+	logger := logging.FromContext(ctx)
+
 	// can return InternalServiceError
-	companies, err := companyService.companyRepository.GetAll(includeApplications, includePersons, includeEvents)
+	companies, err := companyService.companyRepository.GetAll(ctx, includeApplications, includePersons, includeEvents)
 	if err != nil {
 		return nil, err
 	}
 	if len(companies) == 0 {
-		slog.Info("CompanyService.GetAllCompanies: Retrieved zero companies")
+		logger.Info("CompanyService.GetAllCompanies: Retrieved zero companies")
 		return nil, nil
 	}
 
-	slog.Info("CompanyService.GetAllCompanies: Retrieved " + string(rune(len(companies))) + " companies")
+	logger.Info("CompanyService.GetAllCompanies: Retrieved " + string(rune(len(companies))) + " companies")
 	return companies, nil
 }
 
-// UpdateCompany can return InternalServiceError, ValidationError
-func (companyService *CompanyService) UpdateCompany(company *models.UpdateCompany) error {
+// UpdateCompany can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (companyService *CompanyService) UpdateCompany(ctx context.Context, company *models.UpdateCompany) error {
+	logger := logging.FromContext(ctx)
+
 	if company == nil {
-		slog.Error("CompanyService.UpdateCompany: company is nil")
+		logger.Error("CompanyService.UpdateCompany: company is nil")
 		return internalErrors.NewValidationError(nil, "UpdateCompany model is nil")
 	}
 
 	// can return ValidationError
 	err := company.Validate()
 	if err != nil {
-		slog.Info("CompanyService.UpdateCompany: UpdateCompany model is invalid. ", "error", err)
+		logger.Info("CompanyService.UpdateCompany: UpdateCompany model is invalid. ", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = companyService.companyRepository.Update(company)
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = companyService.companyRepository.Update(ctx, company)
 	if err != nil {
-		slog.Error("CompanyService.Update: Error updating company", "error", err)
+		logger.Error("CompanyService.Update: Error updating company", "error", err)
 	}
 
 	return err
 }
 
-// DeleteCompany can return InternalServiceError, NotFoundError, ValidationError
-func (companyService *CompanyService) DeleteCompany(companyId *uuid.UUID) error {
+// DeleteCompany deletes the company. If expectedVersion is set, it is only deleted if it has that version.
+//
+// DeleteCompany can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (companyService *CompanyService) DeleteCompany(
+	ctx context.Context, companyId *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+
 	if companyId == nil {
 		companyIdString := "company ID"
 		err := internalErrors.NewValidationError(&companyIdString, "companyId is required")
-		slog.Info("CompanyService.DeleteCompany: Error: companyID is nil", "error", err)
+		logger.Info("CompanyService.DeleteCompany: Error: companyID is nil", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err := companyService.companyRepository.Delete(companyId)
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err := companyService.companyRepository.Delete(ctx, companyId, expectedVersion)
 	if err != nil {
-		slog.Error("CompanyService.DeleteCompany: Error deleting company", "error", err)
+		logger.Error("CompanyService.DeleteCompany: Error deleting company", "error", err)
 	}
 
 	return err
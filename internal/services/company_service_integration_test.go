@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -98,7 +99,7 @@ func TestCreateCompany_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany, err := companyService.CreateCompany(&companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -120,7 +121,7 @@ func TestCreateCompany_ShouldHandleEmptyFields(t *testing.T) {
 	}
 
 	insertedDateApproximation := time.Now()
-	insertedCompany, err := companyService.CreateCompany(&companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -144,7 +145,7 @@ func TestCreateCompany_ShouldHandleUnsetCreatedDate(t *testing.T) {
 	}
 
 	insertedDateApproximation := time.Now()
-	insertedCompany, err := companyService.CreateCompany(&companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -161,7 +162,7 @@ func TestCreateCompany_ShouldSetUnsetLastContactToCreatedDate(t *testing.T) {
 		LastContact: &time.Time{},
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	insertedCompany, err := companyService.CreateCompany(&companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -183,10 +184,10 @@ func TestGetCompanyById_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := companyService.CreateCompany(&companyToInsert)
+	_, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
-	retrievedCompany, err := companyService.GetCompanyById(companyToInsert.ID)
+	retrievedCompany, err := companyService.GetCompanyById(context.Background(), companyToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedCompany)
 
@@ -203,7 +204,7 @@ func TestGetCompanyById_ShouldReturnNotFoundErrorForAnIDThatDoesNotExist(t *test
 	companyService, _, _, _, _, _, _ := setupCompanyService(t)
 
 	nonExistingId := uuid.New()
-	retrievedCompany, err := companyService.GetCompanyById(&nonExistingId)
+	retrievedCompany, err := companyService.GetCompanyById(context.Background(), &nonExistingId)
 	assert.Error(t, err)
 	assert.Nil(t, retrievedCompany)
 
@@ -227,7 +228,7 @@ func TestGetCompaniesByName_ShouldReturnCompanies(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 20)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 21)),
 	}
-	_, err := companyService.CreateCompany(&companyToInsert1)
+	_, err := companyService.CreateCompany(context.Background(), &companyToInsert1)
 	assert.NoError(t, err)
 
 	companyToInsert2 := models.CreateCompany{
@@ -235,7 +236,7 @@ func TestGetCompaniesByName_ShouldReturnCompanies(t *testing.T) {
 		Name:        "Brand AB",
 		CompanyType: models.CompanyTypeEmployer,
 	}
-	_, err = companyService.CreateCompany(&companyToInsert2)
+	_, err = companyService.CreateCompany(context.Background(), &companyToInsert2)
 	assert.NoError(t, err)
 
 	companyToInsert3 := models.CreateCompany{
@@ -243,12 +244,12 @@ func TestGetCompaniesByName_ShouldReturnCompanies(t *testing.T) {
 		Name:        "Day Workers",
 		CompanyType: models.CompanyTypeRecruiter,
 	}
-	_, err = companyService.CreateCompany(&companyToInsert3)
+	_, err = companyService.CreateCompany(context.Background(), &companyToInsert3)
 	assert.NoError(t, err)
 
 	// GetByName
 
-	companies, err := companyService.GetCompaniesByName(testutil.ToPtr("day"))
+	companies, err := companyService.GetCompaniesByName(context.Background(), testutil.ToPtr("day"))
 	assert.NoError(t, err)
 	assert.NotNil(t, companies)
 	assert.Len(t, companies, 2)
@@ -273,7 +274,7 @@ func TestGetCompaniesByName_ShouldReturnNotFoundErrorIfNoNamesMatch(t *testing.T
 		Name:        "Trickery AB",
 		CompanyType: models.CompanyTypeConsultancy,
 	}
-	_, err := companyService.CreateCompany(&companyToInsert1)
+	_, err := companyService.CreateCompany(context.Background(), &companyToInsert1)
 	assert.NoError(t, err)
 
 	companyToInsert2 := models.CreateCompany{
@@ -281,12 +282,12 @@ func TestGetCompaniesByName_ShouldReturnNotFoundErrorIfNoNamesMatch(t *testing.T
 		Name:        "Offshoring Inc.",
 		CompanyType: models.CompanyTypeEmployer,
 	}
-	_, err = companyService.CreateCompany(&companyToInsert2)
+	_, err = companyService.CreateCompany(context.Background(), &companyToInsert2)
 	assert.NoError(t, err)
 
 	// GetByName
 	nameToGet := "Bee"
-	companies, err := companyService.GetCompaniesByName(&nameToGet)
+	companies, err := companyService.GetCompaniesByName(context.Background(), &nameToGet)
 	assert.Nil(t, companies)
 	assert.Error(t, err)
 
@@ -311,7 +312,7 @@ func TestGetAllCompanies_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	insertedCompany1, err := companyService.CreateCompany(&company1ToInsert)
+	insertedCompany1, err := companyService.CreateCompany(context.Background(), &company1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -324,7 +325,7 @@ func TestGetAllCompanies_ShouldWork(t *testing.T) {
 		Name:        "company2Name",
 		CompanyType: models.CompanyTypeConsultancy,
 	}
-	insertedCompany2, err := companyService.CreateCompany(&company2ToInsert)
+	insertedCompany2, err := companyService.CreateCompany(context.Background(), &company2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
@@ -337,13 +338,14 @@ func TestGetAllCompanies_ShouldWork(t *testing.T) {
 		Name:        "company3Name",
 		CompanyType: models.CompanyTypeEmployer,
 	}
-	insertedCompany3, err := companyService.CreateCompany(&company3ToInsert)
+	insertedCompany3, err := companyService.CreateCompany(context.Background(), &company3ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany3)
 
 	// get all companies
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -368,6 +370,7 @@ func TestGetAllCompanies_ShouldReturnNilIfNoCompaniesInDatabase(t *testing.T) {
 	companyService, _, _, _, _, _, _ := setupCompanyService(t)
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -388,7 +391,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	insertedCompany1, err := companyService.CreateCompany(company1ToInsert)
+	insertedCompany1, err := companyService.CreateCompany(context.Background(), company1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -398,7 +401,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	insertedCompany2, err := companyService.CreateCompany(company2ToInsert)
+	insertedCompany2, err := companyService.CreateCompany(context.Background(), company2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
@@ -408,7 +411,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	insertedCompany3, err := companyService.CreateCompany(company3ToInsert)
+	insertedCompany3, err := companyService.CreateCompany(context.Background(), company3ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany3)
 
@@ -430,7 +433,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err = applicationRepository.Create(&application1)
+	_, err = applicationRepository.Create(context.Background(), &application1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -452,6 +455,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationsIfIncludeApplicati
 	// get all companies
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -510,7 +514,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := companyService.CreateCompany(company1ToInsert)
+	_, err := companyService.CreateCompany(context.Background(), company1ToInsert)
 	assert.NoError(t, err)
 
 	company2ToInsert := &models.CreateCompany{
@@ -519,7 +523,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyService.CreateCompany(company2ToInsert)
+	_, err = companyService.CreateCompany(context.Background(), company2ToInsert)
 	assert.NoError(t, err)
 
 	company3ToInsert := &models.CreateCompany{
@@ -528,12 +532,13 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyService.CreateCompany(company3ToInsert)
+	_, err = companyService.CreateCompany(context.Background(), company3ToInsert)
 	assert.NoError(t, err)
 
 	// get all companies
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -563,7 +568,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	insertedCompany1, err := companyService.CreateCompany(company1ToInsert)
+	insertedCompany1, err := companyService.CreateCompany(context.Background(), company1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -573,7 +578,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	insertedCompany2, err := companyService.CreateCompany(company2ToInsert)
+	insertedCompany2, err := companyService.CreateCompany(context.Background(), company2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
@@ -583,7 +588,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	insertedCompany3, err := companyService.CreateCompany(company3ToInsert)
+	insertedCompany3, err := companyService.CreateCompany(context.Background(), company3ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany3)
 
@@ -605,7 +610,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err = applicationRepository.Create(&application1)
+	_, err = applicationRepository.Create(context.Background(), &application1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -627,6 +632,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithApplicationIDsIfIncludeApplica
 	// get all companies
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -685,7 +691,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	insertedCompany1, err := companyService.CreateCompany(company1ToInsert)
+	insertedCompany1, err := companyService.CreateCompany(context.Background(), company1ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany1)
 
@@ -695,7 +701,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	insertedCompany2, err := companyService.CreateCompany(company2ToInsert)
+	insertedCompany2, err := companyService.CreateCompany(context.Background(), company2ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany2)
 
@@ -705,13 +711,14 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoApplicationsIfIncludeApplica
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	insertedCompany3, err := companyService.CreateCompany(company3ToInsert)
+	insertedCompany3, err := companyService.CreateCompany(context.Background(), company3ToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany3)
 
 	// get all companies
 
 	results, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -744,6 +751,7 @@ func TestGetAllCompanies_ShouldReturnNilApplicationsIfIncludeApplicationsIsSetTo
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -764,7 +772,7 @@ func TestGetAllCompanies_ShouldReturnASingleApplicationEntryWhenCompanyIDAndRecr
 		Name:        "companyName",
 		CompanyType: models.CompanyTypeConsultancy,
 	}
-	insertedCompany, err := companyService.CreateCompany(companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -779,12 +787,13 @@ func TestGetAllCompanies_ShouldReturnASingleApplicationEntryWhenCompanyIDAndRecr
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 	}
 
-	_, err = applicationRepository.Create(&application)
+	_, err = applicationRepository.Create(context.Background(), &application)
 	assert.NoError(t, err)
 
 	// get all companies
 
 	idResults, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -833,7 +842,7 @@ func TestGetAll_ShouldReturnCompaniesWithEventsIfIncludeEventsIsSetToAll(t *test
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 8)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 9)),
 	}
-	_, err := eventRepository.Create(&event1)
+	_, err := eventRepository.Create(context.Background(), &event1)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -853,6 +862,7 @@ func TestGetAll_ShouldReturnCompaniesWithEventsIfIncludeEventsIsSetToAll(t *test
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -890,6 +900,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilEventsIfIncludeEventsIsSetToAllAndTh
 	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -920,7 +931,7 @@ func TestGetAll_ShouldReturnCompaniesWithEventIDsIfIncludeEventsIsSetToIDs(t *te
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 8)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 9)),
 	}
-	_, err := eventRepository.Create(&createEvent1)
+	_, err := eventRepository.Create(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -939,6 +950,7 @@ func TestGetAll_ShouldReturnCompaniesWithEventIDsIfIncludeEventsIsSetToIDs(t *te
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -968,6 +980,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilEventsIfIncludeEventsIsSetToIDsAndTh
 	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -998,6 +1011,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilEventsIfIncludeEventsIsSetToNone(t *
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1023,7 +1037,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyService.CreateCompany(&createCompany1)
+	_, err := companyService.CreateCompany(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	createCompany2 := models.CreateCompany{
@@ -1032,7 +1046,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyService.CreateCompany(&createCompany2)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	createCompany3 := models.CreateCompany{
@@ -1041,7 +1055,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyService.CreateCompany(&createCompany3)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1056,7 +1070,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1073,7 +1087,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		PersonID:    *person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -1081,7 +1095,7 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -1089,12 +1103,13 @@ func TestGetAll_ShouldReturnCompaniesWithPersonsIfIncludePersonsIsSetToAll(t *te
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1136,7 +1151,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToAllAnd
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 	}
-	_, err := companyService.CreateCompany(&createCompany1)
+	_, err := companyService.CreateCompany(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	createCompany2 := models.CreateCompany{
@@ -1145,10 +1160,11 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToAllAnd
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyService.CreateCompany(&createCompany2)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1175,7 +1191,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyService.CreateCompany(&createCompany1)
+	_, err := companyService.CreateCompany(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	createCompany2 := models.CreateCompany{
@@ -1184,7 +1200,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyService.CreateCompany(&createCompany2)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	createCompany3 := models.CreateCompany{
@@ -1193,7 +1209,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		CompanyType: models.CompanyTypeRecruiter,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
-	_, err = companyService.CreateCompany(&createCompany3)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany3)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1208,7 +1224,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1225,7 +1241,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		PersonID:    *person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson1)
 	assert.NoError(t, err)
 
 	companyPerson2 := models.AssociateCompanyPerson{
@@ -1233,7 +1249,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson2)
 	assert.NoError(t, err)
 
 	companyPerson3 := models.AssociateCompanyPerson{
@@ -1241,12 +1257,13 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithPersonIDsIfIncludePersonsIsIDs
 		PersonID:    person2ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&companyPerson3)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &companyPerson3)
 	assert.NoError(t, err)
 
 	// get all companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1290,7 +1307,7 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoPersonsIfIncludePersonsIsSet
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyService.CreateCompany(&createCompany1)
+	_, err := companyService.CreateCompany(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	createCompany2 := models.CreateCompany{
@@ -1299,10 +1316,11 @@ func TestGetAllCompanies_ShouldReturnCompaniesWithNoPersonsIfIncludePersonsIsSet
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyService.CreateCompany(&createCompany2)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1329,7 +1347,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToIDsAnd
 		CompanyType: models.CompanyTypeConsultancy,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -4)),
 	}
-	_, err := companyService.CreateCompany(&createCompany1)
+	_, err := companyService.CreateCompany(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	createCompany2 := models.CreateCompany{
@@ -1338,7 +1356,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToIDsAnd
 		CompanyType: models.CompanyTypeEmployer,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 	}
-	_, err = companyService.CreateCompany(&createCompany2)
+	_, err = companyService.CreateCompany(context.Background(), &createCompany2)
 	assert.NoError(t, err)
 
 	// create persons
@@ -1354,7 +1372,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToIDsAnd
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personRepository.Create(&person1)
+	_, err = personRepository.Create(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	repositoryhelpers.CreatePerson(
@@ -1366,6 +1384,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToIDsAnd
 	// get all persons
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1399,6 +1418,7 @@ func TestGetAll_ShouldReturnCompaniesWithNilPersonsIfIncludePersonsIsSetToNone(t
 	// get companies
 
 	companies, err := companyService.GetAllCompanies(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1427,7 +1447,7 @@ func TestUpdateCompany_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -2)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -1)),
 	}
-	insertedCompany, err := companyService.CreateCompany(&companyToInsert)
+	insertedCompany, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedCompany)
 
@@ -1442,11 +1462,11 @@ func TestUpdateCompany_ShouldWork(t *testing.T) {
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 1, 0)),
 	}
 	updatedDateApproximation := time.Now()
-	err = companyService.UpdateCompany(&updateModel)
+	err = companyService.UpdateCompany(context.Background(), &updateModel)
 	assert.NoError(t, err)
 
 	// get the company to ensure that the changes have been applied.
-	retrievedCompany, err := companyService.GetCompanyById(&id)
+	retrievedCompany, err := companyService.GetCompanyById(context.Background(), &id)
 	assert.NoError(t, err)
 
 	assert.NotNil(t, retrievedCompany)
@@ -1465,7 +1485,7 @@ func TestUpdateCompany_ShouldNotReturnErrorIfIdToUpdateDoesNotExist(t *testing.T
 		ID:   uuid.New(),
 		Name: testutil.ToPtr("Updated Name"),
 	}
-	err := companyService.UpdateCompany(&updateModel)
+	err := companyService.UpdateCompany(context.Background(), &updateModel)
 	assert.NoError(t, err)
 }
 
@@ -1487,18 +1507,18 @@ func TestDeleteCompany_ShouldWork(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
 
-	_, err := companyService.CreateCompany(&companyToInsert)
+	_, err := companyService.CreateCompany(context.Background(), &companyToInsert)
 	assert.NoError(t, err)
 
 	// delete the company:
 
-	err = companyService.DeleteCompany(&id)
+	err = companyService.DeleteCompany(context.Background(), &id, nil)
 	assert.NoError(t, err)
 
 	// try to get the company:
 	// this should return an error as the company no longer exists.
 
-	deletedCompany, err := companyService.GetCompanyById(&id)
+	deletedCompany, err := companyService.GetCompanyById(context.Background(), &id)
 	assert.Error(t, err)
 	assert.Nil(t, deletedCompany)
 
@@ -1512,10 +1532,38 @@ func TestDeleteCompany_ShouldReturnNotFoundErrorIfIdToDeleteDoesNotExist(t *test
 
 	id := uuid.New()
 
-	err := companyService.DeleteCompany(&id)
+	err := companyService.DeleteCompany(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: Company does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+func TestDeleteCompany_ShouldReturnPreconditionFailedErrorIfCompanyHasBeenUpdated(t *testing.T) {
+	companyService, _, _, _, _, _, _ := setupCompanyService(t)
+
+	companyToInsert := models.CreateCompany{
+		Name:        "companyName",
+		CompanyType: models.CompanyTypeRecruiter,
+	}
+	company, err := companyService.CreateCompany(context.Background(), &companyToInsert)
+	assert.NoError(t, err)
+
+	err = companyService.UpdateCompany(context.Background(), &models.UpdateCompany{
+		ID:              company.ID,
+		Notes:           testutil.ToPtr("Updated notes"),
+		ExpectedVersion: &company.Version,
+	})
+	assert.NoError(t, err)
+
+	err = companyService.DeleteCompany(context.Background(), &company.ID, &company.Version)
+	assert.Error(t, err)
+
+	var preconditionFailedError *internalErrors.PreconditionFailedError
+	assert.True(t, errors.As(err, &preconditionFailedError))
+
+	retrievedCompany, err := companyService.GetCompanyById(context.Background(), &company.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, retrievedCompany.Version)
+}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
@@ -17,7 +18,7 @@ import (
 func TestCreateCompany_ShouldReturnValidationErrorOnNilCompany(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	nilCompany, err := companyService.CreateCompany(nil)
+	nilCompany, err := companyService.CreateCompany(context.Background(), nil)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 
@@ -39,7 +40,7 @@ func TestCreateCompany_ShouldReturnValidationErrorOnEmptyName(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
 
-	nilCompany, err := companyService.CreateCompany(company)
+	nilCompany, err := companyService.CreateCompany(context.Background(), company)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 
@@ -60,7 +61,7 @@ func TestCreateCompany_ShouldReturnValidationErrorOnEmptyCompanyType(t *testing.
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
 
-	nilCompany, err := companyService.CreateCompany(company)
+	nilCompany, err := companyService.CreateCompany(context.Background(), company)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 
@@ -82,7 +83,7 @@ func TestCreateCompany_ShouldReturnValidationErrorOnInvalidCompanyType(t *testin
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, -3)),
 	}
 
-	nilCompany, err := companyService.CreateCompany(company)
+	nilCompany, err := companyService.CreateCompany(context.Background(), company)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 
@@ -104,7 +105,7 @@ func TestCreateCompany_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *testing.
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -5, 0)),
 	}
 
-	nilCompany, err := companyService.CreateCompany(company)
+	nilCompany, err := companyService.CreateCompany(context.Background(), company)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 
@@ -121,7 +122,7 @@ func TestCreateCompany_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *testing.
 func TestGetCompanyById_ShouldReturnValidationErrorIfCompanyIdIsNil(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	company, err := companyService.GetCompanyById(nil)
+	company, err := companyService.GetCompanyById(context.Background(), nil)
 	assert.Nil(t, company)
 	assert.Error(t, err)
 
@@ -130,11 +131,46 @@ func TestGetCompanyById_ShouldReturnValidationErrorIfCompanyIdIsNil(t *testing.T
 	assert.Equal(t, "validation error on field 'company ID': companyId is required", validationError.Error())
 }
 
+// fakeCompanyRepository serves GetById from a map, so that the service can be tested without a database.
+type fakeCompanyRepository struct {
+	CompanyRepository
+	companies map[uuid.UUID]*models.Company
+}
+
+func (repository *fakeCompanyRepository) GetById(_ context.Context, id *uuid.UUID) (*models.Company, error) {
+	company, exists := repository.companies[*id]
+	if !exists {
+		return nil, internalErrors.NewNotFoundError("ID: '" + id.String() + "'")
+	}
+	return company, nil
+}
+
+func TestGetCompanyById_ShouldReturnCompanyFromRepository(t *testing.T) {
+	companyID := uuid.New()
+	storedCompany := &models.Company{ID: companyID, Name: testutil.ToPtr("CompanyName")}
+	companyService := NewCompanyService(
+		&fakeCompanyRepository{companies: map[uuid.UUID]*models.Company{companyID: storedCompany}})
+
+	company, err := companyService.GetCompanyById(context.Background(), &companyID)
+	assert.NoError(t, err)
+	assert.Equal(t, storedCompany, company)
+}
+
+func TestGetCompanyById_ShouldReturnNotFoundErrorFromRepository(t *testing.T) {
+	companyService := NewCompanyService(&fakeCompanyRepository{companies: map[uuid.UUID]*models.Company{}})
+
+	company, err := companyService.GetCompanyById(context.Background(), testutil.ToPtr(uuid.New()))
+	assert.Nil(t, company)
+
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
 // -------- GetCompaniesByName tests: --------
 func TestGetCompaniesByName_ShouldReturnValidationErrorIfCompanyNameIsNil(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	nilCompany, err := companyService.GetCompaniesByName(nil)
+	nilCompany, err := companyService.GetCompaniesByName(context.Background(), nil)
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
@@ -145,7 +181,7 @@ func TestGetCompaniesByName_ShouldReturnValidationErrorIfCompanyNameIsNil(t *tes
 func TestGetCompaniesByName_ShouldReturnValidationErrorIfCompanyNameIsEmpty(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	nilCompany, err := companyService.GetCompaniesByName(testutil.ToPtr(""))
+	nilCompany, err := companyService.GetCompaniesByName(context.Background(), testutil.ToPtr(""))
 	assert.Nil(t, nilCompany)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
@@ -158,7 +194,7 @@ func TestGetCompaniesByName_ShouldReturnValidationErrorIfCompanyNameIsEmpty(t *t
 func TestUpdateCompany_ShouldReturnValidationErrorIfCompanyIsNil(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	err := companyService.UpdateCompany(nil)
+	err := companyService.UpdateCompany(context.Background(), nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -173,7 +209,7 @@ func TestUpdateCompany_ShouldReturnValidationErrorIfCompanyContainsNothingToUpda
 		ID: uuid.New(),
 	}
 
-	err := companyService.UpdateCompany(companyToUpdate)
+	err := companyService.UpdateCompany(context.Background(), companyToUpdate)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -186,7 +222,7 @@ func TestUpdateCompany_ShouldReturnValidationErrorIfCompanyContainsNothingToUpda
 func TestDeleteCompany_ShouldReturnValidationErrorIfCompanyIdIsNil(t *testing.T) {
 	companyService := NewCompanyService(nil)
 
-	err := companyService.DeleteCompany(nil)
+	err := companyService.DeleteCompany(context.Background(), nil, nil)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/similarity"
+	"slices"
+	"time"
+)
+
+// DuplicateService finds companies and persons that were entered more than once, and merges them.
+type DuplicateService struct {
+	companyRepository CompanyRepository
+	personRepository  PersonRepository
+	unitOfWork        UnitOfWork
+}
+
+func NewDuplicateService(
+	companyRepository CompanyRepository, personRepository PersonRepository, unitOfWork UnitOfWork) *DuplicateService {
+
+	return &DuplicateService{
+		companyRepository: companyRepository,
+		personRepository:  personRepository,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+// FindDuplicateCompanies returns every pair of companies whose normalized names are similar, most similar first.
+//
+// FindDuplicateCompanies can return InternalServiceError
+func (duplicateService *DuplicateService) FindDuplicateCompanies(
+	ctx context.Context) ([]*models.CompanyDuplicate, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError
+	companies, err := duplicateService.companyRepository.GetAll(
+		ctx, models.IncludeExtraDataTypeNone, models.IncludeExtraDataTypeNone, models.IncludeExtraDataTypeNone)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedNames := make([]string, len(companies))
+	for index, company := range companies {
+		normalizedNames[index] = similarity.NormalizeCompanyName(valueOrEmpty(company.Name))
+	}
+
+	duplicates := []*models.CompanyDuplicate{}
+	for first := range companies {
+		for second := first + 1; second < len(companies); second++ {
+			score := similarity.Ratio(normalizedNames[first], normalizedNames[second])
+			if score < similarity.Threshold {
+				continue
+			}
+
+			older, newer := companies[first], companies[second]
+			if isCreatedBefore(newer.CreatedDate, older.CreatedDate) {
+				older, newer = newer, older
+			}
+			duplicates = append(duplicates, &models.CompanyDuplicate{
+				Company:   older,
+				Duplicate: newer,
+				Score:     score,
+				Reasons:   []models.DuplicateReason{models.DuplicateReasonName},
+			})
+		}
+	}
+
+	slices.SortStableFunc(duplicates, func(first, second *models.CompanyDuplicate) int {
+		return compareScoresDescending(first.Score, second.Score)
+	})
+
+	logger.Info("DuplicateService.FindDuplicateCompanies: Found duplicate companies", "count", len(duplicates))
+	return duplicates, nil
+}
+
+// FindDuplicatePersons returns every pair of persons whose normalized names are similar, or whose email addresses
+// have the same domain and similar local parts, most similar first. A pair with similar names and the same email
+// domain also gets the domain reason. The score is that of the most similar of the name and the email local part.
+//
+// FindDuplicatePersons can return InternalServiceError
+func (duplicateService *DuplicateService) FindDuplicatePersons(
+	ctx context.Context) ([]*models.PersonDuplicate, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError
+	persons, err := duplicateService.personRepository.GetAll(
+		ctx, models.IncludeExtraDataTypeNone, models.IncludeExtraDataTypeNone, models.IncludeExtraDataTypeNone)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedNames := make([]string, len(persons))
+	emailLocalParts := make([]string, len(persons))
+	emailDomains := make([]string, len(persons))
+	for index, person := range persons {
+		normalizedNames[index] = similarity.NormalizePersonName(valueOrEmpty(person.Name))
+		emailLocalParts[index], emailDomains[index], _ = similarity.SplitEmail(valueOrEmpty(person.Email))
+	}
+
+	duplicates := []*models.PersonDuplicate{}
+	for first := range persons {
+		for second := first + 1; second < len(persons); second++ {
+			var reasons []models.DuplicateReason
+			var score float64
+
+			nameScore := similarity.Ratio(normalizedNames[first], normalizedNames[second])
+			if nameScore >= similarity.Threshold {
+				reasons = append(reasons, models.DuplicateReasonName)
+				score = nameScore
+			}
+
+			if emailDomains[first] != "" && emailDomains[first] == emailDomains[second] {
+				emailScore := similarity.Ratio(emailLocalParts[first], emailLocalParts[second])
+				if emailScore >= similarity.Threshold {
+					reasons = append(reasons, models.DuplicateReasonEmail)
+					score = max(score, emailScore)
+				}
+				if nameScore >= similarity.Threshold {
+					reasons = append(reasons, models.DuplicateReasonDomain)
+				}
+			}
+
+			if len(reasons) == 0 {
+				continue
+			}
+
+			older, newer := persons[first], persons[second]
+			if isCreatedBefore(newer.CreatedDate, older.CreatedDate) {
+				older, newer = newer, older
+			}
+			duplicates = append(duplicates, &models.PersonDuplicate{
+				Person:    older,
+				Duplicate: newer,
+				Score:     score,
+				Reasons:   reasons,
+			})
+		}
+	}
+
+	// Pairs with more reasons come before pairs with the same score and fewer reasons
+	slices.SortStableFunc(duplicates, func(first, second *models.PersonDuplicate) int {
+		if comparison := compareScoresDescending(first.Score, second.Score); comparison != 0 {
+			return comparison
+		}
+		return len(second.Reasons) - len(first.Reasons)
+	})
+
+	logger.Info("DuplicateService.FindDuplicatePersons: Found duplicate persons", "count", len(duplicates))
+	return duplicates, nil
+}
+
+// MergeCompanies moves the applications, persons and events of the duplicate onto the survivor, deletes the
+// duplicate and returns the survivor. Nothing is changed if any step fails.
+//
+// MergeCompanies can return InternalServiceError, NotFoundError, ValidationError
+func (duplicateService *DuplicateService) MergeCompanies(
+	ctx context.Context, merge *models.Merge) (*models.Company, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if merge == nil {
+		logger.Error("DuplicateService.MergeCompanies: merge is nil")
+		return nil, internalErrors.NewValidationError(nil, "Merge is nil")
+	}
+
+	err := merge.Validate()
+	if err != nil {
+		logger.Info("DuplicateService.MergeCompanies: merge is invalid", "error", err)
+		return nil, err
+	}
+
+	var survivor *models.Company
+	err = duplicateService.unitOfWork.WithTx(ctx, func(repositories *Repositories) error {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if _, err := repositories.Company.GetById(ctx, &merge.DuplicateID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if _, err := repositories.Company.GetById(ctx, &merge.SurvivorID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, ValidationError
+		if err := repositories.Company.MoveReferences(ctx, &merge.DuplicateID, &merge.SurvivorID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if err := repositories.Company.Delete(ctx, &merge.DuplicateID, nil); err != nil {
+			return err
+		}
+
+		var err error
+		survivor, err = repositories.Company.GetById(ctx, &merge.SurvivorID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"DuplicateService.MergeCompanies: Merged companies",
+		"survivorID", merge.SurvivorID,
+		"duplicateID", merge.DuplicateID)
+	return survivor, nil
+}
+
+// MergePersons moves the companies, applications and events of the duplicate onto the survivor, deletes the
+// duplicate and returns the survivor. Nothing is changed if any step fails.
+//
+// MergePersons can return InternalServiceError, NotFoundError, ValidationError
+func (duplicateService *DuplicateService) MergePersons(ctx context.Context, merge *models.Merge) (*models.Person, error) {
+	logger := logging.FromContext(ctx)
+
+	if merge == nil {
+		logger.Error("DuplicateService.MergePersons: merge is nil")
+		return nil, internalErrors.NewValidationError(nil, "Merge is nil")
+	}
+
+	err := merge.Validate()
+	if err != nil {
+		logger.Info("DuplicateService.MergePersons: merge is invalid", "error", err)
+		return nil, err
+	}
+
+	var survivor *models.Person
+	err = duplicateService.unitOfWork.WithTx(ctx, func(repositories *Repositories) error {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if _, err := repositories.Person.GetById(ctx, &merge.DuplicateID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if _, err := repositories.Person.GetById(ctx, &merge.SurvivorID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, ValidationError
+		if err := repositories.Person.MoveReferences(ctx, &merge.DuplicateID, &merge.SurvivorID); err != nil {
+			return err
+		}
+
+		// can return InternalServiceError, NotFoundError, ValidationError
+		if err := repositories.Person.Delete(ctx, &merge.DuplicateID, nil); err != nil {
+			return err
+		}
+
+		var err error
+		survivor, err = repositories.Person.GetById(ctx, &merge.SurvivorID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"DuplicateService.MergePersons: Merged persons",
+		"survivorID", merge.SurvivorID,
+		"duplicateID", merge.DuplicateID)
+	return survivor, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// isCreatedBefore treats a missing date as later than any other, so that rows with a known creation date are
+// preferred as survivors.
+func isCreatedBefore(first *time.Time, second *time.Time) bool {
+	if first == nil {
+		return false
+	}
+	if second == nil {
+		return true
+	}
+	return first.Before(*second)
+}
+
+func compareScoresDescending(first float64, second float64) int {
+	switch {
+	case first > second:
+		return -1
+	case first < second:
+		return 1
+	default:
+		return 0
+	}
+}
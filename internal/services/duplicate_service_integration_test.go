@@ -0,0 +1,256 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupDuplicateService(t *testing.T) (
+	*services.DuplicateService,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.PersonRepository,
+	*repositories.ApplicationPersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupDuplicateServiceTestContainer(t, *config)
+
+	var duplicateService *services.DuplicateService
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	var personRepository *repositories.PersonRepository
+	var applicationPersonRepository *repositories.ApplicationPersonRepository
+	err := container.Invoke(func(
+		service *services.DuplicateService,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		person *repositories.PersonRepository,
+		applicationPerson *repositories.ApplicationPersonRepository) {
+
+		duplicateService = service
+		applicationRepository = application
+		companyRepository = company
+		personRepository = person
+		applicationPersonRepository = applicationPerson
+	})
+	assert.NoError(t, err)
+
+	return duplicateService, applicationRepository, companyRepository, personRepository, applicationPersonRepository
+}
+
+func createNamedCompany(
+	t *testing.T, companyRepository *repositories.CompanyRepository, name string, createdDate time.Time) *models.Company {
+
+	company, err := companyRepository.Create(context.Background(), &models.CreateCompany{
+		Name:        name,
+		CompanyType: models.CompanyTypeEmployer,
+		CreatedDate: &createdDate,
+	})
+	assert.NoError(t, err)
+	return company
+}
+
+func createNamedPerson(
+	t *testing.T,
+	personRepository *repositories.PersonRepository,
+	name string,
+	email *string,
+	createdDate time.Time) *models.Person {
+
+	person, err := personRepository.Create(context.Background(), &models.CreatePerson{
+		Name:        name,
+		PersonType:  models.PersonTypeExternalRecruiter,
+		Email:       email,
+		CreatedDate: &createdDate,
+	})
+	assert.NoError(t, err)
+	return person
+}
+
+// -------- FindDuplicateCompanies tests: --------
+
+func TestFindDuplicateCompanies_ShouldReturnCompaniesWithSimilarNormalizedNames(t *testing.T) {
+	duplicateService, _, companyRepository, _, _ := setupDuplicateService(t)
+
+	now := time.Now()
+	acmeAB := createNamedCompany(t, companyRepository, "Acme AB", now.AddDate(0, 0, -2))
+	acme := createNamedCompany(t, companyRepository, "ACME", now.AddDate(0, 0, -1))
+	initech := createNamedCompany(t, companyRepository, "Initech Ltd", now.AddDate(0, 0, -4))
+	initek := createNamedCompany(t, companyRepository, "Initek", now.AddDate(0, 0, -3))
+	createNamedCompany(t, companyRepository, "Globex", now)
+
+	duplicates, err := duplicateService.FindDuplicateCompanies(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, duplicates, 1)
+
+	assert.Equal(t, acmeAB.ID, duplicates[0].Company.ID, "the older company should be the survivor")
+	assert.Equal(t, acme.ID, duplicates[0].Duplicate.ID)
+	assert.Equal(t, 1.0, duplicates[0].Score)
+	assert.Equal(t, []models.DuplicateReason{models.DuplicateReasonName}, duplicates[0].Reasons)
+
+	// "initech" and "initek" are too far apart
+	for _, duplicate := range duplicates {
+		assert.NotEqual(t, initech.ID, duplicate.Company.ID)
+		assert.NotEqual(t, initek.ID, duplicate.Duplicate.ID)
+	}
+}
+
+func TestFindDuplicateCompanies_ShouldReturnEmptySliceIfThereAreNoDuplicates(t *testing.T) {
+	duplicateService, _, _, _, _ := setupDuplicateService(t)
+
+	duplicates, err := duplicateService.FindDuplicateCompanies(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, duplicates)
+	assert.Empty(t, duplicates)
+}
+
+// -------- FindDuplicatePersons tests: --------
+
+func TestFindDuplicatePersons_ShouldReturnPersonsWithSimilarNamesOrEmails(t *testing.T) {
+	duplicateService, _, _, personRepository, _ := setupDuplicateService(t)
+
+	now := time.Now()
+	jane := createNamedPerson(t, personRepository, "Jane Doe", testutil.ToPtr("jane.doe@acme.se"), now.AddDate(0, 0, -1))
+	janeAgain := createNamedPerson(t, personRepository, "Doe, Jane", testutil.ToPtr("janedoe@acme.se"), now)
+	john := createNamedPerson(t, personRepository, "John Smith", testutil.ToPtr("j.smith@initech.com"), now)
+	johnny := createNamedPerson(t, personRepository, "Johnny S", testutil.ToPtr("jsmith+jobs@initech.com"), now)
+	anna := createNamedPerson(t, personRepository, "Anna Berg", testutil.ToPtr("anna.berg@recruitco.se"), now)
+	annaAgain := createNamedPerson(t, personRepository, "A. Bergh", testutil.ToPtr("annabergh@recruitco.se"), now)
+	createNamedPerson(t, personRepository, "Maria Lind", testutil.ToPtr("info@acme.se"), now)
+	createNamedPerson(t, personRepository, "Erik Lund", testutil.ToPtr("info@initech.com"), now)
+	createNamedPerson(t, personRepository, "Lisa Ek", testutil.ToPtr("lisa.ek@acme.se"), now)
+	createNamedPerson(t, personRepository, "Per Holm", testutil.ToPtr("lisaek@gmail.com"), now)
+
+	duplicates, err := duplicateService.FindDuplicatePersons(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, duplicates, 3)
+
+	assert.Equal(t, jane.ID, duplicates[0].Person.ID)
+	assert.Equal(t, janeAgain.ID, duplicates[0].Duplicate.ID)
+	assert.Equal(t, 1.0, duplicates[0].Score)
+	assert.Equal(
+		t,
+		[]models.DuplicateReason{models.DuplicateReasonName, models.DuplicateReasonEmail, models.DuplicateReasonDomain},
+		duplicates[0].Reasons)
+
+	assert.ElementsMatch(t, []uuid.UUID{john.ID, johnny.ID}, []uuid.UUID{duplicates[1].Person.ID, duplicates[1].Duplicate.ID})
+	assert.Equal(t, 1.0, duplicates[1].Score)
+	assert.Equal(t, []models.DuplicateReason{models.DuplicateReasonEmail}, duplicates[1].Reasons)
+
+	// "annaberg" and "annabergh" differ in more than dots and tags
+	assert.ElementsMatch(
+		t, []uuid.UUID{anna.ID, annaAgain.ID}, []uuid.UUID{duplicates[2].Person.ID, duplicates[2].Duplicate.ID})
+	assert.InDelta(t, 0.89, duplicates[2].Score, 0.01)
+	assert.Equal(t, []models.DuplicateReason{models.DuplicateReasonEmail}, duplicates[2].Reasons)
+}
+
+func TestFindDuplicatePersons_ShouldAddDomainReasonToSimilarNamesWithSameEmailDomain(t *testing.T) {
+	duplicateService, _, _, personRepository, _ := setupDuplicateService(t)
+
+	now := time.Now()
+	createNamedPerson(t, personRepository, "Karin Nilsson", testutil.ToPtr("karin.n@talentfirm.se"), now)
+	createNamedPerson(t, personRepository, "Nilsson, Karin", testutil.ToPtr("knilsson@talentfirm.se"), now)
+	createNamedPerson(t, personRepository, "Sven Olsson", testutil.ToPtr("sven@acme.se"), now)
+	createNamedPerson(t, personRepository, "Sven Olson", testutil.ToPtr("sven.olson@initech.com"), now)
+
+	duplicates, err := duplicateService.FindDuplicatePersons(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, duplicates, 2)
+
+	assert.Equal(t, 1.0, duplicates[0].Score)
+	assert.Equal(
+		t, []models.DuplicateReason{models.DuplicateReasonName, models.DuplicateReasonDomain}, duplicates[0].Reasons)
+
+	assert.Equal(t, []models.DuplicateReason{models.DuplicateReasonName}, duplicates[1].Reasons)
+}
+
+// -------- MergeCompanies tests: --------
+
+func TestMergeCompanies_ShouldMoveReferencesAndDeleteDuplicate(t *testing.T) {
+	duplicateService, applicationRepository, companyRepository, _, _ := setupDuplicateService(t)
+
+	survivor := createNamedCompany(t, companyRepository, "Acme AB", time.Now().AddDate(0, 0, -1))
+	duplicate := createNamedCompany(t, companyRepository, "ACME", time.Now())
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &duplicate.ID, nil, nil)
+
+	merged, err := duplicateService.MergeCompanies(
+		context.Background(), &models.Merge{SurvivorID: survivor.ID, DuplicateID: duplicate.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, merged.ID)
+	assert.Equal(t, "Acme AB", *merged.Name)
+
+	movedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, *movedApplication.CompanyID)
+
+	_, err = companyRepository.GetById(context.Background(), &duplicate.ID)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
+func TestMergeCompanies_ShouldReturnNotFoundErrorAndChangeNothingIfSurvivorDoesNotExist(t *testing.T) {
+	duplicateService, applicationRepository, companyRepository, _, _ := setupDuplicateService(t)
+
+	duplicate := createNamedCompany(t, companyRepository, "ACME", time.Now())
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &duplicate.ID, nil, nil)
+
+	merged, err := duplicateService.MergeCompanies(
+		context.Background(), &models.Merge{SurvivorID: uuid.New(), DuplicateID: duplicate.ID})
+	assert.Nil(t, merged)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+
+	unchangedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, duplicate.ID, *unchangedApplication.CompanyID)
+}
+
+// -------- MergePersons tests: --------
+
+func TestMergePersons_ShouldMoveReferencesAndDeleteDuplicate(t *testing.T) {
+	duplicateService, applicationRepository, companyRepository, personRepository, applicationPersonRepository :=
+		setupDuplicateService(t)
+
+	survivor := createNamedPerson(t, personRepository, "Jane Doe", testutil.ToPtr("jane@acme.se"), time.Now())
+	duplicate := createNamedPerson(t, personRepository, "Jane Doe", testutil.ToPtr("jane@gmail.com"), time.Now())
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	application := repositoryhelpers.CreateApplication(
+		t, applicationRepository, testutil.ToPtr(uuid.New()), &company.ID, nil, nil)
+	repositoryhelpers.AssociateApplicationPerson(t, applicationPersonRepository, application.ID, survivor.ID, nil)
+	repositoryhelpers.AssociateApplicationPerson(t, applicationPersonRepository, application.ID, duplicate.ID, nil)
+
+	merged, err := duplicateService.MergePersons(
+		context.Background(), &models.Merge{SurvivorID: survivor.ID, DuplicateID: duplicate.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, survivor.ID, merged.ID)
+	assert.Equal(t, "jane@acme.se", *merged.Email)
+
+	applicationPersons, err := applicationPersonRepository.GetByID(context.Background(), &application.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, applicationPersons, 1)
+	assert.Equal(t, survivor.ID, applicationPersons[0].PersonID)
+
+	_, err = personRepository.GetById(context.Background(), &duplicate.ID)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
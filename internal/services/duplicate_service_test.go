@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- MergeCompanies tests: --------
+
+func TestMergeCompanies_ShouldReturnValidationErrorOnNilMerge(t *testing.T) {
+	duplicateService := NewDuplicateService(nil, nil, nil)
+
+	company, err := duplicateService.MergeCompanies(context.Background(), nil)
+	assert.Nil(t, company)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: Merge is nil", validationError.Error())
+}
+
+func TestMergeCompanies_ShouldReturnValidationErrorIfIDsAreTheSame(t *testing.T) {
+	duplicateService := NewDuplicateService(nil, nil, nil)
+
+	id := uuid.New()
+	company, err := duplicateService.MergeCompanies(
+		context.Background(), &models.Merge{SurvivorID: id, DuplicateID: id})
+	assert.Nil(t, company)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: SurvivorID and DuplicateID cannot be the same", validationError.Error())
+}
+
+// -------- MergePersons tests: --------
+
+func TestMergePersons_ShouldReturnValidationErrorOnEmptySurvivorID(t *testing.T) {
+	duplicateService := NewDuplicateService(nil, nil, nil)
+
+	person, err := duplicateService.MergePersons(context.Background(), &models.Merge{DuplicateID: uuid.New()})
+	assert.Nil(t, person)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'SurvivorID': SurvivorID is empty", validationError.Error())
+}
@@ -1,46 +1,48 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 
 	"github.com/google/uuid"
 )
 
 type EventPersonService struct {
-	eventPersonRepository *repositories.EventPersonRepository
+	eventPersonRepository EventPersonRepository
 }
 
-func NewEventPersonService(eventPersonRepository *repositories.EventPersonRepository) *EventPersonService {
+func NewEventPersonService(eventPersonRepository EventPersonRepository) *EventPersonService {
 	return &EventPersonService{eventPersonRepository: eventPersonRepository}
 }
 
 // AssociateEventPerson can return ConflictError, InternalServiceError, ValidationError
 func (eventPersonService *EventPersonService) AssociateEventPerson(
-	AssociateEventPerson *models.AssociateEventPerson) (*models.EventPerson, error) {
+	ctx context.Context, AssociateEventPerson *models.AssociateEventPerson) (*models.EventPerson, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if AssociateEventPerson == nil {
-		slog.Error("person_service.AssociateEventPerson: model is nil")
+		logger.Error("person_service.AssociateEventPerson: model is nil")
 		return nil, internalErrors.NewValidationError(nil, "AssociateEventPerson model is nil")
 	}
 
 	err := AssociateEventPerson.Validate()
 	if err != nil {
-		slog.Info("person_service.AssociateEventPerson: EventPerson to create is invalid", "error", err)
+		logger.Info("person_service.AssociateEventPerson: EventPerson to create is invalid", "error", err)
 
 		return nil, err
 	}
 
 	insertedEventPerson, err :=
-		eventPersonService.eventPersonRepository.AssociateEventPerson(AssociateEventPerson)
+		eventPersonService.eventPersonRepository.AssociateEventPerson(ctx, AssociateEventPerson)
 
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info(
+	logger.Info(
 		"person_service.AssociateEventPerson: Associated event to person.",
 		"person.EventID", insertedEventPerson.EventID,
 		"person.PersonID", insertedEventPerson.PersonID)
@@ -49,21 +51,23 @@ func (eventPersonService *EventPersonService) AssociateEventPerson(
 
 // GetByID can return ValidationError, InternalServiceError
 func (eventPersonService *EventPersonService) GetByID(
-	eventID *uuid.UUID, personID *uuid.UUID) ([]*models.EventPerson, error) {
+	ctx context.Context, eventID *uuid.UUID, personID *uuid.UUID) ([]*models.EventPerson, error) {
+
+	logger := logging.FromContext(ctx)
 
 	if (eventID == nil || *eventID == uuid.Nil) && (personID == nil || *personID == uuid.Nil) {
 		return nil, internalErrors.NewValidationError(nil, "eventID and personID cannot both be empty")
 	}
 
-	eventPersons, err := eventPersonService.eventPersonRepository.GetByID(eventID, personID)
+	eventPersons, err := eventPersonService.eventPersonRepository.GetByID(ctx, eventID, personID)
 	if err != nil {
 		return nil, err
 	}
 
 	if eventPersons == nil {
-		slog.Info("EventPersonService.GetByID: Retrieved zero persons")
+		logger.Info("EventPersonService.GetByID: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"EventPersonService.GetByID: Retrieved " + string(rune(len(eventPersons))) + " persons")
 	}
 
@@ -71,28 +75,49 @@ func (eventPersonService *EventPersonService) GetByID(
 }
 
 // GetAll can return InternalServiceError
-func (eventPersonService *EventPersonService) GetAll() ([]*models.EventPerson, error) {
-	eventPersons, err := eventPersonService.eventPersonRepository.GetAll()
+func (eventPersonService *EventPersonService) GetAll(ctx context.Context) ([]*models.EventPerson, error) {
+	logger := logging.FromContext(ctx)
+
+	eventPersons, err := eventPersonService.eventPersonRepository.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if eventPersons == nil {
-		slog.Info("EventPersonService.GetAllEventPersons: Retrieved zero persons")
+		logger.Info("EventPersonService.GetAllEventPersons: Retrieved zero persons")
 	} else {
-		slog.Info(
+		logger.Info(
 			"EventPersonService.GetAllEventPersons: Retrieved " + string(rune(len(eventPersons))) + " persons")
 	}
 
 	return eventPersons, nil
 }
 
+// Update can return InternalServiceError, NotFoundError, ValidationError
+func (eventPersonService *EventPersonService) Update(ctx context.Context, model *models.UpdateEventPerson) error {
+	logger := logging.FromContext(ctx)
+
+	if model == nil {
+		logger.Error("event_person_service.Update: model is nil")
+		return internalErrors.NewValidationError(nil, "UpdateEventPerson model is nil")
+	}
+
+	err := model.Validate()
+	if err != nil {
+		logger.Info("event_person_service.Update: EventPerson to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return eventPersonService.eventPersonRepository.Update(ctx, model)
+}
+
 // Delete can return InternalServiceError, NotFoundError, ValidationError
-func (eventPersonService *EventPersonService) Delete(model *models.DeleteEventPerson) error {
+func (eventPersonService *EventPersonService) Delete(ctx context.Context, model *models.DeleteEventPerson) error {
 	err := model.Validate()
 	if err != nil {
 		return err
 	}
 
-	return eventPersonService.eventPersonRepository.Delete(model)
+	return eventPersonService.eventPersonRepository.Delete(ctx, model)
 }
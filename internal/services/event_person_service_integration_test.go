@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	configPackage "jobsearchtracker/internal/config"
 	internalErrors "jobsearchtracker/internal/errors"
@@ -61,7 +62,7 @@ func TestAssociateEventToPerson_ShouldAssociateAEventToAPerson(t *testing.T) {
 		PersonID:    person.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	associatedEventPerson, err := eventPersonService.AssociateEventPerson(&eventPerson)
+	associatedEventPerson, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, eventPerson.EventID, associatedEventPerson.EventID)
@@ -79,7 +80,7 @@ func TestAssociateEventToPerson_ShouldAssociateAEventToAPersonWithOnlyRequiredFi
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	associatedEventPerson, err := eventPersonService.AssociateEventPerson(&eventPerson)
+	associatedEventPerson, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	assert.Equal(t, eventPerson.EventID, associatedEventPerson.EventID)
@@ -97,10 +98,10 @@ func TestAssociateEventToPerson_ShouldReturnConflictErrorIfEventIDAndPersonIDCom
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.Error(t, err)
 
 	var conflictError *internalErrors.ConflictError
@@ -127,7 +128,7 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -135,7 +136,7 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -143,10 +144,10 @@ func TestGetByID_ShouldGetRecordsMatchingEventID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	eventPersons, err := eventPersonService.GetByID(&event1.ID, nil)
+	eventPersons, err := eventPersonService.GetByID(context.Background(), &event1.ID, nil)
 	assert.NoError(t, err)
 	assert.Len(t, eventPersons, 2)
 
@@ -171,7 +172,7 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -179,7 +180,7 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -187,10 +188,10 @@ func TestEventPersonGetByID_ShouldGetRecordsMatchingPersonID(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	eventPersons, err := eventPersonService.GetByID(nil, &person1.ID)
+	eventPersons, err := eventPersonService.GetByID(context.Background(), nil, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, eventPersons, 2)
 
@@ -214,24 +215,24 @@ func TestGetByID_ShouldGetRecordsMatchingEventIDAndPersonID(t *testing.T) {
 		EventID:  event1.ID,
 		PersonID: person1.ID,
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
 		EventID:  event1.ID,
 		PersonID: person2.ID,
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
 		EventID:  event2.ID,
 		PersonID: person1.ID,
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	persons, err := eventPersonService.GetByID(&event1.ID, &person1.ID)
+	persons, err := eventPersonService.GetByID(context.Background(), &event1.ID, &person1.ID)
 	assert.NoError(t, err)
 	assert.Len(t, persons, 1)
 	assert.Equal(t, event1.ID, persons[0].EventID)
@@ -254,7 +255,7 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person1.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson1)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson1)
 	assert.NoError(t, err)
 
 	eventPerson2 := models.AssociateEventPerson{
@@ -262,7 +263,7 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson2)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson2)
 	assert.NoError(t, err)
 
 	eventPerson3 := models.AssociateEventPerson{
@@ -270,10 +271,10 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 		PersonID:    person2.ID,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = eventPersonService.AssociateEventPerson(&eventPerson3)
+	_, err = eventPersonService.AssociateEventPerson(context.Background(), &eventPerson3)
 	assert.NoError(t, err)
 
-	personCompanies, err := eventPersonService.GetAll()
+	personCompanies, err := eventPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 
 	assert.Len(t, personCompanies, 3)
@@ -297,7 +298,7 @@ func TestGetAllEventPersons_ShouldReturnAllEventPersons(t *testing.T) {
 func TestGetAllEventPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	eventPersonService, _, _ := setupEventPersonService(t)
 
-	results, err := eventPersonService.GetAll()
+	results, err := eventPersonService.GetAll(context.Background())
 	assert.NoError(t, err)
 	assert.Nil(t, results)
 }
@@ -314,7 +315,7 @@ func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteEventPerson{
@@ -322,7 +323,7 @@ func TestDeleteEventPerson_ShouldDeleteEventPerson(t *testing.T) {
 		PersonID: person.ID,
 	}
 
-	err = eventPersonService.Delete(&deleteModel)
+	err = eventPersonService.Delete(context.Background(), &deleteModel)
 	assert.NoError(t, err)
 }
 
@@ -336,7 +337,7 @@ func TestDeleteEventPerson_ShouldReturnNotFoundErrorIfNoMatchingEventPersonInDat
 		EventID:  event.ID,
 		PersonID: person.ID,
 	}
-	_, err := eventPersonService.AssociateEventPerson(&eventPerson)
+	_, err := eventPersonService.AssociateEventPerson(context.Background(), &eventPerson)
 	assert.NoError(t, err)
 
 	deleteModel := models.DeleteEventPerson{
@@ -344,7 +345,7 @@ func TestDeleteEventPerson_ShouldReturnNotFoundErrorIfNoMatchingEventPersonInDat
 		PersonID: uuid.New(),
 	}
 
-	err = eventPersonService.Delete(&deleteModel)
+	err = eventPersonService.Delete(context.Background(), &deleteModel)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
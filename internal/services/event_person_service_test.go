@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/testutil"
@@ -15,7 +16,7 @@ import (
 func TestAssociateEventPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
 	service := NewEventPersonService(nil)
 
-	nilEvent, err := service.AssociateEventPerson(nil)
+	nilEvent, err := service.AssociateEventPerson(context.Background(), nil)
 	assert.Nil(t, nilEvent)
 
 	var validationError *internalErrors.ValidationError
@@ -58,7 +59,7 @@ func TestGetByID_ShouldReturnValidationErrorIfEventIDAndPersonIDAreEmpty(t *test
 		t.Run(test.testName, func(t *testing.T) {
 			service := NewEventPersonService(nil)
 
-			personCompanies, err := service.GetByID(test.eventID, test.personID)
+			personCompanies, err := service.GetByID(context.Background(), test.eventID, test.personID)
 			assert.Nil(t, personCompanies)
 
 			assert.Error(t, err)
@@ -67,6 +68,31 @@ func TestGetByID_ShouldReturnValidationErrorIfEventIDAndPersonIDAreEmpty(t *test
 	}
 }
 
+// -------- Update tests: --------
+
+func TestUpdateEventPerson_ShouldReturnValidationErrorIfModelIsNil(t *testing.T) {
+	service := NewEventPersonService(nil)
+
+	err := service.Update(context.Background(), nil)
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: UpdateEventPerson model is nil", validationError.Error())
+}
+
+func TestUpdateEventPerson_ShouldReturnValidationErrorIfNothingToUpdate(t *testing.T) {
+	service := NewEventPersonService(nil)
+
+	err := service.Update(context.Background(), &models.UpdateEventPerson{
+		EventID:  uuid.New(),
+		PersonID: uuid.New(),
+	})
+
+	var validationError *internalErrors.ValidationError
+	assert.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "validation error: nothing to update", validationError.Error())
+}
+
 // -------- Delete tests: --------
 
 func TestDelete_ShouldReturnValidationErrorIfEventIDOrPersonIDisEmpty(t *testing.T) {
@@ -105,7 +131,7 @@ func TestDelete_ShouldReturnValidationErrorIfEventIDOrPersonIDisEmpty(t *testing
 				PersonID: test.personID,
 			}
 
-			err := service.Delete(&deleteModel)
+			err := service.Delete(context.Background(), &deleteModel)
 			assert.Error(t, err)
 
 			var validationError *internalErrors.ValidationError
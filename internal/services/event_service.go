@@ -1,27 +1,33 @@
 package services
 
 import (
+	"context"
+	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type EventService struct {
-	eventRepository *repositories.EventRepository
+	eventRepository          EventRepository
+	eventTransitionValidator *EventTransitionValidator
 }
 
-func NewEventService(eventRepository *repositories.EventRepository) *EventService {
-	return &EventService{eventRepository: eventRepository}
+func NewEventService(
+	eventRepository EventRepository, eventTransitionValidator *EventTransitionValidator) *EventService {
+
+	return &EventService{eventRepository: eventRepository, eventTransitionValidator: eventTransitionValidator}
 }
 
 // CreateEvent can return ConflictError, InternalServiceError, ValidationError
-func (eventService *EventService) CreateEvent(event *models.CreateEvent) (*models.Event, error) {
+func (eventService *EventService) CreateEvent(ctx context.Context, event *models.CreateEvent) (*models.Event, error) {
+	logger := logging.FromContext(ctx)
+
 	if event == nil {
-		slog.Error("event_service.CreateEvent: event is nil")
+		logger.Error("event_service.CreateEvent: event is nil")
 		return nil, internalErrors.NewValidationError(nil, "CreateEvent is nil")
 	}
 
@@ -33,7 +39,7 @@ func (eventService *EventService) CreateEvent(event *models.CreateEvent) (*model
 		} else {
 			eventID = "[not set]"
 		}
-		slog.Info("event_service.CreateEvent: event to create is invalid. ", "ID", eventID, "error", err)
+		logger.Info("event_service.CreateEvent: event to create is invalid. ", "ID", eventID, "error", err)
 		return nil, err
 	}
 
@@ -43,95 +49,227 @@ func (eventService *EventService) CreateEvent(event *models.CreateEvent) (*model
 	} else if event.CreatedDate.IsZero() {
 		createdDate := time.Now()
 		event.CreatedDate = &createdDate
-		slog.Info(
+		logger.Info(
 			"event_service.CreateEvent: event.CreatedDate is zero. Setting to '" + event.CreatedDate.String() + "'")
 	}
 
-	insertedEvent, err := eventService.eventRepository.Create(event)
+	insertedEvent, err := eventService.eventRepository.Create(ctx, event)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("event_service.CreateEvent: Inserted event.", "event.ID", insertedEvent.ID)
+	logger.Info("event_service.CreateEvent: Inserted event.", "event.ID", insertedEvent.ID)
+
+	// the event is already inserted, so a failing overlap check should not fail the request
+	insertedEvent.OverlappingEvents, err = eventService.getOverlappingEvents(ctx, insertedEvent)
+	if err != nil {
+		logger.Error("event_service.CreateEvent: Unable to check for overlapping events", "error", err)
+	}
+
 	return insertedEvent, nil
 }
 
 // GetEventByID can return ConflictError, InternalServiceError, NewValidationError
-func (eventService *EventService) GetEventByID(eventID *uuid.UUID) (*models.Event, error) {
+func (eventService *EventService) GetEventByID(ctx context.Context, eventID *uuid.UUID) (*models.Event, error) {
+	logger := logging.FromContext(ctx)
+
 	if eventID == nil {
 		eventIDString := "event ID"
 		err := internalErrors.NewValidationError(&eventIDString, "eventID is required")
-		slog.Info("eventService.GetEvenByID: Failed to get Event", "error", err)
+		logger.Info("eventService.GetEvenByID: Failed to get Event", "error", err)
 		return nil, err
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	event, err := eventService.eventRepository.GetByID(eventID)
+	event, err := eventService.eventRepository.GetByID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("eventService.GetEvenByID: Retrieved event.", "event.ID", event.ID.String())
+	logger.Info("eventService.GetEvenByID: Retrieved event.", "event.ID", event.ID.String())
+
+	// can return InternalServiceError
+	event.OverlappingEvents, err = eventService.getOverlappingEvents(ctx, event)
+	if err != nil {
+		return nil, err
+	}
 
 	return event, nil
 }
 
+// GetEventsByIDs returns the events with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetEventsByIDs can return InternalServiceError, ValidationError
+func (eventService *EventService) GetEventsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Event, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, ValidationError
+	events, err := eventService.eventRepository.GetAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("EventService.GetEventsByIDs: Retrieved events", "requested", len(ids), "found", len(events))
+
+	return events, nil
+}
+
 // GetAllEvents can return InternalServiceError
 func (eventService *EventService) GetAllEvents(
+	ctx context.Context,
 	includeApplications models.IncludeExtraDataType,
 	includeCompanies models.IncludeExtraDataType,
 	includePersons models.IncludeExtraDataType) ([]*models.Event, error) {
 
-	events, err := eventService.eventRepository.GetAll(includeApplications, includeCompanies, includePersons)
+	logger := logging.FromContext(ctx)
+
+	events, err := eventService.eventRepository.GetAll(ctx, includeApplications, includeCompanies, includePersons)
 	if err != nil {
 		return nil, err
 	}
 
 	if events == nil {
-		slog.Info("event_service.GetAllEvents: Retrieved Zero events")
+		logger.Info("event_service.GetAllEvents: Retrieved Zero events")
 	} else {
-		slog.Info("event_service.GetAllEvents: Retrieved " + string(rune(len(events))) + " events")
+		logger.Info("event_service.GetAllEvents: Retrieved " + string(rune(len(events))) + " events")
 	}
 
 	return events, nil
 }
 
-// UpdateEvent can return InternalServiceError, ValidationError
-func (eventService *EventService) UpdateEvent(event *models.UpdateEvent) error {
+// UpdateEvent updates the event and returns it, with the booked events that it now clashes with in
+// OverlappingEvents.
+//
+// UpdateEvent can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (eventService *EventService) UpdateEvent(ctx context.Context, event *models.UpdateEvent) (*models.Event, error) {
+	logger := logging.FromContext(ctx)
+
 	if event == nil {
-		slog.Error("EventService.UpdateEvent: UpdateEvent is nil")
-		return internalErrors.NewValidationError(nil, "UpdateEvent model is nil")
+		logger.Error("EventService.UpdateEvent: UpdateEvent is nil")
+		return nil, internalErrors.NewValidationError(nil, "UpdateEvent model is nil")
 	}
 
 	// can return ValidationError
 	err := event.Validate()
 	if err != nil {
-		slog.Info("EventService.UpdateEvent: UpdateEvent model is invalid. ", "error", err)
-		return err
+		logger.Info("EventService.UpdateEvent: UpdateEvent model is invalid. ", "error", err)
+		return nil, err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = eventService.eventRepository.Update(event)
+	if event.EventType != nil || event.EventDate != nil {
+		// can return InternalServiceError, ValidationError
+		err = eventService.validateEventTransitions(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = eventService.eventRepository.Update(ctx, event)
 	if err != nil {
-		slog.Error("EventService.UpdateEvent: Error updating event", "error", err)
+		logger.Error("EventService.UpdateEvent: Error updating event", "error", err)
+		return nil, err
 	}
 
-	return err
+	// can return InternalServiceError, NotFoundError, ValidationError
+	updatedEvent, err := eventService.eventRepository.GetByID(ctx, &event.ID)
+	if err != nil {
+		logger.Error("EventService.UpdateEvent: Unable to get updated event", "error", err)
+		return nil, err
+	}
+
+	// the event is already updated, so a failing overlap check should not fail the request
+	updatedEvent.OverlappingEvents, err = eventService.getOverlappingEvents(ctx, updatedEvent)
+	if err != nil {
+		logger.Error("EventService.UpdateEvent: Unable to check for overlapping events", "error", err)
+	}
+
+	return updatedEvent, nil
 }
 
-// DeleteEvent can return InternalServiceError, NotFoundError, ValidationError
-func (eventService *EventService) DeleteEvent(eventID *uuid.UUID) error {
+// DeleteEvent deletes the event. If expectedVersion is set, it is only deleted if it has that version.
+//
+// DeleteEvent can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (eventService *EventService) DeleteEvent(
+	ctx context.Context, eventID *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+
 	if eventID == nil {
 		eventIDString := "event ID"
 		err := internalErrors.NewValidationError(&eventIDString, "eventID is required")
-		slog.Info("eventService.DeleteEvent: Failed to delete event", "error", err)
+		logger.Info("eventService.DeleteEvent: Failed to delete event", "error", err)
 		return err
 	}
 
-	err := eventService.eventRepository.Delete(eventID)
+	err := eventService.eventRepository.Delete(ctx, eventID, expectedVersion)
 	if err != nil {
-		slog.Error("EventService.DeleteEvent: Error deleting event", "error", err)
+		logger.Error("EventService.DeleteEvent: Error deleting event", "error", err)
 	}
 
 	return err
 }
+
+// internal functions
+
+// validateEventTransitions checks the event, as it will be once event is applied, against the event transition rules
+// of its applications.
+//
+// validateEventTransitions can return InternalServiceError, ValidationError
+func (eventService *EventService) validateEventTransitions(ctx context.Context, event *models.UpdateEvent) error {
+	// can return InternalServiceError, NotFoundError, ValidationError
+	currentEvent, err := eventService.eventRepository.GetByID(ctx, &event.ID)
+	if err != nil {
+		var notFoundErr *internalErrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			// an event that does not exist has no applications to check
+			return nil
+		}
+		return err
+	}
+
+	updatedEvent := *currentEvent
+	if event.EventType != nil {
+		updatedEvent.EventType = event.EventType
+	}
+	if event.EventDate != nil {
+		updatedEvent.EventDate = event.EventDate
+	}
+
+	// can return InternalServiceError, ValidationError
+	return eventService.eventTransitionValidator.ValidateEvent(ctx, &updatedEvent)
+}
+
+// getOverlappingEvents returns the other booked events that clash with event, and logs a warning if there are any.
+// Events that are not booked never clash.
+//
+// getOverlappingEvents can return InternalServiceError
+func (eventService *EventService) getOverlappingEvents(
+	ctx context.Context, event *models.Event) ([]*models.Event, error) {
+
+	if event.EventType == nil || !event.EventType.IsBooked() || event.EventDate == nil {
+		return nil, nil
+	}
+
+	endDate := event.EndDate()
+	if endDate == nil {
+		defaultEndDate := event.EventDate.Add(models.DefaultBookedEventDuration)
+		endDate = &defaultEndDate
+	}
+
+	// can return InternalServiceError
+	overlappingEvents, err := eventService.eventRepository.GetOverlappingBookedEvents(
+		ctx, &event.ID, *event.EventDate, *endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(overlappingEvents) > 0 {
+		logging.FromContext(ctx).Warn(
+			"event_service.getOverlappingEvents: Booked event clashes with other booked events",
+			"event.ID", event.ID,
+			"overlappingEventCount", len(overlappingEvents))
+	}
+
+	return overlappingEvents, nil
+}
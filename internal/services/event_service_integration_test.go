@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	"jobsearchtracker/internal/api/v1/requests"
 	configPackage "jobsearchtracker/internal/config"
@@ -107,7 +108,7 @@ func TestCreateEvent_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	insertedEvent, err := eventService.CreateEvent(&createEvent)
+	insertedEvent, err := eventService.CreateEvent(context.Background(), &createEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedEvent)
 
@@ -129,7 +130,7 @@ func TestCreate_ShouldInsertEventWithOnlyRequiredFields(t *testing.T) {
 	}
 	createdDateApproximation := time.Now()
 
-	insertedEvent, err := eventService.CreateEvent(&createEvent)
+	insertedEvent, err := eventService.CreateEvent(context.Background(), &createEvent)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedEvent)
 
@@ -156,10 +157,10 @@ func TestGetEventByID_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 6, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 5, 0)),
 	}
-	_, err := eventService.CreateEvent(&createEvent)
+	_, err := eventService.CreateEvent(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
-	event, err := eventService.GetEventByID(createEvent.ID)
+	event, err := eventService.GetEventByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -176,7 +177,7 @@ func TestGetEventByID_ShouldReturnNotFoundErrorIfEventIDDoesNotExist(t *testing.
 	eventService, _, _, _, _, _, _, _ := setupEventService(t)
 
 	id := uuid.New()
-	nilEvent, err := eventService.GetEventByID(&id)
+	nilEvent, err := eventService.GetEventByID(context.Background(), &id)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -201,12 +202,13 @@ func TestGetAllEvents_ShouldReturnAllEvents(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	_, err := eventService.CreateEvent(&createEvent1)
+	_, err := eventService.CreateEvent(context.Background(), &createEvent1)
 	assert.NoError(t, err)
 
 	createEvent2 := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -235,6 +237,7 @@ func TestGetAllEvents_ShouldReturnNilIfNoEventsInDatabase(t *testing.T) {
 	eventService, _, _, _, _, _, _, _ := setupEventService(t)
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -293,7 +296,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnApplicationsIfIncludeApplicatio
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -313,6 +316,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnApplicationsIfIncludeApplicatio
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -364,6 +368,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoApplicationsIfIncludeApplicat
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -411,7 +416,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnApplicationIDsIfIncludeApplicat
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -430,6 +435,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnApplicationIDsIfIncludeApplicat
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -478,6 +484,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoApplicationsIfIncludeApplicat
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -524,6 +531,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoApplicationsIfIncludeApplicat
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -575,7 +583,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnCompaniesIfIncludeCompaniesIsSe
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 4, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 3, 0)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -590,26 +598,27 @@ func TestEventRepositoryGetAllEvents_ShouldReturnCompaniesIfIncludeCompaniesIsSe
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -655,6 +664,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoCompaniesIfIncludeCompaniesIs
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -707,7 +717,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnCompanyIDsIfIncludeCompaniesIsS
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 4, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 3, 0)),
 	}
-	_, err := companyRepository.Create(&createCompany1)
+	_, err := companyRepository.Create(context.Background(), &createCompany1)
 	assert.NoError(t, err)
 
 	company2ID := repositoryhelpers.CreateCompany(
@@ -722,26 +732,27 @@ func TestEventRepositoryGetAllEvents_ShouldReturnCompanyIDsIfIncludeCompaniesIsS
 		CompanyID: *createCompany1.ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company1Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company1Event1)
 	assert.NoError(t, err)
 
 	Company2Event1 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event1ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event1)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event1)
 	assert.NoError(t, err)
 
 	Company2Event2 := models.AssociateCompanyEvent{
 		CompanyID: company2ID,
 		EventID:   event2ID,
 	}
-	_, err = companyEventRepository.AssociateCompanyEvent(&Company2Event2)
+	_, err = companyEventRepository.AssociateCompanyEvent(context.Background(), &Company2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -784,6 +795,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoCompaniesIfIncludeCompaniesIs
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -814,6 +826,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoCompaniesIfIncludeCompaniesIs
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -867,7 +880,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnPersonsIfIncludePersonsIsSetToA
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -882,26 +895,27 @@ func TestEventRepositoryGetAllEvents_ShouldReturnPersonsIfIncludePersonsIsSetToA
 		PersonID: *createPerson1.ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person1Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person1Event1)
 	assert.NoError(t, err)
 
 	Person2Event1 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event1)
 	assert.NoError(t, err)
 
 	Person2Event2 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event2ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -948,6 +962,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -1002,7 +1017,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnPersonIDsIfIncludePersonsIsSetT
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err := personRepository.Create(&createPerson1)
+	_, err := personRepository.Create(context.Background(), &createPerson1)
 	assert.NoError(t, err)
 
 	person2ID := repositoryhelpers.CreatePerson(
@@ -1017,26 +1032,27 @@ func TestEventRepositoryGetAllEvents_ShouldReturnPersonIDsIfIncludePersonsIsSetT
 		PersonID: *createPerson1.ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person1Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person1Event1)
 	assert.NoError(t, err)
 
 	Person2Event1 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event1ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event1)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event1)
 	assert.NoError(t, err)
 
 	Person2Event2 := models.AssociateEventPerson{
 		PersonID: person2ID,
 		EventID:  event2ID,
 	}
-	_, err = eventPersonRepository.AssociateEventPerson(&Person2Event2)
+	_, err = eventPersonRepository.AssociateEventPerson(context.Background(), &Person2Event2)
 	assert.NoError(t, err)
 
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1080,6 +1096,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -1110,6 +1127,7 @@ func TestEventRepositoryGetAllEvents_ShouldReturnNoPersonsIfIncludePersonsIsSetT
 	// get all events
 
 	events, err := eventService.GetAllEvents(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1136,7 +1154,7 @@ func TestUpdateEvent_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 13, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 14, 0)),
 	}
-	_, err := eventService.CreateEvent(&createEvent)
+	_, err := eventService.CreateEvent(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
 	var eventType models.EventType = models.EventTypeCallBooked
@@ -1148,10 +1166,10 @@ func TestUpdateEvent_ShouldWork(t *testing.T) {
 		EventDate:   testutil.ToPtr(time.Now().AddDate(0, -3, 0)),
 	}
 	updatedDateApproximation := time.Now()
-	err = eventService.UpdateEvent(&updateEvent)
+	_, err = eventService.UpdateEvent(context.Background(), &updateEvent)
 	assert.NoError(t, err)
 
-	event, err := eventService.GetEventByID(createEvent.ID)
+	event, err := eventService.GetEventByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -1172,17 +1190,17 @@ func TestUpdateEvent_ShouldUpdateASingleField(t *testing.T) {
 		EventType: models.EventTypeApplied,
 		EventDate: time.Now().AddDate(0, 12, 0),
 	}
-	_, err := eventService.CreateEvent(&createEvent)
+	_, err := eventService.CreateEvent(context.Background(), &createEvent)
 	assert.NoError(t, err)
 
 	updateEvent := models.UpdateEvent{
 		ID:    *createEvent.ID,
 		Notes: testutil.ToPtr("New Notes"),
 	}
-	err = eventService.UpdateEvent(&updateEvent)
+	_, err = eventService.UpdateEvent(context.Background(), &updateEvent)
 	assert.NoError(t, err)
 
-	event, err := eventService.GetEventByID(createEvent.ID)
+	event, err := eventService.GetEventByID(context.Background(), createEvent.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
 
@@ -1190,15 +1208,18 @@ func TestUpdateEvent_ShouldUpdateASingleField(t *testing.T) {
 	assert.Equal(t, updateEvent.Notes, event.Notes)
 }
 
-func TestUpdateEvent_ShouldNotReturnErrorIfEventDoesNotExist(t *testing.T) {
+func TestUpdateEvent_ShouldReturnNotFoundErrorIfEventDoesNotExist(t *testing.T) {
 	eventService, _, _, _, _, _, _, _ := setupEventService(t)
 
 	updateEvent := models.UpdateEvent{
 		ID:    uuid.New(),
 		Notes: testutil.ToPtr("New Notes"),
 	}
-	err := eventService.UpdateEvent(&updateEvent)
-	assert.NoError(t, err)
+	event, err := eventService.UpdateEvent(context.Background(), &updateEvent)
+	assert.Nil(t, event)
+
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
 }
 
 // -------- DeleteEvent tests: --------
@@ -1208,10 +1229,10 @@ func TestDeleteEvent_ShouldDeleteEvent(t *testing.T) {
 
 	eventID := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil).ID
 
-	err := eventService.DeleteEvent(&eventID)
+	err := eventService.DeleteEvent(context.Background(), &eventID, nil)
 	assert.NoError(t, err)
 
-	retrievedPerson, err := eventService.GetEventByID(&eventID)
+	retrievedPerson, err := eventService.GetEventByID(context.Background(), &eventID)
 	assert.Nil(t, retrievedPerson)
 	assert.Error(t, err)
 }
@@ -1220,10 +1241,84 @@ func TestDeleteEvent_ShouldReturnNotFoundErrorIfEventIDDoesNotExist(t *testing.T
 	eventService, _, _, _, _, _, _, _ := setupEventService(t)
 
 	id := uuid.New()
-	err := eventService.DeleteEvent(&id)
+	err := eventService.DeleteEvent(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
 	assert.True(t, errors.As(err, &notFoundError))
 	assert.Equal(t, "error: object not found: event does not exist. ID: "+id.String(), notFoundError.Error())
 }
+
+// -------- Overlap detection tests: --------
+
+func TestCreateEvent_ShouldReturnOverlappingBookedEvents(t *testing.T) {
+	eventService, _, _, _, _, _, _, _ := setupEventService(t)
+
+	eventDate := time.Now().AddDate(0, 0, 7).Truncate(time.Minute)
+	existingEvent, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType:       models.EventTypeInterviewBooked,
+		EventDate:       eventDate,
+		DurationMinutes: testutil.ToPtr(60),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, existingEvent.OverlappingEvents)
+
+	clashingEvent, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType: models.EventTypeCallBooked,
+		EventDate: eventDate.Add(45 * time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, clashingEvent.OverlappingEvents, 1)
+	assert.Equal(t, existingEvent.ID, clashingEvent.OverlappingEvents[0].ID)
+
+	retrievedEvent, err := eventService.GetEventByID(context.Background(), &existingEvent.ID)
+	assert.NoError(t, err)
+	assert.Len(t, retrievedEvent.OverlappingEvents, 1)
+	assert.Equal(t, clashingEvent.ID, retrievedEvent.OverlappingEvents[0].ID)
+}
+
+func TestUpdateEvent_ShouldReturnOverlappingBookedEvents(t *testing.T) {
+	eventService, _, _, _, _, _, _, _ := setupEventService(t)
+
+	eventDate := time.Now().AddDate(0, 0, 7).Truncate(time.Minute)
+	existingEvent, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType:       models.EventTypeInterviewBooked,
+		EventDate:       eventDate,
+		DurationMinutes: testutil.ToPtr(60),
+	})
+	assert.NoError(t, err)
+
+	movedEvent, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType: models.EventTypeCallBooked,
+		EventDate: eventDate.AddDate(0, 0, 1),
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, movedEvent.OverlappingEvents)
+
+	updatedEvent, err := eventService.UpdateEvent(context.Background(), &models.UpdateEvent{
+		ID:        movedEvent.ID,
+		EventDate: testutil.ToPtr(eventDate.Add(30 * time.Minute)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, movedEvent.ID, updatedEvent.ID)
+	assert.Len(t, updatedEvent.OverlappingEvents, 1)
+	assert.Equal(t, existingEvent.ID, updatedEvent.OverlappingEvents[0].ID)
+}
+
+func TestCreateEvent_ShouldNotReturnOverlappingEventsForEventsThatAreNotBooked(t *testing.T) {
+	eventService, _, _, _, _, _, _, _ := setupEventService(t)
+
+	eventDate := time.Now().AddDate(0, 0, 7)
+	_, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType: models.EventTypeInterviewBooked,
+		EventDate: eventDate,
+	})
+	assert.NoError(t, err)
+
+	completedEvent, err := eventService.CreateEvent(context.Background(), &models.CreateEvent{
+		EventType: models.EventTypeInterviewCompleted,
+		EventDate: eventDate,
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, completedEvent.OverlappingEvents)
+}
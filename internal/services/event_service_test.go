@@ -1,9 +1,11 @@
 package services
 
 import (
+	"context"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
 	"testing"
 	"time"
 
@@ -14,9 +16,9 @@ import (
 // -------- CreateEvent tests: --------
 
 func TestCreateEvent_ShouldReturnValidationErrorOnNilEvent(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
-	nilEvent, err := eventService.CreateEvent(nil)
+	nilEvent, err := eventService.CreateEvent(context.Background(), nil)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -26,13 +28,13 @@ func TestCreateEvent_ShouldReturnValidationErrorOnNilEvent(t *testing.T) {
 }
 
 func TestCreateEvent_ShouldReturnValidationErrorOnEmptyEventType(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
 	event := models.CreateEvent{
 		EventType: "",
 		EventDate: time.Now(),
 	}
-	nilEvent, err := eventService.CreateEvent(&event)
+	nilEvent, err := eventService.CreateEvent(context.Background(), &event)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -42,13 +44,13 @@ func TestCreateEvent_ShouldReturnValidationErrorOnEmptyEventType(t *testing.T) {
 }
 
 func TestCreateEvent_ShouldReturnValidationErrorOnUnsetEventDate(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
 	event := models.CreateEvent{
 		EventType: models.EventTypeApplied,
 		EventDate: time.Time{},
 	}
-	nilEvent, err := eventService.CreateEvent(&event)
+	nilEvent, err := eventService.CreateEvent(context.Background(), &event)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -64,9 +66,9 @@ func TestCreateEvent_ShouldReturnValidationErrorOnUnsetEventDate(t *testing.T) {
 // -------- GetEventByID tests: --------
 
 func TestGetEventByID_ShouldReturnValidationErrorIfEventIDIsNil(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
-	nilEvent, err := eventService.GetEventByID(nil)
+	nilEvent, err := eventService.GetEventByID(context.Background(), nil)
 	assert.Nil(t, nilEvent)
 	assert.Error(t, err)
 
@@ -78,9 +80,10 @@ func TestGetEventByID_ShouldReturnValidationErrorIfEventIDIsNil(t *testing.T) {
 // -------- UpdateEvent tests: --------
 
 func TestUpdateEvent_ShouldReturnValidationErrorIfUpdateEventIsNil(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
-	err := eventService.UpdateEvent(nil)
+	event, err := eventService.UpdateEvent(context.Background(), nil)
+	assert.Nil(t, event)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -89,12 +92,14 @@ func TestUpdateEvent_ShouldReturnValidationErrorIfUpdateEventIsNil(t *testing.T)
 }
 
 func TestUpdateEvent_ShouldReturnValidationErrorIfNoEventFieldsToUpdate(t *testing.T) {
-	eventService := NewEventService(nil)
+	// The check for fields to update is done by the repository
+	eventService := NewEventService(repositories.NewEventRepository(nil, 0), nil)
 
 	eventToUpdate := &models.UpdateEvent{
 		ID: uuid.New(),
 	}
-	err := eventService.UpdateEvent(eventToUpdate)
+	event, err := eventService.UpdateEvent(context.Background(), eventToUpdate)
+	assert.Nil(t, event)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -105,9 +110,9 @@ func TestUpdateEvent_ShouldReturnValidationErrorIfNoEventFieldsToUpdate(t *testi
 // -------- DeleteEvent tests: --------
 
 func TestDeleteEvent_ShouldReturnValidationErrorIfEventIDIsNil(t *testing.T) {
-	eventService := NewEventService(nil)
+	eventService := NewEventService(nil, nil)
 
-	err := eventService.DeleteEvent(nil)
+	err := eventService.DeleteEvent(context.Background(), nil, nil)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// EventTransitionValidator checks the events of an application against EventTransitionRules. In strict mode a broken
+// rule is returned as a ValidationError, and in warn mode it is only logged.
+type EventTransitionValidator struct {
+	eventRepository            EventRepository
+	applicationEventRepository ApplicationEventRepository
+	rules                      *models.EventTransitionRules
+	mode                       models.EventTransitionMode
+}
+
+func NewEventTransitionValidator(
+	eventRepository EventRepository,
+	applicationEventRepository ApplicationEventRepository,
+	rules *models.EventTransitionRules,
+	mode models.EventTransitionMode) *EventTransitionValidator {
+
+	return &EventTransitionValidator{
+		eventRepository:            eventRepository,
+		applicationEventRepository: applicationEventRepository,
+		rules:                      rules,
+		mode:                       mode,
+	}
+}
+
+// withRepositories returns a validator with the same rules and mode that reads through the given repositories, such
+// as those of a transaction.
+func (validator *EventTransitionValidator) withRepositories(
+	eventRepository EventRepository,
+	applicationEventRepository ApplicationEventRepository) *EventTransitionValidator {
+
+	return NewEventTransitionValidator(eventRepository, applicationEventRepository, validator.rules, validator.mode)
+}
+
+// ValidateAssociation checks the event with eventID against the other events of the application with applicationID.
+// An event that does not exist is not checked, so that associating it reports the missing event instead.
+//
+// ValidateAssociation can return InternalServiceError, ValidationError
+func (validator *EventTransitionValidator) ValidateAssociation(
+	ctx context.Context, applicationID uuid.UUID, eventID uuid.UUID) error {
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	event, err := validator.eventRepository.GetByID(ctx, &eventID)
+	if err != nil {
+		var notFoundErr *internalErrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	// can return InternalServiceError, ValidationError
+	return validator.validate(ctx, applicationID, event)
+}
+
+// ValidateEvent checks event, as it will be once it is updated, against the other events of each of its applications.
+//
+// ValidateEvent can return InternalServiceError, ValidationError
+func (validator *EventTransitionValidator) ValidateEvent(ctx context.Context, event *models.Event) error {
+	// can return InternalServiceError, ValidationError
+	applicationEvents, err := validator.applicationEventRepository.GetByID(ctx, nil, &event.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, applicationEvent := range applicationEvents {
+		// can return InternalServiceError, ValidationError
+		err = validator.validate(ctx, applicationEvent.ApplicationID, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// internal functions
+
+// validate can return InternalServiceError, ValidationError
+func (validator *EventTransitionValidator) validate(
+	ctx context.Context, applicationID uuid.UUID, event *models.Event) error {
+
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, ValidationError
+	applicationEvents, err := validator.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{ApplicationID: &applicationID},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeNone)
+	if err != nil {
+		return err
+	}
+
+	// can return ValidationError
+	err = validator.rules.Check(event, applicationEvents)
+	if err == nil {
+		return nil
+	}
+
+	if validator.mode == models.EventTransitionModeStrict {
+		logger.Info(
+			"EventTransitionValidator.validate: Event breaks the transition rules",
+			"applicationID", applicationID, "eventID", event.ID, "error", err)
+		return err
+	}
+
+	logger.Warn(
+		"EventTransitionValidator.validate: Event breaks the transition rules",
+		"applicationID", applicationID, "eventID", event.ID, "error", err)
+	return nil
+}
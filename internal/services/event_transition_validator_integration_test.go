@@ -0,0 +1,186 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventTransitionTestSetup struct {
+	validator                  *services.EventTransitionValidator
+	eventService               *services.EventService
+	applicationRepository      *repositories.ApplicationRepository
+	companyRepository          *repositories.CompanyRepository
+	eventRepository            *repositories.EventRepository
+	applicationEventRepository *repositories.ApplicationEventRepository
+}
+
+func setupEventTransitionValidator(t *testing.T, mode string) *eventTransitionTestSetup {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		EventTransitionMode:                  mode,
+	}
+
+	container := dependencyinjection.SetupEventServiceTestContainer(t, *config)
+
+	setup := &eventTransitionTestSetup{}
+	err := container.Invoke(func(
+		validator *services.EventTransitionValidator,
+		eventService *services.EventService,
+		applicationRepository *repositories.ApplicationRepository,
+		companyRepository *repositories.CompanyRepository,
+		eventRepository *repositories.EventRepository,
+		applicationEventRepository *repositories.ApplicationEventRepository) {
+
+		setup.validator = validator
+		setup.eventService = eventService
+		setup.applicationRepository = applicationRepository
+		setup.companyRepository = companyRepository
+		setup.eventRepository = eventRepository
+		setup.applicationEventRepository = applicationEventRepository
+	})
+	assert.NoError(t, err)
+
+	return setup
+}
+
+func (setup *eventTransitionTestSetup) createApplication(t *testing.T) *models.Application {
+	companyID := repositoryhelpers.CreateCompany(t, setup.companyRepository, nil, nil).ID
+	return repositoryhelpers.CreateApplication(t, setup.applicationRepository, nil, &companyID, nil, nil)
+}
+
+func (setup *eventTransitionTestSetup) createEvent(
+	t *testing.T, applicationID *uuid.UUID, eventType models.EventType, eventDate time.Time) *models.Event {
+
+	event := repositoryhelpers.CreateEvent(t, setup.eventRepository, nil, &eventType, &eventDate)
+	if applicationID != nil {
+		repositoryhelpers.AssociateApplicationEvent(t, setup.applicationEventRepository, *applicationID, event.ID, nil)
+	}
+	return event
+}
+
+// -------- ValidateAssociation tests: --------
+
+func TestValidateAssociation_ShouldReturnValidationErrorInStrictMode(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeStrict)
+	application := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &application.ID, models.EventTypeRejected, now.AddDate(0, 0, -1))
+	interview := setup.createEvent(t, nil, models.EventTypeInterviewBooked, now)
+
+	err := setup.validator.ValidateAssociation(context.Background(), application.ID, interview.ID)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Contains(t, err.Error(), "the application was closed by a rejected event on")
+	assert.Contains(t, err.Error(), "so no interviewBooked event can follow it")
+}
+
+func TestValidateAssociation_ShouldNotReturnErrorInWarnMode(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeWarn)
+	application := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &application.ID, models.EventTypeRejected, now.AddDate(0, 0, -1))
+	interview := setup.createEvent(t, nil, models.EventTypeInterviewBooked, now)
+
+	err := setup.validator.ValidateAssociation(context.Background(), application.ID, interview.ID)
+	assert.NoError(t, err)
+}
+
+func TestValidateAssociation_ShouldIgnoreEventsOfOtherApplications(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeStrict)
+	application := setup.createApplication(t)
+	otherApplication := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &otherApplication.ID, models.EventTypeRejected, now.AddDate(0, 0, -1))
+	setup.createEvent(t, &application.ID, models.EventTypeInterviewBooked, now.AddDate(0, 0, -1))
+	interview := setup.createEvent(t, nil, models.EventTypeInterviewCompleted, now)
+
+	err := setup.validator.ValidateAssociation(context.Background(), application.ID, interview.ID)
+	assert.NoError(t, err)
+}
+
+func TestValidateAssociation_ShouldNotCheckEventThatDoesNotExist(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeStrict)
+	application := setup.createApplication(t)
+
+	err := setup.validator.ValidateAssociation(context.Background(), application.ID, uuid.New())
+	assert.NoError(t, err)
+}
+
+// -------- ValidateEvent tests: --------
+
+func TestValidateEvent_ShouldCheckEveryApplicationOfTheEvent(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeStrict)
+	application := setup.createApplication(t)
+	otherApplication := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &application.ID, models.EventTypeCallBooked, now.AddDate(0, 0, -1))
+	call := setup.createEvent(t, &application.ID, models.EventTypeCallBooked, now)
+	repositoryhelpers.AssociateApplicationEvent(t, setup.applicationEventRepository, otherApplication.ID, call.ID, nil)
+
+	callCompleted := models.EventType(models.EventTypeCallCompleted)
+	call.EventType = &callCompleted
+
+	err := setup.validator.ValidateEvent(context.Background(), call)
+	assert.Equal(
+		t, "validation error on field 'eventType': callCompleted requires an earlier callBooked event", err.Error())
+}
+
+// -------- EventService.UpdateEvent tests: --------
+
+func TestUpdateEvent_ShouldRejectUpdateThatBreaksTransitionRulesInStrictMode(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeStrict)
+	application := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &application.ID, models.EventTypeOffer, now.AddDate(0, 0, -2))
+	signed := setup.createEvent(t, &application.ID, models.EventTypeSigned, now.AddDate(0, 0, -1))
+
+	// moving the signing before the offer leaves it without a preceding offer
+	earlierDate := now.AddDate(0, 0, -3)
+	_, err := setup.eventService.UpdateEvent(
+		context.Background(), &models.UpdateEvent{ID: signed.ID, EventDate: &earlierDate})
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'eventType': signed requires an earlier offer event", err.Error())
+
+	retrievedEvent, err := setup.eventRepository.GetByID(context.Background(), &signed.ID)
+	assert.NoError(t, err)
+	assert.True(t, retrievedEvent.EventDate.Equal(*signed.EventDate), "the event should not have been updated")
+}
+
+func TestUpdateEvent_ShouldApplyUpdateThatBreaksTransitionRulesInWarnMode(t *testing.T) {
+	setup := setupEventTransitionValidator(t, models.EventTransitionModeWarn)
+	application := setup.createApplication(t)
+
+	now := time.Now()
+	setup.createEvent(t, &application.ID, models.EventTypeRejected, now.AddDate(0, 0, -2))
+	event := setup.createEvent(t, &application.ID, models.EventTypeOther, now.AddDate(0, 0, -1))
+
+	eventType := models.EventType(models.EventTypeOffer)
+	_, err := setup.eventService.UpdateEvent(
+		context.Background(), &models.UpdateEvent{ID: event.ID, EventType: &eventType})
+	assert.NoError(t, err)
+
+	retrievedEvent, err := setup.eventRepository.GetByID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, eventType, *retrievedEvent.EventType)
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+)
+
+type HealthService struct {
+	database         *sql.DB
+	migrationService *MigrationService
+}
+
+func NewHealthService(database *sql.DB, migrationService *MigrationService) *HealthService {
+	return &HealthService{database: database, migrationService: migrationService}
+}
+
+// CheckReadiness pings the database and reads the migration version. Failures are reported in the result rather
+// than returned, so that every check is always run.
+func (healthService *HealthService) CheckReadiness(ctx context.Context) *models.Readiness {
+	var readiness models.Readiness
+
+	err := healthService.database.PingContext(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Warn("HealthService.CheckReadiness: Database ping failed", "error", err)
+		message := err.Error()
+		readiness.DatabaseError = &message
+		return &readiness
+	}
+	readiness.IsDatabaseReachable = true
+
+	// can return InternalServiceError
+	status, err := healthService.migrationService.GetReadinessStatus(ctx)
+	if err != nil {
+		message := err.Error()
+		readiness.MigrationError = &message
+		return &readiness
+	}
+	readiness.Migration = status
+
+	return &readiness
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// IdempotencyService lets a client retry a request with the same Idempotency-Key without it being handled twice. The
+// first request with a key reserves it, and its response is saved once it has been handled. A retry with the key
+// gets the saved response, until the key expires. A key that has not got a response within lease, because the
+// request that reserved it never finished, can be reserved again.
+type IdempotencyService struct {
+	idempotencyKeyRepository IdempotencyKeyRepository
+	ttl                      time.Duration
+	lease                    time.Duration
+}
+
+func NewIdempotencyService(
+	idempotencyKeyRepository IdempotencyKeyRepository, ttl time.Duration, lease time.Duration) *IdempotencyService {
+
+	return &IdempotencyService{idempotencyKeyRepository: idempotencyKeyRepository, ttl: ttl, lease: lease}
+}
+
+// Begin is called before a request with an Idempotency-Key is handled. If the key has not been used for path yet, it
+// is reserved and Begin returns it with isReplay false, and the request must be handled and passed to SaveResponse.
+// If a request with the key has already been handled, Begin returns the key with its saved response and isReplay
+// true. A retry must have the same method, query and body as the first request, or Begin returns a ConflictError.
+//
+// Begin can return ConflictError, InternalServiceError, ValidationError
+func (idempotencyService *IdempotencyService) Begin(
+	ctx context.Context,
+	key string,
+	method string,
+	path string,
+	rawQuery string,
+	requestBody []byte) (*models.IdempotencyKey, bool, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	if err := validateIdempotencyKey(key); err != nil {
+		logger.Info("idempotency_service.Begin: Idempotency-Key is invalid", "error", err)
+		return nil, false, err
+	}
+
+	now := time.Now()
+	idempotencyKey := models.IdempotencyKey{
+		Key:         key,
+		Path:        path,
+		RequestHash: hashRequest(method, path, rawQuery, requestBody),
+		CreatedDate: now,
+		ExpiryDate:  now.Add(idempotencyService.ttl),
+	}
+
+	// Expired keys are only kept until the next request with a key.
+	if _, err := idempotencyService.idempotencyKeyRepository.DeleteExpired(ctx, now); err != nil {
+		logger.Warn("idempotency_service.Begin: Unable to delete expired idempotency keys", "error", err)
+	}
+
+	// can return InternalServiceError
+	isReserved, err := idempotencyService.idempotencyKeyRepository.Reserve(
+		ctx, &idempotencyKey, now.Add(-idempotencyService.lease))
+	if err != nil {
+		return nil, false, err
+	}
+	if isReserved {
+		return &idempotencyKey, false, nil
+	}
+
+	// can return InternalServiceError, NotFoundError
+	existingKey, err := idempotencyService.idempotencyKeyRepository.GetByKeyAndPath(ctx, key, path)
+	if err != nil {
+		var notFoundErr *internalErrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			// The request that held the key failed and released it between Reserve and GetByKeyAndPath.
+			return nil, false, internalErrors.NewConflictError(
+				"a request with this Idempotency-Key is being handled. Retry it later")
+		}
+		return nil, false, err
+	}
+
+	if existingKey.RequestHash != idempotencyKey.RequestHash {
+		logger.Info("idempotency_service.Begin: Idempotency-Key was used for a different request", "path", path)
+		return nil, false, internalErrors.NewConflictError(
+			"Idempotency-Key has already been used for a different request to " + path)
+	}
+
+	if existingKey.StatusCode == nil {
+		logger.Info("idempotency_service.Begin: Request with Idempotency-Key is still being handled", "path", path)
+		return nil, false, internalErrors.NewConflictError(
+			"a request with this Idempotency-Key is being handled. Retry it later")
+	}
+
+	logger.Info(
+		"idempotency_service.Begin: Replaying response", "path", path, "statusCode", *existingKey.StatusCode)
+	return existingKey, true, nil
+}
+
+// SaveResponse stores the response to a request that was reserved by Begin, so that it is replayed to retries. A
+// server error is not stored, and the key is released instead, so that the request can be retried.
+//
+// SaveResponse can return InternalServiceError, NotFoundError, ValidationError
+func (idempotencyService *IdempotencyService) SaveResponse(
+	ctx context.Context, idempotencyKey *models.IdempotencyKey) error {
+
+	logger := logging.FromContext(ctx)
+
+	if idempotencyKey.StatusCode == nil {
+		logger.Info("idempotency_service.SaveResponse: StatusCode is nil")
+		statusCodeField := "StatusCode"
+		return internalErrors.NewValidationError(&statusCodeField, "StatusCode is required")
+	}
+
+	if *idempotencyKey.StatusCode >= http.StatusInternalServerError {
+		logger.Info(
+			"idempotency_service.SaveResponse: Releasing Idempotency-Key after server error",
+			"path", idempotencyKey.Path,
+			"statusCode", *idempotencyKey.StatusCode)
+		// can return InternalServiceError, NotFoundError
+		return idempotencyService.idempotencyKeyRepository.Delete(ctx, idempotencyKey.Key, idempotencyKey.Path)
+	}
+
+	// can return InternalServiceError, NotFoundError
+	return idempotencyService.idempotencyKeyRepository.SaveResponse(ctx, idempotencyKey)
+}
+
+// Release deletes a key that was reserved by Begin without saving a response, so that the request can be retried.
+//
+// Release can return InternalServiceError, NotFoundError
+func (idempotencyService *IdempotencyService) Release(
+	ctx context.Context, idempotencyKey *models.IdempotencyKey) error {
+
+	// can return InternalServiceError, NotFoundError
+	return idempotencyService.idempotencyKeyRepository.Delete(ctx, idempotencyKey.Key, idempotencyKey.Path)
+}
+
+// validateIdempotencyKey accepts non-empty keys of printable ASCII characters, so that a key cannot inject arbitrary
+// content into logs.
+//
+// validateIdempotencyKey can return ValidationError
+func validateIdempotencyKey(key string) error {
+	idempotencyKeyField := "Idempotency-Key"
+
+	if key == "" {
+		return internalErrors.NewValidationError(&idempotencyKeyField, "Idempotency-Key cannot be empty")
+	}
+
+	if len(key) > models.MaxIdempotencyKeyLength {
+		return internalErrors.NewValidationError(
+			&idempotencyKeyField,
+			"Idempotency-Key cannot be longer than "+strconv.Itoa(models.MaxIdempotencyKeyLength)+" characters")
+	}
+
+	for index := 0; index < len(key); index++ {
+		if key[index] < ' ' || key[index] > '~' {
+			return internalErrors.NewValidationError(
+				&idempotencyKeyField, "Idempotency-Key can only contain printable ASCII characters")
+		}
+	}
+
+	return nil
+}
+
+// hashRequest returns the hex-encoded SHA-256 of everything that tells two requests to the same path apart. The
+// parts are separated by newlines, which cannot occur in a method, path or query.
+func hashRequest(method string, path string, rawQuery string, requestBody []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + "\n" + path + "\n" + rawQuery + "\n"))
+	hash.Write(requestBody)
+	return hex.EncodeToString(hash.Sum(nil))
+}
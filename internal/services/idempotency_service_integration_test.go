@@ -0,0 +1,150 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupIdempotencyService(t *testing.T) *services.IdempotencyService {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		IdempotencyKeyTTLHours:               1,
+		IdempotencyKeyLeaseSeconds:           60,
+	}
+
+	container := dependencyinjection.SetupIdempotencyServiceTestContainer(t, *config)
+
+	var idempotencyService *services.IdempotencyService
+	err := container.Invoke(func(service *services.IdempotencyService) {
+		idempotencyService = service
+	})
+	assert.NoError(t, err)
+
+	return idempotencyService
+}
+
+// -------- Begin tests: --------
+
+func TestBegin_ShouldReplaySavedResponse(t *testing.T) {
+	idempotencyService := setupIdempotencyService(t)
+
+	idempotencyKey, isReplay, err := idempotencyService.Begin(
+		context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(`{"name":"Company"}`))
+	assert.NoError(t, err)
+	assert.False(t, isReplay)
+	assert.NotEmpty(t, idempotencyKey.RequestHash)
+
+	idempotencyKey.StatusCode = testutil.ToPtr(http.StatusCreated)
+	idempotencyKey.ResponseBody = []byte(`{"id":"1"}`)
+	assert.NoError(t, idempotencyService.SaveResponse(context.Background(), idempotencyKey))
+
+	replayedKey, isReplay, err := idempotencyService.Begin(
+		context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(`{"name":"Company"}`))
+	assert.NoError(t, err)
+	assert.True(t, isReplay)
+	assert.Equal(t, http.StatusCreated, *replayedKey.StatusCode)
+	assert.Equal(t, []byte(`{"id":"1"}`), replayedKey.ResponseBody)
+}
+
+func TestBegin_ShouldReturnConflictError(t *testing.T) {
+	idempotencyService := setupIdempotencyService(t)
+
+	_, _, err := idempotencyService.Begin(
+		context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(`{"name":"Company"}`))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		testName      string
+		requestBody   string
+		expectedError string
+	}{
+		{
+			"request is still being handled",
+			`{"name":"Company"}`,
+			"conflict error on insert: a request with this Idempotency-Key is being handled. Retry it later",
+		},
+		{
+			"key was used for a different request",
+			`{"name":"Other company"}`,
+			"conflict error on insert: Idempotency-Key has already been used for a different request to " +
+				"/api/v1/company/new",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			idempotencyKey, isReplay, err := idempotencyService.Begin(
+				context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(test.requestBody))
+			assert.Nil(t, idempotencyKey)
+			assert.False(t, isReplay)
+
+			var conflictErr *internalErrors.ConflictError
+			assert.True(t, errors.As(err, &conflictErr))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestBegin_ShouldReturnValidationErrorForInvalidKey(t *testing.T) {
+	idempotencyService := setupIdempotencyService(t)
+
+	tests := []struct {
+		testName      string
+		key           string
+		expectedError string
+	}{
+		{"empty", "", "validation error on field 'Idempotency-Key': Idempotency-Key cannot be empty"},
+		{
+			"too long",
+			strings.Repeat("a", 256),
+			"validation error on field 'Idempotency-Key': Idempotency-Key cannot be longer than 255 characters",
+		},
+		{
+			"contains a newline",
+			"abc\ndef",
+			"validation error on field 'Idempotency-Key': Idempotency-Key can only contain printable ASCII " +
+				"characters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			_, _, err := idempotencyService.Begin(
+				context.Background(), test.key, http.MethodPost, "/api/v1/company/new", "", nil)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+// -------- SaveResponse tests: --------
+
+func TestSaveResponse_ShouldReleaseKeyAfterServerError(t *testing.T) {
+	idempotencyService := setupIdempotencyService(t)
+
+	idempotencyKey, _, err := idempotencyService.Begin(
+		context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(`{"name":"Company"}`))
+	assert.NoError(t, err)
+
+	idempotencyKey.StatusCode = testutil.ToPtr(http.StatusInternalServerError)
+	assert.NoError(t, idempotencyService.SaveResponse(context.Background(), idempotencyKey))
+
+	// the retry is handled again instead of being answered with the error
+	_, isReplay, err := idempotencyService.Begin(
+		context.Background(), "key-1", http.MethodPost, "/api/v1/company/new", "", []byte(`{"name":"Company"}`))
+	assert.NoError(t, err)
+	assert.False(t, isReplay)
+}
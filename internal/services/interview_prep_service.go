@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// InterviewPrepService keeps structured preparation notes and the questions of interviews and code tests.
+type InterviewPrepService struct {
+	eventRepository             EventRepository
+	interviewPrepRepository     InterviewPrepRepository
+	interviewQuestionRepository InterviewQuestionRepository
+}
+
+func NewInterviewPrepService(
+	eventRepository EventRepository,
+	interviewPrepRepository InterviewPrepRepository,
+	interviewQuestionRepository InterviewQuestionRepository) *InterviewPrepService {
+
+	return &InterviewPrepService{
+		eventRepository:             eventRepository,
+		interviewPrepRepository:     interviewPrepRepository,
+		interviewQuestionRepository: interviewQuestionRepository,
+	}
+}
+
+// CreateInterviewPrep only accepts events of type interviewCompleted or codeTestCompleted.
+//
+// CreateInterviewPrep can return ConflictError, InternalServiceError, ValidationError
+func (interviewPrepService *InterviewPrepService) CreateInterviewPrep(
+	ctx context.Context, prep *models.CreateInterviewPrep) (*models.InterviewPrep, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if prep == nil {
+		logger.Error("interview_prep_service.CreateInterviewPrep: CreateInterviewPrep is nil")
+		return nil, internalErrors.NewValidationError(nil, "CreateInterviewPrep is nil")
+	}
+
+	// can return ValidationError
+	err := prep.Validate()
+	if err != nil {
+		logger.Info("interview_prep_service.CreateInterviewPrep: Interview prep to create is invalid", "error", err)
+		return nil, err
+	}
+
+	// can return InternalServiceError, ValidationError
+	err = interviewPrepService.checkEventHasInterviewPrep(ctx, &prep.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	insertedPrep, err := interviewPrepService.interviewPrepRepository.Create(ctx, prep)
+	if err != nil {
+		return nil, err
+	}
+	insertedPrep.Questions = []*models.InterviewQuestion{}
+
+	logger.Info("interview_prep_service.CreateInterviewPrep: Inserted interview prep", "eventID", insertedPrep.EventID)
+	return insertedPrep, nil
+}
+
+// GetInterviewPrepByEventID returns the prep together with its questions, oldest first.
+//
+// GetInterviewPrepByEventID can return InternalServiceError, NotFoundError, ValidationError
+func (interviewPrepService *InterviewPrepService) GetInterviewPrepByEventID(
+	ctx context.Context, eventID *uuid.UUID) (*models.InterviewPrep, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if eventID == nil {
+		logger.Error("interview_prep_service.GetInterviewPrepByEventID: eventID is nil")
+		return nil, internalErrors.NewValidationError(nil, "eventID is nil")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	prep, err := interviewPrepService.interviewPrepRepository.GetByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return InternalServiceError, ValidationError
+	prep.Questions, err = interviewPrepService.interviewQuestionRepository.GetAllByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return prep, nil
+}
+
+// UpdateInterviewPrep can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (interviewPrepService *InterviewPrepService) UpdateInterviewPrep(
+	ctx context.Context, prep *models.UpdateInterviewPrep) error {
+
+	logger := logging.FromContext(ctx)
+
+	if prep == nil {
+		logger.Error("interview_prep_service.UpdateInterviewPrep: UpdateInterviewPrep is nil")
+		return internalErrors.NewValidationError(nil, "UpdateInterviewPrep is nil")
+	}
+
+	// can return ValidationError
+	err := prep.Validate()
+	if err != nil {
+		logger.Info("interview_prep_service.UpdateInterviewPrep: UpdateInterviewPrep is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	return interviewPrepService.interviewPrepRepository.Update(ctx, prep)
+}
+
+// DeleteInterviewPrep also deletes the questions of the prep. If expectedVersion is set, the prep is only deleted if it
+// has that version.
+//
+// DeleteInterviewPrep can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (interviewPrepService *InterviewPrepService) DeleteInterviewPrep(
+	ctx context.Context, eventID *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+
+	if eventID == nil {
+		logger.Error("interview_prep_service.DeleteInterviewPrep: eventID is nil")
+		return internalErrors.NewValidationError(nil, "eventID is nil")
+	}
+
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	return interviewPrepService.interviewPrepRepository.Delete(ctx, eventID, expectedVersion)
+}
+
+// CreateInterviewQuestion requires an interview prep for the event of the question.
+//
+// CreateInterviewQuestion can return ConflictError, InternalServiceError, ValidationError
+func (interviewPrepService *InterviewPrepService) CreateInterviewQuestion(
+	ctx context.Context, question *models.CreateInterviewQuestion) (*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if question == nil {
+		logger.Error("interview_prep_service.CreateInterviewQuestion: CreateInterviewQuestion is nil")
+		return nil, internalErrors.NewValidationError(nil, "CreateInterviewQuestion is nil")
+	}
+
+	// can return ValidationError
+	err := question.Validate()
+	if err != nil {
+		logger.Info("interview_prep_service.CreateInterviewQuestion: Question to create is invalid", "error", err)
+		return nil, err
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	insertedQuestion, err := interviewPrepService.interviewQuestionRepository.Create(ctx, question)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"interview_prep_service.CreateInterviewQuestion: Inserted interview question", "ID", insertedQuestion.ID)
+	return insertedQuestion, nil
+}
+
+// UpdateInterviewQuestion can return InternalServiceError, NotFoundError, ValidationError
+func (interviewPrepService *InterviewPrepService) UpdateInterviewQuestion(
+	ctx context.Context, question *models.UpdateInterviewQuestion) error {
+
+	logger := logging.FromContext(ctx)
+
+	if question == nil {
+		logger.Error("interview_prep_service.UpdateInterviewQuestion: UpdateInterviewQuestion is nil")
+		return internalErrors.NewValidationError(nil, "UpdateInterviewQuestion is nil")
+	}
+
+	// can return ValidationError
+	err := question.Validate()
+	if err != nil {
+		logger.Info(
+			"interview_prep_service.UpdateInterviewQuestion: UpdateInterviewQuestion is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return interviewPrepService.interviewQuestionRepository.Update(ctx, question)
+}
+
+// DeleteInterviewQuestion can return InternalServiceError, NotFoundError, ValidationError
+func (interviewPrepService *InterviewPrepService) DeleteInterviewQuestion(ctx context.Context, id *uuid.UUID) error {
+	logger := logging.FromContext(ctx)
+
+	if id == nil {
+		logger.Error("interview_prep_service.DeleteInterviewQuestion: ID is nil")
+		return internalErrors.NewValidationError(nil, "ID is nil")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return interviewPrepService.interviewQuestionRepository.Delete(ctx, id)
+}
+
+// GetInterviewQuestions returns every question asked in the interviews and code tests of either an application or a
+// company, newest event first. Exactly one of applicationID and companyID must be given.
+//
+// GetInterviewQuestions can return InternalServiceError, ValidationError
+func (interviewPrepService *InterviewPrepService) GetInterviewQuestions(
+	ctx context.Context, applicationID *uuid.UUID, companyID *uuid.UUID) ([]*models.InterviewQuestion, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if (applicationID == nil) == (companyID == nil) {
+		logger.Info("interview_prep_service.GetInterviewQuestions: Not exactly one of applicationID and companyID")
+		return nil, internalErrors.NewValidationError(nil, "exactly one of applicationID and companyID is required")
+	}
+
+	if applicationID != nil {
+		// can return InternalServiceError, ValidationError
+		return interviewPrepService.interviewQuestionRepository.GetAllByApplicationID(ctx, applicationID)
+	}
+
+	// can return InternalServiceError, ValidationError
+	return interviewPrepService.interviewQuestionRepository.GetAllByCompanyID(ctx, companyID)
+}
+
+// internal functions
+
+// checkEventHasInterviewPrep can return InternalServiceError, ValidationError
+func (interviewPrepService *InterviewPrepService) checkEventHasInterviewPrep(
+	ctx context.Context, eventID *uuid.UUID) error {
+
+	logger := logging.FromContext(ctx)
+	eventIDField := "EventID"
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	event, err := interviewPrepService.eventRepository.GetByID(ctx, eventID)
+	if err != nil {
+		var notFoundErr *internalErrors.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			logger.Info("interview_prep_service.checkEventHasInterviewPrep: Event does not exist", "eventID", eventID)
+			return internalErrors.NewValidationError(&eventIDField, "Event does not exist: '"+eventID.String()+"'")
+		}
+		return err
+	}
+
+	if event.EventType == nil || !event.EventType.HasInterviewPrep() {
+		logger.Info(
+			"interview_prep_service.checkEventHasInterviewPrep: Event type does not have interview prep",
+			"eventID", eventID)
+		return internalErrors.NewValidationError(
+			&eventIDField, "interview prep can only be added to interviewCompleted and codeTestCompleted events")
+	}
+
+	return nil
+}
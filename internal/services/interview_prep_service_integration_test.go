@@ -0,0 +1,179 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupInterviewPrepService(t *testing.T) (
+	*services.InterviewPrepService,
+	*repositories.EventRepository,
+	*repositories.ApplicationRepository,
+	*repositories.ApplicationEventRepository,
+	*repositories.CompanyRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupInterviewPrepServiceTestContainer(t, *config)
+
+	var interviewPrepService *services.InterviewPrepService
+	var eventRepository *repositories.EventRepository
+	var applicationRepository *repositories.ApplicationRepository
+	var applicationEventRepository *repositories.ApplicationEventRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		service *services.InterviewPrepService,
+		event *repositories.EventRepository,
+		application *repositories.ApplicationRepository,
+		applicationEvent *repositories.ApplicationEventRepository,
+		company *repositories.CompanyRepository) {
+
+		interviewPrepService = service
+		eventRepository = event
+		applicationRepository = application
+		applicationEventRepository = applicationEvent
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	return interviewPrepService, eventRepository, applicationRepository, applicationEventRepository, companyRepository
+}
+
+// -------- CreateInterviewPrep tests: --------
+
+func TestCreateInterviewPrep_ShouldCreatePrepForCodeTest(t *testing.T) {
+	interviewPrepService, eventRepository, _, _, _ := setupInterviewPrepService(t)
+
+	var eventType models.EventType = models.EventTypeCodeTestCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	prep, err := interviewPrepService.CreateInterviewPrep(context.Background(), &models.CreateInterviewPrep{
+		EventID:         event.ID,
+		TechnicalTopics: testutil.ToPtr("Concurrency"),
+		SelfRating:      testutil.ToPtr(3),
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, prep)
+
+	assert.Equal(t, event.ID, prep.EventID)
+	assert.Equal(t, "Concurrency", *prep.TechnicalTopics)
+	assert.Equal(t, 3, *prep.SelfRating)
+	assert.NotNil(t, prep.Questions)
+	assert.Empty(t, prep.Questions)
+}
+
+func TestCreateInterviewPrep_ShouldReturnValidationErrorForOtherEventTypes(t *testing.T) {
+	interviewPrepService, eventRepository, _, _, _ := setupInterviewPrepService(t)
+
+	var eventType models.EventType = models.EventTypeApplied
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	prep, err := interviewPrepService.CreateInterviewPrep(
+		context.Background(), &models.CreateInterviewPrep{EventID: event.ID})
+	assert.Nil(t, prep)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'EventID': interview prep can only be added to interviewCompleted and "+
+			"codeTestCompleted events",
+		err.Error())
+}
+
+func TestCreateInterviewPrep_ShouldReturnValidationErrorIfEventDoesNotExist(t *testing.T) {
+	interviewPrepService, _, _, _, _ := setupInterviewPrepService(t)
+
+	eventID := uuid.New()
+	prep, err := interviewPrepService.CreateInterviewPrep(
+		context.Background(), &models.CreateInterviewPrep{EventID: eventID})
+	assert.Nil(t, prep)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t, "validation error on field 'EventID': Event does not exist: '"+eventID.String()+"'", err.Error())
+}
+
+// -------- GetInterviewPrepByEventID tests: --------
+
+func TestGetInterviewPrepByEventID_ShouldReturnPrepWithQuestions(t *testing.T) {
+	interviewPrepService, eventRepository, _, _, _ := setupInterviewPrepService(t)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+
+	_, err := interviewPrepService.CreateInterviewPrep(
+		context.Background(), &models.CreateInterviewPrep{EventID: event.ID})
+	assert.NoError(t, err)
+
+	question, err := interviewPrepService.CreateInterviewQuestion(
+		context.Background(),
+		&models.CreateInterviewQuestion{
+			EventID:      event.ID,
+			QuestionType: models.InterviewQuestionTypeAskedToUs,
+			Question:     "Explain a goroutine leak",
+			Topic:        testutil.ToPtr("Go"),
+		})
+	assert.NoError(t, err)
+
+	prep, err := interviewPrepService.GetInterviewPrepByEventID(context.Background(), &event.ID)
+	assert.NoError(t, err)
+	assert.Len(t, prep.Questions, 1)
+	assert.Equal(t, question.ID, prep.Questions[0].ID)
+	assert.Equal(t, "Go", *prep.Questions[0].Topic)
+}
+
+// -------- GetInterviewQuestions tests: --------
+
+func TestGetInterviewQuestions_ShouldReturnQuestionsOfApplicationAndCompany(t *testing.T) {
+	interviewPrepService, eventRepository, applicationRepository, applicationEventRepository, companyRepository :=
+		setupInterviewPrepService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil)
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &company.ID, nil, nil)
+
+	var eventType models.EventType = models.EventTypeInterviewCompleted
+	event := repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, testutil.ToPtr(time.Now()))
+	repositoryhelpers.AssociateApplicationEvent(t, applicationEventRepository, application.ID, event.ID, nil)
+
+	_, err := interviewPrepService.CreateInterviewPrep(
+		context.Background(), &models.CreateInterviewPrep{EventID: event.ID})
+	assert.NoError(t, err)
+
+	question, err := interviewPrepService.CreateInterviewQuestion(
+		context.Background(),
+		&models.CreateInterviewQuestion{
+			EventID:      event.ID,
+			QuestionType: models.InterviewQuestionTypeAskedByUs,
+			Question:     "How is on-call organised?",
+		})
+	assert.NoError(t, err)
+
+	applicationQuestions, err := interviewPrepService.GetInterviewQuestions(
+		context.Background(), &application.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, applicationQuestions, 1)
+	assert.Equal(t, question.ID, applicationQuestions[0].ID)
+
+	companyQuestions, err := interviewPrepService.GetInterviewQuestions(context.Background(), nil, &company.ID)
+	assert.NoError(t, err)
+	assert.Len(t, companyQuestions, 1)
+	assert.Equal(t, question.ID, companyQuestions[0].ID)
+}
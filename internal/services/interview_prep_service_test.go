@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- CreateInterviewPrep tests: --------
+
+func TestCreateInterviewPrep_ShouldReturnValidationErrorOnNilPrep(t *testing.T) {
+	interviewPrepService := NewInterviewPrepService(nil, nil, nil)
+
+	prep, err := interviewPrepService.CreateInterviewPrep(context.Background(), nil)
+	assert.Nil(t, prep)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: CreateInterviewPrep is nil", err.Error())
+}
+
+// -------- GetInterviewPrepByEventID tests: --------
+
+func TestGetInterviewPrepByEventID_ShouldReturnValidationErrorOnNilEventID(t *testing.T) {
+	interviewPrepService := NewInterviewPrepService(nil, nil, nil)
+
+	prep, err := interviewPrepService.GetInterviewPrepByEventID(context.Background(), nil)
+	assert.Nil(t, prep)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: eventID is nil", err.Error())
+}
+
+// -------- CreateInterviewQuestion tests: --------
+
+func TestCreateInterviewQuestion_ShouldReturnValidationErrorOnNilQuestion(t *testing.T) {
+	interviewPrepService := NewInterviewPrepService(nil, nil, nil)
+
+	question, err := interviewPrepService.CreateInterviewQuestion(context.Background(), nil)
+	assert.Nil(t, question)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: CreateInterviewQuestion is nil", err.Error())
+}
+
+// -------- GetInterviewQuestions tests: --------
+
+func TestGetInterviewQuestions_ShouldRequireExactlyOneID(t *testing.T) {
+	interviewPrepService := NewInterviewPrepService(nil, nil, nil)
+
+	applicationID := uuid.New()
+	companyID := uuid.New()
+
+	tests := []struct {
+		testName      string
+		applicationID *uuid.UUID
+		companyID     *uuid.UUID
+	}{
+		{"neither", nil, nil},
+		{"both", &applicationID, &companyID},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			questions, err := interviewPrepService.GetInterviewQuestions(
+				context.Background(), test.applicationID, test.companyID)
+			assert.Nil(t, questions)
+
+			var validationErr *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, "validation error: exactly one of applicationID and companyID is required", err.Error())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/jobad"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobAdSnapshotService reads saved job ads and keeps a copy of them for an application.
+type JobAdSnapshotService struct {
+	jobAdSnapshotRepository JobAdSnapshotRepository
+}
+
+func NewJobAdSnapshotService(jobAdSnapshotRepository JobAdSnapshotRepository) *JobAdSnapshotService {
+	return &JobAdSnapshotService{jobAdSnapshotRepository: jobAdSnapshotRepository}
+}
+
+// ParseJobAd reads a saved job ad without storing it. The job ad URL is normalized the same way as the job ad URL of
+// an application.
+//
+// ParseJobAd can return ValidationError
+func (jobAdSnapshotService *JobAdSnapshotService) ParseJobAd(
+	ctx context.Context, content []byte) (*models.JobAdPosting, error) {
+
+	logger := logging.FromContext(ctx)
+
+	// can return ValidationError
+	posting, sourceType, err := jobad.Parse(content)
+	if err != nil {
+		logger.Info("job_ad_snapshot_service.ParseJobAd: Unable to parse job ad", "error", err)
+		return nil, err
+	}
+
+	posting.JobAdURL = normalizeJobAdURL(posting.JobAdURL)
+
+	logger.Info("job_ad_snapshot_service.ParseJobAd: Parsed job ad", "sourceType", sourceType.String())
+	return posting, nil
+}
+
+// CreateJobAdSnapshot parses a saved job ad and stores it, together with what was read from it, for an application.
+//
+// CreateJobAdSnapshot can return ConflictError, InternalServiceError, ValidationError
+func (jobAdSnapshotService *JobAdSnapshotService) CreateJobAdSnapshot(
+	ctx context.Context, applicationID *uuid.UUID, content []byte) (*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if applicationID == nil {
+		logger.Error("job_ad_snapshot_service.CreateJobAdSnapshot: applicationID is nil")
+		return nil, internalErrors.NewValidationError(nil, "applicationID is nil")
+	}
+
+	// can return ValidationError
+	posting, sourceType, err := jobad.Parse(content)
+	if err != nil {
+		logger.Info("job_ad_snapshot_service.CreateJobAdSnapshot: Unable to parse job ad", "error", err)
+		return nil, err
+	}
+
+	posting.JobAdURL = normalizeJobAdURL(posting.JobAdURL)
+
+	createdDate := time.Now()
+	snapshot := models.CreateJobAdSnapshot{
+		ApplicationID: *applicationID,
+		SourceType:    sourceType,
+		Content:       string(content),
+		JobAdPosting:  *posting,
+		CreatedDate:   &createdDate,
+	}
+
+	err = snapshot.Validate()
+	if err != nil {
+		logger.Info("job_ad_snapshot_service.CreateJobAdSnapshot: Snapshot to create is invalid", "error", err)
+		return nil, err
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	insertedSnapshot, err := jobAdSnapshotService.jobAdSnapshotRepository.Create(ctx, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"job_ad_snapshot_service.CreateJobAdSnapshot: Inserted job ad snapshot",
+		"jobAdSnapshot.ID", insertedSnapshot.ID,
+		"applicationID", applicationID)
+	return insertedSnapshot, nil
+}
+
+// GetJobAdSnapshotById can return InternalServiceError, NotFoundError, ValidationError
+func (jobAdSnapshotService *JobAdSnapshotService) GetJobAdSnapshotById(
+	ctx context.Context, id *uuid.UUID) (*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if id == nil {
+		logger.Error("job_ad_snapshot_service.GetJobAdSnapshotById: ID is nil")
+		return nil, internalErrors.NewValidationError(nil, "ID is nil")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return jobAdSnapshotService.jobAdSnapshotRepository.GetById(ctx, id)
+}
+
+// GetJobAdSnapshotsByApplicationID can return InternalServiceError, ValidationError
+func (jobAdSnapshotService *JobAdSnapshotService) GetJobAdSnapshotsByApplicationID(
+	ctx context.Context, applicationID *uuid.UUID) ([]*models.JobAdSnapshot, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if applicationID == nil {
+		logger.Error("job_ad_snapshot_service.GetJobAdSnapshotsByApplicationID: applicationID is nil")
+		return nil, internalErrors.NewValidationError(nil, "applicationID is nil")
+	}
+
+	// can return InternalServiceError, ValidationError
+	return jobAdSnapshotService.jobAdSnapshotRepository.GetAllByApplicationID(ctx, applicationID)
+}
@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupJobAdSnapshotService(t *testing.T) (
+	*services.JobAdSnapshotService, *repositories.ApplicationRepository, *repositories.CompanyRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupJobAdSnapshotServiceTestContainer(t, *config)
+
+	var jobAdSnapshotService *services.JobAdSnapshotService
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	err := container.Invoke(func(
+		service *services.JobAdSnapshotService,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository) {
+
+		jobAdSnapshotService = service
+		applicationRepository = application
+		companyRepository = company
+	})
+	assert.NoError(t, err)
+
+	return jobAdSnapshotService, applicationRepository, companyRepository
+}
+
+func readJobAdFixture(t *testing.T, name string) []byte {
+	content, err := os.ReadFile("../jobad/testdata/" + name)
+	assert.NoError(t, err)
+	return content
+}
+
+// -------- ParseJobAd tests: --------
+
+func TestParseJobAd_ShouldNormalizeJobAdURL(t *testing.T) {
+	jobAdSnapshotService, _, _ := setupJobAdSnapshotService(t)
+
+	posting, err := jobAdSnapshotService.ParseJobAd(context.Background(), readJobAdFixture(t, "job_posting.jsonld"))
+	assert.NoError(t, err)
+	assert.NotNil(t, posting)
+
+	assert.Equal(t, "Senior Backend Developer", *posting.JobTitle)
+	assert.Equal(t, "https://careers.acme.se/jobs/1234-senior-backend-developer", *posting.JobAdURL)
+}
+
+func TestParseJobAd_ShouldReturnValidationErrorIfThereIsNoJobPosting(t *testing.T) {
+	jobAdSnapshotService, _, _ := setupJobAdSnapshotService(t)
+
+	posting, err := jobAdSnapshotService.ParseJobAd(
+		context.Background(), readJobAdFixture(t, "not_a_job_posting.json"))
+	assert.Nil(t, posting)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: job ad does not contain a JobPosting", err.Error())
+}
+
+// -------- CreateJobAdSnapshot tests: --------
+
+func TestCreateJobAdSnapshot_ShouldStoreContentAndParsedFields(t *testing.T) {
+	jobAdSnapshotService, applicationRepository, companyRepository := setupJobAdSnapshotService(t)
+
+	companyID := repositoryhelpers.CreateCompany(t, companyRepository, nil, nil).ID
+	application := repositoryhelpers.CreateApplication(t, applicationRepository, nil, &companyID, nil, nil)
+	content := readJobAdFixture(t, "job_ad_with_json_ld.html")
+
+	insertedSnapshot, err := jobAdSnapshotService.CreateJobAdSnapshot(context.Background(), &application.ID, content)
+	assert.NoError(t, err)
+	assert.NotNil(t, insertedSnapshot)
+
+	assert.Equal(t, application.ID, insertedSnapshot.ApplicationID)
+	assert.Equal(t, models.JobAdSourceType(models.JobAdSourceTypeHTML), insertedSnapshot.SourceType)
+	assert.Equal(t, "Globex Corporation", *insertedSnapshot.CompanyName)
+	assert.Equal(t, models.RemoteStatusType(models.RemoteStatusTypeOffice), insertedSnapshot.RemoteStatusType)
+	assert.NotNil(t, insertedSnapshot.CreatedDate)
+
+	retrievedSnapshot, err := jobAdSnapshotService.GetJobAdSnapshotById(context.Background(), &insertedSnapshot.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), *retrievedSnapshot.Content)
+
+	snapshots, err := jobAdSnapshotService.GetJobAdSnapshotsByApplicationID(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, insertedSnapshot.ID, snapshots[0].ID)
+}
+
+func TestCreateJobAdSnapshot_ShouldReturnValidationErrorIfApplicationDoesNotExist(t *testing.T) {
+	jobAdSnapshotService, _, _ := setupJobAdSnapshotService(t)
+
+	applicationID := uuid.New()
+	insertedSnapshot, err := jobAdSnapshotService.CreateJobAdSnapshot(
+		context.Background(), &applicationID, readJobAdFixture(t, "job_posting.jsonld"))
+	assert.Nil(t, insertedSnapshot)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(
+		t,
+		"validation error on field 'ApplicationID': Application does not exist: '"+applicationID.String()+"'",
+		err.Error())
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- CreateJobAdSnapshot tests: --------
+
+func TestCreateJobAdSnapshot_ShouldReturnValidationErrorOnNilApplicationID(t *testing.T) {
+	jobAdSnapshotService := NewJobAdSnapshotService(nil)
+
+	snapshot, err := jobAdSnapshotService.CreateJobAdSnapshot(context.Background(), nil, []byte("<html></html>"))
+	assert.Nil(t, snapshot)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: applicationID is nil", err.Error())
+}
+
+func TestCreateJobAdSnapshot_ShouldReturnValidationErrorOnEmptyContent(t *testing.T) {
+	jobAdSnapshotService := NewJobAdSnapshotService(nil)
+
+	applicationID := uuid.New()
+	snapshot, err := jobAdSnapshotService.CreateJobAdSnapshot(context.Background(), &applicationID, []byte("  \n"))
+	assert.Nil(t, snapshot)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: job ad is empty", err.Error())
+}
+
+// -------- GetJobAdSnapshotById tests: --------
+
+func TestGetJobAdSnapshotById_ShouldReturnValidationErrorOnNilID(t *testing.T) {
+	jobAdSnapshotService := NewJobAdSnapshotService(nil)
+
+	snapshot, err := jobAdSnapshotService.GetJobAdSnapshotById(context.Background(), nil)
+	assert.Nil(t, snapshot)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: ID is nil", err.Error())
+}
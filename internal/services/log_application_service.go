@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// LogApplicationService creates an application and everything belonging to it in a single transaction, so that a
+// failure part way through leaves nothing behind.
+type LogApplicationService struct {
+	unitOfWork               UnitOfWork
+	eventTransitionValidator *EventTransitionValidator
+}
+
+func NewLogApplicationService(
+	unitOfWork UnitOfWork, eventTransitionValidator *EventTransitionValidator) *LogApplicationService {
+
+	return &LogApplicationService{unitOfWork: unitOfWork, eventTransitionValidator: eventTransitionValidator}
+}
+
+// LogApplication can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+func (logApplicationService *LogApplicationService) LogApplication(
+	ctx context.Context, logApplication *models.LogApplication) (*models.Application, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if logApplication == nil {
+		logger.Error("LogApplicationService.LogApplication: logApplication is nil")
+		return nil, internalErrors.NewValidationError(nil, "LogApplication is nil")
+	}
+
+	err := logApplication.Validate()
+	if err != nil {
+		logger.Info("LogApplicationService.LogApplication: LogApplication is invalid", "error", err)
+		return nil, err
+	}
+
+	var application *models.Application
+	err = logApplicationService.unitOfWork.WithTx(ctx, func(repositories *Repositories) error {
+		var txErr error
+		eventTransitionValidator := logApplicationService.eventTransitionValidator.withRepositories(
+			repositories.Event, repositories.ApplicationEvent)
+		application, txErr = logApplicationInTx(ctx, repositories, eventTransitionValidator, logApplication)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("LogApplicationService.LogApplication: Logged application.", "application.ID", application.ID)
+	return application, nil
+}
+
+// logApplicationInTx reuses the single-entity services on top of the transactional repositories, so that the
+// validation and defaults are the same as when each record is created on its own.
+func logApplicationInTx(
+	ctx context.Context,
+	repositories *Repositories,
+	eventTransitionValidator *EventTransitionValidator,
+	logApplication *models.LogApplication) (*models.Application, error) {
+
+	companyService := NewCompanyService(repositories.Company)
+	personService := NewPersonService(repositories.Person)
+
+	// can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+	company, err := createOrGetCompany(ctx, companyService, logApplication.Company, logApplication.Application.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+	recruiter, err := createOrGetCompany(
+		ctx, companyService, logApplication.Recruiter, logApplication.Application.RecruiterID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationService := NewApplicationService(repositories.Application)
+
+	if !logApplication.Force {
+		// can return ConflictError, InternalServiceError, ValidationError
+		err = applicationService.CheckForDuplicateApplication(ctx, logApplication.Application)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	application, err := applicationService.CreateApplication(ctx, logApplication.Application)
+	if err != nil {
+		return nil, err
+	}
+	application.Company = company
+	application.Recruiter = recruiter
+
+	persons := make([]*models.Person, 0, len(logApplication.Persons)+len(logApplication.PersonIDs))
+	for _, createPerson := range logApplication.Persons {
+		// can return ConflictError, InternalServiceError, ValidationError
+		person, err := personService.CreatePerson(ctx, createPerson)
+		if err != nil {
+			return nil, err
+		}
+		persons = append(persons, person)
+	}
+	for _, personID := range logApplication.PersonIDs {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		person, err := personService.GetPersonById(ctx, &personID)
+		if err != nil {
+			return nil, err
+		}
+		persons = append(persons, person)
+	}
+
+	applicationPersonService := NewApplicationPersonService(repositories.ApplicationPerson)
+	for _, person := range persons {
+		// can return ConflictError, InternalServiceError, ValidationError
+		_, err = applicationPersonService.AssociateApplicationPerson(ctx, &models.AssociateApplicationPerson{
+			ApplicationID: application.ID,
+			PersonID:      person.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	application.Persons = &persons
+
+	events := make([]*models.Event, 0, 1)
+	if logApplication.Event != nil {
+		// can return ConflictError, InternalServiceError, ValidationError
+		event, err := NewEventService(repositories.Event, eventTransitionValidator).CreateEvent(ctx, logApplication.Event)
+		if err != nil {
+			return nil, err
+		}
+
+		// can return ConflictError, InternalServiceError, ValidationError
+		applicationEventService := NewApplicationEventService(repositories.ApplicationEvent, eventTransitionValidator)
+		_, err = applicationEventService.AssociateApplicationEvent(
+			ctx, &models.AssociateApplicationEvent{ApplicationID: application.ID, EventID: event.ID})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	application.Events = &events
+
+	return application, nil
+}
+
+// createOrGetCompany creates createCompany if it is set, and otherwise returns the existing company with companyID,
+// if that is set.
+func createOrGetCompany(
+	ctx context.Context,
+	companyService *CompanyService,
+	createCompany *models.CreateCompany,
+	companyID *uuid.UUID) (*models.Company, error) {
+
+	if createCompany != nil {
+		// can return ConflictError, InternalServiceError, ValidationError
+		return companyService.CreateCompany(ctx, createCompany)
+	}
+
+	if companyID == nil {
+		return nil, nil
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return companyService.GetCompanyById(ctx, companyID)
+}
@@ -0,0 +1,219 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupLogApplicationService(t *testing.T) (
+	*services.LogApplicationService,
+	*repositories.ApplicationRepository,
+	*repositories.CompanyRepository,
+	*repositories.EventRepository,
+	*repositories.PersonRepository,
+	*repositories.ApplicationEventRepository,
+	*repositories.ApplicationPersonRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupLogApplicationServiceTestContainer(t, *config)
+
+	var logApplicationService *services.LogApplicationService
+	err := container.Invoke(func(service *services.LogApplicationService) {
+		logApplicationService = service
+	})
+	assert.NoError(t, err)
+
+	var applicationRepository *repositories.ApplicationRepository
+	var companyRepository *repositories.CompanyRepository
+	var eventRepository *repositories.EventRepository
+	var personRepository *repositories.PersonRepository
+	var applicationEventRepository *repositories.ApplicationEventRepository
+	var applicationPersonRepository *repositories.ApplicationPersonRepository
+	err = container.Invoke(func(
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		event *repositories.EventRepository,
+		person *repositories.PersonRepository,
+		applicationEvent *repositories.ApplicationEventRepository,
+		applicationPerson *repositories.ApplicationPersonRepository) {
+
+		applicationRepository = application
+		companyRepository = company
+		eventRepository = event
+		personRepository = person
+		applicationEventRepository = applicationEvent
+		applicationPersonRepository = applicationPerson
+	})
+	assert.NoError(t, err)
+
+	return logApplicationService,
+		applicationRepository,
+		companyRepository,
+		eventRepository,
+		personRepository,
+		applicationEventRepository,
+		applicationPersonRepository
+}
+
+func newLogApplication(companyID uuid.UUID) *models.LogApplication {
+	return &models.LogApplication{
+		Application: &models.CreateApplication{
+			ID:               testutil.ToPtr(uuid.New()),
+			CompanyID:        &companyID,
+			JobTitle:         testutil.ToPtr("Job Title"),
+			RemoteStatusType: models.RemoteStatusTypeHybrid,
+		},
+		Company: &models.CreateCompany{
+			ID:          &companyID,
+			Name:        "Company",
+			CompanyType: models.CompanyTypeEmployer,
+		},
+		Persons: []*models.CreatePerson{
+			{ID: testutil.ToPtr(uuid.New()), Name: "First Person", PersonType: models.PersonTypeCTO},
+			{ID: testutil.ToPtr(uuid.New()), Name: "Second Person", PersonType: models.PersonTypeDeveloper},
+		},
+		Event: &models.CreateEvent{
+			ID:        testutil.ToPtr(uuid.New()),
+			EventType: models.EventTypeApplied,
+			EventDate: time.Now(),
+		},
+	}
+}
+
+// -------- LogApplication tests: --------
+
+func TestLogApplication_ShouldCreateEverythingAndReturnPopulatedApplication(t *testing.T) {
+	logApplicationService,
+		applicationRepository,
+		companyRepository,
+		_,
+		personRepository,
+		applicationEventRepository,
+		applicationPersonRepository := setupLogApplicationService(t)
+
+	recruiter := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+	existingPerson := repositoryhelpers.CreatePerson(t, personRepository, testutil.ToPtr(uuid.New()), nil)
+
+	logApplication := newLogApplication(uuid.New())
+	logApplication.Application.RecruiterID = &recruiter.ID
+	logApplication.PersonIDs = []uuid.UUID{existingPerson.ID}
+
+	application, err := logApplicationService.LogApplication(context.Background(), logApplication)
+	assert.NoError(t, err)
+	assert.NotNil(t, application)
+
+	assert.Equal(t, *logApplication.Application.ID, application.ID)
+	assert.Equal(t, *logApplication.Company.ID, application.Company.ID)
+	assert.Equal(t, recruiter.ID, application.Recruiter.ID)
+	assert.Len(t, *application.Persons, 3)
+	assert.Equal(t, *logApplication.Persons[0].ID, (*application.Persons)[0].ID)
+	assert.Equal(t, *logApplication.Persons[1].ID, (*application.Persons)[1].ID)
+	assert.Equal(t, existingPerson.ID, (*application.Persons)[2].ID)
+	assert.Len(t, *application.Events, 1)
+	assert.Equal(t, *logApplication.Event.ID, (*application.Events)[0].ID)
+
+	storedApplication, err := applicationRepository.GetById(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, logApplication.Company.ID, storedApplication.CompanyID)
+	assert.Equal(t, &recruiter.ID, storedApplication.RecruiterID)
+
+	applicationPersons, err := applicationPersonRepository.GetAll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, applicationPersons, 3)
+
+	applicationEvents, err := applicationEventRepository.GetAll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, applicationEvents, 1)
+	assert.Equal(t, application.ID, applicationEvents[0].ApplicationID)
+	assert.Equal(t, *logApplication.Event.ID, applicationEvents[0].EventID)
+}
+
+func TestLogApplication_ShouldRollBackEverythingIfEventCannotBeCreated(t *testing.T) {
+	logApplicationService,
+		applicationRepository,
+		companyRepository,
+		eventRepository,
+		personRepository,
+		_,
+		applicationPersonRepository := setupLogApplicationService(t)
+
+	existingEvent := repositoryhelpers.CreateEvent(t, eventRepository, nil, nil, nil)
+
+	logApplication := newLogApplication(uuid.New())
+	logApplication.Event.ID = &existingEvent.ID
+
+	application, err := logApplicationService.LogApplication(context.Background(), logApplication)
+	assert.Nil(t, application)
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+
+	var notFoundError *internalErrors.NotFoundError
+	_, err = applicationRepository.GetById(context.Background(), logApplication.Application.ID)
+	assert.True(t, errors.As(err, &notFoundError))
+
+	_, err = companyRepository.GetById(context.Background(), logApplication.Company.ID)
+	assert.True(t, errors.As(err, &notFoundError))
+
+	_, err = personRepository.GetById(context.Background(), logApplication.Persons[0].ID)
+	assert.True(t, errors.As(err, &notFoundError))
+
+	applicationPersons, err := applicationPersonRepository.GetAll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, applicationPersons)
+}
+
+func TestLogApplication_ShouldReturnNotFoundErrorAndRollBackIfPersonIDDoesNotExist(t *testing.T) {
+	logApplicationService, applicationRepository, companyRepository, _, _, _, _ := setupLogApplicationService(t)
+
+	logApplication := newLogApplication(uuid.New())
+	logApplication.PersonIDs = []uuid.UUID{uuid.New()}
+
+	application, err := logApplicationService.LogApplication(context.Background(), logApplication)
+	assert.Nil(t, application)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+
+	_, err = applicationRepository.GetById(context.Background(), logApplication.Application.ID)
+	assert.True(t, errors.As(err, &notFoundError))
+
+	_, err = companyRepository.GetById(context.Background(), logApplication.Company.ID)
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
+func TestLogApplication_ShouldUseExistingCompany(t *testing.T) {
+	logApplicationService, _, companyRepository, _, _, _, _ := setupLogApplicationService(t)
+
+	company := repositoryhelpers.CreateCompany(t, companyRepository, testutil.ToPtr(uuid.New()), nil)
+
+	logApplication := newLogApplication(company.ID)
+	logApplication.Company = nil
+	logApplication.Persons = nil
+	logApplication.Event = nil
+
+	application, err := logApplicationService.LogApplication(context.Background(), logApplication)
+	assert.NoError(t, err)
+	assert.NotNil(t, application)
+
+	assert.Equal(t, company.ID, application.Company.ID)
+	assert.Equal(t, company.Name, application.Company.Name)
+	assert.Nil(t, application.Recruiter)
+	assert.Empty(t, *application.Persons)
+	assert.Empty(t, *application.Events)
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+	"errors"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// -------- LogApplication tests: --------
+
+func TestLogApplication_ShouldReturnValidationErrorOnNilLogApplication(t *testing.T) {
+	logApplicationService := NewLogApplicationService(nil, nil)
+
+	application, err := logApplicationService.LogApplication(context.Background(), nil)
+	assert.Nil(t, application)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error: LogApplication is nil", validationError.Error())
+}
+
+func TestLogApplication_ShouldReturnValidationErrorOnNilApplication(t *testing.T) {
+	logApplicationService := NewLogApplicationService(nil, nil)
+
+	application, err := logApplicationService.LogApplication(context.Background(), &models.LogApplication{})
+	assert.Nil(t, application)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'Application': Application is nil", validationError.Error())
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"jobsearchtracker/internal/config"
+	databasePackage "jobsearchtracker/internal/database"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+)
+
+type MigrationService struct {
+	database              *sql.DB
+	config                *config.Config
+	typeDefinitionService *TypeDefinitionService
+
+	// availableVersions is read from the migration source once, as the migrations cannot change while the server
+	// runs. availableVersionsErr is set instead if they could not be read.
+	availableVersions    []uint
+	availableVersionsErr error
+}
+
+func NewMigrationService(
+	database *sql.DB, config *config.Config, typeDefinitionService *TypeDefinitionService) *MigrationService {
+
+	availableVersions, err := databasePackage.GetAvailableMigrationVersions(config)
+	if err != nil {
+		slog.Error("NewMigrationService: Unable to read available migrations", "error", err)
+	}
+
+	return &MigrationService{
+		database:              database,
+		config:                config,
+		typeDefinitionService: typeDefinitionService,
+		availableVersions:     availableVersions,
+		availableVersionsErr:  err,
+	}
+}
+
+// GetStatus can return InternalServiceError
+func (migrationService *MigrationService) GetStatus() (*models.MigrationStatus, error) {
+	status, err := databasePackage.GetMigrationStatus(migrationService.database, migrationService.config)
+	if err != nil {
+		slog.Error("MigrationService.GetStatus: Unable to get migration status", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Unable to get migration status: " + err.Error())
+	}
+
+	return status, nil
+}
+
+// GetReadinessStatus returns the same status as GetStatus, but reads the schema version with a single query and
+// compares it against the migrations that were available at startup.
+//
+// GetReadinessStatus can return InternalServiceError
+func (migrationService *MigrationService) GetReadinessStatus(ctx context.Context) (*models.MigrationStatus, error) {
+	if migrationService.availableVersionsErr != nil {
+		return nil, internalErrors.NewInternalServiceError(
+			"Unable to read available migrations: " + migrationService.availableVersionsErr.Error())
+	}
+
+	status, err := databasePackage.ReadMigrationStatus(
+		ctx, migrationService.database, migrationService.availableVersions)
+	if err != nil {
+		logging.FromContext(ctx).Error(
+			"MigrationService.GetReadinessStatus: Unable to read migration version", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Unable to read migration version: " + err.Error())
+	}
+
+	return status, nil
+}
+
+// MigrateUp can return ConflictError, InternalServiceError
+func (migrationService *MigrationService) MigrateUp() (*models.MigrationStatus, error) {
+	err := databasePackage.MigrateUp(migrationService.database, migrationService.config)
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, migrationService.translateError("MigrateUp", err)
+	}
+	migrationService.reloadKnownTypes("MigrateUp")
+
+	slog.Info("MigrationService.MigrateUp: Applied pending migrations")
+	return migrationService.GetStatus()
+}
+
+// MigrateDown rolls back the given number of migrations after taking a backup of the database.
+//
+// MigrateDown can return ConflictError, InternalServiceError, ValidationError
+func (migrationService *MigrationService) MigrateDown(steps int) (*models.MigrationResult, error) {
+	if steps <= 0 {
+		stepsField := "steps"
+		return nil, internalErrors.NewValidationError(&stepsField, "steps must be greater than 0")
+	}
+
+	// can return InternalServiceError
+	status, err := migrationService.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Dirty {
+		return nil, newDirtyDatabaseError(status.Version)
+	}
+
+	appliedCount := len(status.AppliedVersions)
+	if steps > appliedCount {
+		stepsField := "steps"
+		return nil, internalErrors.NewValidationError(
+			&stepsField,
+			"cannot roll back "+strconv.Itoa(steps)+" migrations: only "+strconv.Itoa(appliedCount)+" are applied")
+	}
+
+	// can return InternalServiceError
+	backupFile, err := migrationService.backup()
+	if err != nil {
+		return nil, err
+	}
+
+	err = databasePackage.MigrateDown(migrationService.database, migrationService.config, steps)
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, migrationService.translateError("MigrateDown", err)
+	}
+	migrationService.reloadKnownTypes("MigrateDown")
+
+	slog.Info("MigrationService.MigrateDown: Rolled back migrations", "steps", steps, "backupFile", *backupFile)
+	return migrationService.buildResult(backupFile)
+}
+
+// MigrateToVersion migrates up or down to the given version. A backup is taken if the migration goes down.
+//
+// MigrateToVersion can return ConflictError, InternalServiceError, ValidationError
+func (migrationService *MigrationService) MigrateToVersion(version uint) (*models.MigrationResult, error) {
+	// can return InternalServiceError
+	status, err := migrationService.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	if status.Dirty {
+		return nil, newDirtyDatabaseError(status.Version)
+	}
+
+	if version > status.LatestVersion {
+		versionField := "version"
+		return nil, internalErrors.NewValidationError(
+			&versionField,
+			"version "+strconv.FormatUint(uint64(version), 10)+" does not exist. Latest version is "+
+				strconv.FormatUint(uint64(status.LatestVersion), 10))
+	}
+
+	var backupFile *string
+	if version < status.Version {
+		// can return InternalServiceError
+		backupFile, err = migrationService.backup()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = databasePackage.MigrateToVersion(migrationService.database, migrationService.config, version)
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return nil, migrationService.translateError("MigrateToVersion", err)
+	}
+	migrationService.reloadKnownTypes("MigrateToVersion")
+
+	slog.Info("MigrationService.MigrateToVersion: Migrated database", "version", version)
+	return migrationService.buildResult(backupFile)
+}
+
+// ForceVersion sets the schema version and clears the dirty flag without running any migrations.
+//
+// ForceVersion can return InternalServiceError, ValidationError
+func (migrationService *MigrationService) ForceVersion(version int) (*models.MigrationStatus, error) {
+	if version < -1 {
+		versionField := "version"
+		return nil, internalErrors.NewValidationError(&versionField, "version must be -1 or greater")
+	}
+
+	err := databasePackage.ForceMigrationVersion(migrationService.database, migrationService.config, version)
+	if err != nil {
+		return nil, migrationService.translateError("ForceVersion", err)
+	}
+	migrationService.reloadKnownTypes("ForceVersion")
+
+	slog.Warn("MigrationService.ForceVersion: Forced migration version", "version", version)
+	return migrationService.GetStatus()
+}
+
+// reloadKnownTypes replaces the known types with the contents of the lookup tables, which a migration may have
+// created, dropped or filled. The built-in types are used if the tables cannot be read, such as below the version
+// that creates them.
+func (migrationService *MigrationService) reloadKnownTypes(methodName string) {
+	// can return InternalServiceError, ValidationError
+	err := migrationService.typeDefinitionService.LoadKnownTypes(context.Background())
+	if err != nil {
+		slog.Warn(
+			"MigrationService."+methodName+": Unable to load the event, person and company types. Using the "+
+				"built-in types.",
+			"error", err)
+		models.ResetKnownTypes()
+	}
+}
+
+// backup can return InternalServiceError
+func (migrationService *MigrationService) backup() (*string, error) {
+	backupFile, err := databasePackage.BackupDatabase(migrationService.database, migrationService.config)
+	if err != nil {
+		slog.Error("MigrationService.backup: Unable to back up database", "error", err)
+		return nil, internalErrors.NewInternalServiceError("Unable to back up database: " + err.Error())
+	}
+
+	return &backupFile, nil
+}
+
+// buildResult can return InternalServiceError
+func (migrationService *MigrationService) buildResult(backupFile *string) (*models.MigrationResult, error) {
+	status, err := migrationService.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MigrationResult{Status: status, BackupFile: backupFile}, nil
+}
+
+func (migrationService *MigrationService) translateError(methodName string, err error) error {
+	var dirtyErr migrate.ErrDirty
+	if errors.As(err, &dirtyErr) {
+		slog.Info("MigrationService."+methodName+": Database is dirty", "version", dirtyErr.Version)
+		return newDirtyDatabaseError(uint(dirtyErr.Version))
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		slog.Info("MigrationService."+methodName+": Migration does not exist", "error", err)
+		return internalErrors.NewValidationError(nil, "migration does not exist: "+err.Error())
+	}
+
+	slog.Error("MigrationService."+methodName+": Error running migration", "error", err)
+	return internalErrors.NewInternalServiceError("Error running migration: " + err.Error())
+}
+
+func newDirtyDatabaseError(version uint) *internalErrors.ConflictError {
+	return internalErrors.NewConflictError(
+		"database is dirty at version " + strconv.FormatUint(uint64(version), 10) +
+			". Fix the schema and force a version before migrating")
+}
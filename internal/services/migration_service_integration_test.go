@@ -0,0 +1,290 @@
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMigrationService(t *testing.T) (*services.MigrationService, *configPackage.Config, *sql.DB) {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+		DatabaseFileName:                     "test.sqlite",
+		DatabaseBackupPath:                   t.TempDir(),
+	}
+
+	container := dependencyinjection.SetupMigrationServiceTestContainer(t, *config)
+
+	var migrationService *services.MigrationService
+	err := container.Invoke(func(service *services.MigrationService) {
+		migrationService = service
+	})
+	assert.NoError(t, err)
+
+	var database *sql.DB
+	err = container.Invoke(func(db *sql.DB) {
+		database = db
+	})
+	assert.NoError(t, err)
+
+	return migrationService, config, database
+}
+
+// -------- GetStatus tests: --------
+
+func TestGetStatus_ShouldReturnLatestVersionAfterSetup(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	status, err := migrationService.GetStatus()
+	assert.NoError(t, err)
+	assert.NotNil(t, status)
+
+	assert.Equal(t, uint(19), status.Version)
+	assert.False(t, status.Dirty)
+	assert.Equal(t, uint(19), status.LatestVersion)
+	assert.Equal(t, []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, status.AppliedVersions)
+	assert.Empty(t, status.PendingVersions)
+}
+
+func TestGetStatus_ShouldUseEmbeddedMigrationsIfMigrationsPathIsEmpty(t *testing.T) {
+	config := configPackage.Config{DatabaseMigrationsPath: ""}
+	container := dependencyinjection.SetupMigrationServiceTestContainer(t, config)
+
+	var migrationService *services.MigrationService
+	err := container.Invoke(func(service *services.MigrationService) {
+		migrationService = service
+	})
+	assert.NoError(t, err)
+
+	status, err := migrationService.GetStatus()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(19), status.Version)
+	assert.Equal(t, uint(19), status.LatestVersion)
+	assert.Empty(t, status.PendingVersions)
+}
+
+// -------- GetReadinessStatus tests: --------
+
+func TestGetReadinessStatus_ShouldMatchGetStatus(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	_, err := migrationService.MigrateDown(2)
+	assert.NoError(t, err)
+
+	readinessStatus, err := migrationService.GetReadinessStatus(context.Background())
+	assert.NoError(t, err)
+
+	status, err := migrationService.GetStatus()
+	assert.NoError(t, err)
+
+	assert.Equal(t, status, readinessStatus)
+	assert.Equal(t, []uint{18, 19}, readinessStatus.PendingVersions)
+}
+
+func TestGetReadinessStatus_ShouldReturnDirtyFlag(t *testing.T) {
+	migrationService, _, database := setupMigrationService(t)
+
+	_, err := database.Exec("UPDATE schema_migrations SET dirty = 1")
+	assert.NoError(t, err)
+
+	status, err := migrationService.GetReadinessStatus(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, status.Dirty)
+	assert.Equal(t, uint(19), status.Version)
+}
+
+// -------- MigrateDown tests: --------
+
+func TestMigrateDown_ShouldRollBackStepsAndCreateBackup(t *testing.T) {
+	migrationService, config, _ := setupMigrationService(t)
+
+	result, err := migrationService.MigrateDown(2)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+
+	assert.Equal(t, uint(17), result.Status.Version)
+	assert.Equal(t, []uint{18, 19}, result.Status.PendingVersions)
+
+	assert.NotNil(t, result.BackupFile)
+	assert.Contains(t, *result.BackupFile, config.DatabaseBackupPath)
+	_, err = os.Stat(*result.BackupFile)
+	assert.NoError(t, err)
+}
+
+func TestMigrateDown_ShouldReturnValidationErrorForInvalidSteps(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	tests := []struct {
+		testName     string
+		steps        int
+		errorMessage string
+	}{
+		{"zero steps", 0, "validation error on field 'steps': steps must be greater than 0"},
+		{"negative steps", -1, "validation error on field 'steps': steps must be greater than 0"},
+		{
+			"more steps than applied migrations",
+			20,
+			"validation error on field 'steps': cannot roll back 20 migrations: only 19 are applied"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			result, err := migrationService.MigrateDown(test.steps)
+			assert.Nil(t, result)
+			assert.Error(t, err)
+
+			var validationError *internalErrors.ValidationError
+			assert.True(t, errors.As(err, &validationError))
+			assert.Equal(t, test.errorMessage, validationError.Error())
+		})
+	}
+}
+
+func TestMigrateDown_ShouldReturnConflictErrorIfDatabaseIsDirty(t *testing.T) {
+	migrationService, _, database := setupMigrationService(t)
+
+	// golang-migrate marks the version as dirty when a migration fails part way through.
+	_, err := database.Exec("UPDATE schema_migrations SET dirty = 1")
+	assert.NoError(t, err)
+
+	result, err := migrationService.MigrateDown(1)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+
+	var conflictError *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictError))
+}
+
+// -------- MigrateToVersion tests: --------
+
+func TestMigrateToVersion_ShouldMigrateDownAndUp(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	result, err := migrationService.MigrateToVersion(5)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), result.Status.Version)
+	assert.NotNil(t, result.BackupFile)
+
+	result, err = migrationService.MigrateToVersion(19)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(19), result.Status.Version)
+	assert.Nil(t, result.BackupFile)
+}
+
+func TestMigrateToVersion_ShouldRollBackEverythingOnVersionZero(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	result, err := migrationService.MigrateToVersion(0)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), result.Status.Version)
+	assert.Empty(t, result.Status.AppliedVersions)
+	assert.Len(t, result.Status.PendingVersions, 19)
+}
+
+func TestMigrateToVersion_ShouldReturnValidationErrorIfVersionDoesNotExist(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	result, err := migrationService.MigrateToVersion(99)
+	assert.Nil(t, result)
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(
+		t,
+		"validation error on field 'version': version 99 does not exist. Latest version is 19",
+		validationError.Error())
+}
+
+func TestMigrateToVersion_ShouldReloadKnownTypes(t *testing.T) {
+	migrationService, _, database := setupMigrationService(t)
+	t.Cleanup(models.ResetKnownTypes)
+
+	_, err := database.Exec(`INSERT INTO company_type (name, display_name, sort_order, created_date)
+		VALUES ('agency', 'Agency', 40, '2026-01-01T10:00:00.000Z')`)
+	assert.NoError(t, err)
+	models.SetKnownTypes(models.TypeKindCompany, []string{models.CompanyTypeEmployer, "agency"})
+
+	_, err = migrationService.MigrateToVersion(12)
+	assert.NoError(t, err)
+	assert.False(t, models.IsKnownType(models.TypeKindCompany, "agency"))
+	assert.True(t, models.IsKnownType(models.TypeKindCompany, models.CompanyTypeRecruiter))
+
+	_, err = migrationService.MigrateToVersion(19)
+	assert.NoError(t, err)
+	assert.False(t, models.IsKnownType(models.TypeKindCompany, "agency"))
+	assert.True(t, models.IsKnownType(models.TypeKindCompany, models.CompanyTypeConsultancy))
+}
+
+// -------- ForceVersion tests: --------
+
+func TestForceVersion_ShouldSetVersionWithoutRunningMigrations(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	status, err := migrationService.ForceVersion(4)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(4), status.Version)
+	assert.False(t, status.Dirty)
+	assert.Equal(t, []uint{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, status.PendingVersions)
+}
+
+func TestForceVersion_ShouldReturnValidationErrorIfVersionIsTooLow(t *testing.T) {
+	migrationService, _, _ := setupMigrationService(t)
+
+	status, err := migrationService.ForceVersion(-2)
+	assert.Nil(t, status)
+	assert.Error(t, err)
+
+	var validationError *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "validation error on field 'version': version must be -1 or greater", validationError.Error())
+}
+
+func TestMigrateToVersion_ShouldKeepRowsWhenTypeLookupTablesAreRolledBack(t *testing.T) {
+	migrationService, _, database := setupMigrationService(t)
+
+	statements := []string{
+		`INSERT INTO company (id, name, company_type, created_date)
+			VALUES ('c0000000-0000-0000-0000-000000000001', 'Company', 'recruiter', '2026-01-01T10:00:00.000Z')`,
+		`INSERT INTO person (id, name, person_type, created_date)
+			VALUES ('90000000-0000-0000-0000-000000000001', 'Person', 'CTO', '2026-01-01T10:00:00.000Z')`,
+		`INSERT INTO event (id, event_type, event_date, created_date)
+			VALUES ('e0000000-0000-0000-0000-000000000001', 'interviewCompleted', '2026-01-02T10:00:00.000Z',
+				'2026-01-01T10:00:00.000Z')`,
+		`INSERT INTO company_event (company_id, event_id, created_date)
+			VALUES ('c0000000-0000-0000-0000-000000000001', 'e0000000-0000-0000-0000-000000000001',
+				'2026-01-01T10:00:00.000Z')`,
+		`INSERT INTO interview_prep (event_id, notes, created_date)
+			VALUES ('e0000000-0000-0000-0000-000000000001', 'Notes', '2026-01-01T10:00:00.000Z')`,
+	}
+	for _, statement := range statements {
+		_, err := database.Exec(statement)
+		assert.NoError(t, err)
+	}
+
+	countRows := func(table string) int {
+		var count int
+		assert.NoError(t, database.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&count))
+		return count
+	}
+
+	for _, version := range []uint{12, 13} {
+		result, err := migrationService.MigrateToVersion(version)
+		assert.NoError(t, err)
+		assert.Equal(t, version, result.Status.Version)
+
+		for _, table := range []string{"company", "person", "event", "company_event", "interview_prep"} {
+			assert.Equal(t, 1, countRows(table), "table %s at version %d", table, version)
+		}
+	}
+}
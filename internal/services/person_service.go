@@ -1,27 +1,31 @@
 package services
 
 import (
+	"context"
 	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
 	"jobsearchtracker/internal/models"
-	"jobsearchtracker/internal/repositories"
-	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type PersonService struct {
-	personRepository *repositories.PersonRepository
+	personRepository PersonRepository
 }
 
-func NewPersonService(personRepository *repositories.PersonRepository) *PersonService {
+func NewPersonService(personRepository PersonRepository) *PersonService {
 	return &PersonService{personRepository: personRepository}
 }
 
 // CreatePerson can return ConflictError, InternalServiceError, ValidationError
-func (personService *PersonService) CreatePerson(person *models.CreatePerson) (*models.Person, error) {
+func (personService *PersonService) CreatePerson(
+	ctx context.Context, person *models.CreatePerson) (*models.Person, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if person == nil {
-		slog.Error("person_service.CreatePerson: person is nil")
+		logger.Error("person_service.CreatePerson: person is nil")
 		return nil, internalErrors.NewValidationError(nil, "CreatePerson is nil")
 	}
 
@@ -33,7 +37,7 @@ func (personService *PersonService) CreatePerson(person *models.CreatePerson) (*
 		} else {
 			personID = "[not set]"
 		}
-		slog.Info("person_service.CreatePerson: Person to create is invalid. ", "ID", personID, "error", err)
+		logger.Info("person_service.CreatePerson: Person to create is invalid. ", "ID", personID, "error", err)
 		return nil, err
 	}
 
@@ -43,57 +47,80 @@ func (personService *PersonService) CreatePerson(person *models.CreatePerson) (*
 	} else if person.CreatedDate.IsZero() {
 		createdDate := time.Now()
 		person.CreatedDate = &createdDate
-		slog.Info(
+		logger.Info(
 			"person_service.createPerson: person.CreatedDate is zero. Setting to '" + person.CreatedDate.String() + "'")
 	}
 
-	insertedPerson, err := personService.personRepository.Create(person)
+	insertedPerson, err := personService.personRepository.Create(ctx, person)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("person_service.createPerson: Inserted person.", "person.ID", insertedPerson.ID)
+	logger.Info("person_service.createPerson: Inserted person.", "person.ID", insertedPerson.ID)
 	return insertedPerson, nil
 }
 
 // GetPersonById can return ConflictError, InternalServiceError, NewValidationError
-func (personService *PersonService) GetPersonById(personId *uuid.UUID) (*models.Person, error) {
+func (personService *PersonService) GetPersonById(ctx context.Context, personId *uuid.UUID) (*models.Person, error) {
+	logger := logging.FromContext(ctx)
+
 	if personId == nil {
 		personIdString := "person ID"
 		err := internalErrors.NewValidationError(&personIdString, "personId is required")
-		slog.Info("personService.GetPersonById: Failed to get person", "error", err)
+		logger.Info("personService.GetPersonById: Failed to get person", "error", err)
 		return nil, err
 	}
 
 	// can return InternalServiceError, NotFoundError, ValidationError
-	person, err := personService.personRepository.GetById(personId)
+	person, err := personService.personRepository.GetById(ctx, personId)
 	if err != nil {
 		return nil, err
 	}
 
-	slog.Info("PersonService.GetPersonById: Retrieved person.", "person.ID", person.ID.String())
+	logger.Info("PersonService.GetPersonById: Retrieved person.", "person.ID", person.ID.String())
 
 	return person, nil
 }
 
+// GetPersonsByIDs returns the persons with the given IDs, in the order of ids. IDs that do not exist are skipped.
+//
+// GetPersonsByIDs can return InternalServiceError, ValidationError
+func (personService *PersonService) GetPersonsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Person, error) {
+	logger := logging.FromContext(ctx)
+
+	// can return InternalServiceError, ValidationError
+	persons, err := personService.personRepository.GetAllByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("PersonService.GetPersonsByIDs: Retrieved persons", "requested", len(ids), "found", len(persons))
+
+	return persons, nil
+}
+
 // GetPersonsByName can return InternalServiceError, NotFoundError, ValidationError
-func (personService *PersonService) GetPersonsByName(personName *string) ([]*models.Person, error) {
+func (personService *PersonService) GetPersonsByName(
+	ctx context.Context, personName *string) ([]*models.Person, error) {
+
+	logger := logging.FromContext(ctx)
+
 	if personName == nil {
 		personNameString := "personName"
 		err := internalErrors.NewValidationError(&personNameString, "personName is required")
-		slog.Info("personService.GetPersonByName: Failed to get person", "error", err)
+		logger.Info("personService.GetPersonByName: Failed to get person", "error", err)
 		return nil, err
 	}
 
-	persons, err := personService.personRepository.GetAllByName(personName)
+	persons, err := personService.personRepository.GetAllByName(ctx, personName)
 	if err != nil {
 		return nil, err
 	}
 
 	if persons == nil {
-		slog.Info("PersonService.GetAllPersons: Retrieved zero persons")
+		logger.Info("PersonService.GetAllPersons: Retrieved zero persons")
 	} else {
-		slog.Info("PersonService.GetAllPersons: Retrieved " + string(rune(len(persons))) + " persons")
+		logger.Info("PersonService.GetAllPersons: Retrieved " + string(rune(len(persons))) + " persons")
 	}
 
 	return persons, nil
@@ -101,61 +128,72 @@ func (personService *PersonService) GetPersonsByName(personName *string) ([]*mod
 
 // GetAllPersons can return InternalServiceError
 func (personService *PersonService) GetAllPersons(
+	ctx context.Context,
 	includeCompanies models.IncludeExtraDataType,
 	includeEvents models.IncludeExtraDataType,
 	includeApplications models.IncludeExtraDataType) ([]*models.Person, error) {
 
+	logger := logging.FromContext(ctx)
+
 	// can return InternalServiceError
-	persons, err := personService.personRepository.GetAll(includeCompanies, includeEvents, includeApplications)
+	persons, err := personService.personRepository.GetAll(ctx, includeCompanies, includeEvents, includeApplications)
 	if err != nil {
 		return nil, err
 	}
 
 	if persons == nil {
-		slog.Info("PersonService.GetAllPersons: Retrieved zero persons")
+		logger.Info("PersonService.GetAllPersons: Retrieved zero persons")
 	} else {
-		slog.Info("PersonService.GetAllPersons: Retrieved " + string(rune(len(persons))) + " persons")
+		logger.Info("PersonService.GetAllPersons: Retrieved " + string(rune(len(persons))) + " persons")
 	}
 
 	return persons, nil
 }
 
-// UpdatePerson can return InternalServiceError, ValidationError
-func (personService *PersonService) UpdatePerson(person *models.UpdatePerson) error {
+// UpdatePerson can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (personService *PersonService) UpdatePerson(ctx context.Context, person *models.UpdatePerson) error {
+	logger := logging.FromContext(ctx)
+
 	if person == nil {
-		slog.Error("PersonService.UpdatePerson: UpdatePerson is nil")
+		logger.Error("PersonService.UpdatePerson: UpdatePerson is nil")
 		return internalErrors.NewValidationError(nil, "UpdatePerson model is nil")
 	}
 
 	// can return ValidationError
 	err := person.Validate()
 	if err != nil {
-		slog.Info("PersonService.UpdatePerson: UpdatePerson model is invalid. ", "error", err)
+		logger.Info("PersonService.UpdatePerson: UpdatePerson model is invalid. ", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err = personService.personRepository.Update(person)
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err = personService.personRepository.Update(ctx, person)
 	if err != nil {
-		slog.Error("PersonService.UpdatePerson: Error updating person", "error", err)
+		logger.Error("PersonService.UpdatePerson: Error updating person", "error", err)
 	}
 
 	return err
 }
 
-// DeletePerson can return InternalServiceError, NotFoundError, ValidationError
-func (personService *PersonService) DeletePerson(personId *uuid.UUID) error {
+// DeletePerson deletes the person. If expectedVersion is set, it is only deleted if it has that version.
+//
+// DeletePerson can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+func (personService *PersonService) DeletePerson(
+	ctx context.Context, personId *uuid.UUID, expectedVersion *int) error {
+
+	logger := logging.FromContext(ctx)
+
 	if personId == nil {
 		personIdString := "person ID"
 		err := internalErrors.NewValidationError(&personIdString, "personId is required")
-		slog.Info("PersonService.DeletePerson: Error deleting person", "error", err)
+		logger.Info("PersonService.DeletePerson: Error deleting person", "error", err)
 		return err
 	}
 
-	// can return InternalServiceError, ValidationError
-	err := personService.personRepository.Delete(personId)
+	// can return InternalServiceError, NotFoundError, PreconditionFailedError, ValidationError
+	err := personService.personRepository.Delete(ctx, personId, expectedVersion)
 	if err != nil {
-		slog.Error("PersonService.DeletePerson: Error deleting person", "error", err)
+		logger.Error("PersonService.DeletePerson: Error deleting person", "error", err)
 	}
 
 	return err
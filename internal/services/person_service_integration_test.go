@@ -1,6 +1,7 @@
 package services_test
 
 import (
+	"context"
 	"errors"
 	"jobsearchtracker/internal/api/v1/requests"
 	configPackage "jobsearchtracker/internal/config"
@@ -108,7 +109,7 @@ func TestCreatePerson_ShouldWork(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, -2, 0)),
 	}
 
-	insertedPerson, err := personService.CreatePerson(&personToInsert)
+	insertedPerson, err := personService.CreatePerson(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
@@ -130,7 +131,7 @@ func TestCreatePerson_ShouldHandleEmptyFields(t *testing.T) {
 	}
 
 	insertedDateApproximation := time.Now()
-	insertedPerson, err := personService.CreatePerson(&personToInsert)
+	insertedPerson, err := personService.CreatePerson(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 	assert.NotNil(t, insertedPerson)
 
@@ -159,10 +160,10 @@ func TestGetPersonById_ShouldWork(t *testing.T) {
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, -1, 0)),
 	}
 
-	_, err := personService.CreatePerson(&personToInsert)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 
-	retrievedPerson, err := personService.GetPersonById(personToInsert.ID)
+	retrievedPerson, err := personService.GetPersonById(context.Background(), personToInsert.ID)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPerson)
 
@@ -179,7 +180,7 @@ func TestGetPersonById_ShouldReturnNotFoundErrorForAnIdThatDoesNotExist(t *testi
 	personService, _, _, _, _, _, _, _ := setupPersonService(t)
 
 	id := uuid.New()
-	nilPerson, err := personService.GetPersonById(&id)
+	nilPerson, err := personService.GetPersonById(context.Background(), &id)
 	assert.Nil(t, nilPerson)
 
 	assert.Error(t, err)
@@ -204,7 +205,7 @@ func TestGetPersonsByName_ShouldReturnMultiplePersons(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, -3, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, -4, 0)),
 	}
-	_, err := personService.CreatePerson(&personToInsert1)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert1)
 	assert.NoError(t, err)
 
 	personToInsert2 := models.CreatePerson{
@@ -212,7 +213,7 @@ func TestGetPersonsByName_ShouldReturnMultiplePersons(t *testing.T) {
 		Name:       "Mary Sparks",
 		PersonType: models.PersonTypeOther,
 	}
-	_, err = personService.CreatePerson(&personToInsert2)
+	_, err = personService.CreatePerson(context.Background(), &personToInsert2)
 	assert.NoError(t, err)
 
 	personToInsert3 := models.CreatePerson{
@@ -220,12 +221,12 @@ func TestGetPersonsByName_ShouldReturnMultiplePersons(t *testing.T) {
 		Name:       "David Jonesson",
 		PersonType: models.PersonTypeExternalRecruiter,
 	}
-	_, err = personService.CreatePerson(&personToInsert3)
+	_, err = personService.CreatePerson(context.Background(), &personToInsert3)
 	assert.NoError(t, err)
 
 	// GetByName
 
-	persons, err := personService.GetPersonsByName(testutil.ToPtr("son"))
+	persons, err := personService.GetPersonsByName(context.Background(), testutil.ToPtr("son"))
 	assert.NoError(t, err)
 	assert.NotNil(t, persons)
 	assert.Len(t, persons, 2)
@@ -251,7 +252,7 @@ func TestGetPersonsByName_ShouldReturnNotFoundErrorIfNoNamesMatch(t *testing.T)
 		Name:       "Debbie Star",
 		PersonType: models.PersonTypeUnknown,
 	}
-	_, err := personService.CreatePerson(&personToInsert1)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert1)
 	assert.NoError(t, err)
 
 	personToInsert2 := models.CreatePerson{
@@ -259,12 +260,12 @@ func TestGetPersonsByName_ShouldReturnNotFoundErrorIfNoNamesMatch(t *testing.T)
 		Name:       "Manny Dee",
 		PersonType: models.PersonTypeJobAdvertiser,
 	}
-	_, err = personService.CreatePerson(&personToInsert2)
+	_, err = personService.CreatePerson(context.Background(), &personToInsert2)
 	assert.NoError(t, err)
 
 	// GetByName
 	nameToGet := "Bee"
-	persons, err := personService.GetPersonsByName(testutil.ToPtr(nameToGet))
+	persons, err := personService.GetPersonsByName(context.Background(), testutil.ToPtr(nameToGet))
 	assert.Nil(t, persons)
 	assert.Error(t, err)
 
@@ -290,7 +291,7 @@ func TestGetAllPersons_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&personToInsert1)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert1)
 	assert.NoError(t, err)
 
 	personToInsert2 := models.CreatePerson{
@@ -298,11 +299,12 @@ func TestGetAllPersons_ShouldWork(t *testing.T) {
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&personToInsert2)
+	_, err = personService.CreatePerson(context.Background(), &personToInsert2)
 	assert.NoError(t, err)
 
 	// getAll
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -326,6 +328,7 @@ func TestGetAllPersons_ShouldReturnNilIfNoPersonsInDatabase(t *testing.T) {
 	personService, _, _, _, _, _, _, _ := setupPersonService(t)
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -382,7 +385,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnApplicationsIfIncludeApplication
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -402,6 +405,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnApplicationsIfIncludeApplication
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -453,6 +457,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnNoApplicationsIfIncludeApplicati
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll)
@@ -500,7 +505,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnApplicationIDsIfIncludeApplicati
 		CreatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate:          testutil.ToPtr(time.Now().AddDate(0, 0, 5)),
 	}
-	_, err := applicationRepository.Create(&createApplication1)
+	_, err := applicationRepository.Create(context.Background(), &createApplication1)
 	assert.NoError(t, err)
 
 	application2ID := repositoryhelpers.CreateApplication(
@@ -519,6 +524,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnApplicationIDsIfIncludeApplicati
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -573,6 +579,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnNoApplicationsIfIncludeApplicati
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs)
@@ -619,6 +626,7 @@ func TestPersonServiceGetAllPersons_ShouldReturnNoApplicationsIfIncludeApplicati
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -643,7 +651,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&person1)
+	_, err := personService.CreatePerson(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -652,7 +660,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&person2)
+	_, err = personService.CreatePerson(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -661,7 +669,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personService.CreatePerson(&person3)
+	_, err = personService.CreatePerson(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -675,7 +683,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -683,7 +691,7 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -692,26 +700,27 @@ func TestGetAllPersons_ShouldReturnCompaniesIfIncludeCompaniesIsSetToAll(t *test
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -752,7 +761,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&person1)
+	_, err := personService.CreatePerson(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -761,7 +770,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&person2)
+	_, err = personService.CreatePerson(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -770,7 +779,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personService.CreatePerson(&person3)
+	_, err = personService.CreatePerson(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -784,7 +793,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -792,12 +801,13 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToAllAndThe
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -829,7 +839,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&person1)
+	_, err := personService.CreatePerson(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -838,7 +848,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&person2)
+	_, err = personService.CreatePerson(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -847,7 +857,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personService.CreatePerson(&person3)
+	_, err = personService.CreatePerson(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -861,7 +871,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -869,7 +879,7 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -878,26 +888,27 @@ func TestGetAllPersons_ShouldReturnCompanyIDsIfIncludeCompaniesIsSetToIDs(t *tes
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -936,7 +947,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&person1)
+	_, err := personService.CreatePerson(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -945,7 +956,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&person2)
+	_, err = personService.CreatePerson(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -954,7 +965,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personService.CreatePerson(&person3)
+	_, err = personService.CreatePerson(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -968,7 +979,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		LastContact: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -976,12 +987,13 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToIDsAndThe
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1013,7 +1025,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		PersonType:  models.PersonTypeDeveloper,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := personService.CreatePerson(&person1)
+	_, err := personService.CreatePerson(context.Background(), &person1)
 	assert.NoError(t, err)
 
 	person2 := models.CreatePerson{
@@ -1022,7 +1034,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		PersonType:  models.PersonTypeCTO,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 2)),
 	}
-	_, err = personService.CreatePerson(&person2)
+	_, err = personService.CreatePerson(context.Background(), &person2)
 	assert.NoError(t, err)
 
 	person3 := models.CreatePerson{
@@ -1031,7 +1043,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		PersonType:  models.PersonTypeHR,
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 1)),
 	}
-	_, err = personService.CreatePerson(&person3)
+	_, err = personService.CreatePerson(context.Background(), &person3)
 	assert.NoError(t, err)
 
 	// add two companies
@@ -1041,7 +1053,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		Name:        "Company1Name",
 		CompanyType: requests.CompanyTypeEmployer,
 	}
-	_, err = companyRepository.Create(&company1)
+	_, err = companyRepository.Create(context.Background(), &company1)
 	assert.NoError(t, err)
 
 	company2 := models.CreateCompany{
@@ -1049,7 +1061,7 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		Name:        "Company2Name",
 		CompanyType: requests.CompanyTypeConsultancy,
 	}
-	_, err = companyRepository.Create(&company2)
+	_, err = companyRepository.Create(context.Background(), &company2)
 	assert.NoError(t, err)
 
 	// associate persons and companies
@@ -1058,26 +1070,27 @@ func TestGetAllPersons_ShouldReturnNoCompaniesIfIncludeCompaniesIsSetToNone(t *t
 		CompanyID: *company1.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company1person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company1person1)
 	assert.NoError(t, err)
 
 	Company2person1 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person1.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person1)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person1)
 	assert.NoError(t, err)
 
 	Company2person2 := models.AssociateCompanyPerson{
 		CompanyID: *company2.ID,
 		PersonID:  *person2.ID,
 	}
-	_, err = companyPersonRepository.AssociateCompanyPerson(&Company2person2)
+	_, err = companyPersonRepository.AssociateCompanyPerson(context.Background(), &Company2person2)
 	assert.NoError(t, err)
 
 	// get all persons
 
 	persons, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1116,7 +1129,7 @@ func TestGetAllPersons_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testing.T)
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -1134,6 +1147,7 @@ func TestGetAllPersons_ShouldReturnEventsIfIncludeEventsIsSetToAll(t *testing.T)
 	// get all persons
 
 	results, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1169,6 +1183,7 @@ func TestGetAllPersons_ShouldReturnNoEventsIfIncludeEventsIsSetToAllAndThereAreN
 	// get all persons
 
 	results, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeAll,
 		models.IncludeExtraDataTypeNone)
@@ -1200,7 +1215,7 @@ func TestGetAllPersons_ShouldReturnEventIDsIfIncludeEventsIsSetToIds(t *testing.
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 4)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, 0, 3)),
 	}
-	_, err := eventRepository.Create(&event1ToInsert)
+	_, err := eventRepository.Create(context.Background(), &event1ToInsert)
 	assert.NoError(t, err)
 
 	event2ID := repositoryhelpers.CreateEvent(
@@ -1218,6 +1233,7 @@ func TestGetAllPersons_ShouldReturnEventIDsIfIncludeEventsIsSetToIds(t *testing.
 	// get all persons
 
 	results, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1253,6 +1269,7 @@ func TestGetAllPersons_ShouldReturnNoEventIDsIfIncludeEventsIsSetToIDsAndThereAr
 	// get all persons
 
 	results, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeIDs,
 		models.IncludeExtraDataTypeNone)
@@ -1281,6 +1298,7 @@ func TestGetAllPersons_ShouldReturnNoEventsIfIncludeEventsIsSetToNone(t *testing
 	// get all persons
 
 	results, err := personService.GetAllPersons(
+		context.Background(),
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone,
 		models.IncludeExtraDataTypeNone)
@@ -1312,7 +1330,7 @@ func TestUpdatePerson_ShouldWork(t *testing.T) {
 		CreatedDate: testutil.ToPtr(time.Now().AddDate(1, 0, 0)),
 		UpdatedDate: testutil.ToPtr(time.Now().AddDate(0, -2, 0)),
 	}
-	_, err := personService.CreatePerson(&personToInsert)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 
 	// update person
@@ -1328,11 +1346,11 @@ func TestUpdatePerson_ShouldWork(t *testing.T) {
 	}
 
 	updatedDateApproximation := time.Now()
-	err = personService.UpdatePerson(&personToUpdate)
+	err = personService.UpdatePerson(context.Background(), &personToUpdate)
 	assert.NoError(t, err)
 
 	// get ById
-	retrievedPerson, err := personService.GetPersonById(&id)
+	retrievedPerson, err := personService.GetPersonById(context.Background(), &id)
 	assert.NoError(t, err)
 	assert.NotNil(t, retrievedPerson)
 
@@ -1353,7 +1371,7 @@ func TestUpdatePerson_ShouldNotReturnErrorIfIdToUpdateDoesNotExist(t *testing.T)
 		Notes: testutil.ToPtr("Random Notes"),
 	}
 
-	err := personService.UpdatePerson(&personToUpdate)
+	err := personService.UpdatePerson(context.Background(), &personToUpdate)
 	assert.NoError(t, err)
 }
 
@@ -1369,17 +1387,17 @@ func TestDeletePerson_ShouldWork(t *testing.T) {
 		Name:       "Dave Davesson",
 		PersonType: models.PersonTypeDeveloper,
 	}
-	_, err := personService.CreatePerson(&personToInsert)
+	_, err := personService.CreatePerson(context.Background(), &personToInsert)
 	assert.NoError(t, err)
 
 	// delete person
 
-	err = personService.DeletePerson(personToInsert.ID)
+	err = personService.DeletePerson(context.Background(), personToInsert.ID, nil)
 	assert.NoError(t, err)
 
 	//ensure that person is deleted
 
-	retrievedPerson, err := personService.GetPersonById(personToInsert.ID)
+	retrievedPerson, err := personService.GetPersonById(context.Background(), personToInsert.ID)
 	assert.Nil(t, retrievedPerson)
 	assert.Error(t, err)
 
@@ -1392,7 +1410,7 @@ func TestDeletePerson_ShouldReturnNotFoundErrorIfIdToDeleteDoesNotExist(t *testi
 	personService, _, _, _, _, _, _, _ := setupPersonService(t)
 
 	id := uuid.New()
-	err := personService.DeletePerson(&id)
+	err := personService.DeletePerson(context.Background(), &id, nil)
 	assert.Error(t, err)
 
 	var notFoundError *internalErrors.NotFoundError
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"errors"
 	internalErrors "jobsearchtracker/internal/errors"
 	"jobsearchtracker/internal/models"
@@ -16,7 +17,7 @@ import (
 func TestCreatePerson_ShouldReturnValidationErrorOnNilPerson(t *testing.T) {
 	personService := NewPersonService(nil)
 
-	nilPerson, err := personService.CreatePerson(nil)
+	nilPerson, err := personService.CreatePerson(context.Background(), nil)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 
@@ -33,7 +34,7 @@ func TestCreatePerson_ShouldReturnValidationErrorOnEmptyName(t *testing.T) {
 		PersonType: models.PersonTypeDeveloper,
 	}
 
-	nilPerson, err := personService.CreatePerson(&person)
+	nilPerson, err := personService.CreatePerson(context.Background(), &person)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 
@@ -49,7 +50,7 @@ func TestCreatePerson_ShouldReturnValidationErrorOnNilPersonType(t *testing.T) {
 		Name: "Random",
 	}
 
-	nilPerson, err := personService.CreatePerson(&person)
+	nilPerson, err := personService.CreatePerson(context.Background(), &person)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 
@@ -67,7 +68,7 @@ func TestCreatePerson_ShouldReturnValidationErrorOnInvalidPersonType(t *testing.
 		PersonType: badPersonType,
 	}
 
-	nilPerson, err := personService.CreatePerson(&person)
+	nilPerson, err := personService.CreatePerson(context.Background(), &person)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 
@@ -85,7 +86,7 @@ func TestCreatePerson_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *testing.T
 		UpdatedDate: &time.Time{},
 	}
 
-	nilPerson, err := personService.CreatePerson(&person)
+	nilPerson, err := personService.CreatePerson(context.Background(), &person)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
@@ -100,7 +101,7 @@ func TestCreatePerson_ShouldReturnValidationErrorOnUnsetUpdatedDate(t *testing.T
 func TestGetPersonById_ShouldReturnValidationErrorIfPersonIdIsNil(t *testing.T) {
 	personService := NewPersonService(nil)
 
-	nilPerson, err := personService.GetPersonById(nil)
+	nilPerson, err := personService.GetPersonById(context.Background(), nil)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
@@ -113,7 +114,7 @@ func TestGetPersonById_ShouldReturnValidationErrorIfPersonIdIsNil(t *testing.T)
 func TestGetPersonsByName_ShouldReturnValidationErrorIfPersonNameIsNil(t *testing.T) {
 	personService := NewPersonService(nil)
 
-	nilPerson, err := personService.GetPersonsByName(nil)
+	nilPerson, err := personService.GetPersonsByName(context.Background(), nil)
 	assert.Nil(t, nilPerson)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
@@ -126,7 +127,7 @@ func TestGetPersonsByName_ShouldReturnValidationErrorIfPersonNameIsNil(t *testin
 func TestUpdatePerson_ShouldReturnValidationErrorIfPersonIsNil(t *testing.T) {
 	personService := NewPersonService(nil)
 
-	err := personService.UpdatePerson(nil)
+	err := personService.UpdatePerson(context.Background(), nil)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
@@ -141,7 +142,7 @@ func TestUpdatePerson_ShouldReturnValidationErrorIfPersonContainsNothingToUpdate
 		ID: id,
 	}
 
-	err := personService.UpdatePerson(&person)
+	err := personService.UpdatePerson(context.Background(), &person)
 	assert.Error(t, err)
 
 	var validationError *internalErrors.ValidationError
@@ -154,7 +155,7 @@ func TestUpdatePerson_ShouldReturnValidationErrorIfPersonContainsNothingToUpdate
 func TestDeletePerson_ShouldReturnValidationErrorIfPersonIdIsNil(t *testing.T) {
 	personService := NewPersonService(nil)
 
-	err := personService.DeletePerson(nil)
+	err := personService.DeletePerson(context.Background(), nil, nil)
 	assert.Error(t, err)
 	var validationError *internalErrors.ValidationError
 	assert.True(t, errors.As(err, &validationError))
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The services depend on these interfaces rather than on the SQLite repositories, so that they can be given
+// repositories that share a transaction, or fakes in unit tests.
+
+type ApplicationRepository interface {
+	Create(ctx context.Context, application *models.CreateApplication) (*models.Application, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*models.Application, error)
+	GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Application, error)
+	GetAllByJobTitle(ctx context.Context, jobTitle *string) ([]*models.Application, error)
+	GetAllByJobAdURLOrCompanyAndJobTitle(
+		ctx context.Context, jobAdURL *string, companyID *uuid.UUID, jobTitle *string) ([]*models.Application, error)
+	GetAllByCompanyID(ctx context.Context, companyID *uuid.UUID) ([]*models.Application, error)
+	GetAll(
+		ctx context.Context,
+		includeCompany models.IncludeExtraDataType,
+		includeRecruiter models.IncludeExtraDataType,
+		includePersons models.IncludeExtraDataType,
+		includeEvents models.IncludeExtraDataType) ([]*models.Application, error)
+	GetAllJobAdURLs(ctx context.Context) (map[uuid.UUID]string, error)
+	Update(ctx context.Context, application *models.UpdateApplication) error
+	UpdateJobAdURL(ctx context.Context, id uuid.UUID, jobAdURL string) error
+	Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error
+}
+
+type ApplicationEventRepository interface {
+	AssociateApplicationEvent(
+		ctx context.Context, associateModel *models.AssociateApplicationEvent) (*models.ApplicationEvent, error)
+	GetByID(ctx context.Context, applicationID *uuid.UUID, eventID *uuid.UUID) ([]*models.ApplicationEvent, error)
+	GetAll(ctx context.Context) ([]*models.ApplicationEvent, error)
+	Update(ctx context.Context, model *models.UpdateApplicationEvent) error
+	Delete(ctx context.Context, model *models.DeleteApplicationEvent) error
+}
+
+type ApplicationPersonRepository interface {
+	AssociateApplicationPerson(
+		ctx context.Context, associateModel *models.AssociateApplicationPerson) (*models.ApplicationPerson, error)
+	GetByID(ctx context.Context, applicationID *uuid.UUID, personID *uuid.UUID) ([]*models.ApplicationPerson, error)
+	GetAll(ctx context.Context) ([]*models.ApplicationPerson, error)
+	Update(ctx context.Context, model *models.UpdateApplicationPerson) error
+	Delete(ctx context.Context, model *models.DeleteApplicationPerson) error
+}
+
+type ChangeRepository interface {
+	GetLatestSequence(ctx context.Context) (int64, error)
+	GetAllAfterSequence(
+		ctx context.Context, afterSequence int64, filter *models.ChangeFilter, limit int) ([]*models.Change, error)
+	DeleteOlderThan(ctx context.Context, before time.Time) (int64, error)
+}
+
+type CompanyRepository interface {
+	Create(ctx context.Context, company *models.CreateCompany) (*models.Company, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*models.Company, error)
+	GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Company, error)
+	GetAllByName(ctx context.Context, name *string) ([]*models.Company, error)
+	GetAll(
+		ctx context.Context,
+		includeApplications models.IncludeExtraDataType,
+		includePersons models.IncludeExtraDataType,
+		includeEvents models.IncludeExtraDataType) ([]*models.Company, error)
+	Update(ctx context.Context, company *models.UpdateCompany) error
+	Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error
+	MoveReferences(ctx context.Context, fromID *uuid.UUID, toID *uuid.UUID) error
+}
+
+type CompanyEventRepository interface {
+	AssociateCompanyEvent(
+		ctx context.Context, associateModel *models.AssociateCompanyEvent) (*models.CompanyEvent, error)
+	GetByID(ctx context.Context, companyID *uuid.UUID, eventID *uuid.UUID) ([]*models.CompanyEvent, error)
+	GetAll(ctx context.Context) ([]*models.CompanyEvent, error)
+	Update(ctx context.Context, model *models.UpdateCompanyEvent) error
+	Delete(ctx context.Context, model *models.DeleteCompanyEvent) error
+}
+
+type CompanyPersonRepository interface {
+	AssociateCompanyPerson(
+		ctx context.Context, associateModel *models.AssociateCompanyPerson) (*models.CompanyPerson, error)
+	GetByID(ctx context.Context, companyID *uuid.UUID, personID *uuid.UUID) ([]*models.CompanyPerson, error)
+	GetAll(ctx context.Context) ([]*models.CompanyPerson, error)
+	Update(ctx context.Context, model *models.UpdateCompanyPerson) error
+	Delete(ctx context.Context, model *models.DeleteCompanyPerson) error
+}
+
+type EventRepository interface {
+	Create(ctx context.Context, event *models.CreateEvent) (*models.Event, error)
+	GetByID(ctx context.Context, id *uuid.UUID) (*models.Event, error)
+	GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Event, error)
+	GetAll(
+		ctx context.Context,
+		includeApplications models.IncludeExtraDataType,
+		includeCompanies models.IncludeExtraDataType,
+		includePersons models.IncludeExtraDataType) ([]*models.Event, error)
+	GetAllByFilter(
+		ctx context.Context,
+		filter *models.EventFilter,
+		includeApplications models.IncludeExtraDataType,
+		includeCompanies models.IncludeExtraDataType,
+		includePersons models.IncludeExtraDataType) ([]*models.Event, error)
+	GetOverlappingBookedEvents(
+		ctx context.Context, excludedID *uuid.UUID, startDate time.Time, endDate time.Time) ([]*models.Event, error)
+	Update(ctx context.Context, event *models.UpdateEvent) error
+	Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error
+}
+
+type EventPersonRepository interface {
+	AssociateEventPerson(
+		ctx context.Context, associateModel *models.AssociateEventPerson) (*models.EventPerson, error)
+	GetByID(ctx context.Context, eventID *uuid.UUID, personID *uuid.UUID) ([]*models.EventPerson, error)
+	GetAll(ctx context.Context) ([]*models.EventPerson, error)
+	Update(ctx context.Context, model *models.UpdateEventPerson) error
+	Delete(ctx context.Context, model *models.DeleteEventPerson) error
+}
+
+type IdempotencyKeyRepository interface {
+	Reserve(ctx context.Context, idempotencyKey *models.IdempotencyKey, leaseExpiredBefore time.Time) (bool, error)
+	GetByKeyAndPath(ctx context.Context, key string, path string) (*models.IdempotencyKey, error)
+	SaveResponse(ctx context.Context, idempotencyKey *models.IdempotencyKey) error
+	Delete(ctx context.Context, key string, path string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type InterviewPrepRepository interface {
+	Create(ctx context.Context, prep *models.CreateInterviewPrep) (*models.InterviewPrep, error)
+	GetByEventID(ctx context.Context, eventID *uuid.UUID) (*models.InterviewPrep, error)
+	Update(ctx context.Context, prep *models.UpdateInterviewPrep) error
+	Delete(ctx context.Context, eventID *uuid.UUID, expectedVersion *int) error
+}
+
+type InterviewQuestionRepository interface {
+	Create(ctx context.Context, question *models.CreateInterviewQuestion) (*models.InterviewQuestion, error)
+	GetByID(ctx context.Context, id *uuid.UUID) (*models.InterviewQuestion, error)
+	GetAllByEventID(ctx context.Context, eventID *uuid.UUID) ([]*models.InterviewQuestion, error)
+	GetAllByApplicationID(ctx context.Context, applicationID *uuid.UUID) ([]*models.InterviewQuestion, error)
+	GetAllByCompanyID(ctx context.Context, companyID *uuid.UUID) ([]*models.InterviewQuestion, error)
+	Update(ctx context.Context, question *models.UpdateInterviewQuestion) error
+	Delete(ctx context.Context, id *uuid.UUID) error
+}
+
+type JobAdSnapshotRepository interface {
+	Create(ctx context.Context, snapshot *models.CreateJobAdSnapshot) (*models.JobAdSnapshot, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*models.JobAdSnapshot, error)
+	GetAllByApplicationID(ctx context.Context, applicationID *uuid.UUID) ([]*models.JobAdSnapshot, error)
+}
+
+type PersonRepository interface {
+	Create(ctx context.Context, person *models.CreatePerson) (*models.Person, error)
+	GetById(ctx context.Context, id *uuid.UUID) (*models.Person, error)
+	GetAllByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Person, error)
+	GetAllByName(ctx context.Context, name *string) ([]*models.Person, error)
+	GetAll(
+		ctx context.Context,
+		includeCompanies models.IncludeExtraDataType,
+		includeEvents models.IncludeExtraDataType,
+		includeApplications models.IncludeExtraDataType) ([]*models.Person, error)
+	Update(ctx context.Context, person *models.UpdatePerson) error
+	Delete(ctx context.Context, id *uuid.UUID, expectedVersion *int) error
+	MoveReferences(ctx context.Context, fromID *uuid.UUID, toID *uuid.UUID) error
+}
+
+type TypeDefinitionRepository interface {
+	Create(ctx context.Context, typeDefinition *models.CreateTypeDefinition) (*models.TypeDefinition, error)
+	GetByName(ctx context.Context, kind models.TypeKind, name string) (*models.TypeDefinition, error)
+	GetAll(ctx context.Context, kind models.TypeKind) ([]*models.TypeDefinition, error)
+	Update(ctx context.Context, typeDefinition *models.UpdateTypeDefinition) error
+	Delete(ctx context.Context, kind models.TypeKind, name string) error
+}
+
+var (
+	_ ApplicationRepository       = (*repositories.ApplicationRepository)(nil)
+	_ ApplicationEventRepository  = (*repositories.ApplicationEventRepository)(nil)
+	_ ApplicationPersonRepository = (*repositories.ApplicationPersonRepository)(nil)
+	_ ChangeRepository            = (*repositories.ChangeRepository)(nil)
+	_ CompanyRepository           = (*repositories.CompanyRepository)(nil)
+	_ CompanyEventRepository      = (*repositories.CompanyEventRepository)(nil)
+	_ CompanyPersonRepository     = (*repositories.CompanyPersonRepository)(nil)
+	_ EventRepository             = (*repositories.EventRepository)(nil)
+	_ EventPersonRepository       = (*repositories.EventPersonRepository)(nil)
+	_ IdempotencyKeyRepository    = (*repositories.IdempotencyKeyRepository)(nil)
+	_ InterviewPrepRepository     = (*repositories.InterviewPrepRepository)(nil)
+	_ InterviewQuestionRepository = (*repositories.InterviewQuestionRepository)(nil)
+	_ JobAdSnapshotRepository     = (*repositories.JobAdSnapshotRepository)(nil)
+	_ PersonRepository            = (*repositories.PersonRepository)(nil)
+	_ TypeDefinitionRepository    = (*repositories.TypeDefinitionRepository)(nil)
+)
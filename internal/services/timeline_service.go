@@ -0,0 +1,331 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimelineService builds the history of an application, company or person from the created and updated dates of the
+// records, the dates of their events and the dates on which links were made or changed. Deleted links leave no trace.
+type TimelineService struct {
+	applicationRepository       ApplicationRepository
+	companyRepository           CompanyRepository
+	eventRepository             EventRepository
+	personRepository            PersonRepository
+	applicationPersonRepository ApplicationPersonRepository
+	companyPersonRepository     CompanyPersonRepository
+}
+
+func NewTimelineService(
+	applicationRepository ApplicationRepository,
+	companyRepository CompanyRepository,
+	eventRepository EventRepository,
+	personRepository PersonRepository,
+	applicationPersonRepository ApplicationPersonRepository,
+	companyPersonRepository CompanyPersonRepository) *TimelineService {
+
+	return &TimelineService{
+		applicationRepository:       applicationRepository,
+		companyRepository:           companyRepository,
+		eventRepository:             eventRepository,
+		personRepository:            personRepository,
+		applicationPersonRepository: applicationPersonRepository,
+		companyPersonRepository:     companyPersonRepository,
+	}
+}
+
+// GetApplicationTimeline returns the creation and last update of the application, its events with their companies
+// and persons, and the persons linked to it.
+//
+// GetApplicationTimeline can return InternalServiceError, NotFoundError, ValidationError
+func (timelineService *TimelineService) GetApplicationTimeline(
+	ctx context.Context, applicationID *uuid.UUID) (*models.Timeline, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if applicationID == nil || *applicationID == uuid.Nil {
+		logger.Info("timeline_service.GetApplicationTimeline: applicationID is empty")
+		return nil, internalErrors.NewValidationError(nil, "applicationID is empty")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	application, err := timelineService.applicationRepository.GetById(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := newRecordEntries(
+		application.CreatedDate, application.UpdatedDate, models.TimelineEntry{Application: application})
+
+	// can return InternalServiceError, ValidationError
+	events, err := timelineService.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{ApplicationID: applicationID},
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, newEventEntries(events)...)
+
+	// can return InternalServiceError, ValidationError
+	applicationPersons, err := timelineService.applicationPersonRepository.GetByID(ctx, applicationID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, applicationPerson := range applicationPersons {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		person, err := timelineService.personRepository.GetById(ctx, &applicationPerson.PersonID)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, newLinkEntries(
+			applicationPerson.CreatedDate,
+			applicationPerson.UpdatedDate,
+			applicationPerson.LinkDetails,
+			models.TimelineEntry{Person: person})...)
+	}
+
+	logger.Info("timeline_service.GetApplicationTimeline: Built timeline", "entryCount", len(entries))
+	return newTimeline(nameOrID(application.JobTitle, application.ID), entries), nil
+}
+
+// GetCompanyTimeline returns the creation and last update of the company, the creation of the applications with the
+// company as company or recruiter, its events with their applications and persons, and the persons linked to it.
+//
+// GetCompanyTimeline can return InternalServiceError, NotFoundError, ValidationError
+func (timelineService *TimelineService) GetCompanyTimeline(
+	ctx context.Context, companyID *uuid.UUID) (*models.Timeline, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if companyID == nil || *companyID == uuid.Nil {
+		logger.Info("timeline_service.GetCompanyTimeline: companyID is empty")
+		return nil, internalErrors.NewValidationError(nil, "companyID is empty")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	company, err := timelineService.companyRepository.GetById(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := newRecordEntries(company.CreatedDate, company.UpdatedDate, models.TimelineEntry{Company: company})
+
+	// can return InternalServiceError
+	applications, err := timelineService.applicationRepository.GetAllByCompanyID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		if application.CreatedDate != nil {
+			entries = append(entries, &models.TimelineEntry{
+				Date:        *application.CreatedDate,
+				EntryType:   models.TimelineEntryTypeCreated,
+				Application: application,
+			})
+		}
+	}
+
+	// can return InternalServiceError, ValidationError
+	events, err := timelineService.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{CompanyID: companyID},
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeNone,
+		models.IncludeExtraDataTypeAll)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, newEventEntries(events)...)
+
+	// can return InternalServiceError, ValidationError
+	companyPersons, err := timelineService.companyPersonRepository.GetByID(ctx, companyID, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, companyPerson := range companyPersons {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		person, err := timelineService.personRepository.GetById(ctx, &companyPerson.PersonID)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, newLinkEntries(
+			companyPerson.CreatedDate,
+			companyPerson.UpdatedDate,
+			companyPerson.LinkDetails,
+			models.TimelineEntry{Person: person})...)
+	}
+
+	logger.Info("timeline_service.GetCompanyTimeline: Built timeline", "entryCount", len(entries))
+	return newTimeline(nameOrID(company.Name, company.ID), entries), nil
+}
+
+// GetPersonTimeline returns the creation and last update of the person, the events the person took part in with
+// their applications, companies and other persons, and the companies and applications the person is linked to.
+//
+// GetPersonTimeline can return InternalServiceError, NotFoundError, ValidationError
+func (timelineService *TimelineService) GetPersonTimeline(
+	ctx context.Context, personID *uuid.UUID) (*models.Timeline, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if personID == nil || *personID == uuid.Nil {
+		logger.Info("timeline_service.GetPersonTimeline: personID is empty")
+		return nil, internalErrors.NewValidationError(nil, "personID is empty")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	person, err := timelineService.personRepository.GetById(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := newRecordEntries(person.CreatedDate, person.UpdatedDate, models.TimelineEntry{Person: person})
+
+	// can return InternalServiceError, ValidationError
+	events, err := timelineService.eventRepository.GetAllByFilter(
+		ctx,
+		&models.EventFilter{PersonID: personID},
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll,
+		models.IncludeExtraDataTypeAll)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, newEventEntries(events)...)
+
+	// can return InternalServiceError, ValidationError
+	companyPersons, err := timelineService.companyPersonRepository.GetByID(ctx, nil, personID)
+	if err != nil {
+		return nil, err
+	}
+	for _, companyPerson := range companyPersons {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		company, err := timelineService.companyRepository.GetById(ctx, &companyPerson.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, newLinkEntries(
+			companyPerson.CreatedDate,
+			companyPerson.UpdatedDate,
+			companyPerson.LinkDetails,
+			models.TimelineEntry{Company: company})...)
+	}
+
+	// can return InternalServiceError, ValidationError
+	applicationPersons, err := timelineService.applicationPersonRepository.GetByID(ctx, nil, personID)
+	if err != nil {
+		return nil, err
+	}
+	for _, applicationPerson := range applicationPersons {
+		// can return InternalServiceError, NotFoundError, ValidationError
+		application, err := timelineService.applicationRepository.GetById(ctx, &applicationPerson.ApplicationID)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, newLinkEntries(
+			applicationPerson.CreatedDate,
+			applicationPerson.UpdatedDate,
+			applicationPerson.LinkDetails,
+			models.TimelineEntry{Application: application})...)
+	}
+
+	logger.Info("timeline_service.GetPersonTimeline: Built timeline", "entryCount", len(entries))
+	return newTimeline(nameOrID(person.Name, person.ID), entries), nil
+}
+
+// internal functions
+
+func newTimeline(title string, entries []*models.TimelineEntry) *models.Timeline {
+	models.SortTimelineEntries(entries)
+	return &models.Timeline{Title: title, Entries: entries}
+}
+
+// newRecordEntries returns a created entry and, if the record was updated, an updated entry, both with the record
+// set in entry.
+func newRecordEntries(
+	createdDate *time.Time, updatedDate *time.Time, entry models.TimelineEntry) []*models.TimelineEntry {
+
+	var entries []*models.TimelineEntry
+
+	if createdDate != nil {
+		created := entry
+		created.Date = *createdDate
+		created.EntryType = models.TimelineEntryTypeCreated
+		entries = append(entries, &created)
+	}
+
+	if updatedDate != nil {
+		updated := entry
+		updated.Date = *updatedDate
+		updated.EntryType = models.TimelineEntryTypeUpdated
+		entries = append(entries, &updated)
+	}
+
+	return entries
+}
+
+// newLinkEntries returns a linked entry and, if the link was updated, a linkUpdated entry, both with the linked
+// record set in entry.
+func newLinkEntries(
+	createdDate time.Time,
+	updatedDate *time.Time,
+	linkDetails models.LinkDetails,
+	entry models.TimelineEntry) []*models.TimelineEntry {
+
+	entry.LinkDetails = &linkDetails
+
+	linked := entry
+	linked.Date = createdDate
+	linked.EntryType = models.TimelineEntryTypeLinked
+	entries := []*models.TimelineEntry{&linked}
+
+	if updatedDate != nil {
+		linkUpdated := entry
+		linkUpdated.Date = *updatedDate
+		linkUpdated.EntryType = models.TimelineEntryTypeLinkUpdated
+		entries = append(entries, &linkUpdated)
+	}
+
+	return entries
+}
+
+// newEventEntries dates each event by its event date, or by its created date if it has none.
+func newEventEntries(events []*models.Event) []*models.TimelineEntry {
+	entries := make([]*models.TimelineEntry, 0, len(events))
+	for _, event := range events {
+		date := event.EventDate
+		if date == nil || date.IsZero() {
+			date = event.CreatedDate
+		}
+		if date == nil {
+			continue
+		}
+
+		entries = append(entries, &models.TimelineEntry{
+			Date:      *date,
+			EntryType: models.TimelineEntryTypeEvent,
+			Event:     event,
+		})
+	}
+	return entries
+}
+
+// nameOrID returns name, or id if name is empty.
+func nameOrID(name *string, id uuid.UUID) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return id.String()
+}
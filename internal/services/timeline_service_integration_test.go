@@ -0,0 +1,215 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type timelineTestRepositories struct {
+	application       *repositories.ApplicationRepository
+	company           *repositories.CompanyRepository
+	event             *repositories.EventRepository
+	person            *repositories.PersonRepository
+	applicationEvent  *repositories.ApplicationEventRepository
+	applicationPerson *repositories.ApplicationPersonRepository
+	companyEvent      *repositories.CompanyEventRepository
+	companyPerson     *repositories.CompanyPersonRepository
+	eventPerson       *repositories.EventPersonRepository
+}
+
+func setupTimelineService(t *testing.T) (*services.TimelineService, *timelineTestRepositories) {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupTimelineServiceTestContainer(t, *config)
+
+	var timelineService *services.TimelineService
+	testRepositories := timelineTestRepositories{}
+	err := container.Invoke(func(
+		service *services.TimelineService,
+		application *repositories.ApplicationRepository,
+		company *repositories.CompanyRepository,
+		event *repositories.EventRepository,
+		person *repositories.PersonRepository,
+		applicationEvent *repositories.ApplicationEventRepository,
+		applicationPerson *repositories.ApplicationPersonRepository,
+		companyEvent *repositories.CompanyEventRepository,
+		companyPerson *repositories.CompanyPersonRepository,
+		eventPerson *repositories.EventPersonRepository) {
+
+		timelineService = service
+		testRepositories = timelineTestRepositories{
+			application:       application,
+			company:           company,
+			event:             event,
+			person:            person,
+			applicationEvent:  applicationEvent,
+			applicationPerson: applicationPerson,
+			companyEvent:      companyEvent,
+			companyPerson:     companyPerson,
+			eventPerson:       eventPerson,
+		}
+	})
+	assert.NoError(t, err)
+
+	return timelineService, &testRepositories
+}
+
+// -------- GetApplicationTimeline tests: --------
+
+func TestGetApplicationTimeline_ShouldReturnEntriesOldestFirst(t *testing.T) {
+	timelineService, testRepositories := setupTimelineService(t)
+
+	start := time.Now().AddDate(0, 0, -10).UTC()
+	company := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, nil)
+	application := repositoryhelpers.CreateApplication(
+		t, testRepositories.application, nil, &company.ID, nil, testutil.ToPtr(start))
+	person := repositoryhelpers.CreatePerson(t, testRepositories.person, nil, nil)
+	repositoryhelpers.AssociateApplicationPerson(
+		t, testRepositories.applicationPerson, application.ID, person.ID, testutil.ToPtr(start.AddDate(0, 0, 1)))
+
+	var interviewBooked models.EventType = models.EventTypeInterviewBooked
+	event := repositoryhelpers.CreateEvent(
+		t, testRepositories.event, nil, &interviewBooked, testutil.ToPtr(start.AddDate(0, 0, 3)))
+	repositoryhelpers.AssociateApplicationEvent(t, testRepositories.applicationEvent, application.ID, event.ID, nil)
+	repositoryhelpers.AssociateEventPerson(t, testRepositories.eventPerson, event.ID, person.ID, nil)
+
+	timeline, err := timelineService.GetApplicationTimeline(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "JobTitle", timeline.Title)
+	assert.Len(t, timeline.Entries, 3)
+
+	created := timeline.Entries[0]
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeCreated), created.EntryType)
+	assert.Equal(t, application.ID, created.Application.ID)
+
+	linked := timeline.Entries[1]
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeLinked), linked.EntryType)
+	assert.Equal(t, person.ID, linked.Person.ID)
+	assert.NotNil(t, linked.LinkDetails)
+
+	eventEntry := timeline.Entries[2]
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeEvent), eventEntry.EntryType)
+	assert.Equal(t, event.ID, eventEntry.Event.ID)
+	assert.Len(t, *eventEntry.Event.Persons, 1)
+	assert.Equal(t, person.ID, (*eventEntry.Event.Persons)[0].ID)
+}
+
+func TestGetApplicationTimeline_ShouldIncludeUpdates(t *testing.T) {
+	timelineService, testRepositories := setupTimelineService(t)
+
+	company := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, nil)
+	application := repositoryhelpers.CreateApplication(t, testRepositories.application, nil, &company.ID, nil, nil)
+	err := testRepositories.application.Update(context.Background(), &models.UpdateApplication{
+		ID:       application.ID,
+		JobTitle: testutil.ToPtr("Backend developer"),
+	})
+	assert.NoError(t, err)
+
+	timeline, err := timelineService.GetApplicationTimeline(context.Background(), &application.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Backend developer", timeline.Title)
+	assert.Len(t, timeline.Entries, 2)
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeUpdated), timeline.Entries[1].EntryType)
+}
+
+func TestGetApplicationTimeline_ShouldReturnNotFoundErrorForUnknownApplication(t *testing.T) {
+	timelineService, _ := setupTimelineService(t)
+
+	timeline, err := timelineService.GetApplicationTimeline(context.Background(), testutil.ToPtr(uuid.New()))
+	assert.Nil(t, timeline)
+
+	var notFoundErr *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+// -------- GetCompanyTimeline tests: --------
+
+func TestGetCompanyTimeline_ShouldIncludeApplicationsEventsAndPersons(t *testing.T) {
+	timelineService, testRepositories := setupTimelineService(t)
+
+	start := time.Now().AddDate(0, 0, -10).UTC()
+	company := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, testutil.ToPtr(start))
+	application := repositoryhelpers.CreateApplication(
+		t, testRepositories.application, nil, &company.ID, nil, testutil.ToPtr(start.AddDate(0, 0, 1)))
+	event := repositoryhelpers.CreateEvent(
+		t, testRepositories.event, nil, nil, testutil.ToPtr(start.AddDate(0, 0, 2)))
+	repositoryhelpers.AssociateCompanyEvent(t, testRepositories.companyEvent, company.ID, event.ID, nil)
+	person := repositoryhelpers.CreatePerson(t, testRepositories.person, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(
+		t, testRepositories.companyPerson, company.ID, person.ID, testutil.ToPtr(start.AddDate(0, 0, 3)))
+
+	timeline, err := timelineService.GetCompanyTimeline(context.Background(), &company.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "CompanyName", timeline.Title)
+	assert.Len(t, timeline.Entries, 4)
+	assert.Equal(t, company.ID, timeline.Entries[0].Company.ID)
+	assert.Equal(t, application.ID, timeline.Entries[1].Application.ID)
+	assert.Equal(t, event.ID, timeline.Entries[2].Event.ID)
+	assert.Equal(t, person.ID, timeline.Entries[3].Person.ID)
+}
+
+// -------- GetPersonTimeline tests: --------
+
+func TestGetPersonTimeline_ShouldIncludeEventsAndLinkedRecords(t *testing.T) {
+	timelineService, testRepositories := setupTimelineService(t)
+
+	start := time.Now().AddDate(0, 0, -10).UTC()
+	person := repositoryhelpers.CreatePerson(t, testRepositories.person, nil, testutil.ToPtr(start))
+	company := repositoryhelpers.CreateCompany(t, testRepositories.company, nil, nil)
+	repositoryhelpers.AssociateCompanyPerson(
+		t, testRepositories.companyPerson, company.ID, person.ID, testutil.ToPtr(start.AddDate(0, 0, 1)))
+	application := repositoryhelpers.CreateApplication(t, testRepositories.application, nil, &company.ID, nil, nil)
+	repositoryhelpers.AssociateApplicationPerson(
+		t, testRepositories.applicationPerson, application.ID, person.ID, testutil.ToPtr(start.AddDate(0, 0, 2)))
+	event := repositoryhelpers.CreateEvent(
+		t, testRepositories.event, nil, nil, testutil.ToPtr(start.AddDate(0, 0, 3)))
+	repositoryhelpers.AssociateEventPerson(t, testRepositories.eventPerson, event.ID, person.ID, nil)
+
+	err := testRepositories.companyPerson.Update(context.Background(), &models.UpdateCompanyPerson{
+		CompanyID:   company.ID,
+		PersonID:    person.ID,
+		LinkDetails: models.LinkDetails{Role: testutil.ToPtr("Recruiter")},
+	})
+	assert.NoError(t, err)
+
+	timeline, err := timelineService.GetPersonTimeline(context.Background(), &person.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "PersonName", timeline.Title)
+	assert.Len(t, timeline.Entries, 5)
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeCreated), timeline.Entries[0].EntryType)
+	assert.Equal(t, company.ID, timeline.Entries[1].Company.ID)
+	assert.Equal(t, application.ID, timeline.Entries[2].Application.ID)
+	assert.Equal(t, event.ID, timeline.Entries[3].Event.ID)
+
+	linkUpdated := timeline.Entries[4]
+	assert.Equal(t, models.TimelineEntryType(models.TimelineEntryTypeLinkUpdated), linkUpdated.EntryType)
+	assert.Equal(t, company.ID, linkUpdated.Company.ID)
+	assert.Equal(t, "Recruiter", *linkUpdated.LinkDetails.Role)
+}
+
+func TestGetPersonTimeline_ShouldReturnValidationErrorForEmptyID(t *testing.T) {
+	timelineService, _ := setupTimelineService(t)
+
+	timeline, err := timelineService.GetPersonTimeline(context.Background(), &uuid.Nil)
+	assert.Nil(t, timeline)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error: personID is empty", err.Error())
+}
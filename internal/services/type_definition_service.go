@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/models"
+)
+
+// TypeDefinitionService manages the event, person and company types. It keeps the known types of the models package
+// in step with the lookup tables, so that validating a type does not need a query.
+type TypeDefinitionService struct {
+	typeDefinitionRepository TypeDefinitionRepository
+}
+
+func NewTypeDefinitionService(typeDefinitionRepository TypeDefinitionRepository) *TypeDefinitionService {
+	return &TypeDefinitionService{typeDefinitionRepository: typeDefinitionRepository}
+}
+
+// CreateTypeDefinition can return ConflictError, InternalServiceError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) CreateTypeDefinition(
+	ctx context.Context, typeDefinition *models.CreateTypeDefinition) (*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if typeDefinition == nil {
+		logger.Error("type_definition_service.CreateTypeDefinition: CreateTypeDefinition is nil")
+		return nil, internalErrors.NewValidationError(nil, "CreateTypeDefinition is nil")
+	}
+
+	// can return ValidationError
+	err := typeDefinition.Validate()
+	if err != nil {
+		logger.Info("type_definition_service.CreateTypeDefinition: Type to create is invalid", "error", err)
+		return nil, err
+	}
+
+	// can return ConflictError, InternalServiceError, ValidationError
+	insertedTypeDefinition, err := typeDefinitionService.typeDefinitionRepository.Create(ctx, typeDefinition)
+	if err != nil {
+		return nil, err
+	}
+
+	// can return InternalServiceError, ValidationError
+	err = typeDefinitionService.loadKnownTypesOfKind(ctx, typeDefinition.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(
+		"type_definition_service.CreateTypeDefinition: Inserted type",
+		"kind", insertedTypeDefinition.Kind,
+		"name", insertedTypeDefinition.Name)
+	return insertedTypeDefinition, nil
+}
+
+// GetTypeDefinitionByName can return InternalServiceError, NotFoundError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) GetTypeDefinitionByName(
+	ctx context.Context, kind models.TypeKind, name string) (*models.TypeDefinition, error) {
+
+	logger := logging.FromContext(ctx)
+
+	if name == "" {
+		logger.Info("type_definition_service.GetTypeDefinitionByName: name is empty")
+		nameField := "Name"
+		return nil, internalErrors.NewValidationError(&nameField, "Name is empty")
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return typeDefinitionService.typeDefinitionRepository.GetByName(ctx, kind, name)
+}
+
+// GetAllTypeDefinitions returns the types of kind ordered by their sort order.
+//
+// GetAllTypeDefinitions can return InternalServiceError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) GetAllTypeDefinitions(
+	ctx context.Context, kind models.TypeKind) ([]*models.TypeDefinition, error) {
+
+	// can return InternalServiceError, ValidationError
+	return typeDefinitionService.typeDefinitionRepository.GetAll(ctx, kind)
+}
+
+// UpdateTypeDefinition can return InternalServiceError, NotFoundError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) UpdateTypeDefinition(
+	ctx context.Context, typeDefinition *models.UpdateTypeDefinition) error {
+
+	logger := logging.FromContext(ctx)
+
+	if typeDefinition == nil {
+		logger.Error("type_definition_service.UpdateTypeDefinition: UpdateTypeDefinition is nil")
+		return internalErrors.NewValidationError(nil, "UpdateTypeDefinition is nil")
+	}
+
+	// can return ValidationError
+	err := typeDefinition.Validate()
+	if err != nil {
+		logger.Info("type_definition_service.UpdateTypeDefinition: Type to update is invalid", "error", err)
+		return err
+	}
+
+	// can return InternalServiceError, NotFoundError, ValidationError
+	return typeDefinitionService.typeDefinitionRepository.Update(ctx, typeDefinition)
+}
+
+// DeleteTypeDefinition refuses to delete built-in types, and types that are still in use.
+//
+// DeleteTypeDefinition can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) DeleteTypeDefinition(
+	ctx context.Context, kind models.TypeKind, name string) error {
+
+	logger := logging.FromContext(ctx)
+
+	if name == "" {
+		logger.Info("type_definition_service.DeleteTypeDefinition: name is empty")
+		nameField := "Name"
+		return internalErrors.NewValidationError(&nameField, "Name is empty")
+	}
+
+	// can return ConflictError, InternalServiceError, NotFoundError, ValidationError
+	err := typeDefinitionService.typeDefinitionRepository.Delete(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("type_definition_service.DeleteTypeDefinition: Deleted type", "kind", kind, "name", name)
+
+	// can return InternalServiceError, ValidationError
+	return typeDefinitionService.loadKnownTypesOfKind(ctx, kind)
+}
+
+// LoadKnownTypes replaces the known types of the models package with the contents of the lookup tables. It should be
+// called once the database is migrated.
+//
+// LoadKnownTypes can return InternalServiceError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) LoadKnownTypes(ctx context.Context) error {
+	for _, kind := range []models.TypeKind{models.TypeKindCompany, models.TypeKindEvent, models.TypeKindPerson} {
+		// can return InternalServiceError, ValidationError
+		err := typeDefinitionService.loadKnownTypesOfKind(ctx, kind)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// internal functions
+
+// loadKnownTypesOfKind can return InternalServiceError, ValidationError
+func (typeDefinitionService *TypeDefinitionService) loadKnownTypesOfKind(
+	ctx context.Context, kind models.TypeKind) error {
+
+	// can return InternalServiceError, ValidationError
+	typeDefinitions, err := typeDefinitionService.typeDefinitionRepository.GetAll(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(typeDefinitions))
+	for index, typeDefinition := range typeDefinitions {
+		names[index] = typeDefinition.Name
+	}
+	models.SetKnownTypes(kind, names)
+
+	return nil
+}
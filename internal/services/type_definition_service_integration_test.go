@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"jobsearchtracker/internal/testutil/repositoryhelpers"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTypeDefinitionService(t *testing.T) (*services.TypeDefinitionService, *repositories.EventRepository) {
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupTypeDefinitionServiceTestContainer(t, *config)
+
+	var typeDefinitionService *services.TypeDefinitionService
+	var eventRepository *repositories.EventRepository
+	err := container.Invoke(func(service *services.TypeDefinitionService, eventRepo *repositories.EventRepository) {
+		typeDefinitionService = service
+		eventRepository = eventRepo
+	})
+	assert.NoError(t, err)
+
+	// later tests should not see the types of this one
+	t.Cleanup(func() {
+		assert.NoError(t, typeDefinitionService.LoadKnownTypes(context.Background()))
+	})
+
+	return typeDefinitionService, eventRepository
+}
+
+func TestCreateTypeDefinition_ShouldMakeTypeKnown(t *testing.T) {
+	typeDefinitionService, _ := setupTypeDefinitionService(t)
+	assert.False(t, models.PersonType("designer").IsValid())
+
+	typeDefinition, err := typeDefinitionService.CreateTypeDefinition(
+		context.Background(),
+		&models.CreateTypeDefinition{Kind: models.TypeKindPerson, Name: "designer", DisplayName: "Designer"})
+	assert.NoError(t, err)
+	assert.Equal(t, "designer", typeDefinition.Name)
+
+	assert.True(t, models.PersonType("designer").IsValid())
+
+	err = typeDefinitionService.DeleteTypeDefinition(context.Background(), models.TypeKindPerson, "designer")
+	assert.NoError(t, err)
+
+	assert.False(t, models.PersonType("designer").IsValid())
+}
+
+func TestCreateTypeDefinition_ShouldReturnValidationErrorForInvalidPipelineStage(t *testing.T) {
+	typeDefinitionService, _ := setupTypeDefinitionService(t)
+
+	pipelineStage := models.PipelineStage("archived")
+	typeDefinition, err := typeDefinitionService.CreateTypeDefinition(
+		context.Background(),
+		&models.CreateTypeDefinition{
+			Kind:          models.TypeKindEvent,
+			Name:          "ghosted",
+			DisplayName:   "Ghosted",
+			PipelineStage: &pipelineStage,
+		})
+	assert.Nil(t, typeDefinition)
+
+	var validationErr *internalErrors.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "validation error on field 'PipelineStage': PipelineStage is invalid", err.Error())
+}
+
+func TestDeleteTypeDefinition_ShouldKeepTypeKnownIfItIsInUse(t *testing.T) {
+	typeDefinitionService, eventRepository := setupTypeDefinitionService(t)
+
+	_, err := typeDefinitionService.CreateTypeDefinition(
+		context.Background(),
+		&models.CreateTypeDefinition{Kind: models.TypeKindEvent, Name: "ghosted", DisplayName: "Ghosted"})
+	assert.NoError(t, err)
+
+	var eventType models.EventType = "ghosted"
+	repositoryhelpers.CreateEvent(t, eventRepository, nil, &eventType, nil)
+
+	err = typeDefinitionService.DeleteTypeDefinition(context.Background(), models.TypeKindEvent, "ghosted")
+
+	var conflictErr *internalErrors.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.True(t, models.IsKnownType(models.TypeKindEvent, "ghosted"))
+}
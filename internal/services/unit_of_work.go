@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"jobsearchtracker/internal/config"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/logging"
+	"jobsearchtracker/internal/repositories"
+)
+
+// Repositories holds one repository of each kind. Within UnitOfWork.WithTx they all share the same transaction.
+type Repositories struct {
+	Application       ApplicationRepository
+	ApplicationEvent  ApplicationEventRepository
+	ApplicationPerson ApplicationPersonRepository
+	Company           CompanyRepository
+	CompanyEvent      CompanyEventRepository
+	CompanyPerson     CompanyPersonRepository
+	Event             EventRepository
+	EventPerson       EventPersonRepository
+	Person            PersonRepository
+}
+
+// UnitOfWork runs composite operations atomically.
+type UnitOfWork interface {
+	// WithTx calls fn with repositories that share one transaction. The transaction is committed if fn returns nil,
+	// and rolled back if fn returns an error or panics. The error returned by fn is returned unchanged.
+	WithTx(ctx context.Context, fn func(repositories *Repositories) error) error
+}
+
+type SQLUnitOfWork struct {
+	database *sql.DB
+	config   *config.Config
+}
+
+func NewUnitOfWork(database *sql.DB, config *config.Config) *SQLUnitOfWork {
+	return &SQLUnitOfWork{database: database, config: config}
+}
+
+// WithTx can return InternalServiceError, and any error returned by fn
+func (unitOfWork *SQLUnitOfWork) WithTx(ctx context.Context, fn func(repositories *Repositories) error) error {
+	logger := logging.FromContext(ctx)
+
+	tx, err := unitOfWork.database.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("SQLUnitOfWork.WithTx: Unable to begin transaction", "error", err)
+		return internalErrors.NewInternalServiceError("Unable to begin transaction: " + err.Error())
+	}
+
+	committed := false
+	defer func() {
+		if committed {
+			return
+		}
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && !errors.Is(rollbackErr, sql.ErrTxDone) {
+			logger.Error("SQLUnitOfWork.WithTx: Unable to roll back transaction", "error", rollbackErr)
+		}
+	}()
+
+	err = fn(newTxRepositories(tx, unitOfWork.config))
+	if err != nil {
+		logger.Info("SQLUnitOfWork.WithTx: Rolling back transaction", "error", err)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.Error("SQLUnitOfWork.WithTx: Unable to commit transaction", "error", err)
+		return internalErrors.NewInternalServiceError("Unable to commit transaction: " + err.Error())
+	}
+	committed = true
+
+	return nil
+}
+
+func newTxRepositories(tx *sql.Tx, config *config.Config) *Repositories {
+	queryTimeout := config.DatabaseQueryTimeout()
+
+	return &Repositories{
+		Application:       repositories.NewApplicationRepository(tx, queryTimeout),
+		ApplicationEvent:  repositories.NewApplicationEventRepository(tx, queryTimeout),
+		ApplicationPerson: repositories.NewApplicationPersonRepository(tx, queryTimeout),
+		Company:           repositories.NewCompanyRepository(tx, queryTimeout),
+		CompanyEvent:      repositories.NewCompanyEventRepository(tx, queryTimeout),
+		CompanyPerson:     repositories.NewCompanyPersonRepository(tx, queryTimeout),
+		Event:             repositories.NewEventRepository(tx, queryTimeout),
+		EventPerson:       repositories.NewEventPersonRepository(tx, queryTimeout),
+		Person:            repositories.NewPersonRepository(tx, queryTimeout),
+	}
+}
@@ -0,0 +1,216 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	configPackage "jobsearchtracker/internal/config"
+	databasePackage "jobsearchtracker/internal/database"
+	internalErrors "jobsearchtracker/internal/errors"
+	"jobsearchtracker/internal/metrics"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/repositories"
+	"jobsearchtracker/internal/services"
+	"jobsearchtracker/internal/testutil"
+	"jobsearchtracker/internal/testutil/dependencyinjection"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupUnitOfWork(t *testing.T) (
+	*services.SQLUnitOfWork, *repositories.ApplicationRepository, *repositories.CompanyRepository) {
+
+	config := &configPackage.Config{
+		DatabaseMigrationsPath:               "../../migrations",
+		IsDatabaseMigrationsPathAbsolutePath: false,
+	}
+
+	container := dependencyinjection.SetupUnitOfWorkTestContainer(t, *config)
+
+	var unitOfWork *services.SQLUnitOfWork
+	err := container.Invoke(func(uow *services.SQLUnitOfWork) {
+		unitOfWork = uow
+	})
+	assert.NoError(t, err)
+
+	var applicationRepository *repositories.ApplicationRepository
+	err = container.Invoke(func(repository *repositories.ApplicationRepository) {
+		applicationRepository = repository
+	})
+	assert.NoError(t, err)
+
+	var companyRepository *repositories.CompanyRepository
+	err = container.Invoke(func(repository *repositories.CompanyRepository) {
+		companyRepository = repository
+	})
+	assert.NoError(t, err)
+
+	return unitOfWork, applicationRepository, companyRepository
+}
+
+// createCompanyAndApplication inserts a company and an application referencing it, using the transactional
+// repositories.
+func createCompanyAndApplication(
+	repositories *services.Repositories, companyID uuid.UUID, applicationID uuid.UUID) error {
+
+	_, err := repositories.Company.Create(context.Background(), &models.CreateCompany{
+		ID:          &companyID,
+		Name:        "CompanyName",
+		CompanyType: models.CompanyTypeEmployer,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repositories.Application.Create(context.Background(), &models.CreateApplication{
+		ID:               &applicationID,
+		CompanyID:        &companyID,
+		JobTitle:         testutil.ToPtr("JobTitle"),
+		RemoteStatusType: models.RemoteStatusTypeRemote,
+	})
+	return err
+}
+
+// -------- WithTx tests: --------
+
+func TestWithTx_ShouldCommitChangesOfAllRepositories(t *testing.T) {
+	unitOfWork, applicationRepository, companyRepository := setupUnitOfWork(t)
+
+	companyID := uuid.New()
+	applicationID := uuid.New()
+
+	err := unitOfWork.WithTx(context.Background(), func(repositories *services.Repositories) error {
+		return createCompanyAndApplication(repositories, companyID, applicationID)
+	})
+	assert.NoError(t, err)
+
+	company, err := companyRepository.GetById(context.Background(), &companyID)
+	assert.NoError(t, err)
+	assert.Equal(t, companyID, company.ID)
+
+	application, err := applicationRepository.GetById(context.Background(), &applicationID)
+	assert.NoError(t, err)
+	assert.Equal(t, applicationID, application.ID)
+	assert.Equal(t, companyID, *application.CompanyID)
+}
+
+func TestWithTx_ShouldRollBackAllChangesAndReturnErrorOfFn(t *testing.T) {
+	unitOfWork, applicationRepository, companyRepository := setupUnitOfWork(t)
+
+	companyID := uuid.New()
+	applicationID := uuid.New()
+	fnError := errors.New("something went wrong")
+
+	err := unitOfWork.WithTx(context.Background(), func(repositories *services.Repositories) error {
+		if err := createCompanyAndApplication(repositories, companyID, applicationID); err != nil {
+			return err
+		}
+		return fnError
+	})
+	assert.ErrorIs(t, err, fnError)
+
+	company, err := companyRepository.GetById(context.Background(), &companyID)
+	assert.Nil(t, company)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+
+	application, err := applicationRepository.GetById(context.Background(), &applicationID)
+	assert.Nil(t, application)
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
+func TestWithTx_ShouldRollBackAllChangesOnPanic(t *testing.T) {
+	unitOfWork, _, companyRepository := setupUnitOfWork(t)
+
+	companyID := uuid.New()
+	applicationID := uuid.New()
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = unitOfWork.WithTx(context.Background(), func(repositories *services.Repositories) error {
+			if err := createCompanyAndApplication(repositories, companyID, applicationID); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	})
+
+	company, err := companyRepository.GetById(context.Background(), &companyID)
+	assert.Nil(t, company)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
+func TestWithTx_ShouldRollBackWhenARepositoryFails(t *testing.T) {
+	unitOfWork, _, companyRepository := setupUnitOfWork(t)
+
+	companyID := uuid.New()
+
+	err := unitOfWork.WithTx(context.Background(), func(repositories *services.Repositories) error {
+		if err := createCompanyAndApplication(repositories, companyID, uuid.New()); err != nil {
+			return err
+		}
+		// A second company with the same ID violates the primary key
+		_, err := repositories.Company.Create(context.Background(), &models.CreateCompany{
+			ID:          &companyID,
+			Name:        "Another name",
+			CompanyType: models.CompanyTypeRecruiter,
+		})
+		return err
+	})
+	assert.Error(t, err)
+
+	company, err := companyRepository.GetById(context.Background(), &companyID)
+	assert.Nil(t, company)
+	var notFoundError *internalErrors.NotFoundError
+	assert.True(t, errors.As(err, &notFoundError))
+}
+
+func TestWithTx_ShouldWaitForConcurrentWritersOfAFileDatabase(t *testing.T) {
+	config := &configPackage.Config{
+		DatabaseFilePath:                   t.TempDir(),
+		IsDatabaseFileLocationAbsolutePath: true,
+		DatabaseFileName:                   "test.sqlite",
+		DatabaseMigrationsPath:             "../../migrations",
+		DatabaseQueryTimeoutSeconds:        10,
+	}
+
+	database, err := databasePackage.NewFileDatabase(metrics.NewMetrics()).Connect(config)
+	assert.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, database.Close()) })
+	assert.NoError(t, databasePackage.RunMigrations(database, config))
+
+	unitOfWork := services.NewUnitOfWork(database, config)
+
+	// Every transaction reads before it writes, which fails with SQLITE_BUSY if the write lock is only taken on the
+	// first write.
+	const writers = 10
+	errs := make(chan error, writers)
+	var waitGroup sync.WaitGroup
+	for range writers {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			errs <- unitOfWork.WithTx(context.Background(), func(repositories *services.Repositories) error {
+				_, err := repositories.Company.GetAll(
+					context.Background(),
+					models.IncludeExtraDataTypeNone,
+					models.IncludeExtraDataTypeNone,
+					models.IncludeExtraDataTypeNone)
+				if err != nil {
+					return err
+				}
+				time.Sleep(10 * time.Millisecond)
+				return createCompanyAndApplication(repositories, uuid.New(), uuid.New())
+			})
+		}()
+	}
+	waitGroup.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,117 @@
+package similarity
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Threshold is the lowest Ratio at which two normalized values are considered the same.
+const Threshold = 0.85
+
+// companyLegalForms are dropped from company names, so that "Acme AB" and "ACME" normalize to the same value.
+var companyLegalForms = map[string]bool{
+	"ab": true, "ag": true, "as": true, "asa": true, "bv": true, "co": true, "company": true, "corp": true,
+	"corporation": true, "gmbh": true, "inc": true, "incorporated": true, "limited": true, "llc": true, "ltd": true,
+	"nv": true, "oy": true, "oyj": true, "plc": true, "sa": true, "sarl": true, "spa": true,
+}
+
+// genericEmailLocalParts are shared role mailboxes, which say nothing about who is behind them.
+var genericEmailLocalParts = map[string]bool{
+	"careers": true, "contact": true, "hello": true, "hiring": true, "hr": true, "info": true, "jobs": true,
+	"mail": true, "noreply": true, "no-reply": true, "office": true, "recruiter": true, "recruiting": true,
+	"recruitment": true, "support": true, "talent": true,
+}
+
+// NormalizeCompanyName lower-cases name, removes punctuation and drops legal forms such as "AB" and "Inc". A name
+// that consists of nothing but a legal form is kept as it is.
+func NormalizeCompanyName(name string) string {
+	words := words(name)
+
+	var kept []string
+	for _, word := range words {
+		if !companyLegalForms[word] {
+			kept = append(kept, word)
+		}
+	}
+	if len(kept) == 0 {
+		kept = words
+	}
+
+	return strings.Join(kept, " ")
+}
+
+// NormalizePersonName lower-cases name, removes punctuation and sorts the words, so that "Doe, Jane" and "jane doe"
+// normalize to the same value.
+func NormalizePersonName(name string) string {
+	words := words(name)
+	slices.Sort(words)
+	return strings.Join(words, " ")
+}
+
+// SplitEmail returns the part of email before the "@", lower-cased and without any "+tag" or dots, and the
+// lower-cased domain, so that "Jane.Doe+jobs@Acme.se" splits into "janedoe" and "acme.se". It returns false if email
+// has no local part or domain, or if the local part is a shared role mailbox such as "info".
+func SplitEmail(email string) (string, string, bool) {
+	localPart, domain, found := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !found || domain == "" {
+		return "", "", false
+	}
+
+	if genericEmailLocalParts[localPart] {
+		return "", "", false
+	}
+
+	localPart, _, _ = strings.Cut(localPart, "+")
+	localPart = strings.ReplaceAll(localPart, ".", "")
+	if localPart == "" || genericEmailLocalParts[localPart] {
+		return "", "", false
+	}
+
+	return localPart, domain, true
+}
+
+// Ratio returns how similar first and second are, from 0 for nothing in common to 1 for identical, based on the
+// Levenshtein distance relative to the length of the longer value.
+func Ratio(first string, second string) float64 {
+	firstRunes := []rune(first)
+	secondRunes := []rune(second)
+
+	longest := max(len(firstRunes), len(secondRunes))
+	if longest == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(firstRunes, secondRunes))/float64(longest)
+}
+
+func words(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func levenshtein(first []rune, second []rune) int {
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+	for index := range previous {
+		previous[index] = index
+	}
+
+	for firstIndex := 1; firstIndex <= len(first); firstIndex++ {
+		current[0] = firstIndex
+		for secondIndex := 1; secondIndex <= len(second); secondIndex++ {
+			substitutionCost := 1
+			if first[firstIndex-1] == second[secondIndex-1] {
+				substitutionCost = 0
+			}
+			current[secondIndex] = min(
+				previous[secondIndex]+1,
+				current[secondIndex-1]+1,
+				previous[secondIndex-1]+substitutionCost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
@@ -0,0 +1,69 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCompanyName(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Acme AB", expected: "acme"},
+		{name: "ACME", expected: "acme"},
+		{name: "  Acme,  Inc. ", expected: "acme"},
+		{name: "Big-Bank Sweden AB", expected: "big bank sweden"},
+		{name: "AB", expected: "ab"},
+		{name: "Café Ltd", expected: "café"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, NormalizeCompanyName(test.name))
+		})
+	}
+}
+
+func TestNormalizePersonName(t *testing.T) {
+	assert.Equal(t, "doe jane", NormalizePersonName("Jane Doe"))
+	assert.Equal(t, "doe jane", NormalizePersonName("Doe, Jane"))
+	assert.Equal(t, "", NormalizePersonName(" - "))
+}
+
+func TestSplitEmail(t *testing.T) {
+	tests := []struct {
+		email             string
+		expectedLocalPart string
+		expectedDomain    string
+		expectedFound     bool
+	}{
+		{email: "Jane.Doe+jobs@Acme.se", expectedLocalPart: "janedoe", expectedDomain: "acme.se", expectedFound: true},
+		{email: "janedoe@gmail.com", expectedLocalPart: "janedoe", expectedDomain: "gmail.com", expectedFound: true},
+		{email: "info@acme.se", expectedFound: false},
+		{email: "jobs+backend@acme.se", expectedFound: false},
+		{email: "Recruiting@acme.se", expectedFound: false},
+		{email: "no-at-sign", expectedFound: false},
+		{email: "@acme.se", expectedFound: false},
+		{email: "janedoe@", expectedFound: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.email, func(t *testing.T) {
+			localPart, domain, found := SplitEmail(test.email)
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expectedLocalPart, localPart)
+			assert.Equal(t, test.expectedDomain, domain)
+		})
+	}
+}
+
+func TestRatio(t *testing.T) {
+	assert.Equal(t, 1.0, Ratio("", ""))
+	assert.Equal(t, 1.0, Ratio("acme", "acme"))
+	assert.Equal(t, 0.0, Ratio("abc", "xyz"))
+	assert.Equal(t, 0.75, Ratio("acme", "acne"))
+	assert.InDelta(t, 0.9, Ratio("jobsearcher", "jobsearchr"), 0.01)
+	assert.Equal(t, 0.5, Ratio("åäöx", "åä"))
+}
@@ -5,6 +5,7 @@ import (
 	apiV1 "jobsearchtracker/internal/api/v1/handlers"
 	configPackage "jobsearchtracker/internal/config"
 	databasePackage "jobsearchtracker/internal/database"
+	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/repositories"
 	"jobsearchtracker/internal/services"
 	"log"
@@ -15,7 +16,7 @@ import (
 	"go.uber.org/dig"
 )
 
-func SetupDatabaseTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+func SetupDatabaseTestContainer(t testing.TB, config configPackage.Config) *dig.Container {
 	container := dig.New()
 
 	err := container.Provide(func() *configPackage.Config {
@@ -60,53 +61,74 @@ func SetupDatabaseTestContainer(t *testing.T, config configPackage.Config) *dig.
 	return container
 }
 
+// provideEventTransitionValidator requires an EventRepository and an ApplicationEventRepository. The mode is taken
+// from config.EventTransitionMode, and is warn if that is not set.
+func provideEventTransitionValidator(container *dig.Container) {
+	err := container.Provide(func(
+		eventRepository *repositories.EventRepository,
+		applicationEventRepository *repositories.ApplicationEventRepository,
+		config *configPackage.Config) *services.EventTransitionValidator {
+
+		mode := models.EventTransitionMode(config.EventTransitionMode)
+		if !mode.IsValid() {
+			mode = models.EventTransitionModeWarn
+		}
+
+		return services.NewEventTransitionValidator(
+			eventRepository, applicationEventRepository, &models.DefaultEventTransitionRules, mode)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide eventTransitionValidator", err)
+	}
+}
+
 // -------- Application containers: --------
 
 func SetupApplicationRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationRepository", err)
 	}
 
 	// the CompanyRepository is also needed due to a FK dependency.
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository in SetupApplicationRepositoryTestContainer", err)
 	}
 
 	// Add EventRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventRepository in SetupApplicationRepositoryTestContainer", err)
 	}
 
 	// Add PersonRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide personRepository", err)
 	}
 
 	// Add ApplicationEventRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationEventRepository {
-		return repositories.NewApplicationEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationEventRepository {
+		return repositories.NewApplicationEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationEventRepository", err)
 	}
 
 	// Add ApplicationPersonRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationPersonRepository {
-		return repositories.NewApplicationPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationPersonRepository {
+		return repositories.NewApplicationPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationPersonRepository", err)
@@ -146,32 +168,32 @@ func SetupApplicationHandlerTestContainer(t *testing.T, config configPackage.Con
 func SetupApplicationEventRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.ApplicationEventRepository {
-		return repositories.NewApplicationEventRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationEventRepository {
+		return repositories.NewApplicationEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationEventRepository", err)
 	}
 
 	// Add EventRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventRepository", err)
 	}
 
 	// Add CompanyRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository", err)
 	}
 
 	// Add ApplicationRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationRepository", err)
@@ -183,10 +205,14 @@ func SetupApplicationEventRepositoryTestContainer(t *testing.T, config configPac
 func SetupApplicationEventServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupApplicationEventRepositoryTestContainer(t, config)
 
+	provideEventTransitionValidator(container)
+
 	err := container.Provide(
-		func(repository *repositories.ApplicationEventRepository) *services.ApplicationEventService {
+		func(
+			repository *repositories.ApplicationEventRepository,
+			eventTransitionValidator *services.EventTransitionValidator) *services.ApplicationEventService {
 
-			return services.NewApplicationEventService(repository)
+			return services.NewApplicationEventService(repository, eventTransitionValidator)
 		})
 	if err != nil {
 		log.Fatal("Failed to provide applicationEventService", err)
@@ -213,32 +239,32 @@ func SetupApplicationEventHandlerTestContainer(t *testing.T, config configPackag
 func SetupApplicationPersonRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.ApplicationPersonRepository {
-		return repositories.NewApplicationPersonRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationPersonRepository {
+		return repositories.NewApplicationPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationPersonRepository", err)
 	}
 
 	// Add PersonRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide personRepository", err)
 	}
 
 	// Add CompanyRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository", err)
 	}
 
 	// Add ApplicationRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide applicationRepository", err)
@@ -278,43 +304,43 @@ func SetupApplicationPersonHandlerTestContainer(t *testing.T, config configPacka
 func SetupCompanyRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide ApplicationRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide EventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide PersonRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyEventRepository {
-		return repositories.NewCompanyEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyEventRepository {
+		return repositories.NewCompanyEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyEventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyPersonRepository {
-		return repositories.NewCompanyPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyPersonRepository", err)
@@ -356,24 +382,24 @@ func SetupCompanyHandlerTestContainer(t *testing.T, config configPackage.Config)
 func SetupCompanyEventRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.CompanyEventRepository {
-		return repositories.NewCompanyEventRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyEventRepository {
+		return repositories.NewCompanyEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyEventRepository", err)
 	}
 
 	// Add CompanyRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository", err)
 	}
 
 	// Add EventRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventRepository", err)
@@ -413,24 +439,24 @@ func SetupCompanyEventHandlerTestContainer(t *testing.T, config configPackage.Co
 func SetupCompanyPersonRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.CompanyPersonRepository {
-		return repositories.NewCompanyPersonRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyPersonRepository", err)
 	}
 
 	// Add PersonRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide personRepository", err)
 	}
 
 	// Add CompanyRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide companyRepository", err)
@@ -470,50 +496,50 @@ func SetupCompanyPersonHandlerTestContainer(t *testing.T, config configPackage.C
 func SetupEventRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide ApplicationRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide PersonRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationEventRepository {
-		return repositories.NewApplicationEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationEventRepository {
+		return repositories.NewApplicationEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide ApplicationEventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyEventRepository {
-		return repositories.NewCompanyEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyEventRepository {
+		return repositories.NewCompanyEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyEventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.EventPersonRepository {
-		return repositories.NewEventPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventPersonRepository {
+		return repositories.NewEventPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide EventPersonRepository", err)
@@ -525,8 +551,13 @@ func SetupEventRepositoryTestContainer(t *testing.T, config configPackage.Config
 func SetupEventServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupEventRepositoryTestContainer(t, config)
 
-	err := container.Provide(func(repository *repositories.EventRepository) *services.EventService {
-		return services.NewEventService(repository)
+	provideEventTransitionValidator(container)
+
+	err := container.Provide(func(
+		repository *repositories.EventRepository,
+		eventTransitionValidator *services.EventTransitionValidator) *services.EventService {
+
+		return services.NewEventService(repository, eventTransitionValidator)
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventService", err)
@@ -551,24 +582,24 @@ func SetupEventHandlerTestContainer(t *testing.T, config configPackage.Config) *
 func SetupEventPersonRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.EventPersonRepository {
-		return repositories.NewEventPersonRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventPersonRepository {
+		return repositories.NewEventPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventPersonRepository", err)
 	}
 
 	// Add PersonRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide personRepository", err)
 	}
 
 	// Add EventRepository in order to insert data for testing
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide eventRepository", err)
@@ -608,50 +639,50 @@ func SetupEventPersonHandlerTestContainer(t *testing.T, config configPackage.Con
 func SetupPersonRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
 	container := SetupDatabaseTestContainer(t, config)
 
-	err := container.Provide(func(db *sql.DB) *repositories.PersonRepository {
-		return repositories.NewPersonRepository(db)
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.PersonRepository {
+		return repositories.NewPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide personRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationRepository {
-		return repositories.NewApplicationRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationRepository {
+		return repositories.NewApplicationRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide ApplicationRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyRepository {
-		return repositories.NewCompanyRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyRepository {
+		return repositories.NewCompanyRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.ApplicationPersonRepository {
-		return repositories.NewApplicationPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ApplicationPersonRepository {
+		return repositories.NewApplicationPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide ApplicationPersonRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.CompanyPersonRepository {
-		return repositories.NewCompanyPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide CompanyPersonRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.EventRepository {
-		return repositories.NewEventRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventRepository {
+		return repositories.NewEventRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide EventRepository", err)
 	}
 
-	err = container.Provide(func(db *sql.DB) *repositories.EventPersonRepository {
-		return repositories.NewEventPersonRepository(db)
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventPersonRepository {
+		return repositories.NewEventPersonRepository(db, config.DatabaseQueryTimeout())
 	})
 	if err != nil {
 		log.Fatal("Failed to provide EventPersonRepository", err)
@@ -685,3 +716,501 @@ func SetupPersonHandlerTestContainer(t *testing.T, config configPackage.Config)
 
 	return container
 }
+
+// -------- Migration containers: --------
+
+func SetupMigrationServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupTypeDefinitionServiceTestContainer(t, config)
+
+	err := container.Provide(services.NewMigrationService)
+	if err != nil {
+		log.Fatal("Failed to provide migrationService", err)
+	}
+
+	return container
+}
+
+func SetupMigrationHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupMigrationServiceTestContainer(t, config)
+
+	err := container.Provide(func(migrationService *services.MigrationService) *apiV1.MigrationHandler {
+		return apiV1.NewMigrationHandler(migrationService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide migrationHandler", err)
+	}
+
+	return container
+}
+
+func SetupHealthServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupMigrationServiceTestContainer(t, config)
+
+	err := container.Provide(services.NewHealthService)
+	if err != nil {
+		log.Fatal("Failed to provide healthService", err)
+	}
+
+	return container
+}
+
+func SetupHealthHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupHealthServiceTestContainer(t, config)
+
+	err := container.Provide(func(healthService *services.HealthService) *apiV1.HealthHandler {
+		return apiV1.NewHealthHandler(healthService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide healthHandler", err)
+	}
+
+	return container
+}
+
+// -------- Unit of work containers: --------
+
+func SetupUnitOfWorkTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupApplicationRepositoryTestContainer(t, config)
+
+	err := container.Provide(services.NewUnitOfWork)
+	if err != nil {
+		log.Fatal("Failed to provide unitOfWork", err)
+	}
+
+	return container
+}
+
+func SetupLogApplicationServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupUnitOfWorkTestContainer(t, config)
+
+	provideEventTransitionValidator(container)
+
+	err := container.Provide(func(
+		unitOfWork *services.SQLUnitOfWork,
+		eventTransitionValidator *services.EventTransitionValidator) *services.LogApplicationService {
+
+		return services.NewLogApplicationService(unitOfWork, eventTransitionValidator)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide logApplicationService", err)
+	}
+
+	return container
+}
+
+func SetupLogApplicationHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupLogApplicationServiceTestContainer(t, config)
+
+	err := container.Provide(func(logApplicationService *services.LogApplicationService) *apiV1.LogApplicationHandler {
+		return apiV1.NewLogApplicationHandler(logApplicationService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide logApplicationHandler", err)
+	}
+
+	return container
+}
+
+// -------- Duplicate containers: --------
+
+func SetupDuplicateServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupUnitOfWorkTestContainer(t, config)
+
+	err := container.Provide(func(
+		companyRepository *repositories.CompanyRepository,
+		personRepository *repositories.PersonRepository,
+		unitOfWork *services.SQLUnitOfWork) *services.DuplicateService {
+
+		return services.NewDuplicateService(companyRepository, personRepository, unitOfWork)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide duplicateService", err)
+	}
+
+	return container
+}
+
+func SetupDuplicateHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupDuplicateServiceTestContainer(t, config)
+
+	err := container.Provide(func(duplicateService *services.DuplicateService) *apiV1.DuplicateHandler {
+		return apiV1.NewDuplicateHandler(duplicateService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide duplicateHandler", err)
+	}
+
+	return container
+}
+
+// -------- Bulk link containers: --------
+
+func SetupBulkLinkServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupUnitOfWorkTestContainer(t, config)
+
+	provideEventTransitionValidator(container)
+
+	// Add CompanyPersonRepository in order to check the links in tests
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide companyPersonRepository", err)
+	}
+
+	// Add EventPersonRepository in order to check the links in tests
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventPersonRepository {
+		return repositories.NewEventPersonRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide eventPersonRepository", err)
+	}
+
+	err = container.Provide(func(
+		unitOfWork *services.SQLUnitOfWork,
+		eventTransitionValidator *services.EventTransitionValidator) *services.BulkLinkService {
+
+		return services.NewBulkLinkService(unitOfWork, eventTransitionValidator)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide bulkLinkService", err)
+	}
+
+	return container
+}
+
+func SetupBulkLinkHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupBulkLinkServiceTestContainer(t, config)
+
+	err := container.Provide(func(bulkLinkService *services.BulkLinkService) *apiV1.BulkLinkHandler {
+		return apiV1.NewBulkLinkHandler(bulkLinkService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide bulkLinkHandler", err)
+	}
+
+	return container
+}
+
+// -------- Timeline containers: --------
+
+func SetupTimelineServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupApplicationRepositoryTestContainer(t, config)
+
+	// Add CompanyEventRepository in order to insert data for testing
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyEventRepository {
+		return repositories.NewCompanyEventRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide companyEventRepository", err)
+	}
+
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide companyPersonRepository", err)
+	}
+
+	// Add EventPersonRepository in order to insert data for testing
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.EventPersonRepository {
+		return repositories.NewEventPersonRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide eventPersonRepository", err)
+	}
+
+	err = container.Provide(func(
+		applicationRepository *repositories.ApplicationRepository,
+		companyRepository *repositories.CompanyRepository,
+		eventRepository *repositories.EventRepository,
+		personRepository *repositories.PersonRepository,
+		applicationPersonRepository *repositories.ApplicationPersonRepository,
+		companyPersonRepository *repositories.CompanyPersonRepository) *services.TimelineService {
+
+		return services.NewTimelineService(
+			applicationRepository,
+			companyRepository,
+			eventRepository,
+			personRepository,
+			applicationPersonRepository,
+			companyPersonRepository)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide timelineService", err)
+	}
+
+	return container
+}
+
+func SetupTimelineHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupTimelineServiceTestContainer(t, config)
+
+	err := container.Provide(func(timelineService *services.TimelineService) *apiV1.TimelineHandler {
+		return apiV1.NewTimelineHandler(timelineService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide timelineHandler", err)
+	}
+
+	return container
+}
+
+// -------- Job ad snapshot containers: --------
+
+func SetupJobAdSnapshotRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupApplicationRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.JobAdSnapshotRepository {
+		return repositories.NewJobAdSnapshotRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide jobAdSnapshotRepository", err)
+	}
+
+	return container
+}
+
+func SetupJobAdSnapshotServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupJobAdSnapshotRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(
+		jobAdSnapshotRepository *repositories.JobAdSnapshotRepository) *services.JobAdSnapshotService {
+
+		return services.NewJobAdSnapshotService(jobAdSnapshotRepository)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide jobAdSnapshotService", err)
+	}
+
+	return container
+}
+
+func SetupJobAdSnapshotHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupJobAdSnapshotServiceTestContainer(t, config)
+
+	err := container.Provide(func(jobAdSnapshotService *services.JobAdSnapshotService) *apiV1.JobAdSnapshotHandler {
+		return apiV1.NewJobAdSnapshotHandler(jobAdSnapshotService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide jobAdSnapshotHandler", err)
+	}
+
+	return container
+}
+
+// -------- Interview prep containers: --------
+
+func SetupInterviewPrepRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupApplicationRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.InterviewPrepRepository {
+		return repositories.NewInterviewPrepRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide interviewPrepRepository", err)
+	}
+
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.InterviewQuestionRepository {
+		return repositories.NewInterviewQuestionRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide interviewQuestionRepository", err)
+	}
+
+	// Add CompanyEventRepository in order to insert data for testing
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyEventRepository {
+		return repositories.NewCompanyEventRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide companyEventRepository in SetupInterviewPrepRepositoryTestContainer", err)
+	}
+
+	return container
+}
+
+func SetupInterviewPrepServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupInterviewPrepRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(
+		eventRepository *repositories.EventRepository,
+		interviewPrepRepository *repositories.InterviewPrepRepository,
+		interviewQuestionRepository *repositories.InterviewQuestionRepository) *services.InterviewPrepService {
+
+		return services.NewInterviewPrepService(eventRepository, interviewPrepRepository, interviewQuestionRepository)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide interviewPrepService", err)
+	}
+
+	return container
+}
+
+func SetupInterviewPrepHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupInterviewPrepServiceTestContainer(t, config)
+
+	err := container.Provide(func(interviewPrepService *services.InterviewPrepService) *apiV1.InterviewPrepHandler {
+		return apiV1.NewInterviewPrepHandler(interviewPrepService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide interviewPrepHandler", err)
+	}
+
+	return container
+}
+
+// -------- Agenda containers: --------
+
+func SetupAgendaServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupEventRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(eventRepository *repositories.EventRepository) *services.AgendaService {
+		return services.NewAgendaService(eventRepository)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide agendaService", err)
+	}
+
+	return container
+}
+
+func SetupAgendaHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupAgendaServiceTestContainer(t, config)
+
+	err := container.Provide(func(agendaService *services.AgendaService) *apiV1.AgendaHandler {
+		return apiV1.NewAgendaHandler(agendaService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide agendaHandler", err)
+	}
+
+	return container
+}
+
+// -------- Type definition containers: --------
+
+func SetupTypeDefinitionRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	// The event, company and person repositories are used to put types in use
+	container := SetupEventRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.TypeDefinitionRepository {
+		return repositories.NewTypeDefinitionRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide typeDefinitionRepository", err)
+	}
+
+	return container
+}
+
+func SetupTypeDefinitionServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupTypeDefinitionRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(
+		typeDefinitionRepository *repositories.TypeDefinitionRepository) *services.TypeDefinitionService {
+
+		return services.NewTypeDefinitionService(typeDefinitionRepository)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide typeDefinitionService", err)
+	}
+
+	return container
+}
+
+func SetupTypeDefinitionHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupTypeDefinitionServiceTestContainer(t, config)
+
+	err := container.Provide(func(typeDefinitionService *services.TypeDefinitionService) *apiV1.TypeDefinitionHandler {
+		return apiV1.NewTypeDefinitionHandler(typeDefinitionService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide typeDefinitionHandler", err)
+	}
+
+	return container
+}
+
+// -------- Change containers: --------
+
+func SetupChangeRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	// The record and link repositories are used to make changes
+	container := SetupApplicationRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.ChangeRepository {
+		return repositories.NewChangeRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide changeRepository", err)
+	}
+
+	err = container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.CompanyPersonRepository {
+		return repositories.NewCompanyPersonRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide companyPersonRepository in SetupChangeRepositoryTestContainer", err)
+	}
+
+	return container
+}
+
+func SetupChangeServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupChangeRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(
+		changeRepository *repositories.ChangeRepository, config *configPackage.Config) *services.ChangeService {
+
+		return services.NewChangeService(
+			changeRepository, config.ChangeStreamPollInterval(), config.ChangeLogRetention())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide changeService", err)
+	}
+
+	return container
+}
+
+func SetupChangeHandlerTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupChangeServiceTestContainer(t, config)
+
+	err := container.Provide(func(changeService *services.ChangeService) *apiV1.ChangeHandler {
+		return apiV1.NewChangeHandler(changeService)
+	})
+	if err != nil {
+		log.Fatal("Failed to provide changeHandler", err)
+	}
+
+	return container
+}
+
+// -------- Idempotency containers: --------
+
+func SetupIdempotencyKeyRepositoryTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupDatabaseTestContainer(t, config)
+
+	err := container.Provide(func(db *sql.DB, config *configPackage.Config) *repositories.IdempotencyKeyRepository {
+		return repositories.NewIdempotencyKeyRepository(db, config.DatabaseQueryTimeout())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide idempotencyKeyRepository", err)
+	}
+
+	return container
+}
+
+func SetupIdempotencyServiceTestContainer(t *testing.T, config configPackage.Config) *dig.Container {
+	container := SetupIdempotencyKeyRepositoryTestContainer(t, config)
+
+	err := container.Provide(func(
+		idempotencyKeyRepository *repositories.IdempotencyKeyRepository,
+		config *configPackage.Config) *services.IdempotencyService {
+
+		return services.NewIdempotencyService(
+			idempotencyKeyRepository, config.IdempotencyKeyTTL(), config.IdempotencyKeyLease())
+	})
+	if err != nil {
+		log.Fatal("Failed to provide idempotencyService", err)
+	}
+
+	return container
+}
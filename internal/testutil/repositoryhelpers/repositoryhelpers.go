@@ -1,6 +1,7 @@
 package repositoryhelpers
 
 import (
+	"context"
 	"jobsearchtracker/internal/models"
 	"jobsearchtracker/internal/repositories"
 	"jobsearchtracker/internal/testutil"
@@ -12,7 +13,7 @@ import (
 )
 
 func CreateApplication(
-	t *testing.T,
+	t testing.TB,
 	applicationRepository *repositories.ApplicationRepository,
 	applicationID *uuid.UUID,
 	companyID *uuid.UUID,
@@ -27,14 +28,14 @@ func CreateApplication(
 		RemoteStatusType: models.RemoteStatusTypeHybrid,
 		CreatedDate:      createdDate,
 	}
-	insertedApplication, err := applicationRepository.Create(&application)
+	insertedApplication, err := applicationRepository.Create(context.Background(), &application)
 	assert.NoError(t, err)
 
 	return insertedApplication
 }
 
 func AssociateApplicationEvent(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.ApplicationEventRepository,
 	applicationID uuid.UUID,
 	eventID uuid.UUID,
@@ -46,14 +47,14 @@ func AssociateApplicationEvent(
 		CreatedDate:   createdDate,
 	}
 
-	associatedApplicationEvent, err := repository.AssociateApplicationEvent(&model)
+	associatedApplicationEvent, err := repository.AssociateApplicationEvent(context.Background(), &model)
 	assert.NoError(t, err)
 
 	return associatedApplicationEvent
 }
 
 func AssociateApplicationPerson(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.ApplicationPersonRepository,
 	applicationID uuid.UUID,
 	personID uuid.UUID,
@@ -65,14 +66,14 @@ func AssociateApplicationPerson(
 		CreatedDate:   createdDate,
 	}
 
-	associatedApplicationPerson, err := repository.AssociateApplicationPerson(&model)
+	associatedApplicationPerson, err := repository.AssociateApplicationPerson(context.Background(), &model)
 	assert.NoError(t, err)
 
 	return associatedApplicationPerson
 }
 
 func AssociateCompanyEvent(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.CompanyEventRepository,
 	companyID uuid.UUID,
 	eventID uuid.UUID,
@@ -84,14 +85,14 @@ func AssociateCompanyEvent(
 		CreatedDate: createdDate,
 	}
 
-	associatedCompanyEvent, err := repository.AssociateCompanyEvent(&model)
+	associatedCompanyEvent, err := repository.AssociateCompanyEvent(context.Background(), &model)
 	assert.NoError(t, err)
 
 	return associatedCompanyEvent
 }
 
 func AssociateCompanyPerson(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.CompanyPersonRepository,
 	companyID uuid.UUID,
 	personID uuid.UUID,
@@ -103,14 +104,14 @@ func AssociateCompanyPerson(
 		CreatedDate: createdDate,
 	}
 
-	associatedCompanyPerson, err := repository.AssociateCompanyPerson(&model)
+	associatedCompanyPerson, err := repository.AssociateCompanyPerson(context.Background(), &model)
 	assert.NoError(t, err)
 
 	return associatedCompanyPerson
 }
 
 func AssociateEventPerson(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.EventPersonRepository,
 	eventID uuid.UUID,
 	personID uuid.UUID,
@@ -122,14 +123,14 @@ func AssociateEventPerson(
 		CreatedDate: createdDate,
 	}
 
-	associatedEventPerson, err := repository.AssociateEventPerson(&model)
+	associatedEventPerson, err := repository.AssociateEventPerson(context.Background(), &model)
 	assert.NoError(t, err)
 
 	return associatedEventPerson
 }
 
 func CreateCompany(
-	t *testing.T,
+	t testing.TB,
 	companyRepository *repositories.CompanyRepository,
 	companyID *uuid.UUID,
 	createdDate *time.Time) *models.Company {
@@ -141,14 +142,14 @@ func CreateCompany(
 		CreatedDate: createdDate,
 	}
 
-	insertedCompany, err := companyRepository.Create(&company)
+	insertedCompany, err := companyRepository.Create(context.Background(), &company)
 	assert.NoError(t, err)
 
 	return insertedCompany
 }
 
 func CreateEvent(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.EventRepository,
 	eventID *uuid.UUID,
 	eventType *models.EventType,
@@ -175,14 +176,14 @@ func CreateEvent(
 		EventType: *eventTypeToUse,
 		EventDate: *eventDateToUse,
 	}
-	insertedEvent, err := repository.Create(&event)
+	insertedEvent, err := repository.Create(context.Background(), &event)
 	assert.NoError(t, err)
 
 	return insertedEvent
 }
 
 func CreatePerson(
-	t *testing.T,
+	t testing.TB,
 	repository *repositories.PersonRepository,
 	personID *uuid.UUID,
 	createdDate *time.Time) *models.Person {
@@ -194,7 +195,7 @@ func CreatePerson(
 		CreatedDate: createdDate,
 	}
 
-	insertedPerson, err := repository.Create(&person)
+	insertedPerson, err := repository.Create(context.Background(), &person)
 	assert.NoError(t, err)
 
 	return insertedPerson
@@ -5,15 +5,23 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
 	"jobsearchtracker/internal/api"
 	configPackage "jobsearchtracker/internal/config"
 	databasePackage "jobsearchtracker/internal/database"
+	"jobsearchtracker/internal/metrics"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
+	// embeds the IANA timezone database, so that event timezones validate the same on every host
+	_ "time/tzdata"
 
 	"go.uber.org/dig"
 )
@@ -28,7 +36,15 @@ func run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	container, err := setupContainer()
+	config, commandArguments, err := configPackage.NewConfig(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	container, err := setupContainer(config)
 	if err != nil {
 		return fmt.Errorf("failed to setup container: %w", err)
 	}
@@ -44,6 +60,13 @@ func run() error {
 		}
 	}()
 
+	if len(commandArguments) > 0 {
+		if commandArguments[0] != "migrate" {
+			return fmt.Errorf("unknown command '%s'\n\n%s", commandArguments[0], migrateUsage)
+		}
+		return runMigrateCommand(container, commandArguments[1:])
+	}
+
 	err = container.Invoke(func(database *sql.DB, config *configPackage.Config) error {
 		return databasePackage.RunMigrations(database, config)
 	})
@@ -51,9 +74,24 @@ func run() error {
 		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
+	var httpServer *http.Server
+	err = container.Invoke(func(server *http.Server) {
+		httpServer = server
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create server: %w", err)
+	}
+
+	// Requests still running when the shutdown timeout expires have their context cancelled, which aborts their
+	// database queries.
+	requestsCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+	httpServer.BaseContext = func(net.Listener) context.Context { return requestsCtx }
+
 	errChan := make(chan error, 1)
 	go func() {
-		errChan <- container.Invoke(startServer)
+		slog.Info("Starting server...", "address", httpServer.Addr)
+		errChan <- httpServer.ListenAndServe()
 	}()
 
 	// Wait for interruption
@@ -67,6 +105,20 @@ func run() error {
 		slog.Info("Shutting down gracefully...")
 	}
 
+	// Stop accepting connections and wait for in-flight requests to finish.
+	shutdownCtx, cancel := context.WithTimeout(
+		context.Background(), time.Duration(config.ShutdownTimeoutSeconds)*time.Second)
+	defer cancel()
+
+	if err = httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+
+	if err = <-errChan; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("server stopped unexpectedly: %w", err)
+	}
+
+	slog.Info("Server stopped.")
 	return nil
 }
 
@@ -77,21 +129,22 @@ func main() {
 	}
 }
 
-func setupContainer() (*dig.Container, error) {
+func setupContainer(config *configPackage.Config) (*dig.Container, error) {
 	container := dig.New()
 
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	logger := newLogger(config)
+	slog.SetDefault(logger)
 	if err := container.Provide(func() *slog.Logger { return logger }); err != nil {
 		return nil, fmt.Errorf("failed to provide logger: %w", err)
 	}
 
-	config, err := configPackage.NewConfig()
+	err := container.Provide(func() *configPackage.Config { return config })
 	if err != nil {
-		return nil, fmt.Errorf("failed to load config: %w", err)
+		return nil, fmt.Errorf("failed to provide config: %w", err)
 	}
 
-	if err = container.Provide(func() *configPackage.Config { return config }); err != nil {
-		return nil, fmt.Errorf("failed to provide config: %w", err)
+	if err = container.Provide(metrics.NewMetrics); err != nil {
+		return nil, fmt.Errorf("failed to provide metrics: %w", err)
 	}
 
 	if err = container.Provide(databasePackage.NewFileDatabase); err != nil {
@@ -109,10 +162,33 @@ func setupContainer() (*dig.Container, error) {
 		return nil, fmt.Errorf("failed to provide api server: %w", err)
 	}
 
+	if err = container.Provide(newHTTPServer); err != nil {
+		return nil, fmt.Errorf("failed to provide http server: %w", err)
+	}
+
 	return container, nil
 }
 
-func startServer(server *api.Server, config *configPackage.Config) error {
-	slog.Info("Starting server...", "port", config.ServerPort)
-	return http.ListenAndServe(fmt.Sprintf(":%d", config.ServerPort), server)
+func newLogger(config *configPackage.Config) *slog.Logger {
+	var level slog.Level
+	// config.validate() has already checked the log level.
+	_ = level.UnmarshalText([]byte(config.LogLevel))
+
+	handlerOptions := &slog.HandlerOptions{Level: level}
+	if config.LogFormat == "text" {
+		return slog.New(slog.NewTextHandler(os.Stdout, handlerOptions))
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, handlerOptions))
+}
+
+func newHTTPServer(server *api.Server, config *configPackage.Config) *http.Server {
+	httpServer := &http.Server{
+		Addr:         net.JoinHostPort(config.BindAddress, strconv.Itoa(config.ServerPort)),
+		Handler:      server,
+		ReadTimeout:  time.Duration(config.ReadTimeoutSeconds) * time.Second,
+		WriteTimeout: time.Duration(config.WriteTimeoutSeconds) * time.Second,
+		IdleTimeout:  time.Duration(config.IdleTimeoutSeconds) * time.Second,
+	}
+	httpServer.RegisterOnShutdown(server.Close)
+	return httpServer
 }
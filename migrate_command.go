@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"jobsearchtracker/internal/models"
+	"jobsearchtracker/internal/services"
+	"strconv"
+	"strings"
+
+	"go.uber.org/dig"
+)
+
+const migrateUsage = `usage: jobsearchtracker [flags] migrate <command> [argument]
+
+commands:
+  status        show the current version, dirty flag, and applied and pending migrations
+  version       show the current version
+  up            apply all pending migrations
+  down [steps]  roll back [steps] migrations (default 1). The database is backed up first
+  goto <v>      migrate up or down to version <v>. The database is backed up first if migrating down
+  force <v>     set the version to <v> and clear the dirty flag without running migrations`
+
+// runMigrateCommand runs `jobsearchtracker [flags] migrate <command> [argument]` against the configured database.
+func runMigrateCommand(container *dig.Container, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	return container.Invoke(func(migrationService *services.MigrationService) error {
+		switch args[0] {
+		case "status":
+			status, err := migrationService.GetStatus()
+			if err != nil {
+				return err
+			}
+			printMigrationStatus(status)
+
+		case "version":
+			status, err := migrationService.GetStatus()
+			if err != nil {
+				return err
+			}
+			if status.Dirty {
+				fmt.Printf("%d (dirty)\n", status.Version)
+			} else {
+				fmt.Println(status.Version)
+			}
+
+		case "up":
+			status, err := migrationService.MigrateUp()
+			if err != nil {
+				return err
+			}
+			printMigrationStatus(status)
+
+		case "down":
+			steps := 1
+			if len(args) > 1 {
+				var err error
+				steps, err = strconv.Atoi(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid number of steps '%s': %w", args[1], err)
+				}
+			}
+
+			result, err := migrationService.MigrateDown(steps)
+			if err != nil {
+				return err
+			}
+			printMigrationResult(result)
+
+		case "goto":
+			if len(args) < 2 {
+				return errors.New("goto requires a version\n\n" + migrateUsage)
+			}
+
+			version, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return fmt.Errorf("invalid version '%s': %w", args[1], err)
+			}
+
+			result, err := migrationService.MigrateToVersion(uint(version))
+			if err != nil {
+				return err
+			}
+			printMigrationResult(result)
+
+		case "force":
+			if len(args) < 2 {
+				return errors.New("force requires a version\n\n" + migrateUsage)
+			}
+
+			version, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid version '%s': %w", args[1], err)
+			}
+
+			status, err := migrationService.ForceVersion(version)
+			if err != nil {
+				return err
+			}
+			printMigrationStatus(status)
+
+		default:
+			return fmt.Errorf("unknown migrate command '%s'\n\n%s", args[0], migrateUsage)
+		}
+
+		return nil
+	})
+}
+
+func printMigrationStatus(status *models.MigrationStatus) {
+	fmt.Printf("version:  %d\n", status.Version)
+	fmt.Printf("dirty:    %t\n", status.Dirty)
+	fmt.Printf("latest:   %d\n", status.LatestVersion)
+	fmt.Printf("applied:  %s\n", joinVersions(status.AppliedVersions))
+	fmt.Printf("pending:  %s\n", joinVersions(status.PendingVersions))
+}
+
+func printMigrationResult(result *models.MigrationResult) {
+	if result.BackupFile != nil {
+		fmt.Printf("backup:   %s\n", *result.BackupFile)
+	}
+	printMigrationStatus(result.Status)
+}
+
+func joinVersions(versions []uint) string {
+	if len(versions) == 0 {
+		return "none"
+	}
+
+	versionStrings := make([]string, len(versions))
+	for index, version := range versions {
+		versionStrings[index] = strconv.FormatUint(uint64(version), 10)
+	}
+
+	return strings.Join(versionStrings, ", ")
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_job_ad_snapshot_application_id;
+DROP TABLE job_ad_snapshot;
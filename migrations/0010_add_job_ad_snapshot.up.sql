@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS job_ad_snapshot
+(
+    id                  UUID        PRIMARY KEY,
+    application_id      UUID        NOT NULL,
+    source_type         TEXT        NOT NULL    CHECK (source_type IN ('html', 'jsonLd')),
+    content             TEXT        NOT NULL,
+    job_title           TEXT        NULLABLE,
+    company_name        TEXT        NULLABLE,
+    job_ad_url          TEXT        NULLABLE,
+    country             TEXT        NULLABLE,
+    area                TEXT        NULLABLE,
+    remote_status_type  TEXT        NOT NULL    CHECK (remote_status_type IN ('hybrid', 'office', 'remote', 'unknown')),
+    salary              TEXT        NULLABLE,
+    created_date        DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_job_ad_snapshot_application FOREIGN KEY (application_id) REFERENCES application(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_ad_snapshot_application_id ON job_ad_snapshot (application_id);
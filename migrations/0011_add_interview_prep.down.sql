@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_interview_question_event_id;
+DROP TABLE interview_question;
+DROP TABLE interview_prep;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS interview_prep
+(
+    event_id            UUID        PRIMARY KEY,
+    technical_topics    TEXT        NULLABLE,
+    self_rating         INT         NULLABLE    CHECK (self_rating BETWEEN 1 AND 5),
+    notes               TEXT        NULLABLE,
+    created_date        DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date        DATETIME    NULLABLE,
+    CONSTRAINT fk_interview_prep_event FOREIGN KEY (event_id) REFERENCES event(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS interview_question
+(
+    id              UUID        PRIMARY KEY,
+    event_id        UUID        NOT NULL,
+    question_type   TEXT        NOT NULL    CHECK (question_type IN ('askedToUs', 'askedByUs')),
+    question        TEXT        NOT NULL,
+    answer          TEXT        NULLABLE,
+    topic           TEXT        NULLABLE,
+    created_date    DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME    NULLABLE,
+    CONSTRAINT fk_interview_question_interview_prep
+        FOREIGN KEY (event_id) REFERENCES interview_prep(event_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_interview_question_event_id ON interview_question (event_id);
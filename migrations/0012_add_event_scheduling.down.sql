@@ -0,0 +1,5 @@
+ALTER TABLE event DROP COLUMN timezone;
+ALTER TABLE event DROP COLUMN interview_format;
+ALTER TABLE event DROP COLUMN meeting_url;
+ALTER TABLE event DROP COLUMN location;
+ALTER TABLE event DROP COLUMN duration_minutes;
@@ -0,0 +1,5 @@
+ALTER TABLE event ADD COLUMN duration_minutes INTEGER NULLABLE CHECK (duration_minutes > 0);
+ALTER TABLE event ADD COLUMN location TEXT NULLABLE;
+ALTER TABLE event ADD COLUMN meeting_url TEXT NULLABLE;
+ALTER TABLE event ADD COLUMN interview_format TEXT NULLABLE CHECK (interview_format IN ('phone', 'video', 'onsite'));
+ALTER TABLE event ADD COLUMN timezone TEXT NULLABLE;
@@ -0,0 +1,95 @@
+-- Restores the CHECK constraints of 0001, 0002 and 0006. User-defined types are not allowed by them, so events and
+-- persons of a user-defined type become 'other', and companies of a user-defined type become 'employer'.
+PRAGMA defer_foreign_keys = ON;
+
+CREATE TEMPORARY TABLE interview_prep_copy AS SELECT * FROM interview_prep;
+CREATE TEMPORARY TABLE interview_question_copy AS SELECT * FROM interview_question;
+
+-- event
+CREATE TEMPORARY TABLE event_copy AS SELECT * FROM event;
+DROP TABLE event;
+
+CREATE TABLE event
+(
+    id           UUID       PRIMARY KEY,
+    event_type   TEXT CHECK (event_type IN ('applied', 'callBooked', 'callCompleted', 'codeTestCompleted',
+                                           'codeTestReceived', 'interviewBooked', 'interviewCompleted', 'paused',
+                                           'offer', 'other', 'recruiterInterviewBooked', 'recruiterInterviewCompleted',
+                                           'rejected', 'signed', 'withdrew'))    NOT NULL,
+    description  TEXT       NULLABLE,
+    notes        TEXT       NULLABLE,
+    event_date   DATETIME   NOT NULL,
+    created_date DATETIME   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date DATETIME   NULLABLE,
+    duration_minutes INTEGER NULLABLE CHECK (duration_minutes > 0),
+    location TEXT NULLABLE,
+    meeting_url TEXT NULLABLE,
+    interview_format TEXT NULLABLE CHECK (interview_format IN ('phone', 'video', 'onsite')),
+    timezone TEXT NULLABLE
+);
+
+INSERT INTO event (id, event_type, description, notes, event_date, created_date, updated_date, duration_minutes,
+                   location, meeting_url, interview_format, timezone)
+SELECT id,
+       CASE WHEN event_type IN (SELECT name FROM event_type WHERE is_built_in) THEN event_type ELSE 'other' END,
+       description, notes, event_date, created_date, updated_date, duration_minutes,
+       location, meeting_url, interview_format, timezone FROM event_copy;
+DROP TABLE event_copy;
+
+-- person
+CREATE TEMPORARY TABLE person_copy AS SELECT * FROM person;
+DROP TABLE person;
+
+CREATE TABLE person
+(
+    id           UUID   PRIMARY KEY,
+    name         TEXT   NOT NULL,
+    person_type  TEXT CHECK (person_type IN ('CEO', 'CTO', 'developer', 'externalRecruiter', 'internalRecruiter', 'HR',
+                                             'jobAdvertiser', 'jobContact', 'other', 'unknown'))    NOT NULL,
+    email        TEXT   NULLABLE UNIQUE,
+    phone        TEXT   NULLABLE,
+    notes        TEXT   NULLABLE,
+    created_date DATETIME   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date DATETIME NULLABLE
+);
+
+INSERT INTO person (id, name, person_type, email, phone, notes, created_date, updated_date)
+SELECT id,
+       name,
+       CASE WHEN person_type IN (SELECT name FROM person_type WHERE is_built_in) THEN person_type ELSE 'other' END,
+       email, phone, notes, created_date, updated_date FROM person_copy;
+DROP TABLE person_copy;
+
+-- company
+CREATE TEMPORARY TABLE company_copy AS SELECT * FROM company;
+DROP TABLE company;
+
+CREATE TABLE company (
+    id              UUID                                                                    PRIMARY KEY,
+    name            TEXT                                                                    NOT NULL,
+    company_type    TEXT CHECK(company_type IN ('employer', 'recruiter', 'consultancy'))    NOT NULL,
+    notes           TEXT                                                                    NULLABLE,
+    last_contact    DATETIME                                                                NULLABLE,
+    created_date    DATETIME                                                                NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME                                                                NULLABLE
+);
+
+INSERT INTO company (id, name, company_type, notes, last_contact, created_date, updated_date)
+SELECT id,
+       name,
+       CASE WHEN company_type IN (SELECT name FROM company_type WHERE is_built_in) THEN company_type ELSE 'employer' END,
+       notes, last_contact, created_date, updated_date FROM company_copy;
+DROP TABLE company_copy;
+
+CREATE INDEX index_company_name ON company(name);
+CREATE INDEX index_created_date ON company(created_date);
+CREATE INDEX index_last_contact ON company(last_contact);
+
+INSERT INTO interview_prep SELECT * FROM interview_prep_copy;
+INSERT INTO interview_question SELECT * FROM interview_question_copy;
+DROP TABLE interview_prep_copy;
+DROP TABLE interview_question_copy;
+
+DROP TABLE event_type;
+DROP TABLE person_type;
+DROP TABLE company_type;
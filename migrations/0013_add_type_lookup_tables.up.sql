@@ -0,0 +1,151 @@
+-- The event, person and company types move from CHECK constraints to lookup tables, so that new types can be added
+-- without a migration. SQLite cannot drop a CHECK constraint, so the three tables are rebuilt. Their rows are copied
+-- to temporary tables first, because dropping a table deletes its rows, which cascades to interview_prep and
+-- interview_question. Foreign keys are only checked on commit, once every row is back.
+PRAGMA defer_foreign_keys = ON;
+
+CREATE TABLE IF NOT EXISTS company_type
+(
+    name            TEXT        PRIMARY KEY,
+    display_name    TEXT        NOT NULL,
+    sort_order      INTEGER     NOT NULL    DEFAULT 0,
+    pipeline_stage  TEXT        NULLABLE    CHECK (pipeline_stage IN ('applied', 'screening', 'interviewing', 'offer',
+                                                                      'hired', 'closed')),
+    is_built_in     BOOLEAN     NOT NULL    DEFAULT FALSE,
+    created_date    DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME    NULLABLE
+);
+
+CREATE TABLE IF NOT EXISTS person_type
+(
+    name            TEXT        PRIMARY KEY,
+    display_name    TEXT        NOT NULL,
+    sort_order      INTEGER     NOT NULL    DEFAULT 0,
+    pipeline_stage  TEXT        NULLABLE    CHECK (pipeline_stage IN ('applied', 'screening', 'interviewing', 'offer',
+                                                                      'hired', 'closed')),
+    is_built_in     BOOLEAN     NOT NULL    DEFAULT FALSE,
+    created_date    DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME    NULLABLE
+);
+
+CREATE TABLE IF NOT EXISTS event_type
+(
+    name            TEXT        PRIMARY KEY,
+    display_name    TEXT        NOT NULL,
+    sort_order      INTEGER     NOT NULL    DEFAULT 0,
+    pipeline_stage  TEXT        NULLABLE    CHECK (pipeline_stage IN ('applied', 'screening', 'interviewing', 'offer',
+                                                                      'hired', 'closed')),
+    is_built_in     BOOLEAN     NOT NULL    DEFAULT FALSE,
+    created_date    DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME    NULLABLE
+);
+
+INSERT INTO company_type (name, display_name, sort_order, pipeline_stage, is_built_in, created_date) VALUES
+    ('employer',    'Employer',    10, NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('recruiter',   'Recruiter',   20, NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('consultancy', 'Consultancy', 30, NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+
+INSERT INTO person_type (name, display_name, sort_order, pipeline_stage, is_built_in, created_date) VALUES
+    ('CEO',               'CEO',                10,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('CTO',               'CTO',                20,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('developer',         'Developer',          30,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('externalRecruiter', 'External recruiter', 40,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('internalRecruiter', 'Internal recruiter', 50,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('HR',                'HR',                 60,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('jobAdvertiser',     'Job advertiser',     70,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('jobContact',        'Job contact',        80,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('other',             'Other',              90,  NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('unknown',           'Unknown',            100, NULL, TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+
+INSERT INTO event_type (name, display_name, sort_order, pipeline_stage, is_built_in, created_date) VALUES
+    ('applied',                     'Applied',                       10,  'applied',      TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('recruiterInterviewBooked',    'Recruiter interview booked',    20,  'screening',    TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('recruiterInterviewCompleted', 'Recruiter interview completed', 30,  'screening',    TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('callBooked',                  'Call booked',                   40,  'screening',    TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('callCompleted',               'Call completed',                50,  'screening',    TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('codeTestReceived',            'Code test received',            60,  'interviewing', TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('codeTestCompleted',           'Code test completed',           70,  'interviewing', TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('interviewBooked',             'Interview booked',              80,  'interviewing', TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('interviewCompleted',          'Interview completed',           90,  'interviewing', TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('offer',                       'Offer',                         100, 'offer',        TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('signed',                      'Signed',                        110, 'hired',        TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('rejected',                    'Rejected',                      120, 'closed',       TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('withdrew',                    'Withdrew',                      130, 'closed',       TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('paused',                      'Paused',                        140, NULL,           TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now')),
+    ('other',                       'Other',                         150, NULL,           TRUE, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+
+CREATE TEMPORARY TABLE interview_prep_copy AS SELECT * FROM interview_prep;
+CREATE TEMPORARY TABLE interview_question_copy AS SELECT * FROM interview_question;
+
+-- company
+CREATE TEMPORARY TABLE company_copy AS SELECT * FROM company;
+DROP TABLE company;
+
+CREATE TABLE company (
+    id              UUID        PRIMARY KEY,
+    name            TEXT        NOT NULL,
+    company_type    TEXT        NOT NULL    REFERENCES company_type(name),
+    notes           TEXT        NULLABLE,
+    last_contact    DATETIME    NULLABLE,
+    created_date    DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date    DATETIME    NULLABLE
+);
+
+INSERT INTO company (id, name, company_type, notes, last_contact, created_date, updated_date)
+SELECT id, name, company_type, notes, last_contact, created_date, updated_date FROM company_copy;
+DROP TABLE company_copy;
+
+CREATE INDEX index_company_name ON company(name);
+CREATE INDEX index_created_date ON company(created_date);
+CREATE INDEX index_last_contact ON company(last_contact);
+
+-- person
+CREATE TEMPORARY TABLE person_copy AS SELECT * FROM person;
+DROP TABLE person;
+
+CREATE TABLE person
+(
+    id           UUID       PRIMARY KEY,
+    name         TEXT       NOT NULL,
+    person_type  TEXT       NOT NULL    REFERENCES person_type(name),
+    email        TEXT       NULLABLE    UNIQUE,
+    phone        TEXT       NULLABLE,
+    notes        TEXT       NULLABLE,
+    created_date DATETIME   NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date DATETIME   NULLABLE
+);
+
+INSERT INTO person (id, name, person_type, email, phone, notes, created_date, updated_date)
+SELECT id, name, person_type, email, phone, notes, created_date, updated_date FROM person_copy;
+DROP TABLE person_copy;
+
+-- event
+CREATE TEMPORARY TABLE event_copy AS SELECT * FROM event;
+DROP TABLE event;
+
+CREATE TABLE event
+(
+    id                  UUID        PRIMARY KEY,
+    event_type          TEXT        NOT NULL    REFERENCES event_type(name),
+    description         TEXT        NULLABLE,
+    notes               TEXT        NULLABLE,
+    event_date          DATETIME    NOT NULL,
+    created_date        DATETIME    NOT NULL    DEFAULT CURRENT_TIMESTAMP,
+    updated_date        DATETIME    NULLABLE,
+    duration_minutes    INTEGER     NULLABLE    CHECK (duration_minutes > 0),
+    location            TEXT        NULLABLE,
+    meeting_url         TEXT        NULLABLE,
+    interview_format    TEXT        NULLABLE    CHECK (interview_format IN ('phone', 'video', 'onsite')),
+    timezone            TEXT        NULLABLE
+);
+
+INSERT INTO event (id, event_type, description, notes, event_date, created_date, updated_date, duration_minutes,
+                   location, meeting_url, interview_format, timezone)
+SELECT id, event_type, description, notes, event_date, created_date, updated_date, duration_minutes,
+       location, meeting_url, interview_format, timezone FROM event_copy;
+DROP TABLE event_copy;
+
+INSERT INTO interview_prep SELECT * FROM interview_prep_copy;
+INSERT INTO interview_question SELECT * FROM interview_question_copy;
+DROP TABLE interview_prep_copy;
+DROP TABLE interview_question_copy;
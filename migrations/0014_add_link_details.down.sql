@@ -0,0 +1,30 @@
+-- The CHECK on end_date references start_date, so end_date is dropped first.
+ALTER TABLE company_event DROP COLUMN updated_date;
+ALTER TABLE company_event DROP COLUMN end_date;
+ALTER TABLE company_event DROP COLUMN start_date;
+ALTER TABLE company_event DROP COLUMN notes;
+ALTER TABLE company_event DROP COLUMN role;
+
+ALTER TABLE application_event DROP COLUMN updated_date;
+ALTER TABLE application_event DROP COLUMN end_date;
+ALTER TABLE application_event DROP COLUMN start_date;
+ALTER TABLE application_event DROP COLUMN notes;
+ALTER TABLE application_event DROP COLUMN role;
+
+ALTER TABLE event_person DROP COLUMN updated_date;
+ALTER TABLE event_person DROP COLUMN end_date;
+ALTER TABLE event_person DROP COLUMN start_date;
+ALTER TABLE event_person DROP COLUMN notes;
+ALTER TABLE event_person DROP COLUMN role;
+
+ALTER TABLE application_person DROP COLUMN updated_date;
+ALTER TABLE application_person DROP COLUMN end_date;
+ALTER TABLE application_person DROP COLUMN start_date;
+ALTER TABLE application_person DROP COLUMN notes;
+ALTER TABLE application_person DROP COLUMN role;
+
+ALTER TABLE company_person DROP COLUMN updated_date;
+ALTER TABLE company_person DROP COLUMN end_date;
+ALTER TABLE company_person DROP COLUMN start_date;
+ALTER TABLE company_person DROP COLUMN notes;
+ALTER TABLE company_person DROP COLUMN role;
@@ -0,0 +1,30 @@
+-- Links between companies, persons, applications and events get an optional role, notes and the period they cover.
+ALTER TABLE company_person ADD COLUMN role TEXT NULLABLE;
+ALTER TABLE company_person ADD COLUMN notes TEXT NULLABLE;
+ALTER TABLE company_person ADD COLUMN start_date DATETIME NULLABLE;
+ALTER TABLE company_person ADD COLUMN end_date DATETIME NULLABLE CHECK (end_date >= start_date);
+ALTER TABLE company_person ADD COLUMN updated_date DATETIME NULLABLE;
+
+ALTER TABLE application_person ADD COLUMN role TEXT NULLABLE;
+ALTER TABLE application_person ADD COLUMN notes TEXT NULLABLE;
+ALTER TABLE application_person ADD COLUMN start_date DATETIME NULLABLE;
+ALTER TABLE application_person ADD COLUMN end_date DATETIME NULLABLE CHECK (end_date >= start_date);
+ALTER TABLE application_person ADD COLUMN updated_date DATETIME NULLABLE;
+
+ALTER TABLE event_person ADD COLUMN role TEXT NULLABLE;
+ALTER TABLE event_person ADD COLUMN notes TEXT NULLABLE;
+ALTER TABLE event_person ADD COLUMN start_date DATETIME NULLABLE;
+ALTER TABLE event_person ADD COLUMN end_date DATETIME NULLABLE CHECK (end_date >= start_date);
+ALTER TABLE event_person ADD COLUMN updated_date DATETIME NULLABLE;
+
+ALTER TABLE application_event ADD COLUMN role TEXT NULLABLE;
+ALTER TABLE application_event ADD COLUMN notes TEXT NULLABLE;
+ALTER TABLE application_event ADD COLUMN start_date DATETIME NULLABLE;
+ALTER TABLE application_event ADD COLUMN end_date DATETIME NULLABLE CHECK (end_date >= start_date);
+ALTER TABLE application_event ADD COLUMN updated_date DATETIME NULLABLE;
+
+ALTER TABLE company_event ADD COLUMN role TEXT NULLABLE;
+ALTER TABLE company_event ADD COLUMN notes TEXT NULLABLE;
+ALTER TABLE company_event ADD COLUMN start_date DATETIME NULLABLE;
+ALTER TABLE company_event ADD COLUMN end_date DATETIME NULLABLE CHECK (end_date >= start_date);
+ALTER TABLE company_event ADD COLUMN updated_date DATETIME NULLABLE;
@@ -0,0 +1,30 @@
+DROP TRIGGER IF EXISTS trigger_change_log_application_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_application_update;
+DROP TRIGGER IF EXISTS trigger_change_log_application_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_company_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_company_update;
+DROP TRIGGER IF EXISTS trigger_change_log_company_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_event_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_event_update;
+DROP TRIGGER IF EXISTS trigger_change_log_event_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_person_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_person_update;
+DROP TRIGGER IF EXISTS trigger_change_log_person_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_application_event_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_application_event_update;
+DROP TRIGGER IF EXISTS trigger_change_log_application_event_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_application_person_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_application_person_update;
+DROP TRIGGER IF EXISTS trigger_change_log_application_person_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_company_event_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_company_event_update;
+DROP TRIGGER IF EXISTS trigger_change_log_company_event_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_company_person_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_company_person_update;
+DROP TRIGGER IF EXISTS trigger_change_log_company_person_delete;
+DROP TRIGGER IF EXISTS trigger_change_log_event_person_insert;
+DROP TRIGGER IF EXISTS trigger_change_log_event_person_update;
+DROP TRIGGER IF EXISTS trigger_change_log_event_person_delete;
+
+DROP INDEX IF EXISTS index_change_log_entity_type;
+DROP TABLE IF EXISTS change_log;
@@ -0,0 +1,190 @@
+-- Every create, update and delete of a record or link is recorded in change_log by a trigger, so that changes made
+-- in a transaction, by a merge or by a cascading delete are recorded too, and only if their transaction commits.
+-- SQLite has one writer at a time, so sequences are committed in order, and a client of the change stream can resume
+-- after the last sequence it received. A migration that rebuilds one of these tables must create its triggers again.
+CREATE TABLE IF NOT EXISTS change_log
+(
+    sequence            INTEGER     PRIMARY KEY AUTOINCREMENT,
+    entity_type         TEXT        NOT NULL    CHECK (entity_type IN ('application', 'company', 'event', 'person',
+                                                                       'application-event', 'application-person',
+                                                                       'company-event', 'company-person',
+                                                                       'event-person')),
+    change_type         TEXT        NOT NULL    CHECK (change_type IN ('created', 'updated', 'deleted',
+                                                                       'associated')),
+    entity_id           UUID        NOT NULL,
+    related_entity_id   UUID        NULLABLE,
+    change_date         DATETIME    NOT NULL
+);
+
+CREATE INDEX index_change_log_entity_type ON change_log(entity_type, sequence);
+
+-- application
+CREATE TRIGGER trigger_change_log_application_insert AFTER INSERT ON application
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('application', 'created', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_update AFTER UPDATE ON application
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('application', 'updated', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_delete AFTER DELETE ON application
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('application', 'deleted', OLD.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- company
+CREATE TRIGGER trigger_change_log_company_insert AFTER INSERT ON company
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('company', 'created', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_update AFTER UPDATE ON company
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('company', 'updated', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_delete AFTER DELETE ON company
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('company', 'deleted', OLD.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- event
+CREATE TRIGGER trigger_change_log_event_insert AFTER INSERT ON event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('event', 'created', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_event_update AFTER UPDATE ON event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('event', 'updated', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_event_delete AFTER DELETE ON event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('event', 'deleted', OLD.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- person
+CREATE TRIGGER trigger_change_log_person_insert AFTER INSERT ON person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('person', 'created', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_person_update AFTER UPDATE ON person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('person', 'updated', NEW.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_person_delete AFTER DELETE ON person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, change_date)
+    VALUES ('person', 'deleted', OLD.id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- application_event
+CREATE TRIGGER trigger_change_log_application_event_insert AFTER INSERT ON application_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-event', 'associated', NEW.application_id, NEW.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_event_update AFTER UPDATE ON application_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-event', 'updated', NEW.application_id, NEW.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_event_delete AFTER DELETE ON application_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-event', 'deleted', OLD.application_id, OLD.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- application_person
+CREATE TRIGGER trigger_change_log_application_person_insert AFTER INSERT ON application_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-person', 'associated', NEW.application_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_person_update AFTER UPDATE ON application_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-person', 'updated', NEW.application_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_application_person_delete AFTER DELETE ON application_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('application-person', 'deleted', OLD.application_id, OLD.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- company_event
+CREATE TRIGGER trigger_change_log_company_event_insert AFTER INSERT ON company_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-event', 'associated', NEW.company_id, NEW.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_event_update AFTER UPDATE ON company_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-event', 'updated', NEW.company_id, NEW.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_event_delete AFTER DELETE ON company_event
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-event', 'deleted', OLD.company_id, OLD.event_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- company_person
+CREATE TRIGGER trigger_change_log_company_person_insert AFTER INSERT ON company_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-person', 'associated', NEW.company_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_person_update AFTER UPDATE ON company_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-person', 'updated', NEW.company_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_company_person_delete AFTER DELETE ON company_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('company-person', 'deleted', OLD.company_id, OLD.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+-- event_person
+CREATE TRIGGER trigger_change_log_event_person_insert AFTER INSERT ON event_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('event-person', 'associated', NEW.event_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_event_person_update AFTER UPDATE ON event_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('event-person', 'updated', NEW.event_id, NEW.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
+
+CREATE TRIGGER trigger_change_log_event_person_delete AFTER DELETE ON event_person
+BEGIN
+    INSERT INTO change_log (entity_type, change_type, entity_id, related_entity_id, change_date)
+    VALUES ('event-person', 'deleted', OLD.event_id, OLD.person_id, strftime('%Y-%m-%dT%H:%M:%fZ', 'now'));
+END;
@@ -0,0 +1,4 @@
+ALTER TABLE person DROP COLUMN version;
+ALTER TABLE event DROP COLUMN version;
+ALTER TABLE company DROP COLUMN version;
+ALTER TABLE application DROP COLUMN version;
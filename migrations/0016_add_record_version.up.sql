@@ -0,0 +1,6 @@
+-- Every update increments the version of the record, so that a client can tell whether a record has been changed since
+-- it read it. Existing records start at version 1.
+ALTER TABLE application ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE company ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE event ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE person ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
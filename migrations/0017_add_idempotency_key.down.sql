@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_idempotency_key_expiry_date;
+DROP TABLE idempotency_key;
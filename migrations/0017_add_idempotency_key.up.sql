@@ -0,0 +1,17 @@
+-- A create or associate request sent with an Idempotency-Key header is recorded here, so that a client that retries it
+-- gets the original response instead of a second record. status_code is NULL while the first request is being handled.
+-- Dates are stored in UTC, so that they can be compared as text.
+CREATE TABLE IF NOT EXISTS idempotency_key
+(
+    key                 TEXT        NOT NULL,
+    path                TEXT        NOT NULL,
+    request_hash        TEXT        NOT NULL,
+    status_code         INTEGER     NULLABLE,
+    content_type        TEXT        NULLABLE,
+    response_body       BLOB        NULLABLE,
+    created_date        DATETIME    NOT NULL,
+    expiry_date         DATETIME    NOT NULL,
+    PRIMARY KEY (key, path)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_expiry_date ON idempotency_key (expiry_date);
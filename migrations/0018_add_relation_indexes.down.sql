@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_application_recruiter_id;
+DROP INDEX IF EXISTS idx_application_company_id;
+DROP INDEX IF EXISTS idx_event_person_person_id;
+DROP INDEX IF EXISTS idx_company_person_person_id;
+DROP INDEX IF EXISTS idx_company_event_event_id;
+DROP INDEX IF EXISTS idx_application_person_person_id;
+DROP INDEX IF EXISTS idx_application_event_event_id;
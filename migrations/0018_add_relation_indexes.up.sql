@@ -0,0 +1,9 @@
+-- The primary keys of the link tables only cover lookups by their first column. These indexes cover the lookups of the
+-- other side, and of the applications of a company or recruiter, used when relations are loaded in batches.
+CREATE INDEX IF NOT EXISTS idx_application_event_event_id ON application_event (event_id);
+CREATE INDEX IF NOT EXISTS idx_application_person_person_id ON application_person (person_id);
+CREATE INDEX IF NOT EXISTS idx_company_event_event_id ON company_event (event_id);
+CREATE INDEX IF NOT EXISTS idx_company_person_person_id ON company_person (person_id);
+CREATE INDEX IF NOT EXISTS idx_event_person_person_id ON event_person (person_id);
+CREATE INDEX IF NOT EXISTS idx_application_company_id ON application (company_id);
+CREATE INDEX IF NOT EXISTS idx_application_recruiter_id ON application (recruiter_id);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trigger_interview_prep_version_question_delete;
+DROP TRIGGER IF EXISTS trigger_interview_prep_version_question_update;
+DROP TRIGGER IF EXISTS trigger_interview_prep_version_question_insert;
+
+ALTER TABLE interview_prep DROP COLUMN version;
@@ -0,0 +1,19 @@
+-- Every update of an interview prep increments its version, as for the records in 0016. Its questions are returned
+-- with it, so adding, updating or deleting a question increments the version of its prep too. A migration that
+-- rebuilds interview_question must create its triggers again.
+ALTER TABLE interview_prep ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+CREATE TRIGGER trigger_interview_prep_version_question_insert AFTER INSERT ON interview_question
+BEGIN
+    UPDATE interview_prep SET version = version + 1 WHERE event_id = NEW.event_id;
+END;
+
+CREATE TRIGGER trigger_interview_prep_version_question_update AFTER UPDATE ON interview_question
+BEGIN
+    UPDATE interview_prep SET version = version + 1 WHERE event_id IN (OLD.event_id, NEW.event_id);
+END;
+
+CREATE TRIGGER trigger_interview_prep_version_question_delete AFTER DELETE ON interview_question
+BEGIN
+    UPDATE interview_prep SET version = version + 1 WHERE event_id = OLD.event_id;
+END;